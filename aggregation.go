@@ -0,0 +1,240 @@
+package sqlparser
+
+import "fmt"
+
+// CheckAggregation validates the use of aggregate functions and GROUP BY
+// in sel. It reports:
+//
+// - select expressions with columns that are neither grouped nor
+// aggregated (the ONLY_FULL_GROUP_BY rule),
+// - aggregates nested inside aggregates,
+// - aggregates in the WHERE clause,
+// - HAVING clauses referencing columns that are neither grouped,
+// aggregated nor select aliases,
+// - DISTINCT in calls of functions that are not aggregates, and
+// DISTINCT with several arguments in aggregates other than COUNT
+// and GROUP_CONCAT.
+//
+// Positional GROUP BY entries and select aliases are resolved against
+// the select list before checking. Functional dependencies on primary
+// keys are not known to the parser, so they are not taken into account.
+// Subqueries are not checked.
+func CheckAggregation(sel *Select) []error {
+	var errs []error
+
+	if sel.Where != nil {
+		_ = Walk(func(node SQLNode) (bool, error) {
+			switch node.(type) {
+			case *Subquery:
+				return false, nil
+			}
+			if isAggregate(node) {
+				errs = append(errs, fmt.Errorf("aggregate %s is not allowed in where clause", String(node)))
+				return false, nil
+			}
+			return true, nil
+		}, sel.Where.Expr)
+	}
+
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *FuncExpr:
+			if err := checkDistinct(node); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return true, nil
+	}, sel)
+
+	hasAggregates := false
+	checkNested := func(node SQLNode) {
+		_ = Walk(func(node SQLNode) (bool, error) {
+			switch node.(type) {
+			case *Subquery:
+				return false, nil
+			}
+			if !isAggregate(node) {
+				return true, nil
+			}
+			hasAggregates = true
+			_ = Walk(func(inner SQLNode) (bool, error) {
+				switch inner.(type) {
+				case *Subquery:
+					return false, nil
+				}
+				if inner != node && isAggregate(inner) {
+					errs = append(errs, fmt.Errorf("aggregate %s is nested inside aggregate %s", String(inner), String(node)))
+					return false, nil
+				}
+				return true, nil
+			}, node)
+			return false, nil
+		}, node)
+	}
+	checkNested(sel.SelectExprs)
+	if sel.Having != nil {
+		checkNested(sel.Having.Expr)
+	}
+
+	grouped, groupErrs := resolveGroupBy(sel)
+	errs = append(errs, groupErrs...)
+	if len(sel.GroupBy) == 0 && !hasAggregates {
+		return errs
+	}
+
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			continue
+		}
+		for _, col := range ungroupedColumns(aliased.Expr, grouped) {
+			errs = append(errs, fmt.Errorf("select expression #%d (%s) contains nonaggregated column %s which is not in group by", i+1, String(aliased.Expr), String(col)))
+		}
+	}
+
+	if sel.Having != nil {
+		for _, col := range ungroupedColumns(sel.Having.Expr, grouped) {
+			if col.Qualifier.IsEmpty() && findSelectAlias(sel.SelectExprs, col.Name) != nil {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("having clause references %s which is neither grouped nor aggregated", String(col)))
+		}
+	}
+	return errs
+}
+
+// resolveGroupBy returns the grouping expressions of sel, with positional
+// entries replaced by the select expression they refer to. Entries that
+// name a select alias are returned both as is and resolved, since the
+// name could also refer to a column of the FROM clause.
+func resolveGroupBy(sel *Select) (grouped []Expr, errs []error) {
+	for _, expr := range sel.GroupBy {
+		switch expr := expr.(type) {
+		case *SQLVal:
+			pos, ok := positionalRef(expr)
+			if !ok {
+				break
+			}
+			if pos < 1 || pos > len(sel.SelectExprs) {
+				errs = append(errs, fmt.Errorf("unknown column %s in group by", String(expr)))
+				continue
+			}
+			aliased, ok := sel.SelectExprs[pos-1].(*AliasedExpr)
+			if !ok {
+				errs = append(errs, fmt.Errorf("cannot group on %s", String(sel.SelectExprs[pos-1])))
+				continue
+			}
+			grouped = append(grouped, aliased.Expr)
+			continue
+		case *ColName:
+			if expr.Qualifier.IsEmpty() {
+				if aliased := findSelectAlias(sel.SelectExprs, expr.Name); aliased != nil {
+					grouped = append(grouped, aliased.Expr)
+				}
+			}
+		}
+		grouped = append(grouped, expr)
+	}
+	for _, expr := range grouped {
+		_ = Walk(func(node SQLNode) (bool, error) {
+			if isAggregate(node) {
+				errs = append(errs, fmt.Errorf("cannot group on aggregate %s", String(node)))
+				return false, nil
+			}
+			return true, nil
+		}, expr)
+	}
+	return grouped, errs
+}
+
+// ungroupedColumns returns the columns of expr that are outside of
+// aggregates and not covered by any of the grouped expressions.
+func ungroupedColumns(expr Expr, grouped []Expr) []*ColName {
+	var cols []*ColName
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if e, ok := node.(Expr); ok && isGrouped(e, grouped) {
+			return false, nil
+		}
+		if isAggregate(node) {
+			return false, nil
+		}
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *ColName:
+			cols = append(cols, node)
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return cols
+}
+
+// isGrouped returns true if expr matches one of the grouped expressions.
+// Columns match if their names are equal and their qualifiers are equal
+// or missing on either side.
+func isGrouped(expr Expr, grouped []Expr) bool {
+	col, isCol := expr.(*ColName)
+	for _, g := range grouped {
+		if gcol, ok := g.(*ColName); ok && isCol {
+			if gcol.Name.Equal(col.Name) && (gcol.Qualifier.IsEmpty() || col.Qualifier.IsEmpty() || gcol.Qualifier == col.Qualifier) {
+				return true
+			}
+			continue
+		}
+		if canonicalString(g) == canonicalString(expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// findSelectAlias returns the select expression aliased as name, if any.
+func findSelectAlias(exprs SelectExprs, name ColIdent) *AliasedExpr {
+	for _, expr := range exprs {
+		if aliased, ok := expr.(*AliasedExpr); ok && !aliased.As.IsEmpty() && aliased.As.Equal(name) {
+			return aliased
+		}
+	}
+	return nil
+}
+
+// checkDistinct validates the use of DISTINCT in the call fn.
+func checkDistinct(fn *FuncExpr) error {
+	if !fn.Distinct {
+		return nil
+	}
+	if !fn.IsAggregate() {
+		return fmt.Errorf("distinct is not allowed in %s, which is not an aggregate", String(fn))
+	}
+	if len(fn.Exprs) > 1 && fn.Name.Lowered() != "count" {
+		return fmt.Errorf("aggregate %s cannot take several distinct arguments", String(fn))
+	}
+	return nil
+}
+
+// isAggregate returns true if node is a call to an aggregate function.
+func isAggregate(node SQLNode) bool {
+	switch node := node.(type) {
+	case *FuncExpr:
+		return node.IsAggregate()
+	case *GroupConcatExpr:
+		return true
+	}
+	return false
+}
+
+// isDistinctAggregate returns true if node is a call to an
+// aggregate function over the distinct values of its arguments,
+// like COUNT(DISTINCT a, b).
+func isDistinctAggregate(node SQLNode) bool {
+	switch node := node.(type) {
+	case *FuncExpr:
+		return node.Distinct && node.IsAggregate()
+	case *GroupConcatExpr:
+		return node.Distinct != ""
+	}
+	return false
+}
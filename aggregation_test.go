@@ -0,0 +1,117 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckAggregation(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want []string
+	}{{
+		in: "select a, b from t",
+	}, {
+		in: "select a, count(*) from t group by a",
+	}, {
+		in: "select t.a, count(*) from t group by a",
+	}, {
+		in: "select a + 1, sum(b) from t group by a + 1",
+	}, {
+		in: "select a, count(*) from t group by 1",
+	}, {
+		in: "select a + b as x, count(*) from t group by x",
+	}, {
+		in: "select a + b as x, count(*) from t group by 1 having x > 1",
+	}, {
+		in: "select a, count(*) c from t group by a having c > 1 and sum(b) > 2",
+	}, {
+		in: "select count(*), max(a) from t",
+	}, {
+		in: "select a, (select b from u) from t group by a",
+	}, {
+		in: "select a, b, count(*) from t group by a",
+		want: []string{
+			"select expression #2 (b) contains nonaggregated column b which is not in group by",
+		},
+	}, {
+		in: "select a, count(*) from t",
+		want: []string{
+			"select expression #1 (a) contains nonaggregated column a which is not in group by",
+		},
+	}, {
+		in: "select a + b from t group by a",
+		want: []string{
+			"select expression #1 (a + b) contains nonaggregated column b which is not in group by",
+		},
+	}, {
+		in: "select u.a from t group by t.a",
+		want: []string{
+			"select expression #1 (u.a) contains nonaggregated column u.a which is not in group by",
+		},
+	}, {
+		in: "select a, b from t group by 2",
+		want: []string{
+			"select expression #1 (a) contains nonaggregated column a which is not in group by",
+		},
+	}, {
+		in: "select a from t group by 3",
+		want: []string{
+			"unknown column 3 in group by",
+			"select expression #1 (a) contains nonaggregated column a which is not in group by",
+		},
+	}, {
+		in: "select a, count(*) from t group by 2",
+		want: []string{
+			"cannot group on aggregate count(*)",
+			"select expression #1 (a) contains nonaggregated column a which is not in group by",
+		},
+	}, {
+		in: "select max(sum(a)) from t",
+		want: []string{
+			"aggregate sum(a) is nested inside aggregate max(sum(a))",
+		},
+	}, {
+		in: "select a from t where count(*) > 1 group by a",
+		want: []string{
+			"aggregate count(*) is not allowed in where clause",
+		},
+	}, {
+		in: "select a from t where a in (select max(b) from u)",
+	}, {
+		in: "select a, count(*) from t group by a having b > 1",
+		want: []string{
+			"having clause references b which is neither grouped nor aggregated",
+		},
+	}, {
+		in: "select a, count(distinct b, c), avg(distinct d), count(t.*) from t group by a",
+	}, {
+		in: "select count(distinct a), sum(distinct b) from t having max(distinct c) > 1",
+	}, {
+		in: "select a, count(distinct b) from t",
+		want: []string{
+			"select expression #1 (a) contains nonaggregated column a which is not in group by",
+		},
+	}, {
+		in: "select abs(distinct a), sum(distinct a, b) from t where x in (select lower(distinct y) from u)",
+		want: []string{
+			"distinct is not allowed in abs(distinct a), which is not an aggregate",
+			"aggregate sum(distinct a, b) cannot take several distinct arguments",
+			"select expression #1 (abs(distinct a)) contains nonaggregated column a which is not in group by",
+		},
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		var got []string
+		for _, err := range CheckAggregation(tree.(*Select)) {
+			got = append(got, err.Error())
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("CheckAggregation(%s): %q, want %q", tcase.in, got, tcase.want)
+		}
+	}
+}
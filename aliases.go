@@ -0,0 +1,237 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ResolveAliases returns the select expressions of sel keyed by their
+// lowercased alias, which is how ORDER BY, GROUP BY and HAVING refer
+// to them.
+//
+// MySQL allows an alias to be defined more than once. Such an alias
+// is left out of the map and it's only an error if ORDER BY, GROUP BY
+// or HAVING refer to it, unless all its definitions are the same
+// expression.
+//
+// An alias can have the same name as a column of the FROM clause. MySQL
+// resolves ORDER BY and HAVING references to the alias, and GROUP BY
+// references to the column. The parser doesn't know the columns of the
+// tables, so for GROUP BY a name is only considered a column if it's
+// used as a column elsewhere in the select list or WHERE clause.
+// WHERE can never refer to aliases.
+func ResolveAliases(sel *Select) (map[string]Expr, error) {
+	aliases := make(map[string]Expr)
+	ambiguous := make(map[string]bool)
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok || aliased.As.IsEmpty() {
+			continue
+		}
+		name := aliased.As.Lowered()
+		if prev, ok := aliases[name]; ok && canonicalString(prev) != canonicalString(aliased.Expr) {
+			ambiguous[name] = true
+		}
+		aliases[name] = aliased.Expr
+	}
+	for name := range ambiguous {
+		delete(aliases, name)
+	}
+	if len(ambiguous) != 0 {
+		for _, col := range aliasReferences(sel, ambiguous) {
+			return nil, fmt.Errorf("alias %s is ambiguous", String(col))
+		}
+	}
+	return aliases, nil
+}
+
+// RewriteAliases replaces the references to select aliases in the
+// ORDER BY, GROUP BY and HAVING clauses of sel with the expression
+// they stand for, following the rules of ResolveAliases. This is
+// needed for engines that don't support alias references.
+// The replacements are copies of the select expressions, parenthesized
+// when they're part of a larger expression and not atomic, so that
+// x * 2 with x standing for a + 1 becomes (a + 1) * 2.
+func RewriteAliases(sel *Select) error {
+	aliases, err := ResolveAliases(sel)
+	if err != nil {
+		return err
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+	names := make(map[string]bool, len(aliases))
+	for name := range aliases {
+		names[name] = true
+	}
+	for _, col := range aliasReferences(sel, names) {
+		alias := aliases[col.Name.Lowered()]
+		replace := func(root *Expr) bool {
+			to := DeepCopy(alias).(Expr)
+			if *root != col && !isAtomicExpr(to) {
+				to = &ParenExpr{Expr: to}
+			}
+			return replaceExprs(col, to, root)
+		}
+		for _, order := range sel.OrderBy {
+			if replace(&order.Expr) {
+				break
+			}
+		}
+		for i := range sel.GroupBy {
+			if replace(&sel.GroupBy[i]) {
+				break
+			}
+		}
+		if sel.Having != nil {
+			replace(&sel.Having.Expr)
+		}
+	}
+	return nil
+}
+
+// isAtomicExpr returns true if expr needs no parentheses to be an
+// operand of any operator.
+func isAtomicExpr(expr Expr) bool {
+	switch expr := expr.(type) {
+	case *ColName, *SQLVal, *NullVal, BoolVal, ValTuple, ListArg,
+		*ParenExpr, *Subquery, *FuncExpr, *GroupConcatExpr,
+		*ValuesFuncExpr, *ConvertExpr, *ConvertUsingExpr, *SubstrExpr,
+		*ExtractExpr, *PositionExpr, *TrimExpr, *WeightStringExpr,
+		*MatchExpr, *CaseExpr, *NextValueExpr:
+		return true
+	case *UnaryExpr:
+		return isAtomicExpr(expr.Expr)
+	}
+	return false
+}
+
+// aliasReferences returns the columns of the ORDER BY, GROUP BY and
+// HAVING clauses of sel that refer to one of the aliases in names.
+func aliasReferences(sel *Select, names map[string]bool) []*ColName {
+	var refs []*ColName
+	find := func(columns map[string]bool) Visit {
+		return func(node SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case *Subquery:
+				return false, nil
+			case *ColName:
+				name := node.Name.Lowered()
+				if node.Qualifier.IsEmpty() && names[name] && !columns[name] {
+					refs = append(refs, node)
+				}
+			}
+			return true, nil
+		}
+	}
+
+	_ = Walk(find(nil), sel.OrderBy)
+	_ = Walk(find(selectColumnNames(sel)), sel.GroupBy)
+	if sel.Having != nil {
+		_ = Walk(find(nil), sel.Having.Expr)
+	}
+	return refs
+}
+
+// selectColumnNames returns the lowercased names of the columns
+// referenced by the select expressions and the WHERE clause of sel.
+func selectColumnNames(sel *Select) map[string]bool {
+	columns := make(map[string]bool)
+	visit := func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *ColName:
+			columns[node.Name.Lowered()] = true
+		}
+		return true, nil
+	}
+	_ = Walk(visit, sel.SelectExprs)
+	if sel.Where != nil {
+		_ = Walk(visit, sel.Where.Expr)
+	}
+	return columns
+}
+
+// ResolvePositionalRefs replaces the positions of the ORDER BY and
+// GROUP BY clauses of sel, like the 2 of ORDER BY 2, with the select
+// expression they refer to, counting from 1. It returns an error, and
+// leaves sel unchanged, if a position is out of range, refers to a *
+// expression, or a GROUP BY position to an aggregate.
+//
+// A position that refers to an integer literal is kept, since the
+// literal would be taken for another position: ordering by either is
+// ordering by a constant. Like with RewriteAliases, the replacements
+// are copies of the select expressions.
+func ResolvePositionalRefs(sel *Select) error {
+	resolve := func(expr Expr, clause string) (Expr, error) {
+		pos, ok := positionalRef(expr)
+		if !ok {
+			return expr, nil
+		}
+		if pos < 1 || pos > len(sel.SelectExprs) {
+			return nil, fmt.Errorf("unknown column %s in %s", String(expr), clause)
+		}
+		aliased, ok := sel.SelectExprs[pos-1].(*AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("cannot resolve %s %s to %s", clause, String(expr), String(sel.SelectExprs[pos-1]))
+		}
+		if _, ok := positionalRef(aliased.Expr); ok {
+			return expr, nil
+		}
+		return DeepCopy(aliased.Expr).(Expr), nil
+	}
+
+	groupBy := make(GroupBy, len(sel.GroupBy))
+	for i, expr := range sel.GroupBy {
+		resolved, err := resolve(expr, "group by")
+		if err != nil {
+			return err
+		}
+		if resolved != expr {
+			err = Walk(func(node SQLNode) (bool, error) {
+				if isAggregate(node) {
+					return false, fmt.Errorf("cannot group on aggregate %s", String(node))
+				}
+				return true, nil
+			}, resolved)
+			if err != nil {
+				return err
+			}
+		}
+		groupBy[i] = resolved
+	}
+	orderBy := make([]Expr, len(sel.OrderBy))
+	for i, order := range sel.OrderBy {
+		resolved, err := resolve(order.Expr, "order by")
+		if err != nil {
+			return err
+		}
+		orderBy[i] = resolved
+	}
+
+	copy(sel.GroupBy, groupBy)
+	for i, order := range sel.OrderBy {
+		order.Expr = orderBy[i]
+	}
+	return nil
+}
+
+// positionalRef returns the position expr stands for if it's a
+// reference to a select expression by position, like the 2 of
+// ORDER BY 2. Only integer literals are positions: ORDER BY -1 or
+// ORDER BY '2' order by a constant. The position is 0 if it's too
+// large for an int.
+func positionalRef(expr Expr) (int, bool) {
+	val, ok := expr.(*SQLVal)
+	if !ok || val.Type != IntVal || strings.HasPrefix(string(val.Val), "-") {
+		// The parser folds the sign of -1 into the literal.
+		return 0, false
+	}
+	pos, err := strconv.Atoi(string(val.Val))
+	if err != nil {
+		return 0, true
+	}
+	return pos, true
+}
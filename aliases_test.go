@@ -0,0 +1,202 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveAliases(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want map[string]string
+		err  string
+	}{{
+		in:   "select a, b from t",
+		want: map[string]string{},
+	}, {
+		in: "select a as x, b + 1 as Y, count(*) c from t",
+		want: map[string]string{
+			"x": "a",
+			"y": "b + 1",
+			"c": "count(*)",
+		},
+	}, {
+		in:   "select a as x, b as x from t",
+		want: map[string]string{},
+	}, {
+		in:   "select a as x, a as x from t order by x",
+		want: map[string]string{"x": "a"},
+	}, {
+		in:  "select a as x, b as x from t order by x",
+		err: "alias x is ambiguous",
+	}, {
+		in:   "select a as x, b as x, x from t group by x",
+		want: map[string]string{},
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		aliases, err := ResolveAliases(tree.(*Select))
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ResolveAliases(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ResolveAliases(%s) err: %v", tcase.in, err)
+			continue
+		}
+		got := make(map[string]string)
+		for name, expr := range aliases {
+			got[name] = String(expr)
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("ResolveAliases(%s): %v, want %v", tcase.in, got, tcase.want)
+		}
+	}
+}
+
+func TestRewriteAliases(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select a + 1 as x from t order by x desc",
+		out: "select a + 1 as x from t order by a + 1 desc",
+	}, {
+		in:  "select a + 1 as x from t order by X + 2 asc",
+		out: "select a + 1 as x from t order by (a + 1) + 2 asc",
+	}, {
+		in:  "select a + 1 as x from t order by x * 2 asc",
+		out: "select a + 1 as x from t order by (a + 1) * 2 asc",
+	}, {
+		in:  "select a or b as x from t group by a, b having x and c",
+		out: "select a or b as x from t group by a, b having (a or b) and c",
+	}, {
+		in:  "select -a as x, f(b) as y from t order by x * y asc",
+		out: "select -a as x, f(b) as y from t order by -a * f(b) asc",
+	}, {
+		in:  "select b as x, count(*) as c from t group by x having c > 1 and x != 0",
+		out: "select b as x, count(*) as c from t group by b having count(*) > 1 and b != 0",
+	}, {
+		in:  "select a + 1 as a from t group by a order by a asc",
+		out: "select a + 1 as a from t group by a order by a + 1 asc",
+	}, {
+		in:  "select a as x from t where x = 1 order by t.x asc",
+		out: "select a as x from t where x = 1 order by t.x asc",
+	}, {
+		in:  "select a as x from t having x in (select x from u)",
+		out: "select a as x from t having a in (select x from u)",
+	}, {
+		in:  "select a, b from t group by 1 order by 2 asc",
+		out: "select a, b from t group by 1 order by 2 asc",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if err := RewriteAliases(tree.(*Select)); err != nil {
+			t.Errorf("RewriteAliases(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("RewriteAliases(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+
+	tree, err := Parse("select a + 1 as x from t order by x asc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := tree.(*Select)
+	if err := RewriteAliases(sel); err != nil {
+		t.Fatal(err)
+	}
+	if sel.OrderBy[0].Expr == sel.SelectExprs[0].(*AliasedExpr).Expr {
+		t.Errorf("RewriteAliases shares the select expression with order by")
+	}
+
+	tree, err = Parse("select a as x, b as x from t having x > 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := RewriteAliases(tree.(*Select)); err == nil || err.Error() != "alias x is ambiguous" {
+		t.Errorf("RewriteAliases err: %v, want alias x is ambiguous", err)
+	}
+}
+
+func TestResolvePositionalRefs(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select a, b + 1 as c from t group by 1, 2 order by 2 desc, a asc",
+		out: "select a, b + 1 as c from t group by a, b + 1 order by b + 1 desc, a asc",
+	}, {
+		in:  "select a, count(*) from t group by 1 order by 2 asc, -1 asc, '1' asc",
+		out: "select a, count(*) from t group by a order by count(*) asc, -1 asc, '1' asc",
+	}, {
+		// the literal 2 would be taken for a position
+		in:  "select 2, a from t order by 1 asc",
+		out: "select 2, a from t order by 1 asc",
+	}, {
+		in:  "select a from t order by 0 asc",
+		err: "unknown column 0 in order by",
+	}, {
+		in:  "select a, b from t group by 3",
+		err: "unknown column 3 in group by",
+	}, {
+		in:  "select a from t group by 1 order by 99999999999999999999 asc",
+		err: "unknown column 99999999999999999999 in order by",
+	}, {
+		in:  "select * from t order by 1 asc",
+		err: "cannot resolve order by 1 to *",
+	}, {
+		in:  "select a, max(b) from t group by 1, 2",
+		err: "cannot group on aggregate max(b)",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		err = ResolvePositionalRefs(tree.(*Select))
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ResolvePositionalRefs(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			if got := String(tree); got != tcase.in {
+				t.Errorf("ResolvePositionalRefs(%s) changed the select: %s", tcase.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ResolvePositionalRefs(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("ResolvePositionalRefs(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+
+	tree, err := Parse("select a + 1 from t group by 1 order by 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := tree.(*Select)
+	if err := ResolvePositionalRefs(sel); err != nil {
+		t.Fatal(err)
+	}
+	expr := sel.SelectExprs[0].(*AliasedExpr).Expr
+	if sel.GroupBy[0] == expr || sel.OrderBy[0].Expr == expr {
+		t.Errorf("ResolvePositionalRefs shares the select expression with group by or order by")
+	}
+}
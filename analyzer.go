@@ -19,7 +19,6 @@ package sqlparser
 // analyzer.go contains utility analysis functions.
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -46,6 +45,18 @@ const (
 	StmtOther
 	StmtUnknown
 	StmtComment
+	StmtFlush
+	StmtKill
+	StmtXA
+	StmtLockTables
+	StmtUnlockTables
+	StmtCall
+	StmtNextval
+	StmtPrepare
+	StmtExecute
+	StmtDeallocate
+	StmtMaintenance
+	StmtDescribe
 )
 
 // Preview analyzes the beginning of the query using a simpler and faster
@@ -97,8 +108,30 @@ func Preview(sql string) int {
 		return StmtShow
 	case "use":
 		return StmtUse
-	case "analyze", "describe", "desc", "explain", "repair", "optimize":
+	case "describe", "desc", "explain":
+		return previewExplain(trimmed)
+	case "do", "handler":
 		return StmtOther
+	case "analyze", "check", "checksum", "optimize", "repair", "cache":
+		return StmtMaintenance
+	case "flush":
+		return StmtFlush
+	case "kill":
+		return StmtKill
+	case "xa":
+		return StmtXA
+	case "lock":
+		return StmtLockTables
+	case "unlock":
+		return StmtUnlockTables
+	case "call":
+		return StmtCall
+	case "prepare":
+		return StmtPrepare
+	case "execute":
+		return StmtExecute
+	case "deallocate":
+		return StmtDeallocate
 	}
 	if strings.Index(trimmed, "/*!") == 0 {
 		return StmtComment
@@ -106,6 +139,47 @@ func Preview(sql string) int {
 	return StmtUnknown
 }
 
+// explainStatements are the words that an EXPLAIN of a statement,
+// rather than of a table, starts with.
+var explainStatements = map[string]bool{
+	"select":     true,
+	"insert":     true,
+	"replace":    true,
+	"update":     true,
+	"delete":     true,
+	"table":      true,
+	"with":       true,
+	"analyze":    true,
+	"extended":   true,
+	"partitions": true,
+	"for":        true,
+}
+
+// previewExplain returns the type of the DESCRIBE, DESC or EXPLAIN
+// statement sql from what follows the verb: StmtDescribe if it's
+// a table, or else StmtOther.
+func previewExplain(sql string) int {
+	rest := ""
+	if end := strings.IndexFunc(sql, unicode.IsSpace); end != -1 {
+		rest = strings.TrimSpace(sql[end:])
+	}
+	if rest == "" || rest[0] == '(' {
+		return StmtOther
+	}
+	word := rest
+	if end := strings.IndexFunc(rest, func(r rune) bool { return unicode.IsSpace(r) || r == '=' }); end != -1 {
+		word = rest[:end]
+		// EXPLAIN FORMAT = JSON
+		if strings.HasPrefix(strings.TrimLeftFunc(rest[end:], unicode.IsSpace), "=") {
+			return StmtOther
+		}
+	}
+	if explainStatements[strings.ToLower(word)] {
+		return StmtOther
+	}
+	return StmtDescribe
+}
+
 // StmtType returns the statement type as a string
 func StmtType(stmtType int) string {
 	switch stmtType {
@@ -137,11 +211,177 @@ func StmtType(stmtType int) string {
 		return "USE"
 	case StmtOther:
 		return "OTHER"
+	case StmtFlush:
+		return "FLUSH"
+	case StmtKill:
+		return "KILL"
+	case StmtXA:
+		return "XA"
+	case StmtLockTables:
+		return "LOCK_TABLES"
+	case StmtUnlockTables:
+		return "UNLOCK_TABLES"
+	case StmtCall:
+		return "CALL"
+	case StmtNextval:
+		return "NEXTVAL"
+	case StmtPrepare:
+		return "PREPARE"
+	case StmtExecute:
+		return "EXECUTE"
+	case StmtDeallocate:
+		return "DEALLOCATE"
+	case StmtMaintenance:
+		return "MAINTENANCE"
+	case StmtDescribe:
+		return "DESCRIBE"
 	default:
 		return "UNKNOWN"
 	}
 }
 
+// StatementType returns the type of a parsed statement. Unlike
+// Preview, it tells a select that fetches values of a sequence,
+// see GetSequenceAccess, from other selects: its type is
+// StmtNextval.
+func StatementType(stmt Statement) int {
+	switch stmt := stmt.(type) {
+	case *Select:
+		if _, ok := GetSequenceAccess(stmt); ok {
+			return StmtNextval
+		}
+		return StmtSelect
+	case *Union, *ParenSelect:
+		return StmtSelect
+	case *Stream:
+		return StmtStream
+	case *Insert:
+		if stmt.Action == ReplaceStr {
+			return StmtReplace
+		}
+		return StmtInsert
+	case *Update:
+		return StmtUpdate
+	case *Delete:
+		return StmtDelete
+	case *DDL, *DBDDL, *CreateTrigger, *DropTrigger, *CreateEvent, *DropEvent,
+		*CreateProcedure, *CreateFunction, *DropRoutine:
+		return StmtDDL
+	case *Begin:
+		return StmtBegin
+	case *Commit:
+		return StmtCommit
+	case *Rollback:
+		return StmtRollback
+	case *Set:
+		return StmtSet
+	case *Show:
+		return StmtShow
+	case *DescribeTable:
+		return StmtDescribe
+	case *Use:
+		return StmtUse
+	case *OtherRead, *OtherAdmin, *Replication, *Do, *Handler:
+		return StmtOther
+	case *Flush:
+		return StmtFlush
+	case *Kill:
+		return StmtKill
+	case *XATransaction:
+		return StmtXA
+	case *LockTables:
+		return StmtLockTables
+	case *UnlockTables:
+		return StmtUnlockTables
+	case *Call:
+		return StmtCall
+	case *Prepare:
+		return StmtPrepare
+	case *Execute:
+		return StmtExecute
+	case *Deallocate:
+		return StmtDeallocate
+	case *TableMaintenance, *IndexCache:
+		return StmtMaintenance
+	}
+	return StmtUnknown
+}
+
+// IsReadOnly returns true if stmt only reads data: a select, except
+// one that fetches values of a sequence or that has an INTO clause, or
+// a SHOW, DESCRIBE or EXPLAIN statement. An INSERT, UPDATE or DELETE
+// with a RETURNING clause is not read only, though it produces rows,
+// see ReturnsRows.
+func IsReadOnly(stmt Statement) bool {
+	switch stmt := stmt.(type) {
+	case *Select:
+		_, ok := GetSequenceAccess(stmt)
+		return !ok && !hasInto(stmt, false)
+	case *Union, *ParenSelect:
+		return !hasInto(stmt, false)
+	case *Stream, *Show, *DescribeTable, *OtherRead:
+		return true
+	}
+	return false
+}
+
+// HasIntoOutfile returns true if a select of stmt, including its
+// subqueries, writes a file on the server with INTO OUTFILE or
+// INTO DUMPFILE.
+func HasIntoOutfile(stmt Statement) bool {
+	return hasInto(stmt, true)
+}
+
+// hasInto returns true if a select of node has an INTO clause,
+// only the ones that write a file if files is set.
+func hasInto(node SQLNode, files bool) bool {
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if into, ok := node.(*SelectInto); ok && into != nil && (!files || into.Type != IntoVarsStr) {
+			found = true
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+// ReturnsRows returns true if stmt produces a result set: the read
+// only statements, see IsReadOnly, the selects that fetch values of a
+// sequence, the table maintenance and index cache statements, which
+// report on every table, and the INSERT, UPDATE and DELETE statements that have a
+// RETURNING clause. A select with an INTO clause doesn't return its
+// rows.
+func ReturnsRows(stmt Statement) bool {
+	switch stmt := stmt.(type) {
+	case *Select:
+		return stmt.Into == nil
+	case *Union, *ParenSelect, *Stream, *Show, *DescribeTable, *OtherRead, *TableMaintenance, *IndexCache:
+		return true
+	case *Insert:
+		return stmt.Returning != nil
+	case *Update:
+		return stmt.Returning != nil
+	case *Delete:
+		return stmt.Returning != nil
+	}
+	return false
+}
+
+// IgnoresErrors returns true if stmt is an INSERT, UPDATE or DELETE
+// with the IGNORE modifier, which turns errors like duplicate keys into
+// warnings and skips the rows that cause them.
+func IgnoresErrors(stmt Statement) bool {
+	switch stmt := stmt.(type) {
+	case *Insert:
+		return stmt.Ignore != ""
+	case *Update:
+		return stmt.Ignore != ""
+	case *Delete:
+		return stmt.Ignore != ""
+	}
+	return false
+}
+
 // IsDML returns true if the query is an INSERT, UPDATE or DELETE statement.
 func IsDML(sql string) bool {
 	switch Preview(sql) {
@@ -167,6 +407,22 @@ func IsColName(node Expr) bool {
 	return ok
 }
 
+// IsInsertValueRef returns true if col, a column of the ON DUPLICATE
+// KEY UPDATE clause of ins, refers to the value inserted in a column
+// rather than to the column of the table: it's qualified with the row
+// alias of ins, like new.c in INSERT ... VALUES (...) AS new ON
+// DUPLICATE KEY UPDATE c = new.c, or it's one of the column aliases of
+// the row alias, like m in AS new(m, n).
+func IsInsertValueRef(col *ColName, ins *Insert) bool {
+	if col == nil || ins == nil || ins.RowAlias == nil {
+		return false
+	}
+	if !col.Qualifier.IsEmpty() {
+		return col.Qualifier.Qualifier.IsEmpty() && col.Qualifier.Name == ins.RowAlias.Name
+	}
+	return ins.RowAlias.Columns.FindColumn(col.Name) >= 0
+}
+
 // IsValue returns true if the Expr is a string, integral or value arg.
 // NULL is not considered to be a value.
 func IsValue(node Expr) bool {
@@ -207,7 +463,66 @@ func IsSimpleTuple(node Expr) bool {
 	return false
 }
 
+// IsExists returns the subquery of expr if expr is an EXISTS,
+// parenthesized or not.
+func IsExists(expr Expr) (*Subquery, bool) {
+	if exists, ok := unparen(expr).(*ExistsExpr); ok {
+		return exists.Subquery, true
+	}
+	return nil, false
+}
+
+// IsNotExists returns the subquery of expr if expr is a NOT EXISTS,
+// parenthesized or not. See ExistsExpr for its form.
+func IsNotExists(expr Expr) (*Subquery, bool) {
+	if not, ok := unparen(expr).(*NotExpr); ok {
+		return IsExists(not.Expr)
+	}
+	return nil, false
+}
+
+// IsColEqualLiteral returns the column and the literal of expr if expr
+// compares a column with a literal with =, in either order, like
+// a = 1 or 'x' = t.b. The literals are the *SQLVal, which include the
+// bind variables.
+func IsColEqualLiteral(expr Expr) (*ColName, *SQLVal, bool) {
+	cmp, ok := unparen(expr).(*ComparisonExpr)
+	if !ok || cmp.Operator != EqualStr {
+		return nil, nil, false
+	}
+	col, colOK := cmp.Left.(*ColName)
+	val, valOK := cmp.Right.(*SQLVal)
+	if !colOK || !valOK {
+		col, colOK = cmp.Right.(*ColName)
+		val, valOK = cmp.Left.(*SQLVal)
+	}
+	if !colOK || !valOK {
+		return nil, nil, false
+	}
+	return col, val, true
+}
+
+// IsColInTuple returns the column and the tuple of expr if expr is a
+// column IN a tuple of simple values, see IsSimpleTuple, like
+// a IN (1, 2). A list bind variable is not a tuple.
+func IsColInTuple(expr Expr) (*ColName, ValTuple, bool) {
+	cmp, ok := unparen(expr).(*ComparisonExpr)
+	if !ok || cmp.Operator != InStr {
+		return nil, nil, false
+	}
+	col, ok := cmp.Left.(*ColName)
+	if !ok {
+		return nil, nil, false
+	}
+	tuple, ok := cmp.Right.(ValTuple)
+	if !ok || !IsSimpleTuple(tuple) {
+		return nil, nil, false
+	}
+	return col, tuple, true
+}
+
 // NewPlanValue builds a sqltypes.PlanValue from an Expr.
+// The error, if any, wraps ErrUnsupported.
 func NewPlanValue(node Expr) (sqltypes.PlanValue, error) {
 	switch node := node.(type) {
 	case *SQLVal:
@@ -217,7 +532,7 @@ func NewPlanValue(node Expr) (sqltypes.PlanValue, error) {
 		case IntVal:
 			n, err := sqltypes.NewIntegral(string(node.Val))
 			if err != nil {
-				return sqltypes.PlanValue{}, fmt.Errorf("%v", err)
+				return sqltypes.PlanValue{}, newError(ErrUnsupported, "%v", err)
 			}
 			return sqltypes.PlanValue{Value: n}, nil
 		case StrVal:
@@ -225,7 +540,7 @@ func NewPlanValue(node Expr) (sqltypes.PlanValue, error) {
 		case HexVal:
 			v, err := node.HexDecode()
 			if err != nil {
-				return sqltypes.PlanValue{}, fmt.Errorf("%v", err)
+				return sqltypes.PlanValue{}, newError(ErrUnsupported, "%v", err)
 			}
 			return sqltypes.PlanValue{Value: sqltypes.MakeTrusted(sqltypes.VarBinary, v)}, nil
 		}
@@ -241,7 +556,7 @@ func NewPlanValue(node Expr) (sqltypes.PlanValue, error) {
 				return sqltypes.PlanValue{}, err
 			}
 			if innerpv.ListKey != "" || innerpv.Values != nil {
-				return sqltypes.PlanValue{}, errors.New("unsupported: nested lists")
+				return sqltypes.PlanValue{}, newError(ErrUnsupported, "unsupported: nested lists")
 			}
 			pv.Values = append(pv.Values, innerpv)
 		}
@@ -249,7 +564,7 @@ func NewPlanValue(node Expr) (sqltypes.PlanValue, error) {
 	case *NullVal:
 		return sqltypes.PlanValue{}, nil
 	}
-	return sqltypes.PlanValue{}, fmt.Errorf("expression is too complex '%v'", String(node))
+	return sqltypes.PlanValue{}, newError(ErrUnsupported, "expression is too complex '%v'", String(node))
 }
 
 // StringIn is a convenience function that returns
@@ -272,7 +587,7 @@ type SetKey struct {
 // ExtractSetValues returns a map of key-value pairs
 // if the query is a SET statement. Values can be bool, int64 or string.
 // Since set variable names are case insensitive, all keys are returned
-// as lower case.
+// as lower case. Errors other than parse errors wrap ErrUnsupported.
 func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope string, err error) {
 	stmt, err := Parse(sql)
 	if err != nil {
@@ -280,7 +595,7 @@ func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope strin
 	}
 	setStmt, ok := stmt.(*Set)
 	if !ok {
-		return nil, "", fmt.Errorf("ast did not yield *sqlparser.Set: %T", stmt)
+		return nil, "", newError(ErrUnsupported, "ast did not yield *sqlparser.Set: %T", stmt)
 	}
 	result := make(map[SetKey]interface{})
 	for _, expr := range setStmt.Exprs {
@@ -298,7 +613,7 @@ func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope strin
 
 		if strings.HasPrefix(expr.Name.Lowered(), "@@") {
 			if setStmt.Scope != "" && scope != "" {
-				return nil, "", fmt.Errorf("unsupported in set: mixed using of variable scope")
+				return nil, "", newError(ErrUnsupported, "unsupported in set: mixed using of variable scope")
 			}
 			_, out := NewStringTokenizer(key).Scan()
 			key = string(out)
@@ -321,7 +636,7 @@ func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope strin
 				}
 				result[setKey] = num
 			default:
-				return nil, "", fmt.Errorf("invalid value type: %v", String(expr))
+				return nil, "", newError(ErrUnsupported, "invalid value type: %v", String(expr))
 			}
 		case BoolVal:
 			var val int64
@@ -336,8 +651,205 @@ func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope strin
 		case *Default:
 			result[setKey] = "default"
 		default:
-			return nil, "", fmt.Errorf("invalid syntax: %s", String(expr))
+			return nil, "", newError(ErrUnsupported, "invalid syntax: %s", String(expr))
 		}
 	}
 	return result, strings.ToLower(setStmt.Scope), nil
 }
+
+// LikePatternType describes the shape of a LIKE pattern
+// as far as index usability is concerned.
+type LikePatternType int
+
+// These are the possible LikePatternType values.
+const (
+	// LikeUnknown is returned when the pattern is not a
+	// literal, for example a bind variable or a column.
+	LikeUnknown = LikePatternType(iota)
+	// LikeNoWildcard is a pattern without any wildcards.
+	// It behaves like an equality comparison.
+	LikeNoWildcard
+	// LikeConstPrefix is a pattern that starts with a
+	// constant prefix followed by a wildcard.
+	LikeConstPrefix
+	// LikeLeadingWildcard is a pattern that starts with
+	// a wildcard and cannot use an index.
+	LikeLeadingWildcard
+)
+
+// LikeInfo is the result of AnalyzeLikePattern.
+// Prefix contains the unescaped literal text before
+// the first wildcard.
+type LikeInfo struct {
+	Type   LikePatternType
+	Prefix string
+}
+
+// Indexable returns true if an index range scan can
+// be used to evaluate the pattern.
+func (li LikeInfo) Indexable() bool {
+	return li.Type == LikeNoWildcard || li.Type == LikeConstPrefix
+}
+
+// AnalyzeLikePattern inspects the pattern of a LIKE or NOT LIKE
+// comparison. It understands the % and _ wildcards and the escape
+// character, which is a backslash unless an ESCAPE clause says
+// otherwise. If the pattern or the escape character are not
+// string literals, the result type is LikeUnknown.
+func AnalyzeLikePattern(cmp *ComparisonExpr) LikeInfo {
+	if cmp.Operator != LikeStr && cmp.Operator != NotLikeStr {
+		return LikeInfo{Type: LikeUnknown}
+	}
+	pattern, ok := cmp.Right.(*SQLVal)
+	if !ok || pattern.Type != StrVal {
+		return LikeInfo{Type: LikeUnknown}
+	}
+	escape, hasEscape := byte('\\'), true
+	if cmp.Escape != nil {
+		esc, ok := cmp.Escape.(*SQLVal)
+		if !ok || esc.Type != StrVal || len(esc.Val) > 1 {
+			return LikeInfo{Type: LikeUnknown}
+		}
+		if len(esc.Val) == 0 {
+			hasEscape = false
+		} else {
+			escape = esc.Val[0]
+		}
+	}
+
+	prefix := make([]byte, 0, len(pattern.Val))
+	for i := 0; i < len(pattern.Val); i++ {
+		ch := pattern.Val[i]
+		switch {
+		case hasEscape && ch == escape && i+1 < len(pattern.Val):
+			i++
+			prefix = append(prefix, pattern.Val[i])
+		case ch == '%' || ch == '_':
+			if i == 0 {
+				return LikeInfo{Type: LikeLeadingWildcard}
+			}
+			return LikeInfo{Type: LikeConstPrefix, Prefix: string(prefix)}
+		default:
+			prefix = append(prefix, ch)
+		}
+	}
+	return LikeInfo{Type: LikeNoWildcard, Prefix: string(prefix)}
+}
+
+// ResolveWindows inlines the named windows of the selects of node:
+// every OVER clause that refers to a window of the WINDOW clause of
+// its select gets the specification of the window instead, and the
+// WINDOW clauses are removed. Like MySQL, it returns an error if a
+// window isn't defined, is defined twice, or is refined with a
+// PARTITION BY, with an ORDER BY when it already has one, or at all
+// when it has a frame.
+func ResolveWindows(node SQLNode) error {
+	var visit Visit
+	visit = func(node SQLNode) (bool, error) {
+		sel, ok := node.(*Select)
+		if !ok {
+			return true, nil
+		}
+		resolver := &windowResolver{
+			defs:      make(map[string]*WindowSpec),
+			resolved:  make(map[string]*WindowSpec),
+			resolving: make(map[string]bool),
+		}
+		for _, window := range sel.Windows {
+			name := window.Name.Lowered()
+			if _, ok := resolver.defs[name]; ok {
+				return false, fmt.Errorf("window %s is defined twice", window.Name.String())
+			}
+			resolver.defs[name] = window.Spec
+		}
+		for _, window := range sel.Windows {
+			if _, err := resolver.named(window.Name); err != nil {
+				return false, err
+			}
+		}
+		err := Walk(func(node SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case *Select:
+				// The names of a subquery are its own.
+				return false, Walk(visit, node)
+			case *FuncExpr:
+				if node.Over == nil {
+					return true, nil
+				}
+				var spec *WindowSpec
+				var err error
+				if node.Over.Spec == nil {
+					spec, err = resolver.named(node.Over.WindowName)
+					if err == nil {
+						spec = DeepCopy(spec).(*WindowSpec)
+					}
+				} else {
+					spec, err = resolver.inline(node.Over.Spec)
+				}
+				if err != nil {
+					return false, err
+				}
+				node.Over = &Over{Spec: spec}
+			}
+			return true, nil
+		}, sel.SelectExprs, sel.From, sel.Where, sel.GroupBy, sel.Having, sel.OrderBy)
+		sel.Windows = nil
+		return false, err
+	}
+	return Walk(visit, node)
+}
+
+// windowResolver resolves the names of the windows of a select.
+type windowResolver struct {
+	defs      map[string]*WindowSpec
+	resolved  map[string]*WindowSpec
+	resolving map[string]bool
+}
+
+// inline returns spec with the window it refines inlined.
+func (r *windowResolver) inline(spec *WindowSpec) (*WindowSpec, error) {
+	if spec.Name.IsEmpty() {
+		return spec, nil
+	}
+	base, err := r.named(spec.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.PartitionBy) > 0 {
+		return nil, fmt.Errorf("cannot override the partition by of window %s", spec.Name.String())
+	}
+	if len(spec.OrderBy) > 0 && len(base.OrderBy) > 0 {
+		return nil, fmt.Errorf("cannot override the order by of window %s", spec.Name.String())
+	}
+	if base.Frame != nil {
+		return nil, fmt.Errorf("cannot refine window %s, which has a frame", spec.Name.String())
+	}
+	inlined := DeepCopy(base).(*WindowSpec)
+	if len(spec.OrderBy) > 0 {
+		inlined.OrderBy = spec.OrderBy
+	}
+	inlined.Frame = spec.Frame
+	return inlined, nil
+}
+
+// named returns the specification of the window name, inlined.
+func (r *windowResolver) named(name ColIdent) (*WindowSpec, error) {
+	key := name.Lowered()
+	if spec, ok := r.resolved[key]; ok {
+		return spec, nil
+	}
+	spec, ok := r.defs[key]
+	if !ok {
+		return nil, fmt.Errorf("window %s is not defined", name.String())
+	}
+	if r.resolving[key] {
+		return nil, fmt.Errorf("window %s refers to itself", name.String())
+	}
+	r.resolving[key] = true
+	spec, err := r.inline(spec)
+	if err != nil {
+		return nil, err
+	}
+	r.resolved[key] = spec
+	return spec, nil
+}
@@ -60,12 +60,36 @@ func TestPreview(t *testing.T) {
 		{"set", StmtSet},
 		{"show", StmtShow},
 		{"use", StmtUse},
-		{"analyze", StmtOther},
+		{"analyze table t", StmtMaintenance},
+		{"check table t", StmtMaintenance},
 		{"describe", StmtOther},
 		{"desc", StmtOther},
 		{"explain", StmtOther},
-		{"repair", StmtOther},
-		{"optimize", StmtOther},
+		{"describe t", StmtDescribe},
+		{"desc db.t col", StmtDescribe},
+		{"explain t 'a%'", StmtDescribe},
+		{"explain select 1", StmtOther},
+		{"EXPLAIN  Analyze select 1", StmtOther},
+		{"explain format=json select 1", StmtOther},
+		{"explain format = json select 1", StmtOther},
+		{"explain (select 1)", StmtOther},
+		{"describe for connection 1", StmtOther},
+		{"repair", StmtMaintenance},
+		{"optimize", StmtMaintenance},
+		{"checksum table t", StmtMaintenance},
+		{"cache index t in c", StmtMaintenance},
+		{"do 1", StmtOther},
+		{"handler t open", StmtOther},
+		{"flush tables", StmtFlush},
+		{"kill 42", StmtKill},
+		{"xa start 'xid'", StmtXA},
+		{"lock tables t read", StmtLockTables},
+		{"unlock tables", StmtUnlockTables},
+		{"call p(1)", StmtCall},
+		{"{call p(1)}", StmtCall},
+		{"prepare s from 'select 1'", StmtPrepare},
+		{"EXECUTE s using @a", StmtExecute},
+		{"deallocate prepare s", StmtDeallocate},
 		{"truncate", StmtDDL},
 		{"unknown", StmtUnknown},
 
@@ -110,6 +134,117 @@ func TestIsDML(t *testing.T) {
 	}
 }
 
+func TestStatementType(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want int
+	}{
+		{"select * from t", StmtSelect},
+		{"select 1 union select 2", StmtSelect},
+		{"((select 1 from t) union select 2)", StmtSelect},
+		{"select next 5 values from seq", StmtNextval},
+		{"select next value for seq", StmtNextval},
+		{"insert into t values (1)", StmtInsert},
+		{"replace into t values (1)", StmtReplace},
+		{"update t set a = 1", StmtUpdate},
+		{"delete from t", StmtDelete},
+		{"create table t (a int)", StmtDDL},
+		{"create database d", StmtDDL},
+		{"begin", StmtBegin},
+		{"set autocommit = 1", StmtSet},
+		{"show tables", StmtShow},
+		{"explain select 1", StmtOther},
+		{"explain with c as (select 1) select * from c", StmtOther},
+		{"describe t", StmtDescribe},
+		{"explain t col", StmtDescribe},
+		{"change replication source to SOURCE_HOST = 'db1'", StmtOther},
+		{"purge binary logs to 'bin.000010'", StmtOther},
+		{"lock tables t read", StmtLockTables},
+		{"prepare s from @sql", StmtPrepare},
+		{"execute s", StmtExecute},
+		{"drop prepare s", StmtDeallocate},
+		{"optimize local table a, b", StmtMaintenance},
+		{"checksum table a quick", StmtMaintenance},
+		{"cache index t1 index (i1) in c", StmtMaintenance},
+		{"load index into cache t1 ignore leaves", StmtMaintenance},
+	}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.sql)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got := StatementType(stmt); got != tcase.want {
+			t.Errorf("StatementType(%s): %s, want %s", tcase.sql, StmtType(got), StmtType(tcase.want))
+		}
+	}
+}
+
+func TestIsReadOnly(t *testing.T) {
+	testcases := []struct {
+		sql      string
+		readOnly bool
+		rows     bool
+	}{
+		{"select * from t", true, true},
+		{"select 1 union select 2", true, true},
+		{"select next value for seq", false, true},
+		{"select a into @x from t", false, false},
+		{"select a from t into outfile '/tmp/x'", false, false},
+		{"select 1 union (select a from t into dumpfile '/tmp/x')", false, true},
+		{"show tables", true, true},
+		{"explain select 1", true, true},
+		{"insert into t values (1)", false, false},
+		{"insert into t values (1) returning id", false, true},
+		{"update t set a = 1 returning *", false, true},
+		{"delete from t returning id", false, true},
+		{"delete from t", false, false},
+		{"create table t (a int)", false, false},
+		{"set autocommit = 1", false, false},
+		{"repair table t quick", false, true},
+		{"checksum table t", false, true},
+		{"load index into cache t", false, true},
+	}
+	for _, tcase := range testcases {
+		stmt, err := ParseWithDialect(tcase.sql, MariaDBDialect)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got := IsReadOnly(stmt); got != tcase.readOnly {
+			t.Errorf("IsReadOnly(%s): %v, want %v", tcase.sql, got, tcase.readOnly)
+		}
+		if got := ReturnsRows(stmt); got != tcase.rows {
+			t.Errorf("ReturnsRows(%s): %v, want %v", tcase.sql, got, tcase.rows)
+		}
+	}
+}
+
+func TestHasIntoOutfile(t *testing.T) {
+	testcases := []struct {
+		sql string
+		out bool
+	}{
+		{"select * from t", false},
+		{"select * from t into outfile '/tmp/x'", true},
+		{"select a from t limit 1 into dumpfile '/tmp/x'", true},
+		{"select a into @x from t", false},
+		{"insert into t select * from (select a from u into outfile '/tmp/x') as d", true},
+		{"select 1 from t where a in (select b from u into outfile '/tmp/x')", true},
+		{"update t set a = 1", false},
+	}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.sql)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got := HasIntoOutfile(stmt); got != tcase.out {
+			t.Errorf("HasIntoOutfile(%s): %v, want %v", tcase.sql, got, tcase.out)
+		}
+	}
+}
+
 func TestGetTableName(t *testing.T) {
 	testcases := []struct {
 		in, out string
@@ -207,6 +342,140 @@ func TestIsNull(t *testing.T) {
 	}
 }
 
+func TestIsInsertValueRef(t *testing.T) {
+	stmt, err := Parse("insert into t(a, b) values (1, 2) as new(m, n) on duplicate key update a = new.m + n + t.b + b + values(a) + db.new.a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ins := stmt.(*Insert)
+	var refs []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if col, ok := node.(*ColName); ok && IsInsertValueRef(col, ins) {
+			refs = append(refs, String(col))
+		}
+		return true, nil
+	}, ins.OnDup)
+	if want := []string{"new.m", "n"}; !reflect.DeepEqual(refs, want) {
+		t.Errorf("IsInsertValueRef: %v, want %v", refs, want)
+	}
+	stmt, err = Parse("insert into t(a, b) values (1, 2) on duplicate key update a = new.a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ins = stmt.(*Insert)
+	if IsInsertValueRef(ins.OnDup[0].Expr.(*ColName), ins) {
+		t.Errorf("IsInsertValueRef(new.a) without row alias: true, want false")
+	}
+}
+
+func TestIsExists(t *testing.T) {
+	testcases := []struct {
+		in        string
+		exists    bool
+		notExists bool
+	}{
+		{"exists (select 1 from t)", true, false},
+		{"(exists (select 1 from t))", true, false},
+		{"not exists (select 1 from t)", false, true},
+		{"not (exists (select 1 from t))", false, true},
+		{"(not exists (select 1 from t))", false, true},
+		{"not not exists (select 1 from t)", false, false},
+		{"a in (select 1 from t)", false, false},
+		{"exists (select 1 from t) is true", false, false},
+	}
+	for _, tcase := range testcases {
+		expr, err := ParseExpr(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		subquery, ok := IsExists(expr)
+		if ok != tcase.exists || ok && String(subquery) != "(select 1 from t)" {
+			t.Errorf("IsExists(%s): %v, %v", tcase.in, String(subquery), ok)
+		}
+		subquery, ok = IsNotExists(expr)
+		if ok != tcase.notExists || ok && String(subquery) != "(select 1 from t)" {
+			t.Errorf("IsNotExists(%s): %v, %v", tcase.in, String(subquery), ok)
+		}
+	}
+
+	// The two forms of NOT EXISTS parse the same.
+	canonical, err := ParseExpr("not exists (select 1 from t)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paren, err := ParseExpr("not (exists (select 1 from t))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(paren, canonical) {
+		t.Errorf("not (exists ...): %#v, want %#v", paren, canonical)
+	}
+}
+
+func TestIsColEqualLiteral(t *testing.T) {
+	testcases := []struct {
+		in  string
+		col string
+		val string
+	}{
+		{in: "a = 1", col: "a", val: "1"},
+		{in: "'x' = t.b", col: "t.b", val: "'x'"},
+		{in: "(a = :v1)", col: "a", val: ":v1"},
+		{in: "a = b"},
+		{in: "1 = 1"},
+		{in: "a != 1"},
+		{in: "a + 1 = 2"},
+		{in: "a = null"},
+	}
+	for _, tcase := range testcases {
+		expr, err := ParseExpr(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		col, val, ok := IsColEqualLiteral(expr)
+		if ok != (tcase.col != "") {
+			t.Errorf("IsColEqualLiteral(%s): %v, want %v", tcase.in, ok, !ok)
+			continue
+		}
+		if ok && (String(col) != tcase.col || String(val) != tcase.val) {
+			t.Errorf("IsColEqualLiteral(%s): %s, %s, want %s, %s", tcase.in, String(col), String(val), tcase.col, tcase.val)
+		}
+	}
+}
+
+func TestIsColInTuple(t *testing.T) {
+	testcases := []struct {
+		in    string
+		col   string
+		tuple string
+	}{
+		{in: "a in (1, 'x', :v1)", col: "a", tuple: "(1, 'x', :v1)"},
+		{in: "(t.a in (1))", col: "t.a", tuple: "(1)"},
+		{in: "a not in (1, 2)"},
+		{in: "a in (b, 2)"},
+		{in: "a in ::list"},
+		{in: "a in (select 1 from t)"},
+		{in: "(a, b) in ((1, 2))"},
+	}
+	for _, tcase := range testcases {
+		expr, err := ParseExpr(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		col, tuple, ok := IsColInTuple(expr)
+		if ok != (tcase.col != "") {
+			t.Errorf("IsColInTuple(%s): %v, want %v", tcase.in, ok, !ok)
+			continue
+		}
+		if ok && (String(col) != tcase.col || String(tuple) != tcase.tuple) {
+			t.Errorf("IsColInTuple(%s): %s, %s, want %s, %s", tcase.in, String(col), String(tuple), tcase.col, tcase.tuple)
+		}
+	}
+}
+
 func TestIsSimpleTuple(t *testing.T) {
 	testcases := []struct {
 		in  Expr
@@ -494,6 +763,144 @@ func TestExtractSetValues(t *testing.T) {
 	}
 }
 
+func TestAnalyzeLikePattern(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want LikeInfo
+	}{{
+		in:   "a like 'abc%'",
+		want: LikeInfo{Type: LikeConstPrefix, Prefix: "abc"},
+	}, {
+		in:   "a like 'ab_d%'",
+		want: LikeInfo{Type: LikeConstPrefix, Prefix: "ab"},
+	}, {
+		in:   "a not like 'abc%'",
+		want: LikeInfo{Type: LikeConstPrefix, Prefix: "abc"},
+	}, {
+		in:   "a like '%abc'",
+		want: LikeInfo{Type: LikeLeadingWildcard},
+	}, {
+		in:   "a like '_abc'",
+		want: LikeInfo{Type: LikeLeadingWildcard},
+	}, {
+		in:   "a like 'abc'",
+		want: LikeInfo{Type: LikeNoWildcard, Prefix: "abc"},
+	}, {
+		in:   "a like ''",
+		want: LikeInfo{Type: LikeNoWildcard, Prefix: ""},
+	}, {
+		in:   "a like '\\%abc%'",
+		want: LikeInfo{Type: LikeConstPrefix, Prefix: "%abc"},
+	}, {
+		in:   "a like 'a\\_b'",
+		want: LikeInfo{Type: LikeNoWildcard, Prefix: "a_b"},
+	}, {
+		in:   "a like '50|%%' escape '|'",
+		want: LikeInfo{Type: LikeConstPrefix, Prefix: "50%"},
+	}, {
+		in:   "a like '|%abc' escape '|'",
+		want: LikeInfo{Type: LikeNoWildcard, Prefix: "%abc"},
+	}, {
+		in:   "a like 'a\\\\%' escape ''",
+		want: LikeInfo{Type: LikeConstPrefix, Prefix: "a\\"},
+	}, {
+		in:   "a like :pattern",
+		want: LikeInfo{Type: LikeUnknown},
+	}, {
+		in:   "a like 'abc%' escape :esc",
+		want: LikeInfo{Type: LikeUnknown},
+	}, {
+		in:   "a like b",
+		want: LikeInfo{Type: LikeUnknown},
+	}, {
+		in:   "a = 'abc'",
+		want: LikeInfo{Type: LikeUnknown},
+	}}
+	for _, tc := range testcases {
+		tree, err := Parse("select * from t where " + tc.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		cmp := tree.(*Select).Where.Expr.(*ComparisonExpr)
+		got := AnalyzeLikePattern(cmp)
+		if got != tc.want {
+			t.Errorf("AnalyzeLikePattern(%s): %+v, want %+v", tc.in, got, tc.want)
+		}
+		if got.Indexable() != (tc.want.Type == LikeNoWildcard || tc.want.Type == LikeConstPrefix) {
+			t.Errorf("AnalyzeLikePattern(%s).Indexable(): %v", tc.in, got.Indexable())
+		}
+	}
+}
+
+func TestResolveWindows(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select sum(b) over w, rank() over (w order by c) from t window w as (partition by a)",
+		out: "select sum(b) over (partition by a), rank() over (partition by a order by c asc) from t",
+	}, {
+		in:  "select sum(b) over W2 from t window w1 as (partition by a), w2 as (w1 order by c)",
+		out: "select sum(b) over (partition by a order by c asc) from t",
+	}, {
+		in:  "select a from t window w as (partition by a)",
+		out: "select a from t",
+	}, {
+		in:  "select sum(b) over (partition by a) from t where a in (select max(c) over w from u window w as (order by c))",
+		out: "select sum(b) over (partition by a) from t where a in (select max(c) over (order by c asc) from u)",
+	}, {
+		in:  "select sum(b) over w from t where a in (select max(c) over w from u window w as (order by c))",
+		err: "window w is not defined",
+	}, {
+		in:  "select a from t window w as (v)",
+		err: "window v is not defined",
+	}, {
+		in:  "select a from t window w as (order by a), w as (order by b)",
+		err: "window w is defined twice",
+	}, {
+		in:  "select a from t window w as (v), v as (w)",
+		err: "window w refers to itself",
+	}, {
+		in:  "select sum(b) over (w partition by c) from t window w as (order by a)",
+		err: "cannot override the partition by of window w",
+	}, {
+		in:  "select sum(b) over (w order by c) from t window w as (order by a)",
+		err: "cannot override the order by of window w",
+	}, {
+		in:  "select sum(b) over (w rows unbounded preceding), sum(c) over w from t window w as (partition by a), v as (order by a rows 1 preceding)",
+		out: "select sum(b) over (partition by a rows unbounded preceding), sum(c) over (partition by a) from t",
+	}, {
+		in:  "select sum(b) over v from t window v as (order by a range between interval 1 day preceding and current row)",
+		out: "select sum(b) over (order by a asc range between interval 1 day preceding and current row) from t",
+	}, {
+		in:  "select sum(b) over (w order by c) from t window w as (rows 1 preceding)",
+		err: "cannot refine window w, which has a frame",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		err = ResolveWindows(tree)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ResolveWindows(%s): %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ResolveWindows(%s): %v", tcase.in, err)
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("ResolveWindows(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
 func newStrVal(in string) *SQLVal {
 	return NewStrVal([]byte(in))
 }
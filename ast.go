@@ -23,6 +23,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/xwb1989/sqlparser/dependency/querypb"
@@ -44,27 +46,87 @@ import (
 // Parse parses the SQL in full and returns a Statement, which
 // is the AST representation of the query. If a DDL statement
 // is partially parsed but still contains a syntax error, the
-// error is ignored and the DDL is returned anyway.
+// error is ignored and the DDL is returned anyway. Parse errors
+// are returned as a *ParseError.
 func Parse(sql string) (Statement, error) {
+	return ParseWithDialect(sql, MySQLDialect)
+}
+
+// ParseStrictDDL is the same as Parse except it errors on
+// partially parsed DDL statements.
+func ParseStrictDDL(sql string) (stmt Statement, err error) {
+	defer recoverParse(&stmt, &err)
 	tokenizer := NewStringTokenizer(sql)
 	if yyParse(tokenizer) != 0 {
-		if tokenizer.partialDDL != nil {
-			log.Printf("ignoring error parsing DDL '%s': %v", sql, tokenizer.LastError)
-			tokenizer.ParseTree = tokenizer.partialDDL
-			return tokenizer.ParseTree, nil
-		}
 		return nil, tokenizer.LastError
 	}
 	return tokenizer.ParseTree, nil
 }
 
-// ParseStrictDDL is the same as Parse except it errors on
-// partially parsed DDL statements.
-func ParseStrictDDL(sql string) (Statement, error) {
+// ParseWithDialect is the same as Parse except the sql is
+// tokenized according to the given dialect.
+func ParseWithDialect(sql string, dialect Dialect) (Statement, error) {
+	return ParseWithOptions(sql, ParseOptions{Dialect: dialect})
+}
+
+// ParseOptions controls optional behavior of ParseWithOptions.
+type ParseOptions struct {
+	// Dialect selects the SQL dialect of the input.
+	Dialect Dialect
+	// TrackSource records the original text of the statement,
+	// see StatementSource and StatementSpan.
+	TrackSource bool
+	// PipesAsConcat makes || the string concatenation operator, like
+	// the PIPES_AS_CONCAT SQL mode of MySQL. By default, || is a
+	// logical OR, like in the default SQL mode of MySQL, except with
+	// PostgresDialect, where it's always concatenation. It's the
+	// same as SQLModePipesAsConcat.
+	PipesAsConcat bool
+	// MaxSize, if set, is the estimated size in bytes of the AST
+	// above which parsing is aborted with ErrTooComplex, see
+	// EstimateSize. It's checked while tokenizing, so that a huge
+	// statement is rejected before its AST is built.
+	MaxSize int
+	// EmptyInLists accepts IN and NOT IN with an empty list, like
+	// a IN (), which MySQL rejects. They're parsed into an
+	// EmptyInExpr, the constant FALSE or TRUE. By default, they're
+	// an error that matches ErrEmptyInList.
+	EmptyInLists bool
+	// NestedComments makes the /* */ comments nest, like in
+	// /* a /* b */ c */, which some dialects allow. By default, a
+	// comment ends at its first */, like in MySQL.
+	NestedComments bool
+	// SQLMode is the SQL mode of the server the statements are meant
+	// for, like SQLModeANSIQuotes, see ParseSQLMode. It should be
+	// formatted with the same mode, see FormatOptions.
+	SQLMode SQLMode
+}
+
+// ParseWithOptions is the same as Parse except its behavior
+// is controlled by opts.
+func ParseWithOptions(sql string, opts ParseOptions) (stmt Statement, err error) {
+	defer recoverParse(&stmt, &err)
 	tokenizer := NewStringTokenizer(sql)
+	tokenizer.Dialect = opts.Dialect
+	tokenizer.TrackSource = opts.TrackSource
+	tokenizer.PipesAsConcat = opts.PipesAsConcat
+	tokenizer.MaxSize = opts.MaxSize
+	tokenizer.EmptyInLists = opts.EmptyInLists
+	tokenizer.NestedComments = opts.NestedComments
+	tokenizer.SQLMode = opts.SQLMode
 	if yyParse(tokenizer) != 0 {
+		if tokenizer.partialDDL != nil {
+			log.Printf("ignoring error parsing DDL '%s': %v", sql, tokenizer.LastError)
+			tokenizer.ParseTree = tokenizer.partialDDL
+			tokenizer.recordSource()
+			return tokenizer.ParseTree, nil
+		}
+		if stmt, ok := parseExtensionClause(sql, opts); ok {
+			return stmt, nil
+		}
 		return nil, tokenizer.LastError
 	}
+	tokenizer.recordSource()
 	return tokenizer.ParseTree, nil
 }
 
@@ -73,9 +135,10 @@ func ParseStrictDDL(sql string) (Statement, error) {
 // The tokenizer will always read up to the end of the statement, allowing for
 // the next call to ParseNext to parse any subsequent SQL statements. When
 // there are no more statements to parse, a error of io.EOF is returned.
-func ParseNext(tokenizer *Tokenizer) (Statement, error) {
-	if tokenizer.lastChar == ';' {
-		tokenizer.next()
+func ParseNext(tokenizer *Tokenizer) (stmt Statement, err error) {
+	defer recoverParse(&stmt, &err)
+	if tokenizer.atDelimiter() {
+		tokenizer.skipDelimiter()
 		tokenizer.skipBlank()
 	}
 	if tokenizer.lastChar == eofChar {
@@ -85,23 +148,39 @@ func ParseNext(tokenizer *Tokenizer) (Statement, error) {
 	tokenizer.reset()
 	tokenizer.multi = true
 	if yyParse(tokenizer) != 0 {
+		if !tokenizer.inStatement && tokenizer.lastChar == eofChar {
+			// Only comments or DELIMITER commands were left.
+			return nil, io.EOF
+		}
 		if tokenizer.partialDDL != nil {
 			tokenizer.ParseTree = tokenizer.partialDDL
+			tokenizer.recordSource()
 			return tokenizer.ParseTree, nil
 		}
 		return nil, tokenizer.LastError
 	}
+	tokenizer.recordSource()
 	return tokenizer.ParseTree, nil
 }
 
+// recoverParse converts a panic raised while parsing into an error.
+// It's only a safety net: the parser must not panic on any input.
+func recoverParse(stmt *Statement, err *error) {
+	if r := recover(); r != nil {
+		*stmt = nil
+		*err = fmt.Errorf("internal error while parsing: %v", r)
+	}
+}
+
 // SplitStatement returns the first sql statement up to either a ; or EOF
 // and the remainder from the given buffer
 func SplitStatement(blob string) (string, string, error) {
 	tokenizer := NewStringTokenizer(blob)
 	tkn := 0
+	var val []byte
 	for {
-		tkn, _ = tokenizer.Scan()
-		if tkn == 0 || tkn == ';' || tkn == eofChar {
+		tkn, val = tokenizer.Scan()
+		if tkn == 0 || tokenizer.isDelimiter(tkn, val) || tkn == eofChar {
 			break
 		}
 	}
@@ -109,24 +188,29 @@ func SplitStatement(blob string) (string, string, error) {
 		return "", "", tokenizer.LastError
 	}
 	if tkn == ';' {
-		return blob[:tokenizer.Position-2], blob[tokenizer.Position-1:], nil
+		return blob[:tokenizer.Position-1-delimiterLen(val)], blob[tokenizer.Position-1:], nil
 	}
 	return blob, "", nil
 }
 
 // SplitStatementToPieces split raw sql statement that may have multi sql pieces to sql pieces
-// returns the sql pieces blob contains; or error if sql cannot be parsed
+// returns the sql pieces blob contains; or error if sql cannot be parsed.
+// DELIMITER commands change the delimiter of the pieces that follow
+// them, and are not part of any piece.
 func SplitStatementToPieces(blob string) (pieces []string, err error) {
 	pieces = make([]string, 0, 16)
 	tokenizer := NewStringTokenizer(blob)
 
 	tkn := 0
+	var val []byte
 	var stmt string
 	stmtBegin := 0
 	for {
-		tkn, _ = tokenizer.Scan()
-		if tkn == ';' {
-			stmt = blob[stmtBegin : tokenizer.Position-2]
+		tkn, val = tokenizer.Scan()
+		if tkn == DELIMITER {
+			stmtBegin = tokenizer.Position - 1
+		} else if tokenizer.isDelimiter(tkn, val) {
+			stmt = blob[stmtBegin : tokenizer.Position-1-delimiterLen(val)]
 			pieces = append(pieces, stmt)
 			stmtBegin = tokenizer.Position - 1
 
@@ -145,6 +229,15 @@ func SplitStatementToPieces(blob string) (pieces []string, err error) {
 	return
 }
 
+// delimiterLen returns the length of the delimiter scanned
+// as a ';' token with the value val.
+func delimiterLen(val []byte) int {
+	if len(val) == 0 {
+		return 1
+	}
+	return len(val)
+}
+
 // SQLNode defines the interface for all nodes
 // generated by the parser.
 type SQLNode interface {
@@ -182,23 +275,421 @@ func Walk(visit Visit, nodes ...SQLNode) error {
 	return nil
 }
 
-// String returns a string representation of an SQLNode.
-func String(node SQLNode) string {
+// PathVisit is the signature of the function WalkWithPath calls
+// for every node. path holds the ancestors of node, starting with
+// the statement. It's reused between calls, so it must be copied
+// if it's needed after the call returns.
+type PathVisit func(node SQLNode, path []SQLNode) (kontinue bool, err error)
+
+// WalkWithPath is like Walk, but it also passes the ancestors
+// of every node to visit. Use PathString to get the struct
+// fields that lead to the node.
+func WalkWithPath(visit PathVisit, stmt Statement) error {
+	var path []SQLNode
+	var walk Visit
+	walk = func(node SQLNode) (bool, error) {
+		kontinue, err := visit(node, path)
+		if err != nil || !kontinue {
+			return false, err
+		}
+		path = append(path, node)
+		err = node.walkSubtree(walk)
+		path = path[:len(path)-1]
+		// The subtree has already been walked.
+		return false, err
+	}
+	return Walk(walk, stmt)
+}
+
+// Children returns the direct children of node, in the order
+// Walk visits them. Like with Walk, optional children that are
+// missing can be returned as nil pointers or empty values.
+func Children(node SQLNode) []SQLNode {
 	if node == nil {
-		return "<nil>"
+		return nil
 	}
+	var children []SQLNode
+	_ = node.walkSubtree(func(child SQLNode) (bool, error) {
+		children = append(children, child)
+		return false, nil
+	})
+	return children
+}
+
+// NodeName returns the name of the type of node, without the
+// package name or pointer indirection, like "Select" or "ColName".
+func NodeName(node SQLNode) string {
+	switch node.(type) {
+	case *AliasedExpr:
+		return "AliasedExpr"
+	case *AliasedTableExpr:
+		return "AliasedTableExpr"
+	case *AlterSpec:
+		return "AlterSpec"
+	case *AndExpr:
+		return "AndExpr"
+	case *Begin:
+		return "Begin"
+	case *BinaryExpr:
+		return "BinaryExpr"
+	case BoolVal:
+		return "BoolVal"
+	case *Call:
+		return "Call"
+	case *CaseExpr:
+		return "CaseExpr"
+	case ColIdent:
+		return "ColIdent"
+	case *ColName:
+		return "ColName"
+	case *CollateExpr:
+		return "CollateExpr"
+	case *ColumnDefinition:
+		return "ColumnDefinition"
+	case *ColumnType:
+		return "ColumnType"
+	case Columns:
+		return "Columns"
+	case Comments:
+		return "Comments"
+	case *Commit:
+		return "Commit"
+	case *CommonTableExpr:
+		return "CommonTableExpr"
+	case *ComparisonExpr:
+		return "ComparisonExpr"
+	case *ConvertExpr:
+		return "ConvertExpr"
+	case *ConvertType:
+		return "ConvertType"
+	case *ConvertUsingExpr:
+		return "ConvertUsingExpr"
+	case *CreateEvent:
+		return "CreateEvent"
+	case *CreateFunction:
+		return "CreateFunction"
+	case *CreateProcedure:
+		return "CreateProcedure"
+	case *CreateTrigger:
+		return "CreateTrigger"
+	case *DBDDL:
+		return "DBDDL"
+	case *DDL:
+		return "DDL"
+	case *Deallocate:
+		return "Deallocate"
+	case *Default:
+		return "Default"
+	case *Delete:
+		return "Delete"
+	case *DescribeTable:
+		return "DescribeTable"
+	case *Do:
+		return "Do"
+	case *DropEvent:
+		return "DropEvent"
+	case *DropRoutine:
+		return "DropRoutine"
+	case *DropTrigger:
+		return "DropTrigger"
+	case *EmptyInExpr:
+		return "EmptyInExpr"
+	case *Execute:
+		return "Execute"
+	case *ExistsExpr:
+		return "ExistsExpr"
+	case *ExtensionExpr:
+		return "ExtensionExpr"
+	case Exprs:
+		return "Exprs"
+	case *ExtractExpr:
+		return "ExtractExpr"
+	case *Flush:
+		return "Flush"
+	case *FuncExpr:
+		return "FuncExpr"
+	case GroupBy:
+		return "GroupBy"
+	case *GroupConcatExpr:
+		return "GroupConcatExpr"
+	case *Handler:
+		return "Handler"
+	case *IndexCache:
+		return "IndexCache"
+	case *IndexCacheTable:
+		return "IndexCacheTable"
+	case IndexCacheTables:
+		return "IndexCacheTables"
+	case *IndexDefinition:
+		return "IndexDefinition"
+	case *ForeignKeyDefinition:
+		return "ForeignKeyDefinition"
+	case IndexHintList:
+		return "IndexHintList"
+	case *IndexHints:
+		return "IndexHints"
+	case *IndexInfo:
+		return "IndexInfo"
+	case *Insert:
+		return "Insert"
+	case *IntervalExpr:
+		return "IntervalExpr"
+	case *IsExpr:
+		return "IsExpr"
+	case JoinCondition:
+		return "JoinCondition"
+	case *JoinTableExpr:
+		return "JoinTableExpr"
+	case *Kill:
+		return "Kill"
+	case *Limit:
+		return "Limit"
+	case ListArg:
+		return "ListArg"
+	case *LockTables:
+		return "LockTables"
+	case *MatchExpr:
+		return "MatchExpr"
+	case *NamedWindow:
+		return "NamedWindow"
+	case NamedWindows:
+		return "NamedWindows"
+	case Nextval:
+		return "Nextval"
+	case *NextValueExpr:
+		return "NextValueExpr"
+	case *NotExpr:
+		return "NotExpr"
+	case *NullVal:
+		return "NullVal"
+	case *OnConflict:
+		return "OnConflict"
+	case OnDup:
+		return "OnDup"
+	case *OptLike:
+		return "OptLike"
+	case *OrExpr:
+		return "OrExpr"
+	case *Order:
+		return "Order"
+	case OrderBy:
+		return "OrderBy"
+	case *OtherAdmin:
+		return "OtherAdmin"
+	case *OtherRead:
+		return "OtherRead"
+	case *Over:
+		return "Over"
+	case *ParenExpr:
+		return "ParenExpr"
+	case *ParenSelect:
+		return "ParenSelect"
+	case *ParenTableExpr:
+		return "ParenTableExpr"
+	case *PartitionDefinition:
+		return "PartitionDefinition"
+	case *PartitionSpec:
+		return "PartitionSpec"
+	case Partitions:
+		return "Partitions"
+	case *PositionExpr:
+		return "PositionExpr"
+	case *Prepare:
+		return "Prepare"
+	case *ProcParam:
+		return "ProcParam"
+	case ProcParams:
+		return "ProcParams"
+	case *RangeCond:
+		return "RangeCond"
+	case *Replication:
+		return "Replication"
+	case *Rollback:
+		return "Rollback"
+	case *RowAlias:
+		return "RowAlias"
+	case *SQLVal:
+		return "SQLVal"
+	case *Select:
+		return "Select"
+	case SelectExprs:
+		return "SelectExprs"
+	case *SelectInto:
+		return "SelectInto"
+	case *Set:
+		return "Set"
+	case *SetExpr:
+		return "SetExpr"
+	case SetExprs:
+		return "SetExprs"
+	case *Show:
+		return "Show"
+	case *ShowFilter:
+		return "ShowFilter"
+	case *StarExpr:
+		return "StarExpr"
+	case *Stream:
+		return "Stream"
+	case *Subquery:
+		return "Subquery"
+	case *SubstrExpr:
+		return "SubstrExpr"
+	case TableExprs:
+		return "TableExprs"
+	case TableIdent:
+		return "TableIdent"
+	case *TableLock:
+		return "TableLock"
+	case TableLocks:
+		return "TableLocks"
+	case *TableMaintenance:
+		return "TableMaintenance"
+	case TableName:
+		return "TableName"
+	case TableNames:
+		return "TableNames"
+	case *TableSpec:
+		return "TableSpec"
+	case *TrimExpr:
+		return "TrimExpr"
+	case *UnaryExpr:
+		return "UnaryExpr"
+	case *Union:
+		return "Union"
+	case *UnlockTables:
+		return "UnlockTables"
+	case *Update:
+		return "Update"
+	case *UpdateExpr:
+		return "UpdateExpr"
+	case UpdateExprs:
+		return "UpdateExprs"
+	case *Use:
+		return "Use"
+	case ValTuple:
+		return "ValTuple"
+	case Values:
+		return "Values"
+	case *ValuesFuncExpr:
+		return "ValuesFuncExpr"
+	case *ViewSpec:
+		return "ViewSpec"
+	case VindexParam:
+		return "VindexParam"
+	case *VindexSpec:
+		return "VindexSpec"
+	case *WeightStringExpr:
+		return "WeightStringExpr"
+	case *When:
+		return "When"
+	case *Where:
+		return "Where"
+	case *WindowSpec:
+		return "WindowSpec"
+	case *WindowFrame:
+		return "WindowFrame"
+	case *FrameBound:
+		return "FrameBound"
+	case *With:
+		return "With"
+	case *XATransaction:
+		return "XATransaction"
+	case *Xid:
+		return "Xid"
+	}
+	return ""
+}
+
+// PathString returns the struct fields that lead from the root
+// of path to node, like "Select.Limit.Rowcount", or
+// "Select.SelectExprs[0].Expr" for slice elements.
+func PathString(path []SQLNode, node SQLNode) string {
+	if len(path) == 0 {
+		return NodeName(node)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(NodeName(path[0]))
+	for i := 1; i < len(path); i++ {
+		buf.WriteString(fieldName(path[i-1], path[i]))
+	}
+	buf.WriteString(fieldName(path[len(path)-1], node))
+	return buf.String()
+}
 
-	buf := NewTrackedBuffer(nil)
-	buf.Myprintf("%v", node)
+// fieldName returns the exported field, or the slice index,
+// under which parent holds child.
+func fieldName(parent, child SQLNode) string {
+	pv := reflect.Indirect(reflect.ValueOf(parent))
+	cv := reflect.ValueOf(child)
+	switch pv.Kind() {
+	case reflect.Struct:
+		for i := 0; i < pv.NumField(); i++ {
+			field := pv.Type().Field(i)
+			if field.PkgPath == "" && sameNode(pv.Field(i), cv) {
+				return "." + field.Name
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < pv.Len(); i++ {
+			if sameNode(pv.Index(i), cv) {
+				return fmt.Sprintf("[%d]", i)
+			}
+		}
+	}
+	return ".?"
+}
+
+// sameNode returns true if v holds node. Pointers and slices
+// are compared by identity, other values by equality, deep
+// equality for the ones that are not comparable, like ColIdent.
+func sameNode(v, node reflect.Value) bool {
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if v.Type() != node.Type() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		return v.Pointer() == node.Pointer()
+	case reflect.Slice:
+		return v.Pointer() == node.Pointer() && v.Len() == node.Len()
+	}
+	if !v.Type().Comparable() {
+		return reflect.DeepEqual(v.Interface(), node.Interface())
+	}
+	return v.Interface() == node.Interface()
+}
+
+// String returns a string representation of an SQLNode.
+func String(node SQLNode) string {
+	var buf bytes.Buffer
+	_, _ = writeNode(&buf, node, MySQLDialect)
 	return buf.String()
 }
 
-// Append appends the SQLNode to the buffer.
+// StringWithDialect returns a string representation of an SQLNode
+// using the identifier quoting and syntax of the given dialect.
+func StringWithDialect(node SQLNode, dialect Dialect) string {
+	var buf bytes.Buffer
+	_, _ = writeNode(&buf, node, dialect)
+	return buf.String()
+}
+
+// Append appends the SQLNode to the buffer. Reusing the buffer
+// to format several nodes avoids allocating one for each of them.
 func Append(buf *bytes.Buffer, node SQLNode) {
 	tbuf := &TrackedBuffer{
 		Buffer: buf,
+		Style:  defaultStyle,
 	}
-	node.Format(tbuf)
+	start := buf.Len()
+	buf.Grow(formattedSize(node))
+	tbuf.formatNode(node)
+	tbuf.applyKeywordCase(start)
 }
 
 // Statement represents a statement.
@@ -207,28 +698,117 @@ type Statement interface {
 	SQLNode
 }
 
-func (*Union) iStatement()      {}
-func (*Select) iStatement()     {}
-func (*Stream) iStatement()     {}
-func (*Insert) iStatement()     {}
-func (*Update) iStatement()     {}
-func (*Delete) iStatement()     {}
-func (*Set) iStatement()        {}
-func (*DBDDL) iStatement()      {}
-func (*DDL) iStatement()        {}
-func (*Show) iStatement()       {}
-func (*Use) iStatement()        {}
-func (*Begin) iStatement()      {}
-func (*Commit) iStatement()     {}
-func (*Rollback) iStatement()   {}
-func (*OtherRead) iStatement()  {}
-func (*OtherAdmin) iStatement() {}
-
-// ParenSelect can actually not be a top level statement,
-// but we have to allow it because it's a requirement
-// of SelectStatement.
+func (*Union) iStatement()            {}
+func (*Select) iStatement()           {}
+func (*Stream) iStatement()           {}
+func (*Insert) iStatement()           {}
+func (*Update) iStatement()           {}
+func (*Delete) iStatement()           {}
+func (*Set) iStatement()              {}
+func (*DBDDL) iStatement()            {}
+func (*DDL) iStatement()              {}
+func (*Show) iStatement()             {}
+func (*Use) iStatement()              {}
+func (*Begin) iStatement()            {}
+func (*Commit) iStatement()           {}
+func (*Rollback) iStatement()         {}
+func (*OtherRead) iStatement()        {}
+func (*DescribeTable) iStatement()    {}
+func (*OtherAdmin) iStatement()       {}
+func (*Replication) iStatement()      {}
+func (*Do) iStatement()               {}
+func (*Handler) iStatement()          {}
+func (*TableMaintenance) iStatement() {}
+func (*IndexCache) iStatement()       {}
+func (*Flush) iStatement()            {}
+func (*Kill) iStatement()             {}
+func (*XATransaction) iStatement()    {}
+func (*LockTables) iStatement()       {}
+func (*UnlockTables) iStatement()     {}
+func (*Call) iStatement()             {}
+func (*Prepare) iStatement()          {}
+func (*Execute) iStatement()          {}
+func (*Deallocate) iStatement()       {}
+func (*CreateTrigger) iStatement()    {}
+func (*DropTrigger) iStatement()      {}
+func (*CreateEvent) iStatement()      {}
+func (*DropEvent) iStatement()        {}
+func (*CreateProcedure) iStatement()  {}
+func (*CreateFunction) iStatement()   {}
+func (*DropRoutine) iStatement()      {}
+
+// ParenSelect is a top level statement for a parenthesized
+// SELECT or UNION, like (select a from t) limit 1.
 func (*ParenSelect) iStatement() {}
 
+// Span is the byte range [Start, End) of a statement
+// in the input it was parsed from.
+type Span struct {
+	Start, End int
+}
+
+// statementSource is embedded in every statement to hold its
+// original text. It's only populated if tracking was requested.
+type statementSource struct {
+	source *sourceText
+	// extensions are the clauses recognized by clause extensions,
+	// see RegisterClauseExtension.
+	extensions []*ExtensionClause
+}
+
+type sourceText struct {
+	span Span
+	text string
+}
+
+func (node *statementSource) setSource(src *sourceText) {
+	node.source = src
+}
+
+func (node *statementSource) getSource() *sourceText {
+	return node.source
+}
+
+func (node *statementSource) addExtension(clause *ExtensionClause) {
+	node.extensions = append(node.extensions, clause)
+}
+
+func (node *statementSource) getExtensions() []*ExtensionClause {
+	return node.extensions
+}
+
+type sourceTracker interface {
+	setSource(*sourceText)
+	getSource() *sourceText
+}
+
+// StatementSource returns the original text of stmt, as
+// recorded by ParseWithOptions or ParseNext when source tracking
+// is enabled. It returns an empty string otherwise.
+func StatementSource(stmt Statement) string {
+	if src := statementSourceText(stmt); src != nil {
+		return src.text
+	}
+	return ""
+}
+
+// StatementSpan returns the location of stmt in the parsed input,
+// or nil if source tracking was not enabled.
+func StatementSpan(stmt Statement) *Span {
+	if src := statementSourceText(stmt); src != nil {
+		span := src.span
+		return &span
+	}
+	return nil
+}
+
+func statementSourceText(stmt Statement) *sourceText {
+	if tracker, ok := stmt.(sourceTracker); ok {
+		return tracker.getSource()
+	}
+	return nil
+}
+
 // SelectStatement any SELECT statement.
 type SelectStatement interface {
 	iSelectStatement()
@@ -245,25 +825,40 @@ func (*ParenSelect) iSelectStatement() {}
 
 // Select represents a SELECT statement.
 type Select struct {
-	Cache       string
-	Comments    Comments
-	Distinct    string
-	Hints       string
+	statementSource
+
+	// With is the WITH clause that defines the common table
+	// expressions of the select, if any.
+	With     *With
+	Comments Comments
+	// Options are the modifiers between the select keyword and the
+	// select expressions, like DISTINCT, in the order they're written.
+	Options     SelectOptions
 	SelectExprs SelectExprs
 	From        TableExprs
 	Where       *Where
 	GroupBy     GroupBy
-	Having      *Where
-	OrderBy     OrderBy
-	Limit       *Limit
-	Lock        string
-}
-
-// Select.Distinct
-const (
-	DistinctStr      = "distinct "
-	StraightJoinHint = "straight_join "
-)
+	// WithRollup is set for GROUP BY ... WITH ROLLUP, which adds a
+	// super-aggregate row for each prefix of the GROUP BY columns,
+	// with NULL for the other ones.
+	WithRollup bool
+	Having     *Where
+	// Windows is the WINDOW clause, which defines the windows
+	// that the OVER clauses can refer to by their names.
+	Windows NamedWindows
+	OrderBy OrderBy
+	Limit   *Limit
+	// Into is the INTO clause, which stores the rows in a file
+	// or in user variables instead of returning them.
+	Into *SelectInto
+	Lock string
+}
+
+// GroupConcatExpr.Distinct
+const DistinctStr = "distinct "
+
+// Select.WithRollup
+const WithRollupStr = " with rollup"
 
 // Select.Lock
 const (
@@ -271,12 +866,135 @@ const (
 	ShareModeStr = " lock in share mode"
 )
 
-// Select.Cache
+// SelectOption is a modifier of a SELECT.
+type SelectOption int
+
+// Select.Options
+const (
+	SelectAll = SelectOption(iota + 1)
+	SelectDistinct
+	// SelectDistinctRow is a synonym of SelectDistinct.
+	SelectDistinctRow
+	SelectHighPriority
+	SelectStraightJoin
+	SelectSmallResult
+	SelectBigResult
+	SelectBufferResult
+	SelectCache
+	SelectNoCache
+	SelectCalcFoundRows
+)
+
+var selectOptionStrings = map[SelectOption]string{
+	SelectAll:           "all",
+	SelectDistinct:      "distinct",
+	SelectDistinctRow:   "distinctrow",
+	SelectHighPriority:  "high_priority",
+	SelectStraightJoin:  "straight_join",
+	SelectSmallResult:   "sql_small_result",
+	SelectBigResult:     "sql_big_result",
+	SelectBufferResult:  "sql_buffer_result",
+	SelectCache:         "sql_cache",
+	SelectNoCache:       "sql_no_cache",
+	SelectCalcFoundRows: "sql_calc_found_rows",
+}
+
+func (opt SelectOption) String() string {
+	return selectOptionStrings[opt]
+}
+
+// same returns the option opt stands for: SelectDistinctRow is
+// the same as SelectDistinct.
+func (opt SelectOption) same() SelectOption {
+	if opt == SelectDistinctRow {
+		return SelectDistinct
+	}
+	return opt
+}
+
+// conflicts returns true if opt and other can't be used together.
+func (opt SelectOption) conflicts(other SelectOption) bool {
+	group := func(opt SelectOption) int {
+		switch opt {
+		case SelectAll, SelectDistinct, SelectDistinctRow:
+			return 1
+		case SelectCache, SelectNoCache:
+			return 2
+		}
+		return 0
+	}
+	return opt.same() != other.same() && group(opt) != 0 && group(opt) == group(other)
+}
+
+// SelectOptions are the options of a SELECT, in the order they're written.
+type SelectOptions []SelectOption
+
+// Has returns true if opts has opt. DISTINCTROW counts as DISTINCT.
+func (opts SelectOptions) Has(opt SelectOption) bool {
+	return opts.index(opt) >= 0
+}
+
+func (opts SelectOptions) index(opt SelectOption) int {
+	for i, o := range opts {
+		if o.same() == opt.same() {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasOption returns true if the select has opt.
+func (node *Select) HasOption(opt SelectOption) bool {
+	return node.Options.Has(opt)
+}
+
+// IsDistinct returns true if the select has DISTINCT or DISTINCTROW.
+func (node *Select) IsDistinct() bool {
+	return node.Options.Has(SelectDistinct)
+}
+
+// AddOption adds opt to the options of the select, in place of the
+// option it conflicts with if there's one, like SQL_CACHE for
+// SQL_NO_CACHE. It does nothing if the select already has opt.
+func (node *Select) AddOption(opt SelectOption) {
+	if node.Options.Has(opt) {
+		return
+	}
+	for i, o := range node.Options {
+		if opt.conflicts(o) {
+			node.Options[i] = opt
+			return
+		}
+	}
+	node.Options = append(node.Options, opt)
+}
+
+// RemoveOption removes opt from the options of the select, and
+// returns true if it had it. Removing SelectDistinct also removes
+// DISTINCTROW.
+func (node *Select) RemoveOption(opt SelectOption) bool {
+	i := node.Options.index(opt)
+	if i < 0 {
+		return false
+	}
+	node.Options = append(node.Options[:i:i], node.Options[i+1:]...)
+	if len(node.Options) == 0 {
+		node.Options = nil
+	}
+	return true
+}
+
+// Insert.Priority, Update.Priority and Delete.Priority. Update and
+// Delete only take LowPriorityStr.
 const (
-	SQLCacheStr   = "sql_cache "
-	SQLNoCacheStr = "sql_no_cache "
+	LowPriorityStr  = "low_priority "
+	DelayedStr      = "delayed "
+	HighPriorityStr = "high_priority "
 )
 
+// Delete.Quick
+const QuickStr = "quick "
+
 // AddOrder adds an order by element
 func (node *Select) AddOrder(order *Order) {
 	node.OrderBy = append(node.OrderBy, order)
@@ -289,11 +1007,24 @@ func (node *Select) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	buf.Myprintf("%vselect %v", node.With, node.Comments)
+	for _, opt := range node.Options {
+		buf.Myprintf("%s ", opt.String())
+	}
+	limit := node.Limit
+	if limit.isTop(buf) {
+		limit.formatTop(buf)
+		limit = nil
+	}
+	rollup := ""
+	if node.WithRollup {
+		rollup = WithRollupStr
+	}
+	buf.Myprintf("%v from %v%v%v%s%v%v%v%v%v%s",
+		node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
-		node.Limit, node.Lock)
+		node.GroupBy, rollup, node.Having, node.Windows, node.OrderBy,
+		limit, node.Into, node.Lock)
 }
 
 func (node *Select) walkSubtree(visit Visit) error {
@@ -302,20 +1033,24 @@ func (node *Select) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.SelectExprs,
 		node.From,
 		node.Where,
 		node.GroupBy,
 		node.Having,
+		node.Windows,
 		node.OrderBy,
 		node.Limit,
+		node.Into,
 	)
 }
 
 // AddWhere adds the boolean expression to the
 // WHERE clause as an AND condition. If the expression
-// is an OR clause, it parenthesizes it. Currently,
+// or the existing condition is an OR clause, it
+// parenthesizes it. Currently,
 // the OR operator is the only one that's lower precedence
 // than AND.
 func (node *Select) AddWhere(expr Expr) {
@@ -329,8 +1064,12 @@ func (node *Select) AddWhere(expr Expr) {
 		}
 		return
 	}
+	left := node.Where.Expr
+	if _, ok := left.(*OrExpr); ok {
+		left = &ParenExpr{Expr: left}
+	}
 	node.Where.Expr = &AndExpr{
-		Left:  node.Where.Expr,
+		Left:  left,
 		Right: expr,
 	}
 	return
@@ -338,7 +1077,8 @@ func (node *Select) AddWhere(expr Expr) {
 
 // AddHaving adds the boolean expression to the
 // HAVING clause as an AND condition. If the expression
-// is an OR clause, it parenthesizes it. Currently,
+// or the existing condition is an OR clause, it
+// parenthesizes it. Currently,
 // the OR operator is the only one that's lower precedence
 // than AND.
 func (node *Select) AddHaving(expr Expr) {
@@ -352,31 +1092,41 @@ func (node *Select) AddHaving(expr Expr) {
 		}
 		return
 	}
+	left := node.Having.Expr
+	if _, ok := left.(*OrExpr); ok {
+		left = &ParenExpr{Expr: left}
+	}
 	node.Having.Expr = &AndExpr{
-		Left:  node.Having.Expr,
+		Left:  left,
 		Right: expr,
 	}
 	return
 }
 
-// ParenSelect is a parenthesized SELECT statement.
+// ParenSelect is a parenthesized SELECT statement. At the top level,
+// it can be followed by an ORDER BY and a LIMIT of its own, like
+// (select a from t) order by a limit 1.
 type ParenSelect struct {
-	Select SelectStatement
+	statementSource
+
+	Select  SelectStatement
+	OrderBy OrderBy
+	Limit   *Limit
 }
 
 // AddOrder adds an order by element
 func (node *ParenSelect) AddOrder(order *Order) {
-	panic("unreachable")
+	node.OrderBy = append(node.OrderBy, order)
 }
 
 // SetLimit sets the limit clause
 func (node *ParenSelect) SetLimit(limit *Limit) {
-	panic("unreachable")
+	node.Limit = limit
 }
 
 // Format formats the node.
 func (node *ParenSelect) Format(buf *TrackedBuffer) {
-	buf.Myprintf("(%v)", node.Select)
+	buf.Myprintf("(%v)%v%v", node.Select, node.OrderBy, node.Limit)
 }
 
 func (node *ParenSelect) walkSubtree(visit Visit) error {
@@ -386,11 +1136,17 @@ func (node *ParenSelect) walkSubtree(visit Visit) error {
 	return Walk(
 		visit,
 		node.Select,
+		node.OrderBy,
+		node.Limit,
 	)
 }
 
 // Union represents a UNION statement.
 type Union struct {
+	statementSource
+
+	// With is the WITH clause of the union, which its selects share.
+	With        *With
 	Type        string
 	Left, Right SelectStatement
 	OrderBy     OrderBy
@@ -417,8 +1173,15 @@ func (node *Union) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
-		node.OrderBy, node.Limit, node.Lock)
+	buf.Myprintf("%v%v %s ", node.With, node.Left, node.Type)
+	// The limit of a select on the right, like the TOP of SQL Server
+	// formatted for MySQL, would be taken for the one of the union.
+	if s, ok := node.Right.(*Select); ok && s.Limit != nil && !s.Limit.isTop(buf) {
+		buf.Myprintf("(%v)", node.Right)
+	} else {
+		buf.Myprintf("%v", node.Right)
+	}
+	buf.Myprintf("%v%v%s", node.OrderBy, node.Limit, node.Lock)
 }
 
 func (node *Union) walkSubtree(visit Visit) error {
@@ -427,13 +1190,75 @@ func (node *Union) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Left,
 		node.Right,
 	)
 }
 
+// With represents the WITH clause of a select, a union, an update or
+// a delete, which defines common table expressions that its FROM
+// clauses can refer to like tables.
+type With struct {
+	// Recursive is set for WITH RECURSIVE, where a common table
+	// expression can refer to itself.
+	Recursive bool
+	CTEs      []*CommonTableExpr
+}
+
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	prefix := "with "
+	if node.Recursive {
+		prefix = "with recursive "
+	}
+	for _, cte := range node.CTEs {
+		buf.Myprintf("%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteByte(' ')
+}
+
+func (node *With) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, cte := range node.CTEs {
+		if err := Walk(visit, cte); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommonTableExpr represents a common table expression of a WITH
+// clause, like t (a, b) AS (SELECT ...). Columns are the names of
+// the columns, if they're given.
+type CommonTableExpr struct {
+	Name     TableIdent
+	Columns  Columns
+	Subquery *Subquery
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
+func (node *CommonTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Columns, node.Subquery)
+}
+
 // Stream represents a SELECT statement.
 type Stream struct {
+	statementSource
+
 	Comments   Comments
 	SelectExpr SelectExpr
 	Table      TableName
@@ -466,14 +1291,28 @@ func (node *Stream) walkSubtree(visit Visit) error {
 // of the implications the deletion part may have on vindexes.
 // If you add fields here, consider adding them to calls to validateSubquerySamePlan.
 type Insert struct {
+	statementSource
+
 	Action     string
 	Comments   Comments
+	Priority   string
 	Ignore     string
 	Table      TableName
 	Partitions Partitions
 	Columns    Columns
 	Rows       InsertRows
-	OnDup      OnDup
+	// SetExprs holds the assignments of the INSERT ... SET form,
+	// in which case Columns and Rows are not set.
+	SetExprs UpdateExprs
+	// RowAlias names the new row for the OnDup clause, see RowAlias.
+	RowAlias *RowAlias
+	OnDup    OnDup
+	// OnConflict holds the ON CONFLICT clause, see Dialect.
+	// OnDup is not set if it is.
+	OnConflict *OnConflict
+	// Returning holds the expressions of a RETURNING clause,
+	// see Dialect.
+	Returning SelectExprs
 }
 
 // DDL strings.
@@ -484,10 +1323,18 @@ const (
 
 // Format formats the node.
 func (node *Insert) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%s %v%sinto %v%v%v %v%v",
-		node.Action,
-		node.Comments, node.Ignore,
-		node.Table, node.Partitions, node.Columns, node.Rows, node.OnDup)
+	if node.SetExprs != nil {
+		buf.Myprintf("%s %v%s%sinto %v%v set %v%v%v%v",
+			node.Action,
+			node.Comments, node.Priority, node.Ignore,
+			node.Table, node.Partitions, node.SetExprs, node.RowAlias, node.OnDup, node.OnConflict)
+	} else {
+		buf.Myprintf("%s %v%s%sinto %v%v%v %v%v%v%v",
+			node.Action,
+			node.Comments, node.Priority, node.Ignore,
+			node.Table, node.Partitions, node.Columns, node.Rows, node.RowAlias, node.OnDup, node.OnConflict)
+	}
+	formatReturning(buf, node.Returning)
 }
 
 func (node *Insert) walkSubtree(visit Visit) error {
@@ -500,7 +1347,11 @@ func (node *Insert) walkSubtree(visit Visit) error {
 		node.Table,
 		node.Columns,
 		node.Rows,
+		node.SetExprs,
+		node.RowAlias,
 		node.OnDup,
+		node.OnConflict,
+		node.Returning,
 	)
 }
 
@@ -518,19 +1369,28 @@ func (*ParenSelect) iInsertRows() {}
 // Update represents an UPDATE statement.
 // If you add fields here, consider adding them to calls to validateSubquerySamePlan.
 type Update struct {
+	statementSource
+
+	// With is the WITH clause that defines the common table
+	// expressions of the update, if any.
+	With       *With
 	Comments   Comments
+	Priority   string
+	Ignore     string
 	TableExprs TableExprs
 	Exprs      UpdateExprs
 	Where      *Where
 	OrderBy    OrderBy
 	Limit      *Limit
+	Returning  SelectExprs
 }
 
 // Format formats the node.
 func (node *Update) Format(buf *TrackedBuffer) {
-	buf.Myprintf("update %v%v set %v%v%v%v",
-		node.Comments, node.TableExprs,
+	buf.Myprintf("%vupdate %v%s%s%v set %v%v%v%v",
+		node.With, node.Comments, node.Priority, node.Ignore, node.TableExprs,
 		node.Exprs, node.Where, node.OrderBy, node.Limit)
+	formatReturning(buf, node.Returning)
 }
 
 func (node *Update) walkSubtree(visit Visit) error {
@@ -539,34 +1399,46 @@ func (node *Update) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.TableExprs,
 		node.Exprs,
 		node.Where,
 		node.OrderBy,
 		node.Limit,
+		node.Returning,
 	)
 }
 
 // Delete represents a DELETE statement.
 // If you add fields here, consider adding them to calls to validateSubquerySamePlan.
 type Delete struct {
+	statementSource
+
+	// With is the WITH clause that defines the common table
+	// expressions of the delete, if any.
+	With       *With
 	Comments   Comments
+	Priority   string
+	Quick      string
+	Ignore     string
 	Targets    TableNames
 	TableExprs TableExprs
 	Partitions Partitions
 	Where      *Where
 	OrderBy    OrderBy
 	Limit      *Limit
+	Returning  SelectExprs
 }
 
 // Format formats the node.
 func (node *Delete) Format(buf *TrackedBuffer) {
-	buf.Myprintf("delete %v", node.Comments)
+	buf.Myprintf("%vdelete %v%s%s%s", node.With, node.Comments, node.Priority, node.Quick, node.Ignore)
 	if node.Targets != nil {
 		buf.Myprintf("%v ", node.Targets)
 	}
 	buf.Myprintf("from %v%v%v%v%v", node.TableExprs, node.Partitions, node.Where, node.OrderBy, node.Limit)
+	formatReturning(buf, node.Returning)
 }
 
 func (node *Delete) walkSubtree(visit Visit) error {
@@ -575,17 +1447,29 @@ func (node *Delete) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.Targets,
 		node.TableExprs,
 		node.Where,
 		node.OrderBy,
 		node.Limit,
+		node.Returning,
 	)
 }
 
+// formatReturning formats the RETURNING clause of an INSERT,
+// UPDATE or DELETE, if it has one.
+func formatReturning(buf *TrackedBuffer, returning SelectExprs) {
+	if returning != nil {
+		buf.Myprintf(" returning %v", returning)
+	}
+}
+
 // Set represents a SET statement.
 type Set struct {
+	statementSource
+
 	Comments Comments
 	Exprs    SetExprs
 	Scope    string
@@ -619,6 +1503,8 @@ func (node *Set) walkSubtree(visit Visit) error {
 
 // DBDDL represents a CREATE, DROP database statement.
 type DBDDL struct {
+	statementSource
+
 	Action   string
 	DBName   string
 	IfExists bool
@@ -648,17 +1534,36 @@ func (node *DBDDL) walkSubtree(visit Visit) error {
 // DDL represents a CREATE, ALTER, DROP, RENAME or TRUNCATE statement.
 // Table is set for AlterStr, DropStr, RenameStr, TruncateStr
 // NewName is set for AlterStr, CreateStr, RenameStr.
+// IfNotExists is set for the CreateStr of a table.
 // VindexSpec is set for CreateVindexStr, DropVindexStr, AddColVindexStr, DropColVindexStr
 // VindexCols is set for AddColVindexStr
 type DDL struct {
+	statementSource
+
 	Action        string
 	Table         TableName
 	NewName       TableName
 	IfExists      bool
+	IfNotExists   bool
 	TableSpec     *TableSpec
 	PartitionSpec *PartitionSpec
 	VindexSpec    *VindexSpec
 	VindexCols    []ColIdent
+
+	// OptLike is set for CREATE TABLE ... LIKE.
+	OptLike *OptLike
+	// AsSelect is set for CREATE TABLE ... SELECT.
+	AsSelect          SelectStatement
+	AsSelectDuplicate string
+	// ViewSpec is set for the CREATE VIEW and ALTER VIEW statements
+	// whose definition was parsed.
+	ViewSpec *ViewSpec
+
+	// AlterSpecs are the alterations of an ALTER TABLE, or the index
+	// that CREATE INDEX adds or that DROP INDEX drops. They're nil if
+	// the alterations were not parsed, like for the CREATE INDEX or
+	// the ALTER TABLE statements the parser only partially parses.
+	AlterSpecs []*AlterSpec
 }
 
 // DDL strings.
@@ -676,14 +1581,42 @@ const (
 	VindexOwnerStr = "owner"
 )
 
+// DDL.AsSelectDuplicate
+const (
+	DuplicateIgnoreStr  = "ignore"
+	DuplicateReplaceStr = "replace"
+)
+
 // Format formats the node.
 func (node *DDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case CreateStr:
+		if node.ViewSpec != nil {
+			replace := ""
+			if node.ViewSpec.OrReplace {
+				replace = " or replace"
+			}
+			buf.Myprintf("%s%s view %v%v", node.Action, replace, node.NewName, node.ViewSpec)
+			return
+		}
+		notExists := ""
+		if node.IfNotExists {
+			notExists = " if not exists"
+		}
+		if node.OptLike != nil {
+			buf.Myprintf("%s table%s %v %v", node.Action, notExists, node.NewName, node.OptLike)
+			return
+		}
 		if node.TableSpec == nil {
-			buf.Myprintf("%s table %v", node.Action, node.NewName)
+			buf.Myprintf("%s table%s %v", node.Action, notExists, node.NewName)
 		} else {
-			buf.Myprintf("%s table %v %v", node.Action, node.NewName, node.TableSpec)
+			buf.Myprintf("%s table%s %v %v", node.Action, notExists, node.NewName, node.TableSpec)
+		}
+		if node.AsSelect != nil {
+			if node.AsSelectDuplicate != "" {
+				buf.Myprintf(" %s", node.AsSelectDuplicate)
+			}
+			buf.Myprintf(" as %v", node.AsSelect)
 		}
 	case DropStr:
 		exists := ""
@@ -694,10 +1627,21 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 	case RenameStr:
 		buf.Myprintf("%s table %v to %v", node.Action, node.Table, node.NewName)
 	case AlterStr:
+		if node.ViewSpec != nil {
+			buf.Myprintf("%s view %v%v", node.Action, node.Table, node.ViewSpec)
+			return
+		}
 		if node.PartitionSpec != nil {
 			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.PartitionSpec)
-		} else {
-			buf.Myprintf("%s table %v", node.Action, node.Table)
+			return
+		}
+		buf.Myprintf("%s table %v", node.Action, node.Table)
+		for i, spec := range node.AlterSpecs {
+			if i == 0 {
+				buf.Myprintf(" %v", spec)
+			} else {
+				buf.Myprintf(", %v", spec)
+			}
 		}
 	case CreateVindexStr:
 		buf.Myprintf("%s %v %v", node.Action, node.VindexSpec.Name, node.VindexSpec)
@@ -711,7 +1655,7 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 			}
 		}
 		buf.Myprintf(")")
-		if node.VindexSpec.Type.String() != "" {
+		if !node.VindexSpec.Type.IsEmpty() {
 			buf.Myprintf(" %v", node.VindexSpec)
 		}
 	case DropColVindexStr:
@@ -725,59 +1669,213 @@ func (node *DDL) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
-	return Walk(
+	if err := Walk(
 		visit,
 		node.Table,
 		node.NewName,
-	)
+		node.OptLike,
+		node.AsSelect,
+		node.ViewSpec,
+	); err != nil {
+		return err
+	}
+	for _, spec := range node.AlterSpecs {
+		if err := Walk(visit, spec); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Partition strings
-const (
-	ReorganizeStr = "reorganize partition"
-)
+// ReadsTables returns true if the DDL also reads from other
+// tables, as CREATE TABLE ... LIKE, CREATE TABLE ... SELECT and
+// the views do.
+func (node *DDL) ReadsTables() bool {
+	return node.OptLike != nil || node.AsSelect != nil || node.ViewSpec != nil
+}
 
-// PartitionSpec describe partition actions (for alter and create)
-type PartitionSpec struct {
-	Action      string
-	Name        ColIdent
-	Definitions []*PartitionDefinition
+// ViewSpec is the definition of a view, in CREATE VIEW and ALTER
+// VIEW: its optional column names, and the select it stands for.
+type ViewSpec struct {
+	// OrReplace is set for CREATE OR REPLACE VIEW.
+	OrReplace bool
+	Columns   Columns
+	Select    SelectStatement
 }
 
-// Format formats the node.
-func (node *PartitionSpec) Format(buf *TrackedBuffer) {
-	switch node.Action {
-	case ReorganizeStr:
-		buf.Myprintf("%s %v into (", node.Action, node.Name)
-		var prefix string
-		for _, pd := range node.Definitions {
-			buf.Myprintf("%s%v", prefix, pd)
-			prefix = ", "
-		}
-		buf.Myprintf(")")
-	default:
-		panic("unimplemented")
+// Format formats the node. It starts with the column list, or
+// with the space before AS.
+func (node *ViewSpec) Format(buf *TrackedBuffer) {
+	if node.Columns != nil {
+		buf.Myprintf("%v", node.Columns)
 	}
+	buf.Myprintf(" as %v", node.Select)
 }
 
-func (node *PartitionSpec) walkSubtree(visit Visit) error {
+func (node *ViewSpec) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
-	if err := Walk(visit, node.Name); err != nil {
-		return err
-	}
-	for _, def := range node.Definitions {
-		if err := Walk(visit, def); err != nil {
-			return err
-		}
-	}
-	return nil
+	return Walk(visit, node.Columns, node.Select)
 }
 
-// PartitionDefinition describes a very minimal partition definition
-type PartitionDefinition struct {
-	Name     ColIdent
+// OptLike represents the LIKE clause of CREATE TABLE ... LIKE.
+type OptLike struct {
+	LikeTable TableName
+}
+
+// Format formats the node.
+func (node *OptLike) Format(buf *TrackedBuffer) {
+	buf.Myprintf("like %v", node.LikeTable)
+}
+
+func (node *OptLike) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.LikeTable)
+}
+
+// AlterSpec is an alteration of an ALTER TABLE statement, like
+// ADD COLUMN or DROP INDEX.
+type AlterSpec struct {
+	Action string
+	// Column is the column that AddColumnStr adds, or the new
+	// definition of the column of ModifyColumnStr and ChangeColumnStr.
+	Column *ColumnDefinition
+	// First and After are the position of the column of AddColumnStr,
+	// ModifyColumnStr and ChangeColumnStr: the first of the table, or
+	// the one after the column After.
+	First bool
+	After ColIdent
+	// Index is the index that AddIndexStr adds, and ForeignKey the
+	// foreign key of AddForeignKeyStr.
+	Index      *IndexDefinition
+	ForeignKey *ForeignKeyDefinition
+	// Name is the column or the index that the alteration is on,
+	// like the one that's dropped or renamed, and NewName its new
+	// name for RenameColumnStr and RenameIndexStr.
+	Name    ColIdent
+	NewName ColIdent
+	// Default is the default of SetDefaultStr.
+	Default *SQLVal
+	// Option and Value are the name and the value of the table option
+	// of TableOptionStr, like engine and InnoDB, and Value is the
+	// character set of ConvertCharsetStr, with the collation Collate.
+	Option  string
+	Value   string
+	Collate string
+}
+
+// AlterSpec.Action
+const (
+	AddColumnStr      = "add column"
+	AddIndexStr       = "add index"
+	AddForeignKeyStr  = "add foreign key"
+	DropColumnStr     = "drop column"
+	DropIndexStr      = "drop index"
+	DropPrimaryKeyStr = "drop primary key"
+	DropForeignKeyStr = "drop foreign key"
+	ModifyColumnStr   = "modify column"
+	ChangeColumnStr   = "change column"
+	SetDefaultStr     = "set default"
+	DropDefaultStr    = "drop default"
+	RenameColumnStr   = "rename column"
+	RenameIndexStr    = "rename index"
+	ConvertCharsetStr = "convert to character set"
+	ForceRebuildStr   = "force"
+	TableOptionStr    = "table option"
+)
+
+// Format formats the node.
+func (node *AlterSpec) Format(buf *TrackedBuffer) {
+	switch node.Action {
+	case AddColumnStr, ModifyColumnStr:
+		buf.Myprintf("%s %v", node.Action, node.Column)
+	case ChangeColumnStr:
+		buf.Myprintf("%s %v %v", node.Action, node.Name, node.Column)
+	case AddIndexStr:
+		buf.Myprintf("add %v", node.Index)
+	case AddForeignKeyStr:
+		buf.Myprintf("add %v", node.ForeignKey)
+	case DropColumnStr, DropIndexStr, DropForeignKeyStr:
+		buf.Myprintf("%s %v", node.Action, node.Name)
+	case SetDefaultStr:
+		buf.Myprintf("alter column %v set default %v", node.Name, node.Default)
+	case DropDefaultStr:
+		buf.Myprintf("alter column %v drop default", node.Name)
+	case RenameColumnStr, RenameIndexStr:
+		buf.Myprintf("%s %v to %v", node.Action, node.Name, node.NewName)
+	case ConvertCharsetStr:
+		buf.Myprintf("%s %s", node.Action, node.Value)
+		if node.Collate != "" {
+			buf.Myprintf(" collate %s", node.Collate)
+		}
+	case TableOptionStr:
+		buf.Myprintf("%s = %s", node.Option, node.Value)
+	default:
+		buf.Myprintf("%s", node.Action)
+	}
+	if node.First {
+		buf.Myprintf(" first")
+	} else if !node.After.IsEmpty() {
+		buf.Myprintf(" after %v", node.After)
+	}
+}
+
+func (node *AlterSpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Column, node.Index, node.ForeignKey, node.Name, node.NewName, node.After)
+}
+
+// Partition strings
+const (
+	ReorganizeStr = "reorganize partition"
+)
+
+// PartitionSpec describe partition actions (for alter and create)
+type PartitionSpec struct {
+	Action      string
+	Name        ColIdent
+	Definitions []*PartitionDefinition
+}
+
+// Format formats the node.
+func (node *PartitionSpec) Format(buf *TrackedBuffer) {
+	switch node.Action {
+	case ReorganizeStr:
+		buf.Myprintf("%s %v into (", node.Action, node.Name)
+		var prefix string
+		for _, pd := range node.Definitions {
+			buf.Myprintf("%s%v", prefix, pd)
+			prefix = ", "
+		}
+		buf.Myprintf(")")
+	default:
+		panic("unimplemented")
+	}
+}
+
+func (node *PartitionSpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if err := Walk(visit, node.Name); err != nil {
+		return err
+	}
+	for _, def := range node.Definitions {
+		if err := Walk(visit, def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PartitionDefinition describes a very minimal partition definition
+type PartitionDefinition struct {
+	Name     ColIdent
 	Limit    Expr
 	Maxvalue bool
 }
@@ -804,9 +1902,10 @@ func (node *PartitionDefinition) walkSubtree(visit Visit) error {
 
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
-	Columns []*ColumnDefinition
-	Indexes []*IndexDefinition
-	Options string
+	Columns     []*ColumnDefinition
+	Indexes     []*IndexDefinition
+	ForeignKeys []*ForeignKeyDefinition
+	Options     string
 }
 
 // Format formats the node.
@@ -822,6 +1921,9 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 	for _, idx := range ts.Indexes {
 		buf.Myprintf(",\n\t%v", idx)
 	}
+	for _, fk := range ts.ForeignKeys {
+		buf.Myprintf(",\n\t%v", fk)
+	}
 
 	buf.Myprintf("\n)%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
 }
@@ -836,6 +1938,11 @@ func (ts *TableSpec) AddIndex(id *IndexDefinition) {
 	ts.Indexes = append(ts.Indexes, id)
 }
 
+// AddForeignKey appends the given foreign key to the list in the spec
+func (ts *TableSpec) AddForeignKey(fk *ForeignKeyDefinition) {
+	ts.ForeignKeys = append(ts.ForeignKeys, fk)
+}
+
 func (ts *TableSpec) walkSubtree(visit Visit) error {
 	if ts == nil {
 		return nil
@@ -853,6 +1960,12 @@ func (ts *TableSpec) walkSubtree(visit Visit) error {
 		}
 	}
 
+	for _, n := range ts.ForeignKeys {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -904,6 +2017,9 @@ type ColumnType struct {
 	// Enum values
 	EnumValues []string
 
+	// Spatial field options
+	SRID *SQLVal
+
 	// Key specification
 	KeyOpt ColumnKeyOption
 }
@@ -930,6 +2046,9 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Zerofill {
 		opts = append(opts, keywordStrings[ZEROFILL])
 	}
+	if ct.SRID != nil {
+		opts = append(opts, "srid", String(ct.SRID))
+	}
 	if ct.Charset != "" {
 		opts = append(opts, keywordStrings[CHARACTER], keywordStrings[SET], ct.Charset)
 	}
@@ -1110,10 +2229,13 @@ func (idx *IndexDefinition) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v (", idx.Info)
 	for i, col := range idx.Columns {
 		if i != 0 {
-			buf.Myprintf(", %v", col.Column)
-		} else {
-			buf.Myprintf("%v", col.Column)
+			buf.Myprintf(", ")
+		}
+		if col.Expr != nil {
+			buf.Myprintf("(%v)", col.Expr)
+			continue
 		}
+		buf.Myprintf("%v", col.Column)
 		if col.Length != nil {
 			buf.Myprintf("(%v)", col.Length)
 		}
@@ -1136,7 +2258,7 @@ func (idx *IndexDefinition) walkSubtree(visit Visit) error {
 	}
 
 	for _, n := range idx.Columns {
-		if err := Walk(visit, n.Column); err != nil {
+		if err := Walk(visit, n.Column, n.Expr); err != nil {
 			return err
 		}
 	}
@@ -1146,30 +2268,102 @@ func (idx *IndexDefinition) walkSubtree(visit Visit) error {
 
 // IndexInfo describes the name and type of an index in a CREATE TABLE statement
 type IndexInfo struct {
-	Type    string
-	Name    ColIdent
-	Primary bool
-	Spatial bool
-	Unique  bool
+	Type     string
+	Name     ColIdent
+	Primary  bool
+	Spatial  bool
+	Fulltext bool
+	Unique   bool
 }
 
 // Format formats the node.
 func (ii *IndexInfo) Format(buf *TrackedBuffer) {
-	if ii.Primary {
+	if ii.Primary || ii.Name.IsEmpty() {
 		buf.Myprintf("%s", ii.Type)
 	} else {
 		buf.Myprintf("%s %v", ii.Type, ii.Name)
 	}
 }
 
+// newCreateIndexInfo returns the IndexInfo of the index named name
+// that a CREATE INDEX creates. kind is the word before INDEX, like
+// unique, or empty.
+func newCreateIndexInfo(kind string, name ColIdent) *IndexInfo {
+	info := &IndexInfo{Type: "index", Name: name}
+	switch kind = strings.ToLower(kind); kind {
+	case "unique":
+		info.Unique = true
+	case "fulltext":
+		info.Fulltext = true
+	case "spatial":
+		info.Spatial = true
+	default:
+		return info
+	}
+	info.Type = kind + " " + info.Type
+	return info
+}
+
+// setConstraintName names the index after the symbol of its CONSTRAINT
+// clause, like MySQL does, if it has no name of its own. The primary
+// key keeps its name.
+func (ii *IndexInfo) setConstraintName(name ColIdent) {
+	if !ii.Primary && ii.Name.IsEmpty() {
+		ii.Name = name
+	}
+}
+
 func (ii *IndexInfo) walkSubtree(visit Visit) error {
 	return Walk(visit, ii.Name)
 }
 
-// IndexColumn describes a column in an index definition with optional length
+// ForeignKeyDefinition describes a FOREIGN KEY of a CREATE TABLE or an
+// ALTER TABLE. Name is the name of the constraint, and IndexName the
+// one of the index that backs it: they're empty if the statement
+// doesn't name them. OnDelete and OnUpdate are the referential
+// actions, like cascade or set null, or empty.
+type ForeignKeyDefinition struct {
+	Name              ColIdent
+	IndexName         ColIdent
+	Source            Columns
+	ReferencedTable   TableName
+	ReferencedColumns Columns
+	OnDelete          string
+	OnUpdate          string
+}
+
+// Format formats the node.
+func (fk *ForeignKeyDefinition) Format(buf *TrackedBuffer) {
+	if !fk.Name.IsEmpty() {
+		buf.Myprintf("constraint %v ", fk.Name)
+	}
+	buf.Myprintf("foreign key ")
+	if !fk.IndexName.IsEmpty() {
+		buf.Myprintf("%v ", fk.IndexName)
+	}
+	buf.Myprintf("%v references %v %v", fk.Source, fk.ReferencedTable, fk.ReferencedColumns)
+	if fk.OnDelete != "" {
+		buf.Myprintf(" on delete %s", fk.OnDelete)
+	}
+	if fk.OnUpdate != "" {
+		buf.Myprintf(" on update %s", fk.OnUpdate)
+	}
+}
+
+func (fk *ForeignKeyDefinition) walkSubtree(visit Visit) error {
+	if fk == nil {
+		return nil
+	}
+	return Walk(visit, fk.Name, fk.IndexName, fk.Source, fk.ReferencedTable, fk.ReferencedColumns)
+}
+
+// IndexColumn describes a column in an index definition with optional length.
+// Expr is set instead of Column for a functional key part, like the
+// (cast(data -> '$.ids' as unsigned array)) of a multi-valued index.
 type IndexColumn struct {
 	Column ColIdent
 	Length *SQLVal
+	Expr   Expr
 }
 
 // LengthScaleOption is used for types that have an optional length
@@ -1277,6 +2471,8 @@ func (node VindexParam) walkSubtree(visit Visit) error {
 
 // Show represents a show statement.
 type Show struct {
+	statementSource
+
 	Type          string
 	OnTable       TableName
 	ShowTablesOpt *ShowTablesOpt
@@ -1314,7 +2510,7 @@ func (node *Show) Format(buf *TrackedBuffer) {
 
 // HasOnTable returns true if the show statement has an "on" clause
 func (node *Show) HasOnTable() bool {
-	return node.OnTable.Name.v != ""
+	return !node.OnTable.Name.IsEmpty()
 }
 
 func (node *Show) walkSubtree(visit Visit) error {
@@ -1350,12 +2546,14 @@ func (node *ShowFilter) walkSubtree(visit Visit) error {
 
 // Use represents a use statement.
 type Use struct {
+	statementSource
+
 	DBName TableIdent
 }
 
 // Format formats the node.
 func (node *Use) Format(buf *TrackedBuffer) {
-	if node.DBName.v != "" {
+	if !node.DBName.IsEmpty() {
 		buf.Myprintf("use %v", node.DBName)
 	} else {
 		buf.Myprintf("use")
@@ -1367,7 +2565,9 @@ func (node *Use) walkSubtree(visit Visit) error {
 }
 
 // Begin represents a Begin statement.
-type Begin struct{}
+type Begin struct {
+	statementSource
+}
 
 // Format formats the node.
 func (node *Begin) Format(buf *TrackedBuffer) {
@@ -1379,7 +2579,9 @@ func (node *Begin) walkSubtree(visit Visit) error {
 }
 
 // Commit represents a Commit statement.
-type Commit struct{}
+type Commit struct {
+	statementSource
+}
 
 // Format formats the node.
 func (node *Commit) Format(buf *TrackedBuffer) {
@@ -1391,7 +2593,9 @@ func (node *Commit) walkSubtree(visit Visit) error {
 }
 
 // Rollback represents a Rollback statement.
-type Rollback struct{}
+type Rollback struct {
+	statementSource
+}
 
 // Format formats the node.
 func (node *Rollback) Format(buf *TrackedBuffer) {
@@ -1402,10 +2606,13 @@ func (node *Rollback) walkSubtree(visit Visit) error {
 	return nil
 }
 
-// OtherRead represents a DESCRIBE, or EXPLAIN statement.
-// It should be used only as an indicator. It does not contain
-// the full AST for the statement.
-type OtherRead struct{}
+// OtherRead represents an EXPLAIN statement of a query, or its
+// synonyms DESCRIBE and DESC. It should be used only as an indicator.
+// It does not contain the full AST for the statement. The statements
+// that describe a table are parsed into DescribeTable.
+type OtherRead struct {
+	statementSource
+}
 
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
@@ -1416,11 +2623,50 @@ func (node *OtherRead) walkSubtree(visit Visit) error {
 	return nil
 }
 
-// OtherAdmin represents a misc statement that relies on ADMIN privileges,
-// such as REPAIR, OPTIMIZE, or TRUNCATE statement.
+// DescribeTable represents a DESCRIBE statement of a table, or its
+// synonyms DESC and EXPLAIN, like DESCRIBE t, DESC t col or
+// EXPLAIN t 'a%'. Column is set if it describes a single column, and
+// Wild if it describes the columns that match a LIKE pattern.
+type DescribeTable struct {
+	statementSource
+
+	Verb   string
+	Table  TableName
+	Column ColIdent
+	Wild   string
+}
+
+// DescribeTable.Verb
+const (
+	DescribeStr = "describe"
+	DescStr     = "desc"
+	ExplainStr  = "explain"
+)
+
+// Format formats the node.
+func (node *DescribeTable) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%s %v", node.Verb, node.Table)
+	if !node.Column.IsEmpty() {
+		buf.Myprintf(" %v", node.Column)
+	} else if node.Wild != "" {
+		buf.Myprintf(" %v", NewStrVal([]byte(node.Wild)))
+	}
+}
+
+func (node *DescribeTable) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Table, node.Column)
+}
+
+// OtherAdmin represents a misc statement that relies on ADMIN privileges.
 // It should be used only as an indicator. It does not contain
-// the full AST for the statement.
-type OtherAdmin struct{}
+// the full AST for the statement. REPAIR and OPTIMIZE statements
+// are parsed into TableMaintenance.
+type OtherAdmin struct {
+	statementSource
+}
 
 // Format formats the node.
 func (node *OtherAdmin) Format(buf *TrackedBuffer) {
@@ -1431,147 +2677,1231 @@ func (node *OtherAdmin) walkSubtree(visit Visit) error {
 	return nil
 }
 
-// Comments represents a list of comments.
-type Comments [][]byte
+// Replication represents a statement that administers replication or
+// the binary log, like CHANGE REPLICATION SOURCE TO, STOP REPLICA or
+// PURGE BINARY LOGS. Only its Action is parsed: the rest of the
+// statement is kept as written in Options.
+type Replication struct {
+	statementSource
+	Action  string
+	Options string
+}
 
-// Format formats the node.
-func (node Comments) Format(buf *TrackedBuffer) {
-	for _, c := range node {
-		buf.Myprintf("%s ", c)
+// Replication.Action
+const (
+	ChangeMasterStr            = "change master to"
+	ChangeReplicationSourceStr = "change replication source to"
+	ChangeReplicationFilterStr = "change replication filter"
+	StartSlaveStr              = "start slave"
+	StartReplicaStr            = "start replica"
+	StartGroupReplicationStr   = "start group_replication"
+	StopSlaveStr               = "stop slave"
+	StopReplicaStr             = "stop replica"
+	StopGroupReplicationStr    = "stop group_replication"
+	ResetMasterStr             = "reset master"
+	ResetSlaveStr              = "reset slave"
+	ResetReplicaStr            = "reset replica"
+	PurgeBinaryLogsStr         = "purge binary logs"
+	PurgeMasterLogsStr         = "purge master logs"
+)
+
+var replicationActions = []string{
+	ChangeMasterStr, ChangeReplicationSourceStr, ChangeReplicationFilterStr,
+	StartSlaveStr, StartReplicaStr, StartGroupReplicationStr,
+	StopSlaveStr, StopReplicaStr, StopGroupReplicationStr,
+	ResetMasterStr, ResetSlaveStr, ResetReplicaStr,
+	PurgeBinaryLogsStr, PurgeMasterLogsStr,
+}
+
+// newReplication returns the Replication statement of text, which
+// starts with verb. The action is the first of replicationActions text
+// starts with, ignoring case. If there's none, it's just verb, so that
+// the variants that aren't known still parse.
+func newReplication(verb, text string) *Replication {
+	for _, action := range replicationActions {
+		if rest, ok := cutKeywords(text, action); ok {
+			return &Replication{Action: action, Options: rest}
+		}
 	}
+	rest, _ := cutKeywords(text, verb)
+	return &Replication{Action: strings.ToLower(verb), Options: rest}
 }
 
-func (node Comments) walkSubtree(visit Visit) error {
-	return nil
+// cutKeywords returns text without its leading keywords, matched as
+// whole words and ignoring case, and true if it starts with them.
+func cutKeywords(text, keywords string) (string, bool) {
+	for _, word := range strings.Fields(keywords) {
+		text = strings.TrimSpace(text)
+		if len(text) < len(word) || !strings.EqualFold(text[:len(word)], word) {
+			return "", false
+		}
+		if len(text) > len(word) && (isLetter(uint16(text[len(word)])) || isDigit(uint16(text[len(word)]))) {
+			return "", false
+		}
+		text = text[len(word):]
+	}
+	return strings.TrimSpace(text), true
 }
 
-// SelectExprs represents SELECT expressions.
-type SelectExprs []SelectExpr
-
 // Format formats the node.
-func (node SelectExprs) Format(buf *TrackedBuffer) {
-	var prefix string
-	for _, n := range node {
-		buf.Myprintf("%s%v", prefix, n)
-		prefix = ", "
+func (node *Replication) Format(buf *TrackedBuffer) {
+	buf.WriteString(node.Action)
+	if node.Options != "" {
+		buf.WriteString(" ")
+		buf.writeVerbatim(node.Options)
 	}
 }
 
-func (node SelectExprs) walkSubtree(visit Visit) error {
-	for _, n := range node {
-		if err := Walk(visit, n); err != nil {
-			return err
-		}
-	}
+func (node *Replication) walkSubtree(visit Visit) error {
 	return nil
 }
 
-// SelectExpr represents a SELECT expression.
-type SelectExpr interface {
-	iSelectExpr()
-	SQLNode
-}
-
-func (*StarExpr) iSelectExpr()    {}
-func (*AliasedExpr) iSelectExpr() {}
-func (Nextval) iSelectExpr()      {}
+// Do represents a DO statement.
+type Do struct {
+	statementSource
 
-// StarExpr defines a '*' or 'table.*' expression.
-type StarExpr struct {
-	TableName TableName
+	Exprs Exprs
 }
 
 // Format formats the node.
-func (node *StarExpr) Format(buf *TrackedBuffer) {
-	if !node.TableName.IsEmpty() {
-		buf.Myprintf("%v.", node.TableName)
-	}
-	buf.Myprintf("*")
+func (node *Do) Format(buf *TrackedBuffer) {
+	buf.Myprintf("do %v", node.Exprs)
 }
 
-func (node *StarExpr) walkSubtree(visit Visit) error {
+func (node *Do) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
-	return Walk(
-		visit,
-		node.TableName,
-	)
+	return Walk(visit, node.Exprs)
 }
 
-// AliasedExpr defines an aliased SELECT expression.
-type AliasedExpr struct {
-	Expr Expr
-	As   ColIdent
+// Handler represents a HANDLER statement.
+// Index, Operator, Values, Where and Limit are only set for HandlerReadStr.
+// Operator is either a comparison operator, in which case Values is set,
+// or one of the read directions.
+type Handler struct {
+	statementSource
+
+	Action   string
+	Table    TableName
+	As       TableIdent
+	Index    ColIdent
+	Operator string
+	Values   ValTuple
+	Where    *Where
+	Limit    *Limit
 }
 
+// Handler.Action
+const (
+	HandlerOpenStr  = "open"
+	HandlerReadStr  = "read"
+	HandlerCloseStr = "close"
+)
+
+// Handler.Operator read directions
+const (
+	HandlerFirstStr = "first"
+	HandlerNextStr  = "next"
+	HandlerPrevStr  = "prev"
+	HandlerLastStr  = "last"
+)
+
 // Format formats the node.
-func (node *AliasedExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v", node.Expr)
-	if !node.As.IsEmpty() {
-		buf.Myprintf(" as %v", node.As)
+func (node *Handler) Format(buf *TrackedBuffer) {
+	buf.Myprintf("handler %v %s", node.Table, node.Action)
+	switch node.Action {
+	case HandlerOpenStr:
+		if !node.As.IsEmpty() {
+			buf.Myprintf(" as %v", node.As)
+		}
+	case HandlerReadStr:
+		if !node.Index.IsEmpty() {
+			buf.Myprintf(" %v", node.Index)
+		}
+		buf.Myprintf(" %s", node.Operator)
+		if node.Values != nil {
+			buf.Myprintf(" %v", node.Values)
+		}
+		buf.Myprintf("%v%v", node.Where, node.Limit)
 	}
 }
 
-func (node *AliasedExpr) walkSubtree(visit Visit) error {
+func (node *Handler) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
 	return Walk(
 		visit,
-		node.Expr,
+		node.Table,
 		node.As,
+		node.Index,
+		node.Values,
+		node.Where,
+		node.Limit,
 	)
 }
 
-// Nextval defines the NEXT VALUE expression.
-type Nextval struct {
-	Expr Expr
-}
-
-// Format formats the node.
-func (node Nextval) Format(buf *TrackedBuffer) {
-	buf.Myprintf("next %v values", node.Expr)
-}
+// Flush represents a FLUSH statement. If FlushOptions is empty,
+// the statement is a FLUSH TABLES.
+type Flush struct {
+	statementSource
 
-func (node Nextval) walkSubtree(visit Visit) error {
-	return Walk(visit, node.Expr)
+	IsLocal      bool
+	FlushOptions []string
+	TableNames   TableNames
+	WithLock     bool
+	ForExport    bool
 }
 
-// Columns represents an insert column list.
-type Columns []ColIdent
-
 // Format formats the node.
-func (node Columns) Format(buf *TrackedBuffer) {
-	if node == nil {
+func (node *Flush) Format(buf *TrackedBuffer) {
+	buf.WriteString("flush")
+	if node.IsLocal {
+		buf.WriteString(" local")
+	}
+	if len(node.FlushOptions) != 0 {
+		prefix := " "
+		for _, option := range node.FlushOptions {
+			buf.Myprintf("%s%s", prefix, option)
+			prefix = ", "
+		}
 		return
 	}
-	prefix := "("
-	for _, n := range node {
-		buf.Myprintf("%s%v", prefix, n)
-		prefix = ", "
+	buf.WriteString(" tables")
+	if len(node.TableNames) != 0 {
+		buf.Myprintf(" %v", node.TableNames)
+	}
+	if node.ForExport {
+		buf.WriteString(" for export")
+	}
+	if node.WithLock {
+		buf.WriteString(" with read lock")
 	}
-	buf.WriteString(")")
 }
 
-func (node Columns) walkSubtree(visit Visit) error {
-	for _, n := range node {
-		if err := Walk(visit, n); err != nil {
-			return err
-		}
+func (node *Flush) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
 	}
-	return nil
+	return Walk(visit, node.TableNames)
 }
 
-// FindColumn finds a column in the column list, returning
-// the index if it exists or -1 otherwise
-func (node Columns) FindColumn(col ColIdent) int {
-	for i, colName := range node {
-		if colName.Equal(col) {
-			return i
-		}
-	}
-	return -1
+// TableMaintenance represents an ANALYZE, CHECK, CHECKSUM, OPTIMIZE or REPAIR
+// TABLE statement. IsLocal is set by NO_WRITE_TO_BINLOG or LOCAL.
+// Options holds the lowercased options of CHECK and REPAIR, like
+// quick, extended or "for upgrade".
+type TableMaintenance struct {
+	statementSource
+
+	Action  string
+	IsLocal bool
+	Tables  TableNames
+	Options []string
+}
+
+// TableMaintenance strings.
+const (
+	AnalyzeStr  = "analyze"
+	CheckStr    = "check"
+	ChecksumStr = "checksum"
+	OptimizeStr = "optimize"
+	RepairStr   = "repair"
+)
+
+// maintenanceOptions are the options that each
+// TableMaintenance action accepts.
+var maintenanceOptions = map[string]map[string]bool{
+	CheckStr: {
+		"for upgrade": true,
+		"quick":       true,
+		"fast":        true,
+		"medium":      true,
+		"extended":    true,
+		"changed":     true,
+	},
+	ChecksumStr: {
+		"quick":    true,
+		"extended": true,
+	},
+	RepairStr: {
+		"quick":    true,
+		"extended": true,
+		"use_frm":  true,
+	},
+}
+
+// invalidMaintenanceOption returns the first of options that
+// action doesn't accept, or "" if it accepts all of them.
+func invalidMaintenanceOption(action string, options []string) string {
+	for _, option := range options {
+		if !maintenanceOptions[action][option] {
+			return option
+		}
+	}
+	return ""
+}
+
+// Format formats the node.
+func (node *TableMaintenance) Format(buf *TrackedBuffer) {
+	buf.WriteString(node.Action)
+	if node.IsLocal {
+		buf.WriteString(" local")
+	}
+	buf.Myprintf(" table %v", node.Tables)
+	for _, option := range node.Options {
+		buf.Myprintf(" %s", option)
+	}
+}
+
+func (node *TableMaintenance) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Tables)
+}
+
+// IndexCache represents a CACHE INDEX statement, which assigns the
+// indexes of tables to the key cache KeyCache, or a LOAD INDEX INTO
+// CACHE statement, which preloads them.
+type IndexCache struct {
+	statementSource
+
+	Action   string
+	Tables   IndexCacheTables
+	KeyCache ColIdent
+}
+
+// IndexCache.Action
+const (
+	CacheIndexStr = "cache index"
+	LoadIndexStr  = "load index into cache"
+)
+
+// Format formats the node.
+func (node *IndexCache) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%s %v", node.Action, node.Tables)
+	if node.Action == CacheIndexStr {
+		buf.Myprintf(" in %v", node.KeyCache)
+	}
+}
+
+func (node *IndexCache) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Tables, node.KeyCache)
+}
+
+// IndexCacheTables is the list of tables of an IndexCache.
+type IndexCacheTables []*IndexCacheTable
+
+// Format formats the node.
+func (node IndexCacheTables) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node IndexCacheTables) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexCacheTable represents a table of an IndexCache, with the
+// partitions and the indexes it's restricted to, if any. AllPartitions
+// is set for PARTITION (ALL). IgnoreLeaves, for LOAD INDEX INTO CACHE,
+// preloads only the nonleaf nodes of the indexes.
+type IndexCacheTable struct {
+	Table         TableName
+	Partitions    Partitions
+	AllPartitions bool
+	Indexes       []ColIdent
+	IgnoreLeaves  bool
+}
+
+// Format formats the node.
+func (node *IndexCacheTable) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v", node.Table)
+	if node.AllPartitions {
+		buf.WriteString(" partition (all)")
+	} else {
+		buf.Myprintf("%v", node.Partitions)
+	}
+	if node.Indexes != nil {
+		prefix := " index ("
+		for _, n := range node.Indexes {
+			buf.Myprintf("%s%v", prefix, n)
+			prefix = ", "
+		}
+		buf.WriteString(")")
+	}
+	if node.IgnoreLeaves {
+		buf.WriteString(" ignore leaves")
+	}
+}
+
+func (node *IndexCacheTable) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if err := Walk(visit, node.Table, node.Partitions); err != nil {
+		return err
+	}
+	for _, n := range node.Indexes {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Kill represents a KILL statement.
+type Kill struct {
+	statementSource
+
+	Type          string
+	ProcesslistID Expr
+}
+
+// Kill.Type
+const (
+	KillConnectionStr = "connection"
+	KillQueryStr      = "query"
+)
+
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	if node.Type == "" {
+		buf.Myprintf("kill %v", node.ProcesslistID)
+		return
+	}
+	buf.Myprintf("kill %s %v", node.Type, node.ProcesslistID)
+}
+
+func (node *Kill) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.ProcesslistID)
+}
+
+// XATransaction represents an XA transaction statement.
+// Xid is nil for XA RECOVER.
+type XATransaction struct {
+	statementSource
+
+	Action   string
+	Xid      *Xid
+	Option   string
+	OnePhase bool
+}
+
+// XATransaction.Action
+const (
+	XAStartStr    = "start"
+	XAEndStr      = "end"
+	XAPrepareStr  = "prepare"
+	XACommitStr   = "commit"
+	XARollbackStr = "rollback"
+	XARecoverStr  = "recover"
+)
+
+// XATransaction.Option
+const (
+	XAJoinStr              = "join"
+	XAResumeStr            = "resume"
+	XASuspendStr           = "suspend"
+	XASuspendForMigrateStr = "suspend for migrate"
+	XAConvertXidStr        = "convert xid"
+)
+
+// Format formats the node.
+func (node *XATransaction) Format(buf *TrackedBuffer) {
+	buf.Myprintf("xa %s", node.Action)
+	if node.Xid != nil {
+		buf.Myprintf(" %v", node.Xid)
+	}
+	if node.Option != "" {
+		buf.Myprintf(" %s", node.Option)
+	}
+	if node.OnePhase {
+		buf.WriteString(" one phase")
+	}
+}
+
+func (node *XATransaction) walkSubtree(visit Visit) error {
+	if node == nil || node.Xid == nil {
+		return nil
+	}
+	return Walk(visit, node.Xid)
+}
+
+// Xid represents the identifier of an XA transaction.
+// Bqual and FormatID are optional.
+type Xid struct {
+	Gtrid    Expr
+	Bqual    Expr
+	FormatID Expr
+}
+
+// Format formats the node.
+func (node *Xid) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v", node.Gtrid)
+	if node.Bqual != nil {
+		buf.Myprintf(", %v", node.Bqual)
+	}
+	if node.FormatID != nil {
+		buf.Myprintf(", %v", node.FormatID)
+	}
+}
+
+func (node *Xid) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Gtrid,
+		node.Bqual,
+		node.FormatID,
+	)
+}
+
+// LockTables represents a LOCK TABLES statement.
+type LockTables struct {
+	statementSource
+
+	Tables TableLocks
+}
+
+// Format formats the node.
+func (node *LockTables) Format(buf *TrackedBuffer) {
+	buf.Myprintf("lock tables %v", node.Tables)
+}
+
+func (node *LockTables) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Tables)
+}
+
+// TableLocks is a list of table locks.
+type TableLocks []*TableLock
+
+// Format formats the node.
+func (node TableLocks) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node TableLocks) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TableLock represents a table and its lock type in LOCK TABLES.
+type TableLock struct {
+	Table TableName
+	As    TableIdent
+	Lock  string
+}
+
+// TableLock.Lock
+const (
+	LockReadStr             = "read"
+	LockReadLocalStr        = "read local"
+	LockWriteStr            = "write"
+	LockLowPriorityWriteStr = "low_priority write"
+)
+
+// Format formats the node.
+func (node *TableLock) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v", node.Table)
+	if !node.As.IsEmpty() {
+		buf.Myprintf(" as %v", node.As)
+	}
+	buf.Myprintf(" %s", node.Lock)
+}
+
+func (node *TableLock) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Table, node.As)
+}
+
+// UnlockTables represents an UNLOCK TABLES statement.
+type UnlockTables struct {
+	statementSource
+}
+
+// Format formats the node.
+func (node *UnlockTables) Format(buf *TrackedBuffer) {
+	buf.WriteString("unlock tables")
+}
+
+func (node *UnlockTables) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// Call represents a CALL statement. ODBC is set if it was
+// written as an ODBC escape, like {call p(1)}.
+type Call struct {
+	statementSource
+
+	Name   TableName
+	Params Exprs
+	ODBC   bool
+}
+
+// Format formats the node.
+func (node *Call) Format(buf *TrackedBuffer) {
+	if node.ODBC {
+		buf.Myprintf("{call %v(%v)}", node.Name, node.Params)
+		return
+	}
+	buf.Myprintf("call %v(%v)", node.Name, node.Params)
+}
+
+func (node *Call) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Params)
+}
+
+// Prepare represents a PREPARE statement. Stmt is the text of the
+// statement to prepare: a string literal, a *ColName if it's in
+// a user variable, or a *FuncExpr, like CONCAT(...), in MariaDB.
+type Prepare struct {
+	statementSource
+
+	Name ColIdent
+	Stmt Expr
+}
+
+// Format formats the node.
+func (node *Prepare) Format(buf *TrackedBuffer) {
+	buf.Myprintf("prepare %v from %v", node.Name, node.Stmt)
+}
+
+func (node *Prepare) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Stmt)
+}
+
+// Text returns the text of the statement to prepare,
+// if it's a string literal.
+func (node *Prepare) Text() (string, bool) {
+	val, ok := node.Stmt.(*SQLVal)
+	if !ok || val.Type != StrVal {
+		return "", false
+	}
+	return string(val.Val), true
+}
+
+// ParseStmt parses the text of the statement to prepare. Its ?
+// placeholders become bind variables, like in Parse. It returns
+// nil if the text is not a literal. The text is only parsed
+// when ParseStmt is called, so that a PREPARE statement parses
+// even if the statement it prepares doesn't.
+func (node *Prepare) ParseStmt() (Statement, error) {
+	text, ok := node.Text()
+	if !ok {
+		return nil, nil
+	}
+	return Parse(text)
+}
+
+// Execute represents an EXECUTE statement. Using holds
+// the user variables of the USING clause, like @a.
+//
+// Immediate is set for the EXECUTE IMMEDIATE of MariaDB, which
+// prepares and executes its statement text at once: it's a string
+// literal, a *ColName for a user variable or a *FuncExpr, and Name
+// is empty.
+type Execute struct {
+	statementSource
+
+	Name      ColIdent
+	Immediate Expr
+	Using     []ColIdent
+}
+
+// Format formats the node.
+func (node *Execute) Format(buf *TrackedBuffer) {
+	if node.Immediate != nil {
+		buf.Myprintf("execute immediate %v", node.Immediate)
+	} else {
+		buf.Myprintf("execute %v", node.Name)
+	}
+	prefix := " using "
+	for _, v := range node.Using {
+		buf.Myprintf("%s%v", prefix, v)
+		prefix = ", "
+	}
+}
+
+func (node *Execute) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if err := Walk(visit, node.Name, node.Immediate); err != nil {
+		return err
+	}
+	for _, v := range node.Using {
+		if err := Walk(visit, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deallocate represents a DEALLOCATE PREPARE statement,
+// which can also be written DROP PREPARE.
+type Deallocate struct {
+	statementSource
+
+	Name ColIdent
+}
+
+// Format formats the node.
+func (node *Deallocate) Format(buf *TrackedBuffer) {
+	buf.Myprintf("deallocate prepare %v", node.Name)
+}
+
+func (node *Deallocate) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
+// CreateTrigger represents a CREATE TRIGGER statement.
+// Body is the parsed statement the trigger runs. If the
+// trigger runs a BEGIN ... END block, Body is nil and the
+// block is kept as RawBody.
+type CreateTrigger struct {
+	statementSource
+
+	Definer string
+	Name    TableName
+	Timing  string
+	// Event is InsertStr, UpdateStr or DeleteStr.
+	Event string
+	Table TableName
+	// Order is FollowsStr or PrecedesStr if the trigger is
+	// ordered relative to OtherTrigger.
+	Order        string
+	OtherTrigger TableIdent
+	Body         Statement
+	RawBody      string
+}
+
+// CreateTrigger strings.
+const (
+	BeforeStr   = "before"
+	AfterStr    = "after"
+	UpdateStr   = "update"
+	DeleteStr   = "delete"
+	FollowsStr  = "follows"
+	PrecedesStr = "precedes"
+)
+
+// Format formats the node.
+func (node *CreateTrigger) Format(buf *TrackedBuffer) {
+	buf.Myprintf("create ")
+	if node.Definer != "" {
+		buf.Myprintf("definer = %s ", node.Definer)
+	}
+	buf.Myprintf("trigger %v %s %s on %v for each row ", node.Name, node.Timing, node.Event, node.Table)
+	if node.Order != "" {
+		buf.Myprintf("%s %v ", node.Order, node.OtherTrigger)
+	}
+	if node.Body != nil {
+		buf.Myprintf("%v", node.Body)
+		return
+	}
+	buf.writeVerbatim(node.RawBody)
+}
+
+func (node *CreateTrigger) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Table, node.OtherTrigger, node.Body)
+}
+
+// formatAccountName formats the parts of an account name, like
+// root, @localhost, as `root`@`localhost`.
+func formatAccountName(parts []string) string {
+	name, host := strings.Join(parts, ""), ""
+	if i := strings.LastIndexByte(name, '@'); i > 0 {
+		name, host = name[:i], name[i+1:]
+	}
+	quote := func(s string) string {
+		return "`" + strings.Replace(s, "`", "``", -1) + "`"
+	}
+	if host == "" {
+		return quote(name)
+	}
+	return quote(name) + "@" + quote(host)
+}
+
+// DropTrigger represents a DROP TRIGGER statement.
+type DropTrigger struct {
+	statementSource
+
+	IfExists bool
+	Name     TableName
+}
+
+// Format formats the node.
+func (node *DropTrigger) Format(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.Myprintf("drop trigger%s %v", exists, node.Name)
+}
+
+func (node *DropTrigger) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
+// CreateEvent represents a CREATE EVENT statement. The schedule
+// is not interpreted and is kept as text, like "every 1 day".
+// Body and RawBody are as in CreateTrigger.
+type CreateEvent struct {
+	statementSource
+
+	Definer     string
+	IfNotExists bool
+	Name        TableName
+	Schedule    string
+	// OnCompletion is PreserveStr, NotPreserveStr or empty.
+	OnCompletion string
+	// Status is EnableStr, DisableStr, DisableOnSlaveStr or empty.
+	Status  string
+	Comment *SQLVal
+	Body    Statement
+	RawBody string
+}
+
+// CreateEvent strings.
+const (
+	PreserveStr       = "preserve"
+	NotPreserveStr    = "not preserve"
+	EnableStr         = "enable"
+	DisableStr        = "disable"
+	DisableOnSlaveStr = "disable on slave"
+)
+
+// Format formats the node.
+func (node *CreateEvent) Format(buf *TrackedBuffer) {
+	buf.Myprintf("create ")
+	if node.Definer != "" {
+		buf.Myprintf("definer = %s ", node.Definer)
+	}
+	notExists := ""
+	if node.IfNotExists {
+		notExists = "if not exists "
+	}
+	buf.Myprintf("event %s%v on schedule %s ", notExists, node.Name, node.Schedule)
+	if node.OnCompletion != "" {
+		buf.Myprintf("on completion %s ", node.OnCompletion)
+	}
+	if node.Status != "" {
+		buf.Myprintf("%s ", node.Status)
+	}
+	if node.Comment != nil {
+		buf.Myprintf("comment %v ", node.Comment)
+	}
+	if node.Body != nil {
+		buf.Myprintf("do %v", node.Body)
+		return
+	}
+	buf.WriteString("do ")
+	buf.writeVerbatim(node.RawBody)
+}
+
+func (node *CreateEvent) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if node.Comment == nil {
+		return Walk(visit, node.Name, node.Body)
+	}
+	return Walk(visit, node.Name, node.Comment, node.Body)
+}
+
+// DropEvent represents a DROP EVENT statement.
+type DropEvent struct {
+	statementSource
+
+	IfExists bool
+	Name     TableName
+}
+
+// Format formats the node.
+func (node *DropEvent) Format(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.Myprintf("drop event%s %v", exists, node.Name)
+}
+
+func (node *DropEvent) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
+// CreateProcedure represents a CREATE PROCEDURE statement.
+// Body and RawBody are as in CreateTrigger.
+type CreateProcedure struct {
+	statementSource
+
+	Definer         string
+	IfNotExists     bool
+	Name            TableName
+	Params          ProcParams
+	Characteristics []string
+	Body            Statement
+	RawBody         string
+}
+
+// Format formats the node.
+func (node *CreateProcedure) Format(buf *TrackedBuffer) {
+	buf.Myprintf("create ")
+	if node.Definer != "" {
+		buf.Myprintf("definer = %s ", node.Definer)
+	}
+	notExists := ""
+	if node.IfNotExists {
+		notExists = "if not exists "
+	}
+	buf.Myprintf("procedure %s%v(%v) ", notExists, node.Name, node.Params)
+	formatRoutineBody(buf, node.Characteristics, node.Body, node.RawBody)
+}
+
+func (node *CreateProcedure) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Params, node.Body)
+}
+
+// CreateFunction represents a CREATE FUNCTION statement. The
+// body, a RETURN statement or a BEGIN ... END block, is kept
+// as RawBody.
+type CreateFunction struct {
+	statementSource
+
+	Definer         string
+	IfNotExists     bool
+	Name            TableName
+	Params          ProcParams
+	Returns         ColumnType
+	Characteristics []string
+	RawBody         string
+}
+
+// Format formats the node.
+func (node *CreateFunction) Format(buf *TrackedBuffer) {
+	buf.Myprintf("create ")
+	if node.Definer != "" {
+		buf.Myprintf("definer = %s ", node.Definer)
+	}
+	notExists := ""
+	if node.IfNotExists {
+		notExists = "if not exists "
+	}
+	buf.Myprintf("function %s%v(%v) returns %v ", notExists, node.Name, node.Params, &node.Returns)
+	formatRoutineBody(buf, node.Characteristics, nil, node.RawBody)
+}
+
+func (node *CreateFunction) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Params, &node.Returns)
+}
+
+// formatRoutineBody formats the characteristics and the
+// body of a stored routine.
+func formatRoutineBody(buf *TrackedBuffer, characteristics []string, body Statement, rawBody string) {
+	for _, c := range characteristics {
+		buf.Myprintf("%s ", c)
+	}
+	if body != nil {
+		buf.Myprintf("%v", body)
+		return
+	}
+	buf.writeVerbatim(rawBody)
+}
+
+// ProcParams represents the parameters of a stored routine.
+type ProcParams []*ProcParam
+
+// Format formats the node.
+func (node ProcParams) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node ProcParams) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcParam represents a parameter of a stored routine.
+// Mode is InStr, OutStr, InOutStr, or empty if it isn't given.
+type ProcParam struct {
+	Mode string
+	Name ColIdent
+	Type ColumnType
+}
+
+// ProcParam.Mode
+const (
+	OutStr   = "out"
+	InOutStr = "inout"
+)
+
+// Format formats the node.
+func (node *ProcParam) Format(buf *TrackedBuffer) {
+	if node.Mode != "" {
+		buf.Myprintf("%s ", node.Mode)
+	}
+	buf.Myprintf("%v %v", node.Name, &node.Type)
+}
+
+func (node *ProcParam) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, &node.Type)
+}
+
+// DropRoutine represents a DROP PROCEDURE or DROP FUNCTION
+// statement.
+type DropRoutine struct {
+	statementSource
+
+	Type     string
+	IfExists bool
+	Name     TableName
+}
+
+// DropRoutine.Type
+const (
+	ProcedureStr = "procedure"
+	FunctionStr  = "function"
+)
+
+// Format formats the node.
+func (node *DropRoutine) Format(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.Myprintf("drop %s%s %v", node.Type, exists, node.Name)
+}
+
+func (node *DropRoutine) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
+// Comments represents a list of comments.
+type Comments [][]byte
+
+// Format formats the node.
+func (node Comments) Format(buf *TrackedBuffer) {
+	for _, c := range node {
+		buf.Myprintf("%s ", c)
+	}
+}
+
+func (node Comments) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// SelectExprs represents SELECT expressions.
+type SelectExprs []SelectExpr
+
+// Format formats the node.
+func (node SelectExprs) Format(buf *TrackedBuffer) {
+	for i, n := range node {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.formatNode(n)
+	}
+}
+
+func (node SelectExprs) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SelectExpr represents a SELECT expression.
+type SelectExpr interface {
+	iSelectExpr()
+	SQLNode
+}
+
+func (*StarExpr) iSelectExpr()    {}
+func (*AliasedExpr) iSelectExpr() {}
+func (Nextval) iSelectExpr()      {}
+
+// StarExpr defines a '*' or 'table.*' expression.
+type StarExpr struct {
+	TableName TableName
+}
+
+// Format formats the node.
+func (node *StarExpr) Format(buf *TrackedBuffer) {
+	if !node.TableName.IsEmpty() {
+		buf.Myprintf("%v.", node.TableName)
+	}
+	buf.Myprintf("*")
+}
+
+func (node *StarExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.TableName,
+	)
+}
+
+// AliasedExpr defines an aliased SELECT expression.
+type AliasedExpr struct {
+	Expr Expr
+	As   ColIdent
+}
+
+// Format formats the node.
+func (node *AliasedExpr) Format(buf *TrackedBuffer) {
+	buf.formatNode(node.Expr)
+	if !node.As.IsEmpty() {
+		if buf.Style.OmitAs {
+			buf.WriteByte(' ')
+		} else {
+			buf.WriteString(" as ")
+		}
+		if buf.nodeFormatter == nil {
+			node.As.Format(buf)
+		} else {
+			buf.formatNode(node.As)
+		}
+	}
+}
+
+func (node *AliasedExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+		node.As,
+	)
+}
+
+// Nextval defines the NEXT VALUE expression.
+type Nextval struct {
+	Expr Expr
+}
+
+// Format formats the node.
+func (node Nextval) Format(buf *TrackedBuffer) {
+	buf.Myprintf("next %v values", node.Expr)
+}
+
+func (node Nextval) walkSubtree(visit Visit) error {
+	return Walk(visit, node.Expr)
+}
+
+// Columns represents an insert column list.
+type Columns []ColIdent
+
+// Format formats the node.
+func (node Columns) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteByte('(')
+	for i, n := range node {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if buf.nodeFormatter == nil {
+			n.Format(buf)
+		} else {
+			buf.formatNode(n)
+		}
+	}
+	buf.WriteByte(')')
+}
+
+func (node Columns) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindColumn finds a column in the column list, returning
+// the index if it exists or -1 otherwise
+func (node Columns) FindColumn(col ColIdent) int {
+	for i, colName := range node {
+		if colName.Equal(col) {
+			return i
+		}
+	}
+	return -1
 }
 
 // Partitions is a type alias for Columns so we can handle printing efficiently
@@ -1604,10 +3934,11 @@ type TableExprs []TableExpr
 
 // Format formats the node.
 func (node TableExprs) Format(buf *TrackedBuffer) {
-	var prefix string
-	for _, n := range node {
-		buf.Myprintf("%s%v", prefix, n)
-		prefix = ", "
+	for i, n := range node {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.formatNode(n)
 	}
 }
 
@@ -1637,19 +3968,21 @@ type AliasedTableExpr struct {
 	Expr       SimpleTableExpr
 	Partitions Partitions
 	As         TableIdent
-	Hints      *IndexHints
+	Hints      IndexHintList
 }
 
 // Format formats the node.
 func (node *AliasedTableExpr) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v%v", node.Expr, node.Partitions)
 	if !node.As.IsEmpty() {
-		buf.Myprintf(" as %v", node.As)
-	}
-	if node.Hints != nil {
-		// Hint node provides the space padding.
-		buf.Myprintf("%v", node.Hints)
+		if buf.Style.OmitAs {
+			buf.Myprintf(" %v", node.As)
+		} else {
+			buf.Myprintf(" as %v", node.As)
+		}
 	}
+	// Hint nodes provide the space padding.
+	buf.Myprintf("%v", node.Hints)
 }
 
 func (node *AliasedTableExpr) walkSubtree(visit Visit) error {
@@ -1715,10 +4048,18 @@ func (node TableName) Format(buf *TrackedBuffer) {
 	if node.IsEmpty() {
 		return
 	}
+	if buf.nodeFormatter != nil {
+		if !node.Qualifier.IsEmpty() {
+			buf.Myprintf("%v.", node.Qualifier)
+		}
+		buf.Myprintf("%v", node.Name)
+		return
+	}
 	if !node.Qualifier.IsEmpty() {
-		buf.Myprintf("%v.", node.Qualifier)
+		node.Qualifier.Format(buf)
+		buf.WriteByte('.')
 	}
-	buf.Myprintf("%v", node.Name)
+	node.Name.Format(buf)
 }
 
 func (node TableName) walkSubtree(visit Visit) error {
@@ -1729,6 +4070,13 @@ func (node TableName) walkSubtree(visit Visit) error {
 	)
 }
 
+// String returns the table name as it's formatted, with quotes
+// around the names that need them, so that ParseTableName parses
+// it back. Its Name and Qualifier return the names unquoted.
+func (node TableName) String() string {
+	return String(node)
+}
+
 // IsEmpty returns true if TableName is nil or empty.
 func (node TableName) IsEmpty() bool {
 	// If Name is empty, Qualifer is also empty.
@@ -1741,17 +4089,25 @@ func (node TableName) IsEmpty() bool {
 func (node TableName) ToViewName() TableName {
 	return TableName{
 		Qualifier: node.Qualifier,
-		Name:      NewTableIdent(strings.ToLower(node.Name.v)),
+		Name:      NewTableIdent(node.Name.Lowered()),
 	}
 }
 
 // ParenTableExpr represents a parenthesized list of TableExpr.
+// ODBC is set if it was written as an ODBC outer join escape,
+// like {oj a left join b on a.id = b.id}, which holds a single
+// TableExpr.
 type ParenTableExpr struct {
 	Exprs TableExprs
+	ODBC  bool
 }
 
 // Format formats the node.
 func (node *ParenTableExpr) Format(buf *TrackedBuffer) {
+	if node.ODBC {
+		buf.Myprintf("{oj %v}", node.Exprs)
+		return
+	}
 	buf.Myprintf("(%v)", node.Exprs)
 }
 
@@ -1791,9 +4147,13 @@ func (node JoinCondition) walkSubtree(visit Visit) error {
 }
 
 // JoinTableExpr represents a TableExpr that's a JOIN operation.
+// Inner and Outer are set if the optional INNER or OUTER keyword is
+// written, like in LEFT OUTER JOIN, so that it's formatted as written.
 type JoinTableExpr struct {
 	LeftExpr  TableExpr
 	Join      string
+	Inner     bool
+	Outer     bool
 	RightExpr TableExpr
 	Condition JoinCondition
 }
@@ -1812,7 +4172,44 @@ const (
 
 // Format formats the node.
 func (node *JoinTableExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v", node.LeftExpr, node.Join, node.RightExpr, node.Condition)
+	buf.Myprintf("%v %s %v%v", node.LeftExpr, node.keyword(), node.RightExpr, node.Condition)
+}
+
+// keyword returns the join keyword of node, with the INNER or OUTER
+// keyword if it's written and the type of the join can have it.
+func (node *JoinTableExpr) keyword() string {
+	if node.Inner {
+		switch node.Join {
+		case JoinStr:
+			return "inner join"
+		case NaturalJoinStr:
+			return "natural inner join"
+		}
+	}
+	if node.Outer {
+		switch node.Join {
+		case LeftJoinStr:
+			return "left outer join"
+		case RightJoinStr:
+			return "right outer join"
+		case NaturalLeftJoinStr:
+			return "natural left outer join"
+		case NaturalRightJoinStr:
+			return "natural right outer join"
+		}
+	}
+	return node.Join
+}
+
+// IsNatural returns true if node is a NATURAL join. Natural joins
+// merge the columns the tables have in common, so they can't have
+// a Condition.
+func (node *JoinTableExpr) IsNatural() bool {
+	switch node.Join {
+	case NaturalJoinStr, NaturalLeftJoinStr, NaturalRightJoinStr:
+		return true
+	}
+	return false
 }
 
 func (node *JoinTableExpr) walkSubtree(visit Visit) error {
@@ -1827,23 +4224,65 @@ func (node *JoinTableExpr) walkSubtree(visit Visit) error {
 	)
 }
 
-// IndexHints represents a list of index hints.
+// IndexHintList represents the index hints of a table.
+type IndexHintList []*IndexHints
+
+// Format formats the node.
+func (node IndexHintList) Format(buf *TrackedBuffer) {
+	for _, n := range node {
+		buf.Myprintf("%v", n)
+	}
+}
+
+func (node IndexHintList) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexHints represents an index hint, like USE INDEX (a, b).
 type IndexHints struct {
-	Type    string
+	Type string
+	// Keyword is KeyStr if the hint was written with KEY, which
+	// is a synonym of INDEX, and IndexStr otherwise.
+	Keyword string
+	// For is the part of the query the hint applies to, or empty
+	// if it applies to all of them.
+	For     string
 	Indexes []ColIdent
 }
 
-// Index hints.
+// IndexHints.Type
 const (
 	UseStr    = "use "
 	IgnoreStr = "ignore "
 	ForceStr  = "force "
 )
 
+// IndexHints.Keyword
+const (
+	IndexStr = "index"
+	KeyStr   = "key"
+)
+
+// IndexHints.For
+const (
+	ForJoinStr    = " for join"
+	ForOrderByStr = " for order by"
+	ForGroupByStr = " for group by"
+)
+
 // Format formats the node.
 func (node *IndexHints) Format(buf *TrackedBuffer) {
-	buf.Myprintf(" %sindex ", node.Type)
-	prefix := "("
+	keyword := node.Keyword
+	if keyword == "" {
+		keyword = IndexStr
+	}
+	buf.Myprintf(" %s%s%s (", node.Type, keyword, node.For)
+	prefix := ""
 	for _, n := range node.Indexes {
 		buf.Myprintf("%s%v", prefix, n)
 		prefix = ", "
@@ -1889,7 +4328,10 @@ func (node *Where) Format(buf *TrackedBuffer) {
 	if node == nil || node.Expr == nil {
 		return
 	}
-	buf.Myprintf(" %s %v", node.Type, node.Expr)
+	buf.WriteByte(' ')
+	buf.WriteString(node.Type)
+	buf.WriteByte(' ')
+	buf.formatNode(node.Expr)
 }
 
 func (node *Where) walkSubtree(visit Visit) error {
@@ -1919,6 +4361,7 @@ func (*ParenExpr) iExpr()        {}
 func (*ComparisonExpr) iExpr()   {}
 func (*RangeCond) iExpr()        {}
 func (*IsExpr) iExpr()           {}
+func (*EmptyInExpr) iExpr()      {}
 func (*ExistsExpr) iExpr()       {}
 func (*SQLVal) iExpr()           {}
 func (*NullVal) iExpr()          {}
@@ -1934,11 +4377,17 @@ func (*CollateExpr) iExpr()      {}
 func (*FuncExpr) iExpr()         {}
 func (*CaseExpr) iExpr()         {}
 func (*ValuesFuncExpr) iExpr()   {}
+func (*NextValueExpr) iExpr()    {}
 func (*ConvertExpr) iExpr()      {}
 func (*SubstrExpr) iExpr()       {}
+func (*ExtractExpr) iExpr()      {}
+func (*PositionExpr) iExpr()     {}
+func (*TrimExpr) iExpr()         {}
+func (*WeightStringExpr) iExpr() {}
 func (*ConvertUsingExpr) iExpr() {}
 func (*MatchExpr) iExpr()        {}
 func (*GroupConcatExpr) iExpr()  {}
+func (*ExtensionExpr) iExpr()    {}
 func (*Default) iExpr()          {}
 
 // ReplaceExpr finds the from expression from root
@@ -1976,10 +4425,11 @@ type Exprs []Expr
 
 // Format formats the node.
 func (node Exprs) Format(buf *TrackedBuffer) {
-	var prefix string
-	for _, n := range node {
-		buf.Myprintf("%s%v", prefix, n)
-		prefix = ", "
+	for i, n := range node {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.formatNode(n)
 	}
 }
 
@@ -1999,7 +4449,9 @@ type AndExpr struct {
 
 // Format formats the node.
 func (node *AndExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v and %v", node.Left, node.Right)
+	buf.formatNode(node.Left)
+	buf.WriteString(" and ")
+	buf.formatNode(node.Right)
 }
 
 func (node *AndExpr) walkSubtree(visit Visit) error {
@@ -2024,7 +4476,9 @@ type OrExpr struct {
 
 // Format formats the node.
 func (node *OrExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v or %v", node.Left, node.Right)
+	buf.formatNode(node.Left)
+	buf.WriteString(" or ")
+	buf.formatNode(node.Right)
 }
 
 func (node *OrExpr) walkSubtree(visit Visit) error {
@@ -2073,7 +4527,9 @@ type ParenExpr struct {
 
 // Format formats the node.
 func (node *ParenExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("(%v)", node.Expr)
+	buf.WriteByte('(')
+	buf.formatNode(node.Expr)
+	buf.WriteByte(')')
 }
 
 func (node *ParenExpr) walkSubtree(visit Visit) error {
@@ -2114,11 +4570,20 @@ const (
 	NotRegexpStr         = "not regexp"
 	JSONExtractOp        = "->"
 	JSONUnquoteExtractOp = "->>"
+	// MemberOfStr is the MEMBER OF of a value in a JSON array, whose
+	// Right is the array without the parentheses.
+	MemberOfStr = "member of"
 )
 
 // Format formats the node.
 func (node *ComparisonExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v", node.Left, node.Operator, node.Right)
+	buf.formatNode(node.Left)
+	if node.Operator == MemberOfStr {
+		buf.Myprintf(" %s (%v)", node.Operator, node.Right)
+		return
+	}
+	buf.formatOperator(node.Operator, node.Right)
+	buf.formatNode(node.Right)
 	if node.Escape != nil {
 		buf.Myprintf(" escape %v", node.Escape)
 	}
@@ -2140,6 +4605,34 @@ func (node *ComparisonExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Left, &node.Right, &node.Escape)
 }
 
+// EmptyInExpr is an IN or a NOT IN with an empty list, like a IN (),
+// parsed with the EmptyInLists option of ParseOptions. It's always
+// false, or true for NOT IN, and is formatted as such, since MySQL
+// rejects empty lists. Left and Operator only record the original
+// form: Left isn't formatted, and Walk doesn't visit it.
+type EmptyInExpr struct {
+	Left     Expr
+	Operator string
+}
+
+// Value returns the constant the expression stands for.
+func (node *EmptyInExpr) Value() BoolVal {
+	return node.Operator == NotInStr
+}
+
+// Format formats the node.
+func (node *EmptyInExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v", node.Value())
+}
+
+func (node *EmptyInExpr) walkSubtree(visit Visit) error {
+	return nil
+}
+
+func (node *EmptyInExpr) replace(from, to Expr) bool {
+	return false
+}
+
 // RangeCond represents a BETWEEN or a NOT BETWEEN expression.
 type RangeCond struct {
 	Operator string
@@ -2182,12 +4675,14 @@ type IsExpr struct {
 
 // IsExpr.Operator
 const (
-	IsNullStr     = "is null"
-	IsNotNullStr  = "is not null"
-	IsTrueStr     = "is true"
-	IsNotTrueStr  = "is not true"
-	IsFalseStr    = "is false"
-	IsNotFalseStr = "is not false"
+	IsNullStr       = "is null"
+	IsNotNullStr    = "is not null"
+	IsTrueStr       = "is true"
+	IsNotTrueStr    = "is not true"
+	IsFalseStr      = "is false"
+	IsNotFalseStr   = "is not false"
+	IsUnknownStr    = "is unknown"
+	IsNotUnknownStr = "is not unknown"
 )
 
 // Format formats the node.
@@ -2209,7 +4704,9 @@ func (node *IsExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Expr)
 }
 
-// ExistsExpr represents an EXISTS expression.
+// ExistsExpr represents an EXISTS expression. NOT EXISTS is a NotExpr
+// of an ExistsExpr: the parser drops the parentheses of NOT (EXISTS
+// ...), so that it has the same form. See IsExists and IsNotExists.
 type ExistsExpr struct {
 	Subquery *Subquery
 }
@@ -2241,8 +4738,10 @@ func ExprFromValue(value sqltypes.Value) (Expr, error) {
 		return &NullVal{}, nil
 	case value.IsIntegral():
 		return NewIntVal(value.ToBytes()), nil
-	case value.IsFloat() || value.Type() == sqltypes.Decimal:
+	case value.IsFloat():
 		return NewFloatVal(value.ToBytes()), nil
+	case value.Type() == sqltypes.Decimal:
+		return NewDecimalVal(value.ToBytes()), nil
 	case value.IsQuoted():
 		return NewStrVal(value.ToBytes()), nil
 	default:
@@ -2258,7 +4757,9 @@ type ValType int
 // HexNum represents a 0x... value. It cannot
 // be treated as a simple value because it can
 // be interpreted differently depending on the
-// context.
+// context. DecimalVal is a number with a fraction
+// part, like 10.50, and FloatVal a number with an
+// exponent, like 1.05e1.
 const (
 	StrVal = ValType(iota)
 	IntVal
@@ -2267,12 +4768,20 @@ const (
 	HexVal
 	ValArg
 	BitVal
+	DateVal
+	TimeVal
+	TimestampVal
+	DecimalVal
 )
 
 // SQLVal represents a single value.
 type SQLVal struct {
 	Type ValType
 	Val  []byte
+	// ODBC is set for a DateVal, TimeVal or TimestampVal written as
+	// an ODBC escape, like {ts '2006-01-02 15:04:05'}, which is
+	// formatted the same way.
+	ODBC bool
 }
 
 // NewStrVal builds a new StrVal.
@@ -2290,6 +4799,11 @@ func NewFloatVal(in []byte) *SQLVal {
 	return &SQLVal{Type: FloatVal, Val: in}
 }
 
+// NewDecimalVal builds a new DecimalVal.
+func NewDecimalVal(in []byte) *SQLVal {
+	return &SQLVal{Type: DecimalVal, Val: in}
+}
+
 // NewHexNum builds a new HexNum.
 func NewHexNum(in []byte) *SQLVal {
 	return &SQLVal{Type: HexNum, Val: in}
@@ -2305,22 +4819,69 @@ func NewBitVal(in []byte) *SQLVal {
 	return &SQLVal{Type: BitVal, Val: in}
 }
 
+// NewDateVal builds a new DateVal.
+func NewDateVal(in []byte) *SQLVal {
+	return &SQLVal{Type: DateVal, Val: in}
+}
+
+// NewTimeVal builds a new TimeVal.
+func NewTimeVal(in []byte) *SQLVal {
+	return &SQLVal{Type: TimeVal, Val: in}
+}
+
+// NewTimestampVal builds a new TimestampVal.
+func NewTimestampVal(in []byte) *SQLVal {
+	return &SQLVal{Type: TimestampVal, Val: in}
+}
+
 // NewValArg builds a new ValArg.
 func NewValArg(in []byte) *SQLVal {
 	return &SQLVal{Type: ValArg, Val: in}
 }
 
+// dateTimeKeywords and odbcDateTimeEscapes are the keywords and the
+// ODBC escapes of the DateVal, TimeVal and TimestampVal literals.
+var (
+	dateTimeKeywords = map[ValType]string{
+		DateVal:      "date",
+		TimeVal:      "time",
+		TimestampVal: "timestamp",
+	}
+	odbcDateTimeEscapes = map[ValType]string{
+		DateVal:      "d",
+		TimeVal:      "t",
+		TimestampVal: "ts",
+	}
+)
+
 // Format formats the node.
 func (node *SQLVal) Format(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val).EncodeSQL(buf)
-	case IntVal, FloatVal, HexNum:
-		buf.Myprintf("%s", []byte(node.Val))
+		if buf.SingleLine && hasUnescapableControl(node.Val, buf.SQLMode) {
+			buf.Myprintf("X'%s'", hex.EncodeToString(node.Val))
+			return
+		}
+		writeQuoted(buf, node.Val)
+	case IntVal, FloatVal, DecimalVal, HexNum:
+		buf.Write(node.Val)
 	case HexVal:
-		buf.Myprintf("X'%s'", []byte(node.Val))
+		buf.WriteString("X'")
+		buf.Write(node.Val)
+		buf.WriteByte('\'')
 	case BitVal:
-		buf.Myprintf("B'%s'", []byte(node.Val))
+		buf.WriteString("B'")
+		buf.Write(node.Val)
+		buf.WriteByte('\'')
+	case DateVal, TimeVal, TimestampVal:
+		if node.ODBC {
+			buf.Myprintf("{%s ", odbcDateTimeEscapes[node.Type])
+			writeQuoted(buf, node.Val)
+			buf.WriteByte('}')
+			return
+		}
+		buf.Myprintf("%s ", dateTimeKeywords[node.Type])
+		writeQuoted(buf, node.Val)
 	case ValArg:
 		buf.WriteArg(string(node.Val))
 	default:
@@ -2328,6 +4889,36 @@ func (node *SQLVal) Format(buf *TrackedBuffer) {
 	}
 }
 
+// writeQuoted writes val as a quoted string literal, with the escapes
+// of sqltypes.Value.EncodeSQL, but without its intermediate buffer.
+// With SQLModeNoBackslashEscapes, only the quotes are escaped.
+func writeQuoted(buf *TrackedBuffer, val []byte) {
+	buf.WriteByte('\'')
+	start := 0
+	if buf.SQLMode&SQLModeNoBackslashEscapes != 0 {
+		// Only the quotes are escaped, by doubling them.
+		for i, ch := range val {
+			if ch == '\'' {
+				buf.Write(val[start : i+1])
+				start = i
+			}
+		}
+		buf.Write(val[start:])
+		buf.WriteByte('\'')
+		return
+	}
+	for i, ch := range val {
+		if encoded := sqltypes.SQLEncodeMap[ch]; encoded != sqltypes.DontEscape {
+			buf.Write(val[start:i])
+			buf.WriteByte('\\')
+			buf.WriteByte(encoded)
+			start = i + 1
+		}
+	}
+	buf.Write(val[start:])
+	buf.WriteByte('\'')
+}
+
 func (node *SQLVal) walkSubtree(visit Visit) error {
 	return nil
 }
@@ -2346,12 +4937,42 @@ func (node *SQLVal) HexDecode() ([]byte, error) {
 	return dst, err
 }
 
-// NullVal represents a NULL value.
+// These match the formats MySQL accepts for DATE, TIME and
+// TIMESTAMP literals. They only check the shape of the value,
+// not whether it's a valid date or time.
+var (
+	dateLiteralRegexp      = regexp.MustCompile(`^((\d{2}|\d{4})[-/.]\d{1,2}[-/.]\d{1,2}|\d{6}|\d{8})$`)
+	timeLiteralRegexp      = regexp.MustCompile(`^-?((\d{1,2} )?\d{1,3}(:\d{1,2}){1,2}|\d{1,7})(\.\d{1,6})?$`)
+	timestampLiteralRegexp = regexp.MustCompile(`^((\d{2}|\d{4})[-/.]\d{1,2}[-/.]\d{1,2}([ T]\d{1,2}(:\d{1,2}){1,2}(\.\d{1,6})?)?|(\d{12}|\d{14})(\.\d{1,6})?)$`)
+)
+
+func isDateLiteral(val []byte) bool {
+	return dateLiteralRegexp.Match(val)
+}
+
+func isTimeLiteral(val []byte) bool {
+	return timeLiteralRegexp.Match(val)
+}
+
+func isTimestampLiteral(val []byte) bool {
+	return timestampLiteralRegexp.Match(val)
+}
+
+// NullVal represents a NULL value. It's formatted as null, and an
+// ORDER BY NULL has no direction.
+//
+// Normalize leaves it in place, since a NULL bind var would have no
+// type, except in the lists of IN and NOT IN, where it's a NULL_TYPE
+// value of the list bind var. Comparing with it, like in a = NULL, is
+// always NULL: LintNullComparison flags it, and can rewrite it to
+// IS NULL. For the analyzers, NULL is not a value, see IsValue and
+// IsNull, NewPlanValue makes it an empty PlanValue, ExtractSetValues
+// a nil value, and InferType a NULL_TYPE.
 type NullVal struct{}
 
 // Format formats the node.
 func (node *NullVal) Format(buf *TrackedBuffer) {
-	buf.Myprintf("null")
+	buf.WriteString("null")
 }
 
 func (node *NullVal) walkSubtree(visit Visit) error {
@@ -2368,9 +4989,9 @@ type BoolVal bool
 // Format formats the node.
 func (node BoolVal) Format(buf *TrackedBuffer) {
 	if node {
-		buf.Myprintf("true")
+		buf.WriteString("true")
 	} else {
-		buf.Myprintf("false")
+		buf.WriteString("false")
 	}
 }
 
@@ -2395,10 +5016,18 @@ type ColName struct {
 
 // Format formats the node.
 func (node *ColName) Format(buf *TrackedBuffer) {
+	if buf.nodeFormatter != nil {
+		if !node.Qualifier.IsEmpty() {
+			buf.Myprintf("%v.", node.Qualifier)
+		}
+		buf.Myprintf("%v", node.Name)
+		return
+	}
 	if !node.Qualifier.IsEmpty() {
-		buf.Myprintf("%v.", node.Qualifier)
+		node.Qualifier.Format(buf)
+		buf.WriteByte('.')
 	}
-	buf.Myprintf("%v", node.Name)
+	node.Name.Format(buf)
 }
 
 func (node *ColName) walkSubtree(visit Visit) error {
@@ -2441,7 +5070,13 @@ type ValTuple Exprs
 
 // Format formats the node.
 func (node ValTuple) Format(buf *TrackedBuffer) {
-	buf.Myprintf("(%v)", Exprs(node))
+	buf.WriteByte('(')
+	if buf.nodeFormatter == nil {
+		Exprs(node).Format(buf)
+	} else {
+		buf.formatNode(Exprs(node))
+	}
+	buf.WriteByte(')')
 }
 
 func (node ValTuple) walkSubtree(visit Visit) error {
@@ -2516,11 +5151,38 @@ const (
 	ModStr        = "%"
 	ShiftLeftStr  = "<<"
 	ShiftRightStr = ">>"
+	// ConcatStr is the || of the PIPES_AS_CONCAT SQL mode, see
+	// ParseOptions.
+	ConcatStr = "||"
 )
 
-// Format formats the node.
+// Format formats the node. A concatenation is formatted as a call to
+// CONCAT, unless || concatenates strings in the output, see
+// FormatOptions: for MySQL, || is a logical OR by default.
 func (node *BinaryExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v", node.Left, node.Operator, node.Right)
+	if node.Operator == ConcatStr && !buf.PipesAsConcat && buf.SQLMode&SQLModePipesAsConcat == 0 && !buf.Dialect.pipesAsConcat() {
+		buf.Myprintf("concat(")
+		for i, expr := range concatOperands(node, nil) {
+			if i > 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", expr)
+		}
+		buf.Myprintf(")")
+		return
+	}
+	buf.Myprintf("%v", node.Left)
+	buf.formatOperator(node.Operator, node.Right)
+	buf.Myprintf("%v", node.Right)
+}
+
+// concatOperands appends the operands of a chain of || to operands.
+func concatOperands(expr Expr, operands []Expr) []Expr {
+	if concat, ok := expr.(*BinaryExpr); ok && concat.Operator == ConcatStr {
+		operands = concatOperands(concat.Left, operands)
+		return concatOperands(concat.Right, operands)
+	}
+	return append(operands, expr)
 }
 
 func (node *BinaryExpr) walkSubtree(visit Visit) error {
@@ -2633,10 +5295,19 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	// Over is the OVER clause of a window function, or nil.
+	Over *Over
+	// ODBC is set if the call was written as an ODBC escape, like
+	// {fn now()}, which is formatted the same way.
+	ODBC bool
 }
 
 // Format formats the node.
 func (node *FuncExpr) Format(buf *TrackedBuffer) {
+	if node.ODBC {
+		buf.WriteString("{fn ")
+		defer buf.WriteByte('}')
+	}
 	var distinct string
 	if node.Distinct {
 		distinct = "distinct "
@@ -2647,7 +5318,8 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// Function names should not be back-quoted even
 	// if they match a reserved word. So, print the
 	// name as is.
-	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	buf.writeVerbatim(node.Name.String())
+	buf.Myprintf("(%s%v)%v", distinct, node.Exprs, node.Over)
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -2659,6 +5331,7 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Over,
 	)
 }
 
@@ -2672,9 +5345,215 @@ func (node *FuncExpr) replace(from, to Expr) bool {
 			return true
 		}
 	}
+	if node.Over != nil && node.Over.Spec != nil {
+		return node.Over.Spec.replace(from, to)
+	}
+	return false
+}
+
+// Over represents the OVER clause of a window function. It refers
+// either to a window of the WINDOW clause by its name, or specifies
+// the window between parentheses. ResolveWindows inlines the names.
+type Over struct {
+	WindowName ColIdent
+	Spec       *WindowSpec
+}
+
+// Format formats the node.
+func (node *Over) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.Spec == nil {
+		buf.Myprintf(" over %v", node.WindowName)
+		return
+	}
+	buf.Myprintf(" over %v", node.Spec)
+}
+
+func (node *Over) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.WindowName, node.Spec)
+}
+
+// WindowSpec represents the specification of a window, between
+// parentheses. Name is the window it refines, if any, like w in
+// OVER (w ORDER BY c).
+type WindowSpec struct {
+	Name        ColIdent
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *WindowFrame
+}
+
+// Format formats the node.
+func (node *WindowSpec) Format(buf *TrackedBuffer) {
+	buf.WriteByte('(')
+	var sep string
+	if !node.Name.IsEmpty() {
+		buf.Myprintf("%v", node.Name)
+		sep = " "
+	}
+	if len(node.PartitionBy) > 0 {
+		buf.Myprintf("%spartition by %v", sep, node.PartitionBy)
+		sep = " "
+	}
+	prefix := sep + "order by "
+	for _, order := range node.OrderBy {
+		buf.Myprintf("%s%v", prefix, order)
+		prefix = ", "
+		sep = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", sep, node.Frame)
+	}
+	buf.WriteByte(')')
+}
+
+func (node *WindowSpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if node.Frame == nil {
+		return Walk(visit, node.Name, node.PartitionBy, node.OrderBy)
+	}
+	return Walk(visit, node.Name, node.PartitionBy, node.OrderBy, node.Frame)
+}
+
+func (node *WindowSpec) replace(from, to Expr) bool {
+	for i := range node.PartitionBy {
+		if replaceExprs(from, to, &node.PartitionBy[i]) {
+			return true
+		}
+	}
+	for _, order := range node.OrderBy {
+		if replaceExprs(from, to, &order.Expr) {
+			return true
+		}
+	}
+	if node.Frame != nil {
+		return node.Frame.replace(from, to)
+	}
 	return false
 }
 
+// WindowFrame represents the frame of a window, like
+// ROWS BETWEEN 1 PRECEDING AND CURRENT ROW. End is nil if the frame
+// has only a start, which ends it at the current row.
+type WindowFrame struct {
+	// Unit is RowsStr or RangeStr.
+	Unit  string
+	Start *FrameBound
+	End   *FrameBound
+}
+
+// WindowFrame.Unit
+const (
+	RowsStr  = "rows"
+	RangeStr = "range"
+)
+
+// Format formats the node.
+func (node *WindowFrame) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+func (node *WindowFrame) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if node.End == nil {
+		return Walk(visit, node.Start)
+	}
+	return Walk(visit, node.Start, node.End)
+}
+
+func (node *WindowFrame) replace(from, to Expr) bool {
+	if replaceExprs(from, to, &node.Start.Expr) {
+		return true
+	}
+	return node.End != nil && replaceExprs(from, to, &node.End.Expr)
+}
+
+// FrameBound represents the start or the end of the frame of a
+// window. Expr is the offset of a PrecedingStr or FollowingStr bound,
+// like 1 or INTERVAL 1 DAY, and is nil for the other types.
+type FrameBound struct {
+	Type string
+	Expr Expr
+}
+
+// FrameBound.Type
+const (
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	CurrentRowStr         = "current row"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+)
+
+// Format formats the node.
+func (node *FrameBound) Format(buf *TrackedBuffer) {
+	if node.Expr == nil {
+		buf.WriteString(node.Type)
+		return
+	}
+	buf.Myprintf("%v %s", node.Expr, node.Type)
+}
+
+func (node *FrameBound) walkSubtree(visit Visit) error {
+	if node == nil || node.Expr == nil {
+		return nil
+	}
+	return Walk(visit, node.Expr)
+}
+
+// NamedWindows represents the WINDOW clause of a select.
+type NamedWindows []*NamedWindow
+
+// Format formats the node.
+func (node NamedWindows) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node NamedWindows) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NamedWindow represents the definition of a window in
+// the WINDOW clause, like w AS (PARTITION BY a).
+type NamedWindow struct {
+	Name ColIdent
+	Spec *WindowSpec
+}
+
+// Format formats the node.
+func (node *NamedWindow) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v as %v", node.Name, node.Spec)
+}
+
+func (node *NamedWindow) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Spec)
+}
+
 // Aggregates is a map of all aggregate functions.
 var Aggregates = map[string]bool{
 	"avg":          true,
@@ -2695,113 +5574,338 @@ var Aggregates = map[string]bool{
 	"variance":     true,
 }
 
-// IsAggregate returns true if the function is an aggregate.
-func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+// IsAggregate returns true if the function is an aggregate.
+func (node *FuncExpr) IsAggregate() bool {
+	return Aggregates[node.Name.Lowered()]
+}
+
+// ExtensionExpr is a call to a function registered with
+// RegisterFunctionExtension, with the arguments its extension parsed.
+type ExtensionExpr struct {
+	Name ColIdent
+	Args []ExtensionArg
+}
+
+// ExtensionArg is an argument of an ExtensionExpr: an expression,
+// or if Expr is nil, a piece of text, like a keyword, that's
+// formatted as is.
+type ExtensionArg struct {
+	Text string
+	Expr Expr
+}
+
+// Format formats the node. The arguments are written one after the
+// other: the pieces of text hold the spaces and punctuation.
+func (node *ExtensionExpr) Format(buf *TrackedBuffer) {
+	buf.writeVerbatim(node.Name.String())
+	buf.WriteString("(")
+	for _, arg := range node.Args {
+		if arg.Expr != nil {
+			buf.Myprintf("%v", arg.Expr)
+		} else {
+			buf.WriteString(arg.Text)
+		}
+	}
+	buf.WriteString(")")
+}
+
+func (node *ExtensionExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, arg := range node.Args {
+		if arg.Expr == nil {
+			continue
+		}
+		if err := Walk(visit, arg.Expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (node *ExtensionExpr) replace(from, to Expr) bool {
+	for i := range node.Args {
+		if replaceExprs(from, to, &node.Args[i].Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupConcatExpr represents a call to GROUP_CONCAT
+type GroupConcatExpr struct {
+	Distinct  string
+	Exprs     SelectExprs
+	OrderBy   OrderBy
+	Separator string
+}
+
+// Format formats the node
+func (node *GroupConcatExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("group_concat(%s%v%v%s)", node.Distinct, node.Exprs, node.OrderBy, node.Separator)
+}
+
+func (node *GroupConcatExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Exprs,
+		node.OrderBy,
+	)
+}
+
+func (node *GroupConcatExpr) replace(from, to Expr) bool {
+	for _, sel := range node.Exprs {
+		aliased, ok := sel.(*AliasedExpr)
+		if !ok {
+			continue
+		}
+		if replaceExprs(from, to, &aliased.Expr) {
+			return true
+		}
+	}
+	for _, order := range node.OrderBy {
+		if replaceExprs(from, to, &order.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValuesFuncExpr represents a function call.
+type ValuesFuncExpr struct {
+	Name *ColName
+}
+
+// Format formats the node.
+func (node *ValuesFuncExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("values(%v)", node.Name)
+}
+
+func (node *ValuesFuncExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+	)
+}
+
+func (node *ValuesFuncExpr) replace(from, to Expr) bool {
+	return false
+}
+
+// NextValueExpr represents a NEXT VALUE FOR seq expression, which
+// fetches the next value of a sequence.
+type NextValueExpr struct {
+	Sequence TableName
+}
+
+// Format formats the node.
+func (node *NextValueExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("next value for %v", node.Sequence)
+}
+
+func (node *NextValueExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Sequence,
+	)
+}
+
+func (node *NextValueExpr) replace(from, to Expr) bool {
+	return false
+}
+
+// SubstrExpr represents a call to SubstrExpr(column, value_expression) or SubstrExpr(column, value_expression,value_expression)
+// also supported syntax SubstrExpr(column from value_expression for value_expression)
+// FuncName and FromFor preserve the syntax the call was written in.
+type SubstrExpr struct {
+	FuncName string
+	Name     *ColName
+	From     Expr
+	To       Expr
+	FromFor  bool
+}
+
+// SubstrExpr.FuncName
+const (
+	SubstrStr    = "substr"
+	SubstringStr = "substring"
+)
+
+// Format formats the node.
+func (node *SubstrExpr) Format(buf *TrackedBuffer) {
+	funcName := node.FuncName
+	if funcName == "" {
+		funcName = SubstrStr
+	}
+	sep, toSep := ", ", ", "
+	if node.FromFor {
+		sep, toSep = " from ", " for "
+	}
+	if node.To == nil {
+		buf.Myprintf("%s(%v%s%v)", funcName, node.Name, sep, node.From)
+	} else {
+		buf.Myprintf("%s(%v%s%v%s%v)", funcName, node.Name, sep, node.From, toSep, node.To)
+	}
+}
+
+func (node *SubstrExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.From, &node.To)
 }
 
-// GroupConcatExpr represents a call to GROUP_CONCAT
-type GroupConcatExpr struct {
-	Distinct  string
-	Exprs     SelectExprs
-	OrderBy   OrderBy
-	Separator string
+func (node *SubstrExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.From,
+		node.To,
+	)
 }
 
-// Format formats the node
-func (node *GroupConcatExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("group_concat(%s%v%v%s)", node.Distinct, node.Exprs, node.OrderBy, node.Separator)
+// ExtractExpr represents a call to EXTRACT(unit FROM expr).
+type ExtractExpr struct {
+	Unit string
+	Expr Expr
 }
 
-func (node *GroupConcatExpr) walkSubtree(visit Visit) error {
+// Format formats the node.
+func (node *ExtractExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("extract(%s from %v)", node.Unit, node.Expr)
+}
+
+func (node *ExtractExpr) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
 	return Walk(
 		visit,
-		node.Exprs,
-		node.OrderBy,
+		node.Expr,
 	)
 }
 
-func (node *GroupConcatExpr) replace(from, to Expr) bool {
-	for _, sel := range node.Exprs {
-		aliased, ok := sel.(*AliasedExpr)
-		if !ok {
-			continue
-		}
-		if replaceExprs(from, to, &aliased.Expr) {
-			return true
-		}
-	}
-	for _, order := range node.OrderBy {
-		if replaceExprs(from, to, &order.Expr) {
-			return true
-		}
-	}
-	return false
+func (node *ExtractExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr)
 }
 
-// ValuesFuncExpr represents a function call.
-type ValuesFuncExpr struct {
-	Name *ColName
+// PositionExpr represents a call to POSITION(substr IN str).
+type PositionExpr struct {
+	Substr Expr
+	Str    Expr
 }
 
 // Format formats the node.
-func (node *ValuesFuncExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("values(%v)", node.Name)
+func (node *PositionExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("position(%v in %v)", node.Substr, node.Str)
 }
 
-func (node *ValuesFuncExpr) walkSubtree(visit Visit) error {
+func (node *PositionExpr) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
 	return Walk(
 		visit,
-		node.Name,
+		node.Substr,
+		node.Str,
 	)
 }
 
-func (node *ValuesFuncExpr) replace(from, to Expr) bool {
-	return false
+func (node *PositionExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Substr, &node.Str)
 }
 
-// SubstrExpr represents a call to SubstrExpr(column, value_expression) or SubstrExpr(column, value_expression,value_expression)
-// also supported syntax SubstrExpr(column from value_expression for value_expression)
-type SubstrExpr struct {
-	Name *ColName
-	From Expr
-	To   Expr
+// TrimExpr represents a call to TRIM([[type] [remstr] FROM] str).
+type TrimExpr struct {
+	Type   string
+	RemStr Expr
+	Str    Expr
+}
+
+// TrimExpr.Type
+const (
+	TrimBothStr     = "both"
+	TrimLeadingStr  = "leading"
+	TrimTrailingStr = "trailing"
+)
+
+func isTrimType(s string) bool {
+	return s == TrimBothStr || s == TrimLeadingStr || s == TrimTrailingStr
 }
 
 // Format formats the node.
-func (node *SubstrExpr) Format(buf *TrackedBuffer) {
+func (node *TrimExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("trim(")
+	if node.Type != "" {
+		buf.Myprintf("%s ", node.Type)
+	}
+	if node.RemStr != nil {
+		buf.Myprintf("%v ", node.RemStr)
+	}
+	if node.Type != "" || node.RemStr != nil {
+		buf.Myprintf("from ")
+	}
+	buf.Myprintf("%v)", node.Str)
+}
 
-	if node.To == nil {
-		buf.Myprintf("substr(%v, %v)", node.Name, node.From)
-	} else {
-		buf.Myprintf("substr(%v, %v, %v)", node.Name, node.From, node.To)
+func (node *TrimExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
 	}
+	return Walk(
+		visit,
+		node.RemStr,
+		node.Str,
+	)
 }
 
-func (node *SubstrExpr) replace(from, to Expr) bool {
-	return replaceExprs(from, to, &node.From, &node.To)
+func (node *TrimExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.RemStr, &node.Str)
 }
 
-func (node *SubstrExpr) walkSubtree(visit Visit) error {
+// WeightStringExpr represents a call to WEIGHT_STRING(expr [AS type]).
+type WeightStringExpr struct {
+	Expr Expr
+	As   *ConvertType
+}
+
+// Format formats the node.
+func (node *WeightStringExpr) Format(buf *TrackedBuffer) {
+	if node.As != nil {
+		buf.Myprintf("weight_string(%v as %v)", node.Expr, node.As)
+	} else {
+		buf.Myprintf("weight_string(%v)", node.Expr)
+	}
+}
+
+func (node *WeightStringExpr) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
 	return Walk(
 		visit,
-		node.Name,
-		node.From,
-		node.To,
+		node.Expr,
+		node.As,
 	)
 }
 
+func (node *WeightStringExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr)
+}
+
 // ConvertExpr represents a call to CONVERT(expr, type)
-// or it's equivalent CAST(expr AS type). Both are rewritten to the former.
+// or it's equivalent CAST(expr AS type). Both are rewritten to the former,
+// except for the CAST(expr AS type ARRAY) of a multi-valued index.
 type ConvertExpr struct {
 	Expr Expr
 	Type *ConvertType
@@ -2809,6 +5913,11 @@ type ConvertExpr struct {
 
 // Format formats the node.
 func (node *ConvertExpr) Format(buf *TrackedBuffer) {
+	if node.Type != nil && node.Type.Array {
+		// Only CAST takes an ARRAY.
+		buf.Myprintf("cast(%v as %v)", node.Expr, node.Type)
+		return
+	}
 	buf.Myprintf("convert(%v, %v)", node.Expr, node.Type)
 }
 
@@ -2859,6 +5968,8 @@ type ConvertType struct {
 	Scale    *SQLVal
 	Operator string
 	Charset  string
+	// Array is true for the ARRAY of a CAST to an array of the type.
+	Array bool
 }
 
 // this string is "character set" and this comment is required
@@ -2879,6 +5990,9 @@ func (node *ConvertType) Format(buf *TrackedBuffer) {
 	if node.Charset != "" {
 		buf.Myprintf("%s %s", node.Operator, node.Charset)
 	}
+	if node.Array {
+		buf.Myprintf(" array")
+	}
 }
 
 func (node *ConvertType) walkSubtree(visit Visit) error {
@@ -2975,21 +6089,29 @@ func (node *CaseExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Expr, &node.Else)
 }
 
-// Default represents a DEFAULT expression.
+// Default represents the DEFAULT keyword, which stands for the default
+// value of the column in the VALUES rows of an INSERT and on the right
+// side of a SET, or a call to DEFAULT(col), which returns the default
+// value of Name anywhere in an expression. Default is not a literal:
+// Normalize leaves it alone.
 type Default struct {
-	ColName string
+	// Name is nil for the DEFAULT keyword.
+	Name *ColName
 }
 
 // Format formats the node.
 func (node *Default) Format(buf *TrackedBuffer) {
 	buf.Myprintf("default")
-	if node.ColName != "" {
-		buf.Myprintf("(%s)", node.ColName)
+	if node.Name != nil {
+		buf.Myprintf("(%v)", node.Name)
 	}
 }
 
 func (node *Default) walkSubtree(visit Visit) error {
-	return nil
+	if node == nil || node.Name == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
 }
 
 func (node *Default) replace(from, to Expr) bool {
@@ -3099,8 +6221,12 @@ func (node *Order) walkSubtree(visit Visit) error {
 }
 
 // Limit represents a LIMIT clause.
+// Percent is only set by a SQL Server TOP n PERCENT clause,
+// which has no LIMIT equivalent: it's formatted as TOP in every
+// dialect.
 type Limit struct {
 	Offset, Rowcount Expr
+	Percent          bool
 }
 
 // Format formats the node.
@@ -3110,9 +6236,33 @@ func (node *Limit) Format(buf *TrackedBuffer) {
 	}
 	buf.Myprintf(" limit ")
 	if node.Offset != nil {
-		buf.Myprintf("%v, ", node.Offset)
+		buf.formatCount(node.Offset)
+		buf.WriteString(", ")
+	}
+	buf.formatCount(node.Rowcount)
+}
+
+// isTop returns true if the limit of a select is formatted as
+// a TOP clause instead of a LIMIT clause. Only SQL Server has TOP,
+// but a TOP n PERCENT stays one in the other dialects rather than
+// lose its PERCENT.
+func (node *Limit) isTop(buf *TrackedBuffer) bool {
+	if node == nil || node.Offset != nil {
+		return false
+	}
+	return node.Percent || buf.Dialect == SQLServerDialect
+}
+
+// formatTop formats the limit as a SQL Server TOP clause.
+func (node *Limit) formatTop(buf *TrackedBuffer) {
+	if val, ok := node.Rowcount.(*SQLVal); ok && val.Type == IntVal {
+		buf.Myprintf("top %v ", node.Rowcount)
+	} else {
+		buf.Myprintf("top (%v) ", node.Rowcount)
+	}
+	if node.Percent {
+		buf.Myprintf("percent ")
 	}
-	buf.Myprintf("%v", node.Rowcount)
 }
 
 func (node *Limit) walkSubtree(visit Visit) error {
@@ -3131,10 +6281,17 @@ type Values []ValTuple
 
 // Format formats the node.
 func (node Values) Format(buf *TrackedBuffer) {
-	prefix := "values "
-	for _, n := range node {
-		buf.Myprintf("%s%v", prefix, n)
-		prefix = ", "
+	for i, n := range node {
+		if i == 0 {
+			buf.WriteString("values ")
+		} else {
+			buf.WriteString(", ")
+		}
+		if buf.nodeFormatter == nil {
+			n.Format(buf)
+		} else {
+			buf.formatNode(n)
+		}
 	}
 }
 
@@ -3152,10 +6309,11 @@ type UpdateExprs []*UpdateExpr
 
 // Format formats the node.
 func (node UpdateExprs) Format(buf *TrackedBuffer) {
-	var prefix string
-	for _, n := range node {
-		buf.Myprintf("%s%v", prefix, n)
-		prefix = ", "
+	for i, n := range node {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.formatNode(n)
 	}
 }
 
@@ -3176,7 +6334,9 @@ type UpdateExpr struct {
 
 // Format formats the node.
 func (node *UpdateExpr) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v = %v", node.Name, node.Expr)
+	buf.formatNode(node.Name)
+	buf.formatOperator("=", node.Expr)
+	buf.formatNode(node.Expr)
 }
 
 func (node *UpdateExpr) walkSubtree(visit Visit) error {
@@ -3213,14 +6373,19 @@ func (node SetExprs) walkSubtree(visit Visit) error {
 
 // SetExpr represents a set expression.
 type SetExpr struct {
-	Name ColIdent
-	Expr Expr
+	// Qualifier is the NEW or OLD row of a trigger whose column
+	// Name is set, like the NEW of SET NEW.a = 1, if any.
+	Qualifier TableIdent
+	Name      ColIdent
+	Expr      Expr
 }
 
 // Format formats the node.
 func (node *SetExpr) Format(buf *TrackedBuffer) {
 	// We don't have to backtick set variable names.
-	if node.Name.EqualString("charset") || node.Name.EqualString("names") {
+	if !node.Qualifier.IsEmpty() {
+		buf.Myprintf("%v.%s = %v", node.Qualifier, node.Name.String(), node.Expr)
+	} else if node.Name.EqualString("charset") || node.Name.EqualString("names") {
 		buf.Myprintf("%s %v", node.Name.String(), node.Expr)
 	} else {
 		buf.Myprintf("%s = %v", node.Name.String(), node.Expr)
@@ -3233,6 +6398,7 @@ func (node *SetExpr) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.Qualifier,
 		node.Name,
 		node.Expr,
 	)
@@ -3253,8 +6419,175 @@ func (node OnDup) walkSubtree(visit Visit) error {
 	return Walk(visit, UpdateExprs(node))
 }
 
+// RowAlias represents the alias of the row inserted by an INSERT,
+// like new in INSERT ... VALUES (1, 2) AS new(a, b). The ON DUPLICATE
+// KEY UPDATE clause refers to the values of the row as new.a,
+// instead of VALUES(a).
+type RowAlias struct {
+	Name    TableIdent
+	Columns Columns
+}
+
+// Format formats the node.
+func (node *RowAlias) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf(" as %v%v", node.Name, node.Columns)
+}
+
+func (node *RowAlias) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Columns)
+}
+
+// OnConflict represents the ON CONFLICT clause of a Postgres INSERT.
+// The conflict target is either the columns of a unique index, with
+// the optional predicate of a partial index in Where, or the name of
+// a constraint. Both are missing if the clause has no target.
+type OnConflict struct {
+	Columns    Columns
+	Where      *Where
+	Constraint ColIdent
+	Action     string
+	// Exprs and UpdateWhere are the assignments and the condition
+	// of the DoUpdateStr action.
+	Exprs       UpdateExprs
+	UpdateWhere *Where
+}
+
+// OnConflict.Action
+const (
+	DoNothingStr = "do nothing"
+	DoUpdateStr  = "do update"
+)
+
+// Format formats the node.
+func (node *OnConflict) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" on conflict")
+	if node.Columns != nil {
+		buf.Myprintf(" %v%v", node.Columns, node.Where)
+	}
+	if !node.Constraint.IsEmpty() {
+		buf.Myprintf(" on constraint %v", node.Constraint)
+	}
+	buf.Myprintf(" %s", node.Action)
+	if node.Action == DoUpdateStr {
+		buf.Myprintf(" set %v%v", node.Exprs, node.UpdateWhere)
+	}
+}
+
+func (node *OnConflict) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Columns,
+		node.Where,
+		node.Constraint,
+		node.Exprs,
+		node.UpdateWhere,
+	)
+}
+
+// SelectInto represents the INTO clause of a select. IntoOutfileStr
+// writes the rows to FileName, on the server, in the format of the
+// export options, IntoDumpfileStr writes a single row to FileName
+// without any formatting, and IntoVarsStr stores the values of a
+// single row in the user variables Vars.
+type SelectInto struct {
+	Type     string
+	FileName string
+	Vars     []ColIdent
+	Charset  string
+	// The export options of IntoOutfileStr, nil if not set. Optionally
+	// is set for OPTIONALLY ENCLOSED BY, which only encloses strings.
+	FieldsTerminatedBy *SQLVal
+	FieldsEnclosedBy   *SQLVal
+	Optionally         bool
+	FieldsEscapedBy    *SQLVal
+	LinesStartingBy    *SQLVal
+	LinesTerminatedBy  *SQLVal
+}
+
+// SelectInto.Type
+const (
+	IntoOutfileStr  = "outfile"
+	IntoDumpfileStr = "dumpfile"
+	IntoVarsStr     = "vars"
+)
+
+// Format formats the node.
+func (node *SelectInto) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.Type == IntoVarsStr {
+		prefix := " into "
+		for _, v := range node.Vars {
+			buf.Myprintf("%s%v", prefix, v)
+			prefix = ", "
+		}
+		return
+	}
+	buf.Myprintf(" into %s ", node.Type)
+	writeQuoted(buf, []byte(node.FileName))
+	if node.Charset != "" {
+		buf.Myprintf(" character set %s", node.Charset)
+	}
+	if node.FieldsTerminatedBy != nil || node.FieldsEnclosedBy != nil || node.FieldsEscapedBy != nil {
+		buf.Myprintf(" fields")
+		if node.FieldsTerminatedBy != nil {
+			buf.Myprintf(" terminated by %v", node.FieldsTerminatedBy)
+		}
+		if node.FieldsEnclosedBy != nil {
+			if node.Optionally {
+				buf.Myprintf(" optionally")
+			}
+			buf.Myprintf(" enclosed by %v", node.FieldsEnclosedBy)
+		}
+		if node.FieldsEscapedBy != nil {
+			buf.Myprintf(" escaped by %v", node.FieldsEscapedBy)
+		}
+	}
+	if node.LinesStartingBy != nil || node.LinesTerminatedBy != nil {
+		buf.Myprintf(" lines")
+		if node.LinesStartingBy != nil {
+			buf.Myprintf(" starting by %v", node.LinesStartingBy)
+		}
+		if node.LinesTerminatedBy != nil {
+			buf.Myprintf(" terminated by %v", node.LinesTerminatedBy)
+		}
+	}
+}
+
+// walkSubtree only walks the variables: the export options must stay
+// literals, so they are hidden from rewrites like Normalize.
+func (node *SelectInto) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, v := range node.Vars {
+		if err := Walk(visit, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ColIdent is a case insensitive SQL identifier. It will be escaped with
 // backquotes if necessary.
+//
+// Its text form, used by String, MarshalText and MarshalJSON, is the name
+// as it was written, without quotes. Lowered is its canonical form: maps
+// keyed by column names use it, so that the names that are Equal share
+// an entry.
 type ColIdent struct {
 	// This artifact prevents this struct from being compared
 	// with itself. It consumes no space as long as it's not the
@@ -3272,7 +6605,7 @@ func NewColIdent(str string) ColIdent {
 
 // Format formats the node.
 func (node ColIdent) Format(buf *TrackedBuffer) {
-	formatID(buf, node.val, node.Lowered())
+	formatID(buf, node.val)
 }
 
 func (node ColIdent) walkSubtree(visit Visit) error {
@@ -3333,12 +6666,40 @@ func (node *ColIdent) UnmarshalJSON(b []byte) error {
 	if err != nil {
 		return err
 	}
-	node.val = result
+	*node = NewColIdent(result)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the name as it was written.
+func (node ColIdent) MarshalText() ([]byte, error) {
+	return []byte(node.val), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (node *ColIdent) UnmarshalText(text []byte) error {
+	*node = NewColIdent(string(text))
+	return nil
+}
+
+// Scan implements sql.Scanner, so that a name
+// can be read from a database column.
+func (node *ColIdent) Scan(src interface{}) error {
+	name, err := scanIdent(src)
+	if err != nil {
+		return err
+	}
+	*node = NewColIdent(name)
 	return nil
 }
 
 // TableIdent is a case sensitive SQL identifier. It will be escaped with
 // backquotes if necessary.
+//
+// Its text form, used by String, MarshalText and MarshalJSON, is the name
+// as it was written, without quotes. It's also its canonical form, since
+// table names are case sensitive: maps keyed by table names use it, or
+// the TableIdent itself, which is comparable.
 type TableIdent struct {
 	v string
 }
@@ -3350,7 +6711,7 @@ func NewTableIdent(str string) TableIdent {
 
 // Format formats the node.
 func (node TableIdent) Format(buf *TrackedBuffer) {
-	formatID(buf, node.v, strings.ToLower(node.v))
+	formatID(buf, node.v)
 }
 
 func (node TableIdent) walkSubtree(visit Visit) error {
@@ -3376,6 +6737,12 @@ func (node TableIdent) CompliantName() string {
 	return compliantName(node.v)
 }
 
+// Lowered returns a lower-cased table name, for the comparisons
+// that ignore case, like the ones of view names.
+func (node TableIdent) Lowered() string {
+	return strings.ToLower(node.v)
+}
+
 // MarshalJSON marshals into JSON.
 func (node TableIdent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(node.v)
@@ -3392,6 +6759,43 @@ func (node *TableIdent) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It returns the name as it was written.
+func (node TableIdent) MarshalText() ([]byte, error) {
+	return []byte(node.v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (node *TableIdent) UnmarshalText(text []byte) error {
+	node.v = string(text)
+	return nil
+}
+
+// Scan implements sql.Scanner, so that a name
+// can be read from a database column.
+func (node *TableIdent) Scan(src interface{}) error {
+	name, err := scanIdent(src)
+	if err != nil {
+		return err
+	}
+	node.v = name
+	return nil
+}
+
+// scanIdent returns the name held by a database value.
+// NULL is an empty name.
+func scanIdent(src interface{}) (string, error) {
+	switch src := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	}
+	return "", fmt.Errorf("cannot scan %T into an identifier", src)
+}
+
 // Backtick produces a backticked literal given an input string.
 func Backtick(in string) string {
 	var buf bytes.Buffer
@@ -3406,11 +6810,14 @@ func Backtick(in string) string {
 	return buf.String()
 }
 
-func formatID(buf *TrackedBuffer, original, lowered string) {
+func formatID(buf *TrackedBuffer, original string) {
 	isDbSystemVariable := false
 	if len(original) > 1 && original[:2] == "@@" {
 		isDbSystemVariable = true
 	}
+	if buf.Style.QuoteIdentifiers == QuoteAlways && original != "" && original[0] != '@' {
+		goto mustEscape
+	}
 
 	for i, c := range original {
 		if !isLetter(uint16(c)) && (!isDbSystemVariable || !isCarat(uint16(c))) {
@@ -3419,13 +6826,24 @@ func formatID(buf *TrackedBuffer, original, lowered string) {
 			}
 		}
 	}
-	if _, ok := keywords[lowered]; ok {
+	if isKeywordID(original, buf.Dialect) {
 		goto mustEscape
 	}
-	buf.Myprintf("%s", original)
+	buf.WriteString(original)
 	return
 
 mustEscape:
+	if buf.Dialect == SQLServerDialect {
+		buf.WriteByte('[')
+		for _, c := range original {
+			buf.WriteRune(c)
+			if c == ']' {
+				buf.WriteByte(']')
+			}
+		}
+		buf.WriteByte(']')
+		return
+	}
 	buf.WriteByte('`')
 	for _, c := range original {
 		buf.WriteRune(c)
@@ -3436,6 +6854,38 @@ mustEscape:
 	buf.WriteByte('`')
 }
 
+// isKeywordID returns true if the identifier id, which is made of
+// ASCII characters, is a keyword in dialect. It lowers id into an
+// array on the stack instead of allocating a string.
+func isKeywordID(id string, dialect Dialect) bool {
+	var lowered [32]byte
+	if len(id) > len(lowered) {
+		// No keyword is that long.
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		ch := id[i]
+		if 'A' <= ch && ch <= 'Z' {
+			ch += 'a' - 'A'
+		}
+		lowered[i] = ch
+	}
+	key := lowered[:len(id)]
+	if _, ok := keywords[string(key)]; ok {
+		return true
+	}
+	if _, ok := sqlServerKeywords[string(key)]; ok && dialect == SQLServerDialect {
+		return true
+	}
+	if _, ok := returningKeywords[string(key)]; ok && dialect.hasReturning() {
+		return true
+	}
+	if _, ok := onConflictKeywords[string(key)]; ok && dialect.hasOnConflict() {
+		return true
+	}
+	return false
+}
+
 func compliantName(in string) string {
 	var buf bytes.Buffer
 	for i, c := range in {
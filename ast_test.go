@@ -19,6 +19,8 @@ package sqlparser
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -48,6 +50,68 @@ func TestAppend(t *testing.T) {
 	}
 }
 
+// limitedWriter fails the writes after limit bytes.
+type limitedWriter struct {
+	buf    bytes.Buffer
+	limit  int
+	writes int
+}
+
+var errLimit = errors.New("limit reached")
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.buf.Len()+len(p) > w.limit {
+		n := w.limit - w.buf.Len()
+		w.buf.Write(p[:n])
+		return n, errLimit
+	}
+	return w.buf.Write(p)
+}
+
+func TestWriteTo(t *testing.T) {
+	var rows []string
+	for i := 0; i < 10000; i++ {
+		rows = append(rows, fmt.Sprintf("(%d, 'name %d', :v%d)", i, i, i))
+	}
+	large := "insert into t(id, name, v) values " + strings.Join(rows, ", ")
+	for _, query := range []string{"select * from t where a = 1", large} {
+		tree, err := Parse(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := String(tree)
+		w := &limitedWriter{limit: len(want)}
+		n, err := WriteTo(w, tree)
+		if err != nil || n != int64(len(want)) || w.buf.String() != want {
+			t.Errorf("WriteTo(%.40s): %d, %v, want %d", query, n, err, len(want))
+		}
+		var b bytes.Buffer
+		b.WriteString("x")
+		n, err = WriteTo(&b, tree)
+		if err != nil || n != int64(len(want)) || b.String() != "x"+want {
+			t.Errorf("WriteTo(%.40s) to a bytes.Buffer: %d, %v, want %d", query, n, err, len(want))
+		}
+	}
+
+	// The large insert is written in chunks. Formatting stops at the
+	// first error.
+	tree, err := Parse(large)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &limitedWriter{limit: 50000}
+	n, err := WriteTo(w, tree)
+	if err != errLimit || n != 50000 || w.writes != 2 {
+		t.Errorf("WriteTo: %d, %v after %d writes, want 50000, %v after 2", n, err, w.writes, errLimit)
+	}
+
+	w = &limitedWriter{limit: 100}
+	if n, err := WriteTo(w, nil); err != nil || n != 5 || w.buf.String() != "<nil>" {
+		t.Errorf("WriteTo(nil): %d, %v, %q", n, err, w.buf.String())
+	}
+}
+
 func TestSelect(t *testing.T) {
 	tree, err := Parse("select * from t where a = 1")
 	if err != nil {
@@ -108,6 +172,14 @@ func TestSelect(t *testing.T) {
 	if buf.String() != want {
 		t.Errorf("having: %q, want %s", buf.String(), want)
 	}
+	sel = tree.(*Select)
+	sel.AddWhere(expr)
+	buf = NewTrackedBuffer(nil)
+	sel.Where.Format(buf)
+	want = " where (a = 1 or b = 1) and (a = 1 or b = 1)"
+	if buf.String() != want {
+		t.Errorf("where: %q, want %s", buf.String(), want)
+	}
 }
 
 func TestRemoveHints(t *testing.T) {
@@ -191,6 +263,74 @@ func TestSetLimit(t *testing.T) {
 	}
 }
 
+func TestSelectOptions(t *testing.T) {
+	testcases := []struct {
+		in     string
+		add    []SelectOption
+		remove []SelectOption
+		out    string
+	}{{
+		in:     "select sql_calc_found_rows a from t",
+		remove: []SelectOption{SelectCalcFoundRows},
+		out:    "select a from t",
+	}, {
+		in:     "select sql_no_cache sql_calc_found_rows distinct a from t",
+		remove: []SelectOption{SelectCalcFoundRows},
+		out:    "select sql_no_cache distinct a from t",
+	}, {
+		in:     "select distinctrow a from t",
+		remove: []SelectOption{SelectDistinct},
+		out:    "select a from t",
+	}, {
+		in:  "select distinctrow a from t",
+		add: []SelectOption{SelectDistinct},
+		out: "select distinctrow a from t",
+	}, {
+		in:  "select all sql_cache a from t",
+		add: []SelectOption{SelectNoCache, SelectDistinct, SelectStraightJoin},
+		out: "select distinct sql_no_cache straight_join a from t",
+	}, {
+		in:     "select a from t",
+		add:    []SelectOption{SelectBufferResult},
+		remove: []SelectOption{SelectCalcFoundRows},
+		out:    "select sql_buffer_result a from t",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		sel := tree.(*Select)
+		for _, opt := range tcase.add {
+			sel.AddOption(opt)
+			if !sel.HasOption(opt) {
+				t.Errorf("%s: HasOption(%v) after AddOption: false", tcase.in, opt)
+			}
+		}
+		for _, opt := range tcase.remove {
+			had := sel.HasOption(opt)
+			if removed := sel.RemoveOption(opt); removed != had {
+				t.Errorf("%s: RemoveOption(%v): %v, want %v", tcase.in, opt, removed, had)
+			}
+			if sel.HasOption(opt) {
+				t.Errorf("%s: HasOption(%v) after RemoveOption: true", tcase.in, opt)
+			}
+		}
+		if got := String(sel); got != tcase.out {
+			t.Errorf("%s: %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+
+	tree, err := Parse("select distinctrow a from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tree.(*Select).IsDistinct() {
+		t.Errorf("IsDistinct(select distinctrow a from t): false, want true")
+	}
+}
+
 func TestWhere(t *testing.T) {
 	var w *Where
 	buf := NewTrackedBuffer(nil)
@@ -223,6 +363,93 @@ func TestIsAggregate(t *testing.T) {
 	}
 }
 
+func TestDDLReadsTables(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want bool
+	}{{
+		in:   "create table a like b",
+		want: true,
+	}, {
+		in:   "create table a as select * from b",
+		want: true,
+	}, {
+		in:   "create table a (\n\tx int\n)",
+		want: false,
+	}, {
+		in:   "drop table a",
+		want: false,
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got := tree.(*DDL).ReadsTables(); got != tcase.want {
+			t.Errorf("ReadsTables(%s): %v, want %v", tcase.in, got, tcase.want)
+		}
+	}
+}
+
+func TestWalkWithPath(t *testing.T) {
+	tree, err := Parse("select a, b + 1 from t where c = 1 limit 10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	var depth []int
+	err = WalkWithPath(func(node SQLNode, path []SQLNode) (bool, error) {
+		switch node.(type) {
+		case *ColName, *SQLVal:
+			got = append(got, PathString(path, node))
+			depth = append(depth, len(path))
+		case *Where:
+			if _, ok := path[len(path)-1].(*Select); !ok {
+				t.Errorf("parent of where: %T, want *Select", path[len(path)-1])
+			}
+		}
+		return true, nil
+	}, tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"Select.SelectExprs[0].Expr",
+		"Select.SelectExprs[1].Expr.Left",
+		"Select.SelectExprs[1].Expr.Right",
+		"Select.Where.Expr.Left",
+		"Select.Where.Expr.Right",
+		"Select.Limit.Rowcount",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("paths: %q, want %q", got, want)
+	}
+	wantDepth := []int{3, 4, 4, 3, 3, 2}
+	if !reflect.DeepEqual(depth, wantDepth) {
+		t.Errorf("depths: %v, want %v", depth, wantDepth)
+	}
+
+	if got, want := PathString(nil, tree), "Select"; got != want {
+		t.Errorf("PathString(nil, tree): %s, want %s", got, want)
+	}
+
+	// Not descending into the where clause must skip its nodes.
+	count := 0
+	_ = WalkWithPath(func(node SQLNode, path []SQLNode) (bool, error) {
+		if _, ok := node.(*Where); ok {
+			return false, nil
+		}
+		if _, ok := node.(*ColName); ok {
+			count++
+		}
+		return true, nil
+	}, tree)
+	if count != 2 {
+		t.Errorf("columns outside where: %d, want 2", count)
+	}
+}
+
 func TestReplaceExpr(t *testing.T) {
 	tcases := []struct {
 		in, out string
@@ -385,8 +612,8 @@ func TestExprFromValue(t *testing.T) {
 		in:  sqltypes.NewFloat64(1.1),
 		out: NewFloatVal([]byte("1.1")),
 	}, {
-		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.1")),
-		out: NewFloatVal([]byte("1.1")),
+		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.10")),
+		out: NewDecimalVal([]byte("1.10")),
 	}, {
 		in:  sqltypes.NewVarChar("aa"),
 		out: NewStrVal([]byte("aa")),
@@ -454,6 +681,24 @@ func TestColIdent(t *testing.T) {
 	}
 }
 
+func TestFormatIDKeywords(t *testing.T) {
+	for keyword := range keywords {
+		for _, name := range []string{keyword, strings.ToUpper(keyword)} {
+			if got, want := String(NewColIdent(name)), "`"+name+"`"; got != want {
+				t.Errorf("String(%s): %s, want %s", name, got, want)
+			}
+		}
+	}
+	for keyword := range sqlServerKeywords {
+		if got, want := StringWithDialect(NewTableIdent(keyword), SQLServerDialect), "["+keyword+"]"; got != want {
+			t.Errorf("StringWithDialect(%s): %s, want %s", keyword, got, want)
+		}
+		if got := String(NewTableIdent(keyword)); got != keyword {
+			t.Errorf("String(%s): %s, want %s", keyword, got, keyword)
+		}
+	}
+}
+
 func TestColIdentMarshal(t *testing.T) {
 	str := NewColIdent("Ab")
 	b, err := json.Marshal(str)
@@ -502,6 +747,74 @@ func TestTableIdentMarshal(t *testing.T) {
 	}
 }
 
+func TestIdentText(t *testing.T) {
+	for _, name := range []string{"Ab", "a b", "Größe", "名前", "a`b", ""} {
+		col, err := NewColIdent(name).MarshalText()
+		if err != nil || string(col) != name {
+			t.Errorf("ColIdent(%q).MarshalText: %q, %v", name, col, err)
+		}
+		table, err := NewTableIdent(name).MarshalText()
+		if err != nil || string(table) != name {
+			t.Errorf("TableIdent(%q).MarshalText: %q, %v", name, table, err)
+		}
+		var outCol ColIdent
+		if err := outCol.UnmarshalText([]byte(name)); err != nil || !reflect.DeepEqual(outCol, NewColIdent(name)) {
+			t.Errorf("ColIdent.UnmarshalText(%q): %v, %v", name, outCol, err)
+		}
+		var outTable TableIdent
+		if err := outTable.UnmarshalText([]byte(name)); err != nil || outTable != NewTableIdent(name) {
+			t.Errorf("TableIdent.UnmarshalText(%q): %v, %v", name, outTable, err)
+		}
+	}
+
+	// The idents of a struct and the keys of a map
+	// are serialized with the names as written.
+	type config struct {
+		Column ColIdent
+		Tables map[TableIdent]int
+	}
+	in := config{
+		Column: NewColIdent("Größe"),
+		Tables: map[TableIdent]int{NewTableIdent("my table"): 1},
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Column":"Größe","Tables":{"my table":1}}`; string(b) != want {
+		t.Errorf("json.Marshal: %s, want %s", b, want)
+	}
+	var out config
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("json.Unmarshal: %v, want %v", out, in)
+	}
+	if got, want := out.Column.Lowered(), "größe"; got != want {
+		t.Errorf("Lowered: %s, want %s", got, want)
+	}
+}
+
+func TestIdentScan(t *testing.T) {
+	var col ColIdent
+	var table TableIdent
+	for _, src := range []interface{}{"a b", []byte("a b")} {
+		if err := col.Scan(src); err != nil || col.String() != "a b" {
+			t.Errorf("ColIdent.Scan(%v): %v, %v", src, col, err)
+		}
+		if err := table.Scan(src); err != nil || table.String() != "a b" {
+			t.Errorf("TableIdent.Scan(%v): %v, %v", src, table, err)
+		}
+	}
+	if err := col.Scan(nil); err != nil || !col.IsEmpty() {
+		t.Errorf("ColIdent.Scan(nil): %v, %v", col, err)
+	}
+	if err := table.Scan(1); err == nil {
+		t.Error("TableIdent.Scan(1): nil error")
+	}
+}
+
 func TestHexDecode(t *testing.T) {
 	testcase := []struct {
 		in, out string
@@ -529,6 +842,32 @@ func TestHexDecode(t *testing.T) {
 	}
 }
 
+func TestNumberValTypes(t *testing.T) {
+	testcases := []struct {
+		in  string
+		typ ValType
+	}{
+		{"10", IntVal},
+		{"10.50", DecimalVal},
+		{"10.", DecimalVal},
+		{".5", DecimalVal},
+		{"1.05e1", FloatVal},
+		{"1e3", FloatVal},
+		{".5E-2", FloatVal},
+	}
+	for _, tc := range testcases {
+		stmt, err := Parse("select " + tc.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		val, ok := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr.(*SQLVal)
+		if !ok || val.Type != tc.typ || string(val.Val) != tc.in {
+			t.Errorf("Parse(select %s): %#v, want %v", tc.in, val, tc.typ)
+		}
+	}
+}
+
 func TestCompliantName(t *testing.T) {
 	testcases := []struct {
 		in, out string
@@ -615,6 +954,9 @@ func TestSplitStatementToPieces(t *testing.T) {
 			"`createtime` datetime NOT NULL DEFAULT NOW() COMMENT 'create time;'," +
 			"`comment` varchar(100) NOT NULL DEFAULT '' COMMENT 'comment'," +
 			"PRIMARY KEY (`id`))",
+	}, {
+		input:  "DELIMITER $$\ncreate procedure p() begin select 1; end$$\nDELIMITER ;\nselect 2;",
+		output: "\ncreate procedure p() begin select 1; end;\nselect 2",
 	}}
 
 	for _, tcase := range testcases {
@@ -634,3 +976,100 @@ func TestSplitStatementToPieces(t *testing.T) {
 		}
 	}
 }
+
+func TestLockTablesWalk(t *testing.T) {
+	tree, err := Parse("lock tables t as a read, d.u write")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if name, ok := node.(TableName); ok {
+			got = append(got, String(name))
+		}
+		return true, nil
+	}, tree)
+	want := []string{"t", "d.u"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tables: %v, want %v", got, want)
+	}
+}
+
+func TestChildren(t *testing.T) {
+	tree, err := Parse("select a, b + 1 from t where c = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, child := range Children(tree.(*Select).SelectExprs) {
+		got = append(got, NodeName(child)+" "+String(child))
+	}
+	want := []string{"AliasedExpr a", "AliasedExpr b + 1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Children: %q, want %q", got, want)
+	}
+
+	// Walking with Children must visit the same nodes as Walk.
+	var walked, traversed []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		walked = append(walked, NodeName(node))
+		return true, nil
+	}, tree)
+	var traverse func(node SQLNode)
+	traverse = func(node SQLNode) {
+		traversed = append(traversed, NodeName(node))
+		for _, child := range Children(node) {
+			if child != nil {
+				traverse(child)
+			}
+		}
+	}
+	traverse(tree)
+	if !reflect.DeepEqual(traversed, walked) {
+		t.Errorf("traversal: %v, want %v", traversed, walked)
+	}
+
+	if got := Children(nil); got != nil {
+		t.Errorf("Children(nil): %v, want nil", got)
+	}
+	if got := NodeName(NewColIdent("a")); got != "ColIdent" {
+		t.Errorf("NodeName(ColIdent): %s, want ColIdent", got)
+	}
+}
+
+func TestPrepareParseStmt(t *testing.T) {
+	testcases := []struct {
+		in   string
+		text string
+		out  string
+		err  bool
+	}{{
+		in:   "prepare s from 'select * from t where a = ? and b = ?'",
+		text: "select * from t where a = ? and b = ?",
+		out:  "select * from t where a = :v1 and b = :v2",
+	}, {
+		in:   "prepare s from 'select * from'",
+		text: "select * from",
+		err:  true,
+	}, {
+		in: "prepare s from @sql",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		prepare := tree.(*Prepare)
+		if text, _ := prepare.Text(); text != tcase.text {
+			t.Errorf("Text(%s): %q, want %q", tcase.in, text, tcase.text)
+		}
+		stmt, err := prepare.ParseStmt()
+		if (err != nil) != tcase.err {
+			t.Errorf("ParseStmt(%s) err: %v", tcase.in, err)
+		}
+		if got := String(stmt); stmt != nil && got != tcase.out || stmt == nil && tcase.out != "" {
+			t.Errorf("ParseStmt(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
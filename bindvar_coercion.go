@@ -0,0 +1,410 @@
+package sqlparser
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+// SkippedBindVar is a bind variable that CoerceBindVarTypes left alone,
+// or that SampleBindVariables gave a string for, and why.
+type SkippedBindVar struct {
+	Name   string
+	Reason string
+}
+
+// BindVarCoercionError is the report of CoerceBindVarTypes on the bind
+// variables it didn't coerce. It's not a failure: the other bind
+// variables are coerced.
+type BindVarCoercionError struct {
+	Skipped []SkippedBindVar
+}
+
+func (e *BindVarCoercionError) Error() string {
+	reasons := make([]string, 0, len(e.Skipped))
+	for _, skipped := range e.Skipped {
+		reasons = append(reasons, skipped.Name+": "+skipped.Reason)
+	}
+	return "cannot coerce bind variables: " + strings.Join(reasons, "; ")
+}
+
+// CoerceBindVarTypes retypes the bind variables of stmt, like the ones
+// Normalize makes, to the types of the columns they're compared with:
+// in comparisons, IN lists and BETWEEN, and the columns they're
+// assigned to by UPDATE and INSERT. For example, the VarBinary of
+// a = :bv1 becomes a VarChar if a is a VARCHAR in schema, and the
+// Float64 of the tuple of a in ::bv2 becomes Decimal values if a is
+// a DECIMAL.
+//
+// A bind variable is only retyped if the conversion is lossless, like
+// 0.5 to a FLOAT or 12 to a SMALLINT, but not 0.1 to a FLOAT, 300 to a
+// TINYINT or a string to a number. A bind variable compared with columns
+// of different types is left alone. So are the ones whose column has an
+// unknown type, silently. CoerceBindVarTypes returns a
+// *BindVarCoercionError to report the others.
+func CoerceBindVarTypes(stmt Statement, bindVars map[string]*querypb.BindVariable, schema *Schema) error {
+	c := &bindVarCoercer{schema: schema, types: make(map[string][]querypb.Type)}
+	_ = WalkWithPath(func(node SQLNode, path []SQLNode) (bool, error) {
+		c.collect(node, path)
+		return true, nil
+	}, stmt)
+
+	names := make([]string, 0, len(c.types))
+	for name := range c.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var skipped []SkippedBindVar
+	for _, name := range names {
+		bv, ok := bindVars[name]
+		if !ok {
+			continue
+		}
+		types := c.types[name]
+		if len(types) > 1 {
+			typeNames := make([]string, len(types))
+			for i, typ := range types {
+				typeNames[i] = typ.String()
+			}
+			skipped = append(skipped, SkippedBindVar{
+				Name:   name,
+				Reason: "compared with columns of different types " + strings.Join(typeNames, ", "),
+			})
+			continue
+		}
+		coerced, reason := coerceBindVar(bv, types[0])
+		if reason != "" {
+			skipped = append(skipped, SkippedBindVar{Name: name, Reason: reason})
+			continue
+		}
+		bindVars[name] = coerced
+	}
+	if skipped != nil {
+		return &BindVarCoercionError{Skipped: skipped}
+	}
+	return nil
+}
+
+type bindVarCoercer struct {
+	schema *Schema
+	// types are the distinct types of the columns the bind
+	// variables are compared with, by name.
+	types map[string][]querypb.Type
+}
+
+// collect records the types of the columns node compares the bind
+// variables with. path holds the ancestors of node.
+func (c *bindVarCoercer) collect(node SQLNode, path []SQLNode) {
+	switch node := node.(type) {
+	case *ComparisonExpr:
+		if node.Operator == RegexpStr || node.Operator == NotRegexpStr {
+			return
+		}
+		c.pair(node.Left, node.Right, path)
+		if node.Operator != InStr && node.Operator != NotInStr {
+			c.pair(node.Right, node.Left, path)
+		}
+	case *RangeCond:
+		c.pair(node.Left, node.From, path)
+		c.pair(node.Left, node.To, path)
+	case *UpdateExpr:
+		c.pair(node.Name, node.Expr, path)
+	case *Insert:
+		rows, ok := node.Rows.(Values)
+		if !ok || len(node.Columns) == 0 {
+			return
+		}
+		path = append(path[:len(path):len(path)], node)
+		for _, row := range rows {
+			for i, value := range row {
+				if i < len(node.Columns) {
+					c.pair(&ColName{Name: node.Columns[i]}, value, path)
+				}
+			}
+		}
+	}
+}
+
+// pair records the type of left, if it's a column, for the bind
+// variables of right.
+func (c *bindVarCoercer) pair(left, right Expr, path []SQLNode) {
+	if tuple, ok := left.(ValTuple); ok {
+		// A row comparison, like (a, b) = (:v1, :v2).
+		if values, ok := right.(ValTuple); ok && len(values) == len(tuple) {
+			for i := range tuple {
+				c.pair(tuple[i], values[i], path)
+			}
+		}
+		return
+	}
+	col, ok := left.(*ColName)
+	if !ok {
+		return
+	}
+	var names []string
+	switch right := right.(type) {
+	case *SQLVal:
+		if right.Type == ValArg {
+			names = append(names, string(right.Val[1:]))
+		}
+	case ListArg:
+		names = append(names, string(right[2:]))
+	case ValTuple:
+		for _, value := range right {
+			if value, ok := value.(*SQLVal); ok && value.Type == ValArg {
+				names = append(names, string(value.Val[1:]))
+			}
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	typ, ok := c.columnType(col, path)
+	if !ok {
+		return
+	}
+	for _, name := range names {
+		if !containsType(c.types[name], typ) {
+			c.types[name] = append(c.types[name], typ)
+		}
+	}
+}
+
+// columnType returns the type of col, which is in the innermost
+// statement of path, or in one of the statements around it for
+// a correlated subquery.
+func (c *bindVarCoercer) columnType(col *ColName, path []SQLNode) (querypb.Type, bool) {
+	if c.schema == nil {
+		return UnknownType, false
+	}
+	name := col.Name.Lowered()
+	for i := len(path) - 1; i >= 0; i-- {
+		var tables map[string]string
+		var derived bool
+		switch node := path[i].(type) {
+		case *Select:
+			tables, derived = scopeTables(node.From)
+		case *Update:
+			tables, derived = scopeTables(node.TableExprs)
+		case *Delete:
+			tables, derived = scopeTables(node.TableExprs)
+		case *Insert:
+			tables = map[string]string{node.Table.Name.String(): node.Table.Name.String()}
+		default:
+			continue
+		}
+		if !col.Qualifier.IsEmpty() {
+			table, ok := tables[col.Qualifier.Name.String()]
+			if !ok {
+				if derived {
+					return UnknownType, false
+				}
+				continue
+			}
+			typ, ok := c.schema.tables[table][name]
+			return typ, ok
+		}
+		found := false
+		var typ querypb.Type
+		for _, table := range tables {
+			if colType, ok := c.schema.tables[table][name]; ok {
+				if found && colType != typ {
+					return UnknownType, false
+				}
+				typ, found = colType, true
+			}
+		}
+		if found {
+			return typ, true
+		}
+		if derived {
+			// The column may be one of a derived table.
+			return UnknownType, false
+		}
+	}
+	return UnknownType, false
+}
+
+// scopeTables returns the names of the tables of exprs by the names
+// they're referred to with, and whether exprs has derived tables.
+func scopeTables(exprs TableExprs) (tables map[string]string, derived bool) {
+	tables = make(map[string]string)
+	var add func(expr TableExpr)
+	add = func(expr TableExpr) {
+		switch expr := expr.(type) {
+		case *AliasedTableExpr:
+			name, ok := expr.Expr.(TableName)
+			if !ok {
+				derived = true
+				return
+			}
+			if expr.As.IsEmpty() {
+				tables[name.Name.String()] = name.Name.String()
+			} else {
+				tables[expr.As.String()] = name.Name.String()
+			}
+		case *JoinTableExpr:
+			add(expr.LeftExpr)
+			add(expr.RightExpr)
+		case *ParenTableExpr:
+			for _, expr := range expr.Exprs {
+				add(expr)
+			}
+		}
+	}
+	for _, expr := range exprs {
+		add(expr)
+	}
+	return tables, derived
+}
+
+func containsType(types []querypb.Type, typ querypb.Type) bool {
+	for _, t := range types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// coerceBindVar returns bv retyped to typ, or the reason it can't be
+// without loss. All the values of a tuple must convert.
+func coerceBindVar(bv *querypb.BindVariable, typ querypb.Type) (*querypb.BindVariable, string) {
+	if bv.Type != querypb.Type_TUPLE {
+		valueType, value, reason := coerceValue(bv.Type, bv.Value, typ)
+		if reason != "" {
+			return nil, reason
+		}
+		return &querypb.BindVariable{Type: valueType, Value: value}, ""
+	}
+	values := make([]*querypb.Value, len(bv.Values))
+	for i, v := range bv.Values {
+		valueType, value, reason := coerceValue(v.Type, v.Value, typ)
+		if reason != "" {
+			return nil, reason
+		}
+		values[i] = &querypb.Value{Type: valueType, Value: value}
+	}
+	return &querypb.BindVariable{Type: querypb.Type_TUPLE, Values: values}, ""
+}
+
+// coerceValue converts the value val of type from to type to, or
+// returns the reason it can't without loss. NULL stays NULL.
+func coerceValue(from querypb.Type, val []byte, to querypb.Type) (querypb.Type, []byte, string) {
+	if from == to || from == sqltypes.Null {
+		return from, val, ""
+	}
+	fromClass := classOf(from)
+	switch {
+	case isStringType(to) && isStringType(from):
+		return to, val, ""
+	case classOf(to) == temporalClass && (fromClass == stringClass || fromClass == temporalClass):
+		if !validTemporal(string(val), to) {
+			return from, nil, fmt.Sprintf("%q is not a valid %v", val, to)
+		}
+		return to, val, ""
+	case classOf(to) == numericClass && fromClass == numericClass && from != sqltypes.Bit:
+		value, reason := coerceNumber(val, to)
+		if reason != "" {
+			return from, nil, reason
+		}
+		return to, value, ""
+	}
+	return from, nil, fmt.Sprintf("%v compares differently with %v", from, to)
+}
+
+// isStringType returns true for the types of values that compare as
+// strings.
+func isStringType(typ querypb.Type) bool {
+	return sqltypes.IsText(typ) || sqltypes.IsBinary(typ) || typ == sqltypes.Enum || typ == sqltypes.Set
+}
+
+// temporalLayouts are the layouts of the values of temporal types.
+// A fractional second is also accepted after the seconds.
+var temporalLayouts = map[querypb.Type][]string{
+	sqltypes.Date:      {"2006-01-02"},
+	sqltypes.Datetime:  {"2006-01-02 15:04:05", "2006-01-02"},
+	sqltypes.Timestamp: {"2006-01-02 15:04:05", "2006-01-02"},
+	sqltypes.Time:      {"15:04:05"},
+}
+
+func validTemporal(val string, typ querypb.Type) bool {
+	for _, layout := range temporalLayouts[typ] {
+		if _, err := time.Parse(layout, val); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// integralBits are the sizes of the integral types.
+var integralBits = map[querypb.Type]uint{
+	sqltypes.Int8:   8,
+	sqltypes.Uint8:  8,
+	sqltypes.Int16:  16,
+	sqltypes.Uint16: 16,
+	sqltypes.Int24:  24,
+	sqltypes.Uint24: 24,
+	sqltypes.Int32:  32,
+	sqltypes.Uint32: 32,
+	sqltypes.Int64:  64,
+	sqltypes.Uint64: 64,
+	sqltypes.Year:   16,
+}
+
+// coerceNumber converts the number val to the numeric type to, or
+// returns the reason it can't exactly.
+func coerceNumber(val []byte, to querypb.Type) ([]byte, string) {
+	r, ok := new(big.Rat).SetString(string(val))
+	if !ok {
+		return nil, fmt.Sprintf("%s is not a number", val)
+	}
+	inexact := fmt.Sprintf("%s is not exactly a %v", val, to)
+	switch {
+	case sqltypes.IsIntegral(to):
+		bits, ok := integralBits[to]
+		if !ok || !r.IsInt() {
+			return nil, inexact
+		}
+		min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), bits)
+		if sqltypes.IsSigned(to) {
+			max.Rsh(max, 1)
+			min.Neg(max)
+		}
+		max.Sub(max, big.NewInt(1))
+		n := r.Num()
+		if n.Cmp(min) < 0 || n.Cmp(max) > 0 {
+			return nil, fmt.Sprintf("%s is out of the range of %v", val, to)
+		}
+		return []byte(n.String()), ""
+	case to == sqltypes.Float32:
+		if _, exact := r.Float32(); !exact {
+			return nil, inexact
+		}
+		return val, ""
+	case to == sqltypes.Float64:
+		if _, exact := r.Float64(); !exact {
+			return nil, inexact
+		}
+		return val, ""
+	case to == sqltypes.Decimal:
+		if !bytes.ContainsAny(val, "eE") {
+			return val, ""
+		}
+		// A decimal has no exponent. Its digits are the ones needed
+		// to write r exactly, which it always is with enough of them.
+		for prec := 0; ; prec++ {
+			s := r.FloatString(prec)
+			if d, _ := new(big.Rat).SetString(s); d.Cmp(r) == 0 {
+				return []byte(s), ""
+			}
+		}
+	}
+	return nil, inexact
+}
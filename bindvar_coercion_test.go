@@ -0,0 +1,175 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func TestCoerceBindVarTypes(t *testing.T) {
+	ddl, err := Parse("create table t (id bigint unsigned, tiny tinyint, name varchar(10), code varbinary(10), price decimal(10, 2), ratio double, created datetime, day date, kind enum('a', 'b'))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := NewSchema()
+	schema.AddTable("t", ddl.(*DDL).TableSpec)
+	schema.AddColumn("u", "id", sqltypes.Int32)
+	schema.AddColumn("u", "name", sqltypes.VarChar)
+
+	testcases := []struct {
+		in      string
+		out     map[string]*querypb.BindVariable
+		skipped []SkippedBindVar
+	}{{
+		in: "select * from t where name = 'x' and code = 'y' and kind = 'a'",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.VarChar, Value: []byte("x")},
+			"bv2": sqltypes.BytesBindVariable([]byte("y")),
+			"bv3": {Type: sqltypes.Enum, Value: []byte("a")},
+		},
+	}, {
+		in: "select * from t where price = 1.5 and ratio > 0.5 and 1 <= id and tiny between -128 and 127",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.Decimal, Value: []byte("1.5")},
+			"bv2": {Type: sqltypes.Float64, Value: []byte("0.5")},
+			"bv3": {Type: sqltypes.Uint64, Value: []byte("1")},
+			"bv4": {Type: sqltypes.Int8, Value: []byte("-128")},
+			"bv5": {Type: sqltypes.Int8, Value: []byte("127")},
+		},
+	}, {
+		in: "select * from t where price = 1.25e1 and id = 2.0",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.Decimal, Value: []byte("12.5")},
+			"bv2": {Type: sqltypes.Uint64, Value: []byte("2")},
+		},
+	}, {
+		in: "select * from t where ratio = 0.1 and tiny = 300 and id = 'x' and name = 1",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.Decimal, Value: []byte("0.1")},
+			"bv2": sqltypes.Int64BindVariable(300),
+			"bv3": sqltypes.BytesBindVariable([]byte("x")),
+			"bv4": sqltypes.Int64BindVariable(1),
+		},
+		skipped: []SkippedBindVar{
+			{Name: "bv1", Reason: "0.1 is not exactly a FLOAT64"},
+			{Name: "bv2", Reason: "300 is out of the range of INT8"},
+			{Name: "bv3", Reason: "VARBINARY compares differently with UINT64"},
+			{Name: "bv4", Reason: "INT64 compares differently with VARCHAR"},
+		},
+	}, {
+		in: "select * from t where created > '2024-01-01 10:00:00' and day = '2024-01-01' and created < 'tomorrow'",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.Datetime, Value: []byte("2024-01-01 10:00:00")},
+			"bv2": {Type: sqltypes.Date, Value: []byte("2024-01-01")},
+			"bv3": sqltypes.BytesBindVariable([]byte("tomorrow")),
+		},
+		skipped: []SkippedBindVar{
+			{Name: "bv3", Reason: `"tomorrow" is not a valid DATETIME`},
+		},
+	}, {
+		in: "select * from t where price in (1.5, 2) and name not in ('a', 'b')",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{
+				{Type: sqltypes.Decimal, Value: []byte("1.5")},
+				{Type: sqltypes.Decimal, Value: []byte("2")},
+			}},
+			"bv2": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{
+				{Type: sqltypes.VarChar, Value: []byte("a")},
+				{Type: sqltypes.VarChar, Value: []byte("b")},
+			}},
+		},
+	}, {
+		in: "select * from t where tiny in (1, 1000)",
+		out: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.TestBindVariable([]interface{}{1, 1000}),
+		},
+		skipped: []SkippedBindVar{
+			{Name: "bv1", Reason: "1000 is out of the range of INT8"},
+		},
+	}, {
+		// The alias is resolved, and the unqualified name of the
+		// subquery is the one of u.
+		in: "select * from t as x join u on x.id = 1 where u.id = 1 and exists (select 1 from u where name = 'a' and x.name = 'b')",
+		out: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": {Type: sqltypes.VarChar, Value: []byte("a")},
+			"bv3": {Type: sqltypes.VarChar, Value: []byte("b")},
+		},
+		skipped: []SkippedBindVar{
+			{Name: "bv1", Reason: "compared with columns of different types UINT64, INT32"},
+		},
+	}, {
+		// Unknown and ambiguous columns are left alone silently.
+		in: "select * from t join u where id = 1 and other = 'b' and d.x = 'c' and t.name = lower('d')",
+		out: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.BytesBindVariable([]byte("b")),
+			"bv3": sqltypes.BytesBindVariable([]byte("c")),
+			"bv4": sqltypes.BytesBindVariable([]byte("d")),
+		},
+	}, {
+		in: "select * from (select id from u) as d where id = 1",
+		out: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		in: "update t set name = 'a', price = 2 where id = 3",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.VarChar, Value: []byte("a")},
+			"bv2": {Type: sqltypes.Decimal, Value: []byte("2")},
+			"bv3": {Type: sqltypes.Uint64, Value: []byte("3")},
+		},
+	}, {
+		in: "insert into t(name, price) values ('a', 1.5), ('b', null) on duplicate key update tiny = 1",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.VarChar, Value: []byte("a")},
+			"bv2": {Type: sqltypes.Decimal, Value: []byte("1.5")},
+			"bv3": {Type: sqltypes.VarChar, Value: []byte("b")},
+			"bv4": {Type: sqltypes.Int8, Value: []byte("1")},
+		},
+	}, {
+		in: "delete from t where (id, name) = (1, 'a')",
+		out: map[string]*querypb.BindVariable{
+			"bv1": {Type: sqltypes.Uint64, Value: []byte("1")},
+			"bv2": {Type: sqltypes.VarChar, Value: []byte("a")},
+		},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		bindVars := make(map[string]*querypb.BindVariable)
+		Normalize(stmt, bindVars, "bv")
+		err = CoerceBindVarTypes(stmt, bindVars, schema)
+		var skipped []SkippedBindVar
+		if err != nil {
+			coercionErr, ok := err.(*BindVarCoercionError)
+			if !ok {
+				t.Errorf("CoerceBindVarTypes(%s): %v", tcase.in, err)
+				continue
+			}
+			skipped = coercionErr.Skipped
+		}
+		if !reflect.DeepEqual(skipped, tcase.skipped) {
+			t.Errorf("CoerceBindVarTypes(%s) skipped:\n%v, want\n%v", tcase.in, skipped, tcase.skipped)
+		}
+		if !reflect.DeepEqual(bindVars, tcase.out) {
+			t.Errorf("CoerceBindVarTypes(%s):\n%v, want\n%v", tcase.in, bindVars, tcase.out)
+		}
+	}
+}
+
+func TestBindVarCoercionError(t *testing.T) {
+	err := &BindVarCoercionError{Skipped: []SkippedBindVar{
+		{Name: "bv1", Reason: "0.1 is not exactly a FLOAT64"},
+		{Name: "bv2", Reason: "INT64 compares differently with VARCHAR"},
+	}}
+	want := "cannot coerce bind variables: bv1: 0.1 is not exactly a FLOAT64; bv2: INT64 compares differently with VARCHAR"
+	if got := err.Error(); got != want {
+		t.Errorf("Error(): %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+package sqlparser
+
+import "strings"
+
+// CacheabilityOptions are the options of IsCacheable.
+type CacheabilityOptions struct {
+	// VolatileTables are the tables whose rows change too often for
+	// the results that read them to be cached. A table without a
+	// qualifier is volatile in every database.
+	VolatileTables []TableName
+}
+
+// Reason is why IsCacheable found that a statement can't be cached.
+type Reason struct {
+	Message string
+	// Node is the node of the statement that the reason is about,
+	// like the call to NOW().
+	Node SQLNode
+}
+
+// NonDeterministicFunctions are the functions, by lowercased name,
+// whose results can change between two calls with the same arguments.
+// Callers can add to it.
+var NonDeterministicFunctions = map[string]bool{
+	"benchmark":         true,
+	"connection_id":     true,
+	"curdate":           true,
+	"current_date":      true,
+	"current_role":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"curtime":           true,
+	"database":          true,
+	"found_rows":        true,
+	"get_lock":          true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"master_pos_wait":   true,
+	"now":               true,
+	"rand":              true,
+	"random_bytes":      true,
+	"release_lock":      true,
+	"row_count":         true,
+	"schema":            true,
+	"session_user":      true,
+	"sleep":             true,
+	"sysdate":           true,
+	"system_user":       true,
+	"unix_timestamp":    true,
+	"user":              true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"uuid_short":        true,
+}
+
+// IsCacheable returns true if the rows that stmt returns can be
+// cached, that is if they're the same every time it's executed while
+// the tables it reads don't change. Otherwise, it returns the reasons
+// why, in the order of the nodes they're about: stmt is not a select,
+// or calls a function of NonDeterministicFunctions, reads a user or
+// system variable, or a volatile table, locks the rows it reads, or
+// stores them with an INTO clause.
+func IsCacheable(stmt Statement, opts CacheabilityOptions) (bool, []Reason) {
+	if _, ok := stmt.(SelectStatement); !ok {
+		return false, []Reason{{Message: "not a select", Node: stmt}}
+	}
+	var reasons []Reason
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Select:
+			if node.Lock != "" {
+				reasons = append(reasons, Reason{Message: "locks rows", Node: node})
+			}
+			if node.Into != nil {
+				reasons = append(reasons, Reason{Message: "has an into clause", Node: node.Into})
+			}
+		case *Union:
+			if node.Lock != "" {
+				reasons = append(reasons, Reason{Message: "locks rows", Node: node})
+			}
+		case *FuncExpr:
+			if node.Qualifier.IsEmpty() && NonDeterministicFunctions[node.Name.Lowered()] {
+				reasons = append(reasons, Reason{Message: "calls non-deterministic function " + node.Name.Lowered(), Node: node})
+			}
+		case *ColName:
+			if strings.HasPrefix(node.Name.String(), "@") {
+				reasons = append(reasons, Reason{Message: "reads variable " + node.Name.String(), Node: node})
+			}
+		case Nextval, *NextValueExpr:
+			reasons = append(reasons, Reason{Message: "reads the next value of a sequence", Node: node})
+		case *AliasedTableExpr:
+			if name, ok := node.Expr.(TableName); ok && isVolatileTable(name, opts.VolatileTables) {
+				reasons = append(reasons, Reason{Message: "reads volatile table " + String(name), Node: node})
+			}
+		}
+		return true, nil
+	}, stmt)
+	return len(reasons) == 0, reasons
+}
+
+func isVolatileTable(name TableName, volatile []TableName) bool {
+	for _, table := range volatile {
+		if table.Name != name.Name {
+			continue
+		}
+		if table.Qualifier.IsEmpty() || table.Qualifier == name.Qualifier {
+			return true
+		}
+	}
+	return false
+}
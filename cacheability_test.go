@@ -0,0 +1,85 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsCacheable(t *testing.T) {
+	opts := CacheabilityOptions{
+		VolatileTables: []TableName{
+			{Name: NewTableIdent("queue")},
+			{Name: NewTableIdent("sessions"), Qualifier: NewTableIdent("app")},
+		},
+	}
+	testcases := []struct {
+		in      string
+		reasons []string
+	}{{
+		in: "select a, upper(b), count(*) from t where c = 1 group by a",
+	}, {
+		in: "select a from t union select b from u",
+	}, {
+		in: "select a from sessions join other.queue2 on sessions.id = queue2.id join db.sessions",
+	}, {
+		in:      "select now(), RAND() from t where d < current_timestamp",
+		reasons: []string{"calls non-deterministic function now", "calls non-deterministic function rand", "calls non-deterministic function current_timestamp"},
+	}, {
+		in:      "select a from t where b = last_insert_id() and c in (select uuid() from u)",
+		reasons: []string{"calls non-deterministic function last_insert_id", "calls non-deterministic function uuid"},
+	}, {
+		in:      "select @x, @@session.sql_mode from t",
+		reasons: []string{"reads variable @x", "reads variable @@session.sql_mode"},
+	}, {
+		in:      "select a from queue join db.queue join app.sessions where a in (select b from app.queue)",
+		reasons: []string{"reads volatile table queue", "reads volatile table db.queue", "reads volatile table app.sessions", "reads volatile table app.queue"},
+	}, {
+		in:      "select a from t where b = 1 for update",
+		reasons: []string{"locks rows"},
+	}, {
+		in:      "select a from t union select b from u lock in share mode",
+		reasons: []string{"locks rows"},
+	}, {
+		in:      "select a from t into outfile 'x'",
+		reasons: []string{"has an into clause"},
+	}, {
+		in:      "select next 2 values from seq",
+		reasons: []string{"reads the next value of a sequence"},
+	}, {
+		in:      "update t set a = 1",
+		reasons: []string{"not a select"},
+	}, {
+		in:      "set @x = 1",
+		reasons: []string{"not a select"},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		cacheable, reasons := IsCacheable(stmt, opts)
+		var got []string
+		for _, reason := range reasons {
+			got = append(got, reason.Message)
+			if reason.Node == nil {
+				t.Errorf("IsCacheable(%s): %s has no node", tcase.in, reason.Message)
+			}
+		}
+		if !reflect.DeepEqual(got, tcase.reasons) {
+			t.Errorf("IsCacheable(%s): %q, want %q", tcase.in, got, tcase.reasons)
+		}
+		if cacheable != (len(tcase.reasons) == 0) {
+			t.Errorf("IsCacheable(%s): %v, want %v", tcase.in, cacheable, !cacheable)
+		}
+	}
+
+	stmt, err := Parse("select a from t where b < now()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, reasons := IsCacheable(stmt, CacheabilityOptions{})
+	if len(reasons) != 1 || reasons[0].Node != stmt.(*Select).Where.Expr.(*ComparisonExpr).Right {
+		t.Errorf("IsCacheable: %v, want the call to now()", reasons)
+	}
+}
@@ -0,0 +1,111 @@
+package sqlparser
+
+import "fmt"
+
+// Capabilities are the features of a target engine that Unsupported
+// checks statements against. The zero value supports none of the
+// features, and subqueries of any depth. The parser doesn't parse
+// FULL OUTER JOIN nor LATERAL derived tables, so they need no
+// capability: a statement that has them doesn't parse.
+type Capabilities struct {
+	// WindowFunctions is set if the engine supports the OVER clause
+	// of window functions and the WINDOW clause of selects.
+	WindowFunctions bool
+	// CTEs is set if the engine supports the WITH clause, and
+	// RecursiveCTEs if it also supports WITH RECURSIVE.
+	CTEs          bool
+	RecursiveCTEs bool
+	// JSONOperators is set if the engine supports the -> and ->>
+	// operators.
+	JSONOperators bool
+	// MaxSubqueryDepth is the deepest that subqueries can be nested,
+	// 1 for subqueries that can't have subqueries of their own. It's
+	// not checked if it's 0.
+	MaxSubqueryDepth int
+}
+
+// UnsupportedFinding is a part of a statement that needs a capability
+// that the target engine lacks, see Unsupported.
+type UnsupportedFinding struct {
+	// Node is the node that needs the capability: the *With of a
+	// WITH clause, the *FuncExpr of a window function, the *Select
+	// that has a WINDOW clause, the *BinaryExpr of a JSON operator or
+	// the *Subquery that is nested too deep.
+	Node SQLNode
+	// Capability is the name of the field of Capabilities that is
+	// lacking, like "CTEs".
+	Capability string
+	Message    string
+}
+
+// Unsupported returns the parts of stmt that need capabilities that
+// caps lacks, in the order they're found. The derived tables and the
+// common table expressions count as subqueries for MaxSubqueryDepth,
+// and only the subqueries that are one level too deep are reported,
+// not the ones they have.
+//
+// The common table expressions can be replaced with derived tables,
+// see InlineCTEs, unless they're recursive.
+func Unsupported(stmt Statement, caps Capabilities) []UnsupportedFinding {
+	u := &unsupportedFinder{caps: caps}
+	var walk func(depth int) Visit
+	walk = func(depth int) Visit {
+		return func(node SQLNode) (bool, error) {
+			d := depth
+			if sub, ok := node.(*Subquery); ok {
+				d++
+				if max := caps.MaxSubqueryDepth; max > 0 && d == max+1 {
+					u.add(sub, "MaxSubqueryDepth", "subquery nested %d deep exceeds the limit of %d", d, max)
+				}
+			} else {
+				u.checkNode(node)
+			}
+			return false, node.walkSubtree(walk(d))
+		}
+	}
+	_ = Walk(walk(0), stmt)
+	return u.findings
+}
+
+type unsupportedFinder struct {
+	caps     Capabilities
+	findings []UnsupportedFinding
+}
+
+func (u *unsupportedFinder) add(node SQLNode, capability string, format string, args ...interface{}) {
+	u.findings = append(u.findings, UnsupportedFinding{
+		Node:       node,
+		Capability: capability,
+		Message:    fmt.Sprintf(format, args...),
+	})
+}
+
+func (u *unsupportedFinder) checkNode(node SQLNode) {
+	switch node := node.(type) {
+	case *With:
+		if node == nil {
+			return
+		}
+		switch {
+		case !u.caps.CTEs:
+			u.add(node, "CTEs", "common table expressions are not supported")
+		case node.Recursive && !u.caps.RecursiveCTEs:
+			u.add(node, "RecursiveCTEs", "recursive common table expressions are not supported")
+		}
+	case *Select:
+		if len(node.Windows) > 0 && !u.caps.WindowFunctions {
+			u.add(node, "WindowFunctions", "window clause is not supported")
+		}
+	case *FuncExpr:
+		if node.Over != nil && !u.caps.WindowFunctions {
+			u.add(node, "WindowFunctions", "window function %s is not supported", node.Name.String())
+		}
+	case *BinaryExpr:
+		switch node.Operator {
+		case JSONExtractOp, JSONUnquoteExtractOp:
+			if !u.caps.JSONOperators {
+				u.add(node, "JSONOperators", "json operator %s is not supported", node.Operator)
+			}
+		}
+	}
+}
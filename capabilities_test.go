@@ -0,0 +1,87 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnsupported(t *testing.T) {
+	all := Capabilities{
+		WindowFunctions: true,
+		CTEs:            true,
+		RecursiveCTEs:   true,
+		JSONOperators:   true,
+	}
+	testcases := []struct {
+		in   string
+		caps Capabilities
+		out  []string
+	}{{
+		in:   "select a from t where b in (select b from u)",
+		caps: Capabilities{MaxSubqueryDepth: 1},
+	}, {
+		in:   "with c as (select a from t) select row_number() over w, data->>'$.a' from c window w as (order by a)",
+		caps: all,
+	}, {
+		in: "with c as (select a from t) select * from c",
+		out: []string{
+			"CTEs: common table expressions are not supported",
+		},
+	}, {
+		in: "with c as (select a from t) delete from u where a in (select a from c)",
+		out: []string{
+			"CTEs: common table expressions are not supported",
+		},
+	}, {
+		in:   "with recursive c (n) as (select 1 union all select n + 1 from c where n < 3) select * from c",
+		caps: Capabilities{CTEs: true},
+		out: []string{
+			"RecursiveCTEs: recursive common table expressions are not supported",
+		},
+	}, {
+		in: "select rank() over (partition by a order by b), sum(c) over w from t window w as (order by b)",
+		out: []string{
+			"WindowFunctions: window clause is not supported",
+			"WindowFunctions: window function rank is not supported",
+			"WindowFunctions: window function sum is not supported",
+		},
+	}, {
+		in: "select data->'$.a' from t where data->>'$.b' = 'x'",
+		out: []string{
+			"JSONOperators: json operator -> is not supported",
+			"JSONOperators: json operator ->> is not supported",
+		},
+	}, {
+		// Only the subquery that is one level too deep is reported.
+		in:   "select * from t where a in (select a from u where b in (select b from v where c in (select c from w)))",
+		caps: Capabilities{MaxSubqueryDepth: 1},
+		out: []string{
+			"MaxSubqueryDepth: subquery nested 2 deep exceeds the limit of 1",
+		},
+	}, {
+		// Sibling subqueries are at the same depth.
+		in:   "select * from t where (select 1 from dual) = (select 2 from dual) and a in (select a from u)",
+		caps: Capabilities{MaxSubqueryDepth: 1},
+	}, {
+		// Derived tables and common table expressions count.
+		in:   "with c as (select * from (select a from t) as d) select * from c",
+		caps: Capabilities{CTEs: true, MaxSubqueryDepth: 1},
+		out: []string{
+			"MaxSubqueryDepth: subquery nested 2 deep exceeds the limit of 1",
+		},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		var out []string
+		for _, f := range Unsupported(stmt, tcase.caps) {
+			out = append(out, f.Capability+": "+f.Message)
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("Unsupported(%q):\n%q, want\n%q", tcase.in, out, tcase.out)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package sqlparser
+
+import "reflect"
+
+// DeepCopy returns a copy of node that shares nothing with it, so
+// that it can be changed without changing node, for instance when node
+// is cached. Values that aren't SQLNodes, like the Metadata of a
+// ColName, are shared.
+func DeepCopy(node SQLNode) SQLNode {
+	if node == nil {
+		return nil
+	}
+	return cloneNode(node)
+}
+
+// cloneNode returns a deep copy of node. Values that aren't
+// SQLNodes, like the Metadata of a ColName, are not copied.
+func cloneNode(node SQLNode) SQLNode {
+	return cloneValue(reflect.ValueOf(node)).Interface().(SQLNode)
+}
+
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		if _, ok := v.Interface().(SQLNode); !ok {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				c.Field(i).Set(cloneValue(v.Field(i)))
+			}
+		}
+		if src, ok := c.Addr().Interface().(*statementSource); ok {
+			// The source text is immutable, but not the clauses.
+			src.extensions = cloneExtensionClauses(src.extensions)
+		}
+		return c
+	}
+	return v
+}
+
+// cloneExtensionClauses returns copies of clauses.
+func cloneExtensionClauses(clauses []*ExtensionClause) []*ExtensionClause {
+	if clauses == nil {
+		return nil
+	}
+	c := make([]*ExtensionClause, len(clauses))
+	for i, clause := range clauses {
+		copied := *clause
+		c[i] = &copied
+	}
+	return c
+}
@@ -0,0 +1,492 @@
+package sqlparser
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Usage is how a statement uses the columns of a table, see
+// ColumnUsage. The columns are lowercased, and the lists are sorted
+// and have no duplicates.
+type Usage struct {
+	// Equality are the columns compared for equality with =, <=> or
+	// IN, or tested with IS NULL, against values or expressions that
+	// don't depend on the columns of the statement.
+	Equality []string
+	// Range are the columns compared with <, <=, >, >=, BETWEEN or
+	// LIKE against such values.
+	Range []string
+	// Join are the columns compared with the columns of other tables,
+	// in ON or WHERE clauses, or merged by USING or a NATURAL JOIN.
+	Join []string
+	// GroupBy are the columns that GROUP BY clauses group on.
+	GroupBy []string
+	// OrderBy are the columns that ORDER BY clauses sort on.
+	OrderBy []OrderColumn
+	// Select are the columns that select lists refer to. A * stands
+	// for all the columns of its tables.
+	Select []string
+	// Subqueries is how the subqueries and the derived tables of the
+	// statement use the columns of the table, or nil if they don't.
+	// It's always nil with the MergeSubqueries option.
+	Subqueries *Usage
+}
+
+// OrderColumn is a column of an ORDER BY clause.
+type OrderColumn struct {
+	Column string
+	// Direction is AscScr or DescScr.
+	Direction string
+	// Position is the position of the column in the ORDER BY
+	// clause, from 1.
+	Position int
+}
+
+// ColumnUsageOptions controls optional behavior of
+// ColumnUsageWithOptions.
+type ColumnUsageOptions struct {
+	// MergeSubqueries records the columns used by the subqueries
+	// with the ones of the statement, instead of in Subqueries.
+	MergeSubqueries bool
+}
+
+// ColumnUsage returns how stmt uses the columns of each of its tables,
+// by table name as written in the FROM clauses. It's meant to find the
+// columns worth indexing. The unqualified columns are attributed to
+// their table with QualifyColumns, so schema must have the columns of
+// all the tables of stmt, and aliases are resolved to the tables they
+// stand for.
+//
+// Only the columns that can be attributed to a single table are
+// reported: the columns of derived tables and the columns merged by
+// USING or a NATURAL JOIN are not, though the columns they come from
+// are. Only the select of an INSERT is analyzed: its ON DUPLICATE KEY
+// UPDATE clause assigns columns, and refers to the inserted values
+// with its row alias, see IsInsertValueRef. An error is returned if a
+// table or a column is unknown, or if a column is ambiguous. stmt
+// itself is not modified.
+func ColumnUsage(stmt Statement, schema *Schema) (map[TableName]*Usage, error) {
+	return ColumnUsageWithOptions(stmt, schema, ColumnUsageOptions{})
+}
+
+// ColumnUsageWithOptions is the same as ColumnUsage except its
+// behavior is controlled by opts.
+func ColumnUsageWithOptions(stmt Statement, schema *Schema, opts ColumnUsageOptions) (map[TableName]*Usage, error) {
+	var root SelectStatement
+	switch stmt := cloneNode(stmt).(type) {
+	case SelectStatement:
+		root = stmt
+	case *Insert:
+		root, _ = stmt.Rows.(SelectStatement)
+	case *Update:
+		root = &Select{From: stmt.TableExprs, Where: stmt.Where, OrderBy: stmt.OrderBy}
+	case *Delete:
+		root = &Select{From: stmt.TableExprs, Where: stmt.Where, OrderBy: stmt.OrderBy}
+	}
+	usages := make(map[TableName]*Usage)
+	if root == nil {
+		return usages, nil
+	}
+
+	// The selects of unions are qualified one by one. QualifyColumns
+	// takes care of the subqueries.
+	err := WalkSelects(root, func(sel SelectStatement) (bool, error) {
+		if sel, ok := sel.(*Select); ok {
+			return false, QualifyColumns(sel, schema.columns)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c := &usageCollector{schema: schema, opts: opts, usages: usages}
+	_ = WalkWithPath(func(node SQLNode, path []SQLNode) (bool, error) {
+		if sel, ok := node.(*Select); ok {
+			c.addSelect(sel, path)
+		}
+		return true, nil
+	}, root)
+	for _, usage := range usages {
+		usage.sort()
+	}
+	return usages, nil
+}
+
+// UsageTables returns the tables of usages, sorted by name, so that
+// reports can be compared.
+func UsageTables(usages map[TableName]*Usage) []TableName {
+	tables := make([]TableName, 0, len(usages))
+	for table := range usages {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Qualifier != tables[j].Qualifier {
+			return tables[i].Qualifier.String() < tables[j].Qualifier.String()
+		}
+		return tables[i].Name.String() < tables[j].Name.String()
+	})
+	return tables
+}
+
+type usageCollector struct {
+	schema *Schema
+	opts   ColumnUsageOptions
+	usages map[TableName]*Usage
+	// selects are the current select and the ones around it,
+	// innermost first, where its columns can come from.
+	selects []*Select
+	// inSubquery is set if the current select is a subquery.
+	inSubquery bool
+}
+
+// addSelect records the columns used by the clauses of sel, but not
+// by its subqueries. path holds the ancestors of sel.
+func (c *usageCollector) addSelect(sel *Select, path []SQLNode) {
+	c.selects = append(c.selects[:0], sel)
+	c.inSubquery = false
+	for i := len(path) - 1; i >= 0; i-- {
+		switch node := path[i].(type) {
+		case *Select:
+			c.selects = append(c.selects, node)
+		case *Subquery:
+			c.inSubquery = true
+		}
+	}
+
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *AliasedExpr:
+			for _, col := range c.columns(expr.Expr) {
+				c.add(col, func(u *Usage) *[]string { return &u.Select })
+			}
+		case *StarExpr:
+			for _, table := range aliasedTables(sel.From) {
+				if expr.TableName.IsEmpty() || findSource(TableExprs{table}, expr.TableName) != nil {
+					c.addStar(table)
+				}
+			}
+		}
+	}
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *JoinTableExpr:
+			c.addJoin(node)
+		}
+		return true, nil
+	}, sel.From)
+	if sel.Where != nil {
+		c.condition(sel.Where.Expr)
+	}
+	if sel.Having != nil {
+		c.condition(sel.Having.Expr)
+	}
+	grouped, _ := resolveGroupBy(sel)
+	for _, expr := range grouped {
+		if col, ok := expr.(*ColName); ok {
+			c.add(col, func(u *Usage) *[]string { return &u.GroupBy })
+		}
+	}
+	for i, order := range sel.OrderBy {
+		col, ok := orderColumn(sel, order.Expr).(*ColName)
+		if !ok {
+			continue
+		}
+		if usage := c.usage(col); usage != nil {
+			usage.OrderBy = append(usage.OrderBy, OrderColumn{
+				Column:    col.Name.Lowered(),
+				Direction: order.Direction,
+				Position:  i + 1,
+			})
+		}
+	}
+}
+
+// addJoin records the columns that the join compares.
+func (c *usageCollector) addJoin(join *JoinTableExpr) {
+	if join.Condition.On != nil {
+		c.condition(join.Condition.On)
+	}
+	var merged []string
+	for _, col := range join.Condition.Using {
+		merged = append(merged, col.Lowered())
+	}
+	if join.IsNatural() {
+		left := make(map[string]bool)
+		for _, table := range aliasedTables(TableExprs{join.LeftExpr}) {
+			for _, name := range c.tableColumns(table) {
+				left[name] = true
+			}
+		}
+		for _, table := range aliasedTables(TableExprs{join.RightExpr}) {
+			for _, name := range c.tableColumns(table) {
+				if left[name] {
+					merged = append(merged, name)
+				}
+			}
+		}
+	}
+	for _, table := range aliasedTables(TableExprs{join}) {
+		columns := make(map[string]bool)
+		for _, name := range c.tableColumns(table) {
+			columns[name] = true
+		}
+		for _, name := range merged {
+			if columns[name] {
+				c.addName(table, name, func(u *Usage) *[]string { return &u.Join })
+			}
+		}
+	}
+}
+
+// condition records the columns that the comparisons of expr, and of
+// the conditions it's made of, use.
+func (c *usageCollector) condition(expr Expr) {
+	equality := func(u *Usage) *[]string { return &u.Equality }
+	ranged := func(u *Usage) *[]string { return &u.Range }
+	switch expr := expr.(type) {
+	case *AndExpr:
+		c.condition(expr.Left)
+		c.condition(expr.Right)
+	case *OrExpr:
+		c.condition(expr.Left)
+		c.condition(expr.Right)
+	case *ParenExpr:
+		c.condition(expr.Expr)
+	case *ComparisonExpr:
+		switch expr.Operator {
+		case EqualStr, NullSafeEqualStr, InStr:
+			c.compare(expr.Left, equality, expr.Right)
+			c.compare(expr.Right, equality, expr.Left)
+		case LessThanStr, LessEqualStr, GreaterThanStr, GreaterEqualStr:
+			c.compare(expr.Left, ranged, expr.Right)
+			c.compare(expr.Right, ranged, expr.Left)
+		case LikeStr:
+			c.compare(expr.Left, ranged, expr.Right)
+		}
+	case *RangeCond:
+		if expr.Operator == BetweenStr {
+			c.compare(expr.Left, ranged, expr.From, expr.To)
+		}
+	case *IsExpr:
+		if expr.Operator == IsNullStr {
+			c.compare(expr.Expr, equality)
+		}
+	}
+}
+
+// compare records expr, if it's a column, as a join column if others
+// use the columns of other tables, or in field if they don't use any.
+func (c *usageCollector) compare(expr Expr, field func(*Usage) *[]string, others ...Expr) {
+	col, ok := expr.(*ColName)
+	if !ok {
+		return
+	}
+	source := c.source(col)
+	if source == nil {
+		return
+	}
+	dependent := false
+	for _, other := range others {
+		for _, otherCol := range c.columns(other) {
+			otherSource := c.source(otherCol)
+			if otherSource == nil || otherSource == source {
+				dependent = true
+				continue
+			}
+			c.add(col, func(u *Usage) *[]string { return &u.Join })
+			return
+		}
+	}
+	if !dependent {
+		c.add(col, field)
+	}
+}
+
+// add appends the name of col to the field of the usage of its table.
+func (c *usageCollector) add(col *ColName, field func(*Usage) *[]string) {
+	if usage := c.usage(col); usage != nil {
+		list := field(usage)
+		*list = append(*list, col.Name.Lowered())
+	}
+}
+
+// addName appends name to the field of the usage of table.
+func (c *usageCollector) addName(table *AliasedTableExpr, name string, field func(*Usage) *[]string) {
+	if usage := c.tableUsage(table); usage != nil {
+		list := field(usage)
+		*list = append(*list, name)
+	}
+}
+
+// addStar records all the columns of table as selected.
+func (c *usageCollector) addStar(table *AliasedTableExpr) {
+	for _, name := range c.tableColumns(table) {
+		c.addName(table, name, func(u *Usage) *[]string { return &u.Select })
+	}
+}
+
+// usage returns the usage that col is recorded in, or nil if its
+// table can't be told.
+func (c *usageCollector) usage(col *ColName) *Usage {
+	source := c.source(col)
+	if source == nil {
+		return nil
+	}
+	return c.tableUsage(source)
+}
+
+// tableUsage returns the usage of table for the current select, or nil
+// if it's a derived table.
+func (c *usageCollector) tableUsage(table *AliasedTableExpr) *Usage {
+	name, ok := table.Expr.(TableName)
+	if !ok {
+		return nil
+	}
+	usage := c.usages[name]
+	if usage == nil {
+		usage = &Usage{}
+		c.usages[name] = usage
+	}
+	if !c.inSubquery || c.opts.MergeSubqueries {
+		return usage
+	}
+	if usage.Subqueries == nil {
+		usage.Subqueries = &Usage{}
+	}
+	return usage.Subqueries
+}
+
+// tableColumns returns the lowercased columns of table,
+// or nil if it's a derived table.
+func (c *usageCollector) tableColumns(table *AliasedTableExpr) []string {
+	name, ok := table.Expr.(TableName)
+	if !ok {
+		return nil
+	}
+	return c.schema.columns(name)
+}
+
+// source returns the table expression that col comes from, looking in
+// the current select first, and then in the ones around it. It returns
+// nil if col is unqualified, like a merged column or a select alias.
+func (c *usageCollector) source(col *ColName) *AliasedTableExpr {
+	if col.Qualifier.IsEmpty() {
+		return nil
+	}
+	for _, sel := range c.selects {
+		if source := findSource(sel.From, col.Qualifier); source != nil {
+			return source
+		}
+	}
+	return nil
+}
+
+// columns returns the columns of expr, outside of its subqueries.
+func (c *usageCollector) columns(expr Expr) []*ColName {
+	var cols []*ColName
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *ColName:
+			cols = append(cols, node)
+		}
+		return true, nil
+	}, expr)
+	return cols
+}
+
+// findSource returns the table expression of exprs that qualifier
+// refers to: its alias, or its name if it has none. The database of
+// qualifier can be omitted.
+func findSource(exprs TableExprs, qualifier TableName) *AliasedTableExpr {
+	for _, table := range aliasedTables(exprs) {
+		if !table.As.IsEmpty() {
+			if qualifier.Qualifier.IsEmpty() && qualifier.Name == table.As {
+				return table
+			}
+			continue
+		}
+		name, ok := table.Expr.(TableName)
+		if ok && qualifier.Name == name.Name && (qualifier.Qualifier.IsEmpty() || qualifier.Qualifier == name.Qualifier) {
+			return table
+		}
+	}
+	return nil
+}
+
+// aliasedTables returns the tables and derived tables of exprs,
+// including the ones of joins.
+func aliasedTables(exprs TableExprs) []*AliasedTableExpr {
+	var tables []*AliasedTableExpr
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *AliasedTableExpr:
+			tables = append(tables, expr)
+		case *ParenTableExpr:
+			tables = append(tables, aliasedTables(expr.Exprs)...)
+		case *JoinTableExpr:
+			tables = append(tables, aliasedTables(TableExprs{expr.LeftExpr, expr.RightExpr})...)
+		}
+	}
+	return tables
+}
+
+// orderColumn returns the expression that an ORDER BY entry of sel
+// sorts on: the select expression of a position, or of an alias.
+func orderColumn(sel *Select, expr Expr) Expr {
+	switch expr := expr.(type) {
+	case *SQLVal:
+		pos, err := strconv.Atoi(string(expr.Val))
+		if expr.Type != IntVal || err != nil || pos < 1 || pos > len(sel.SelectExprs) {
+			return expr
+		}
+		if aliased, ok := sel.SelectExprs[pos-1].(*AliasedExpr); ok {
+			return aliased.Expr
+		}
+	case *ColName:
+		if expr.Qualifier.IsEmpty() {
+			if aliased := findSelectAlias(sel.SelectExprs, expr.Name); aliased != nil {
+				return aliased.Expr
+			}
+		}
+	}
+	return expr
+}
+
+// sort sorts the lists of the usage, and removes their duplicates.
+func (u *Usage) sort() {
+	for _, list := range []*[]string{&u.Equality, &u.Range, &u.Join, &u.GroupBy, &u.Select} {
+		sort.Strings(*list)
+		names := (*list)[:0]
+		for _, name := range *list {
+			if len(names) == 0 || name != names[len(names)-1] {
+				names = append(names, name)
+			}
+		}
+		*list = names
+	}
+	sort.Slice(u.OrderBy, func(i, j int) bool {
+		a, b := u.OrderBy[i], u.OrderBy[j]
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Direction < b.Direction
+	})
+	orders := u.OrderBy[:0]
+	for _, order := range u.OrderBy {
+		if len(orders) == 0 || order != orders[len(orders)-1] {
+			orders = append(orders, order)
+		}
+	}
+	u.OrderBy = orders
+	if u.Subqueries != nil {
+		u.Subqueries.sort()
+	}
+}
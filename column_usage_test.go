@@ -0,0 +1,210 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func columnUsageSchema() *Schema {
+	schema := NewSchema()
+	for _, col := range []string{"id", "a", "b", "c"} {
+		schema.AddColumn("t", col, sqltypes.Int64)
+	}
+	for _, col := range []string{"id", "t_id", "d", "e"} {
+		schema.AddColumn("u", col, sqltypes.Int64)
+	}
+	return schema
+}
+
+func TestColumnUsage(t *testing.T) {
+	tn := func(name string) TableName { return TableName{Name: NewTableIdent(name)} }
+	testcases := []struct {
+		in     string
+		merged bool
+		out    map[TableName]*Usage
+	}{{
+		in: "select a, b, a + c from t where id = 1 and c > 5 and b is null order by b desc, a",
+		out: map[TableName]*Usage{
+			tn("t"): {
+				Equality: []string{"b", "id"},
+				Range:    []string{"c"},
+				OrderBy:  []OrderColumn{{"b", DescScr, 1}, {"a", AscScr, 2}},
+				Select:   []string{"a", "b", "c"},
+			},
+		},
+	}, {
+		in: "select x.a, y.d from t as x join u as y on x.id = y.t_id where y.e in (1, 2) and x.b like 'a%' and (d between 1 and 2 or c <= 3) group by x.a",
+		out: map[TableName]*Usage{
+			tn("t"): {
+				Range:   []string{"b", "c"},
+				Join:    []string{"id"},
+				GroupBy: []string{"a"},
+				Select:  []string{"a"},
+			},
+			tn("u"): {
+				Equality: []string{"e"},
+				Range:    []string{"d"},
+				Join:     []string{"t_id"},
+				Select:   []string{"d"},
+			},
+		},
+	}, {
+		// Columns compared with columns of their own table are
+		// neither filters nor joins.
+		in: "select id from t where a = b + 1 and c != 2 and a < id",
+		out: map[TableName]*Usage{
+			tn("t"): {Select: []string{"id"}},
+		},
+	}, {
+		in: "select a as x, count(*) from t group by 1 order by x desc",
+		out: map[TableName]*Usage{
+			tn("t"): {
+				GroupBy: []string{"a"},
+				OrderBy: []OrderColumn{{"a", DescScr, 1}},
+				Select:  []string{"a"},
+			},
+		},
+	}, {
+		in: "select a from t where id in (select t_id from u where d = 1) and exists (select 1 from u where u.t_id = t.id)",
+		out: map[TableName]*Usage{
+			tn("t"): {
+				Equality: []string{"id"},
+				Select:   []string{"a"},
+				Subqueries: &Usage{
+					Join: []string{"id"},
+				},
+			},
+			tn("u"): {
+				Subqueries: &Usage{
+					Equality: []string{"d"},
+					Join:     []string{"t_id"},
+					Select:   []string{"t_id"},
+				},
+			},
+		},
+	}, {
+		in:     "select a from t where id in (select t_id from u where d = 1) and exists (select 1 from u where u.t_id = t.id)",
+		merged: true,
+		out: map[TableName]*Usage{
+			tn("t"): {
+				Equality: []string{"id"},
+				Join:     []string{"id"},
+				Select:   []string{"a"},
+			},
+			tn("u"): {
+				Equality: []string{"d"},
+				Join:     []string{"t_id"},
+				Select:   []string{"t_id"},
+			},
+		},
+	}, {
+		// The columns of derived tables and merged columns
+		// aren't attributed.
+		in: "select d.x, id from (select a as x from t where b = 1) as d join t using (id)",
+		out: map[TableName]*Usage{
+			tn("t"): {
+				Join: []string{"id"},
+				Subqueries: &Usage{
+					Equality: []string{"b"},
+					Select:   []string{"a"},
+				},
+			},
+		},
+	}, {
+		in: "select u.* from t natural join u where t.a = 1",
+		out: map[TableName]*Usage{
+			tn("t"): {
+				Equality: []string{"a"},
+				Join:     []string{"id"},
+			},
+			tn("u"): {
+				Join:   []string{"id"},
+				Select: []string{"d", "e", "id", "t_id"},
+			},
+		},
+	}, {
+		in: "select a from t where b = 1 union select d from u where e > 1 order by 1",
+		out: map[TableName]*Usage{
+			tn("t"): {Equality: []string{"b"}, Select: []string{"a"}},
+			tn("u"): {Range: []string{"e"}, Select: []string{"d"}},
+		},
+	}, {
+		in: "update t set a = 1 where b = 2 order by c",
+		out: map[TableName]*Usage{
+			tn("t"): {
+				Equality: []string{"b"},
+				OrderBy:  []OrderColumn{{"c", AscScr, 1}},
+			},
+		},
+	}, {
+		in:  "insert into t(a) values (1)",
+		out: map[TableName]*Usage{},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		before := String(stmt)
+		out, err := ColumnUsageWithOptions(stmt, columnUsageSchema(), ColumnUsageOptions{MergeSubqueries: tcase.merged})
+		if err != nil {
+			t.Errorf("ColumnUsage(%s): %v", tcase.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			for table, usage := range out {
+				t.Logf("%s: %+v %+v", String(table), *usage, usage.Subqueries)
+			}
+			t.Errorf("ColumnUsage(%s, merged: %v): wrong usage", tcase.in, tcase.merged)
+		}
+		if after := String(stmt); after != before {
+			t.Errorf("ColumnUsage(%s) modified its input: %s", tcase.in, after)
+		}
+	}
+}
+
+func TestColumnUsageErrors(t *testing.T) {
+	testcases := []struct {
+		in  string
+		err string
+	}{{
+		in:  "select f from t",
+		err: "unknown column f",
+	}, {
+		in:  "select id from t join u",
+		err: "column id is ambiguous",
+	}, {
+		in:  "select a from v",
+		err: "unknown table v",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if _, err := ColumnUsage(stmt, columnUsageSchema()); err == nil || err.Error() != tcase.err {
+			t.Errorf("ColumnUsage(%s): %v, want %s", tcase.in, err, tcase.err)
+		}
+	}
+}
+
+func TestUsageTables(t *testing.T) {
+	usages := map[TableName]*Usage{
+		{Name: NewTableIdent("u")}:                                {},
+		{Name: NewTableIdent("t"), Qualifier: NewTableIdent("b")}: {},
+		{Name: NewTableIdent("t")}:                                {},
+		{Name: NewTableIdent("a"), Qualifier: NewTableIdent("b")}: {},
+	}
+	var got []string
+	for _, table := range UsageTables(usages) {
+		got = append(got, String(table))
+	}
+	want := []string{"t", "u", "b.a", "b.t"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UsageTables: %v, want %v", got, want)
+	}
+}
@@ -231,6 +231,18 @@ func TestExtractMysqlComment(t *testing.T) {
 		input:      "/*! SET max_execution_time=5000*/",
 		outSQL:     "SET max_execution_time=5000",
 		outVersion: "",
+	}, {
+		input:      "/*!50708*/",
+		outSQL:     "",
+		outVersion: "50708",
+	}, {
+		input:      "/*!*/",
+		outSQL:     "",
+		outVersion: "",
+	}, {
+		input:      "/*!",
+		outSQL:     "",
+		outVersion: "",
 	}}
 	for _, testCase := range testCases {
 		gotVersion, gotSQL := ExtractMysqlComment(testCase.input)
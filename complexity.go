@@ -0,0 +1,139 @@
+package sqlparser
+
+import "reflect"
+
+// Metrics describes the size and complexity of a statement.
+type Metrics struct {
+	// Nodes is the number of nodes in the AST.
+	Nodes int
+	// MaxExprDepth is the deepest nesting of expressions.
+	MaxExprDepth int
+	// Joins counts explicit joins and the extra tables of
+	// comma-separated table lists.
+	Joins int
+	// Subqueries counts subqueries, including derived tables.
+	Subqueries int
+	// UnionBranches counts the selects combined by unions.
+	UnionBranches int
+	// MaxInList is the length of the longest IN or NOT IN list.
+	MaxInList int
+	// Aggregates counts the calls of aggregate functions.
+	Aggregates int
+	// DistinctAggregates counts the calls of aggregate functions
+	// over distinct values, like COUNT(DISTINCT a), which need
+	// to sort or hash their arguments. They are also counted
+	// in Aggregates.
+	DistinctAggregates int
+}
+
+// Complexity computes the Metrics of stmt in a single walk of the AST.
+func Complexity(stmt Statement) Metrics {
+	var m Metrics
+	depth := 0
+	var visit Visit
+	visit = func(node SQLNode) (bool, error) {
+		if isAbsentNode(node) {
+			return false, nil
+		}
+		m.Nodes++
+		switch node := node.(type) {
+		case *JoinTableExpr:
+			m.Joins++
+		case TableExprs:
+			if len(node) > 1 {
+				m.Joins += len(node) - 1
+			}
+		case *Subquery:
+			m.Subqueries++
+		case *Union:
+			for _, branch := range []SelectStatement{node.Left, node.Right} {
+				if _, ok := branch.(*Union); !ok {
+					m.UnionBranches++
+				}
+			}
+		case *FuncExpr, *GroupConcatExpr:
+			if isAggregate(node) {
+				m.Aggregates++
+			}
+			if isDistinctAggregate(node) {
+				m.DistinctAggregates++
+			}
+		case *ComparisonExpr:
+			if node.Operator == InStr || node.Operator == NotInStr {
+				if list, ok := node.Right.(ValTuple); ok && len(list) > m.MaxInList {
+					m.MaxInList = len(list)
+				}
+			}
+		}
+		if _, ok := node.(Expr); !ok {
+			return true, nil
+		}
+		depth++
+		if depth > m.MaxExprDepth {
+			m.MaxExprDepth = depth
+		}
+		err := node.walkSubtree(visit)
+		depth--
+		// The subtree has already been walked.
+		return false, err
+	}
+	_ = Walk(visit, stmt)
+	return m
+}
+
+// isAbsentNode returns true if node stands for an optional part
+// of the statement that is missing, like a nil *Where or an empty
+// alias, so that it's not counted as a node.
+func isAbsentNode(node SQLNode) bool {
+	switch node := node.(type) {
+	case ColIdent:
+		return node.IsEmpty()
+	case TableIdent:
+		return node.IsEmpty()
+	case TableName:
+		return node.IsEmpty()
+	}
+	v := reflect.ValueOf(node)
+	switch v.Kind() {
+	case reflect.Ptr:
+		return v.IsNil()
+	case reflect.Slice:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// Limits sets the maximum values of the Metrics of a statement.
+// A limit of 0 means no limit.
+type Limits struct {
+	MaxNodes         int
+	MaxExprDepth     int
+	MaxJoins         int
+	MaxSubqueries    int
+	MaxUnionBranches int
+	MaxInList        int
+}
+
+// CheckLimits returns an error naming the first limit
+// that the Complexity of stmt exceeds, if any. The error
+// wraps ErrTooComplex.
+func CheckLimits(stmt Statement, limits Limits) error {
+	m := Complexity(stmt)
+	checks := []struct {
+		name       string
+		value, max int
+	}{
+		{"nodes", m.Nodes, limits.MaxNodes},
+		{"expression depth", m.MaxExprDepth, limits.MaxExprDepth},
+		{"joins", m.Joins, limits.MaxJoins},
+		{"subqueries", m.Subqueries, limits.MaxSubqueries},
+		{"union branches", m.UnionBranches, limits.MaxUnionBranches},
+		{"in list length", m.MaxInList, limits.MaxInList},
+	}
+	for _, check := range checks {
+		if check.max > 0 && check.value > check.max {
+			return newError(ErrTooComplex, "%s %d exceeds the limit of %d", check.name, check.value, check.max)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package sqlparser
+
+import "testing"
+
+func TestComplexity(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want Metrics
+	}{{
+		in:   "select 1 from t",
+		want: Metrics{Nodes: 8, MaxExprDepth: 1},
+	}, {
+		in:   "select a from t join u on t.id = u.id, v",
+		want: Metrics{Nodes: 26, MaxExprDepth: 2, Joins: 2},
+	}, {
+		in:   "select a from t where a in (1, 2, 3) and b not in (4, 5)",
+		want: Metrics{Nodes: 26, MaxExprDepth: 4, MaxInList: 3},
+	}, {
+		in:   "select a from (select b from u) as s where a = (select max(c) from v)",
+		want: Metrics{Nodes: 36, MaxExprDepth: 4, Subqueries: 2, Aggregates: 1},
+	}, {
+		in:   "select a from t union select b from u union all select c from v",
+		want: Metrics{Nodes: 29, MaxExprDepth: 1, UnionBranches: 3},
+	}, {
+		in:   "select count(distinct a, b), avg(distinct c), count(t.*), group_concat(distinct d), abs(e) from t",
+		want: Metrics{Nodes: 43, MaxExprDepth: 2, Aggregates: 4, DistinctAggregates: 3},
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got := Complexity(tree); got != tcase.want {
+			t.Errorf("Complexity(%s): %+v, want %+v", tcase.in, got, tcase.want)
+		}
+		// Formatting differences must not change the metrics.
+		tree, err = Parse(String(tree))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got := Complexity(tree); got != tcase.want {
+			t.Errorf("Complexity(String(%s)): %+v, want %+v", tcase.in, got, tcase.want)
+		}
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	tree, err := Parse("select a from t join u on t.id = u.id join v on u.id = v.id where a in (1, 2, 3)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		limits Limits
+		err    string
+	}{{
+		limits: Limits{},
+	}, {
+		limits: Limits{MaxJoins: 2, MaxInList: 3},
+	}, {
+		limits: Limits{MaxJoins: 1},
+		err:    "joins 2 exceeds the limit of 1",
+	}, {
+		limits: Limits{MaxJoins: 10, MaxInList: 2},
+		err:    "in list length 3 exceeds the limit of 2",
+	}, {
+		limits: Limits{MaxExprDepth: 1},
+		err:    "expression depth 3 exceeds the limit of 1",
+	}}
+	for _, tcase := range testcases {
+		err := CheckLimits(tree, tcase.limits)
+		var got string
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tcase.err {
+			t.Errorf("CheckLimits(%+v): %q, want %q", tcase.limits, got, tcase.err)
+		}
+	}
+}
@@ -0,0 +1,1919 @@
+// Package corpus holds the statements that the tests of the parser
+// parse and format, one or more for every construct it supports. Code
+// that analyzes the AST, like visitors, can run over all of them to
+// catch the constructs it doesn't handle.
+package corpus
+
+// Query is a statement of the corpus.
+type Query struct {
+	Input string
+	// Output is Input formatted by the parser, if it differs.
+	Output string
+}
+
+// Queries returns the statements of the corpus, which all parse with
+// the default dialect. The slice is a copy that callers can modify.
+func Queries() []Query {
+	return append([]Query(nil), queries...)
+}
+
+var queries = []Query{{
+	Input:  "select 1",
+	Output: "select 1 from dual",
+}, {
+	Input: "select 1 from t",
+}, {
+	Input: "select .1 from t",
+}, {
+	Input: "select 1.2e1 from t",
+}, {
+	Input: "select 1.2e+1 from t",
+}, {
+	Input: "select 1.2e-1 from t",
+}, {
+	Input: "select 08.3 from t",
+}, {
+	Input: "select 1e10, 1.5E-3, .5, 5., 5.e3, .5e-1 from t where a = .5 and b > 1e10",
+}, {
+	Input: "select 18446744073709551615, 99999999999999999999999 from t limit 18446744073709551615",
+}, {
+	Input: "insert into t values (18446744073709551615, 1e10, .5, 5.)",
+}, {
+	Input:  "select t.5e, t.1, `t`.2a, db.t.3 from t",
+	Output: "select t.`5e`, t.`1`, t.`2a`, db.t.`3` from t",
+}, {
+	Input: "select -1 from t where b = -2",
+}, {
+	Input:  "select - -1 from t",
+	Output: "select 1 from t",
+}, {
+	Input:  "select 1 from t // aa\n",
+	Output: "select 1 from t",
+}, {
+	Input:  "select 1 from t -- aa\n",
+	Output: "select 1 from t",
+}, {
+	Input:  "select 1 from t # aa\n",
+	Output: "select 1 from t",
+}, {
+	Input:  "select 1 --aa\nfrom t",
+	Output: "select 1 from t",
+}, {
+	Input:  "select 1 #aa\nfrom t",
+	Output: "select 1 from t",
+}, {
+	Input: "select /* simplest */ 1 from t",
+}, {
+	Input: "select /* double star **/ 1 from t",
+}, {
+	Input: "select /* double */ /* comment */ 1 from t",
+}, {
+	Input: "select /* back-quote keyword */ `By` from t",
+}, {
+	Input: "select /* back-quote num */ `2a` from t",
+}, {
+	Input: "select /* back-quote . */ `a.b` from t",
+}, {
+	Input: "select /* back-quote back-quote */ `a``b` from t",
+}, {
+	Input:  "select /* back-quote unnecessary */ 1 from `t`",
+	Output: "select /* back-quote unnecessary */ 1 from t",
+}, {
+	Input:  "select /* back-quote idnum */ 1 from `a1`",
+	Output: "select /* back-quote idnum */ 1 from a1",
+}, {
+	Input: "select /* @ */ @@a from b",
+}, {
+	Input: "select /* \\0 */ '\\0' from a",
+}, {
+	Input:  "select 1 /* drop this comment */ from t",
+	Output: "select 1 from t",
+}, {
+	Input: "select /* union */ 1 from t union select 1 from t",
+}, {
+	Input: "select /* double union */ 1 from t union select 1 from t union select 1 from t",
+}, {
+	Input: "select /* union all */ 1 from t union all select 1 from t",
+}, {
+	Input: "select /* union distinct */ 1 from t union distinct select 1 from t",
+}, {
+	Input:  "(select /* union parenthesized select */ 1 from t order by a) union select 1 from t",
+	Output: "(select /* union parenthesized select */ 1 from t order by a asc) union select 1 from t",
+}, {
+	Input: "select /* union parenthesized select 2 */ 1 from t union (select 1 from t)",
+}, {
+	Input:  "(select /* parenthesized select */ a from t order by a limit 1)",
+	Output: "(select /* parenthesized select */ a from t order by a asc limit 1)",
+}, {
+	Input: "(select /* parenthesized union */ 1 from t union select 1 from t)",
+}, {
+	Input: "((select /* nested parenthesized select */ 1 from t))",
+}, {
+	Input: "((select /* nested parenthesized union */ 1 from t) union (select 1 from t))",
+}, {
+	Input:  "(select /* parenthesized select order by */ a from t) order by a limit 1",
+	Output: "(select /* parenthesized select order by */ a from t) order by a asc limit 1",
+}, {
+	Input: "(select /* parenthesized select limit */ a from t) limit 1",
+}, {
+	Input:  "((select /* parenthesized union order by */ 1) union (select 2)) order by 1",
+	Output: "((select /* parenthesized union order by */ 1 from dual) union (select 2 from dual)) order by 1 asc",
+}, {
+	Input: "((select /* nested parenthesized select limit */ a from t limit 2) limit 1) limit 3, 1",
+}, {
+	Input:  "create view v as (select /* view of parenthesized select limit */ a from t) order by a limit 1",
+	Output: "create view v as (select /* view of parenthesized select limit */ a from t) order by a asc limit 1",
+}, {
+	Input:  "select /* union order by */ 1 from t union select 1 from t order by a",
+	Output: "select /* union order by */ 1 from t union select 1 from t order by a asc",
+}, {
+	Input:  "select /* union order by limit lock */ 1 from t union select 1 from t order by a limit 1 for update",
+	Output: "select /* union order by limit lock */ 1 from t union select 1 from t order by a asc limit 1 for update",
+}, {
+	Input: "select /* union with limit on lhs */ 1 from t limit 1 union select 1 from t",
+}, {
+	Input:  "(select id, a from t order by id limit 1) union (select id, b as a from s order by id limit 1) order by a limit 1",
+	Output: "(select id, a from t order by id asc limit 1) union (select id, b as a from s order by id asc limit 1) order by a asc limit 1",
+}, {
+	Input: "select a from (select 1 as a from tbl1 union select 2 from tbl2) as t",
+}, {
+	Input: "select * from t1 join (select * from t2 union select * from t3) as t",
+}, {
+	// Ensure this doesn't generate: ""select * from t1 join t2 on a = b join t3 on a = b".
+	Input: "select * from t1 join t2 on a = b join t3",
+}, {
+	Input: "select * from t1 where col in (select 1 from dual union select 2 from dual)",
+}, {
+	Input: "select * from t1 where exists (select a from t2 union select b from t3)",
+}, {
+	Input: "select /* distinct */ distinct 1 from t",
+}, {
+	Input: "select /* straight_join */ straight_join 1 from t",
+}, {
+	Input: "select /* select options */ sql_calc_found_rows distinct high_priority straight_join sql_small_result sql_big_result sql_buffer_result sql_no_cache 1 from t",
+}, {
+	Input:  "select /* select options in order */ SQL_NO_CACHE DISTINCTROW SQL_CALC_FOUND_ROWS 1 from t",
+	Output: "select /* select options in order */ sql_no_cache distinctrow sql_calc_found_rows 1 from t",
+}, {
+	Input: "select /* all */ all a from t union select sql_cache b from u",
+}, {
+	Input: "select /* for update */ 1 from t for update",
+}, {
+	Input: "select /* lock in share mode */ 1 from t lock in share mode",
+}, {
+	Input: "select /* into outfile */ * from t into outfile '/tmp/x.csv'",
+}, {
+	Input:  "select /* into outfile */ * from t where a = 1 into outfile '/tmp/x.csv' character set utf8mb4 fields terminated by ',' optionally enclosed by '\"' escaped by '\\\\' lines starting by 'x' terminated by '\\n'",
+	Output: "select /* into outfile */ * from t where a = 1 into outfile '/tmp/x.csv' character set utf8mb4 fields terminated by ',' optionally enclosed by '\\\"' escaped by '\\\\' lines starting by 'x' terminated by '\\n'",
+}, {
+	Input:  "select /* into outfile */ a into outfile '/tmp/x' columns enclosed by '\"' lines terminated by ';' from t limit 1",
+	Output: "select /* into outfile */ a from t limit 1 into outfile '/tmp/x' fields enclosed by '\\\"' lines terminated by ';'",
+}, {
+	Input:  "select /* into dumpfile */ a from t limit 1 into DUMPFILE '/tmp/x' for update",
+	Output: "select /* into dumpfile */ a from t limit 1 into dumpfile '/tmp/x' for update",
+}, {
+	Input:  "select /* into vars */ a, b into @x, @y from t where id = 1",
+	Output: "select /* into vars */ a, b from t where id = 1 into @x, @y",
+}, {
+	Input:  "select /* into vars */ 1 into @x",
+	Output: "select /* into vars */ 1 from dual into @x",
+}, {
+	Input: "select /* select list */ 1, 2 from t",
+}, {
+	Input: "select /* * */ * from t",
+}, {
+	Input: "select /* a.* */ a.* from t",
+}, {
+	Input: "select /* a.b.* */ a.b.* from t",
+}, {
+	Input:  "select /* column alias */ a b from t",
+	Output: "select /* column alias */ a as b from t",
+}, {
+	Input: "select /* column alias with as */ a as b from t",
+}, {
+	Input: "select /* keyword column alias */ a as `By` from t",
+}, {
+	Input:  "select /* column alias as string */ a as \"b\" from t",
+	Output: "select /* column alias as string */ a as b from t",
+}, {
+	Input:  "select /* column alias as string without as */ a \"b\" from t",
+	Output: "select /* column alias as string without as */ a as b from t",
+}, {
+	Input: "select /* a.* */ a.* from t",
+}, {
+	Input:  "select next value for t",
+	Output: "select next value for t from dual",
+}, {
+	Input: "select next value for db.s from t",
+}, {
+	Input: "select next value for s + 1 from dual",
+}, {
+	Input: "select a, next value for s as b from t",
+}, {
+	Input: "insert into t(a, b) values (next value for s, 1)",
+}, {
+	Input:  "select next value from t",
+	Output: "select next 1 values from t",
+}, {
+	Input: "select next 10 values from t",
+}, {
+	Input: "select next :a values from t",
+}, {
+	Input: "select /* `By`.* */ `By`.* from t",
+}, {
+	Input: "select /* select with bool expr */ a = b from t",
+}, {
+	Input: "select /* case_when */ case when a = b then c end from t",
+}, {
+	Input: "select /* case_when_else */ case when a = b then c else d end from t",
+}, {
+	Input: "select /* case_when_when_else */ case when a = b then c when b = d then d else d end from t",
+}, {
+	Input: "select /* case */ case aa when a = b then c end from t",
+}, {
+	Input: "select /* parenthesis */ 1 from (t)",
+}, {
+	Input: "select /* parenthesis multi-table */ 1 from (t1, t2)",
+}, {
+	Input: "select /* table list */ 1 from t1, t2",
+}, {
+	Input: "select /* parenthessis in table list 1 */ 1 from (t1), t2",
+}, {
+	Input: "select /* parenthessis in table list 2 */ 1 from t1, (t2)",
+}, {
+	Input: "select /* nested join parenthesis */ 1 from ((t1 join t2 on t1.a = t2.a) left join t3 on t3.b = t2.b)",
+}, {
+	Input: "select /* nested join parenthesis right */ 1 from t1 join (t2 join t3 on t2.a = t3.a) on t1.a = t2.a",
+}, {
+	Input:  "select /* odbc outer join */ 1 from { OJ t1 left outer join t2 on t1.a = t2.a }",
+	Output: "select /* odbc outer join */ 1 from {oj t1 left outer join t2 on t1.a = t2.a}",
+}, {
+	Input: "select /* odbc nested outer join */ 1 from {oj t1 left join (t2 join t3 on t2.a = t3.a) on t1.a = t2.a}, t4",
+}, {
+	Input: "select /* use */ 1 from t1 use index (a) where b = 1",
+}, {
+	Input: "select /* keyword index */ 1 from t1 use index (`By`) where b = 1",
+}, {
+	Input: "select /* ignore */ 1 from t1 as t2 ignore index (a), t3 use index (b) where b = 1",
+}, {
+	Input: "select /* use */ 1 from t1 as t2 use index (a), t3 use index (b) where b = 1",
+}, {
+	Input: "select /* force */ 1 from t1 as t2 force index (a), t3 force index (b) where b = 1",
+}, {
+	Input: "select /* key synonym */ 1 from t1 use key (a), t2 force key (b), t3 ignore key (c)",
+}, {
+	Input: "select /* hint list */ 1 from t1 use index for join (a) ignore index for order by (b) ignore key for group by (c), t2 use index ()",
+}, {
+	Input: "select /* partition and hints */ 1 from t1 partition (p0) as x use index (a) join t2 partition (p1, p2) force key (b) on x.id = t2.id",
+}, {
+	Input:  "select /* hints before partition */ 1 from t1 as x use index (a) partition (p0)",
+	Output: "select /* hints before partition */ 1 from t1 partition (p0) as x use index (a)",
+}, {
+	Input:  "select /* table alias */ 1 from t t1",
+	Output: "select /* table alias */ 1 from t as t1",
+}, {
+	Input: "select /* table alias with as */ 1 from t as t1",
+}, {
+	Input:  "select /* string table alias */ 1 from t as 't1'",
+	Output: "select /* string table alias */ 1 from t as t1",
+}, {
+	Input:  "select /* string table alias without as */ 1 from t 't1'",
+	Output: "select /* string table alias without as */ 1 from t as t1",
+}, {
+	Input: "select /* keyword table alias */ 1 from t as `By`",
+}, {
+	Input: "select /* join */ 1 from t1 join t2",
+}, {
+	Input: "select /* join on */ 1 from t1 join t2 on a = b",
+}, {
+	Input: "select /* join on */ 1 from t1 join t2 using (a)",
+}, {
+	Input: "select /* inner join */ 1 from t1 inner join t2",
+}, {
+	Input: "select /* cross join */ 1 from t1 cross join t2",
+}, {
+	Input: "select /* straight_join */ 1 from t1 straight_join t2",
+}, {
+	Input: "select /* straight_join on */ 1 from t1 straight_join t2 on a = b",
+}, {
+	Input: "select /* left join */ 1 from t1 left join t2 on a = b",
+}, {
+	Input: "select /* left join */ 1 from t1 left join t2 using (a)",
+}, {
+	Input: "select /* left outer join */ 1 from t1 left outer join t2 on a = b",
+}, {
+	Input: "select /* left outer join */ 1 from t1 left outer join t2 using (a)",
+}, {
+	Input: "select /* right join */ 1 from t1 right join t2 on a = b",
+}, {
+	Input: "select /* right join */ 1 from t1 right join t2 using (a)",
+}, {
+	Input: "select /* right outer join */ 1 from t1 right outer join t2 on a = b",
+}, {
+	Input: "select /* right outer join */ 1 from t1 right outer join t2 using (a)",
+}, {
+	Input: "select /* natural join */ 1 from t1 natural join t2",
+}, {
+	Input: "select /* natural left join */ 1 from t1 natural left join t2",
+}, {
+	Input: "select /* natural left outer join */ 1 from t1 natural left outer join t2",
+}, {
+	Input: "select /* natural right join */ 1 from t1 natural right join t2",
+}, {
+	Input: "select /* natural right outer join */ 1 from t1 natural right outer join t2",
+}, {
+	Input: "select /* natural inner join */ 1 from t1 natural inner join t2",
+}, {
+	Input: "select /* join on */ 1 from t1 join t2 on a = b",
+}, {
+	Input: "select /* join using */ 1 from t1 join t2 using (a)",
+}, {
+	Input: "select /* join using (a, b, c) */ 1 from t1 join t2 using (a, b, c)",
+}, {
+	Input: "select /* s.t */ 1 from s.t",
+}, {
+	Input: "select /* keyword schema & table name */ 1 from `By`.`bY`",
+}, {
+	Input: "select /* select in from */ 1 from (select 1 from t) as a",
+}, {
+	Input:  "select /* select in from with no as */ 1 from (select 1 from t) a",
+	Output: "select /* select in from with no as */ 1 from (select 1 from t) as a",
+}, {
+	Input: "select /* where */ 1 from t where a = b",
+}, {
+	Input: "select /* and */ 1 from t where a = b and a = c",
+}, {
+	Input:  "select /* && */ 1 from t where a = b && a = c",
+	Output: "select /* && */ 1 from t where a = b and a = c",
+}, {
+	Input: "select /* or */ 1 from t where a = b or a = c",
+}, {
+	Input:  "select /* || */ 1 from t where a = b || a = c",
+	Output: "select /* || */ 1 from t where a = b or a = c",
+}, {
+	Input: "select /* not */ 1 from t where not a = b",
+}, {
+	Input: "select /* ! */ 1 from t where a = !1",
+}, {
+	Input: "select /* bool is */ 1 from t where a = b is null",
+}, {
+	Input: "select /* bool is not */ 1 from t where a = b is not false",
+}, {
+	Input: "select /* true */ 1 from t where true",
+}, {
+	Input: "select /* false */ 1 from t where false",
+}, {
+	Input: "select /* false on left */ 1 from t where false = 0",
+}, {
+	Input: "select /* exists */ 1 from t where exists (select 1 from t)",
+}, {
+	Input: "select /* not exists */ 1 from t where not exists (select 1 from t)",
+}, {
+	Input:  "select /* not (exists) */ 1 from t where not ((exists (select 1 from t)))",
+	Output: "select /* not (exists) */ 1 from t where not exists (select 1 from t)",
+}, {
+	Input: "select /* (not exists) */ 1 from t where (not exists (select 1 from t)) and not (a and exists (select 1 from t))",
+}, {
+	Input: "select /* (boolean) */ 1 from t where not (a = b)",
+}, {
+	Input: "select /* in value list */ 1 from t where a in (b, c)",
+}, {
+	Input: "select /* in select */ 1 from t where a in (select 1 from t)",
+}, {
+	Input: "select /* row in row list */ 1 from t where (a, b) in ((1, 2), (3, 4))",
+}, {
+	Input: "select /* row not in list arg */ 1 from t where (a, b) not in ::list",
+}, {
+	Input: "select /* row comparison */ 1 from t where (a, b) >= (:x, :y) order by a asc, b asc limit 10",
+}, {
+	Input: "select /* nested rows */ 1 from t where ((a, b), c) = ((1, 2), 3)",
+}, {
+	Input: "select /* not in */ 1 from t where a not in (b, c)",
+}, {
+	Input: "select /* member of */ 1 from t where 17 member of (data -> '$.ids')",
+}, {
+	Input:  "select /* member of */ 1 from t where a MEMBER OF('[1, 2]') and b",
+	Output: "select /* member of */ 1 from t where a member of ('[1, 2]') and b",
+}, {
+	Input: "select /* cast array */ cast(data -> '$.ids' as unsigned array) from t",
+}, {
+	Input:  "select /* array as id */ array, of from t",
+	Output: "select /* array as id */ `array`, `of` from t",
+}, {
+	Input: "select /* like */ 1 from t where a like b",
+}, {
+	Input: "select /* like escape */ 1 from t where a like b escape '!'",
+}, {
+	Input: "select /* not like */ 1 from t where a not like b",
+}, {
+	Input: "select /* not like escape */ 1 from t where a not like b escape '$'",
+}, {
+	Input: "select /* regexp */ 1 from t where a regexp b",
+}, {
+	Input: "select /* not regexp */ 1 from t where a not regexp b",
+}, {
+	Input:  "select /* rlike */ 1 from t where a rlike b",
+	Output: "select /* rlike */ 1 from t where a regexp b",
+}, {
+	Input:  "select /* not rlike */ 1 from t where a not rlike b",
+	Output: "select /* not rlike */ 1 from t where a not regexp b",
+}, {
+	Input: "select /* between */ 1 from t where a between b and c",
+}, {
+	Input: "select /* not between */ 1 from t where a not between b and c",
+}, {
+	Input: "select /* is null */ 1 from t where a is null",
+}, {
+	Input: "select /* is not null */ 1 from t where a is not null",
+}, {
+	Input: "select /* is true */ 1 from t where a is true",
+}, {
+	Input: "select /* is not true */ 1 from t where a is not true",
+}, {
+	Input: "select /* is false */ 1 from t where a is false",
+}, {
+	Input: "select /* is not false */ 1 from t where a is not false",
+}, {
+	Input: "select /* is unknown */ 1 from t where a = b is unknown",
+}, {
+	Input: "select /* is not unknown */ 1 from t where a is not unknown",
+}, {
+	Input:  "select /* unknown as a column */ unknown from t",
+	Output: "select /* unknown as a column */ `unknown` from t",
+}, {
+	Input: "select /* < */ 1 from t where a < b",
+}, {
+	Input: "select /* <= */ 1 from t where a <= b",
+}, {
+	Input: "select /* >= */ 1 from t where a >= b",
+}, {
+	Input: "select /* > */ 1 from t where a > b",
+}, {
+	Input: "select /* != */ 1 from t where a != b",
+}, {
+	Input:  "select /* <> */ 1 from t where a <> b",
+	Output: "select /* <> */ 1 from t where a != b",
+}, {
+	Input: "select /* <=> */ 1 from t where a <=> b",
+}, {
+	Input: "select /* != */ 1 from t where a != b",
+}, {
+	Input: "select /* single value expre list */ 1 from t where a in (b)",
+}, {
+	Input: "select /* select as a value expression */ 1 from t where a = (select a from t)",
+}, {
+	Input: "select /* parenthesised value */ 1 from t where a = (b)",
+}, {
+	Input: "select /* over-parenthesize */ ((1)) from t where ((a)) in (((1))) and ((a, b)) in ((((1, 1))), ((2, 2)))",
+}, {
+	Input: "select /* dot-parenthesize */ (a.b) from t where (b.c) = 2",
+}, {
+	Input: "select /* & */ 1 from t where a = b & c",
+}, {
+	Input: "select /* & */ 1 from t where a = b & c",
+}, {
+	Input: "select /* | */ 1 from t where a = b | c",
+}, {
+	Input: "select /* ^ */ 1 from t where a = b ^ c",
+}, {
+	Input: "select /* + */ 1 from t where a = b + c",
+}, {
+	Input: "select /* - */ 1 from t where a = b - c",
+}, {
+	Input: "select /* * */ 1 from t where a = b * c",
+}, {
+	Input: "select /* / */ 1 from t where a = b / c",
+}, {
+	Input: "select /* % */ 1 from t where a = b % c",
+}, {
+	Input: "select /* div */ 1 from t where a = b div c",
+}, {
+	Input:  "select /* MOD */ 1 from t where a = b MOD c",
+	Output: "select /* MOD */ 1 from t where a = b % c",
+}, {
+	Input: "select /* << */ 1 from t where a = b << c",
+}, {
+	Input: "select /* >> */ 1 from t where a = b >> c",
+}, {
+	Input:  "select /* % no space */ 1 from t where a = b%c",
+	Output: "select /* % no space */ 1 from t where a = b % c",
+}, {
+	Input: "select /* u+ */ 1 from t where a = +b",
+}, {
+	Input: "select /* u- */ 1 from t where a = -b",
+}, {
+	Input: "select /* u~ */ 1 from t where a = ~b",
+}, {
+	Input: "select /* -> */ a.b -> 'ab' from t",
+}, {
+	Input: "select /* -> */ a.b ->> 'ab' from t",
+}, {
+	Input: "select /* empty function */ 1 from t where a = b()",
+}, {
+	Input: "select /* function with 1 param */ 1 from t where a = b(c)",
+}, {
+	Input: "select /* function with many params */ 1 from t where a = b(c, d)",
+}, {
+	Input: "select /* function with distinct */ count(distinct a) from t",
+}, {
+	Input: "select /* distinct with several arguments */ count(distinct a, b), avg(distinct x) from t",
+}, {
+	Input:  "select /* qualified star argument */ COUNT(t.*), count(DISTINCT d.t.*) from t",
+	Output: "select /* qualified star argument */ COUNT(t.*), count(distinct d.t.*) from t",
+}, {
+	Input: "select /* if as func */ 1 from t where a = if(b)",
+}, {
+	Input:  "select /* spatial type as func */ ST_Contains(geom, POINT(1,2)) from t",
+	Output: "select /* spatial type as func */ ST_Contains(geom, point(1, 2)) from t",
+}, {
+	Input: "select /* spatial type as func */ linestring(point(1, 2), point(3, 4)), polygon(a), geometry(b), geometrycollection(c), multipoint(d), multilinestring(e), multipolygon(f) from t",
+}, {
+	Input: "select /* wkt */ st_astext(geom) from t where st_intersects(geom, st_geomfromtext('POINT(1 2)', 4326))",
+}, {
+	Input:  "select /* extract */ EXTRACT(YEAR FROM created) from t",
+	Output: "select /* extract */ extract(year from created) from t",
+}, {
+	Input: "select /* extract */ extract(year_month from a + interval 1 day) from t",
+}, {
+	Input: "select /* position */ position('a' in name) from t",
+}, {
+	Input: "select /* position */ 1 from t where position(a in concat(b, c)) > 1",
+}, {
+	Input: "select /* position as column */ position, t.trim from t where extract = 1",
+}, {
+	Input: "select /* trim */ trim(a) from t",
+}, {
+	Input: "select /* trim */ trim('x' from a) from t",
+}, {
+	Input: "select /* trim */ trim(leading from a) from t",
+}, {
+	Input: "select /* trim */ trim(trailing 'x' from a) from t",
+}, {
+	Input: "select /* trim */ trim(both b from a) from t",
+}, {
+	Input:  "select /* trim */ trim(t.leading from a) from t",
+	Output: "select /* trim */ trim(t.`leading` from a) from t",
+}, {
+	Input:  "select /* trim */ TRIM(BOTH 'x' FROM a) from t",
+	Output: "select /* trim */ trim(both 'x' from a) from t",
+}, {
+	Input: "select /* weight_string */ weight_string(a) from t",
+}, {
+	Input: "select /* weight_string */ weight_string(a as char(5)) from t",
+}, {
+	Input: "select /* weight_string */ weight_string(a as binary(8)) from t",
+}, {
+	Input: "select /* current_timestamp as func */ current_timestamp() from t",
+}, {
+	Input: "select /* mod as func */ a from tab where mod(b, 2) = 0",
+}, {
+	Input: "select /* database as func no param */ database() from t",
+}, {
+	Input: "select /* database as func 1 param */ database(1) from t",
+}, {
+	Input: "select /* schema as func */ schema() from t",
+}, {
+	Input:  "select /* current_user */ current_user, current_user() from t",
+	Output: "select /* current_user */ current_user(), current_user() from t",
+}, {
+	Input: "select /* a */ a from t",
+}, {
+	Input: "select /* a.b */ a.b from t",
+}, {
+	Input: "select /* a.b.c */ a.b.c from t",
+}, {
+	Input: "select /* keyword a.b */ `By`.`bY` from t",
+}, {
+	Input: "select /* string */ 'a' from t",
+}, {
+	Input:  "select /* double quoted string */ \"a\" from t",
+	Output: "select /* double quoted string */ 'a' from t",
+}, {
+	Input:  "select /* quote quote in string */ 'a''a' from t",
+	Output: "select /* quote quote in string */ 'a\\'a' from t",
+}, {
+	Input:  "select /* double quote quote in string */ \"a\"\"a\" from t",
+	Output: "select /* double quote quote in string */ 'a\\\"a' from t",
+}, {
+	Input:  "select /* quote in double quoted string */ \"a'a\" from t",
+	Output: "select /* quote in double quoted string */ 'a\\'a' from t",
+}, {
+	Input: "select /* backslash quote in string */ 'a\\'a' from t",
+}, {
+	Input: "select /* literal backslash in string */ 'a\\\\na' from t",
+}, {
+	Input: "select /* all escapes */ '\\0\\'\\\"\\b\\n\\r\\t\\Z\\\\' from t",
+}, {
+	Input:  "select /* non-escape */ '\\x' from t",
+	Output: "select /* non-escape */ 'x' from t",
+}, {
+	Input: "select /* unescaped backslash */ '\\n' from t",
+}, {
+	Input:  "select /* escaped like wildcards */ 1 from t where a like 'a\\%b\\_c'",
+	Output: "select /* escaped like wildcards */ 1 from t where a like 'a\\\\%b\\\\_c'",
+}, {
+	Input: "select /* value argument */ :a from t",
+}, {
+	Input: "select /* value argument with digit */ :a1 from t",
+}, {
+	Input: "select /* value argument with dot */ :a.b from t",
+}, {
+	Input:  "select /* positional argument */ ? from t",
+	Output: "select /* positional argument */ :v1 from t",
+}, {
+	Input:  "select /* multiple positional arguments */ ?, ? from t",
+	Output: "select /* multiple positional arguments */ :v1, :v2 from t",
+}, {
+	Input: "select /* list arg */ * from t where a in ::list",
+}, {
+	Input: "select /* list arg not in */ * from t where a not in ::list",
+}, {
+	Input: "select /* null */ null from t",
+}, {
+	Input: "select /* octal */ 010 from t",
+}, {
+	Input:  "select /* hex */ x'f0A1' from t",
+	Output: "select /* hex */ X'f0A1' from t",
+}, {
+	Input: "select /* hex caps */ X'F0a1' from t",
+}, {
+	Input:  "select /* bit literal */ b'0101' from t",
+	Output: "select /* bit literal */ B'0101' from t",
+}, {
+	Input: "select /* bit literal caps */ B'010011011010' from t",
+}, {
+	Input: "select /* 0x */ 0xf0 from t",
+}, {
+	Input: "select /* float */ 0.1 from t",
+}, {
+	Input: "select /* decimal trailing zeros */ 10.50, 1., .5 from t",
+}, {
+	Input: "select /* float exponent */ 1.05e1, 1E-3, .5e+2 from t",
+}, {
+	Input: "select /* group by */ 1 from t group by a",
+}, {
+	Input: "select /* group by with rollup */ a, b, count(*) from t group by a, b with rollup having a > 1",
+}, {
+	Input:  "select /* rollup as column */ rollup from t",
+	Output: "select /* rollup as column */ `rollup` from t",
+}, {
+	Input: "select /* having */ 1 from t having a = b",
+}, {
+	Input:  "select /* window */ a, sum(b) over w, rank() over (w order by c) from t window w as (partition by a)",
+	Output: "select /* window */ a, sum(b) over w, rank() over (w order by c asc) from t window w as (partition by a)",
+}, {
+	Input:  "select /* windows */ row_number() over (), count(*) over (partition by a, b order by c desc) from t where d = 1 group by a having e > 1 window w1 as (order by a), w2 as (w1) order by a",
+	Output: "select /* windows */ row_number() over (), count(*) over (partition by a, b order by c desc) from t where d = 1 group by a having e > 1 window w1 as (order by a asc), w2 as (w1) order by a asc",
+}, {
+	Input: "select /* window distinct */ count(distinct a) over (partition by b) from t",
+}, {
+	Input: "select /* window frame */ sum(b) over (rows between unbounded preceding and current row) from t",
+}, {
+	Input:  "select /* window range */ sum(b) over (order by a range between 1 preceding and unbounded following) from t",
+	Output: "select /* window range */ sum(b) over (order by a asc range between 1 preceding and unbounded following) from t",
+}, {
+	Input: "select /* window refined frame */ sum(b) over (w rows 2 following), avg(b) over (w rows current row) from t window w as (partition by a)",
+}, {
+	Input:  "select /* window interval frame */ sum(b) over (order by d RANGE BETWEEN INTERVAL 1 day PRECEDING AND INTERVAL 1 day FOLLOWING) from t",
+	Output: "select /* window interval frame */ sum(b) over (order by d asc range between interval 1 day preceding and interval 1 day following) from t",
+}, {
+	Input:  "select /* frame keywords as columns */ current, preceding, following, unbounded from t",
+	Output: "select /* frame keywords as columns */ `current`, `preceding`, `following`, `unbounded` from t",
+}, {
+	Input: "with c as (select a from t) select /* with */ * from c",
+}, {
+	Input:  "WITH RECURSIVE c (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM c WHERE n < 5) select /* with recursive */ n from c",
+	Output: "with recursive c(n) as (select 1 from dual union all select n + 1 from c where n < 5) select /* with recursive */ n from c",
+}, {
+	Input:  "with a as (select 1), b (x) as (select * from a) select /* with union */ x from b union select 2 order by 1",
+	Output: "with a as (select 1 from dual), b(x) as (select * from a) select /* with union */ x from b union select 2 from dual order by 1 asc",
+}, {
+	Input: "select /* with subquery */ * from (with c as (select a from t) select a from c) as d where a in (with e as (select b from u) select b from e)",
+}, {
+	Input: "select /* with as column */ `with` from t",
+}, {
+	Input: "with c as (select a from t) update /* with */ u join c on u.a = c.a set u.b = 1",
+}, {
+	Input: "with c as (select a from t) delete /* with */ from u where a in (select a from c)",
+}, {
+	Input: "insert /* with */ into u with c as (select a from t) select a from c",
+}, {
+	Input: "insert /* with columns */ into u(a) with c as (select a from t) select a from c",
+}, {
+	Input:  "select /* simple order by */ 1 from t order by a",
+	Output: "select /* simple order by */ 1 from t order by a asc",
+}, {
+	Input: "select /* order by asc */ 1 from t order by a asc",
+}, {
+	Input: "select /* order by desc */ 1 from t order by a desc",
+}, {
+	Input: "select /* order by null */ 1 from t order by null",
+}, {
+	Input: "select /* limit a */ 1 from t limit a",
+}, {
+	Input: "select /* limit a,b */ 1 from t limit a, b",
+}, {
+	Input:  "select /* binary unary */ a- -b from t",
+	Output: "select /* binary unary */ a - -b from t",
+}, {
+	Input: "select /* - - */ - -b from t",
+}, {
+	Input: "select /* binary binary */ binary  binary b from t",
+}, {
+	Input: "select /* binary ~ */ binary  ~b from t",
+}, {
+	Input: "select /* ~ binary */ ~ binary b from t",
+}, {
+	Input: "select /* interval */ adddate('2008-01-02', interval 31 day) from t",
+}, {
+	Input: "select /* interval keyword */ adddate('2008-01-02', interval 1 year) from t",
+}, {
+	Input: "select /* dual */ 1 from dual",
+}, {
+	Input:  "select /* Dual */ 1 from Dual",
+	Output: "select /* Dual */ 1 from dual",
+}, {
+	Input:  "select /* DUAL */ 1 from Dual",
+	Output: "select /* DUAL */ 1 from dual",
+}, {
+	Input: "select /* column as bool in where */ a from t where b",
+}, {
+	Input: "select /* OR of columns in where */ * from t where a or b",
+}, {
+	Input: "select /* OR of mixed columns in where */ * from t where a = 5 or b and c is not null",
+}, {
+	Input: "select /* OR in select columns */ (a or b) from t where c = 5",
+}, {
+	Input: "select /* bool as select value */ a, true from t",
+}, {
+	Input: "select /* bool column in ON clause */ * from t join s on t.id = s.id and s.foo where t.bar",
+}, {
+	Input: "select /* bool in order by */ * from t order by a is null or b asc",
+}, {
+	Input: "select /* string in case statement */ if(max(case a when 'foo' then 1 else 0 end) = 1, 'foo', 'bar') as foobar from t",
+}, {
+	Input:  "/*!show databases*/",
+	Output: "show databases",
+}, {
+	Input:  "select /*!40101 * from*/ t",
+	Output: "select * from t",
+}, {
+	Input:  "select /*! * from*/ t",
+	Output: "select * from t",
+}, {
+	Input:  "select /*!* from*/ t",
+	Output: "select * from t",
+}, {
+	Input:  "select /*!401011 from*/ t",
+	Output: "select 1 from t",
+}, {
+	Input: "select /* dual */ 1 from dual",
+}, {
+	Input: "insert /* simple */ into a values (1)",
+}, {
+	Input: "insert /* a.b */ into a.b values (1)",
+}, {
+	Input: "insert /* multi-value */ into a values (1, 2)",
+}, {
+	Input: "insert /* multi-value list */ into a values (1, 2), (3, 4)",
+}, {
+	Input: "insert /* no values */ into a values ()",
+}, {
+	Input: "insert /* set */ into a set a = 1, b = 2",
+}, {
+	Input: "insert /* set on dup */ into a partition (p1) set a = now(), b = :v on duplicate key update b = values(b) + 1",
+}, {
+	Input: "replace /* set */ into a set a.b = (select max(b) from c)",
+}, {
+	Input: "insert /* set default */ into a set a = default, b = 2",
+}, {
+	Input: "insert /* value expression list */ into a values (a + 1, 2 * 3)",
+}, {
+	Input: "insert /* default */ into a values (default, 2 * 3)",
+}, {
+	Input: "insert /* column list */ into a(a, b) values (1, 2)",
+}, {
+	Input: "insert into a(a, b) values (1, ifnull(null, default(b)))",
+}, {
+	Input: "insert /* default column */ into a(a, b) values (default(a), default(`select`))",
+}, {
+	Input:  "update /* default column */ a set a = DEFAULT ( a.b ) + 1, b = default where c = default(c)",
+	Output: "update /* default column */ a set a = default(a.b) + 1, b = default where c = default(c)",
+}, {
+	Input: "insert /* qualified column list */ into a(a, b) values (1, 2)",
+}, {
+	Input:  "insert /* qualified columns */ into t (t.a, t.b) values (1, 2)",
+	Output: "insert /* qualified columns */ into t(a, b) values (1, 2)",
+}, {
+	Input: "insert /* select */ into a select b, c from d",
+}, {
+	Input:  "insert /* no cols & paren select */ into a(select * from t)",
+	Output: "insert /* no cols & paren select */ into a select * from t",
+}, {
+	Input:  "insert /* cols & paren select */ into a(a,b,c) (select * from t)",
+	Output: "insert /* cols & paren select */ into a(a, b, c) select * from t",
+}, {
+	Input: "insert /* cols & union with paren select */ into a(b, c) (select d, e from f) union (select g from h)",
+}, {
+	Input: "insert /* on duplicate */ into a values (1, 2) on duplicate key update b = func(a), c = d",
+}, {
+	Input: "insert /* bool in insert value */ into a values (1, true, false)",
+}, {
+	Input: "insert /* bool in on duplicate */ into a values (1, 2) on duplicate key update b = false, c = d",
+}, {
+	Input: "insert /* bool in on duplicate */ into a values (1, 2, 3) on duplicate key update b = values(b), c = d",
+}, {
+	Input: "insert /* bool in on duplicate */ into a values (1, 2, 3) on duplicate key update b = values(a.b), c = d",
+}, {
+	Input: "insert /* bool expression on duplicate */ into a values (1, 2) on duplicate key update b = func(a), c = a > d",
+}, {
+	Input: "insert /* row alias */ into a(b, c) values (1, 2), (3, 4) as new on duplicate key update b = new.b + new.c",
+}, {
+	Input:  "insert /* row alias with columns */ into a values (1, 2) AS `new`(x, y) on duplicate key update b = x, c = `new`.y",
+	Output: "insert /* row alias with columns */ into a values (1, 2) as new(x, y) on duplicate key update b = x, c = new.y",
+}, {
+	Input: "insert /* set row alias */ into a set b = 1, c = 2 as n on duplicate key update c = n.b",
+}, {
+	Input: "update /* simple */ a set b = 3",
+}, {
+	Input: "update /* a.b */ a.b set b = 3",
+}, {
+	Input: "update /* list */ a set b = 3, c = 4",
+}, {
+	Input: "update /* expression */ a set b = 3 + 4",
+}, {
+	Input: "update /* where */ a set b = 3 where a = b",
+}, {
+	Input: "update /* order */ a set b = 3 order by c desc",
+}, {
+	Input: "update /* limit */ a set b = 3 limit c",
+}, {
+	Input: "update /* bool in update */ a set b = true",
+}, {
+	Input: "update /* bool expr in update */ a set b = 5 > 2",
+}, {
+	Input: "update /* bool in update where */ a set b = 5 where c",
+}, {
+	Input: "update /* table qualifier */ a set a.b = 3",
+}, {
+	Input: "update /* table qualifier */ a set t.a.b = 3",
+}, {
+	Input:  "update /* table alias */ tt aa set aa.cc = 3",
+	Output: "update /* table alias */ tt as aa set aa.cc = 3",
+}, {
+	Input:  "update (select id from foo) subqalias set id = 4",
+	Output: "update (select id from foo) as subqalias set id = 4",
+}, {
+	Input:  "update foo f, bar b set f.id = b.id where b.name = 'test'",
+	Output: "update foo as f, bar as b set f.id = b.id where b.name = 'test'",
+}, {
+	Input:  "update foo f join bar b on f.name = b.name set f.id = b.id where b.name = 'test'",
+	Output: "update foo as f join bar as b on f.name = b.name set f.id = b.id where b.name = 'test'",
+}, {
+	Input: "delete /* simple */ from a",
+}, {
+	Input: "delete /* a.b */ from a.b",
+}, {
+	Input: "delete /* where */ from a where a = b",
+}, {
+	Input: "delete /* order */ from a order by b desc",
+}, {
+	Input: "delete /* limit */ from a limit b",
+}, {
+	Input: "delete a from a join b on a.id = b.id where b.name = 'test'",
+}, {
+	Input: "delete a, b from a, b where a.id = b.id and b.name = 'test'",
+}, {
+	Input:  "delete from a1, a2 using t1 as a1 inner join t2 as a2 where a1.id=a2.id",
+	Output: "delete a1, a2 from t1 as a1 inner join t2 as a2 where a1.id = a2.id",
+}, {
+	Input: "set /* simple */ a = 3",
+}, {
+	Input: "set #simple\n b = 4",
+}, {
+	Input: "set character_set_results = utf8",
+}, {
+	Input: "set @@session.autocommit = true",
+}, {
+	Input: "set @@session.`autocommit` = true",
+}, {
+	Input: "set @@session.'autocommit' = true",
+}, {
+	Input: "set @@session.\"autocommit\" = true",
+}, {
+	Input:  "set names utf8 collate foo",
+	Output: "set names 'utf8'",
+}, {
+	Input:  "set character set utf8",
+	Output: "set charset 'utf8'",
+}, {
+	Input:  "set character set 'utf8'",
+	Output: "set charset 'utf8'",
+}, {
+	Input:  "set character set \"utf8\"",
+	Output: "set charset 'utf8'",
+}, {
+	Input:  "set charset default",
+	Output: "set charset default",
+}, {
+	Input:  "set session wait_timeout = 3600",
+	Output: "set session wait_timeout = 3600",
+}, {
+	Input: "set /* list */ a = 3, b = 4",
+}, {
+	Input: "set /* mixed list */ a = 3, names 'utf8', charset 'ascii', b = 4",
+}, {
+	Input:  "set session transaction isolation level repeatable read",
+	Output: "set session tx_isolation = 'repeatable read'",
+}, {
+	Input:  "set global transaction isolation level repeatable read",
+	Output: "set global tx_isolation = 'repeatable read'",
+}, {
+	Input:  "set transaction isolation level repeatable read",
+	Output: "set tx_isolation = 'repeatable read'",
+}, {
+	Input:  "set transaction isolation level read committed",
+	Output: "set tx_isolation = 'read committed'",
+}, {
+	Input:  "set transaction isolation level read uncommitted",
+	Output: "set tx_isolation = 'read uncommitted'",
+}, {
+	Input:  "set transaction isolation level serializable",
+	Output: "set tx_isolation = 'serializable'",
+}, {
+	Input:  "set transaction read write",
+	Output: "set tx_read_only = 0",
+}, {
+	Input:  "set transaction read only",
+	Output: "set tx_read_only = 1",
+}, {
+	Input: "set tx_read_only = 1",
+}, {
+	Input: "set tx_read_only = 0",
+}, {
+	Input: "set tx_isolation = 'repeatable read'",
+}, {
+	Input: "set tx_isolation = 'read committed'",
+}, {
+	Input: "set tx_isolation = 'read uncommitted'",
+}, {
+	Input: "set tx_isolation = 'serializable'",
+}, {
+	Input: "set sql_safe_updates = 0",
+}, {
+	Input: "set sql_safe_updates = 1",
+}, {
+	Input:  "alter ignore table a add foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a add foo",
+	Output: "alter table a",
+}, {
+	Input: "alter table a add spatial key foo (column1)",
+}, {
+	Input: "alter table a add unique key foo (column1)",
+}, {
+	Input:  "alter table `By` add foo",
+	Output: "alter table `By`",
+}, {
+	Input:  "alter table a alter foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a change foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a modify foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a drop foo",
+	Output: "alter table a drop column foo",
+}, {
+	Input:  "alter table a disable foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a enable foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a order foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a default foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a discard foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a import foo",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a rename b",
+	Output: "rename table a to b",
+}, {
+	Input:  "alter table `By` rename `bY`",
+	Output: "rename table `By` to `bY`",
+}, {
+	Input:  "alter table a rename to b",
+	Output: "rename table a to b",
+}, {
+	Input:  "alter table a rename as b",
+	Output: "rename table a to b",
+}, {
+	Input: "alter table a rename index foo to bar",
+}, {
+	Input:  "alter table a rename key foo to bar",
+	Output: "alter table a rename index foo to bar",
+}, {
+	Input: "alter table e auto_increment = 20",
+}, {
+	Input: "alter table e character set = 'ascii'",
+}, {
+	Input: "alter table e default character set = 'ascii'",
+}, {
+	Input: "alter table e comment = 'hello'",
+}, {
+	Input:  "alter table a reorganize partition b into (partition c values less than (?), partition d values less than (maxvalue))",
+	Output: "alter table a reorganize partition b into (partition c values less than (:v1), partition d values less than (maxvalue))",
+}, {
+	Input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+	Output: "alter table a",
+}, {
+	Input: "alter table a add column id int",
+}, {
+	Input: "alter table a add index idx (id)",
+}, {
+	Input: "alter table a add fulltext index idx (id)",
+}, {
+	Input: "alter table a add spatial index idx (id)",
+}, {
+	Input:  "alter table a add index idx (b, (cast(data->'$.ids' as unsigned array)))",
+	Output: "alter table a add index idx (b, (cast(data -> '$.ids' as unsigned array)))",
+}, {
+	Input: "alter table a add key idx ((lower(b)), c(10))",
+}, {
+	Input:  "alter table a add foreign key",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a add primary key",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a add constraint",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a add id",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a drop column id int",
+	Output: "alter table a drop column id",
+}, {
+	Input:  "alter table a drop partition p2712",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a drop index idx (id)",
+	Output: "alter table a drop index idx",
+}, {
+	Input:  "alter table a drop fulltext index idx (id)",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a drop spatial index idx (id)",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a drop foreign key",
+	Output: "alter table a",
+}, {
+	Input: "alter table a drop primary key",
+}, {
+	Input:  "alter table a drop constraint",
+	Output: "alter table a",
+}, {
+	Input:  "alter table a drop id",
+	Output: "alter table a drop column id",
+}, {
+	Input: "alter table a add column b int not null default 0 after id, drop column c, add unique index b_idx (b)",
+}, {
+	Input:  "alter table a add b varchar(10) first",
+	Output: "alter table a add column b varchar(10) first",
+}, {
+	Input: "alter table a add column g point srid 4326, add spatial index g_idx (g)",
+}, {
+	Input:  "alter table a modify b bigint unsigned, change column c d text comment 'd'",
+	Output: "alter table a modify column b bigint unsigned, change column c d text comment 'd'",
+}, {
+	Input:  "alter table a change first after int after id",
+	Output: "alter table a change column `first` `after` int after id",
+}, {
+	Input:  "alter table a alter b set default 'x', alter column c drop default",
+	Output: "alter table a alter column b set default 'x', alter column c drop default",
+}, {
+	Input:  "alter table a rename column b to c, rename key d to e",
+	Output: "alter table a rename column b to c, rename index d to e",
+}, {
+	Input:  "alter table a drop foreign key fk_b, drop key b",
+	Output: "alter table a drop foreign key fk_b, drop index b",
+}, {
+	Input:  "alter table a convert to charset utf8mb4 collate utf8mb4_bin",
+	Output: "alter table a convert to character set utf8mb4 collate utf8mb4_bin",
+}, {
+	Input:  "alter table a engine = InnoDB, algorithm = inplace, lock = none",
+	Output: "alter table a engine = InnoDB, algorithm = inplace, lock = none",
+}, {
+	Input: "alter table a force",
+}, {
+	Input:  "alter ignore table a add fulltext key ft (b)",
+	Output: "alter table a add fulltext key ft (b)",
+}, {
+	Input:  "alter table a modify foo",
+	Output: "alter table a",
+}, {
+	Input: "alter table a add vindex hash (id)",
+}, {
+	Input:  "alter table a add vindex `hash` (`id`)",
+	Output: "alter table a add vindex hash (id)",
+}, {
+	Input:  "alter table a add vindex hash (id) using `hash`",
+	Output: "alter table a add vindex hash (id) using hash",
+}, {
+	Input: "alter table a add vindex `add` (`add`)",
+}, {
+	Input: "alter table a add vindex hash (id) using hash",
+}, {
+	Input:  "alter table a add vindex hash (id) using `hash`",
+	Output: "alter table a add vindex hash (id) using hash",
+}, {
+	Input: "alter table user add vindex name_lookup_vdx (name) using lookup_hash with owner=user, table=name_user_idx, from=name, to=user_id",
+}, {
+	Input:  "alter table user2 add vindex name_lastname_lookup_vdx (name,lastname) using lookup with owner=`user`, table=`name_lastname_keyspace_id_map`, from=`name,lastname`, to=`keyspace_id`",
+	Output: "alter table user2 add vindex name_lastname_lookup_vdx (name, lastname) using lookup with owner=user, table=name_lastname_keyspace_id_map, from=name,lastname, to=keyspace_id",
+}, {
+	Input: "alter table a drop vindex hash",
+}, {
+	Input:  "alter table a drop vindex `hash`",
+	Output: "alter table a drop vindex hash",
+}, {
+	Input:  "alter table a drop vindex hash",
+	Output: "alter table a drop vindex hash",
+}, {
+	Input:  "alter table a drop vindex `add`",
+	Output: "alter table a drop vindex `add`",
+}, {
+	Input: "create table a",
+}, {
+	Input:  "create table a (\n\t`a` int\n)",
+	Output: "create table a (\n\ta int\n)",
+}, {
+	Input: "create table `by` (\n\t`by` char\n)",
+}, {
+	Input:  "create table if not exists a (\n\t`a` int\n)",
+	Output: "create table if not exists a (\n\ta int\n)",
+}, {
+	Input:  "create table a ignore me this is garbage",
+	Output: "create table a",
+}, {
+	Input: "create table a like b",
+}, {
+	Input:  "create table if not exists a (like b.c)",
+	Output: "create table if not exists a like b.c",
+}, {
+	Input: "create table if not exists t like u",
+}, {
+	Input: "create table a as select * from b where c = 1",
+}, {
+	Input:  "create table a select * from b",
+	Output: "create table a as select * from b",
+}, {
+	Input: "create table if not exists a as select * from b union select * from c",
+}, {
+	Input:  "create table if not exists t select a from u",
+	Output: "create table if not exists t as select a from u",
+}, {
+	Input:  "create table a ignore select * from b",
+	Output: "create table a ignore as select * from b",
+}, {
+	Input: "create table a replace as select * from b",
+}, {
+	Input:  "create table a (\n\tx int\n) engine=InnoDB select x from b",
+	Output: "create table a (\n\tx int\n) engine=InnoDB as select x from b",
+}, {
+	Input: "create table a (\n\tx int\n) replace as select x from b",
+}, {
+	Input:  "create table a (a int, b char, c garbage)",
+	Output: "create table a",
+}, {
+	Input:  "create table a (id int, b int, c int, unique (b, c), key (c), constraint fk_b foreign key (b) references t (id) on delete cascade on update set null, constraint uq unique key (c), foreign key c_idx (c) references db.t (x, y) on update no action on delete restrict)",
+	Output: "create table a (\n\tid int,\n\tb int,\n\tc int,\n\tunique (b, c),\n\tkey (c),\n\tunique key uq (c),\n\tconstraint fk_b foreign key (b) references t (id) on delete cascade on update set null,\n\tforeign key c_idx (c) references db.t (x, y) on delete restrict on update no action\n)",
+}, {
+	Input:  "alter table a add constraint fk_b foreign key (b) references t (id) on delete set default, add constraint c unique (b), add fulltext key (d)",
+	Output: "alter table a add constraint fk_b foreign key (b) references t (id) on delete set default, add unique c (b), add fulltext key (d)",
+}, {
+	Input: "create vindex hash_vdx using hash",
+}, {
+	Input: "create vindex lookup_vdx using lookup with owner=user, table=name_user_idx, from=name, to=user_id",
+}, {
+	Input: "create vindex xyz_vdx using xyz with param1=hello, param2='world', param3=123",
+}, {
+	Input:  "create index a on b",
+	Output: "alter table b",
+}, {
+	Input:  "create unique index a on b",
+	Output: "alter table b",
+}, {
+	Input:  "create unique index a using foo on b",
+	Output: "alter table b",
+}, {
+	Input:  "create fulltext index a using foo on b",
+	Output: "alter table b",
+}, {
+	Input:  "create spatial index a using foo on b",
+	Output: "alter table b",
+}, {
+	Input:  "create index a on b (c, d(10))",
+	Output: "alter table b add index a (c, d(10))",
+}, {
+	Input:  "create unique index a using btree on b (c) comment 'x'",
+	Output: "alter table b add unique index a (c)",
+}, {
+	Input:  "create index idx on t ((CAST(data->'$.ids' AS UNSIGNED ARRAY)))",
+	Output: "alter table t add index idx ((cast(data -> '$.ids' as unsigned array)))",
+}, {
+	Input:  "create index a on b (c desc)",
+	Output: "alter table b",
+}, {
+	Input:  "create view a",
+	Output: "create table a",
+}, {
+	Input:  "create or replace view a",
+	Output: "create table a",
+}, {
+	Input:  "alter view a",
+	Output: "alter table a",
+}, {
+	Input: "create view a as select b from c union all select b from d",
+}, {
+	Input:  "create or replace view A (b, c) as select 1, 2",
+	Output: "create or replace view a(b, c) as select 1, 2 from dual",
+}, {
+	Input: "create view a as (select b from c)",
+}, {
+	Input:  "create view a as select b from c with check option",
+	Output: "create table a",
+}, {
+	Input: "alter view a(b) as select b from c where d in (select d from e union select d from f)",
+}, {
+	Input:  "drop view a",
+	Output: "drop table a",
+}, {
+	Input:  "drop table a",
+	Output: "drop table a",
+}, {
+	Input:  "drop table if exists a",
+	Output: "drop table if exists a",
+}, {
+	Input:  "drop view if exists a",
+	Output: "drop table if exists a",
+}, {
+	Input:  "drop index b on a",
+	Output: "alter table a drop index b",
+}, {
+	Input: "analyze table a",
+}, {
+	Input:  "ANALYZE NO_WRITE_TO_BINLOG TABLES a, b.c",
+	Output: "analyze local table a, b.c",
+}, {
+	Input: "optimize local table a, b",
+}, {
+	Input: "repair table a quick extended use_frm",
+}, {
+	Input:  "repair no_write_to_binlog table a, b QUICK",
+	Output: "repair local table a, b quick",
+}, {
+	Input: "check table a, b for upgrade",
+}, {
+	Input:  "check tables a Fast Changed",
+	Output: "check table a fast changed",
+}, {
+	Input: "checksum table a, b.c",
+}, {
+	Input:  "CHECKSUM TABLES a QUICK",
+	Output: "checksum table a quick",
+}, {
+	Input: "checksum table a extended",
+}, {
+	Input:  "select checksum, cache, c.load from t as c",
+	Output: "select `checksum`, `cache`, c.`load` from t as c",
+}, {
+	Input: "cache index t1 in hot_cache",
+}, {
+	Input:  "CACHE INDEX t1 INDEX (i1, i2), db.t2 KEY (i3), t3 IN hot_cache",
+	Output: "cache index t1 index (i1, i2), db.t2 index (i3), t3 in hot_cache",
+}, {
+	Input:  "cache index t1 partition (p1, p2) key (i1) in hot_cache",
+	Output: "cache index t1 partition (p1, p2) index (i1) in hot_cache",
+}, {
+	Input:  "cache index t1 PARTITION (ALL) in `default`",
+	Output: "cache index t1 partition (all) in `default`",
+}, {
+	Input:  "LOAD INDEX INTO CACHE t1, t2 IGNORE LEAVES",
+	Output: "load index into cache t1, t2 ignore leaves",
+}, {
+	Input: "load index into cache t1 partition (p1) index (i1, i2) ignore leaves, t2 partition (all) index (i3)",
+}, {
+	Input:  "select check from t",
+	Output: "select `check` from t",
+}, {
+	Input:  "show binary logs",
+	Output: "show binary logs",
+}, {
+	Input:  "show binlog events",
+	Output: "show binlog",
+}, {
+	Input:  "show character set",
+	Output: "show character set",
+}, {
+	Input:  "show character set like '%foo'",
+	Output: "show character set",
+}, {
+	Input:  "show collation",
+	Output: "show collation",
+}, {
+	Input:  "show create database d",
+	Output: "show create database",
+}, {
+	Input:  "show create event e",
+	Output: "show create event",
+}, {
+	Input:  "show create function f",
+	Output: "show create function",
+}, {
+	Input:  "show create procedure p",
+	Output: "show create procedure",
+}, {
+	Input:  "show create table t",
+	Output: "show create table",
+}, {
+	Input:  "show create trigger t",
+	Output: "show create trigger",
+}, {
+	Input:  "show create user u",
+	Output: "show create user",
+}, {
+	Input:  "show create view v",
+	Output: "show create view",
+}, {
+	Input:  "show databases",
+	Output: "show databases",
+}, {
+	Input:  "show engine INNODB",
+	Output: "show engine",
+}, {
+	Input:  "show engines",
+	Output: "show engines",
+}, {
+	Input:  "show storage engines",
+	Output: "show storage",
+}, {
+	Input:  "show errors",
+	Output: "show errors",
+}, {
+	Input:  "show events",
+	Output: "show events",
+}, {
+	Input:  "show function code func",
+	Output: "show function",
+}, {
+	Input:  "show function status",
+	Output: "show function",
+}, {
+	Input:  "show grants for 'root@localhost'",
+	Output: "show grants",
+}, {
+	Input:  "show index from table",
+	Output: "show index",
+}, {
+	Input:  "show indexes from table",
+	Output: "show indexes",
+}, {
+	Input:  "show keys from table",
+	Output: "show keys",
+}, {
+	Input:  "show master status",
+	Output: "show master",
+}, {
+	Input:  "show open tables",
+	Output: "show open",
+}, {
+	Input:  "show plugins",
+	Output: "show plugins",
+}, {
+	Input:  "show privileges",
+	Output: "show privileges",
+}, {
+	Input:  "show procedure code p",
+	Output: "show procedure",
+}, {
+	Input:  "show procedure status",
+	Output: "show procedure",
+}, {
+	Input:  "show processlist",
+	Output: "show processlist",
+}, {
+	Input:  "show full processlist",
+	Output: "show processlist",
+}, {
+	Input:  "show profile cpu for query 1",
+	Output: "show profile",
+}, {
+	Input:  "show profiles",
+	Output: "show profiles",
+}, {
+	Input:  "show relaylog events",
+	Output: "show relaylog",
+}, {
+	Input:  "show slave hosts",
+	Output: "show slave",
+}, {
+	Input:  "show slave status",
+	Output: "show slave",
+}, {
+	Input:  "show status",
+	Output: "show status",
+}, {
+	Input:  "show global status",
+	Output: "show global status",
+}, {
+	Input:  "show session status",
+	Output: "show session status",
+}, {
+	Input:  "show table status",
+	Output: "show table",
+}, {
+	Input: "show tables",
+}, {
+	Input: "show tables like '%keyspace%'",
+}, {
+	Input: "show tables where 1 = 0",
+}, {
+	Input: "show tables from a",
+}, {
+	Input: "show tables from a where 1 = 0",
+}, {
+	Input: "show tables from a like '%keyspace%'",
+}, {
+	Input: "show full tables",
+}, {
+	Input: "show full tables from a",
+}, {
+	Input:  "show full tables in a",
+	Output: "show full tables from a",
+}, {
+	Input: "show full tables from a like '%keyspace%'",
+}, {
+	Input: "show full tables from a where 1 = 0",
+}, {
+	Input: "show full tables like '%keyspace%'",
+}, {
+	Input: "show full tables where 1 = 0",
+}, {
+	Input:  "show triggers",
+	Output: "show triggers",
+}, {
+	Input:  "show variables",
+	Output: "show variables",
+}, {
+	Input:  "show global variables",
+	Output: "show global variables",
+}, {
+	Input:  "show session variables",
+	Output: "show session variables",
+}, {
+	Input:  "show vindexes",
+	Output: "show vindexes",
+}, {
+	Input:  "show vindexes on t",
+	Output: "show vindexes on t",
+}, {
+	Input: "show vitess_keyspaces",
+}, {
+	Input: "show vitess_shards",
+}, {
+	Input: "show vitess_tablets",
+}, {
+	Input: "show vschema_tables",
+}, {
+	Input:  "show warnings",
+	Output: "show warnings",
+}, {
+	Input:  "show foobar",
+	Output: "show foobar",
+}, {
+	Input:  "show foobar like select * from table where syntax is 'ignored'",
+	Output: "show foobar",
+}, {
+	Input:  "use db",
+	Output: "use db",
+}, {
+	Input:  "use duplicate",
+	Output: "use `duplicate`",
+}, {
+	Input:  "use `ks:-80@master`",
+	Output: "use `ks:-80@master`",
+}, {
+	Input: "describe foobar",
+}, {
+	Input: "desc foobar",
+}, {
+	Input: "explain foobar",
+}, {
+	Input: "describe db.foobar id",
+}, {
+	Input:  "DESC foobar `select`",
+	Output: "desc foobar `select`",
+}, {
+	Input:  "desc foobar date",
+	Output: "desc foobar `date`",
+}, {
+	Input: "explain foobar 'a%'",
+}, {
+	Input:  "describe foobar \"it's\"",
+	Output: "describe foobar 'it\\'s'",
+}, {
+	Input:  "explain select * from foobar",
+	Output: "otherread",
+}, {
+	Input:  "desc delete from foobar",
+	Output: "otherread",
+}, {
+	Input:  "explain (select 1)",
+	Output: "otherread",
+}, {
+	Input:  "explain analyze select 1",
+	Output: "otherread",
+}, {
+	Input:  "explain extended select 1",
+	Output: "otherread",
+}, {
+	Input:  "explain partitions select 1",
+	Output: "otherread",
+}, {
+	Input:  "explain format = json select 1",
+	Output: "otherread",
+}, {
+	Input:  "explain format=tree for connection 10",
+	Output: "otherread",
+}, {
+	Input:  "describe for connection 10",
+	Output: "otherread",
+}, {
+	Input:  "explain with c as (select 1) select * from c",
+	Output: "otherread",
+}, {
+	Input:  "explain table foobar",
+	Output: "otherread",
+}, {
+	Input:  "truncate table foo",
+	Output: "truncate table foo",
+}, {
+	Input:  "truncate foo",
+	Output: "truncate table foo",
+}, {
+
+	Input: "select /* EQ true */ 1 from t where a = true",
+}, {
+	Input: "select /* EQ false */ 1 from t where a = false",
+}, {
+	Input: "select /* NE true */ 1 from t where a != true",
+}, {
+	Input: "select /* NE false */ 1 from t where a != false",
+}, {
+	Input: "select /* LT true */ 1 from t where a < true",
+}, {
+	Input: "select /* LT false */ 1 from t where a < false",
+}, {
+	Input: "select /* GT true */ 1 from t where a > true",
+}, {
+	Input: "select /* GT false */ 1 from t where a > false",
+}, {
+	Input: "select /* LE true */ 1 from t where a <= true",
+}, {
+	Input: "select /* LE false */ 1 from t where a <= false",
+}, {
+	Input: "select /* GE true */ 1 from t where a >= true",
+}, {
+	Input: "select /* GE false */ 1 from t where a >= false",
+}, {
+	Input:  "select * from t order by a collate utf8_general_ci",
+	Output: "select * from t order by a collate utf8_general_ci asc",
+}, {
+	Input: "select k collate latin1_german2_ci as k1 from t1 order by k1 asc",
+}, {
+	Input: "select * from t group by a collate utf8_general_ci",
+}, {
+	Input: "select MAX(k collate latin1_german2_ci) from t1",
+}, {
+	Input: "select distinct k collate latin1_german2_ci from t1",
+}, {
+	Input: "select * from t1 where 'Müller' collate latin1_german2_ci = k",
+}, {
+	Input: "select * from t1 where k like 'Müller' collate latin1_german2_ci",
+}, {
+	Input: "select k from t1 group by k having k = 'Müller' collate latin1_german2_ci",
+}, {
+	Input: "select k from t1 join t2 order by a collate latin1_german2_ci asc, b collate latin1_german2_ci asc",
+}, {
+	Input:  "select k collate 'latin1_german2_ci' as k1 from t1 order by k1 asc",
+	Output: "select k collate latin1_german2_ci as k1 from t1 order by k1 asc",
+}, {
+	Input:  "select /* drop trailing semicolon */ 1 from dual;",
+	Output: "select /* drop trailing semicolon */ 1 from dual",
+}, {
+	Input: "select /* cache directive */ sql_no_cache 'foo' from t",
+}, {
+	Input: "select binary 'a' = 'A' from t",
+}, {
+	Input: "select 1 from t where foo = _binary 'bar'",
+}, {
+	Input:  "select 1 from t where foo = _binary'bar'",
+	Output: "select 1 from t where foo = _binary 'bar'",
+}, {
+	Input: "select match(a) against ('foo') from t",
+}, {
+	Input: "select match(a1, a2) against ('foo' in natural language mode with query expansion) from t",
+}, {
+	Input: "select title from video as v where match(v.title, v.tag) against ('DEMO' in boolean mode)",
+}, {
+	Input: "select name, group_concat(score) from t group by name",
+}, {
+	Input: "select name, group_concat(distinct id, score order by id desc separator ':') from t group by name",
+}, {
+	Input: "select * from t partition (p0)",
+}, {
+	Input: "select * from t partition (p0, p1)",
+}, {
+	Input: "select e.id, s.city from employees as e join stores partition (p1) as s on e.store_id = s.id",
+}, {
+	Input: "select truncate(120.3333, 2) from dual",
+}, {
+	Input: "update t partition (p0) set a = 1",
+}, {
+	Input: "insert into t partition (p0) values (1, 'asdf')",
+}, {
+	Input: "insert into t1 select * from t2 partition (p0)",
+}, {
+	Input: "replace into t partition (p0) values (1, 'asdf')",
+}, {
+	Input: "delete from t partition (p0) where a = 1",
+}, {
+	Input: "stream * from t",
+}, {
+	Input: "stream /* comment */ * from t",
+}, {
+	Input: "begin",
+}, {
+	Input:  "start transaction",
+	Output: "begin",
+}, {
+	Input: "commit",
+}, {
+	Input: "rollback",
+}, {
+	Input: "create database test_db",
+}, {
+	Input:  "create schema test_db",
+	Output: "create database test_db",
+}, {
+	Input:  "create database if not exists test_db",
+	Output: "create database test_db",
+}, {
+	Input: "drop database test_db",
+}, {
+	Input:  "drop schema test_db",
+	Output: "drop database test_db",
+}, {
+	Input:  "drop database if exists test_db",
+	Output: "drop database test_db",
+}, {
+	Input: "do 1",
+}, {
+	Input:  "DO sleep(1), release_lock('foo')",
+	Output: "do sleep(1), release_lock('foo')",
+}, {
+	Input: "handler t open",
+}, {
+	Input:  "handler db.t open h",
+	Output: "handler db.t open as h",
+}, {
+	Input: "handler t read first",
+}, {
+	Input:  "HANDLER t READ NEXT",
+	Output: "handler t read next",
+}, {
+	Input: "handler t read idx prev where a > 1 limit 10",
+}, {
+	Input: "handler t read idx = (1, 'a')",
+}, {
+	Input: "handler t read idx >= (5) limit 1, 2",
+}, {
+	Input: "handler t close",
+}, {
+	Input: "flush tables",
+}, {
+	Input: "flush tables t1, db.t2",
+}, {
+	Input: "flush tables with read lock",
+}, {
+	Input: "flush tables t1 with read lock",
+}, {
+	Input: "flush tables t1 for export",
+}, {
+	Input:  "flush table t",
+	Output: "flush tables t",
+}, {
+	Input:  "flush local table t1, t2 with read lock",
+	Output: "flush local tables t1, t2 with read lock",
+}, {
+	Input:  "flush no_write_to_binlog privileges",
+	Output: "flush local privileges",
+}, {
+	Input: "flush local logs",
+}, {
+	Input:  "FLUSH BINARY LOGS, Engine Logs, status, hosts",
+	Output: "flush binary logs, engine logs, status, hosts",
+}, {
+	Input: "kill 42",
+}, {
+	Input: "kill query 42",
+}, {
+	Input:  "KILL CONNECTION 42",
+	Output: "kill connection 42",
+}, {
+	Input: "kill :id",
+}, {
+	Input: "kill connection_id()",
+}, {
+	Input: "kill query @id + 1",
+}, {
+	Input: "kill query (select max(id) from t)",
+}, {
+	Input: "xa start 'xid'",
+}, {
+	Input:  "XA BEGIN \"xid\", 'b', 1 JOIN",
+	Output: "xa start 'xid', 'b', 1 join",
+}, {
+	Input: "xa start X'0102' resume",
+}, {
+	Input: "xa start 0x61, 0x62, 0x1 resume",
+}, {
+	Input: "xa commit 0x61 one phase",
+}, {
+	Input: "xa end 'xid'",
+}, {
+	Input: "xa end 'xid', '' suspend for migrate",
+}, {
+	Input:  "xa prepare 'x''id'",
+	Output: "xa prepare 'x\\'id'",
+}, {
+	Input: "xa commit 'xid' one phase",
+}, {
+	Input: "xa rollback 'xid'",
+}, {
+	Input: "xa recover",
+}, {
+	Input:  "XA RECOVER CONVERT XID",
+	Output: "xa recover convert xid",
+}, {
+	Input:  "set global read_only = ON",
+	Output: "set global read_only = 'on'",
+}, {
+	Input:  "CHANGE MASTER TO MASTER_HOST='db1', MASTER_PASSWORD='p;w\\'d', MASTER_AUTO_POSITION=1",
+	Output: "change master to MASTER_HOST='db1', MASTER_PASSWORD='p;w\\'d', MASTER_AUTO_POSITION=1",
+}, {
+	Input:  "change replication source to SOURCE_HOST = 'db1' for channel `c;1`",
+	Output: "change replication source to SOURCE_HOST = 'db1' for channel `c;1`",
+}, {
+	Input: "change replication filter REPLICATE_DO_DB = (d1, d2)",
+}, {
+	Input:  "START SLAVE",
+	Output: "start slave",
+}, {
+	Input: "start replica io_thread until SOURCE_LOG_FILE = 'bin.000002', SOURCE_LOG_POS = 4",
+}, {
+	Input:  "Stop Replica SQL_THREAD FOR CHANNEL 'c'",
+	Output: "stop replica SQL_THREAD FOR CHANNEL 'c'",
+}, {
+	Input: "stop group_replication",
+}, {
+	Input: "reset master",
+}, {
+	Input: "reset slave all",
+}, {
+	Input:  "RESET QUERY CACHE",
+	Output: "reset query CACHE",
+}, {
+	Input:  "PURGE BINARY LOGS TO 'bin.000010'",
+	Output: "purge binary logs TO 'bin.000010'",
+}, {
+	Input: "purge master logs before '2024-01-01 00:00:00'",
+}, {
+	Input: "lock tables t read",
+}, {
+	Input:  "LOCK TABLE t AS a READ LOCAL, d.u WRITE, v w low_priority write",
+	Output: "lock tables t as a read local, d.u write, v as w low_priority write",
+}, {
+	Input:  "lock tables t read read",
+	Output: "lock tables t as `read` read",
+}, {
+	Input: "unlock tables",
+}, {
+	Input:  "UNLOCK TABLE",
+	Output: "unlock tables",
+}, {
+	Input: "select date '2024-01-01', time '10:00:00', timestamp '2024-01-01 00:00:00' from t",
+}, {
+	Input:  "select date, time '10:00' from t",
+	Output: "select `date`, time '10:00' from t",
+}, {
+	Input: "select timestamp '2024-01-01T10:00:00.123456', time '-1 10:00:00', date '20240101' from t",
+}, {
+	Input: "select * from t where a > {ts '2024-01-01 00:00:00'} and b < {d '2024-01-01'} and c = {t '10:00:00'}",
+}, {
+	Input:  "select {fn NOW()}, {FN concat({fn ucase(a)}, {D '2024-01-01'})} from t",
+	Output: "select {fn NOW()}, {fn concat({fn ucase(a)}, {d '2024-01-01'})} from t",
+}, {
+	Input:  "call p",
+	Output: "call p()",
+}, {
+	Input: "call db.p(1, 'a', @x)",
+}, {
+	Input: "{call p(1, {fn now()})}",
+}, {
+	Input:  "PREPARE stmt1 FROM 'SELECT * FROM t WHERE a = ?'",
+	Output: "prepare stmt1 from 'SELECT * FROM t WHERE a = ?'",
+}, {
+	Input: "prepare stmt1 from @sql",
+}, {
+	Input: "prepare stmt1 from concat('select * from ', @tbl)",
+}, {
+	Input: "execute immediate 'select 1'",
+}, {
+	Input: "execute immediate concat('select * from ', @tbl, ' where a = ?') using @a",
+}, {
+	Input: "execute immediate @sql",
+}, {
+	Input:  "select immediate from t",
+	Output: "select `immediate` from t",
+}, {
+	Input: "prepare stmt1 from 'this is not sql'",
+}, {
+	Input:  "EXECUTE stmt1 USING @a, @b",
+	Output: "execute stmt1 using @a, @b",
+}, {
+	Input: "execute stmt1",
+}, {
+	Input: "deallocate prepare stmt1",
+}, {
+	Input:  "drop prepare stmt1",
+	Output: "deallocate prepare stmt1",
+}, {
+	Input:  "select prepare, execute, deallocate from t",
+	Output: "select `prepare`, `execute`, `deallocate` from t",
+}, {
+	Input:  "CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET NEW.created = NOW()",
+	Output: "create trigger trg before insert on t for each row set NEW.created = NOW()",
+}, {
+	Input:  "create definer=`root`@`localhost` trigger db.trg after update on t for each row follows other insert into log values (old.id)",
+	Output: "create definer = `root`@`localhost` trigger db.trg after update on t for each row follows other insert into log values (old.id)",
+}, {
+	Input:  "create definer = 'root'@'%' trigger trg before delete on t for each row begin if old.id = 1 then set @x = if(old.a, 1, 2); end if; end",
+	Output: "create definer = `root`@`%` trigger trg before delete on t for each row begin if old.id = 1 then set @x = if(old.a, 1, 2); end if; end",
+}, {
+	Input:  "create definer = root@localhost trigger trg after insert on t for each row begin while @c > 0 do set @c = @c - 1; end while; end",
+	Output: "create definer = `root`@`localhost` trigger trg after insert on t for each row begin while @c > 0 do set @c = @c - 1; end while; end",
+}, {
+	Input: "create trigger trg before insert on t for each row begin select 'end'; /* end */ end",
+}, {
+	Input: "drop trigger if exists db.trg",
+}, {
+	Input:  "create definer = current_user() event if not exists ev on schedule every 1 day starts '2020-01-01' + interval 1 hour ends '2021-01-01' on completion not preserve disable on slave comment 'nightly' do delete from t where a < now()",
+	Output: "create definer = current_user event if not exists ev on schedule every 1 day starts '2020-01-01' + interval 1 hour ends '2021-01-01' on completion not preserve disable on slave comment 'nightly' do delete from t where a < now()",
+}, {
+	Input:  "create event ev on schedule at current_timestamp + interval 1 hour enable do begin update t set a = 1; end",
+	Output: "create event ev on schedule at current_timestamp() + interval 1 hour enable do begin update t set a = 1; end",
+}, {
+	Input: "drop event ev",
+}, {
+	Input: "create procedure p(in a int, out b varchar(10), inout c decimal(10,2)) comment 'x' not deterministic reads sql data sql security invoker begin select a into b; if a > 1 then set c = 1; end if; end",
+}, {
+	Input:  "create definer=`root`@`localhost` procedure p() select 1",
+	Output: "create definer = `root`@`localhost` procedure p() select 1 from dual",
+}, {
+	Input:  "create definer=`root`@`localhost` function f(a int) returns int(11) deterministic return a + 1",
+	Output: "create definer = `root`@`localhost` function f(a int) returns int(11) deterministic return a + 1",
+}, {
+	Input:  "create function if not exists db.f() returns varchar(10) charset utf8 no sql begin return 'end;'; end",
+	Output: "create function if not exists db.f() returns varchar(10) character set utf8 no sql begin return 'end;'; end",
+}, {
+	Input: "drop procedure if exists p",
+}, {
+	Input: "drop function db.f",
+}, {
+	Input:  "show create function f",
+	Output: "show create function",
+}}
@@ -0,0 +1,154 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// countQueryAlias is the alias of the derived table that CountQuery
+// wraps the queries it can't rewrite in.
+const countQueryAlias = "counted"
+
+// CountQuery returns a query that counts the rows sel returns, like
+// SELECT COUNT(*) FROM ..., for instance to paginate its results.
+// ORDER BY, LIMIT and the locking clause are dropped, as well as
+// SQL_CALC_FOUND_ROWS: the count is the one of all the rows, not just
+// the ones of a page.
+//
+// If the rows of sel are the ones of its FROM clause, the select list
+// is just replaced with COUNT(*). If they aren't, because sel groups
+// its rows, with GROUP BY or aggregates, filters them with HAVING, or
+// removes duplicates with DISTINCT, or if it's a union, sel is kept
+// in a derived table, which COUNT(*) counts the rows of. The columns
+// of a derived table must have different names: the ones that have
+// the name of a column before them, like the second id of
+// SELECT DISTINCT t.id, u.id, are given aliases like id_2.
+//
+// An error is returned if sel stores its rows with an INTO clause.
+// sel itself is not modified.
+func CountQuery(sel SelectStatement) (*Select, error) {
+	if sel == nil {
+		return nil, errors.New("cannot count the rows of a nil select")
+	}
+	if hasInto(sel, false) {
+		return nil, errors.New("cannot count the rows of a select with an into clause")
+	}
+	sel = cloneNode(sel).(SelectStatement)
+	for {
+		paren, ok := sel.(*ParenSelect)
+		if !ok {
+			break
+		}
+		sel = paren.Select
+	}
+	count := &AliasedExpr{Expr: &FuncExpr{
+		Name:  NewColIdent("count"),
+		Exprs: SelectExprs{&StarExpr{}},
+	}}
+
+	switch node := sel.(type) {
+	case *Select:
+		node.OrderBy = nil
+		node.Limit = nil
+		node.Lock = ""
+		node.RemoveOption(SelectCalcFoundRows)
+		if !selectsGroups(node) {
+			node.SelectExprs = SelectExprs{count}
+			return node, nil
+		}
+	case *Union:
+		node.OrderBy = nil
+		node.Limit = nil
+		node.Lock = ""
+	}
+	aliasDuplicateColumns(sel)
+	return &Select{
+		SelectExprs: SelectExprs{count},
+		From: TableExprs{&AliasedTableExpr{
+			Expr: &Subquery{Select: sel},
+			As:   NewTableIdent(countQueryAlias),
+		}},
+	}, nil
+}
+
+// selectsGroups returns true if the rows sel returns aren't the rows of
+// its FROM clause: sel groups them, filters the groups or removes the
+// duplicates. The aggregates of subqueries don't count.
+func selectsGroups(sel *Select) bool {
+	if len(sel.GroupBy) > 0 || sel.Having != nil || sel.IsDistinct() {
+		return true
+	}
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if _, ok := node.(*Subquery); ok {
+			return false, nil
+		}
+		if isAggregate(node) {
+			found = true
+		}
+		return !found, nil
+	}, sel.SelectExprs)
+	return found
+}
+
+// aliasDuplicateColumns gives aliases to the columns of sel that have
+// the name of a column before them. A union has the names of its first
+// select. The names of the columns that * selects are not known.
+func aliasDuplicateColumns(sel SelectStatement) {
+	for {
+		switch node := sel.(type) {
+		case *Union:
+			sel = node.Left
+			continue
+		case *ParenSelect:
+			sel = node.Select
+			continue
+		}
+		break
+	}
+	first, ok := sel.(*Select)
+	if !ok {
+		return
+	}
+	names := make([]string, len(first.SelectExprs))
+	taken := make(map[string]bool)
+	for i, expr := range first.SelectExprs {
+		if aliased, ok := expr.(*AliasedExpr); ok {
+			names[i] = strings.ToLower(aliasedColumnName(aliased))
+			taken[names[i]] = true
+		}
+	}
+	seen := make(map[string]bool)
+	for i, expr := range first.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			continue
+		}
+		if !seen[names[i]] {
+			seen[names[i]] = true
+			continue
+		}
+		name := aliasedColumnName(aliased)
+		for n := 2; ; n++ {
+			alias := fmt.Sprintf("%s_%d", name, n)
+			if !taken[strings.ToLower(alias)] {
+				taken[strings.ToLower(alias)] = true
+				aliased.As = NewColIdent(alias)
+				break
+			}
+		}
+	}
+}
+
+// aliasedColumnName returns the name of the column of expr: its alias,
+// the name of the column it selects, or else its text.
+func aliasedColumnName(expr *AliasedExpr) string {
+	switch {
+	case !expr.As.IsEmpty():
+		return expr.As.String()
+	case IsColName(expr.Expr):
+		return expr.Expr.(*ColName).Name.String()
+	}
+	return canonicalString(expr.Expr)
+}
@@ -0,0 +1,81 @@
+package sqlparser
+
+import "testing"
+
+func TestCountQuery(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select a, b from t where c = 1",
+		out: "select count(*) from t where c = 1",
+	}, {
+		in:  "select /* page */ sql_calc_found_rows a from t join u on t.id = u.id where c = 1 order by a desc limit 10, 20 for update",
+		out: "select /* page */ count(*) from t join u on t.id = u.id where c = 1",
+	}, {
+		in:  "select a, (select max(b) from u) from t",
+		out: "select count(*) from t",
+	}, {
+		in:  "select a, count(*) from t where c = 1 group by a order by a limit 10",
+		out: "select count(*) from (select a, count(*) from t where c = 1 group by a) as counted",
+	}, {
+		in:  "select a from t group by a having count(*) > 1",
+		out: "select count(*) from (select a from t group by a having count(*) > 1) as counted",
+	}, {
+		in:  "select distinct a, b from t limit 10",
+		out: "select count(*) from (select distinct a, b from t) as counted",
+	}, {
+		in:  "select max(a) from t",
+		out: "select count(*) from (select max(a) from t) as counted",
+	}, {
+		in:  "select a from t union select a from u order by a limit 10",
+		out: "select count(*) from (select a from t union select a from u) as counted",
+	}, {
+		in:  "(select a from t limit 5) union all (select a from u limit 5)",
+		out: "select count(*) from ((select a from t limit 5) union all (select a from u limit 5)) as counted",
+	}, {
+		in:  "((select a from t) union (select a from u)) order by a limit 10",
+		out: "select count(*) from ((select a from t) union (select a from u)) as counted",
+	}, {
+		// The columns of a derived table must have different names.
+		in:  "select distinct t.id, u.id, u.id_2, t.a as A, u.a from t join u on t.id = u.parent_id",
+		out: "select count(*) from (select distinct t.id, u.id as id_3, u.id_2, t.a as A, u.a as a_2 from t join u on t.id = u.parent_id) as counted",
+	}, {
+		in:  "select t.id, u.id from t join u on t.a = u.a group by t.id, u.id union select id, id from v",
+		out: "select count(*) from (select t.id, u.id as id_2 from t join u on t.a = u.a group by t.id, u.id union select id, id from v) as counted",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		before := String(stmt)
+		count, err := CountQuery(stmt.(SelectStatement))
+		if err != nil {
+			t.Errorf("CountQuery(%s): %v", tcase.in, err)
+			continue
+		}
+		if got := String(count); got != tcase.out {
+			t.Errorf("CountQuery(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+		if after := String(stmt); after != before {
+			t.Errorf("CountQuery(%s) modified its input: %s", tcase.in, after)
+		}
+	}
+}
+
+func TestCountQueryErrors(t *testing.T) {
+	stmt, err := Parse("select a from t into outfile 'x'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "cannot count the rows of a select with an into clause"
+	if _, err := CountQuery(stmt.(SelectStatement)); err == nil || err.Error() != want {
+		t.Errorf("CountQuery: %v, want %s", err, want)
+	}
+	want = "cannot count the rows of a nil select"
+	if _, err := CountQuery(nil); err == nil || err.Error() != want {
+		t.Errorf("CountQuery(nil): %v, want %s", err, want)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package sqltypes
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"reflect"
@@ -38,6 +39,45 @@ func ProtoToValue(v *querypb.Value) Value {
 	return MakeTrusted(v.Type, v.Value)
 }
 
+// TupleToProto returns a *querypb.Value of type TUPLE that holds values.
+// Such values are the values of a TUPLE bind variable whose elements
+// are tuples, like the rows of (a, b) IN ((1, 2), (3, 4)). Values
+// can't be tuples themselves.
+func TupleToProto(values []*querypb.Value) *querypb.Value {
+	var buf []byte
+	for _, v := range values {
+		buf = binary.AppendUvarint(buf, uint64(v.Type))
+		buf = binary.AppendUvarint(buf, uint64(len(v.Value)))
+		buf = append(buf, v.Value...)
+	}
+	return &querypb.Value{Type: querypb.Type_TUPLE, Value: buf}
+}
+
+// ProtoToTuple returns the values held by a *querypb.Value
+// of type TUPLE, see TupleToProto.
+func ProtoToTuple(v *querypb.Value) ([]*querypb.Value, error) {
+	if v.Type != querypb.Type_TUPLE {
+		return nil, fmt.Errorf("%v is not a tuple", v.Type)
+	}
+	var values []*querypb.Value
+	buf := v.Value
+	for len(buf) != 0 {
+		typ, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return nil, errors.New("invalid tuple encoding")
+		}
+		buf = buf[n:]
+		size, n := binary.Uvarint(buf)
+		if n <= 0 || size > uint64(len(buf)-n) {
+			return nil, errors.New("invalid tuple encoding")
+		}
+		buf = buf[n:]
+		values = append(values, &querypb.Value{Type: querypb.Type(typ), Value: buf[:size:size]})
+		buf = buf[size:]
+	}
+	return values, nil
+}
+
 // BuildBindVariables builds a map[string]*querypb.BindVariable from a map[string]interface{}.
 func BuildBindVariables(in map[string]interface{}) (map[string]*querypb.BindVariable, error) {
 	if len(in) == 0 {
@@ -127,6 +167,9 @@ func BuildBindVariable(v interface{}) (*querypb.BindVariable, error) {
 			}
 			values[i].Type = lbv.Type
 			values[i].Value = lbv.Value
+			if lbv.Type == querypb.Type_TUPLE {
+				values[i].Value = TupleToProto(lbv.Values).Value
+			}
 			bv.Values[i] = &values[i]
 		}
 		return bv, nil
@@ -229,7 +272,10 @@ func ValidateBindVariable(bv *querypb.BindVariable) error {
 		}
 		for _, val := range bv.Values {
 			if val.Type == querypb.Type_TUPLE {
-				return errors.New("tuple not allowed inside another tuple")
+				if err := validateTuple(val); err != nil {
+					return err
+				}
+				continue
 			}
 			if err := ValidateBindVariable(&querypb.BindVariable{Type: val.Type, Value: val.Value}); err != nil {
 				return err
@@ -243,6 +289,27 @@ func ValidateBindVariable(bv *querypb.BindVariable) error {
 	return err
 }
 
+// validateTuple validates a value of a TUPLE bind variable
+// that is itself a tuple.
+func validateTuple(val *querypb.Value) error {
+	values, err := ProtoToTuple(val)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return errors.New("empty tuple is not allowed")
+	}
+	for _, v := range values {
+		if v.Type == querypb.Type_TUPLE {
+			return errors.New("tuple not allowed inside another tuple")
+		}
+		if err := ValidateBindVariable(&querypb.BindVariable{Type: v.Type, Value: v.Value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BindVariableToValue converts a bind var into a Value.
 func BindVariableToValue(bv *querypb.BindVariable) (Value, error) {
 	if bv.Type == querypb.Type_TUPLE {
@@ -37,6 +37,37 @@ func TestProtoConversions(t *testing.T) {
 	}
 }
 
+func TestTupleConversions(t *testing.T) {
+	values := []*querypb.Value{{
+		Type:  Int64,
+		Value: []byte("1"),
+	}, {
+		Type:  VarChar,
+		Value: []byte(""),
+	}, {
+		Type:  VarBinary,
+		Value: []byte("a,b"),
+	}}
+	tuple := TupleToProto(values)
+	if tuple.Type != querypb.Type_TUPLE {
+		t.Errorf("TupleToProto type: %v, want TUPLE", tuple.Type)
+	}
+	got, err := ProtoToTuple(tuple)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("ProtoToTuple: %v, want %v", got, values)
+	}
+
+	if _, err := ProtoToTuple(&querypb.Value{Type: Int64, Value: []byte("1")}); err == nil {
+		t.Error("ProtoToTuple(INT64): nil, want error")
+	}
+	if _, err := ProtoToTuple(&querypb.Value{Type: querypb.Type_TUPLE, Value: []byte{1, 2, 'a'}}); err == nil {
+		t.Error("ProtoToTuple(truncated): nil, want error")
+	}
+}
+
 func TestBuildBindVariables(t *testing.T) {
 	tcases := []struct {
 		in  map[string]interface{}
@@ -219,6 +250,20 @@ func TestBuildBindVariable(t *testing.T) {
 				Value: []byte("2"),
 			}},
 		},
+	}, {
+		in: []interface{}{[]interface{}{1, "a"}},
+		out: &querypb.BindVariable{
+			Type: querypb.Type_TUPLE,
+			Values: []*querypb.Value{
+				TupleToProto([]*querypb.Value{{
+					Type:  querypb.Type_INT64,
+					Value: []byte("1"),
+				}, {
+					Type:  querypb.Type_VARCHAR,
+					Value: []byte("a"),
+				}}),
+			},
+		},
 	}, {
 		in:  byte(1),
 		err: "type uint8 not supported as bind var: 1",
@@ -478,6 +523,19 @@ func TestValidateBindVariable(t *testing.T) {
 			Type: querypb.Type_TUPLE,
 		},
 		err: "empty tuple is not allowed",
+	}, {
+		in: &querypb.BindVariable{
+			Type: querypb.Type_TUPLE,
+			Values: []*querypb.Value{
+				TupleToProto([]*querypb.Value{{
+					Type:  querypb.Type_INT64,
+					Value: []byte("1"),
+				}, {
+					Type:  querypb.Type_VARCHAR,
+					Value: []byte("a"),
+				}}),
+			},
+		},
 	}, {
 		in: &querypb.BindVariable{
 			Type: querypb.Type_TUPLE,
@@ -485,7 +543,37 @@ func TestValidateBindVariable(t *testing.T) {
 				Type: querypb.Type_TUPLE,
 			}},
 		},
+		err: "empty tuple is not allowed",
+	}, {
+		in: &querypb.BindVariable{
+			Type: querypb.Type_TUPLE,
+			Values: []*querypb.Value{
+				TupleToProto([]*querypb.Value{{
+					Type: querypb.Type_TUPLE,
+				}}),
+			},
+		},
 		err: "tuple not allowed inside another tuple",
+	}, {
+		in: &querypb.BindVariable{
+			Type: querypb.Type_TUPLE,
+			Values: []*querypb.Value{
+				TupleToProto([]*querypb.Value{{
+					Type:  querypb.Type_INT64,
+					Value: []byte("a"),
+				}}),
+			},
+		},
+		err: "invalid syntax",
+	}, {
+		in: &querypb.BindVariable{
+			Type: querypb.Type_TUPLE,
+			Values: []*querypb.Value{{
+				Type:  querypb.Type_TUPLE,
+				Value: []byte{1, 5, 'a'},
+			}},
+		},
+		err: "invalid tuple encoding",
 	}}
 	for _, tcase := range testcases {
 		err := ValidateBindVariable(tcase.in)
@@ -0,0 +1,150 @@
+package sqlparser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// DOTOptions are the options of WriteDOTWithOptions.
+type DOTOptions struct {
+	// MaxListLen, if not zero, collapses the VALUES lists of more
+	// than MaxListLen rows, and the IN tuples of more than MaxListLen
+	// values, into a single node that tells how many they hold.
+	MaxListLen int
+}
+
+// maxDOTValueLen is the length past which the values of the
+// nodes are truncated in their labels.
+const maxDOTValueLen = 32
+
+// WriteDOT writes the AST of stmt to w as a Graphviz digraph, to
+// visualize it when debugging. See WriteDOTWithOptions.
+func WriteDOT(w io.Writer, stmt Statement) error {
+	return WriteDOTWithOptions(w, stmt, DOTOptions{})
+}
+
+// WriteDOTWithOptions writes the AST of stmt to w as a Graphviz
+// digraph. Every node is labeled with the name of its type, see
+// NodeName, and the leaves with their value too, like the name of
+// an identifier or a literal, truncated if it's long. The edges are
+// labeled with the struct fields, or slice indexes, that hold the
+// children. The missing optional children are left out.
+func WriteDOTWithOptions(w io.Writer, stmt Statement, opts DOTOptions) error {
+	d := &dotWriter{w: bufio.NewWriter(w), opts: opts}
+	d.printf("digraph ast {\n")
+	d.printf("\tnode [shape=box];\n")
+	d.node(stmt, nil)
+	d.printf("}\n")
+	if d.err != nil {
+		return d.err
+	}
+	return d.w.Flush()
+}
+
+type dotWriter struct {
+	w    *bufio.Writer
+	opts DOTOptions
+	ids  int
+	err  error
+}
+
+func (d *dotWriter) printf(format string, args ...interface{}) {
+	if d.err == nil {
+		_, d.err = fmt.Fprintf(d.w, format, args...)
+	}
+}
+
+// node writes node and its subtree, and returns the ID of node.
+// parent is the parent of node, or nil for the root.
+func (d *dotWriter) node(node, parent SQLNode) int {
+	id := d.ids
+	d.ids++
+	label := NodeName(node)
+	if summary := d.summary(node, parent); summary != "" {
+		d.printf("\tn%d [label=\"%s\", style=dashed];\n", id, dotEscape(label+"\n"+summary))
+		return id
+	}
+	var children []SQLNode
+	for _, child := range Children(node) {
+		if !isMissingNode(child) {
+			children = append(children, child)
+		}
+	}
+	if len(children) == 0 {
+		label += "\n" + truncateDOTValue(String(node))
+	}
+	d.printf("\tn%d [label=\"%s\"];\n", id, dotEscape(label))
+	for _, child := range children {
+		childID := d.node(child, node)
+		field := fieldName(node, child)
+		if field == ".?" {
+			// Like the Exprs that a ValTuple walks, which
+			// is the ValTuple itself.
+			d.printf("\tn%d -> n%d;\n", id, childID)
+			continue
+		}
+		d.printf("\tn%d -> n%d [label=\"%s\"];\n", id, childID, dotEscape(strings.TrimPrefix(field, ".")))
+	}
+	return id
+}
+
+// summary returns what a node that collapses node says about it, or
+// an empty string if node is not collapsed.
+func (d *dotWriter) summary(node, parent SQLNode) string {
+	if d.opts.MaxListLen == 0 {
+		return ""
+	}
+	switch node := node.(type) {
+	case Values:
+		if len(node) > d.opts.MaxListLen {
+			return fmt.Sprintf("%d rows", len(node))
+		}
+	case ValTuple:
+		cmp, ok := parent.(*ComparisonExpr)
+		if ok && (cmp.Operator == InStr || cmp.Operator == NotInStr) && len(node) > d.opts.MaxListLen {
+			return fmt.Sprintf("%d values", len(node))
+		}
+	}
+	return ""
+}
+
+// isMissingNode returns true if node is a missing optional child:
+// a nil pointer, an empty slice, or an empty value like the ColIdent
+// of an expression without alias.
+func isMissingNode(node SQLNode) bool {
+	v := reflect.ValueOf(node)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice:
+		return v.Len() == 0
+	}
+	for _, child := range Children(node) {
+		if !isMissingNode(child) {
+			return false
+		}
+	}
+	return String(node) == ""
+}
+
+func truncateDOTValue(s string) string {
+	if len(s) <= maxDOTValueLen {
+		return s
+	}
+	n := maxDOTValueLen
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "..."
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// dotEscape escapes s for a quoted DOT string.
+func dotEscape(s string) string {
+	return dotEscaper.Replace(s)
+}
@@ -0,0 +1,73 @@
+package sqlparser
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateDOT = flag.Bool("update-dot", false, "rewrite the golden files of TestWriteDOT")
+
+func TestWriteDOT(t *testing.T) {
+	testcases := []struct {
+		name string
+		in   string
+		opts DOTOptions
+	}{{
+		name: "select",
+		in:   "select a.x, count(*) as c from a join b on a.id = b.a_id where a.y = 'a long string literal that gets truncated' group by a.x",
+	}, {
+		name: "collapsed",
+		in:   "insert into t (a, b) values (1, 'x'), (2, 'y'), (3, 'z') on duplicate key update b = values(b)",
+		opts: DOTOptions{MaxListLen: 2},
+	}, {
+		name: "in_tuple",
+		in:   "delete from t where a in (1, 2, 3, 4) and b not in (5, 6)",
+		opts: DOTOptions{MaxListLen: 3},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		var buf bytes.Buffer
+		if err := WriteDOTWithOptions(&buf, stmt, tcase.opts); err != nil {
+			t.Errorf("WriteDOTWithOptions(%q): %v", tcase.in, err)
+			continue
+		}
+		golden := filepath.Join("testdata", "dot", tcase.name+".dot")
+		if *updateDOT {
+			if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != string(want) {
+			t.Errorf("WriteDOTWithOptions(%q):\n%s\nwant:\n%s", tcase.in, got, want)
+		}
+	}
+}
+
+func TestWriteDOTEscapes(t *testing.T) {
+	stmt, err := Parse("select 'say \"hi\"\\n', `a\"b` from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteDOT(&buf, stmt); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`label="SQLVal\n'say \\\"hi\\\"\\n'"`, `label="ColIdent\n` + "`" + `a\"b` + "`" + `"`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("WriteDOT: %s, want it to contain %s", buf.String(), want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DynamicSQLKind is the kind of a DynamicSQLFinding.
+type DynamicSQLKind int
+
+// These are the possible DynamicSQLKind values.
+const (
+	// DynamicSQLLiteral is reported for a PREPARE or an EXECUTE
+	// IMMEDIATE of a string literal, or of a user variable set to one.
+	DynamicSQLLiteral = DynamicSQLKind(iota + 1)
+	// DynamicSQLVariable is reported for a PREPARE or an EXECUTE
+	// IMMEDIATE of a user variable whose value is not known.
+	DynamicSQLVariable
+	// DynamicSQLBuilt is reported for a PREPARE or an EXECUTE
+	// IMMEDIATE of a text that is built by a function, like
+	// CONCAT('select * from ', @t), or by a concatenation, directly or
+	// through a user variable. It's also reported for the SET of a
+	// user variable to such a text that starts like a statement.
+	DynamicSQLBuilt
+)
+
+// DynamicSQLFinding is a finding of FindDynamicSQL.
+type DynamicSQLFinding struct {
+	Kind DynamicSQLKind
+	// Node is the *Prepare or the *Execute, or the *SetExpr of a
+	// SET, and Source the expression of the text of the statement.
+	Node    SQLNode
+	Source  Expr
+	Message string
+	// Stmt is the statement of the text of a DynamicSQLLiteral, if
+	// it parses, so that it can be checked like the others, and Err
+	// the error of parsing it if not.
+	Stmt Statement
+	Err  error
+}
+
+// FindDynamicSQL returns the statements that stmt prepares or
+// executes from a text: the PREPARE and EXECUTE IMMEDIATE statements,
+// and the SET statements that build the text of a statement from
+// pieces. See FindDynamicSQLInStatements to follow the user variables
+// from their SET to the statement that prepares them.
+func FindDynamicSQL(stmt Statement) []DynamicSQLFinding {
+	return FindDynamicSQLInStatements([]Statement{stmt})
+}
+
+// FindDynamicSQLInStatements is like FindDynamicSQL for a sequence of
+// statements, like a script: the text of the user variable of a
+// PREPARE or an EXECUTE IMMEDIATE is the last value the statements
+// before it set it to, if any.
+func FindDynamicSQLInStatements(stmts []Statement) []DynamicSQLFinding {
+	var findings []DynamicSQLFinding
+	vars := make(map[string]Expr)
+	for _, stmt := range stmts {
+		switch stmt := stmt.(type) {
+		case *Set:
+			for _, expr := range stmt.Exprs {
+				if !isUserVariable(expr.Name) {
+					continue
+				}
+				vars[expr.Name.Lowered()] = expr.Expr
+				if finding, ok := builtStatementFinding(expr); ok {
+					findings = append(findings, finding)
+				}
+			}
+		case *Prepare:
+			findings = append(findings, dynamicSQLFinding(stmt, "prepare "+stmt.Name.String(), stmt.Stmt, vars))
+		case *Execute:
+			if stmt.Immediate != nil {
+				findings = append(findings, dynamicSQLFinding(stmt, "execute immediate", stmt.Immediate, vars))
+			}
+		}
+	}
+	return findings
+}
+
+// dynamicSQLFinding returns the finding of node, which prepares the
+// statement source. what names node in the message, and vars holds
+// the values of the user variables.
+func dynamicSQLFinding(node SQLNode, what string, source Expr, vars map[string]Expr) DynamicSQLFinding {
+	finding := DynamicSQLFinding{Node: node, Source: source}
+	text := source
+	from := ""
+	if col, ok := source.(*ColName); ok {
+		from = ", set to"
+		if text, ok = vars[col.Name.Lowered()]; !ok {
+			finding.Kind = DynamicSQLVariable
+			finding.Message = fmt.Sprintf("%s from %s, whose value is not known", what, String(source))
+			return finding
+		}
+		what += " from " + String(source)
+	} else {
+		from = " from"
+	}
+
+	if val, ok := text.(*SQLVal); ok && val.Type == StrVal {
+		finding.Kind = DynamicSQLLiteral
+		finding.Stmt, finding.Err = Parse(string(val.Val))
+		if finding.Err != nil {
+			finding.Message = fmt.Sprintf("%s%s a literal that doesn't parse", what, from)
+		} else {
+			finding.Message = fmt.Sprintf("%s%s a literal %s statement", what, from, strings.ToLower(StmtType(StatementType(finding.Stmt))))
+		}
+		return finding
+	}
+	finding.Kind = DynamicSQLBuilt
+	if source == text {
+		finding.Message = fmt.Sprintf("%s from %s", what, String(text))
+	} else {
+		finding.Message = fmt.Sprintf("%s, built with %s", what, String(text))
+	}
+	return finding
+}
+
+// builtStatementFinding returns the finding of expr, the SET of a user
+// variable, if it builds a text that starts like a statement.
+func builtStatementFinding(expr *SetExpr) (DynamicSQLFinding, bool) {
+	switch expr.Expr.(type) {
+	case *FuncExpr, *BinaryExpr:
+	default:
+		return DynamicSQLFinding{}, false
+	}
+	// The first literal is the beginning of the text.
+	typ := StmtUnknown
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if val, ok := node.(*SQLVal); ok && val.Type == StrVal {
+			typ = Preview(string(val.Val))
+			found = true
+		}
+		return !found, nil
+	}, expr.Expr)
+	switch typ {
+	case StmtUnknown, StmtComment:
+		return DynamicSQLFinding{}, false
+	}
+	return DynamicSQLFinding{
+		Kind:    DynamicSQLBuilt,
+		Node:    expr,
+		Source:  expr.Expr,
+		Message: fmt.Sprintf("%s is set to a %s statement built with %s", expr.Name.String(), strings.ToLower(StmtType(typ)), String(expr.Expr)),
+	}, true
+}
+
+// isUserVariable returns true if name is a user variable, like @a.
+func isUserVariable(name ColIdent) bool {
+	s := name.String()
+	return len(s) > 1 && s[0] == '@' && s[1] != '@'
+}
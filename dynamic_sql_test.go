@@ -0,0 +1,100 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindDynamicSQL(t *testing.T) {
+	testcases := []struct {
+		in    []string
+		out   []string
+		kinds []DynamicSQLKind
+		stmts []string
+	}{{
+		in:    []string{"prepare s from 'select * from t where a = ?'"},
+		out:   []string{"prepare s from a literal select statement"},
+		kinds: []DynamicSQLKind{DynamicSQLLiteral},
+		stmts: []string{"select * from t where a = :v1"},
+	}, {
+		in:    []string{"prepare s from 'this is not sql'"},
+		out:   []string{"prepare s from a literal that doesn't parse"},
+		kinds: []DynamicSQLKind{DynamicSQLLiteral},
+		stmts: []string{""},
+	}, {
+		in:    []string{"prepare s from @q"},
+		out:   []string{"prepare s from @q, whose value is not known"},
+		kinds: []DynamicSQLKind{DynamicSQLVariable},
+		stmts: []string{""},
+	}, {
+		in: []string{
+			"set @q = concat('SELECT * FROM ', @tbl)",
+			"prepare s from @q",
+			"execute s",
+		},
+		out: []string{
+			"@q is set to a select statement built with concat('SELECT * FROM ', @tbl)",
+			"prepare s from @q, built with concat('SELECT * FROM ', @tbl)",
+		},
+		kinds: []DynamicSQLKind{DynamicSQLBuilt, DynamicSQLBuilt},
+		stmts: []string{"", ""},
+	}, {
+		in: []string{
+			"set @q = 'delete from t where id = ?', @n = concat('a', 'b')",
+			"prepare s from @Q",
+		},
+		out:   []string{"prepare s from @Q, set to a literal delete statement"},
+		kinds: []DynamicSQLKind{DynamicSQLLiteral},
+		stmts: []string{"delete from t where id = :v1"},
+	}, {
+		in:    []string{"prepare s from concat('select ', @cols, ' from t')"},
+		out:   []string{"prepare s from concat('select ', @cols, ' from t')"},
+		kinds: []DynamicSQLKind{DynamicSQLBuilt},
+		stmts: []string{""},
+	}, {
+		in:    []string{"execute immediate 'update t set a = 1'"},
+		out:   []string{"execute immediate from a literal update statement"},
+		kinds: []DynamicSQLKind{DynamicSQLLiteral},
+		stmts: []string{"update t set a = 1"},
+	}, {
+		in:    []string{"execute immediate concat_ws(' ', 'drop table', @t)"},
+		out:   []string{"execute immediate from concat_ws(' ', 'drop table', @t)"},
+		kinds: []DynamicSQLKind{DynamicSQLBuilt},
+		stmts: []string{""},
+	}, {
+		in: []string{"set @@sql_mode = concat('select', 'x'), @a = 1", "execute s using @a"},
+	}}
+	for _, tcase := range testcases {
+		var stmts []Statement
+		for _, in := range tcase.in {
+			stmt, err := Parse(in)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", in, err)
+			}
+			stmts = append(stmts, stmt)
+		}
+		var out, nested []string
+		var kinds []DynamicSQLKind
+		for _, finding := range FindDynamicSQLInStatements(stmts) {
+			out = append(out, finding.Message)
+			kinds = append(kinds, finding.Kind)
+			if finding.Stmt != nil {
+				nested = append(nested, String(finding.Stmt))
+			} else {
+				nested = append(nested, "")
+			}
+		}
+		if !reflect.DeepEqual(out, tcase.out) || !reflect.DeepEqual(kinds, tcase.kinds) || !reflect.DeepEqual(nested, tcase.stmts) {
+			t.Errorf("FindDynamicSQLInStatements(%q): %q %v %q, want %q %v %q", tcase.in, out, kinds, nested, tcase.out, tcase.kinds, tcase.stmts)
+		}
+	}
+
+	stmt, err := Parse("prepare s from 'select 1 from'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	findings := FindDynamicSQL(stmt)
+	if len(findings) != 1 || findings[0].Err == nil || findings[0].Node != stmt {
+		t.Errorf("FindDynamicSQL: %+v, want a finding of the statement with a parse error", findings)
+	}
+}
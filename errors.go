@@ -0,0 +1,108 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by the parser and the helpers of the package can
+// be told apart with errors.Is and these sentinel values, rather than
+// by their text, which isn't stable.
+var (
+	// ErrSyntax is the error of SQL that can't be parsed. The error
+	// is a *ParseError, which holds the details.
+	ErrSyntax = errors.New("syntax error")
+	// ErrEmpty is the error of SQL that has no statement, only blanks,
+	// comments or semicolons. The error is a *ParseError.
+	ErrEmpty = errors.New("query was empty")
+	// ErrMultipleStatements is the error of SQL that has more than one
+	// statement given to a function that parses a single one. The
+	// error is a *ParseError.
+	ErrMultipleStatements = errors.New("multiple statements")
+	// ErrTooComplex is the error of a statement that is nested too
+	// deeply to be parsed, that exceeds the MaxSize of ParseOptions,
+	// or that exceeds the Limits of CheckLimits.
+	ErrTooComplex = errors.New("statement is too complex")
+	// ErrEmptyInList is the error of an IN or a NOT IN with an empty
+	// list, unless the EmptyInLists option of ParseOptions is set.
+	// The error is a *ParseError.
+	ErrEmptyInList = errors.New("empty in list")
+	// ErrUnsupported is the error of a construct that a helper, like
+	// NewPlanValue or ExtractSetValues, can't handle.
+	ErrUnsupported = errors.New("unsupported")
+	// ErrMissingBindVar is the error of a bind variable that has no
+	// value. The error is a *BindVarError, which holds the name.
+	ErrMissingBindVar = errors.New("missing bind var")
+	// ErrInvalidBindVar is the error of a bind variable whose value
+	// has the wrong type, like a list for a scalar bind variable.
+	// The error is a *BindVarError.
+	ErrInvalidBindVar = errors.New("invalid bind var")
+)
+
+// ParseError is the error returned by the parse functions. It wraps
+// ErrSyntax, ErrEmpty, ErrMultipleStatements, ErrTooComplex or
+// ErrEmptyInList.
+type ParseError struct {
+	// Message describes the error, like "syntax error".
+	Message string
+	// Position is the position of the tokenizer when the error
+	// was detected, see Tokenizer.Position.
+	Position int
+	// Near is the token at which the error was detected,
+	// or empty at the end of the input.
+	Near string
+	err  error
+}
+
+// Error returns the message with its position.
+func (e *ParseError) Error() string {
+	if e.Near != "" {
+		return fmt.Sprintf("%s at position %v near '%s'", e.Message, e.Position, e.Near)
+	}
+	return fmt.Sprintf("%s at position %v", e.Message, e.Position)
+}
+
+// Unwrap returns the sentinel value of the error.
+func (e *ParseError) Unwrap() error {
+	return e.err
+}
+
+// BindVarError is the error of a bind variable that can't be
+// resolved. It wraps ErrMissingBindVar or ErrInvalidBindVar.
+type BindVarError struct {
+	// Name is the name of the bind variable, without colons.
+	Name string
+	msg  string
+	err  error
+}
+
+// Error returns the message of the error.
+func (e *BindVarError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the sentinel value of the error.
+func (e *BindVarError) Unwrap() error {
+	return e.err
+}
+
+// sentinelError is an error that wraps a sentinel value,
+// but keeps its own message.
+type sentinelError struct {
+	msg string
+	err error
+}
+
+// newError returns an error with the formatted message
+// that matches sentinel with errors.Is.
+func newError(sentinel error, format string, args ...interface{}) error {
+	return &sentinelError{msg: fmt.Sprintf(format, args...), err: sentinel}
+}
+
+func (e *sentinelError) Error() string {
+	return e.msg
+}
+
+func (e *sentinelError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,100 @@
+package sqlparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func TestParseErrorTypes(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want error
+		near string
+	}{{
+		in:   "select * from",
+		want: ErrSyntax,
+	}, {
+		in:   "select 1 from t where a b",
+		want: ErrSyntax,
+		near: "b",
+	}, {
+		in:   "",
+		want: ErrEmpty,
+	}, {
+		in:   " /* only a comment */ ;",
+		want: ErrEmpty,
+	}, {
+		in:   "select 1; select 2",
+		want: ErrMultipleStatements,
+		near: "select",
+	}, {
+		in:   "select 1;;",
+		want: ErrSyntax,
+	}, {
+		in:   "select " + strings.Repeat("(", maxNesting) + "1" + strings.Repeat(")", maxNesting),
+		want: ErrTooComplex,
+	}, {
+		in:   "select 1 from t where a not in ()",
+		want: ErrEmptyInList,
+	}}
+	for _, tcase := range testcases {
+		_, err := Parse(tcase.in)
+		if !errors.Is(err, tcase.want) {
+			t.Errorf("Parse(%q) err: %v, want %v", tcase.in, err, tcase.want)
+			continue
+		}
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Parse(%q) err: %T, want *ParseError", tcase.in, err)
+			continue
+		}
+		if parseErr.Near != tcase.near {
+			t.Errorf("Parse(%q) near: %q, want %q", tcase.in, parseErr.Near, tcase.near)
+		}
+	}
+}
+
+func TestHelperErrorTypes(t *testing.T) {
+	stmt, err := Parse("select * from t where a in (1, 2, 3)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = CheckLimits(stmt, Limits{MaxInList: 2})
+	if !errors.Is(err, ErrTooComplex) {
+		t.Errorf("CheckLimits err: %v, want %v", err, ErrTooComplex)
+	}
+
+	_, _, err = ExtractSetValues("select 1")
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("ExtractSetValues err: %v, want %v", err, ErrUnsupported)
+	}
+
+	_, err = NewPlanValue(&FuncExpr{Name: NewColIdent("now")})
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("NewPlanValue err: %v, want %v", err, ErrUnsupported)
+	}
+
+	bindVars := map[string]*querypb.BindVariable{
+		"list": sqltypes.Int64BindVariable(1),
+	}
+	stmt, err = Parse("select * from t where a = :v and b in ::list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pq := NewParsedQuery(stmt)
+	for _, want := range []struct {
+		err  error
+		name string
+	}{{ErrMissingBindVar, "v"}, {ErrInvalidBindVar, "list"}} {
+		_, err = pq.GenerateQuery(bindVars, nil)
+		var bvErr *BindVarError
+		if !errors.Is(err, want.err) || !errors.As(err, &bvErr) || bvErr.Name != want.name {
+			t.Errorf("GenerateQuery err: %v, want %v for %s", err, want.err, want.name)
+		}
+		bindVars["v"] = sqltypes.Int64BindVariable(1)
+	}
+}
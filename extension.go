@@ -0,0 +1,191 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FunctionExtension parses the arguments of the calls to a function
+// registered with RegisterFunctionExtension. args is their text,
+// between the parentheses. ParseExpr can parse the expressions in it.
+// An error makes the parsing of the statement fail with its message.
+type FunctionExtension func(args string) ([]ExtensionArg, error)
+
+// ClauseExtension recognizes a clause at the end of sql that the
+// grammar doesn't know, like SAMPLE BY 10 after a select. It returns
+// the text of sql before the clause, and the clause, or false if sql
+// doesn't end with one.
+type ClauseExtension func(sql string) (head string, clause *ExtensionClause, ok bool)
+
+// ExtensionClause is a clause that a ClauseExtension recognized. It's
+// formatted after the statement it's attached to, see
+// StatementExtensions.
+type ExtensionClause struct {
+	// Name identifies the kind of clause, like "sample by".
+	Name string
+	// Text is the text of the clause, as it's formatted.
+	Text string
+}
+
+var (
+	extensionsMu       sync.RWMutex
+	functionExtensions = make(map[string]FunctionExtension)
+	clauseExtensions   []ClauseExtension
+)
+
+// RegisterFunctionExtension makes the parser hand the arguments of the
+// calls to the function name, ignoring case, to parse, instead of
+// parsing them as expressions. The calls are parsed into ExtensionExprs,
+// wherever a function call can be. Like for the functions that are
+// keywords, the name must be followed by the parenthesis, without
+// spaces, and must not be quoted or qualified. Keywords can't be
+// registered, and registering a name again replaces its extension.
+func RegisterFunctionExtension(name string, parse FunctionExtension) error {
+	lowered := strings.ToLower(name)
+	if _, ok := keywords[lowered]; ok {
+		return fmt.Errorf("cannot register keyword %s as a function", name)
+	}
+	if _, ok := functionKeywords[lowered]; ok {
+		return fmt.Errorf("cannot register keyword %s as a function", name)
+	}
+	extensionsMu.Lock()
+	defer extensionsMu.Unlock()
+	functionExtensions[lowered] = parse
+	return nil
+}
+
+// RegisterClauseExtension adds recognize to the clause extensions.
+// When Parse or ParseWithOptions fail to parse a statement, the clause
+// extensions are tried in the order they were registered: the text
+// before the clause the first one recognizes is parsed instead, and the
+// clause is attached to the statement. Since the text before the clause
+// can have a clause too, a statement can have several of them. The
+// statements of ParseNext don't have clauses.
+func RegisterClauseExtension(recognize ClauseExtension) {
+	extensionsMu.Lock()
+	defer extensionsMu.Unlock()
+	clauseExtensions = append(clauseExtensions, recognize)
+}
+
+// StatementExtensions returns the clauses that the clause extensions
+// recognized at the end of stmt, in the order they're written.
+func StatementExtensions(stmt Statement) []*ExtensionClause {
+	if holder, ok := stmt.(extensionHolder); ok {
+		return holder.getExtensions()
+	}
+	return nil
+}
+
+type extensionHolder interface {
+	addExtension(*ExtensionClause)
+	getExtensions() []*ExtensionClause
+}
+
+// ParseExpr parses sql as a single expression, for instance to parse
+// the arguments of a FunctionExtension.
+func ParseExpr(sql string) (Expr, error) {
+	const prefix = "select "
+	stmt, err := Parse(prefix + sql)
+	if err != nil {
+		if parseErr, ok := err.(*ParseError); ok {
+			parseErr.Position -= len(prefix)
+		}
+		return nil, err
+	}
+	sel, ok := stmt.(*Select)
+	if !ok || len(sel.SelectExprs) != 1 || len(sel.From) != 1 || !isDual(sel.From[0]) || sel.Where != nil || sel.GroupBy != nil || sel.OrderBy != nil || sel.Limit != nil || sel.Into != nil {
+		return nil, fmt.Errorf("%s is not an expression", sql)
+	}
+	aliased, ok := sel.SelectExprs[0].(*AliasedExpr)
+	if !ok || !aliased.As.IsEmpty() {
+		return nil, fmt.Errorf("%s is not an expression", sql)
+	}
+	return aliased.Expr, nil
+}
+
+// functionExtension returns the extension of the function
+// called lowered, or nil if there's none.
+func functionExtension(lowered string) FunctionExtension {
+	extensionsMu.RLock()
+	defer extensionsMu.RUnlock()
+	return functionExtensions[lowered]
+}
+
+// parseExtensionClause parses sql with opts after removing the clause
+// that a clause extension recognizes at its end, and attaches the
+// clause to the statement.
+func parseExtensionClause(sql string, opts ParseOptions) (Statement, bool) {
+	extensionsMu.RLock()
+	extensions := clauseExtensions
+	extensionsMu.RUnlock()
+	for _, recognize := range extensions {
+		head, clause, ok := recognize(sql)
+		if !ok || clause == nil || len(head) >= len(sql) {
+			continue
+		}
+		stmt, err := ParseWithOptions(head, opts)
+		if err != nil {
+			continue
+		}
+		holder, ok := stmt.(extensionHolder)
+		if !ok {
+			continue
+		}
+		holder.addExtension(clause)
+		return stmt, true
+	}
+	return nil, false
+}
+
+// scanExtensionCall scans the arguments of a call to the function
+// extension parse, from the opening parenthesis to the closing one,
+// and parses them into the ExtensionExpr of the call.
+func (tkn *Tokenizer) scanExtensionCall(name []byte, parse FunctionExtension) (int, []byte) {
+	var args []byte
+	depth := 0
+	tkn.next()
+	for {
+		ch := tkn.lastChar
+		switch ch {
+		case eofChar:
+			tkn.extensionErr = errors.New("unterminated call to " + string(name))
+			return LEX_ERROR, name
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				tkn.next()
+				exprArgs, err := parse(string(args))
+				if err != nil {
+					tkn.extensionErr = err
+					return LEX_ERROR, name
+				}
+				tkn.extension = &ExtensionExpr{Name: NewColIdent(string(name)), Args: exprArgs}
+				return EXTENSION_FUNC, name
+			}
+			depth--
+		case '\'', '"', '`':
+			// The quoted text is copied as is, parentheses included.
+			args = append(args, byte(ch))
+			tkn.next()
+			for tkn.lastChar != eofChar && tkn.lastChar != ch {
+				if tkn.lastChar == '\\' && tkn.SQLMode.backslashEscapes(ch) {
+					args = append(args, byte(tkn.lastChar))
+					tkn.next()
+					if tkn.lastChar == eofChar {
+						break
+					}
+				}
+				args = append(args, byte(tkn.lastChar))
+				tkn.next()
+			}
+			if tkn.lastChar == eofChar {
+				continue
+			}
+		}
+		args = append(args, byte(ch))
+		tkn.next()
+	}
+}
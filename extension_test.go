@@ -0,0 +1,141 @@
+package sqlparser
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+)
+
+// parseShardHint parses the arguments of shard_hint(n of m).
+func parseShardHint(args string) ([]ExtensionArg, error) {
+	parts := strings.SplitN(strings.ToLower(args), " of ", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("expecting shard_hint(n of m)")
+	}
+	shard, err := ParseExpr(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	count, err := ParseExpr(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	return []ExtensionArg{{Expr: shard}, {Text: " of "}, {Expr: count}}, nil
+}
+
+// recognizeSampleBy recognizes a trailing SAMPLE BY clause.
+func recognizeSampleBy(sql string) (string, *ExtensionClause, bool) {
+	i := strings.LastIndex(strings.ToLower(sql), " sample by ")
+	if i < 0 {
+		return "", nil, false
+	}
+	return sql[:i], &ExtensionClause{Name: "sample by", Text: strings.TrimSpace(sql[i:])}, true
+}
+
+func TestFunctionExtension(t *testing.T) {
+	if err := RegisterFunctionExtension("SHARD_HINT", parseShardHint); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterFunctionExtension("select", parseShardHint); err == nil {
+		t.Errorf("RegisterFunctionExtension(select): nil error")
+	}
+
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select * from t where Shard_Hint(3 OF 8) and a = 1",
+		out: "select * from t where Shard_Hint(3 of 8) and a = 1",
+	}, {
+		in:  "select shard_hint((1 + 2) of ')') from t",
+		out: "select shard_hint((1 + 2) of ')') from t",
+	}, {
+		// With a space, it's not a call to the extension.
+		in:  "select shard_hint (1) from t",
+		out: "select shard_hint(1) from t",
+	}, {
+		in:  "select /*!50000 shard_hint(1 of 2) */ from t",
+		out: "select shard_hint(1 of 2) from t",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%s): %v", tcase.in, err)
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("Parse(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+
+	// The expressions of the arguments are walked.
+	tree, err := Parse("select * from t where shard_hint(3 of 8)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindVars := make(map[string]*querypb.BindVariable)
+	Normalize(tree, bindVars, "bv")
+	if got, want := String(tree), "select * from t where shard_hint(:bv1 of :bv2)"; got != want {
+		t.Errorf("Normalize: %s, want %s", got, want)
+	}
+	want := &ExtensionExpr{
+		Name: NewColIdent("shard_hint"),
+		Args: []ExtensionArg{{Expr: NewValArg([]byte(":bv1"))}, {Text: " of "}, {Expr: NewValArg([]byte(":bv2"))}},
+	}
+	if got := tree.(*Select).Where.Expr; !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse: %#v, want %#v", got, want)
+	}
+
+	for _, in := range []string{
+		"select shard_hint(3) from t",
+		"select shard_hint(3 of 8 from t",
+	} {
+		_, err := Parse(in)
+		if err == nil {
+			t.Errorf("Parse(%s): nil error", in)
+			continue
+		}
+		if !errors.Is(err, ErrSyntax) {
+			t.Errorf("Parse(%s): %v, want a syntax error", in, err)
+		}
+	}
+	if _, err := Parse("select shard_hint(3) from t"); err == nil || !strings.HasPrefix(err.Error(), "expecting shard_hint(n of m) at position") {
+		t.Errorf("Parse: %v, want the error of the extension", err)
+	}
+}
+
+func TestClauseExtension(t *testing.T) {
+	RegisterClauseExtension(recognizeSampleBy)
+
+	tree, err := Parse("select a from t where b = 1 SAMPLE BY 10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ExtensionClause{{Name: "sample by", Text: "SAMPLE BY 10"}}
+	if got := StatementExtensions(tree); !reflect.DeepEqual(got, want) {
+		t.Errorf("StatementExtensions: %v, want %v", got, want)
+	}
+	if got, want := String(tree), "select a from t where b = 1 SAMPLE BY 10"; got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+	// Clauses are attached to the statement, not to its parts.
+	if got, want := String(tree.(*Select).Where.Expr), "b = 1"; got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+
+	// What's before the clause must parse.
+	if _, err := Parse("select a from sample by 10"); err == nil {
+		t.Errorf("Parse: nil error")
+	}
+
+	tree, err = Parse("select a from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := StatementExtensions(tree); got != nil {
+		t.Errorf("StatementExtensions: %v, want nil", got)
+	}
+}
@@ -0,0 +1,358 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FilterTree is a boolean expression, like a WHERE clause, in a form
+// that's convenient for a filter UI: a tree of AND and OR groups whose
+// leaves are conditions on a column.
+//
+// A group has Op set and holds its Children. A leaf has an empty Op
+// and holds its Condition.
+type FilterTree struct {
+	Op        string
+	Children  []*FilterTree
+	Condition *FilterCondition
+}
+
+// FilterTree.Op
+const (
+	FilterAnd = "and"
+	FilterOr  = "or"
+)
+
+// FilterCondition is a condition on a column, like a = 1. Operator is
+// the operator of a ComparisonExpr, a RangeCond or an IsExpr, and
+// Values hold its operands: one for a comparison, the list for IN,
+// two for BETWEEN and none for IS.
+//
+// An expression that doesn't fit, like a function call or a subquery,
+// is kept as its SQL text in Opaque, and the other fields are unset.
+type FilterCondition struct {
+	// Column is qualified by Table, which is qualified by
+	// Database, if they are set.
+	Database string
+	Table    string
+	Column   string
+	// Collation is the collation of a COLLATE clause on the
+	// column, like in a collate utf8mb4_bin = 'x'.
+	Collation string
+	Operator  string
+	Values    []FilterValue
+	Opaque    string
+}
+
+// FilterValue is an operand of a FilterCondition: a literal of the
+// given Type, or a bind variable if BindVar is set. List is set for
+// a list bind variable, which stands for all the values of an IN.
+// Collation is the collation of a COLLATE clause on the value.
+type FilterValue struct {
+	Type      ValType
+	Value     string
+	BindVar   string
+	List      bool
+	Collation string
+}
+
+// ExprToFilterTree converts expr to a FilterTree.
+//
+// A row equality like (a, b) = (1, 2) is split into a condition per
+// column, which are ANDed.
+//
+// The conversion is lossy in two ways: parentheses that are not needed
+// are dropped, and row equalities are split. FilterTreeToExpr only adds
+// the parentheses that are needed, so it returns an expression that's
+// Equal to expr unless it had redundant parentheses or row equalities.
+// Converting the result of FilterTreeToExpr back always returns an
+// equal tree.
+func ExprToFilterTree(expr Expr) (*FilterTree, error) {
+	if expr == nil {
+		return nil, errors.New("cannot convert a nil expression to a filter tree")
+	}
+	return exprToFilterTree(expr), nil
+}
+
+func exprToFilterTree(expr Expr) *FilterTree {
+	if paren, ok := expr.(*ParenExpr); ok {
+		return exprToFilterTree(paren.Expr)
+	}
+	if op, left, right := filterGroup(expr); op != "" {
+		tree := &FilterTree{Op: op}
+		tree.addChildren(left, right)
+		return tree
+	}
+	if conds := rowEqualityToFilterConditions(expr); conds != nil {
+		tree := &FilterTree{Op: FilterAnd}
+		for _, cond := range conds {
+			tree.Children = append(tree.Children, &FilterTree{Condition: cond})
+		}
+		return tree
+	}
+	if cond := exprToFilterCondition(expr); cond != nil {
+		return &FilterTree{Condition: cond}
+	}
+	return &FilterTree{Condition: &FilterCondition{Opaque: String(expr)}}
+}
+
+// addChildren adds the operands of a group. A chain like a and b and c
+// is a single group, and so is a and (b and c): it's the parentheses
+// that are kept in this case. The conditions of a row equality that's
+// an operand of an AND are added to the group too.
+func (tree *FilterTree) addChildren(left, right Expr) {
+	if op, l, r := filterGroup(left); op == tree.Op {
+		tree.addChildren(l, r)
+	} else {
+		tree.addChild(left)
+	}
+	tree.addChild(right)
+}
+
+func (tree *FilterTree) addChild(expr Expr) {
+	child := exprToFilterTree(expr)
+	if _, ok := expr.(*ComparisonExpr); ok && tree.Op == FilterAnd && child.Op == FilterAnd {
+		tree.Children = append(tree.Children, child.Children...)
+		return
+	}
+	tree.Children = append(tree.Children, child)
+}
+
+// rowEqualityToFilterConditions returns the conditions of a row
+// equality like (a, b) = (1, 2), a = 1 and b = 2, or nil if expr
+// is not one or if an operand is not a column or a value.
+func rowEqualityToFilterConditions(expr Expr) []*FilterCondition {
+	cmp, ok := expr.(*ComparisonExpr)
+	if !ok || cmp.Operator != EqualStr {
+		return nil
+	}
+	left, ok := cmp.Left.(ValTuple)
+	if !ok {
+		return nil
+	}
+	right, ok := cmp.Right.(ValTuple)
+	if !ok || len(right) != len(left) {
+		return nil
+	}
+	conds := make([]*FilterCondition, 0, len(left))
+	for i, col := range left {
+		cond := exprToFilterCondition(&ComparisonExpr{Operator: EqualStr, Left: col, Right: right[i]})
+		if cond == nil {
+			return nil
+		}
+		conds = append(conds, cond)
+	}
+	return conds
+}
+
+// filterGroup returns the operator and the operands of expr
+// if it's an AND or an OR.
+func filterGroup(expr Expr) (string, Expr, Expr) {
+	switch expr := expr.(type) {
+	case *AndExpr:
+		return FilterAnd, expr.Left, expr.Right
+	case *OrExpr:
+		return FilterOr, expr.Left, expr.Right
+	}
+	return "", nil, nil
+}
+
+// exprToFilterCondition returns nil if expr is not a condition
+// on a column with literal or bind variable operands.
+func exprToFilterCondition(expr Expr) *FilterCondition {
+	var col *ColName
+	var collation string
+	var operator string
+	var operands []Expr
+	switch expr := expr.(type) {
+	case *ComparisonExpr:
+		if expr.Escape != nil {
+			return nil
+		}
+		col, collation = collatedColumn(expr.Left)
+		operator = expr.Operator
+		switch right := expr.Right.(type) {
+		case ValTuple:
+			if operator != InStr && operator != NotInStr {
+				return nil
+			}
+			operands = right
+		case ListArg:
+			if col == nil || operator != InStr && operator != NotInStr {
+				return nil
+			}
+			cond := newFilterCondition(col, collation, operator)
+			cond.Values = []FilterValue{{BindVar: string(right[2:]), List: true}}
+			return cond
+		default:
+			operands = []Expr{right}
+		}
+	case *RangeCond:
+		col, collation = collatedColumn(expr.Left)
+		operator = expr.Operator
+		operands = []Expr{expr.From, expr.To}
+	case *IsExpr:
+		col, _ = expr.Expr.(*ColName)
+		operator = expr.Operator
+	}
+	if col == nil {
+		return nil
+	}
+	cond := newFilterCondition(col, collation, operator)
+	for _, operand := range operands {
+		var valCollation string
+		if collate, ok := operand.(*CollateExpr); ok {
+			operand, valCollation = collate.Expr, collate.Charset
+		}
+		val, ok := operand.(*SQLVal)
+		if !ok {
+			return nil
+		}
+		if val.Type == ValArg {
+			cond.Values = append(cond.Values, FilterValue{BindVar: string(val.Val[1:]), Collation: valCollation})
+			continue
+		}
+		cond.Values = append(cond.Values, FilterValue{Type: val.Type, Value: string(val.Val), Collation: valCollation})
+	}
+	return cond
+}
+
+// collatedColumn returns the column of expr, which is either
+// a column or a column with a COLLATE clause, and its collation.
+func collatedColumn(expr Expr) (*ColName, string) {
+	if collate, ok := expr.(*CollateExpr); ok {
+		col, _ := collate.Expr.(*ColName)
+		return col, collate.Charset
+	}
+	col, _ := expr.(*ColName)
+	return col, ""
+}
+
+func newFilterCondition(col *ColName, collation, operator string) *FilterCondition {
+	return &FilterCondition{
+		Database:  col.Qualifier.Qualifier.String(),
+		Table:     col.Qualifier.Name.String(),
+		Column:    col.Name.String(),
+		Collation: collation,
+		Operator:  operator,
+	}
+}
+
+// FilterTreeToExpr converts tree back to an expression,
+// see ExprToFilterTree.
+func FilterTreeToExpr(tree *FilterTree) (Expr, error) {
+	if tree == nil {
+		return nil, errors.New("cannot convert a nil filter tree")
+	}
+	switch tree.Op {
+	case "":
+		if tree.Condition == nil {
+			return nil, errors.New("filter tree has neither an operator nor a condition")
+		}
+		return filterConditionToExpr(tree.Condition)
+	case FilterAnd, FilterOr:
+	default:
+		return nil, fmt.Errorf("unknown filter tree operator %q", tree.Op)
+	}
+	if len(tree.Children) == 0 {
+		return nil, fmt.Errorf("filter tree %s group is empty", tree.Op)
+	}
+	var expr Expr
+	for _, child := range tree.Children {
+		childExpr, err := FilterTreeToExpr(child)
+		if err != nil {
+			return nil, err
+		}
+		// AND binds tighter than OR, so an AND group
+		// doesn't need parentheses in an OR group.
+		if child.Op == FilterOr || child.Op == FilterAnd && tree.Op == FilterAnd {
+			childExpr = &ParenExpr{Expr: childExpr}
+		}
+		switch {
+		case expr == nil:
+			expr = childExpr
+		case tree.Op == FilterAnd:
+			expr = &AndExpr{Left: expr, Right: childExpr}
+		default:
+			expr = &OrExpr{Left: expr, Right: childExpr}
+		}
+	}
+	return expr, nil
+}
+
+func filterConditionToExpr(cond *FilterCondition) (Expr, error) {
+	if cond.Opaque != "" {
+		return parseFilterExpr(cond.Opaque)
+	}
+	if cond.Column == "" {
+		return nil, errors.New("filter condition has neither a column nor an opaque expression")
+	}
+	var col Expr = &ColName{
+		Name: NewColIdent(cond.Column),
+		Qualifier: TableName{
+			Name:      NewTableIdent(cond.Table),
+			Qualifier: NewTableIdent(cond.Database),
+		},
+	}
+	if cond.Collation != "" {
+		col = &CollateExpr{Expr: col, Charset: cond.Collation}
+	}
+	values := make(Exprs, 0, len(cond.Values))
+	for _, v := range cond.Values {
+		if v.List {
+			if len(cond.Values) != 1 || cond.Operator != InStr && cond.Operator != NotInStr {
+				return nil, fmt.Errorf("list bind variable ::%s can only be the value of in", v.BindVar)
+			}
+			return &ComparisonExpr{Operator: cond.Operator, Left: col, Right: ListArg("::" + v.BindVar)}, nil
+		}
+		var val Expr = &SQLVal{Type: v.Type, Val: []byte(v.Value)}
+		if v.BindVar != "" {
+			val = NewValArg([]byte(":" + v.BindVar))
+		}
+		if v.Collation != "" {
+			val = &CollateExpr{Expr: val, Charset: v.Collation}
+		}
+		values = append(values, val)
+	}
+
+	switch cond.Operator {
+	case BetweenStr, NotBetweenStr:
+		if len(values) != 2 {
+			return nil, fmt.Errorf("%s needs 2 values, got %d", cond.Operator, len(values))
+		}
+		return &RangeCond{Operator: cond.Operator, Left: col, From: values[0], To: values[1]}, nil
+	case IsNullStr, IsNotNullStr, IsTrueStr, IsNotTrueStr, IsFalseStr, IsNotFalseStr, IsUnknownStr, IsNotUnknownStr:
+		if len(values) != 0 {
+			return nil, fmt.Errorf("%s takes no values, got %d", cond.Operator, len(values))
+		}
+		return &IsExpr{Operator: cond.Operator, Expr: col}, nil
+	case InStr, NotInStr:
+		if len(values) == 0 {
+			return nil, fmt.Errorf("%s needs at least one value", cond.Operator)
+		}
+		return &ComparisonExpr{Operator: cond.Operator, Left: col, Right: ValTuple(values)}, nil
+	case EqualStr, LessThanStr, GreaterThanStr, LessEqualStr, GreaterEqualStr, NotEqualStr,
+		NullSafeEqualStr, LikeStr, NotLikeStr, RegexpStr, NotRegexpStr:
+		if len(values) != 1 {
+			return nil, fmt.Errorf("%s needs 1 value, got %d", cond.Operator, len(values))
+		}
+		return &ComparisonExpr{Operator: cond.Operator, Left: col, Right: values[0]}, nil
+	}
+	return nil, fmt.Errorf("unknown filter condition operator %q", cond.Operator)
+}
+
+// parseFilterExpr parses the text of an opaque condition.
+func parseFilterExpr(sql string) (Expr, error) {
+	const prefix = "select 1 from dual where "
+	stmt, err := Parse(prefix + sql)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse filter condition %q: %v", sql, err)
+	}
+	// Anything but an expression, like an ORDER BY or a UNION,
+	// would make the statement more than the WHERE clause.
+	sel, ok := stmt.(*Select)
+	if !ok || sel.Where == nil || canonicalString(sel) != prefix+canonicalString(sel.Where.Expr) {
+		return nil, fmt.Errorf("filter condition %q is not an expression", sql)
+	}
+	return sel.Where.Expr, nil
+}
@@ -0,0 +1,175 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterTreeRoundTrip(t *testing.T) {
+	testcases := []struct {
+		in string
+		// out is set if redundant parentheses are dropped
+		// or row equalities are split.
+		out string
+	}{{
+		in: "a = 1",
+	}, {
+		in: "t.a != 'x' and db.t.b < 1.5 and c in (1, :v2, 'y')",
+	}, {
+		in: "a = 1 or b = 2 and c like 'x%'",
+	}, {
+		in: "(a = 1 or b = 2) and c not in ::list",
+	}, {
+		in: "a between 1 and :hi and (b = 1 and c is not null)",
+	}, {
+		in: "a = 1 and b = 2 or (c = 3 or d = 4)",
+	}, {
+		in:  "(a = 1 and b = 2) or (c = 3 or d = 4)",
+		out: "a = 1 and b = 2 or (c = 3 or d = 4)",
+	}, {
+		in:  "((a = 1)) or (b = 2 and c = 3)",
+		out: "a = 1 or b = 2 and c = 3",
+	}, {
+		in: "a = now() and b in (select b from t) and not c = 1",
+	}, {
+		in: "a like 'x' escape '!' or a = b or 1 = a or x'ff' = ''",
+	}, {
+		in:  "x = 0 and (a, t.b) = (1, :v)",
+		out: "x = 0 and a = 1 and t.b = :v",
+	}, {
+		in:  "(a, b) = (1, 2) or c = 3",
+		out: "a = 1 and b = 2 or c = 3",
+	}, {
+		in: "(a, b) = (1, f(c)) and (a, b) >= (1, 2) and (a, b) in ((1, 2))",
+	}, {
+		in: "a collate utf8mb4_bin = 'x' and b in ('y' collate latin1_general_ci, :v) and c between 'a' and 'z' collate utf8mb4_bin",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse("select * from t where " + tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		expr := tree.(*Select).Where.Expr
+		filter, err := ExprToFilterTree(expr)
+		if err != nil {
+			t.Errorf("ExprToFilterTree(%s) err: %v", tcase.in, err)
+			continue
+		}
+		got, err := FilterTreeToExpr(filter)
+		if err != nil {
+			t.Errorf("FilterTreeToExpr(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if tcase.out == "" {
+			if !reflect.DeepEqual(got, expr) {
+				t.Errorf("FilterTreeToExpr(ExprToFilterTree(%s)): %s, not equal to the input", tcase.in, String(got))
+			}
+		} else if String(got) != tcase.out {
+			t.Errorf("FilterTreeToExpr(ExprToFilterTree(%s)): %s, want %s", tcase.in, String(got), tcase.out)
+		}
+		again, err := ExprToFilterTree(got)
+		if err != nil {
+			t.Errorf("ExprToFilterTree(%s) err: %v", String(got), err)
+			continue
+		}
+		if !reflect.DeepEqual(again, filter) {
+			t.Errorf("ExprToFilterTree(%s) is not the tree of %s", String(got), tcase.in)
+		}
+	}
+}
+
+func TestExprToFilterTree(t *testing.T) {
+	tree, err := Parse("select * from t where t.a = 1 and (b in (:v1, 'x') or f(c) > 2)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ExprToFilterTree(tree.(*Select).Where.Expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &FilterTree{
+		Op: FilterAnd,
+		Children: []*FilterTree{{
+			Condition: &FilterCondition{
+				Table:    "t",
+				Column:   "a",
+				Operator: EqualStr,
+				Values:   []FilterValue{{Type: IntVal, Value: "1"}},
+			},
+		}, {
+			Op: FilterOr,
+			Children: []*FilterTree{{
+				Condition: &FilterCondition{
+					Column:   "b",
+					Operator: InStr,
+					Values:   []FilterValue{{BindVar: "v1"}, {Type: StrVal, Value: "x"}},
+				},
+			}, {
+				Condition: &FilterCondition{Opaque: "f(c) > 2"},
+			}},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExprToFilterTree: %+v, want %+v", got, want)
+	}
+}
+
+func TestExprToFilterTreeCollation(t *testing.T) {
+	tree, err := Parse("select * from t where a collate utf8mb4_bin = 'x' collate utf8mb4_general_ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ExprToFilterTree(tree.(*Select).Where.Expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &FilterTree{
+		Condition: &FilterCondition{
+			Column:    "a",
+			Collation: "utf8mb4_bin",
+			Operator:  EqualStr,
+			Values:    []FilterValue{{Type: StrVal, Value: "x", Collation: "utf8mb4_general_ci"}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExprToFilterTree: %+v, want %+v", got.Condition, want.Condition)
+	}
+}
+
+func TestFilterTreeToExprErrors(t *testing.T) {
+	testcases := []struct {
+		in  *FilterTree
+		err string
+	}{{
+		in:  &FilterTree{},
+		err: "filter tree has neither an operator nor a condition",
+	}, {
+		in:  &FilterTree{Op: "xor", Children: []*FilterTree{{}}},
+		err: `unknown filter tree operator "xor"`,
+	}, {
+		in:  &FilterTree{Op: FilterOr},
+		err: "filter tree or group is empty",
+	}, {
+		in:  &FilterTree{Condition: &FilterCondition{Column: "a", Operator: "~"}},
+		err: `unknown filter condition operator "~"`,
+	}, {
+		in:  &FilterTree{Condition: &FilterCondition{Column: "a", Operator: BetweenStr, Values: []FilterValue{{Type: IntVal, Value: "1"}}}},
+		err: "between needs 2 values, got 1",
+	}, {
+		in:  &FilterTree{Condition: &FilterCondition{Column: "a", Operator: EqualStr, Values: []FilterValue{{BindVar: "l", List: true}}}},
+		err: "list bind variable ::l can only be the value of in",
+	}, {
+		in:  &FilterTree{Condition: &FilterCondition{Opaque: "1 order by a"}},
+		err: `filter condition "1 order by a" is not an expression`,
+	}, {
+		in:  &FilterTree{Condition: &FilterCondition{Opaque: "a ="}},
+		err: `cannot parse filter condition "a =": syntax error at position 29`,
+	}}
+	for _, tcase := range testcases {
+		_, err := FilterTreeToExpr(tcase.in)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("FilterTreeToExpr(%+v) err: %v, want %s", tcase.in, err, tcase.err)
+		}
+	}
+}
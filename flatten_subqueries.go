@@ -0,0 +1,530 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// FlattenInfo reports what FlattenSubqueries did.
+type FlattenInfo struct {
+	// Flattened is the number of subqueries that were turned into joins.
+	Flattened int
+	// Skipped are the subqueries that were left in place.
+	Skipped []SkippedSubquery
+}
+
+// SkippedSubquery is a subquery that FlattenSubqueries left in place,
+// with the reason why.
+type SkippedSubquery struct {
+	Subquery *Subquery
+	Reason   string
+}
+
+// FlattenSubqueries returns a copy of sel in which the simple
+// subqueries are replaced with joins on derived tables, for engines
+// that can't run correlated subqueries:
+//
+// - A condition x IN (SELECT y FROM ...) of the WHERE clause becomes a
+// join on (SELECT DISTINCT y FROM ...) ON x = y.
+//
+// - A condition EXISTS (SELECT ... FROM ...) of the WHERE clause becomes
+// a join on (SELECT 1 FROM ... LIMIT 1).
+//
+// - A scalar subquery of the select list that aggregates, and so
+// returns exactly one row, becomes a cross join on it.
+//
+// IN and EXISTS subqueries can be correlated, as long as they only
+// refer to the outer query in equalities of their WHERE clause, like
+// t2.a = t1.a. These are moved to the ON condition of the join.
+// The columns of the subqueries must be qualified, see QualifyColumns,
+// to tell them apart from the outer ones.
+//
+// The joins return each row of sel at most once, so the result is the
+// same. Any subquery that doesn't fit is left in place and reported in
+// the FlattenInfo. sel itself is not modified.
+func FlattenSubqueries(sel *Select) (*Select, *FlattenInfo, error) {
+	if sel == nil {
+		return nil, nil, errors.New("cannot flatten the subqueries of a nil select")
+	}
+	f := &flattener{
+		sel:   cloneNode(sel).(*Select),
+		info:  &FlattenInfo{},
+		names: make(map[string]bool),
+		done:  make(map[*Subquery]bool),
+	}
+	f.flatten()
+	return f.sel, f.info, nil
+}
+
+type flattener struct {
+	sel  *Select
+	info *FlattenInfo
+	// names holds the lowercased identifiers of sel,
+	// which the derived tables must not reuse.
+	names map[string]bool
+	// done holds the subqueries that were flattened or skipped.
+	done map[*Subquery]bool
+	// joined is the FROM clause with the joins added so far.
+	joined TableExpr
+}
+
+func (f *flattener) flatten() {
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case ColIdent:
+			f.names[node.Lowered()] = true
+		case TableIdent:
+			f.names[node.String()] = true
+		}
+		return true, nil
+	}, f.sel)
+
+	qualifiers, ok := f.starQualifiers()
+	if !ok {
+		f.skipRest("select * cannot be qualified with a using or natural join")
+		return
+	}
+
+	if f.sel.Where != nil {
+		var kept []Expr
+		for _, cond := range splitConjuncts(f.sel.Where.Expr) {
+			if !f.flattenCondition(cond) {
+				kept = append(kept, cond)
+			}
+		}
+		if len(kept) != len(splitConjuncts(f.sel.Where.Expr)) {
+			f.sel.Where = nil
+			for _, cond := range kept {
+				f.sel.AddWhere(cond)
+			}
+		}
+	}
+	for _, expr := range f.sel.SelectExprs {
+		if aliased, ok := expr.(*AliasedExpr); ok {
+			f.flattenScalars(aliased)
+		}
+	}
+	f.skipRest("only IN and EXISTS conditions of the where clause and scalar subqueries of the select list are flattened")
+
+	if f.joined != nil {
+		f.sel.From = TableExprs{f.joined}
+		f.expandStars(qualifiers)
+	}
+}
+
+// starQualifiers returns the qualifiers of the tables of the FROM
+// clause, in the order in which * selects their columns. It returns
+// false if * is used and this order can't be kept.
+func (f *flattener) starQualifiers() ([]TableName, bool) {
+	var qualifiers []TableName
+	var add func(exprs ...TableExpr) bool
+	add = func(exprs ...TableExpr) bool {
+		for _, expr := range exprs {
+			switch expr := expr.(type) {
+			case *AliasedTableExpr:
+				if !expr.As.IsEmpty() {
+					qualifiers = append(qualifiers, TableName{Name: expr.As})
+				} else if name, ok := expr.Expr.(TableName); ok {
+					qualifiers = append(qualifiers, name)
+				}
+			case *ParenTableExpr:
+				if !add(expr.Exprs...) {
+					return false
+				}
+			case *JoinTableExpr:
+				// The merged columns of these joins come first.
+				if expr.Condition.Using != nil || expr.IsNatural() {
+					return false
+				}
+				if !add(expr.LeftExpr, expr.RightExpr) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	for _, expr := range f.sel.SelectExprs {
+		if star, ok := expr.(*StarExpr); ok && star.TableName.IsEmpty() {
+			return qualifiers, add(f.sel.From...)
+		}
+	}
+	return nil, true
+}
+
+// expandStars replaces the unqualified stars of the select list with
+// one star per qualifier, so that they don't select the columns of
+// the derived tables.
+func (f *flattener) expandStars(qualifiers []TableName) {
+	var exprs SelectExprs
+	for _, expr := range f.sel.SelectExprs {
+		if star, ok := expr.(*StarExpr); ok && star.TableName.IsEmpty() {
+			for _, qualifier := range qualifiers {
+				exprs = append(exprs, &StarExpr{TableName: qualifier})
+			}
+			continue
+		}
+		exprs = append(exprs, expr)
+	}
+	f.sel.SelectExprs = exprs
+}
+
+// flattenCondition flattens cond if it's an IN or EXISTS subquery.
+// It returns false if it's not, or if the subquery was skipped.
+func (f *flattener) flattenCondition(cond Expr) bool {
+	switch cond := cond.(type) {
+	case *ComparisonExpr:
+		sub, ok := cond.Right.(*Subquery)
+		if !ok {
+			return false
+		}
+		if cond.Operator != InStr {
+			f.skip(sub, fmt.Sprintf("%s subqueries are not flattened", cond.Operator))
+			return false
+		}
+		return f.flattenIn(cond.Left, sub)
+	case *ExistsExpr:
+		return f.flattenExists(cond.Subquery)
+	case *NotExpr:
+		if exists, ok := cond.Expr.(*ExistsExpr); ok {
+			f.skip(exists.Subquery, "not exists subqueries are not flattened")
+		}
+	}
+	return false
+}
+
+func (f *flattener) flattenIn(left Expr, sub *Subquery) bool {
+	if _, ok := left.(ValTuple); ok {
+		f.skip(sub, "tuple comparisons are not flattened")
+		return false
+	}
+	if containsSubquery(left) {
+		f.skip(sub, "the left side of in has a subquery")
+		return false
+	}
+	inner, ok := sub.Select.(*Select)
+	if !ok {
+		f.skip(sub, "unions are not flattened")
+		return false
+	}
+	if len(inner.SelectExprs) != 1 {
+		f.skip(sub, "the subquery must select one column")
+		return false
+	}
+	aliased, ok := inner.SelectExprs[0].(*AliasedExpr)
+	if !ok {
+		f.skip(sub, "the subquery must select one column")
+		return false
+	}
+	correlations, reason := f.decorrelate(inner)
+	if reason != "" {
+		f.skip(sub, reason)
+		return false
+	}
+	alias, cols := f.newNames(len(correlations) + 1)
+	inner.AddOption(SelectDistinct)
+	inner.SelectExprs = SelectExprs{&AliasedExpr{Expr: aliased.Expr, As: cols[0]}}
+	on := Expr(&ComparisonExpr{Operator: EqualStr, Left: left, Right: derivedColumn(alias, cols[0])})
+	on = f.addCorrelations(inner, on, alias, cols[1:], correlations)
+	f.join(JoinStr, sub, alias, on)
+	return true
+}
+
+func (f *flattener) flattenExists(sub *Subquery) bool {
+	inner, ok := sub.Select.(*Select)
+	if !ok {
+		f.skip(sub, "unions are not flattened")
+		return false
+	}
+	if inner.GroupBy != nil || inner.Having != nil || hasAggregate(inner.SelectExprs) {
+		f.skip(sub, "exists subqueries that aggregate are not flattened")
+		return false
+	}
+	correlations, reason := f.decorrelate(inner)
+	if reason != "" {
+		f.skip(sub, reason)
+		return false
+	}
+	alias, cols := f.newNames(len(correlations))
+	if len(correlations) == 0 {
+		// One row is enough to tell that it exists.
+		inner.SelectExprs = SelectExprs{&AliasedExpr{Expr: NewIntVal([]byte("1"))}}
+		inner.Limit = &Limit{Rowcount: NewIntVal([]byte("1"))}
+		f.join(JoinStr, sub, alias, nil)
+		return true
+	}
+	inner.AddOption(SelectDistinct)
+	inner.SelectExprs = nil
+	f.join(JoinStr, sub, alias, f.addCorrelations(inner, nil, alias, cols, correlations))
+	return true
+}
+
+// flattenScalars flattens the scalar subqueries of expr.
+func (f *flattener) flattenScalars(expr *AliasedExpr) {
+	var subs []*Subquery
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if sub, ok := node.(*Subquery); ok {
+			subs = append(subs, sub)
+			return false, nil
+		}
+		return true, nil
+	}, expr.Expr)
+	for _, sub := range subs {
+		inner, ok := sub.Select.(*Select)
+		if !ok {
+			f.skip(sub, "unions are not flattened")
+			continue
+		}
+		if len(inner.SelectExprs) != 1 {
+			f.skip(sub, "the subquery must select one column")
+			continue
+		}
+		aliased, ok := inner.SelectExprs[0].(*AliasedExpr)
+		// Without GROUP BY, an aggregate returns exactly one row,
+		// unless HAVING or LIMIT remove it.
+		if !ok || !hasAggregate(inner.SelectExprs) || inner.GroupBy != nil || inner.Having != nil || inner.Limit != nil {
+			f.skip(sub, "only scalar subqueries that aggregate without group by are flattened")
+			continue
+		}
+		if reason := f.checkColumns(inner); reason != "" {
+			f.skip(sub, reason)
+			continue
+		}
+		if refs := outerRefs(inner, newTableScope(inner.From)); len(refs) != 0 {
+			f.skip(sub, fmt.Sprintf("correlated scalar subqueries are not flattened, it refers to %s", String(refs[0])))
+			continue
+		}
+		alias, cols := f.newNames(1)
+		if expr.As.IsEmpty() && expr.Expr == Expr(sub) {
+			// Keep the name of the result column.
+			expr.As = NewColIdent(String(sub))
+		}
+		inner.SelectExprs = SelectExprs{&AliasedExpr{Expr: aliased.Expr, As: cols[0]}}
+		replaceExprs(sub, derivedColumn(alias, cols[0]), &expr.Expr)
+		f.join(CrossJoinStr, sub, alias, nil)
+	}
+}
+
+// correlation is an equality of the WHERE clause of a subquery
+// between an expression of the subquery and one of the outer query.
+type correlation struct {
+	inner, outer Expr
+}
+
+// decorrelate removes the correlations from the WHERE clause of sub
+// and returns them. It returns a reason if sub refers to the outer
+// query in other ways, or if it can't be flattened.
+func (f *flattener) decorrelate(sub *Select) ([]correlation, string) {
+	if sub.Limit != nil {
+		return nil, "subqueries with a limit are not flattened"
+	}
+	if reason := f.checkColumns(sub); reason != "" {
+		return nil, reason
+	}
+	scope := newTableScope(sub.From)
+	where := sub.Where
+	sub.Where = nil
+	refs := outerRefs(sub, scope)
+	sub.Where = where
+	if len(refs) != 0 {
+		return nil, fmt.Sprintf("the subquery refers to %s outside of its where clause", String(refs[0]))
+	}
+	if sub.Where == nil {
+		return nil, ""
+	}
+	var correlations []correlation
+	var kept []Expr
+	for _, cond := range splitConjuncts(sub.Where.Expr) {
+		if len(outerRefs(cond, scope)) == 0 {
+			kept = append(kept, cond)
+			continue
+		}
+		cmp, ok := cond.(*ComparisonExpr)
+		if !ok || cmp.Operator != EqualStr {
+			return nil, fmt.Sprintf("correlated condition %s is not an equality", String(cond))
+		}
+		switch {
+		case !hasInnerRefs(cmp.Left, scope) && len(outerRefs(cmp.Right, scope)) == 0:
+			correlations = append(correlations, correlation{inner: cmp.Right, outer: cmp.Left})
+		case !hasInnerRefs(cmp.Right, scope) && len(outerRefs(cmp.Left, scope)) == 0:
+			correlations = append(correlations, correlation{inner: cmp.Left, outer: cmp.Right})
+		default:
+			return nil, fmt.Sprintf("correlated condition %s mixes the columns of both queries", String(cond))
+		}
+	}
+	if len(correlations) != 0 && (sub.GroupBy != nil || sub.Having != nil || hasAggregate(sub.SelectExprs)) {
+		return nil, "correlated subqueries that aggregate are not flattened"
+	}
+	sub.Where = nil
+	for _, cond := range kept {
+		sub.AddWhere(cond)
+	}
+	return correlations, ""
+}
+
+// checkColumns returns a reason if sub has unqualified columns,
+// or subqueries of its own.
+func (f *flattener) checkColumns(sub *Select) string {
+	var reason string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName:
+			if node.Qualifier.IsEmpty() {
+				reason = fmt.Sprintf("column %s of the subquery is not qualified", String(node))
+			}
+		case *Subquery:
+			reason = "nested subqueries are not flattened"
+		}
+		return reason == "", nil
+	}, sub.SelectExprs, sub.From, sub.Where, sub.GroupBy, sub.Having, sub.OrderBy)
+	return reason
+}
+
+// addCorrelations adds the inner sides of correlations to the
+// select list of sub as cols, and ANDs their equalities with the
+// outer sides to on.
+func (f *flattener) addCorrelations(sub *Select, on Expr, alias TableIdent, cols []ColIdent, correlations []correlation) Expr {
+	for i, c := range correlations {
+		sub.SelectExprs = append(sub.SelectExprs, &AliasedExpr{Expr: c.inner, As: cols[i]})
+		cond := &ComparisonExpr{Operator: EqualStr, Left: c.outer, Right: derivedColumn(alias, cols[i])}
+		if on == nil {
+			on = cond
+		} else {
+			on = &AndExpr{Left: on, Right: cond}
+		}
+	}
+	return on
+}
+
+// join adds a join on sub as the derived table alias.
+func (f *flattener) join(typ string, sub *Subquery, alias TableIdent, on Expr) {
+	f.done[sub] = true
+	f.info.Flattened++
+	if f.joined == nil {
+		if len(f.sel.From) == 1 {
+			f.joined = f.sel.From[0]
+		} else {
+			f.joined = &ParenTableExpr{Exprs: f.sel.From}
+		}
+	}
+	f.joined = &JoinTableExpr{
+		LeftExpr:  f.joined,
+		Join:      typ,
+		RightExpr: &AliasedTableExpr{Expr: sub, As: alias},
+		Condition: JoinCondition{On: on},
+	}
+}
+
+// newNames returns a new alias for a derived table,
+// and n new names for its columns.
+func (f *flattener) newNames(n int) (TableIdent, []ColIdent) {
+	var alias string
+	for i := 1; ; i++ {
+		alias = "sq" + strconv.Itoa(i)
+		if !f.names[alias] {
+			break
+		}
+	}
+	f.names[alias] = true
+	cols := make([]ColIdent, 0, n)
+	for i := 1; len(cols) < n; i++ {
+		name := alias + "_" + strconv.Itoa(i)
+		if !f.names[name] {
+			f.names[name] = true
+			cols = append(cols, NewColIdent(name))
+		}
+	}
+	return NewTableIdent(alias), cols
+}
+
+func (f *flattener) skip(sub *Subquery, reason string) {
+	f.done[sub] = true
+	f.info.Skipped = append(f.info.Skipped, SkippedSubquery{Subquery: sub, Reason: reason})
+}
+
+// skipRest reports the subqueries of the select, other
+// than derived tables, that haven't been handled.
+func (f *flattener) skipRest(reason string) {
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *AliasedTableExpr:
+			if _, ok := node.Expr.(*Subquery); ok {
+				return false, nil
+			}
+		case *Subquery:
+			if !f.done[node] {
+				f.skip(node, reason)
+			}
+			return false, nil
+		}
+		return true, nil
+	}, f.sel)
+}
+
+func derivedColumn(alias TableIdent, col ColIdent) *ColName {
+	return &ColName{Name: col, Qualifier: TableName{Name: alias}}
+}
+
+// splitConjuncts returns the operands of the ANDs of expr.
+func splitConjuncts(expr Expr) []Expr {
+	switch e := expr.(type) {
+	case *AndExpr:
+		return append(splitConjuncts(e.Left), splitConjuncts(e.Right)...)
+	case *ParenExpr:
+		if _, ok := e.Expr.(*AndExpr); ok {
+			return splitConjuncts(e.Expr)
+		}
+	}
+	return []Expr{expr}
+}
+
+// outerRefs returns the qualified columns of node that don't
+// refer to a table of scope.
+func outerRefs(node SQLNode, scope *tableScope) []*ColName {
+	var refs []*ColName
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if col, ok := node.(*ColName); ok && !col.Qualifier.IsEmpty() {
+			if _, _, found := scope.lookup(col.Qualifier); !found {
+				refs = append(refs, col)
+			}
+		}
+		return true, nil
+	}, node)
+	return refs
+}
+
+// hasInnerRefs returns true if expr has columns of a table of scope.
+func hasInnerRefs(expr Expr, scope *tableScope) bool {
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if col, ok := node.(*ColName); ok {
+			if _, _, ok := scope.lookup(col.Qualifier); ok {
+				found = true
+			}
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+func containsSubquery(expr Expr) bool {
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if _, ok := node.(*Subquery); ok {
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+func hasAggregate(root SQLNode) bool {
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if isAggregate(node) {
+			found = true
+		}
+		return !found, nil
+	}, root)
+	return found
+}
@@ -0,0 +1,97 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenSubqueries(t *testing.T) {
+	testcases := []struct {
+		in      string
+		out     string
+		skipped []string
+	}{{
+		in:  "select t1.a from t1 where t1.b = 1 and t1.x in (select t2.y from t2 where t2.c > 0)",
+		out: "select t1.a from t1 join (select distinct t2.y as sq1_1 from t2 where t2.c > 0) as sq1 on t1.x = sq1.sq1_1 where t1.b = 1",
+	}, {
+		// correlated in
+		in:  "select a from t1 where x in (select t2.y from t2 where t2.a = t1.a and t2.c > 0)",
+		out: "select a from t1 join (select distinct t2.y as sq1_1, t2.a as sq1_2 from t2 where t2.c > 0) as sq1 on x = sq1.sq1_1 and t1.a = sq1.sq1_2",
+	}, {
+		in:  "select * from t1, t3 as u where exists (select 1 from t2 where t1.a = t2.a) and (u.b = 1 or u.b = 2)",
+		out: "select t1.*, u.* from (t1, t3 as u) join (select distinct t2.a as sq1_1 from t2) as sq1 on t1.a = sq1.sq1_1 where (u.b = 1 or u.b = 2)",
+	}, {
+		// uncorrelated exists
+		in:  "select t1.a from t1 where exists (select t2.* from t2 where t2.c = 1)",
+		out: "select t1.a from t1 join (select 1 from t2 where t2.c = 1 limit 1) as sq1",
+	}, {
+		in:  "select t1.a, (select max(t2.b) from t2) from t1",
+		out: "select t1.a, sq1.sq1_1 as `(select max(t2.b) from t2)` from t1 cross join (select max(t2.b) as sq1_1 from t2) as sq1",
+	}, {
+		// the names of the derived tables are not in use
+		in:  "select sq1.a, 1 + (select count(*) from t2) as n from sq1 where sq1.b in (select t2.b from t2)",
+		out: "select sq1.a, 1 + sq3.sq3_1 as n from sq1 join (select distinct t2.b as sq2_1 from t2) as sq2 on sq1.b = sq2.sq2_1 cross join (select count(*) as sq3_1 from t2) as sq3",
+	}, {
+		in:  "select a from t1 where a not in (select t2.a from t2) or exists (select 1 from t2)",
+		out: "select a from t1 where a not in (select t2.a from t2) or exists (select 1 from t2)",
+		skipped: []string{
+			"only IN and EXISTS conditions of the where clause and scalar subqueries of the select list are flattened",
+			"only IN and EXISTS conditions of the where clause and scalar subqueries of the select list are flattened",
+		},
+	}, {
+		in:  "select a from t1 where a not in (select t2.a from t2) and not exists (select 1 from t2) and a in (select b from t2)",
+		out: "select a from t1 where a not in (select t2.a from t2) and not exists (select 1 from t2) and a in (select b from t2)",
+		skipped: []string{
+			"not in subqueries are not flattened",
+			"not exists subqueries are not flattened",
+			"column b of the subquery is not qualified",
+		},
+	}, {
+		in:  "select a from t1 where exists (select t2.a from t2 where t2.a > t1.a) and exists (select count(*) from t2 where t2.a = t1.a)",
+		out: "select a from t1 where exists (select t2.a from t2 where t2.a > t1.a) and exists (select count(*) from t2 where t2.a = t1.a)",
+		skipped: []string{
+			"correlated condition t2.a > t1.a is not an equality",
+			"exists subqueries that aggregate are not flattened",
+		},
+	}, {
+		in:  "select a, (select t2.b from t2 limit 1), (select max(t2.b) from t2 where t2.a = t1.a) from t1",
+		out: "select a, (select t2.b from t2 limit 1), (select max(t2.b) from t2 where t2.a = t1.a) from t1",
+		skipped: []string{
+			"only scalar subqueries that aggregate without group by are flattened",
+			"correlated scalar subqueries are not flattened, it refers to t1.a",
+		},
+	}, {
+		in:      "select * from t1 join t2 using (a) where t1.b in (select t3.b from t3)",
+		out:     "select * from t1 join t2 using (a) where t1.b in (select t3.b from t3)",
+		skipped: []string{"select * cannot be qualified with a using or natural join"},
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		in := String(tree)
+		got, info, err := FlattenSubqueries(tree.(*Select))
+		if err != nil {
+			t.Errorf("FlattenSubqueries(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if String(got) != tcase.out {
+			t.Errorf("FlattenSubqueries(%s):\n%s, want\n%s", tcase.in, String(got), tcase.out)
+		}
+		if String(tree) != in {
+			t.Errorf("FlattenSubqueries(%s) modified its input: %s", tcase.in, String(tree))
+		}
+		var skipped []string
+		for _, s := range info.Skipped {
+			skipped = append(skipped, s.Reason)
+		}
+		if !reflect.DeepEqual(skipped, tcase.skipped) {
+			t.Errorf("FlattenSubqueries(%s) skipped:\n%q, want\n%q", tcase.in, skipped, tcase.skipped)
+		}
+		if _, err := Parse(String(got)); err != nil {
+			t.Errorf("FlattenSubqueries(%s): %s doesn't parse: %v", tcase.in, String(got), err)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package sqlparser
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+// FormatOptions are the options of StringWithOptions.
+type FormatOptions struct {
+	// Dialect selects the identifier quoting and syntax
+	// the node is generated in.
+	Dialect Dialect
+	// SingleLine makes the output safe to embed in line oriented
+	// logs: it has no line breaks, tabs or other control characters.
+	// The whitespace between tokens is replaced by a space. Control
+	// characters in string literals are escaped, with a backslash if
+	// MySQL has an escape for them, like \n, or else by writing the
+	// literal in hexadecimal. The output parses to the same values.
+	//
+	// Identifiers and comments, and the string literals of text that
+	// the AST holds verbatim, like the body of a procedure, have no
+	// such escapes: their control characters are replaced by escapes
+	// like \n or \x01 as text. The output still parses, but names the
+	// identifiers differently.
+	SingleLine bool
+	// MaxLength truncates the output to at most MaxLength bytes if
+	// it's longer. The output is cut at a token boundary outside of
+	// quotes and comments, and is followed by an ellipsis and the
+	// number of bytes that were cut. A truncated output is not meant
+	// to parse. Zero means no limit.
+	MaxLength int
+	// PipesAsConcat formats string concatenations with ||, for
+	// servers in the PIPES_AS_CONCAT SQL mode. By default, they are
+	// formatted as calls to CONCAT, since || is a logical OR in the
+	// default SQL mode of MySQL, except with PostgresDialect. It's the
+	// same as SQLModePipesAsConcat.
+	PipesAsConcat bool
+	// SQLMode is the SQL mode of the server the output is meant for.
+	// With SQLModeNoBackslashEscapes, the strings are written without
+	// backslash escapes: their quotes are doubled, and their other
+	// characters are written as they are, except that with SingleLine
+	// the strings with control characters are written in hexadecimal.
+	// The identifiers are quoted with backquotes in every mode.
+	SQLMode SQLMode
+	// Style, if set, overrides the default style, see
+	// SetDefaultStyle.
+	Style *FormatStyle
+}
+
+// StringWithOptions returns a string representation of an SQLNode
+// formatted according to opts.
+func StringWithOptions(node SQLNode, opts FormatOptions) string {
+	if node == nil {
+		return "<nil>"
+	}
+
+	buf := NewTrackedBuffer(nil)
+	buf.Dialect = opts.Dialect
+	buf.SingleLine = opts.SingleLine
+	buf.PipesAsConcat = opts.PipesAsConcat
+	buf.SQLMode = opts.SQLMode
+	if opts.Style != nil {
+		buf.Style = *opts.Style
+	}
+	buf.Myprintf("%v", node)
+	buf.applyKeywordCase(0)
+	out, cuts := scanFormatted(buf.String(), opts)
+	if opts.MaxLength <= 0 || len(out) <= opts.MaxLength {
+		return out
+	}
+	kept := 0
+	for _, cut := range cuts {
+		if cut > opts.MaxLength {
+			break
+		}
+		kept = cut
+	}
+	if kept == 0 {
+		return fmt.Sprintf("... (%d more bytes)", len(out))
+	}
+	return fmt.Sprintf("%s ... (%d more bytes)", out[:kept], len(out)-kept)
+}
+
+// scanFormatted goes through the formatted sql, and returns it on
+// a single line if opts.SingleLine is set. It also returns the
+// offsets of the output at which it can be cut without leaving a
+// quote or a comment open: before a space or an opening parenthesis,
+// and after a comma or a closing parenthesis.
+func scanFormatted(sql string, opts FormatOptions) (string, []int) {
+	out := &bytes.Buffer{}
+	var cuts []int
+	for i := 0; i < len(sql); {
+		ch := sql[i]
+		switch {
+		case ch == '\'' || ch == '"' || ch == '`' || ch == '[' && opts.Dialect == SQLServerDialect:
+			i = scanQuoted(out, sql, i, opts.SingleLine, opts.SQLMode)
+		case ch == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			i = scanComment(out, sql, i, opts.SingleLine)
+		case opts.SingleLine && (ch == '#' || ch == '-' && isLineComment(sql, i)):
+			i = scanLineComment(out, sql, i)
+		case isSpaceOrControl(ch) && (opts.SingleLine || ch == ' '):
+			if opts.SingleLine {
+				for i < len(sql) && isSpaceOrControl(sql[i]) {
+					i++
+				}
+				if out.Len() == 0 || i == len(sql) {
+					continue
+				}
+				cuts = append(cuts, out.Len())
+				out.WriteByte(' ')
+				continue
+			}
+			cuts = append(cuts, out.Len())
+			out.WriteByte(ch)
+			i++
+		case ch == '(':
+			cuts = append(cuts, out.Len())
+			out.WriteByte(ch)
+			i++
+		case ch == ',' || ch == ')':
+			out.WriteByte(ch)
+			cuts = append(cuts, out.Len())
+			i++
+		default:
+			out.WriteByte(ch)
+			i++
+		}
+	}
+	return out.String(), cuts
+}
+
+// scanQuoted copies the quoted string or identifier that starts at
+// sql[start], and returns the offset after it.
+func scanQuoted(out *bytes.Buffer, sql string, start int, singleLine bool, mode SQLMode) int {
+	quote := sql[start]
+	if quote == '[' {
+		quote = ']'
+	}
+	isString := quote == '\'' || quote == '"' && mode&SQLModeANSIQuotes == 0
+	escapes := mode.backslashEscapes(uint16(quote))
+	out.WriteByte(sql[start])
+	for i := start + 1; i < len(sql); i++ {
+		ch := sql[i]
+		escaped := false
+		if escapes && ch == '\\' && i+1 < len(sql) {
+			out.WriteByte(ch)
+			i++
+			ch = sql[i]
+			escaped = true
+		} else if ch == quote {
+			out.WriteByte(ch)
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++
+				out.WriteByte(quote)
+				continue
+			}
+			return i + 1
+		}
+		switch {
+		case !singleLine || !isControl(ch):
+			out.WriteByte(ch)
+		case isString && escapes && sqltypes.SQLEncodeMap[ch] != sqltypes.DontEscape:
+			if !escaped {
+				out.WriteByte('\\')
+			}
+			out.WriteByte(sqltypes.SQLEncodeMap[ch])
+		case escaped:
+			fmt.Fprintf(out, "x%02x", ch)
+		default:
+			writeControlText(out, ch)
+		}
+	}
+	return len(sql)
+}
+
+// scanComment copies the /* */ comment that starts at sql[start],
+// and returns the offset after it.
+func scanComment(out *bytes.Buffer, sql string, start int, singleLine bool) int {
+	end := len(sql)
+	if n := bytes.Index([]byte(sql[start+2:]), []byte("*/")); n >= 0 {
+		end = start + 2 + n + 2
+	}
+	for i := start; i < end; i++ {
+		if singleLine && isControl(sql[i]) {
+			writeControlText(out, sql[i])
+			continue
+		}
+		out.WriteByte(sql[i])
+	}
+	return end
+}
+
+// scanLineComment writes the -- or # comment that starts at sql[start]
+// as a /* */ comment, since it would otherwise extend to the end of
+// the single line. It returns the offset after it.
+func scanLineComment(out *bytes.Buffer, sql string, start int) int {
+	i := start + 1
+	if sql[start] == '-' {
+		i++
+	}
+	out.WriteString("/*")
+	for ; i < len(sql) && sql[i] != '\n'; i++ {
+		switch {
+		case sql[i] == '*' && i+1 < len(sql) && sql[i+1] == '/':
+			out.WriteString("* ")
+		case isControl(sql[i]):
+			if sql[i] != '\r' {
+				writeControlText(out, sql[i])
+			}
+		default:
+			out.WriteByte(sql[i])
+		}
+	}
+	out.WriteString(" */")
+	return i
+}
+
+// isLineComment returns true if sql[i:] starts a -- comment,
+// which needs a blank or a control character after the dashes.
+func isLineComment(sql string, i int) bool {
+	return i+2 < len(sql) && sql[i+1] == '-' && isSpaceOrControl(sql[i+2])
+}
+
+// writeControlText writes ch as an escape like \n or \x01, as text.
+func writeControlText(out *bytes.Buffer, ch byte) {
+	if escaped := sqltypes.SQLEncodeMap[ch]; escaped != sqltypes.DontEscape {
+		out.WriteByte('\\')
+		out.WriteByte(escaped)
+		return
+	}
+	fmt.Fprintf(out, "\\x%02x", ch)
+}
+
+// hasUnescapableControl returns true if val has control characters
+// that have no backslash escape in MySQL, or that can't be escaped in
+// mode.
+func hasUnescapableControl(val []byte, mode SQLMode) bool {
+	for _, ch := range val {
+		if isControl(ch) && (sqltypes.SQLEncodeMap[ch] == sqltypes.DontEscape || mode&SQLModeNoBackslashEscapes != 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func isControl(ch byte) bool {
+	return ch < ' ' || ch == 0x7f
+}
+
+func isSpaceOrControl(ch byte) bool {
+	return ch == ' ' || isControl(ch)
+}
@@ -0,0 +1,166 @@
+package sqlparser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStringWithOptions(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select 'a\nb\tc' from t",
+		out: `select 'a\nb\tc' from t`,
+	}, {
+		in:  "select 'a\x01b', \"c\x7f\" from t",
+		out: `select X'610162', X'637f' from t`,
+	}, {
+		in:  "select `a\nb` from t",
+		out: "select `a\\nb` from t",
+	}, {
+		in:  "select /* a\nb */ 1 from t",
+		out: `select /* a\nb */ 1 from t`,
+	}, {
+		in:  "create table t (\n\ta int,\n\tb varchar(10) default 'x\r\ny'\n) engine=InnoDB, charset=utf8",
+		out: `create table t ( a int, b varchar(10) default 'x\r\ny' ) engine=InnoDB, charset=utf8`,
+	}, {
+		in:  "create procedure p() begin\n  -- say hi\n  select 'hi\x01';\nend",
+		out: `create procedure p() begin /* say hi */ select 'hi\x01'; end`,
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		out := StringWithOptions(tree, FormatOptions{SingleLine: true})
+		if out != tcase.out {
+			t.Errorf("StringWithOptions(%q):\n%s, want\n%s", tcase.in, out, tcase.out)
+		}
+		if strings.IndexFunc(out, func(r rune) bool { return r < ' ' || r == 0x7f }) >= 0 {
+			t.Errorf("StringWithOptions(%q): %q has control characters", tcase.in, out)
+		}
+		if _, err := Parse(out); err != nil {
+			t.Errorf("StringWithOptions(%q): %s doesn't parse: %v", tcase.in, out, err)
+		}
+	}
+}
+
+func TestStringWithOptionsMaxLength(t *testing.T) {
+	testcases := []struct {
+		in        string
+		maxLength int
+		out       string
+	}{{
+		in:        "select a, b from t",
+		maxLength: 18,
+		out:       "select a, b from t",
+	}, {
+		in:        "select a, b from t",
+		maxLength: 12,
+		out:       "select a, b ... (7 more bytes)",
+	}, {
+		in:        "select a, b from t where c = 'a quoted string'",
+		maxLength: 40,
+		out:       "select a, b from t where c = ... (18 more bytes)",
+	}, {
+		in:        "select count(*) from t",
+		maxLength: 14,
+		out:       "select count ... (10 more bytes)",
+	}, {
+		in:        "select 'a long string that has no boundary'",
+		maxLength: 20,
+		out:       "select ... (47 more bytes)",
+	}, {
+		in:        "select 'a'",
+		maxLength: 3,
+		out:       "... (20 more bytes)",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		out := StringWithOptions(tree, FormatOptions{SingleLine: true, MaxLength: tcase.maxLength})
+		if out != tcase.out {
+			t.Errorf("StringWithOptions(%s, %d):\n%s, want\n%s", tcase.in, tcase.maxLength, out, tcase.out)
+		}
+	}
+}
+
+func TestStringWithOptionsPipesAsConcat(t *testing.T) {
+	testcases := []struct {
+		in       string
+		mysql    string
+		concat   string
+		postgres string
+	}{{
+		in:       "select a || b || c from t",
+		mysql:    "select concat(a, b, c) from t",
+		concat:   "select a || b || c from t",
+		postgres: `select a || b || c from t`,
+	}, {
+		in:       "select (a || 'x') || (b || c) as x from t where d = e || 1",
+		mysql:    "select concat((concat(a, 'x')), (concat(b, c))) as x from t where d = concat(e, 1)",
+		concat:   "select (a || 'x') || (b || c) as x from t where d = e || 1",
+		postgres: `select (a || 'x') || (b || c) as x from t where d = e || 1`,
+	}}
+	for _, tcase := range testcases {
+		tree, err := ParseWithOptions(tcase.in, ParseOptions{PipesAsConcat: true})
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		if out := String(tree); out != tcase.mysql {
+			t.Errorf("String(%q):\n%s, want\n%s", tcase.in, out, tcase.mysql)
+		}
+		if out := StringWithOptions(tree, FormatOptions{PipesAsConcat: true}); out != tcase.concat {
+			t.Errorf("StringWithOptions(%q, PipesAsConcat):\n%s, want\n%s", tcase.in, out, tcase.concat)
+		}
+		if out := StringWithDialect(tree, PostgresDialect); out != tcase.postgres {
+			t.Errorf("StringWithDialect(%q, PostgresDialect):\n%s, want\n%s", tcase.in, out, tcase.postgres)
+		}
+		// The CONCAT calls parse to the same values in the
+		// default SQL mode.
+		if _, err := Parse(tcase.mysql); err != nil {
+			t.Errorf("Parse(%q): %v", tcase.mysql, err)
+		}
+	}
+}
+
+func TestStringWithOptionsSQLMode(t *testing.T) {
+	mode := SQLModeNoBackslashEscapes
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  `select 'a\b''c' from t where d like 'x\%'`,
+		out: `SELECT 'a\b''c' FROM t WHERE d LIKE 'x\%'`,
+	}, {
+		in:  `select 'a\' from t where b = 'c'`,
+		out: `SELECT 'a\' FROM t WHERE b = 'c'`,
+	}, {
+		// The control characters can't be escaped.
+		in:  "select 'a\nb', 'c' from t",
+		out: `SELECT X'610a62', 'c' FROM t`,
+	}, {
+		in:  "create procedure p() begin select 'a\\'; select 'b'; end",
+		out: `CREATE PROCEDURE p() begin select 'a\'; select 'b'; end`,
+	}}
+	for _, tcase := range testcases {
+		tree, err := ParseWithOptions(tcase.in, ParseOptions{SQLMode: mode})
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		out := StringWithOptions(tree, FormatOptions{SingleLine: true, SQLMode: mode, Style: &FormatStyle{KeywordCase: KeywordUpper}})
+		if out != tcase.out {
+			t.Errorf("StringWithOptions(%q):\n%s, want\n%s", tcase.in, out, tcase.out)
+		}
+		if _, err := ParseWithOptions(out, ParseOptions{SQLMode: mode}); err != nil {
+			t.Errorf("StringWithOptions(%q): %s doesn't parse: %v", tcase.in, out, err)
+		}
+	}
+}
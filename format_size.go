@@ -0,0 +1,107 @@
+package sqlparser
+
+// formattedSize returns an estimate of the length of the formatted
+// node, so that its buffer can be allocated once. It only looks into
+// the nodes that make statements large, lists of rows, of expressions
+// and of columns, and counts a fixed length for the others: the
+// buffer grows as usual if the estimate is too small.
+func formattedSize(node SQLNode) int {
+	switch node := node.(type) {
+	case *Insert:
+		size := 32 + formattedTableNameSize(node.Table) + formattedColumnsSize(node.Columns)
+		if rows, ok := node.Rows.(Values); ok {
+			size += formattedValuesSize(rows)
+		} else if node.Rows != nil {
+			size += formattedSize(node.Rows)
+		}
+		return size + formattedUpdateExprsSize(node.SetExprs) + formattedUpdateExprsSize(UpdateExprs(node.OnDup))
+	case *Select:
+		size := 16
+		for _, expr := range node.SelectExprs {
+			size += 2 + formattedSize(expr)
+		}
+		for _, expr := range node.From {
+			size += 2 + formattedSize(expr)
+		}
+		if node.Where != nil {
+			size += 7 + formattedSize(node.Where.Expr)
+		}
+		return size + 32
+	case *Update:
+		size := 32 + formattedUpdateExprsSize(node.Exprs)
+		if node.Where != nil {
+			size += 7 + formattedSize(node.Where.Expr)
+		}
+		return size
+	case *Delete:
+		size := 32
+		if node.Where != nil {
+			size += 7 + formattedSize(node.Where.Expr)
+		}
+		return size
+	case *AliasedExpr:
+		return formattedSize(node.Expr) + 4 + len(node.As.String())
+	case *AliasedTableExpr:
+		if name, ok := node.Expr.(TableName); ok {
+			return formattedTableNameSize(name) + 4 + len(node.As.String())
+		}
+		return 32
+	case *AndExpr:
+		return formattedSize(node.Left) + 5 + formattedSize(node.Right)
+	case *OrExpr:
+		return formattedSize(node.Left) + 4 + formattedSize(node.Right)
+	case *ComparisonExpr:
+		return formattedSize(node.Left) + 2 + len(node.Operator) + formattedSize(node.Right)
+	case *ParenExpr:
+		return 2 + formattedSize(node.Expr)
+	case ValTuple:
+		return formattedExprsSize(Exprs(node)) + 2
+	case Exprs:
+		return formattedExprsSize(node)
+	case *ColName:
+		return len(node.Qualifier.Name.String()) + 1 + len(node.Name.String())
+	case *SQLVal:
+		// Room for the quotes and a few escapes.
+		return len(node.Val) + 4
+	case *NullVal:
+		return 4
+	}
+	return 16
+}
+
+func formattedExprsSize(exprs Exprs) int {
+	size := 0
+	for _, expr := range exprs {
+		size += 2 + formattedSize(expr)
+	}
+	return size
+}
+
+// formattedValuesSize takes the rows to be the size of the first one,
+// large inserts have many rows of similar sizes.
+func formattedValuesSize(rows Values) int {
+	if len(rows) == 0 {
+		return 7
+	}
+	return 7 + len(rows)*(4+formattedExprsSize(Exprs(rows[0])))
+}
+
+func formattedColumnsSize(cols Columns) int {
+	size := 2
+	for _, col := range cols {
+		size += 2 + len(col.String())
+	}
+	return size
+}
+
+func formattedUpdateExprsSize(exprs UpdateExprs) int {
+	size := 0
+	for _, expr := range exprs {
+		size += 5 + formattedSize(expr.Name) + formattedSize(expr.Expr)
+	}
+	return size
+}
+
+func formattedTableNameSize(name TableName) int {
+	return len(name.Qualifier.String()) + 1 + len(name.Name.String())
+}
@@ -0,0 +1,180 @@
+package sqlparser
+
+import (
+	"bytes"
+	"strings"
+)
+
+// FormatStyle is the style nodes are formatted in. Its zero value is
+// the style the package has always used: lowercase keywords, quotes
+// around the identifiers that need them, an explicit AS before the
+// aliases, and spaces around the operators. Every style formats SQL
+// that parses to the same statement.
+type FormatStyle struct {
+	// KeywordCase is the case of the keywords. The keywords are
+	// those of the Dialect the node is formatted in. The names of
+	// functions and the text that the AST holds verbatim, like the
+	// body of a procedure, keep their case.
+	KeywordCase KeywordCase
+	// QuoteIdentifiers is when identifiers are quoted.
+	QuoteIdentifiers QuotePolicy
+	// OmitAs leaves out the optional AS between an expression or a
+	// table and its alias.
+	OmitAs bool
+	// CompactOperators leaves out the spaces around the operators
+	// that are symbols, like = or +, but not those that are words,
+	// like like or div.
+	CompactOperators bool
+}
+
+// KeywordCase is the case of the keywords of a FormatStyle.
+type KeywordCase int
+
+// The cases of the keywords.
+const (
+	KeywordLower KeywordCase = iota
+	KeywordUpper
+)
+
+// QuotePolicy is when a FormatStyle quotes identifiers.
+type QuotePolicy int
+
+// The quote policies. QuoteWhenNeeded quotes the identifiers that are
+// keywords or have characters that are not letters or digits, and
+// QuoteAlways every identifier, except variables like @x.
+const (
+	QuoteWhenNeeded QuotePolicy = iota
+	QuoteAlways
+)
+
+// defaultStyle is the style nodes are formatted in, unless
+// FormatOptions.Style overrides it.
+var defaultStyle FormatStyle
+
+// SetDefaultStyle sets the style String, StringWithDialect, WriteTo,
+// Append and the queries generated with a TrackedBuffer format nodes
+// in. FormatOptions.Style overrides it for a call of
+// StringWithOptions. SetDefaultStyle is not safe to call while nodes
+// are formatted: call it at initialization.
+func SetDefaultStyle(style FormatStyle) {
+	defaultStyle = style
+}
+
+// canonicalString formats node in the zero style, whatever the default
+// style is. The package compares nodes by their canonical strings, and
+// uses them as keys, so that SetDefaultStyle doesn't change what they
+// match.
+func canonicalString(node SQLNode) string {
+	if node == nil {
+		return "<nil>"
+	}
+	buf := &TrackedBuffer{Buffer: new(bytes.Buffer)}
+	buf.formatNode(node)
+	return buf.String()
+}
+
+// writeVerbatim writes text, which keeps its case whatever the
+// KeywordCase of the style, like the name of a function.
+func (buf *TrackedBuffer) writeVerbatim(text string) {
+	start := buf.Len()
+	buf.WriteString(text)
+	if buf.Style.KeywordCase != KeywordLower {
+		buf.verbatim = append(buf.verbatim, [2]int{start, buf.Len()})
+	}
+}
+
+// formatOperator writes the binary operator op, which is followed by
+// the operand right, with the spaces around it that the style has.
+func (buf *TrackedBuffer) formatOperator(op string, right SQLNode) {
+	if !buf.Style.CompactOperators || op == "" || isLetter(uint16(op[0])) {
+		buf.WriteByte(' ')
+		buf.WriteString(op)
+		buf.WriteByte(' ')
+		return
+	}
+	buf.WriteString(op)
+	// a--1 would start a comment.
+	if strings.HasSuffix(op, "-") && strings.HasPrefix(String(right), "-") {
+		buf.WriteByte(' ')
+	}
+}
+
+// applyKeywordCase changes the case of the keywords the buffer holds
+// from offset from, as the style says. The quoted strings and
+// identifiers, the comments and the verbatim text are skipped, as are
+// the words that are not keywords: identifiers that are keywords are
+// quoted.
+func (buf *TrackedBuffer) applyKeywordCase(from int) {
+	if buf.Style.KeywordCase == KeywordLower {
+		return
+	}
+	sql := buf.Bytes()
+	verbatim := buf.verbatim
+	for i := from; i < len(sql); {
+		for len(verbatim) > 0 && verbatim[0][1] <= i {
+			verbatim = verbatim[1:]
+		}
+		if len(verbatim) > 0 && verbatim[0][0] <= i {
+			i = verbatim[0][1]
+			continue
+		}
+		ch := sql[i]
+		switch {
+		case ch == '\'' || ch == '"' || ch == '`' || ch == '[' && buf.Dialect == SQLServerDialect:
+			i = skipQuoted(sql, i, buf.SQLMode)
+		case ch == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			i = skipUntil(sql, i+2, "*/")
+		case ch == '#' || ch == '-' && i+2 < len(sql) && sql[i+1] == '-' && isSpaceOrControl(sql[i+2]):
+			i = skipUntil(sql, i+1, "\n")
+		case isLetter(uint16(ch)) || isDigit(uint16(ch)) || ch == '$':
+			start := i
+			for i < len(sql) && (isLetter(uint16(sql[i])) || isDigit(uint16(sql[i])) || sql[i] == '$') {
+				i++
+			}
+			// Numbers, variables, bind variables, qualified names
+			// and the introducers of strings are not keywords.
+			if isDigit(uint16(ch)) || ch == '@' || start > 0 && (sql[start-1] == ':' || sql[start-1] == '.' || sql[start-1] == '@') {
+				continue
+			}
+			if i < len(sql) && sql[i] == '\'' || !isKeywordID(string(sql[start:i]), buf.Dialect) {
+				continue
+			}
+			for j := start; j < i; j++ {
+				if 'a' <= sql[j] && sql[j] <= 'z' {
+					sql[j] -= 'a' - 'A'
+				}
+			}
+		default:
+			i++
+		}
+	}
+}
+
+// skipQuoted returns the offset after the quoted string or identifier
+// that starts at sql[start], quoted like in mode. A doubled quote is
+// skipped as two quoted strings.
+func skipQuoted(sql []byte, start int, mode SQLMode) int {
+	quote := sql[start]
+	if quote == '[' {
+		quote = ']'
+	}
+	escapes := mode.backslashEscapes(uint16(quote))
+	for i := start + 1; i < len(sql); i++ {
+		switch {
+		case sql[i] == '\\' && escapes:
+			i++
+		case sql[i] == quote:
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+// skipUntil returns the offset after the first end in sql[i:], or
+// the length of sql if there is none.
+func skipUntil(sql []byte, i int, end string) int {
+	if n := bytes.Index(sql[i:], []byte(end)); n >= 0 {
+		return i + n + len(end)
+	}
+	return len(sql)
+}
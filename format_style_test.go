@@ -0,0 +1,138 @@
+package sqlparser
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/corpus"
+)
+
+func TestFormatStyle(t *testing.T) {
+	in := "select a, T.b as x, count(*) as `select`, left(c, 1) from t as T join u where a = -1 and b - -1 > 2 and c like 'select%' and @status = :from and d <=> e div 2 order by x desc"
+	testcases := []struct {
+		style FormatStyle
+		out   string
+	}{{
+		out: "select a, T.b as x, count(*) as `select`, left(c, 1) from t as T join u where a = -1 and b - -1 > 2 and c like 'select%' and @status = :from and d <=> e div 2 order by x desc",
+	}, {
+		style: FormatStyle{KeywordCase: KeywordUpper},
+		out:   "SELECT a, T.b AS x, count(*) AS `select`, left(c, 1) FROM t AS T JOIN u WHERE a = -1 AND b - -1 > 2 AND c LIKE 'select%' AND @status = :from AND d <=> e DIV 2 ORDER BY x DESC",
+	}, {
+		style: FormatStyle{QuoteIdentifiers: QuoteAlways},
+		out:   "select `a`, `T`.`b` as `x`, count(*) as `select`, left(`c`, 1) from `t` as `T` join `u` where `a` = -1 and `b` - -1 > 2 and `c` like 'select%' and @status = :from and `d` <=> `e` div 2 order by `x` desc",
+	}, {
+		style: FormatStyle{OmitAs: true},
+		out:   "select a, T.b x, count(*) `select`, left(c, 1) from t T join u where a = -1 and b - -1 > 2 and c like 'select%' and @status = :from and d <=> e div 2 order by x desc",
+	}, {
+		style: FormatStyle{CompactOperators: true},
+		out:   "select a, T.b as x, count(*) as `select`, left(c, 1) from t as T join u where a=-1 and b- -1>2 and c like 'select%' and @status=:from and d<=>e div 2 order by x desc",
+	}}
+	tree, err := Parse(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tcase := range testcases {
+		style := tcase.style
+		if got := StringWithOptions(tree, FormatOptions{Style: &style}); got != tcase.out {
+			t.Errorf("StringWithOptions(%+v):\n%s, want\n%s", style, got, tcase.out)
+		}
+	}
+
+	// The keywords are those of the dialect.
+	style := FormatStyle{KeywordCase: KeywordUpper}
+	tree, err = Parse("delete from t where returning = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dialect := range []Dialect{MySQLDialect, PostgresDialect} {
+		want := "DELETE FROM t WHERE returning = 1"
+		if dialect == PostgresDialect {
+			want = "DELETE FROM t WHERE `returning` = 1"
+		}
+		if got := StringWithOptions(tree, FormatOptions{Dialect: dialect, Style: &style}); got != want {
+			t.Errorf("StringWithOptions(%v): %s, want %s", dialect, got, want)
+		}
+	}
+}
+
+// TestFormatStyleRoundTrip checks that every style formats the
+// statements of the corpus as SQL that parses to the same statement.
+func TestFormatStyleRoundTrip(t *testing.T) {
+	styles := []FormatStyle{
+		{KeywordCase: KeywordUpper},
+		{QuoteIdentifiers: QuoteAlways},
+		{OmitAs: true},
+		{CompactOperators: true},
+		{KeywordCase: KeywordUpper, QuoteIdentifiers: QuoteAlways, OmitAs: true, CompactOperators: true},
+	}
+	for _, query := range corpus.Queries() {
+		tree, err := Parse(query.Input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", query.Input, err)
+			continue
+		}
+		want := String(tree)
+		if _, err := ParseStrictDDL(want); err != nil {
+			// Like the DDL the parser only partially parses.
+			continue
+		}
+		for _, style := range styles {
+			style := style
+			formatted := StringWithOptions(tree, FormatOptions{Style: &style})
+			reparsed, err := Parse(formatted)
+			if err != nil {
+				t.Errorf("%+v: Parse(%q): %v", style, formatted, err)
+				continue
+			}
+			if got := String(reparsed); got != want {
+				t.Errorf("%+v: %q parses to %q, want %q", style, formatted, got, want)
+			}
+		}
+	}
+}
+
+func TestSetDefaultStyle(t *testing.T) {
+	defer SetDefaultStyle(FormatStyle{})
+	SetDefaultStyle(FormatStyle{KeywordCase: KeywordUpper, OmitAs: true})
+	tree, err := Parse("select a as b from t where c = :c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "SELECT a b FROM t WHERE c = :c"
+	if got := String(tree); got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+	var out bytes.Buffer
+	if _, err := WriteTo(&out, tree); err != nil || out.String() != want {
+		t.Errorf("WriteTo: %s, %v, want %s", out.String(), err, want)
+	}
+	if got := NewParsedQuery(tree).Query; got != want {
+		t.Errorf("NewParsedQuery: %s, want %s", got, want)
+	}
+	lower := FormatStyle{}
+	if got, want := StringWithOptions(tree, FormatOptions{Style: &lower}), "select a as b from t where c = :c"; got != want {
+		t.Errorf("StringWithOptions: %s, want %s", got, want)
+	}
+}
+
+func TestSetDefaultStyleInternal(t *testing.T) {
+	// The default style doesn't change what the package matches.
+	defer SetDefaultStyle(FormatStyle{})
+	SetDefaultStyle(FormatStyle{KeywordCase: KeywordUpper, QuoteIdentifiers: QuoteAlways})
+	expr, err := FilterTreeToExpr(&FilterTree{Condition: &FilterCondition{Opaque: "a like 'x%'"}})
+	if err != nil {
+		t.Errorf("FilterTreeToExpr: %v", err)
+	} else if got, want := String(expr), "`a` LIKE 'x%'"; got != want {
+		t.Errorf("FilterTreeToExpr: %s, want %s", got, want)
+	}
+	if _, err := ParseTableName("db.t"); err != nil {
+		t.Errorf("ParseTableName: %v", err)
+	}
+	stmt, err := Parse("select a + 1 as x, a + 1 as x from t group by a + 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := CheckAggregation(stmt.(*Select)); len(errs) != 0 {
+		t.Errorf("CheckAggregation: %v", errs)
+	}
+}
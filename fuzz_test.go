@@ -0,0 +1,46 @@
+package sqlparser
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+// FuzzParse checks that no input makes the parser, or the
+// formatting of what it returns, panic.
+func FuzzParse(f *testing.F) {
+	for _, tcase := range validSQL {
+		f.Add(tcase.Input)
+	}
+	for _, tcase := range invalidSQL {
+		f.Add(tcase.input)
+	}
+	f.Fuzz(func(t *testing.T, sql string) {
+		for _, dialect := range []Dialect{MySQLDialect, PostgresDialect, SQLServerDialect, MariaDBDialect} {
+			stmt, err := ParseWithOptions(sql, ParseOptions{Dialect: dialect, TrackSource: true})
+			if err != nil && strings.HasPrefix(err.Error(), "internal error") {
+				// The recover in the parser hides panics, which
+				// must still be fixed.
+				t.Fatalf("ParseWithOptions(%q): %v", sql, err)
+			}
+			if err == nil {
+				_ = StringWithDialect(stmt, dialect)
+				_ = StatementSource(stmt)
+			}
+		}
+
+		tokens := NewTokenizer(strings.NewReader(sql))
+		for {
+			stmt, err := ParseNext(tokens)
+			if err == io.EOF {
+				break
+			}
+			if err != nil && strings.HasPrefix(err.Error(), "internal error") {
+				t.Fatalf("ParseNext(%q): %v", sql, err)
+			}
+			if err == nil {
+				_ = String(stmt)
+			}
+		}
+	})
+}
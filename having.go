@@ -0,0 +1,80 @@
+package sqlparser
+
+// PushHavingToWhere moves the conditions of the HAVING clause of sel
+// that can be checked on the rows before they are grouped to the WHERE
+// clause, where they filter the rows earlier and can use indexes. It
+// returns true if a condition was moved.
+//
+// A condition of the HAVING clause is moved if it doesn't have
+// aggregates, subqueries, references to select aliases or calls to
+// NonDeterministicFunctions, and its columns are grouped: then it has
+// the same value for all the rows of a group, and filtering the rows
+// is filtering the groups. Without
+// GROUP BY, the conditions are only moved if the select doesn't
+// aggregate, since an aggregate select without GROUP BY returns a row
+// even when WHERE filters all the rows out.
+//
+// Nothing is moved for GROUP BY ... WITH ROLLUP: the super-aggregate
+// rows are computed from the rows that pass WHERE, so filtering the
+// rows earlier changes their values, and HAVING sees NULL for the
+// columns they don't group on.
+func PushHavingToWhere(sel *Select) bool {
+	if sel.Having == nil || sel.WithRollup {
+		return false
+	}
+	if len(sel.GroupBy) == 0 && hasAggregate(sel.SelectExprs) {
+		return false
+	}
+	grouped, errs := resolveGroupBy(sel)
+	if len(errs) != 0 {
+		return false
+	}
+
+	var pushed, kept []Expr
+	for _, cond := range splitConjuncts(sel.Having.Expr) {
+		if canPushHaving(sel, cond, grouped) {
+			pushed = append(pushed, cond)
+		} else {
+			kept = append(kept, cond)
+		}
+	}
+	if len(pushed) == 0 {
+		return false
+	}
+	for _, cond := range pushed {
+		sel.AddWhere(cond)
+	}
+	sel.Having = nil
+	for _, cond := range kept {
+		sel.AddHaving(cond)
+	}
+	return true
+}
+
+// canPushHaving returns true if cond, a condition of the HAVING clause
+// of sel, can be moved to its WHERE clause. grouped are the grouping
+// expressions of sel, see resolveGroupBy.
+func canPushHaving(sel *Select, cond Expr, grouped []Expr) bool {
+	if containsSubquery(cond) || hasAggregate(cond) {
+		return false
+	}
+	pushed := true
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName:
+			if node.Qualifier.IsEmpty() && findSelectAlias(sel.SelectExprs, node.Name) != nil {
+				pushed = false
+			}
+		case *FuncExpr:
+			// rand() > 0.5 would filter each row instead of each group.
+			if node.Qualifier.IsEmpty() && NonDeterministicFunctions[node.Name.Lowered()] {
+				pushed = false
+			}
+		}
+		return pushed, nil
+	}, cond)
+	if !pushed || len(sel.GroupBy) == 0 {
+		return pushed
+	}
+	return len(ungroupedColumns(cond, grouped)) == 0
+}
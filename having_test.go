@@ -0,0 +1,76 @@
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestPushHavingToWhere(t *testing.T) {
+	testcases := []struct {
+		in     string
+		out    string
+		pushed bool
+	}{{
+		in:     "select a, count(*) from t group by a having a > 1",
+		out:    "select a, count(*) from t where a > 1 group by a",
+		pushed: true,
+	}, {
+		in:     "select a, count(*) from t where b = 2 group by a having a > 1 and count(*) > 3",
+		out:    "select a, count(*) from t where b = 2 and a > 1 group by a having count(*) > 3",
+		pushed: true,
+	}, {
+		in:     "select a, count(*) as c from t group by a having c > 3 and (a = 1 or a = 2)",
+		out:    "select a, count(*) as c from t where (a = 1 or a = 2) group by a having c > 3",
+		pushed: true,
+	}, {
+		in:  "select a, count(*) as c from t group by a having c > 3",
+		out: "select a, count(*) as c from t group by a having c > 3",
+	}, {
+		in:  "select a + 1 as x, count(*) from t group by a having x > 3",
+		out: "select a + 1 as x, count(*) from t group by a having x > 3",
+	}, {
+		in:  "select a, count(*) from t group by a having b > 1",
+		out: "select a, count(*) from t group by a having b > 1",
+	}, {
+		in:     "select a + 1, count(*) from t group by 1 having a + 1 > 2",
+		out:    "select a + 1, count(*) from t where a + 1 > 2 group by 1",
+		pushed: true,
+	}, {
+		in:     "select a from t group by a having rand() > 0.5 and a > 1",
+		out:    "select a from t where a > 1 group by a having rand() > 0.5",
+		pushed: true,
+	}, {
+		in:  "select a from t group by a having a < now()",
+		out: "select a from t group by a having a < now()",
+	}, {
+		in:  "select a, count(*) from t group by a having a in (select b from u)",
+		out: "select a, count(*) from t group by a having a in (select b from u)",
+	}, {
+		in:  "select a, b, count(*) from t group by a, b with rollup having a > 1",
+		out: "select a, b, count(*) from t group by a, b with rollup having a > 1",
+	}, {
+		in:  "select count(*) from t having 1 = 0",
+		out: "select count(*) from t having 1 = 0",
+	}, {
+		in:     "select a, b as c from t having a > 1 and c > 2",
+		out:    "select a, b as c from t where a > 1 having c > 2",
+		pushed: true,
+	}, {
+		in:  "select a from t",
+		out: "select a from t",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		sel := stmt.(*Select)
+		pushed := PushHavingToWhere(sel)
+		if pushed != tcase.pushed {
+			t.Errorf("PushHavingToWhere(%q): %v, want %v", tcase.in, pushed, tcase.pushed)
+		}
+		if out := String(sel); out != tcase.out {
+			t.Errorf("PushHavingToWhere(%q): %q, want %q", tcase.in, out, tcase.out)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IndexDefKind is the kind of an IndexDef.
+type IndexDefKind int
+
+// These are the possible IndexDefKind values.
+const (
+	IndexDefIndex = IndexDefKind(iota + 1)
+	IndexDefUnique
+	IndexDefPrimary
+	IndexDefFulltext
+	IndexDefSpatial
+	// IndexDefForeignKey is the constraint of a FOREIGN KEY, and
+	// IndexDefForeignKeyIndex the index that backs it. They are named
+	// separately: a foreign key has an IndexDef of each kind.
+	IndexDefForeignKey
+	IndexDefForeignKeyIndex
+	// IndexDefRename is the RENAME INDEX of an ALTER TABLE.
+	IndexDefRename
+)
+
+// IndexDef is an index or a constraint that a CREATE TABLE or an ALTER
+// TABLE defines, see ListIndexDefinitions.
+type IndexDef struct {
+	Kind  IndexDefKind
+	Table TableName
+	// Name is the name of the index or the constraint, or empty if
+	// the statement doesn't name it, like for the UNIQUE of a column.
+	// It's the new name of an IndexDefRename, and OldName the name it
+	// replaces.
+	Name    string
+	OldName string
+	// Columns are the columns of the index or of the foreign key, or
+	// the expression in parentheses of a functional key part, and
+	// ReferencedTable and ReferencedColumns the ones a foreign key
+	// references.
+	Columns           []string
+	ReferencedTable   TableName
+	ReferencedColumns []string
+	// Inline is true for the key of a column definition, like
+	// a int unique.
+	Inline bool
+	// Node is the node of the AST that defines it: an
+	// *IndexDefinition, a *ForeignKeyDefinition, the
+	// *ColumnDefinition of an inline key, or the *AlterSpec of a
+	// RENAME INDEX.
+	Node SQLNode
+}
+
+// indexDefEntry is an IndexDef, and the function that renames it in
+// the statement.
+type indexDefEntry struct {
+	def    IndexDef
+	rename func(name ColIdent)
+}
+
+// ListIndexDefinitions returns the indexes and the constraints that
+// ddl defines, in the order they're written: the ones of a CREATE
+// TABLE, and the ones that an ALTER TABLE adds or renames. The indexes
+// that are dropped are not returned.
+func ListIndexDefinitions(ddl *DDL) []IndexDef {
+	entries := indexDefEntries(ddl)
+	if entries == nil {
+		return nil
+	}
+	defs := make([]IndexDef, len(entries))
+	for i, entry := range entries {
+		defs[i] = entry.def
+	}
+	return defs
+}
+
+// RenameIndexes renames the indexes and the constraints of ddl that
+// ListIndexDefinitions returns to the names that mapper returns for
+// them. A name that's empty, or that's the same as the current one,
+// keeps the current name. The current name of an inline key, which the
+// statement doesn't name, is the one of its column, which MySQL names
+// it after.
+//
+// An inline key of a column that's given another name is made into an
+// index of the table, since a column can't name its key: the inline
+// form is not preserved. The indexes keep the order of their definitions, so
+// the ones made from the keys of the columns of a CREATE TABLE come
+// before its other indexes, in the order of the columns. New names
+// that are already used in the statement, by the indexes or by the
+// foreign key constraints, get a suffix: idx_a, idx_a_2, ... The
+// primary key can't be renamed.
+func RenameIndexes(ddl *DDL, mapper func(IndexDef) string) error {
+	if ddl == nil || ddl.Action != CreateStr && ddl.Action != AlterStr {
+		return errors.New("cannot rename the indexes of a statement that is not a create table or an alter table")
+	}
+	if ddl.Action == CreateStr && ddl.TableSpec == nil {
+		return errors.New("cannot rename the indexes of a create table without columns")
+	}
+	if ddl.Action == AlterStr && ddl.AlterSpecs == nil {
+		return errors.New("cannot rename the indexes of an alter table whose alterations were not parsed")
+	}
+	entries := indexDefEntries(ddl)
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		name := mapper(entry.def)
+		if strings.EqualFold(name, entry.currentName()) {
+			name = ""
+		}
+		if name != "" && entry.def.Kind == IndexDefPrimary {
+			return fmt.Errorf("cannot rename the primary key to %s", name)
+		}
+		names[i] = name
+	}
+
+	// The names that are kept are used first.
+	used := map[bool]map[string]bool{false: {}, true: {}}
+	for i, entry := range entries {
+		if current := entry.currentName(); names[i] == "" && current != "" {
+			used[entry.def.Kind == IndexDefForeignKey][strings.ToLower(current)] = true
+		}
+	}
+	for i, entry := range entries {
+		if names[i] == "" {
+			continue
+		}
+		namespace := used[entry.def.Kind == IndexDefForeignKey]
+		name := names[i]
+		for n := 2; namespace[strings.ToLower(name)]; n++ {
+			name = fmt.Sprintf("%s_%d", names[i], n)
+		}
+		namespace[strings.ToLower(name)] = true
+		entry.rename(NewColIdent(name))
+	}
+	return nil
+}
+
+// currentName returns the name of the index or the constraint: the
+// name of its column for an inline key other than the primary key.
+func (entry indexDefEntry) currentName() string {
+	if entry.def.Inline && entry.def.Name == "" {
+		return entry.def.Columns[0]
+	}
+	return entry.def.Name
+}
+
+// indexDefEntries returns the entries of the indexes and the
+// constraints of ddl.
+func indexDefEntries(ddl *DDL) []indexDefEntry {
+	if ddl == nil {
+		return nil
+	}
+	var entries []indexDefEntry
+	switch {
+	case ddl.Action == CreateStr && ddl.TableSpec != nil:
+		spec := ddl.TableSpec
+		// inlined is the number of indexes made from the keys of
+		// the columns, which are renamed in the order of the columns.
+		inlined := 0
+		for _, col := range spec.Columns {
+			col := col
+			entries = appendInlineKey(entries, ddl.NewName, col, func(index *IndexDefinition) {
+				spec.Indexes = append(spec.Indexes[:inlined:inlined], append([]*IndexDefinition{index}, spec.Indexes[inlined:]...)...)
+				inlined++
+			})
+		}
+		for _, index := range spec.Indexes {
+			entries = append(entries, indexEntry(ddl.NewName, index))
+		}
+		for _, fk := range spec.ForeignKeys {
+			entries = append(entries, foreignKeyEntries(ddl.NewName, fk)...)
+		}
+	case ddl.Action == AlterStr:
+		for _, spec := range ddl.AlterSpecs {
+			spec := spec
+			switch spec.Action {
+			case AddColumnStr:
+				entries = appendInlineKey(entries, ddl.Table, spec.Column, func(index *IndexDefinition) {
+					ddl.insertAlterSpec(spec, &AlterSpec{Action: AddIndexStr, Index: index})
+				})
+			case AddIndexStr:
+				entries = append(entries, indexEntry(ddl.Table, spec.Index))
+			case AddForeignKeyStr:
+				entries = append(entries, foreignKeyEntries(ddl.Table, spec.ForeignKey)...)
+			case RenameIndexStr:
+				entries = append(entries, indexDefEntry{
+					def: IndexDef{
+						Kind:    IndexDefRename,
+						Table:   ddl.Table,
+						Name:    spec.NewName.String(),
+						OldName: spec.Name.String(),
+						Node:    spec,
+					},
+					rename: func(name ColIdent) { spec.NewName = name },
+				})
+			}
+		}
+	}
+	return entries
+}
+
+// insertAlterSpec inserts spec after the alteration after.
+func (node *DDL) insertAlterSpec(after, spec *AlterSpec) {
+	for i, s := range node.AlterSpecs {
+		if s == after {
+			node.AlterSpecs = append(node.AlterSpecs[:i+1], append([]*AlterSpec{spec}, node.AlterSpecs[i+1:]...)...)
+			return
+		}
+	}
+}
+
+// appendInlineKey appends the entry of the key of col to entries, if it
+// has one. Renaming a UNIQUE or a SPATIAL KEY of a column removes it
+// from the column, and passes the index that replaces it to add.
+func appendInlineKey(entries []indexDefEntry, table TableName, col *ColumnDefinition, add func(*IndexDefinition)) []indexDefEntry {
+	def := IndexDef{
+		Table:   table,
+		Columns: []string{col.Name.String()},
+		Inline:  true,
+		Node:    col,
+	}
+	info := &IndexInfo{}
+	switch col.Type.KeyOpt {
+	case colKeyPrimary, colKey:
+		def.Kind, def.Name = IndexDefPrimary, "PRIMARY"
+		return append(entries, indexDefEntry{def: def})
+	case colKeyUnique, colKeyUniqueKey:
+		def.Kind = IndexDefUnique
+		info.Type, info.Unique = "unique key", true
+	case colKeySpatialKey:
+		def.Kind = IndexDefSpatial
+		info.Type, info.Spatial = "spatial key", true
+	default:
+		return entries
+	}
+	return append(entries, indexDefEntry{
+		def: def,
+		rename: func(name ColIdent) {
+			col.Type.KeyOpt = colKeyNone
+			info.Name = name
+			add(&IndexDefinition{Info: info, Columns: []*IndexColumn{{Column: col.Name}}})
+		},
+	})
+}
+
+// indexEntry returns the entry of the index of table.
+func indexEntry(table TableName, index *IndexDefinition) indexDefEntry {
+	def := IndexDef{
+		Kind:  IndexDefIndex,
+		Table: table,
+		Name:  index.Info.Name.String(),
+		Node:  index,
+	}
+	switch {
+	case index.Info.Primary:
+		def.Kind = IndexDefPrimary
+	case index.Info.Unique:
+		def.Kind = IndexDefUnique
+	case index.Info.Fulltext:
+		def.Kind = IndexDefFulltext
+	case index.Info.Spatial:
+		def.Kind = IndexDefSpatial
+	}
+	for _, col := range index.Columns {
+		if col.Expr != nil {
+			def.Columns = append(def.Columns, "("+String(col.Expr)+")")
+			continue
+		}
+		def.Columns = append(def.Columns, col.Column.String())
+	}
+	return indexDefEntry{
+		def:    def,
+		rename: func(name ColIdent) { index.Info.Name = name },
+	}
+}
+
+// foreignKeyEntries returns the entries of the constraint of fk, a
+// foreign key of table, and of its index.
+func foreignKeyEntries(table TableName, fk *ForeignKeyDefinition) []indexDefEntry {
+	def := IndexDef{
+		Kind:            IndexDefForeignKey,
+		Table:           table,
+		Name:            fk.Name.String(),
+		ReferencedTable: fk.ReferencedTable,
+		Node:            fk,
+	}
+	for _, col := range fk.Source {
+		def.Columns = append(def.Columns, col.String())
+	}
+	for _, col := range fk.ReferencedColumns {
+		def.ReferencedColumns = append(def.ReferencedColumns, col.String())
+	}
+	indexDef := def
+	indexDef.Kind, indexDef.Name = IndexDefForeignKeyIndex, fk.IndexName.String()
+	return []indexDefEntry{{
+		def:    def,
+		rename: func(name ColIdent) { fk.Name = name },
+	}, {
+		def:    indexDef,
+		rename: func(name ColIdent) { fk.IndexName = name },
+	}}
+}
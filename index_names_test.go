@@ -0,0 +1,175 @@
+package sqlparser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestListIndexDefinitions(t *testing.T) {
+	kinds := map[IndexDefKind]string{
+		IndexDefIndex:           "index",
+		IndexDefUnique:          "unique",
+		IndexDefPrimary:         "primary",
+		IndexDefFulltext:        "fulltext",
+		IndexDefSpatial:         "spatial",
+		IndexDefForeignKey:      "foreign key",
+		IndexDefForeignKeyIndex: "foreign key index",
+		IndexDefRename:          "rename",
+	}
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in: "create table t (id int key, a int unique, b int, key (b), fulltext key ft (a), constraint fk_b foreign key (b) references u (id))",
+		out: []string{
+			"primary t PRIMARY inline (id)",
+			"unique t  inline (a)",
+			"index t  (b)",
+			"fulltext t ft (a)",
+			"foreign key t fk_b (b) u (id)",
+			"foreign key index t  (b) u (id)",
+		},
+	}, {
+		in: "alter table db.t add column c int unique, add constraint uq unique (a, b), add foreign key b_idx (b) references u (id), rename index x to y, drop index z",
+		out: []string{
+			"unique db.t  inline (c)",
+			"unique db.t uq (a, b)",
+			"foreign key db.t  (b) u (id)",
+			"foreign key index db.t b_idx (b) u (id)",
+			"rename db.t y from x",
+		},
+	}, {
+		in:  "create index idx on t (a, (cast(data->'$.ids' as unsigned array)))",
+		out: []string{"index t idx (a, (cast(data -> '$.ids' as unsigned array)))"},
+	}, {
+		in: "drop table t",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		var out []string
+		for _, def := range ListIndexDefinitions(stmt.(*DDL)) {
+			s := kinds[def.Kind] + " " + String(def.Table) + " " + def.Name
+			if def.Inline {
+				s += " inline"
+			}
+			if def.Columns != nil {
+				s += " (" + strings.Join(def.Columns, ", ") + ")"
+			}
+			if def.ReferencedColumns != nil {
+				s += " " + String(def.ReferencedTable) + " (" + strings.Join(def.ReferencedColumns, ", ") + ")"
+			}
+			if def.OldName != "" {
+				s += " from " + def.OldName
+			}
+			out = append(out, s)
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("ListIndexDefinitions(%s):\n%q, want\n%q", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestRenameIndexes(t *testing.T) {
+	// The naming convention: idx_<table>_<cols>, uq_<table>_<cols> and
+	// fk_<table>_<ref>. The primary key and the renamed indexes keep
+	// their names.
+	convention := func(def IndexDef) string {
+		table := def.Table.Name.String()
+		switch def.Kind {
+		case IndexDefIndex, IndexDefForeignKeyIndex:
+			return "idx_" + table + "_" + strings.Join(def.Columns, "_")
+		case IndexDefUnique:
+			return "uq_" + table + "_" + strings.Join(def.Columns, "_")
+		case IndexDefForeignKey:
+			return "fk_" + table + "_" + def.ReferencedTable.Name.String()
+		}
+		return ""
+	}
+	testcases := []struct {
+		in     string
+		mapper func(IndexDef) string
+		out    string
+	}{{
+		in:  "create table t (id int primary key, a int unique, b int, c text, key (b), fulltext key idx_t_b (c), unique key (a), foreign key (b) references u (id), constraint x foreign key (a) references u (id))",
+		out: "create table t (\n\tid int primary key,\n\ta int,\n\tb int,\n\tc text,\n\tunique key uq_t_a (a),\n\tkey idx_t_b_2 (b),\n\tfulltext key idx_t_b (c),\n\tunique key uq_t_a_2 (a),\n\tconstraint fk_t_u foreign key idx_t_b_3 (b) references u (id),\n\tconstraint fk_t_u_2 foreign key idx_t_a (a) references u (id)\n)",
+	}, {
+		// The keys of the columns keep their place before the
+		// other indexes.
+		in:  "create table t (a int unique, b int unique, c int, unique key (c))",
+		out: "create table t (\n\ta int,\n\tb int,\n\tc int,\n\tunique key uq_t_a (a),\n\tunique key uq_t_b (b),\n\tunique key uq_t_c (c)\n)",
+	}, {
+		in:  "alter table t add column c int unique first, add key (c), rename index old to new, add constraint fk foreign key (c) references u (id)",
+		out: "alter table t add column c int first, add unique key uq_t_c (c), add key idx_t_c (c), rename index old to new, add constraint fk_t_u foreign key idx_t_c_2 (c) references u (id)",
+	}, {
+		// An inline key that keeps the name of its column stays
+		// inline, and the name is taken.
+		in:     "create table t (a int unique, b int, key (b))",
+		mapper: func(def IndexDef) string { return def.Columns[0] },
+		out:    "create table t (\n\ta int unique,\n\tb int,\n\tkey b (b)\n)",
+	}, {
+		in:     "create table t (a int unique, b int, key (b))",
+		mapper: func(IndexDef) string { return "a" },
+		out:    "create table t (\n\ta int unique,\n\tb int,\n\tkey a_2 (b)\n)",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if tcase.mapper == nil {
+			tcase.mapper = convention
+		}
+		if err := RenameIndexes(stmt.(*DDL), tcase.mapper); err != nil {
+			t.Errorf("RenameIndexes(%s): %v", tcase.in, err)
+			continue
+		}
+		got := String(stmt)
+		if got != tcase.out {
+			t.Errorf("RenameIndexes(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+		reparsed, err := Parse(got)
+		if err != nil {
+			t.Errorf("RenameIndexes(%s): %s: %v", tcase.in, got, err)
+			continue
+		}
+		if String(reparsed) != got {
+			t.Errorf("RenameIndexes(%s) doesn't round-trip: %s", tcase.in, String(reparsed))
+		}
+	}
+
+	errcases := []struct {
+		in     string
+		mapper func(IndexDef) string
+		err    string
+	}{{
+		in:     "create table t (id int primary key)",
+		mapper: func(IndexDef) string { return "pk" },
+		err:    "cannot rename the primary key to pk",
+	}, {
+		in:  "drop table t",
+		err: "cannot rename the indexes of a statement that is not a create table or an alter table",
+	}, {
+		in:  "alter table t partition by hash (id)",
+		err: "cannot rename the indexes of an alter table whose alterations were not parsed",
+	}}
+	for _, tcase := range errcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if tcase.mapper == nil {
+			tcase.mapper = convention
+		}
+		err = RenameIndexes(stmt.(*DDL), tcase.mapper)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("RenameIndexes(%s): %v, want %s", tcase.in, err, tcase.err)
+		}
+	}
+}
@@ -0,0 +1,679 @@
+package sqlparser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+// UnknownType is the type InferType returns for the expressions whose
+// type it can't tell, like the columns that are not in the schema, the
+// unknown functions and the bind variables.
+const UnknownType = sqltypes.Expression
+
+// Schema holds the types of the columns of tables, see InferType,
+// their unique keys, see PaginationInfo, and their other indexes, see
+// ClassifyMigration.
+type Schema struct {
+	// tables maps the names of the tables to their columns,
+	// by lowercased names.
+	tables map[string]map[string]querypb.Type
+	// uniqueKeys maps the names of the tables to the lowercased
+	// columns of their unique keys.
+	uniqueKeys map[string][][]string
+	// indexes maps the names of the tables to the lowercased columns
+	// of their indexes that are not unique.
+	indexes map[string][][]string
+}
+
+// NewSchema returns an empty schema.
+func NewSchema() *Schema {
+	return &Schema{tables: make(map[string]map[string]querypb.Type)}
+}
+
+// AddColumn sets the type of the column of table.
+func (s *Schema) AddColumn(table, column string, typ querypb.Type) {
+	columns := s.tables[table]
+	if columns == nil {
+		columns = make(map[string]querypb.Type)
+		s.tables[table] = columns
+	}
+	columns[strings.ToLower(column)] = typ
+}
+
+// AddUniqueKey adds a unique key of table, made of columns.
+func (s *Schema) AddUniqueKey(table string, columns ...string) {
+	if s.uniqueKeys == nil {
+		s.uniqueKeys = make(map[string][][]string)
+	}
+	key := make([]string, len(columns))
+	for i, col := range columns {
+		key[i] = strings.ToLower(col)
+	}
+	s.uniqueKeys[table] = append(s.uniqueKeys[table], key)
+}
+
+// AddIndex adds an index of table that is not unique, made of columns.
+func (s *Schema) AddIndex(table string, columns ...string) {
+	if s.indexes == nil {
+		s.indexes = make(map[string][][]string)
+	}
+	index := make([]string, len(columns))
+	for i, col := range columns {
+		index[i] = strings.ToLower(col)
+	}
+	s.indexes[table] = append(s.indexes[table], index)
+}
+
+// AddTable adds the columns of the CREATE TABLE spec of table, and its
+// indexes.
+func (s *Schema) AddTable(table string, spec *TableSpec) {
+	for _, col := range spec.Columns {
+		s.AddColumn(table, col.Name.String(), col.Type.SQLType())
+		// The KEY of a column is its PRIMARY KEY.
+		switch col.Type.KeyOpt {
+		case colKeyPrimary, colKey, colKeyUnique, colKeyUniqueKey:
+			s.AddUniqueKey(table, col.Name.String())
+		case colKeySpatialKey:
+			s.AddIndex(table, col.Name.String())
+		}
+	}
+	for _, index := range spec.Indexes {
+		columns := make([]string, len(index.Columns))
+		for i, col := range index.Columns {
+			columns[i] = col.Column.String()
+		}
+		if index.Info.Primary || index.Info.Unique {
+			s.AddUniqueKey(table, columns...)
+		} else {
+			s.AddIndex(table, columns...)
+		}
+	}
+}
+
+// isIndexed returns true if column is a column of an index of table,
+// unique or not.
+func (s *Schema) isIndexed(table, column string) bool {
+	if s == nil {
+		return false
+	}
+	column = strings.ToLower(column)
+	for _, keys := range [][][]string{s.uniqueKeys[table], s.indexes[table]} {
+		for _, key := range keys {
+			for _, col := range key {
+				if col == column {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ColumnType returns the type of col, and false if it's unknown. An
+// unqualified column is looked up in all the tables, and is unknown
+// if they don't agree on its type. The qualifier of the table, if
+// any, is ignored: tables are named by their name alone.
+func (s *Schema) ColumnType(col *ColName) (querypb.Type, bool) {
+	if s == nil {
+		return UnknownType, false
+	}
+	name := col.Name.Lowered()
+	if !col.Qualifier.IsEmpty() {
+		typ, ok := s.tables[col.Qualifier.Name.String()][name]
+		return typ, ok
+	}
+	found := false
+	var typ querypb.Type
+	for _, columns := range s.tables {
+		if colType, ok := columns[name]; ok {
+			if found && colType != typ {
+				return UnknownType, false
+			}
+			typ, found = colType, true
+		}
+	}
+	return typ, found
+}
+
+// columns returns the names of the columns of table, sorted, or nil if
+// the table is unknown. Like for ColumnType, the qualifier of the table
+// is ignored.
+func (s *Schema) columns(table TableName) []string {
+	if s == nil {
+		return nil
+	}
+	columns, ok := s.tables[table.Name.String()]
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(columns))
+	for name := range columns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FunctionTypes are the return types of functions, by lowercased name,
+// for InferType. The functions whose type depends on their arguments,
+// like MIN or COALESCE, are not in the map. Callers can add to it.
+var FunctionTypes = map[string]querypb.Type{
+	"bit_length":        sqltypes.Int64,
+	"char_length":       sqltypes.Int64,
+	"character_length":  sqltypes.Int64,
+	"concat":            sqltypes.VarChar,
+	"concat_ws":         sqltypes.VarChar,
+	"count":             sqltypes.Int64,
+	"curdate":           sqltypes.Date,
+	"current_date":      sqltypes.Date,
+	"current_time":      sqltypes.Time,
+	"current_timestamp": sqltypes.Datetime,
+	"curtime":           sqltypes.Time,
+	"date":              sqltypes.Date,
+	"date_format":       sqltypes.VarChar,
+	"datediff":          sqltypes.Int64,
+	"day":               sqltypes.Int64,
+	"dayofmonth":        sqltypes.Int64,
+	"dayofweek":         sqltypes.Int64,
+	"dayofyear":         sqltypes.Int64,
+	"from_unixtime":     sqltypes.Datetime,
+	"hex":               sqltypes.VarChar,
+	"hour":              sqltypes.Int64,
+	"instr":             sqltypes.Int64,
+	"json_extract":      sqltypes.TypeJSON,
+	"json_object":       sqltypes.TypeJSON,
+	"json_array":        sqltypes.TypeJSON,
+	"last_insert_id":    sqltypes.Uint64,
+	"lcase":             sqltypes.VarChar,
+	"left":              sqltypes.VarChar,
+	"length":            sqltypes.Int64,
+	"localtime":         sqltypes.Datetime,
+	"localtimestamp":    sqltypes.Datetime,
+	"locate":            sqltypes.Int64,
+	"lower":             sqltypes.VarChar,
+	"lpad":              sqltypes.VarChar,
+	"ltrim":             sqltypes.VarChar,
+	"md5":               sqltypes.VarChar,
+	"minute":            sqltypes.Int64,
+	"month":             sqltypes.Int64,
+	"now":               sqltypes.Datetime,
+	"rand":              sqltypes.Float64,
+	"repeat":            sqltypes.VarChar,
+	"replace":           sqltypes.VarChar,
+	"reverse":           sqltypes.VarChar,
+	"right":             sqltypes.VarChar,
+	"rpad":              sqltypes.VarChar,
+	"rtrim":             sqltypes.VarChar,
+	"second":            sqltypes.Int64,
+	"sha1":              sqltypes.VarChar,
+	"sha2":              sqltypes.VarChar,
+	"sqrt":              sqltypes.Float64,
+	"str_to_date":       sqltypes.Datetime,
+	"substring_index":   sqltypes.VarChar,
+	"sysdate":           sqltypes.Datetime,
+	"time":              sqltypes.Time,
+	"timestampdiff":     sqltypes.Int64,
+	"to_days":           sqltypes.Int64,
+	"ucase":             sqltypes.VarChar,
+	"unhex":             sqltypes.VarBinary,
+	"unix_timestamp":    sqltypes.Int64,
+	"upper":             sqltypes.VarChar,
+	"utc_date":          sqltypes.Date,
+	"utc_time":          sqltypes.Time,
+	"utc_timestamp":     sqltypes.Datetime,
+	"uuid":              sqltypes.VarChar,
+	"week":              sqltypes.Int64,
+	"year":              sqltypes.Int64,
+}
+
+// argTypeFunctions are the functions whose type is the type that
+// unites the ones of their arguments, see unionTypes.
+var argTypeFunctions = map[string]bool{
+	"coalesce": true,
+	"greatest": true,
+	"ifnull":   true,
+	"least":    true,
+	"max":      true,
+	"min":      true,
+}
+
+// InferType returns the type of the result of expr, the way MySQL
+// types it:
+//   - columns have their type in schema, which may be nil;
+//   - literals have the type of their value, and NULL is sqltypes.Null;
+//   - arithmetic on integers is an integer, unsigned if an operand
+//     is, except for /, which gives a decimal like on decimals, and
+//     floats or strings turn it into a float;
+//   - functions have their type in FunctionTypes, or the one of their
+//     arguments, like MIN or COALESCE, and casts have their target
+//     type;
+//   - CASE, IF and the like unite the types of their results;
+//   - comparisons and logical operators are integers;
+//   - an operator or comparison with a NULL operand is NULL.
+//
+// InferType returns UnknownType for the expressions it can't type, like
+// unknown columns and functions, or bind variables. See InferTypeStrict
+// for an error instead.
+func InferType(expr Expr, schema *Schema) (querypb.Type, error) {
+	return (&typeInferrer{schema: schema}).infer(expr)
+}
+
+// InferTypeStrict is the same as InferType, except that it returns
+// an error for the unknown columns and functions. An expression that
+// is unknown for another reason, like a bind variable, is not an error.
+func InferTypeStrict(expr Expr, schema *Schema) (querypb.Type, error) {
+	return (&typeInferrer{schema: schema, strict: true}).infer(expr)
+}
+
+type typeInferrer struct {
+	schema *Schema
+	strict bool
+}
+
+func (ti *typeInferrer) infer(expr Expr) (querypb.Type, error) {
+	switch expr := expr.(type) {
+	case *SQLVal:
+		return sqlValType(expr), nil
+	case *NullVal:
+		return sqltypes.Null, nil
+	case BoolVal, *EmptyInExpr, *NextValueExpr:
+		return sqltypes.Int64, nil
+	case *ColName:
+		return ti.column(expr)
+	case *ValuesFuncExpr:
+		return ti.column(expr.Name)
+	case *Default:
+		if expr.Name == nil {
+			return UnknownType, nil
+		}
+		return ti.column(expr.Name)
+	case *ParenExpr:
+		return ti.infer(expr.Expr)
+	case *CollateExpr:
+		return ti.infer(expr.Expr)
+	case *AndExpr:
+		return ti.logical(expr.Left, expr.Right)
+	case *OrExpr:
+		return ti.logical(expr.Left, expr.Right)
+	case *NotExpr:
+		return ti.logical(expr.Expr)
+	case *ComparisonExpr:
+		return ti.logical(expr.Left, expr.Right)
+	case *RangeCond:
+		return ti.logical(expr.Left, expr.From, expr.To)
+	case *IsExpr:
+		if _, err := ti.infer(expr.Expr); err != nil {
+			return UnknownType, err
+		}
+		return sqltypes.Int64, nil
+	case *ExistsExpr:
+		return sqltypes.Int64, nil
+	case *MatchExpr:
+		return sqltypes.Float64, nil
+	case *UnaryExpr:
+		return ti.unary(expr)
+	case *BinaryExpr:
+		return ti.binary(expr)
+	case *FuncExpr:
+		return ti.function(expr)
+	case *CaseExpr:
+		types := make([]querypb.Type, 0, len(expr.Whens)+1)
+		for _, when := range expr.Whens {
+			if _, err := ti.infer(when.Cond); err != nil {
+				return UnknownType, err
+			}
+			typ, err := ti.infer(when.Val)
+			if err != nil {
+				return UnknownType, err
+			}
+			types = append(types, typ)
+		}
+		if expr.Else != nil {
+			typ, err := ti.infer(expr.Else)
+			if err != nil {
+				return UnknownType, err
+			}
+			types = append(types, typ)
+		}
+		return unionTypes(types...), nil
+	case *ConvertExpr:
+		if _, err := ti.infer(expr.Expr); err != nil {
+			return UnknownType, err
+		}
+		return convertType(expr.Type), nil
+	case *ConvertUsingExpr, *SubstrExpr, *TrimExpr, *GroupConcatExpr:
+		return sqltypes.VarChar, nil
+	case *WeightStringExpr:
+		return sqltypes.VarBinary, nil
+	case *ExtractExpr, *PositionExpr:
+		return sqltypes.Int64, nil
+	case *Subquery:
+		// The type of a scalar subquery is the one of its column.
+		if sel, ok := expr.Select.(*Select); ok && len(sel.SelectExprs) == 1 {
+			if aliased, ok := sel.SelectExprs[0].(*AliasedExpr); ok {
+				return ti.infer(aliased.Expr)
+			}
+		}
+	}
+	return UnknownType, nil
+}
+
+func (ti *typeInferrer) column(col *ColName) (querypb.Type, error) {
+	if typ, ok := ti.schema.ColumnType(col); ok {
+		return typ, nil
+	}
+	if ti.strict {
+		return UnknownType, fmt.Errorf("unknown column %s", String(col))
+	}
+	return UnknownType, nil
+}
+
+// logical returns the type of a logical operator or comparison of
+// operands: an integer, or NULL if one of them is NULL.
+func (ti *typeInferrer) logical(operands ...Expr) (querypb.Type, error) {
+	null := false
+	for _, operand := range operands {
+		if _, ok := operand.(ValTuple); ok {
+			continue
+		}
+		typ, err := ti.infer(operand)
+		if err != nil {
+			return UnknownType, err
+		}
+		null = null || typ == sqltypes.Null
+	}
+	if null {
+		return sqltypes.Null, nil
+	}
+	return sqltypes.Int64, nil
+}
+
+func (ti *typeInferrer) unary(expr *UnaryExpr) (querypb.Type, error) {
+	typ, err := ti.infer(expr.Expr)
+	if err != nil || typ == sqltypes.Null || typ == UnknownType {
+		return typ, err
+	}
+	switch expr.Operator {
+	case UMinusStr:
+		if sqltypes.IsIntegral(typ) {
+			return sqltypes.Int64, nil
+		}
+		return numericType(typ), nil
+	case UPlusStr:
+		return typ, nil
+	case TildaStr:
+		return sqltypes.Uint64, nil
+	case BangStr:
+		return sqltypes.Int64, nil
+	case BinaryStr, UBinaryStr:
+		return sqltypes.VarBinary, nil
+	}
+	return UnknownType, nil
+}
+
+func (ti *typeInferrer) binary(expr *BinaryExpr) (querypb.Type, error) {
+	left, err := ti.infer(expr.Left)
+	if err != nil {
+		return UnknownType, err
+	}
+	right, err := ti.infer(expr.Right)
+	if err != nil {
+		return UnknownType, err
+	}
+	switch {
+	case left == sqltypes.Null || right == sqltypes.Null:
+		return sqltypes.Null, nil
+	case expr.Operator == ConcatStr:
+		if sqltypes.IsBinary(left) || sqltypes.IsBinary(right) {
+			return sqltypes.VarBinary, nil
+		}
+		return sqltypes.VarChar, nil
+	case left == UnknownType || right == UnknownType:
+		return UnknownType, nil
+	}
+	left, right = numericType(left), numericType(right)
+	switch expr.Operator {
+	case BitAndStr, BitOrStr, BitXorStr, ShiftLeftStr, ShiftRightStr:
+		return sqltypes.Uint64, nil
+	case IntDivStr:
+		if sqltypes.IsUnsigned(left) || sqltypes.IsUnsigned(right) {
+			return sqltypes.Uint64, nil
+		}
+		return sqltypes.Int64, nil
+	case DivStr:
+		if sqltypes.IsFloat(left) || sqltypes.IsFloat(right) {
+			return sqltypes.Float64, nil
+		}
+		return sqltypes.Decimal, nil
+	}
+	switch {
+	case sqltypes.IsFloat(left) || sqltypes.IsFloat(right):
+		return sqltypes.Float64, nil
+	case left == sqltypes.Decimal || right == sqltypes.Decimal:
+		return sqltypes.Decimal, nil
+	case sqltypes.IsUnsigned(left) || sqltypes.IsUnsigned(right):
+		return sqltypes.Uint64, nil
+	}
+	return sqltypes.Int64, nil
+}
+
+func (ti *typeInferrer) function(fn *FuncExpr) (querypb.Type, error) {
+	var args []querypb.Type
+	for _, expr := range fn.Exprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			// count(*)
+			args = append(args, UnknownType)
+			continue
+		}
+		typ, err := ti.infer(aliased.Expr)
+		if err != nil {
+			return UnknownType, err
+		}
+		args = append(args, typ)
+	}
+	name := fn.Name.Lowered()
+	if fn.Qualifier.IsEmpty() {
+		if typ, ok := FunctionTypes[name]; ok {
+			return typ, nil
+		}
+		switch {
+		case argTypeFunctions[name]:
+			return unionTypes(args...), nil
+		case name == "if" && len(args) == 3:
+			return unionTypes(args[1:]...), nil
+		case name == "nullif" && len(args) == 2:
+			return args[0], nil
+		case (name == "abs" || name == "sum" || name == "avg") && len(args) == 1:
+			typ := args[0]
+			if typ == sqltypes.Null || typ == UnknownType {
+				return typ, nil
+			}
+			typ = numericType(typ)
+			switch {
+			case name == "abs":
+				return typ, nil
+			case sqltypes.IsFloat(typ):
+				return sqltypes.Float64, nil
+			}
+			return sqltypes.Decimal, nil
+		}
+	}
+	if ti.strict {
+		return UnknownType, fmt.Errorf("unknown function %s", String(fn.Name))
+	}
+	return UnknownType, nil
+}
+
+// sqlValType returns the type of the value of a literal.
+func sqlValType(val *SQLVal) querypb.Type {
+	switch val.Type {
+	case StrVal:
+		return sqltypes.VarChar
+	case IntVal:
+		return sqltypes.Int64
+	case FloatVal:
+		return sqltypes.Float64
+	case DecimalVal:
+		return sqltypes.Decimal
+	case HexNum, HexVal:
+		return sqltypes.VarBinary
+	case BitVal:
+		return sqltypes.Bit
+	case DateVal:
+		return sqltypes.Date
+	case TimeVal:
+		return sqltypes.Time
+	case TimestampVal:
+		return sqltypes.Datetime
+	}
+	return UnknownType
+}
+
+// convertType returns the type of a CAST or CONVERT to typ.
+func convertType(typ *ConvertType) querypb.Type {
+	switch strings.ToLower(typ.Type) {
+	case "binary":
+		return sqltypes.VarBinary
+	case "char", "nchar":
+		return sqltypes.VarChar
+	case "date":
+		return sqltypes.Date
+	case "datetime":
+		return sqltypes.Datetime
+	case "time":
+		return sqltypes.Time
+	case "decimal":
+		return sqltypes.Decimal
+	case "signed":
+		return sqltypes.Int64
+	case "unsigned":
+		return sqltypes.Uint64
+	case "json":
+		return sqltypes.TypeJSON
+	}
+	return UnknownType
+}
+
+// numericType returns the type of typ in arithmetic: strings and
+// temporal types are converted to floats, and the BIT and YEAR types
+// to integers.
+func numericType(typ querypb.Type) querypb.Type {
+	switch {
+	case sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal:
+		return typ
+	case typ == sqltypes.Bit:
+		return sqltypes.Uint64
+	}
+	return sqltypes.Float64
+}
+
+// typeClass is the category of a type, for unionTypes
+// and TypesCompatible.
+type typeClass int
+
+const (
+	numericClass = typeClass(iota + 1)
+	stringClass
+	temporalClass
+)
+
+func classOf(typ querypb.Type) typeClass {
+	switch typ {
+	case sqltypes.Date, sqltypes.Time, sqltypes.Datetime, sqltypes.Timestamp:
+		return temporalClass
+	case sqltypes.Bit, sqltypes.Year, sqltypes.Decimal:
+		return numericClass
+	}
+	if sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) {
+		return numericClass
+	}
+	return stringClass
+}
+
+// unionTypes returns the type that holds the values of all types, like
+// the type of a CASE with results of these types. NULL is ignored: it's
+// the result only if all the types are NULL.
+func unionTypes(types ...querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	for _, typ := range types {
+		switch {
+		case typ == UnknownType:
+			return UnknownType
+		case typ == sqltypes.Null || typ == result:
+			continue
+		case result == sqltypes.Null:
+			result = typ
+			continue
+		}
+		resultClass, class := classOf(result), classOf(typ)
+		switch {
+		case resultClass != class:
+			// Like MySQL, mixed categories are strings.
+			result = sqltypes.VarChar
+		case class == numericClass:
+			left, right := numericType(result), numericType(typ)
+			switch {
+			case sqltypes.IsFloat(left) || sqltypes.IsFloat(right):
+				result = sqltypes.Float64
+			case left == sqltypes.Decimal || right == sqltypes.Decimal:
+				result = sqltypes.Decimal
+			case sqltypes.IsUnsigned(left) && sqltypes.IsUnsigned(right):
+				result = sqltypes.Uint64
+			case sqltypes.IsUnsigned(left) || sqltypes.IsUnsigned(right):
+				result = sqltypes.Decimal
+			default:
+				result = sqltypes.Int64
+			}
+		case class == temporalClass:
+			result = sqltypes.Datetime
+		case sqltypes.IsBinary(result) || sqltypes.IsBinary(typ):
+			result = sqltypes.VarBinary
+		default:
+			result = sqltypes.VarChar
+		}
+	}
+	return result
+}
+
+// TypesCompatible returns true if a value of type valueType compares
+// with an expression of type exprType without changing meaning: both
+// are numbers, or strings, or temporal values, which can also be
+// compared with strings, like '2024-01-01'. Unlike MySQL, a string is
+// not taken to compare with a number, since the conversion from one to
+// the other ignores the trailing characters of the string. NULL and
+// UnknownType are compatible with all types.
+func TypesCompatible(exprType, valueType querypb.Type) bool {
+	if exprType == sqltypes.Null || valueType == sqltypes.Null || exprType == UnknownType || valueType == UnknownType {
+		return true
+	}
+	exprClass, valueClass := classOf(exprType), classOf(valueType)
+	switch {
+	case exprClass == valueClass:
+		return true
+	case exprClass == temporalClass && valueClass == stringClass:
+		return true
+	case exprClass == stringClass && valueClass == temporalClass:
+		return true
+	}
+	return false
+}
+
+// BindVarCompatible returns true if the value of bv compares with an
+// expression of type exprType, see TypesCompatible. All the values of
+// a tuple, for IN, must be.
+func BindVarCompatible(exprType querypb.Type, bv *querypb.BindVariable) bool {
+	if bv.Type != querypb.Type_TUPLE {
+		return TypesCompatible(exprType, bv.Type)
+	}
+	for _, value := range bv.Values {
+		if !TypesCompatible(exprType, value.Type) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func testSchema(t *testing.T) *Schema {
+	ddl, err := Parse("create table t (id bigint unsigned, a int, b varchar(10), c decimal(10, 2), d double, e datetime, f varbinary(10))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := NewSchema()
+	schema.AddTable("t", ddl.(*DDL).TableSpec)
+	schema.AddColumn("u", "id", sqltypes.Int32)
+	schema.AddColumn("u", "g", sqltypes.Date)
+	return schema
+}
+
+func TestInferType(t *testing.T) {
+	schema := testSchema(t)
+	testcases := []struct {
+		in  string
+		out querypb.Type
+		err string
+	}{
+		{in: "1", out: sqltypes.Int64},
+		{in: "1.5", out: sqltypes.Decimal},
+		{in: "1e3", out: sqltypes.Float64},
+		{in: "'x'", out: sqltypes.VarChar},
+		{in: "x'ff'", out: sqltypes.VarBinary},
+		{in: "date '2024-01-01'", out: sqltypes.Date},
+		{in: "null", out: sqltypes.Null},
+		{in: "true", out: sqltypes.Int64},
+		{in: ":v", out: UnknownType},
+		{in: "a", out: sqltypes.Int32},
+		{in: "t.id", out: sqltypes.Uint64},
+		{in: "u.id", out: sqltypes.Int32},
+		// id has a different type in t and u.
+		{in: "id", out: UnknownType, err: "unknown column id"},
+		{in: "g", out: sqltypes.Date},
+		{in: "x", out: UnknownType, err: "unknown column x"},
+		{in: "a + 1", out: sqltypes.Int64},
+		{in: "a + t.id", out: sqltypes.Uint64},
+		{in: "a / 2", out: sqltypes.Decimal},
+		{in: "a div 2", out: sqltypes.Int64},
+		{in: "a * c", out: sqltypes.Decimal},
+		{in: "c - d", out: sqltypes.Float64},
+		{in: "a + b", out: sqltypes.Float64},
+		{in: "-t.id", out: sqltypes.Int64},
+		{in: "a & 1", out: sqltypes.Uint64},
+		{in: "a + null", out: sqltypes.Null},
+		{in: "a + :v", out: UnknownType},
+		{in: "(a + 1) * 2", out: sqltypes.Int64},
+		{in: "a = 1 and b like 'x%'", out: sqltypes.Int64},
+		{in: "a = null", out: sqltypes.Null},
+		{in: "a is null", out: sqltypes.Int64},
+		{in: "a in (1, 2)", out: sqltypes.Int64},
+		{in: "count(*)", out: sqltypes.Int64},
+		{in: "NOW()", out: sqltypes.Datetime},
+		{in: "concat(b, a)", out: sqltypes.VarChar},
+		{in: "max(e)", out: sqltypes.Datetime},
+		{in: "sum(a)", out: sqltypes.Decimal},
+		{in: "avg(d)", out: sqltypes.Float64},
+		{in: "coalesce(null, a, 1)", out: sqltypes.Int64},
+		{in: "ifnull(a, c)", out: sqltypes.Decimal},
+		{in: "if(a > 0, b, 'x')", out: sqltypes.VarChar},
+		{in: "frobnicate(a)", out: UnknownType, err: "unknown function frobnicate"},
+		{in: "case when a > 0 then a when a < 0 then t.id else null end", out: sqltypes.Decimal},
+		{in: "case a when 1 then 'x' else 2 end", out: sqltypes.VarChar},
+		{in: "case when a > 0 then e else g end", out: sqltypes.Datetime},
+		{in: "case when a > 0 then null end", out: sqltypes.Null},
+		{in: "cast(b as signed)", out: sqltypes.Int64},
+		{in: "convert(a, CHAR)", out: sqltypes.VarChar},
+		{in: "b collate utf8_bin", out: sqltypes.VarChar},
+		{in: "(select max(c) from t)", out: sqltypes.Decimal},
+		{in: "exists (select 1 from t)", out: sqltypes.Int64},
+	}
+	for _, tcase := range testcases {
+		stmt, err := Parse("select " + tcase.in + " from t")
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		expr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+		typ, err := InferType(expr, schema)
+		if err != nil || typ != tcase.out {
+			t.Errorf("InferType(%s): %v, %v, want %v", tcase.in, typ, err, tcase.out)
+		}
+		typ, err = InferTypeStrict(expr, schema)
+		var errStr string
+		if err != nil {
+			errStr = err.Error()
+		}
+		if errStr != tcase.err {
+			t.Errorf("InferTypeStrict(%s) err: %q, want %q", tcase.in, errStr, tcase.err)
+		}
+		if err == nil && typ != tcase.out {
+			t.Errorf("InferTypeStrict(%s): %v, want %v", tcase.in, typ, tcase.out)
+		}
+	}
+
+	// Without a schema, columns are unknown.
+	if typ, err := InferType(&ColName{Name: NewColIdent("a")}, nil); typ != UnknownType || err != nil {
+		t.Errorf("InferType(a, nil): %v, %v, want %v", typ, err, UnknownType)
+	}
+}
+
+func TestBindVarCompatible(t *testing.T) {
+	testcases := []struct {
+		expr querypb.Type
+		bv   *querypb.BindVariable
+		out  bool
+	}{
+		{sqltypes.Int32, sqltypes.Int64BindVariable(1), true},
+		{sqltypes.Decimal, sqltypes.Float64BindVariable(1.5), true},
+		{sqltypes.Int32, sqltypes.StringBindVariable("1"), false},
+		{sqltypes.VarChar, sqltypes.Int64BindVariable(1), false},
+		{sqltypes.VarChar, sqltypes.BytesBindVariable([]byte("x")), true},
+		{sqltypes.Datetime, sqltypes.StringBindVariable("2024-01-01"), true},
+		{sqltypes.Date, sqltypes.Int64BindVariable(20240101), false},
+		{sqltypes.Int32, sqltypes.NullBindVariable, true},
+		{UnknownType, sqltypes.StringBindVariable("x"), true},
+		{sqltypes.Int64, sqltypes.TestBindVariable([]interface{}{1, 2}), true},
+		{sqltypes.Int64, sqltypes.TestBindVariable([]interface{}{1, "x"}), false},
+	}
+	for _, tcase := range testcases {
+		if got := BindVarCompatible(tcase.expr, tcase.bv); got != tcase.out {
+			t.Errorf("BindVarCompatible(%v, %v): %v, want %v", tcase.expr, tcase.bv, got, tcase.out)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package sqlparser
+
+import "fmt"
+
+// InlineCTEs returns a copy of stmt in which the references to common
+// table expressions are replaced with derived tables, for engines that
+// don't support the WITH clause. A reference is replaced with the
+// subquery of its common table expression, aliased like the reference
+// or else with the name of the expression, and the WITH clauses are
+// removed. The column names of an expression, like the a and b of
+// t (a, b) AS (SELECT ...), become the aliases of the columns of its
+// select.
+//
+// An expression that is referred to more than once is copied each
+// time, so a subquery that isn't deterministic, like one that calls
+// rand(), can return different rows for each reference. It returns an
+// error for an expression that refers to itself, which can't be
+// inlined, and for column names that can't be given to the columns of
+// the select, like when it selects *. stmt itself is not modified.
+func InlineCTEs(stmt Statement) (Statement, error) {
+	stmt = cloneNode(stmt).(Statement)
+	if err := inlineCTEs(stmt, nil); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// inlineCTEs inlines the common table expressions of node, where the
+// ones of scope, by their names, can be referred to.
+func inlineCTEs(node SQLNode, scope map[string]*CommonTableExpr) error {
+	return Walk(func(node SQLNode) (bool, error) {
+		var with **With
+		switch node := node.(type) {
+		case *Select:
+			with = &node.With
+		case *Union:
+			with = &node.With
+		case *Update:
+			with = &node.With
+		case *Delete:
+			with = &node.With
+		case *AliasedTableExpr:
+			name, ok := node.Expr.(TableName)
+			if !ok || !name.Qualifier.IsEmpty() {
+				return true, nil
+			}
+			cte, ok := scope[name.Name.String()]
+			if !ok {
+				return true, nil
+			}
+			// The subquery of cte is already inlined.
+			node.Expr = cloneNode(cte.Subquery).(*Subquery)
+			if node.As.IsEmpty() {
+				node.As = name.Name
+			}
+			return false, nil
+		}
+		if with == nil || *with == nil {
+			return true, nil
+		}
+		inner := make(map[string]*CommonTableExpr, len(scope)+len((*with).CTEs))
+		for name, cte := range scope {
+			inner[name] = cte
+		}
+		for _, cte := range (*with).CTEs {
+			if (*with).Recursive && refersTo(cte.Subquery, cte.Name) {
+				return false, fmt.Errorf("cannot inline recursive common table expression %s", cte.Name.String())
+			}
+			if err := inlineCTEs(cte.Subquery, inner); err != nil {
+				return false, err
+			}
+			if err := nameCTEColumns(cte); err != nil {
+				return false, err
+			}
+			inner[cte.Name.String()] = cte
+		}
+		*with = nil
+		return false, inlineCTEs(node, inner)
+	}, node)
+}
+
+// refersTo returns true if node refers to the table name without a
+// qualifier.
+func refersTo(node SQLNode, name TableIdent) bool {
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if expr, ok := node.(*AliasedTableExpr); ok {
+			if table, ok := expr.Expr.(TableName); ok && table.Qualifier.IsEmpty() && table.Name == name {
+				found = true
+			}
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+// nameCTEColumns gives the column names of cte, if it has some, to the
+// columns of its select, the first one of a union.
+func nameCTEColumns(cte *CommonTableExpr) error {
+	if len(cte.Columns) == 0 {
+		return nil
+	}
+	sel := cte.Subquery.Select
+	for {
+		switch s := sel.(type) {
+		case *Union:
+			sel = s.Left
+			continue
+		case *ParenSelect:
+			sel = s.Select
+			continue
+		}
+		break
+	}
+	first, ok := sel.(*Select)
+	if !ok || len(first.SelectExprs) != len(cte.Columns) {
+		return fmt.Errorf("cannot name the columns of common table expression %s", cte.Name.String())
+	}
+	for i, expr := range first.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			return fmt.Errorf("cannot name the columns of common table expression %s", cte.Name.String())
+		}
+		aliased.As = cte.Columns[i]
+	}
+	cte.Columns = nil
+	return nil
+}
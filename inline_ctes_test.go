@@ -0,0 +1,84 @@
+package sqlparser
+
+import "testing"
+
+func TestInlineCTEs(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select * from t",
+		out: "select * from t",
+	}, {
+		in:  "with c as (select a from t where b = 1) select * from c",
+		out: "select * from (select a from t where b = 1) as c",
+	}, {
+		in:  "with c as (select a from t) select x.a from c as x join c on x.a = c.a",
+		out: "select x.a from (select a from t) as x join (select a from t) as c on x.a = c.a",
+	}, {
+		// A common table expression can refer to the ones before it.
+		in:  "with a as (select 1 as x), b as (select x from a) select * from b",
+		out: "select * from (select x from (select 1 as x from dual) as a) as b",
+	}, {
+		in:  "with c (x, y) as (select a, b + 1 from t union select 1, 2) select x from c",
+		out: "select x from (select a as x, b + 1 as y from t union select 1, 2 from dual) as c",
+	}, {
+		// db.c is a table, not the common table expression.
+		in:  "with c as (select 1) select * from c, db.c",
+		out: "select * from (select 1 from dual) as c, db.c",
+	}, {
+		in:  "select * from t where a in (with c as (select a from u) select a from c)",
+		out: "select * from t where a in (select a from (select a from u) as c)",
+	}, {
+		// The common table expression of a subquery hides the one
+		// with the same name outside.
+		in:  "with c as (select 1) select * from c where exists (with c as (select 2) select * from c)",
+		out: "select * from (select 1 from dual) as c where exists (select * from (select 2 from dual) as c)",
+	}, {
+		in:  "with c as (select a from t) select a from c union select a from c",
+		out: "select a from (select a from t) as c union select a from (select a from t) as c",
+	}, {
+		in:  "with c as (select a from t) update u join c on u.a = c.a set u.b = 1",
+		out: "update u join (select a from t) as c on u.a = c.a set u.b = 1",
+	}, {
+		in:  "with c as (select a from t) delete u from u join c on u.a = c.a",
+		out: "delete u from u join (select a from t) as c on u.a = c.a",
+	}, {
+		in:  "insert into u with c as (select a from t) select a from c",
+		out: "insert into u select a from (select a from t) as c",
+	}, {
+		in:  "with recursive c as (select a from t) select * from c",
+		out: "select * from (select a from t) as c",
+	}, {
+		in:  "with recursive c (n) as (select 1 union all select n + 1 from c where n < 3) select * from c",
+		err: "cannot inline recursive common table expression c",
+	}, {
+		in:  "with c (x, y) as (select * from t) select * from c",
+		err: "cannot name the columns of common table expression c",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		inlined, err := InlineCTEs(stmt)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("InlineCTEs(%q): %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("InlineCTEs(%q): %v", tcase.in, err)
+			continue
+		}
+		if got := String(inlined); got != tcase.out {
+			t.Errorf("InlineCTEs(%q):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+		if got := String(stmt); got != String(mustParse(t, tcase.in)) {
+			t.Errorf("InlineCTEs(%q) modified the statement: %s", tcase.in, got)
+		}
+	}
+}
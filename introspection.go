@@ -0,0 +1,130 @@
+package sqlparser
+
+// IntrospectionKind tells what kind of introspection query
+// IsIntrospectionQuery found.
+type IntrospectionKind int
+
+// These are the possible IntrospectionKind values.
+const (
+	// IntrospectionNone is the kind of the other queries.
+	IntrospectionNone = IntrospectionKind(iota)
+	// IntrospectionSystemSchema is the kind of a select that only
+	// reads tables of the system schemas, like information_schema.tables.
+	IntrospectionSystemSchema
+	// IntrospectionFunction is the kind of a select without tables
+	// that only calls information functions, like SELECT DATABASE().
+	IntrospectionFunction
+	// IntrospectionShow is the kind of a SHOW statement.
+	IntrospectionShow
+	// IntrospectionDescribe is the kind of a DESCRIBE statement of
+	// a table, see DescribeTable.
+	IntrospectionDescribe
+)
+
+// systemSchemas are the schemas that hold the server's metadata.
+var systemSchemas = map[string]bool{
+	"information_schema": true,
+	"performance_schema": true,
+	"mysql":              true,
+	"sys":                true,
+}
+
+// infoFunctions are the information functions that tell about the
+// connection and the server when they're called without arguments.
+var infoFunctions = map[string]bool{
+	"database":       true,
+	"schema":         true,
+	"version":        true,
+	"connection_id":  true,
+	"user":           true,
+	"current_user":   true,
+	"session_user":   true,
+	"system_user":    true,
+	"last_insert_id": true,
+}
+
+// IsIntrospectionQuery returns true if stmt is one of the queries that
+// clients and ORMs send to learn about the server and its schemas, and
+// the kind of the query:
+//
+// - a select, or a union of selects, whose tables, including the ones
+// of subqueries, are all in the information_schema, performance_schema,
+// mysql or sys schemas. The tables must be qualified by their schema:
+// an unqualified table, like version, is taken to be a user table.
+//
+// - a select without a FROM clause whose expressions are all calls of
+// the information functions DATABASE, SCHEMA, VERSION, CONNECTION_ID,
+// USER, CURRENT_USER, SESSION_USER, SYSTEM_USER and LAST_INSERT_ID,
+// without arguments.
+//
+// - a SHOW statement, or a DESCRIBE statement of a table.
+func IsIntrospectionQuery(stmt Statement) (bool, IntrospectionKind) {
+	switch stmt := stmt.(type) {
+	case *Show:
+		return true, IntrospectionShow
+	case *DescribeTable:
+		return true, IntrospectionDescribe
+	case *Select:
+		if isInfoFunctionSelect(stmt) {
+			return true, IntrospectionFunction
+		}
+	case *Union, *ParenSelect:
+	default:
+		return false, IntrospectionNone
+	}
+	if readsSystemSchemasOnly(stmt) {
+		return true, IntrospectionSystemSchema
+	}
+	return false, IntrospectionNone
+}
+
+// isInfoFunctionSelect returns true if sel has no tables
+// and only selects information functions.
+func isInfoFunctionSelect(sel *Select) bool {
+	if len(sel.From) != 1 || !isDual(sel.From[0]) || sel.Where != nil {
+		return false
+	}
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			return false
+		}
+		fn, ok := aliased.Expr.(*FuncExpr)
+		if !ok || !fn.Qualifier.IsEmpty() || len(fn.Exprs) != 0 || !infoFunctions[fn.Name.Lowered()] {
+			return false
+		}
+	}
+	return true
+}
+
+// readsSystemSchemasOnly returns true if stmt reads at least
+// one table, and if all of them are in systemSchemas.
+func readsSystemSchemasOnly(stmt Statement) bool {
+	found, system := false, true
+	_ = Walk(func(node SQLNode) (bool, error) {
+		table, ok := node.(*AliasedTableExpr)
+		if !ok || isDual(table) {
+			return true, nil
+		}
+		name, ok := table.Expr.(TableName)
+		if !ok {
+			// A derived table, whose tables are walked.
+			return true, nil
+		}
+		found = true
+		if !systemSchemas[name.Qualifier.Lowered()] {
+			system = false
+		}
+		return system, nil
+	}, stmt)
+	return found && system
+}
+
+func isDual(expr TableExpr) bool {
+	table, ok := expr.(*AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	name, ok := table.Expr.(TableName)
+	return ok && name.Qualifier.IsEmpty() && name.Name.Lowered() == "dual"
+}
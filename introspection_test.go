@@ -0,0 +1,85 @@
+package sqlparser
+
+import "testing"
+
+func TestIsIntrospectionQuery(t *testing.T) {
+	testcases := []struct {
+		in   string
+		kind IntrospectionKind
+	}{{
+		in:   "select database()",
+		kind: IntrospectionFunction,
+	}, {
+		in:   "select schema(), version() as v, connection_id() from dual",
+		kind: IntrospectionFunction,
+	}, {
+		in:   "SELECT USER(), CURRENT_USER, current_user(), session_user(), system_user()",
+		kind: IntrospectionFunction,
+	}, {
+		in:   "select last_insert_id()",
+		kind: IntrospectionFunction,
+	}, {
+		in: "select last_insert_id(5)",
+	}, {
+		in: "select version(), 1",
+	}, {
+		in: "select version() from t",
+	}, {
+		in: "select version from version",
+	}, {
+		in: "select * from version",
+	}, {
+		in: "select now()",
+	}, {
+		in:   "select table_name from information_schema.tables where table_schema = 'db'",
+		kind: IntrospectionSystemSchema,
+	}, {
+		in:   "select * from INFORMATION_SCHEMA.COLUMNS c join performance_schema.threads t on c.x = t.x",
+		kind: IntrospectionSystemSchema,
+	}, {
+		in:   "select user from mysql.user union select name from sys.version",
+		kind: IntrospectionSystemSchema,
+	}, {
+		in:   "select * from (select * from information_schema.tables) as t",
+		kind: IntrospectionSystemSchema,
+	}, {
+		in: "select * from information_schema.tables as x join t on t.a = x.a",
+	}, {
+		in: "select * from information_schema.tables where table_name in (select name from t)",
+	}, {
+		in: "select information_schema.tables.a from t",
+	}, {
+		in: "select * from schemata",
+	}, {
+		in: "delete from mysql.user",
+	}, {
+		in:   "show tables",
+		kind: IntrospectionShow,
+	}, {
+		in:   "show variables like 'sql_mode'",
+		kind: IntrospectionShow,
+	}, {
+		in:   "show create table t",
+		kind: IntrospectionShow,
+	}, {
+		in:   "describe t",
+		kind: IntrospectionDescribe,
+	}, {
+		in: "explain select * from information_schema.tables",
+	}, {
+		in: "select 1",
+	}, {
+		in: "set names utf8",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		ok, kind := IsIntrospectionQuery(stmt)
+		if ok != (tcase.kind != IntrospectionNone) || kind != tcase.kind {
+			t.Errorf("IsIntrospectionQuery(%s): %v, %v, want %v", tcase.in, ok, kind, tcase.kind)
+		}
+	}
+}
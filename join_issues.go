@@ -0,0 +1,268 @@
+package sqlparser
+
+// JoinIssueSeverity tells how a pair of tables reported by
+// FindUnconstrainedJoins is joined.
+type JoinIssueSeverity int
+
+// These are the possible JoinIssueSeverity values.
+const (
+	// JoinConditionWeak is the severity of tables that are only
+	// connected by an OR condition, a condition that is not an
+	// equality, or a condition buried in a subquery.
+	JoinConditionWeak = JoinIssueSeverity(iota + 1)
+	// JoinConditionMissing is the severity of tables that nothing
+	// connects: their join is a cartesian product.
+	JoinConditionMissing
+)
+
+// JoinIssue is a pair of tables of a FROM clause that are not
+// connected by an equality predicate. The tables are named by their
+// alias if they have one, or else by their table name.
+type JoinIssue struct {
+	Left, Right TableName
+	Severity    JoinIssueSeverity
+	Reason      string
+}
+
+// FindUnconstrainedJoins returns the tables of the FROM clause of sel
+// that are joined without a condition, like the tables of
+// "select ... from a, b where a.x = 1" or of an explicit CROSS JOIN.
+//
+// Two tables are connected by a top level equality of the WHERE
+// clause or of an ON condition that compares a column of one table to
+// a column of the other, and by USING and NATURAL joins. Other
+// conditions that refer to several tables only connect them weakly:
+// the tables are reported with the JoinConditionWeak severity. The
+// tables that are connected, directly or through other tables, are
+// not reported, and neither are the tables of derived tables, whose
+// joins are not part of sel.
+//
+// Columns must be qualified to be taken into account, see
+// QualifyColumns. For USING and NATURAL joins, which don't name the
+// tables of their columns, the first tables of both sides are taken
+// to be connected.
+func FindUnconstrainedJoins(sel *Select) []JoinIssue {
+	g := &joinGraph{}
+	g.addTables(sel.From...)
+	if sel.Where != nil {
+		g.addCondition(sel.Where.Expr)
+	}
+	return g.issues()
+}
+
+// joinGraph holds the tables of a FROM clause, and the conditions
+// that connect them.
+type joinGraph struct {
+	tables []joinGraphTable
+	// parent is the union-find forest of the strongly
+	// connected tables.
+	parent []int
+	weak   []weakCondition
+}
+
+type joinGraphTable struct {
+	// name is the alias of the table, or its name.
+	name  TableName
+	alias bool
+	// crossJoined is set for the tables of the right side
+	// of a join without a condition.
+	crossJoined bool
+}
+
+// weakCondition is a condition that refers to several tables
+// without being an equality between two of them.
+type weakCondition struct {
+	expr   Expr
+	tables []int
+}
+
+// addTables adds the tables of exprs to the graph, and the conditions
+// of their joins. It returns the indexes of the tables.
+func (g *joinGraph) addTables(exprs ...TableExpr) []int {
+	var added []int
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *AliasedTableExpr:
+			table := joinGraphTable{name: TableName{Name: expr.As}, alias: true}
+			if name, ok := expr.Expr.(TableName); ok && expr.As.IsEmpty() {
+				table = joinGraphTable{name: name}
+			}
+			added = append(added, len(g.tables))
+			g.tables = append(g.tables, table)
+			g.parent = append(g.parent, len(g.parent))
+		case *ParenTableExpr:
+			added = append(added, g.addTables(expr.Exprs...)...)
+		case *JoinTableExpr:
+			left := g.addTables(expr.LeftExpr)
+			right := g.addTables(expr.RightExpr)
+			switch {
+			case expr.Condition.On != nil:
+				g.addCondition(expr.Condition.On)
+			case len(expr.Condition.Using) != 0 || expr.IsNatural():
+				if len(left) != 0 && len(right) != 0 {
+					g.union(left[0], right[0])
+				}
+			default:
+				for _, i := range right {
+					g.tables[i].crossJoined = true
+				}
+			}
+			added = append(added, left...)
+			added = append(added, right...)
+		}
+	}
+	return added
+}
+
+// addCondition adds the conjuncts of cond that refer to
+// several tables.
+func (g *joinGraph) addCondition(cond Expr) {
+	for _, conjunct := range splitConjuncts(cond) {
+		if cmp, ok := conjunct.(*ComparisonExpr); ok && (cmp.Operator == EqualStr || cmp.Operator == NullSafeEqualStr) && !containsSubquery(cmp) {
+			left, right := g.refs(cmp.Left), g.refs(cmp.Right)
+			if len(left) == 1 && len(right) == 1 && left[0] != right[0] {
+				g.union(left[0], right[0])
+				continue
+			}
+		}
+		if tables := g.refs(conjunct); len(tables) > 1 {
+			g.weak = append(g.weak, weakCondition{expr: conjunct, tables: tables})
+		}
+	}
+}
+
+// refs returns the indexes of the tables whose columns are referenced
+// by node, including the columns of correlated subqueries.
+func (g *joinGraph) refs(node SQLNode) []int {
+	found := make([]bool, len(g.tables))
+	var visit func(scopes []*tableScope, nodes ...SQLNode)
+	visit = func(scopes []*tableScope, nodes ...SQLNode) {
+		_ = Walk(func(node SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case *Select:
+				inner := append([]*tableScope{newTableScope(node.From)}, scopes...)
+				visit(inner, node.SelectExprs, node.From, node.Where, node.GroupBy, node.Having, node.OrderBy)
+				return false, nil
+			case *ColName:
+				if node.Qualifier.IsEmpty() {
+					return true, nil
+				}
+				for _, scope := range scopes {
+					if _, _, ok := scope.lookup(node.Qualifier); ok {
+						return true, nil
+					}
+				}
+				if i := g.lookup(node.Qualifier); i >= 0 {
+					found[i] = true
+				}
+			}
+			return true, nil
+		}, nodes...)
+	}
+	visit(nil, node)
+	var tables []int
+	for i, ok := range found {
+		if ok {
+			tables = append(tables, i)
+		}
+	}
+	return tables
+}
+
+// lookup returns the index of the table that qualifier refers to,
+// or -1 if there is none.
+func (g *joinGraph) lookup(qualifier TableName) int {
+	for i, table := range g.tables {
+		if table.name.Name.IsEmpty() || table.name.Name != qualifier.Name {
+			continue
+		}
+		if qualifier.Qualifier.IsEmpty() || !table.alias && table.name.Qualifier == qualifier.Qualifier {
+			return i
+		}
+	}
+	return -1
+}
+
+func (g *joinGraph) find(i int) int {
+	for g.parent[i] != i {
+		g.parent[i] = g.parent[g.parent[i]]
+		i = g.parent[i]
+	}
+	return g.parent[i]
+}
+
+func (g *joinGraph) union(i, j int) {
+	g.parent[g.find(i)] = g.find(j)
+}
+
+// issues returns a JoinIssue for every strongly connected group of
+// tables but the first, in the order of the FROM clause: it's paired
+// with an earlier group that a weak condition connects it to, or
+// else with the group before it.
+func (g *joinGraph) issues() []JoinIssue {
+	var groups []int
+	group := make(map[int]int)
+	for i := range g.tables {
+		root := g.find(i)
+		if _, ok := group[root]; !ok {
+			group[root] = len(groups)
+			groups = append(groups, i)
+		}
+	}
+
+	var issues []JoinIssue
+	for n := 1; n < len(groups); n++ {
+		issue := JoinIssue{
+			Left:     g.tables[groups[n-1]].name,
+			Right:    g.tables[groups[n]].name,
+			Severity: JoinConditionMissing,
+			Reason:   "no join condition",
+		}
+		if g.tables[groups[n]].crossJoined {
+			issue.Reason = "cross join without a join condition"
+		}
+		if left, right, cond := g.weakLink(group, n); cond != nil {
+			issue = JoinIssue{
+				Left:     g.tables[left].name,
+				Right:    g.tables[right].name,
+				Severity: JoinConditionWeak,
+				Reason:   "only connected by " + weakReason(cond) + ": " + String(cond),
+			}
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// weakLink returns the first weak condition that connects a table of
+// group n to a table of an earlier group, and the two tables.
+func (g *joinGraph) weakLink(group map[int]int, n int) (left, right int, cond Expr) {
+	for _, weak := range g.weak {
+		left, right = -1, -1
+		for _, i := range weak.tables {
+			switch m := group[g.find(i)]; {
+			case m < n && left < 0:
+				left = i
+			case m == n && right < 0:
+				right = i
+			}
+		}
+		if left >= 0 && right >= 0 {
+			return left, right, weak.expr
+		}
+	}
+	return -1, -1, nil
+}
+
+func weakReason(cond Expr) string {
+	switch cond := cond.(type) {
+	case *ParenExpr:
+		return weakReason(cond.Expr)
+	case *OrExpr:
+		return "an or condition"
+	}
+	if containsSubquery(cond) {
+		return "a subquery"
+	}
+	return "a condition that is not an equality"
+}
@@ -0,0 +1,91 @@
+package sqlparser
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFindUnconstrainedJoins(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in: "select * from a where a.x = 1",
+	}, {
+		in: "select * from a, b where a.id = b.a_id and a.x = 1",
+	}, {
+		in: "select * from a join b on a.id = b.a_id join c on c.b_id = b.id",
+	}, {
+		in: "select * from a join b using (id) natural join c",
+	}, {
+		in:  "select * from a, b where a.x = 1",
+		out: []string{"a, b: missing: no join condition"},
+	}, {
+		in:  "select * from a cross join b",
+		out: []string{"a, b: missing: cross join without a join condition"},
+	}, {
+		in: "select * from a cross join b where b.a_id = a.id",
+	}, {
+		in:  "select * from a, b, c where a.id = c.a_id",
+		out: []string{"a, b: missing: no join condition"},
+	}, {
+		in:  "select * from a, b, c where a.id = b.a_id and (b.x = 1 or c.y = 1)",
+		out: []string{"b, c: weak: only connected by an or condition: (b.x = 1 or c.y = 1)"},
+	}, {
+		in: "select * from a, b, c",
+		out: []string{
+			"a, b: missing: no join condition",
+			"b, c: missing: no join condition",
+		},
+	}, {
+		in:  "select * from a, b where a.id = b.a_id or a.id = b.b_id",
+		out: []string{"a, b: weak: only connected by an or condition: a.id = b.a_id or a.id = b.b_id"},
+	}, {
+		in:  "select * from a, b where (a.x = 1 or b.y = 2)",
+		out: []string{"a, b: weak: only connected by an or condition: (a.x = 1 or b.y = 2)"},
+	}, {
+		in:  "select * from a join b on a.x < b.y",
+		out: []string{"a, b: weak: only connected by a condition that is not an equality: a.x < b.y"},
+	}, {
+		in:  "select * from a, b where exists (select 1 from c where c.a_id = a.id and c.b_id = b.id)",
+		out: []string{"a, b: weak: only connected by a subquery: exists (select 1 from c where c.a_id = a.id and c.b_id = b.id)"},
+	}, {
+		// the subquery has its own table a
+		in:  "select * from a, b where b.x in (select a.x from a where a.y = 1)",
+		out: []string{"a, b: missing: no join condition"},
+	}, {
+		// self joins
+		in: "select * from t as p, t as c where c.parent_id = p.id",
+	}, {
+		in:  "select * from t as p, t as c where t.parent_id = p.id",
+		out: []string{"p, c: missing: no join condition"},
+	}, {
+		in: "select * from db.a, b where db.a.id = 1 and a.id = b.a_id",
+	}, {
+		in:  "select * from a, (select b.id from b, c) as d where d.id = 1",
+		out: []string{"a, d: missing: no join condition"},
+	}, {
+		// unqualified columns are ignored
+		in:  "select * from a, b where id = a_id",
+		out: []string{"a, b: missing: no join condition"},
+	}}
+	severities := map[JoinIssueSeverity]string{
+		JoinConditionWeak:    "weak",
+		JoinConditionMissing: "missing",
+	}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		var out []string
+		for _, issue := range FindUnconstrainedJoins(tree.(*Select)) {
+			out = append(out, fmt.Sprintf("%s, %s: %s: %s", String(issue.Left), String(issue.Right), severities[issue.Severity], issue.Reason))
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("FindUnconstrainedJoins(%s):\n%q, want\n%q", tcase.in, out, tcase.out)
+		}
+	}
+}
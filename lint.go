@@ -0,0 +1,331 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// LintIssue is a construct of a statement that a lint Rule flags.
+type LintIssue struct {
+	// RuleID is the ID of the rule that reported the issue.
+	RuleID  string
+	Message string
+	// Node is the node of the statement that the issue is about.
+	Node SQLNode
+	// Fix, if not nil, fixes the issue by rewriting the statement
+	// in place.
+	Fix func() error
+}
+
+// Rule is a lint rule, see Lint.
+type Rule interface {
+	// ID returns the identifier of the rule.
+	ID() string
+	// Check returns the issues of stmt. Lint sets their RuleID.
+	Check(stmt Statement) []LintIssue
+}
+
+// NewRule returns a Rule with the given ID whose Check calls check.
+func NewRule(id string, check func(stmt Statement) []LintIssue) Rule {
+	return &funcRule{id: id, check: check}
+}
+
+type funcRule struct {
+	id    string
+	check func(stmt Statement) []LintIssue
+}
+
+func (r *funcRule) ID() string {
+	return r.id
+}
+
+func (r *funcRule) Check(stmt Statement) []LintIssue {
+	return r.check(stmt)
+}
+
+// Lint checks stmt against rules, and returns the issues they report,
+// in the order of rules.
+func Lint(stmt Statement, rules []Rule) []LintIssue {
+	var issues []LintIssue
+	for _, rule := range rules {
+		for _, issue := range rule.Check(stmt) {
+			issue.RuleID = rule.ID()
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// The built-in lint rules. They check the subqueries of the
+// statement too.
+var (
+	// LintCommaJoin flags the FROM clauses that join tables with
+	// commas, like "from a, b", instead of an explicit JOIN.
+	LintCommaJoin = NewRule("comma-join", lintCommaJoin)
+	// LintDeleteWithoutWhere flags the DELETE statements that have
+	// neither a WHERE clause nor a LIMIT, and so delete all the rows.
+	LintDeleteWithoutWhere = NewRule("delete-without-where", lintDeleteWithoutWhere)
+	// LintUpdateWithoutWhere flags the UPDATE statements that have
+	// neither a WHERE clause nor a LIMIT, and so update all the rows.
+	LintUpdateWithoutWhere = NewRule("update-without-where", lintUpdateWithoutWhere)
+	// LintDeleteOrderByWithoutLimit flags the DELETE statements with
+	// an ORDER BY clause but no LIMIT, whose order is useless.
+	LintDeleteOrderByWithoutLimit = NewRule("delete-order-by-without-limit", lintDeleteOrderByWithoutLimit)
+	// LintGroupByPosition flags the GROUP BY entries that are
+	// positions in the select list, like "group by 1".
+	LintGroupByPosition = NewRule("group-by-position", lintGroupByPosition)
+	// LintNullComparison flags the comparisons with NULL, like
+	// a = NULL, which are NULL whatever a is, and the NOT IN lists
+	// that hold a NULL, which are never true. The = and <> ones can
+	// be fixed: they're rewritten to IS NULL and IS NOT NULL.
+	LintNullComparison = NewRule("null-comparison", lintNullComparison)
+	// LintIndexHintConflict flags the tables with both USE INDEX and
+	// FORCE INDEX hints for the same part of the query, which MySQL
+	// rejects. A hint without a FOR clause applies to all the parts.
+	LintIndexHintConflict = NewRule("index-hint-conflict", lintIndexHintConflict)
+)
+
+// LintNotEqualStyle returns a rule that flags the not-equal operators
+// that are not spelled as preferred, which is either "<>" or "!=".
+//
+// The AST doesn't record how the operators are spelled, so the rule
+// reads the original text of the statement: it only flags the
+// statements parsed with source tracking, see ParseOptions.
+func LintNotEqualStyle(preferred string) Rule {
+	return NewRule("not-equal-style", func(stmt Statement) []LintIssue {
+		src := StatementSource(stmt)
+		if src == "" {
+			return nil
+		}
+		var issues []LintIssue
+		tkn := NewStringTokenizer(src)
+		for {
+			typ, val := tkn.Scan()
+			if typ == 0 || typ == LEX_ERROR {
+				break
+			}
+			if typ == NE && string(val) != preferred {
+				issues = append(issues, LintIssue{
+					Message: fmt.Sprintf("use %s instead of %s at position %d", preferred, val, tkn.Position-len(val)),
+					Node:    stmt,
+				})
+			}
+		}
+		return issues
+	})
+}
+
+// LintBannedFunctions returns a rule that flags the calls
+// of the functions names, like SLEEP or LOAD_FILE. Names are
+// compared case-insensitively.
+func LintBannedFunctions(names ...string) Rule {
+	banned := make(map[string]bool, len(names))
+	for _, name := range names {
+		banned[strings.ToLower(name)] = true
+	}
+	return NewRule("banned-function", func(stmt Statement) []LintIssue {
+		var issues []LintIssue
+		_ = Walk(func(node SQLNode) (bool, error) {
+			if fn, ok := node.(*FuncExpr); ok && banned[fn.Name.Lowered()] {
+				issues = append(issues, LintIssue{
+					Message: fmt.Sprintf("function %s is not allowed", fn.Name.Lowered()),
+					Node:    fn,
+				})
+			}
+			return true, nil
+		}, stmt)
+		return issues
+	})
+}
+
+// DefaultLintRules returns all the built-in rules, with "<>" as the
+// preferred not-equal operator, and SLEEP and LOAD_FILE banned.
+func DefaultLintRules() []Rule {
+	return []Rule{
+		LintCommaJoin,
+		LintDeleteWithoutWhere,
+		LintUpdateWithoutWhere,
+		LintDeleteOrderByWithoutLimit,
+		LintGroupByPosition,
+		LintNullComparison,
+		LintIndexHintConflict,
+		LintNotEqualStyle("<>"),
+		LintBannedFunctions("sleep", "load_file"),
+	}
+}
+
+func lintCommaJoin(stmt Statement) []LintIssue {
+	var issues []LintIssue
+	_ = Walk(func(node SQLNode) (bool, error) {
+		var exprs TableExprs
+		switch node := node.(type) {
+		case *Select:
+			exprs = node.From
+		case *ParenTableExpr:
+			exprs = node.Exprs
+		}
+		if len(exprs) > 1 {
+			issues = append(issues, LintIssue{
+				Message: fmt.Sprintf("implicit join of %v, use an explicit join", String(exprs)),
+				Node:    exprs,
+			})
+		}
+		return true, nil
+	}, stmt)
+	return issues
+}
+
+func lintDeleteWithoutWhere(stmt Statement) []LintIssue {
+	if del, ok := stmt.(*Delete); ok && del.Where == nil && del.Limit == nil {
+		return []LintIssue{{Message: "delete without a where clause deletes all rows", Node: del}}
+	}
+	return nil
+}
+
+func lintUpdateWithoutWhere(stmt Statement) []LintIssue {
+	if upd, ok := stmt.(*Update); ok && upd.Where == nil && upd.Limit == nil {
+		return []LintIssue{{Message: "update without a where clause updates all rows", Node: upd}}
+	}
+	return nil
+}
+
+func lintDeleteOrderByWithoutLimit(stmt Statement) []LintIssue {
+	if del, ok := stmt.(*Delete); ok && len(del.OrderBy) != 0 && del.Limit == nil {
+		return []LintIssue{{Message: "order by without a limit in delete", Node: del.OrderBy}}
+	}
+	return nil
+}
+
+func lintGroupByPosition(stmt Statement) []LintIssue {
+	var issues []LintIssue
+	_ = Walk(func(node SQLNode) (bool, error) {
+		sel, ok := node.(*Select)
+		if !ok {
+			return true, nil
+		}
+		for _, expr := range sel.GroupBy {
+			if val, ok := expr.(*SQLVal); ok && val.Type == IntVal {
+				issues = append(issues, LintIssue{
+					Message: fmt.Sprintf("group by position %s, use the expression instead", val.Val),
+					Node:    val,
+				})
+			}
+		}
+		return true, nil
+	}, stmt)
+	return issues
+}
+
+func lintNullComparison(stmt Statement) []LintIssue {
+	var issues []LintIssue
+	_ = Walk(func(node SQLNode) (bool, error) {
+		cmp, ok := node.(*ComparisonExpr)
+		if !ok {
+			return true, nil
+		}
+		switch cmp.Operator {
+		case NullSafeEqualStr, InStr:
+		case NotInStr:
+			if tuple, ok := cmp.Right.(ValTuple); ok {
+				for _, val := range tuple {
+					if IsNull(val) {
+						issues = append(issues, LintIssue{
+							Message: fmt.Sprintf("%s is never true, since the list holds a null", String(cmp)),
+							Node:    cmp,
+						})
+						break
+					}
+				}
+			}
+		default:
+			expr := cmp.Left
+			if IsNull(expr) {
+				expr = cmp.Right
+			} else if !IsNull(cmp.Right) {
+				break
+			}
+			issue := LintIssue{
+				Message: fmt.Sprintf("%s is always null", String(cmp)),
+				Node:    cmp,
+			}
+			operator := IsNullStr
+			switch cmp.Operator {
+			case NotEqualStr:
+				operator = IsNotNullStr
+				fallthrough
+			case EqualStr:
+				is := &IsExpr{Operator: operator, Expr: expr}
+				issue.Message += fmt.Sprintf(", use %s instead", String(is))
+				issue.Fix = func() error {
+					if !replaceNode(reflect.ValueOf(stmt), cmp, is) {
+						return errors.New("cannot fix " + String(cmp) + ": it is no longer in the statement")
+					}
+					return nil
+				}
+			}
+			issues = append(issues, issue)
+		}
+		return true, nil
+	}, stmt)
+	return issues
+}
+
+func lintIndexHintConflict(stmt Statement) []LintIssue {
+	var issues []LintIssue
+	_ = Walk(func(node SQLNode) (bool, error) {
+		table, ok := node.(*AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		for i, use := range table.Hints {
+			if use.Type != UseStr {
+				continue
+			}
+			for _, force := range table.Hints {
+				if force.Type == ForceStr && (use.For == "" || force.For == "" || use.For == force.For) {
+					issues = append(issues, LintIssue{
+						Message: fmt.Sprintf("%s and %s cannot be combined for %s", strings.TrimSpace(String(use)), strings.TrimSpace(String(force)), String(table.Expr)),
+						Node:    table.Hints[i],
+					})
+					break
+				}
+			}
+		}
+		return true, nil
+	}, stmt)
+	return issues
+}
+
+// replaceNode replaces the from node held by v, or by the nodes under
+// it, with to. It returns false if it doesn't find from.
+func replaceNode(v reflect.Value, from, to Expr) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return false
+		}
+		if v.Kind() == reflect.Interface && v.Interface() == from {
+			if !v.CanSet() || !reflect.TypeOf(to).AssignableTo(v.Type()) {
+				return false
+			}
+			v.Set(reflect.ValueOf(to))
+			return true
+		}
+		return replaceNode(v.Elem(), from, to)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" && replaceNode(v.Field(i), from, to) {
+				return true
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if replaceNode(v.Index(i), from, to) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+package sqlparser
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in: "select a from t join u on t.id = u.id where a <> 1 group by a",
+	}, {
+		in:  "select a from t, u where t.id = u.id",
+		out: []string{"comma-join: implicit join of t, u, use an explicit join"},
+	}, {
+		in: "select a from t join (u, v) on t.id = u.id where exists (select 1 from w, x)",
+		out: []string{
+			"comma-join: implicit join of u, v, use an explicit join",
+			"comma-join: implicit join of w, x, use an explicit join",
+		},
+	}, {
+		in:  "delete from t",
+		out: []string{"delete-without-where: delete without a where clause deletes all rows"},
+	}, {
+		in: "delete from t limit 10",
+	}, {
+		in: "delete from t where a = 1",
+	}, {
+		in:  "delete t from t join u on t.id = u.id",
+		out: []string{"delete-without-where: delete without a where clause deletes all rows"},
+	}, {
+		in: "delete from t where a = 1 order by b",
+		out: []string{
+			"delete-order-by-without-limit: order by without a limit in delete",
+		},
+	}, {
+		in: "delete from t order by b limit 1",
+	}, {
+		in:  "update t set a = 1",
+		out: []string{"update-without-where: update without a where clause updates all rows"},
+	}, {
+		in: "update t set a = 1 order by b limit 5",
+	}, {
+		in: "update t set a = 1 where b = 2",
+	}, {
+		in: "select a, count(*) from t group by 1, a having count(*) > 1 order by 1",
+		out: []string{
+			"group-by-position: group by position 1, use the expression instead",
+		},
+	}, {
+		in: "select a from t where a != 1 and b <> 2 and c != 'x != y'",
+		out: []string{
+			"not-equal-style: use <> instead of != at position 25",
+			"not-equal-style: use <> instead of != at position 47",
+		},
+	}, {
+		in: "select SLEEP(1), load_file('/etc/passwd'), sleep from t where a = benchmark(1, sleep(2))",
+		out: []string{
+			"banned-function: function sleep is not allowed",
+			"banned-function: function load_file is not allowed",
+			"banned-function: function sleep is not allowed",
+		},
+	}, {
+		in: "select a from t join u on u.id <> null where a = null and null <=> b and c in (1, null) and d not in (2, null) and e like null",
+		out: []string{
+			"null-comparison: u.id != null is always null, use u.id is not null instead",
+			"null-comparison: a = null is always null, use a is null instead",
+			"null-comparison: d not in (2, null) is never true, since the list holds a null",
+			"null-comparison: e like null is always null",
+		},
+	}, {
+		in: "select a from t use index (i) force key (j) join u use index for join (k) force index for order by (l) on t.id = u.id",
+		out: []string{
+			"index-hint-conflict: use index (i) and force key (j) cannot be combined for t",
+		},
+	}, {
+		in: "select a from t use index for group by (i) force index (j) join u force index (k) ignore index (l)",
+		out: []string{
+			"index-hint-conflict: use index for group by (i) and force index (j) cannot be combined for t",
+		},
+	}}
+	for _, tcase := range testcases {
+		tree, err := ParseWithOptions(tcase.in, ParseOptions{TrackSource: true})
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		var out []string
+		for _, issue := range Lint(tree, DefaultLintRules()) {
+			out = append(out, fmt.Sprintf("%s: %s", issue.RuleID, issue.Message))
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("Lint(%s): %q, want %q", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestLintIssueNode(t *testing.T) {
+	tree, err := Parse("select sleep(1) from t where a = 1 group by 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := Lint(tree, []Rule{LintGroupByPosition, LintBannedFunctions("SLEEP")})
+	var nodes []string
+	for _, issue := range issues {
+		nodes = append(nodes, String(issue.Node))
+	}
+	if want := []string{"2", "sleep(1)"}; !reflect.DeepEqual(nodes, want) {
+		t.Errorf("Lint nodes: %q, want %q", nodes, want)
+	}
+
+	// Without source tracking, the spelling of != is unknown.
+	tree, err = Parse("select a from t where a != 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issues := Lint(tree, []Rule{LintNotEqualStyle("<>")}); issues != nil {
+		t.Errorf("Lint without source: %v, want none", issues)
+	}
+}
+
+func TestLintCustomRule(t *testing.T) {
+	noSelectStar := NewRule("no-select-star", func(stmt Statement) []LintIssue {
+		var issues []LintIssue
+		_ = Walk(func(node SQLNode) (bool, error) {
+			if star, ok := node.(*StarExpr); ok {
+				issues = append(issues, LintIssue{Message: "select * is not allowed", Node: star})
+			}
+			return true, nil
+		}, stmt)
+		return issues
+	})
+	tree, err := Parse("select * from t, u")
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := Lint(tree, []Rule{noSelectStar, LintCommaJoin})
+	var out []string
+	for _, issue := range issues {
+		out = append(out, fmt.Sprintf("%s: %s: %s", issue.RuleID, String(issue.Node), issue.Message))
+	}
+	want := []string{
+		"no-select-star: *: select * is not allowed",
+		"comma-join: t, u: implicit join of t, u, use an explicit join",
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("Lint: %q, want %q", out, want)
+	}
+}
+
+func TestLintFix(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select a from t join u on u.id <> null where a = null and (select 1 from v where null = v.b) and c > null",
+		out: "select a from t join u on u.id is not null where a is null and (select 1 from v where v.b is null) and c > null",
+	}, {
+		in:  "update t set a = (b = null) where c = null",
+		out: "update t set a = (b is null) where c is null",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		for _, issue := range Lint(tree, []Rule{LintNullComparison}) {
+			if issue.Fix == nil {
+				continue
+			}
+			if err := issue.Fix(); err != nil {
+				t.Errorf("Fix(%s): %v", tcase.in, err)
+			}
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("Fix(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+
+	// A fix doesn't apply twice.
+	tree, err := Parse("select a from t where a = null")
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := Lint(tree, []Rule{LintNullComparison})
+	if err := issues[0].Fix(); err != nil {
+		t.Fatal(err)
+	}
+	want := "cannot fix a = null: it is no longer in the statement"
+	if err := issues[0].Fix(); err == nil || err.Error() != want {
+		t.Errorf("Fix again: %v, want %s", err, want)
+	}
+}
@@ -0,0 +1,369 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MigrationSafety is how an action of a migration affects the table
+// while it runs, see ClassifyMigration.
+type MigrationSafety int
+
+// These are the possible MigrationSafety values, from the safest to
+// the least safe.
+const (
+	// MigrationSafeOnline is for the actions that only change metadata,
+	// or that are done in place without blocking writes.
+	MigrationSafeOnline = MigrationSafety(iota + 1)
+	// MigrationTableCopy is for the actions that rebuild the table:
+	// writes are permitted, but they take time and space in
+	// proportion to the size of the table.
+	MigrationTableCopy
+	// MigrationBlocking is for the actions that block the writes to
+	// the table while they run.
+	MigrationBlocking
+	// MigrationUnknown is for the actions that no rule covers, which
+	// have to be assumed to be the least safe.
+	MigrationUnknown
+)
+
+var migrationSafetyStrings = map[MigrationSafety]string{
+	MigrationSafeOnline: "safe-online",
+	MigrationTableCopy:  "requires-table-copy",
+	MigrationBlocking:   "blocking",
+	MigrationUnknown:    "unknown",
+}
+
+func (safety MigrationSafety) String() string {
+	return migrationSafetyStrings[safety]
+}
+
+// MigrationKind is the kind of an action of a migration, that the
+// MigrationRule values are for.
+type MigrationKind string
+
+// These are the kinds of the actions that ClassifyMigration finds.
+const (
+	MigrationCreateTable   = MigrationKind("create table")
+	MigrationDropTable     = MigrationKind("drop table")
+	MigrationTruncateTable = MigrationKind("truncate table")
+	MigrationRenameTable   = MigrationKind("rename table")
+	MigrationCreateDB      = MigrationKind("create database")
+	MigrationDropDB        = MigrationKind("drop database")
+
+	// MigrationAddColumn adds a column after the last one, and
+	// MigrationAddPositionedColumn with FIRST or AFTER.
+	MigrationAddColumn              = MigrationKind("add column")
+	MigrationAddPositionedColumn    = MigrationKind("add positioned column")
+	MigrationAddAutoIncrementColumn = MigrationKind("add auto_increment column")
+	MigrationDropColumn             = MigrationKind("drop column")
+	// MigrationDropIndexedColumn drops a column of an index, which is
+	// only known with a schema, see MigrationOptions.
+	MigrationDropIndexedColumn = MigrationKind("drop indexed column")
+	// MigrationModifyColumn changes the definition of a column, with
+	// MODIFY or CHANGE.
+	MigrationModifyColumn = MigrationKind("modify column")
+	MigrationSetDefault   = MigrationKind("set default")
+	MigrationRenameColumn = MigrationKind("rename column")
+
+	MigrationAddIndex         = MigrationKind("add index")
+	MigrationAddPrimaryKey    = MigrationKind("add primary key")
+	MigrationAddFulltextIndex = MigrationKind("add fulltext index")
+	MigrationAddSpatialIndex  = MigrationKind("add spatial index")
+	MigrationDropIndex        = MigrationKind("drop index")
+	MigrationDropPrimaryKey   = MigrationKind("drop primary key")
+	MigrationAddForeignKey    = MigrationKind("add foreign key")
+	MigrationDropForeignKey   = MigrationKind("drop foreign key")
+	MigrationRenameIndex      = MigrationKind("rename index")
+
+	MigrationConvertCharset = MigrationKind("convert character set")
+	// MigrationRebuildTable is FORCE, or a table option that rebuilds
+	// the table, like row_format or the default character set.
+	MigrationRebuildTable = MigrationKind("rebuild table")
+	MigrationChangeEngine = MigrationKind("change engine")
+	// MigrationTableOption is a table option that only changes
+	// metadata, like comment or auto_increment.
+	MigrationTableOption         = MigrationKind("table option")
+	MigrationReorganizePartition = MigrationKind("reorganize partition")
+	// MigrationUnparsed is an ALTER TABLE whose alterations were not
+	// parsed. No rule is for it: it's always MigrationUnknown.
+	MigrationUnparsed = MigrationKind("unparsed alteration")
+)
+
+// destructiveMigrations are the kinds of the actions that lose data.
+var destructiveMigrations = map[MigrationKind]bool{
+	MigrationDropTable:         true,
+	MigrationTruncateTable:     true,
+	MigrationDropDB:            true,
+	MigrationDropColumn:        true,
+	MigrationDropIndexedColumn: true,
+}
+
+// MySQLVersion is a version of MySQL, like 8.0.29.
+type MySQLVersion struct {
+	Major, Minor, Patch int
+}
+
+// Less returns true if v is before other.
+func (v MySQLVersion) Less(other MySQLVersion) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+func (v MySQLVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// MigrationRule is the safety of the actions of kind Kind from the
+// version Since of MySQL, until the Since of the next rule of the kind.
+type MigrationRule struct {
+	Kind   MigrationKind
+	Since  MySQLVersion
+	Safety MigrationSafety
+	Reason string
+}
+
+// DefaultMigrationRules are the rules of ClassifyMigration, after the
+// online DDL of InnoDB. The actions of a version before the first rule
+// of their kind are MigrationUnknown.
+var DefaultMigrationRules = []MigrationRule{
+	{MigrationCreateTable, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "creates a new table"},
+	{MigrationDropTable, MySQLVersion{5, 6, 0}, MigrationBlocking, "drops the table and its rows, once the queries that use it are done"},
+	{MigrationTruncateTable, MySQLVersion{5, 6, 0}, MigrationBlocking, "deletes the rows of the table, once the queries that use it are done"},
+	{MigrationRenameTable, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "only changes metadata"},
+	{MigrationCreateDB, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "creates a new database"},
+	{MigrationDropDB, MySQLVersion{5, 6, 0}, MigrationBlocking, "drops the tables of the database and their rows"},
+
+	{MigrationAddColumn, MySQLVersion{5, 6, 0}, MigrationTableCopy, "adding a column rebuilds the table"},
+	{MigrationAddColumn, MySQLVersion{8, 0, 12}, MigrationSafeOnline, "a column added last is added instantly"},
+	{MigrationAddPositionedColumn, MySQLVersion{5, 6, 0}, MigrationTableCopy, "adding a column rebuilds the table"},
+	{MigrationAddPositionedColumn, MySQLVersion{8, 0, 29}, MigrationSafeOnline, "a column is added instantly in any position"},
+	{MigrationAddAutoIncrementColumn, MySQLVersion{5, 6, 0}, MigrationBlocking, "adding an auto_increment column rebuilds the table and blocks writes"},
+	{MigrationDropColumn, MySQLVersion{5, 6, 0}, MigrationTableCopy, "dropping a column rebuilds the table"},
+	{MigrationDropColumn, MySQLVersion{8, 0, 29}, MigrationSafeOnline, "a column is dropped instantly"},
+	{MigrationDropIndexedColumn, MySQLVersion{5, 6, 0}, MigrationTableCopy, "dropping a column of an index rebuilds the table"},
+	{MigrationModifyColumn, MySQLVersion{5, 6, 0}, MigrationBlocking, "changing the type of a column copies the table and blocks writes"},
+	{MigrationSetDefault, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "only changes metadata"},
+	{MigrationRenameColumn, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "only changes metadata"},
+
+	{MigrationAddIndex, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "a secondary index is built in place and permits writes"},
+	{MigrationAddPrimaryKey, MySQLVersion{5, 6, 0}, MigrationTableCopy, "adding a primary key rebuilds the table"},
+	{MigrationAddFulltextIndex, MySQLVersion{5, 6, 0}, MigrationBlocking, "adding a fulltext index blocks writes, and rebuilds the table for the first one"},
+	{MigrationAddSpatialIndex, MySQLVersion{5, 6, 0}, MigrationBlocking, "adding a spatial index copies the table and blocks writes"},
+	{MigrationAddSpatialIndex, MySQLVersion{5, 7, 0}, MigrationBlocking, "adding a spatial index blocks writes"},
+	{MigrationDropIndex, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "only changes metadata"},
+	{MigrationDropPrimaryKey, MySQLVersion{5, 6, 0}, MigrationBlocking, "dropping the primary key copies the table and blocks writes"},
+	{MigrationAddForeignKey, MySQLVersion{5, 6, 0}, MigrationBlocking, "adding a foreign key copies the table and blocks writes, unless foreign_key_checks is disabled"},
+	{MigrationDropForeignKey, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "only changes metadata"},
+	{MigrationRenameIndex, MySQLVersion{5, 7, 0}, MigrationSafeOnline, "only changes metadata"},
+
+	{MigrationConvertCharset, MySQLVersion{5, 6, 0}, MigrationBlocking, "converting the character set copies the table and blocks writes"},
+	{MigrationRebuildTable, MySQLVersion{5, 6, 0}, MigrationTableCopy, "rebuilds the table"},
+	{MigrationChangeEngine, MySQLVersion{5, 6, 0}, MigrationBlocking, "changing the engine copies the table and blocks writes"},
+	{MigrationTableOption, MySQLVersion{5, 6, 0}, MigrationSafeOnline, "only changes metadata"},
+	{MigrationReorganizePartition, MySQLVersion{5, 6, 0}, MigrationBlocking, "reorganizing partitions copies their rows and blocks writes"},
+}
+
+// MigrationOptions are the options of ClassifyMigrationWithOptions.
+type MigrationOptions struct {
+	// Version is the version of MySQL that the migration runs on. The
+	// zero value is the latest one.
+	Version MySQLVersion
+	// Rules replace DefaultMigrationRules if they're not nil.
+	Rules []MigrationRule
+	// Schema is used to find the dropped columns that are in an
+	// index, see MigrationDropIndexedColumn. It can be nil.
+	Schema *Schema
+}
+
+// MigrationAction is an action of a migration. Node is the node of the
+// AST that does it: an *AlterSpec or a *PartitionSpec of an ALTER
+// TABLE, or else the statement.
+type MigrationAction struct {
+	Kind        MigrationKind
+	Node        SQLNode
+	Safety      MigrationSafety
+	Destructive bool
+	Reason      string
+}
+
+// MigrationReport is the result of ClassifyMigration. Safety is the
+// least safe of the Safety of the actions, and Destructive is true if
+// one of them is.
+type MigrationReport struct {
+	Actions     []MigrationAction
+	Safety      MigrationSafety
+	Destructive bool
+}
+
+// ClassifyMigration returns how the actions of the DDL statement stmt
+// affect the tables while they run on the latest version of MySQL,
+// according to DefaultMigrationRules, and which of them lose data. A
+// statement that is not DDL, or a vindex DDL, has no actions, and its
+// report has a zero Safety.
+func ClassifyMigration(stmt Statement) MigrationReport {
+	return ClassifyMigrationWithOptions(stmt, MigrationOptions{})
+}
+
+// ClassifyMigrationWithOptions is ClassifyMigration with options.
+func ClassifyMigrationWithOptions(stmt Statement, opts MigrationOptions) MigrationReport {
+	if opts.Rules == nil {
+		opts.Rules = DefaultMigrationRules
+	}
+	var report MigrationReport
+	add := func(kind MigrationKind, node SQLNode) {
+		action := classifyMigrationAction(kind, opts)
+		action.Node = node
+		report.Actions = append(report.Actions, action)
+		if action.Safety > report.Safety {
+			report.Safety = action.Safety
+		}
+		report.Destructive = report.Destructive || action.Destructive
+	}
+
+	switch stmt := stmt.(type) {
+	case *DDL:
+		switch stmt.Action {
+		case CreateStr:
+			add(MigrationCreateTable, stmt)
+		case DropStr:
+			add(MigrationDropTable, stmt)
+		case TruncateStr:
+			add(MigrationTruncateTable, stmt)
+		case RenameStr:
+			add(MigrationRenameTable, stmt)
+		case AlterStr:
+			switch {
+			case stmt.PartitionSpec != nil:
+				add(MigrationReorganizePartition, stmt.PartitionSpec)
+			case len(stmt.AlterSpecs) == 0:
+				add(MigrationUnparsed, stmt)
+			}
+			for _, spec := range stmt.AlterSpecs {
+				for _, kind := range alterSpecMigrations(stmt.Table, spec, opts.Schema) {
+					add(kind, spec)
+				}
+			}
+		}
+	case *DBDDL:
+		switch stmt.Action {
+		case CreateStr:
+			add(MigrationCreateDB, stmt)
+		case DropStr:
+			add(MigrationDropDB, stmt)
+		}
+	}
+	return report
+}
+
+// classifyMigrationAction returns the action of kind according to the
+// latest of the rules of opts for the version of opts, without a node.
+func classifyMigrationAction(kind MigrationKind, opts MigrationOptions) MigrationAction {
+	action := MigrationAction{
+		Kind:        kind,
+		Safety:      MigrationUnknown,
+		Destructive: destructiveMigrations[kind],
+	}
+	if kind == MigrationUnparsed {
+		action.Reason = "the alterations were not parsed"
+		return action
+	}
+	var rule *MigrationRule
+	for i := range opts.Rules {
+		candidate := &opts.Rules[i]
+		if candidate.Kind != kind || opts.Version != (MySQLVersion{}) && opts.Version.Less(candidate.Since) {
+			continue
+		}
+		if rule == nil || rule.Since.Less(candidate.Since) {
+			rule = candidate
+		}
+	}
+	if rule == nil {
+		if opts.Version == (MySQLVersion{}) {
+			action.Reason = fmt.Sprintf("no rule for %s", kind)
+		} else {
+			action.Reason = fmt.Sprintf("no rule for %s in MySQL %v", kind, opts.Version)
+		}
+		return action
+	}
+	action.Safety, action.Reason = rule.Safety, rule.Reason
+	return action
+}
+
+// alterSpecMigrations returns the kinds of the actions of spec, an
+// alteration of table. A column added with a key also adds an index.
+// The ALGORITHM and LOCK options have no action.
+func alterSpecMigrations(table TableName, spec *AlterSpec, schema *Schema) []MigrationKind {
+	switch spec.Action {
+	case AddColumnStr:
+		var kinds []MigrationKind
+		switch {
+		case bool(spec.Column.Type.Autoincrement):
+			kinds = append(kinds, MigrationAddAutoIncrementColumn)
+		case spec.First || !spec.After.IsEmpty():
+			kinds = append(kinds, MigrationAddPositionedColumn)
+		default:
+			kinds = append(kinds, MigrationAddColumn)
+		}
+		switch spec.Column.Type.KeyOpt {
+		case colKeyPrimary, colKey:
+			kinds = append(kinds, MigrationAddPrimaryKey)
+		case colKeyUnique, colKeyUniqueKey:
+			kinds = append(kinds, MigrationAddIndex)
+		case colKeySpatialKey:
+			kinds = append(kinds, MigrationAddSpatialIndex)
+		}
+		return kinds
+	case AddIndexStr:
+		switch {
+		case spec.Index.Info.Primary:
+			return []MigrationKind{MigrationAddPrimaryKey}
+		case spec.Index.Info.Fulltext:
+			return []MigrationKind{MigrationAddFulltextIndex}
+		case spec.Index.Info.Spatial:
+			return []MigrationKind{MigrationAddSpatialIndex}
+		}
+		return []MigrationKind{MigrationAddIndex}
+	case DropColumnStr:
+		if schema.isIndexed(table.Name.String(), spec.Name.String()) {
+			return []MigrationKind{MigrationDropIndexedColumn}
+		}
+		return []MigrationKind{MigrationDropColumn}
+	case ModifyColumnStr, ChangeColumnStr:
+		return []MigrationKind{MigrationModifyColumn}
+	case SetDefaultStr, DropDefaultStr:
+		return []MigrationKind{MigrationSetDefault}
+	case RenameColumnStr:
+		return []MigrationKind{MigrationRenameColumn}
+	case DropIndexStr:
+		return []MigrationKind{MigrationDropIndex}
+	case DropPrimaryKeyStr:
+		return []MigrationKind{MigrationDropPrimaryKey}
+	case AddForeignKeyStr:
+		return []MigrationKind{MigrationAddForeignKey}
+	case DropForeignKeyStr:
+		return []MigrationKind{MigrationDropForeignKey}
+	case RenameIndexStr:
+		return []MigrationKind{MigrationRenameIndex}
+	case ConvertCharsetStr:
+		return []MigrationKind{MigrationConvertCharset}
+	case ForceRebuildStr:
+		return []MigrationKind{MigrationRebuildTable}
+	case TableOptionStr:
+		switch strings.TrimPrefix(spec.Option, "default ") {
+		case "algorithm", "lock":
+			return nil
+		case "engine":
+			return []MigrationKind{MigrationChangeEngine}
+		case "row_format", "key_block_size", "character set", "charset", "collate":
+			return []MigrationKind{MigrationRebuildTable}
+		}
+		return []MigrationKind{MigrationTableOption}
+	}
+	return nil
+}
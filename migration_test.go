@@ -0,0 +1,138 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClassifyMigration(t *testing.T) {
+	schema := NewSchema()
+	ddl, err := Parse("create table t (id int primary key, a int, b int, c text, key ab (a, b))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema.AddTable("t", ddl.(*DDL).TableSpec)
+
+	testcases := []struct {
+		in          string
+		version     MySQLVersion
+		actions     []string
+		safety      MigrationSafety
+		destructive bool
+	}{{
+		in:      "alter table t add column d int",
+		actions: []string{"add column d int: add column safe-online"},
+		safety:  MigrationSafeOnline,
+	}, {
+		in:      "alter table t add column d int",
+		version: MySQLVersion{5, 7, 30},
+		actions: []string{"add column d int: add column requires-table-copy"},
+		safety:  MigrationTableCopy,
+	}, {
+		in:      "alter table t add column d int after a, algorithm = instant",
+		version: MySQLVersion{8, 0, 28},
+		actions: []string{"add column d int after a: add positioned column requires-table-copy"},
+		safety:  MigrationTableCopy,
+	}, {
+		in: "alter table t add column n int auto_increment unique key, modify c varchar(10), add fulltext key ft (c)",
+		actions: []string{
+			"add column n int auto_increment unique key: add auto_increment column blocking",
+			"add column n int auto_increment unique key: add index safe-online",
+			"modify column c varchar(10): modify column blocking",
+			"add fulltext key ft (c): add fulltext index blocking",
+		},
+		safety: MigrationBlocking,
+	}, {
+		in: "alter table t drop column a, drop c, drop index ab",
+		actions: []string{
+			"drop column a: drop indexed column requires-table-copy",
+			"drop column c: drop column safe-online",
+			"drop index ab: drop index safe-online",
+		},
+		safety:      MigrationTableCopy,
+		destructive: true,
+	}, {
+		in:      "alter table t rename index ab to a_b",
+		version: MySQLVersion{5, 6, 51},
+		actions: []string{"rename index ab to a_b: rename index unknown"},
+		safety:  MigrationUnknown,
+	}, {
+		in: "alter table t engine = InnoDB, row_format = compressed, comment = 'x', convert to charset utf8mb4",
+		actions: []string{
+			"engine = InnoDB: change engine blocking",
+			"row_format = compressed: rebuild table requires-table-copy",
+			"comment = 'x': table option safe-online",
+			"convert to character set utf8mb4: convert character set blocking",
+		},
+		safety: MigrationBlocking,
+	}, {
+		in: "alter table t add constraint fk_a foreign key (a) references u (id), add column d int key",
+		actions: []string{
+			"add constraint fk_a foreign key (a) references u (id): add foreign key blocking",
+			"add column d int key: add column safe-online",
+			"add column d int key: add primary key requires-table-copy",
+		},
+		safety: MigrationBlocking,
+	}, {
+		in:      "drop index ab on t",
+		actions: []string{"drop index ab: drop index safe-online"},
+		safety:  MigrationSafeOnline,
+	}, {
+		in:      "alter table t partition by hash (id)",
+		actions: []string{"alter table t: unparsed alteration unknown"},
+		safety:  MigrationUnknown,
+	}, {
+		in:          "drop table t",
+		actions:     []string{"drop table t: drop table blocking"},
+		safety:      MigrationBlocking,
+		destructive: true,
+	}, {
+		in:          "truncate table t",
+		actions:     []string{"truncate table t: truncate table blocking"},
+		safety:      MigrationBlocking,
+		destructive: true,
+	}, {
+		in:      "create table u (id int)",
+		actions: []string{"create table u (\n\tid int\n): create table safe-online"},
+		safety:  MigrationSafeOnline,
+	}, {
+		in: "select * from t",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		report := ClassifyMigrationWithOptions(stmt, MigrationOptions{Version: tcase.version, Schema: schema})
+		var actions []string
+		for _, action := range report.Actions {
+			actions = append(actions, String(action.Node)+": "+string(action.Kind)+" "+action.Safety.String())
+			if action.Reason == "" {
+				t.Errorf("ClassifyMigration(%s): no reason for %s", tcase.in, action.Kind)
+			}
+		}
+		if !reflect.DeepEqual(actions, tcase.actions) {
+			t.Errorf("ClassifyMigration(%s):\n%q, want\n%q", tcase.in, actions, tcase.actions)
+		}
+		if report.Safety != tcase.safety || report.Destructive != tcase.destructive {
+			t.Errorf("ClassifyMigration(%s): %v, destructive %v, want %v, destructive %v", tcase.in, report.Safety, report.Destructive, tcase.safety, tcase.destructive)
+		}
+	}
+
+	// The report refers to the nodes of the AST.
+	stmt, err := Parse("alter table t drop column a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := ClassifyMigration(stmt)
+	if len(report.Actions) != 1 || report.Actions[0].Node != stmt.(*DDL).AlterSpecs[0] || report.Actions[0].Kind != MigrationDropColumn {
+		t.Errorf("ClassifyMigration: %+v", report.Actions)
+	}
+
+	rules := []MigrationRule{{Kind: MigrationDropColumn, Safety: MigrationBlocking, Reason: "not allowed"}}
+	report = ClassifyMigrationWithOptions(stmt, MigrationOptions{Rules: rules})
+	if report.Safety != MigrationBlocking || report.Actions[0].Reason != "not allowed" {
+		t.Errorf("ClassifyMigration with rules: %+v", report)
+	}
+}
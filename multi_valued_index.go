@@ -0,0 +1,82 @@
+package sqlparser
+
+// MultiValuedKeyPart returns the key part of index that makes it a
+// multi-valued index: the CAST(... AS ... ARRAY) of a JSON array, like
+// the cast(data -> '$.ids' as unsigned array) of
+// key ((cast(data -> '$.ids' as unsigned array))).
+func MultiValuedKeyPart(index *IndexDefinition) (*ConvertExpr, bool) {
+	if index == nil {
+		return nil, false
+	}
+	for _, col := range index.Columns {
+		if cast, ok := col.Expr.(*ConvertExpr); ok && cast.Type != nil && cast.Type.Array {
+			return cast, true
+		}
+	}
+	return nil, false
+}
+
+// MultiValuedIndexPredicates returns the predicates of where that can
+// use the multi-valued index: the conditions ANDed in where that are
+// a value MEMBER OF the array that the index casts, a JSON_CONTAINS of
+// the array, or a JSON_OVERLAPS of the array and another value. The
+// array must be written like in the index, except for the qualifiers
+// of its columns. It returns nil if index is not multi-valued.
+func MultiValuedIndexPredicates(index *IndexDefinition, where *Where) []Expr {
+	cast, ok := MultiValuedKeyPart(index)
+	if !ok || where == nil {
+		return nil
+	}
+	array := unqualifiedString(cast.Expr)
+	var preds []Expr
+	for _, pred := range splitConjuncts(where.Expr) {
+		if isMultiValuedPredicate(pred, func(expr Expr) bool { return unqualifiedString(expr) == array }) {
+			preds = append(preds, pred)
+		}
+	}
+	return preds
+}
+
+// isMultiValuedPredicate returns true if pred is a predicate that
+// a multi-valued index can serve, and match returns true for its
+// array.
+func isMultiValuedPredicate(pred Expr, match func(Expr) bool) bool {
+	switch pred := pred.(type) {
+	case *ComparisonExpr:
+		return pred.Operator == MemberOfStr && match(pred.Right)
+	case *FuncExpr:
+		if !pred.Qualifier.IsEmpty() || pred.Distinct || len(pred.Exprs) != 2 {
+			return false
+		}
+		var args [2]Expr
+		for i, expr := range pred.Exprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				return false
+			}
+			args[i] = aliased.Expr
+		}
+		switch pred.Name.Lowered() {
+		case "json_contains":
+			return match(args[0])
+		case "json_overlaps":
+			return match(args[0]) || match(args[1])
+		}
+	}
+	return false
+}
+
+// unqualifiedString returns expr formatted without the qualifiers of
+// its columns.
+func unqualifiedString(expr Expr) string {
+	buf := NewTrackedBuffer(func(buf *TrackedBuffer, node SQLNode) {
+		if col, ok := node.(*ColName); ok {
+			buf.Myprintf("%v", col.Name)
+			return
+		}
+		node.Format(buf)
+	})
+	buf.Style = FormatStyle{}
+	buf.Myprintf("%v", expr)
+	return buf.String()
+}
@@ -0,0 +1,76 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMultiValuedKeyPart(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "create index idx on t ((cast(data->'$.ids' as unsigned array)))",
+		out: "cast(data -> '$.ids' as unsigned array)",
+	}, {
+		in:  "alter table t add unique key idx (a, (cast(data->'$.zips' as char(10) array)))",
+		out: "cast(data -> '$.zips' as char(10) array)",
+	}, {
+		in: "alter table t add key idx ((cast(data->'$.id' as unsigned)))",
+	}, {
+		in: "create index idx on t (a)",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		out := ""
+		if cast, ok := MultiValuedKeyPart(stmt.(*DDL).AlterSpecs[0].Index); ok {
+			out = String(cast)
+		}
+		if out != tcase.out {
+			t.Errorf("MultiValuedKeyPart(%q): %q, want %q", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestMultiValuedIndexPredicates(t *testing.T) {
+	stmt, err := Parse("create index idx on t ((cast(data->'$.ids' as unsigned array)))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := stmt.(*DDL).AlterSpecs[0].Index
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in:  "select * from t where 17 member of (data->'$.ids')",
+		out: []string{"17 member of (data -> '$.ids')"},
+	}, {
+		in: "select * from t where a = 1 and json_contains(t.data->'$.ids', '[1, 2]') and JSON_OVERLAPS('[3]', data->'$.ids')",
+		out: []string{
+			"json_contains(t.data -> '$.ids', '[1, 2]')",
+			"JSON_OVERLAPS('[3]', data -> '$.ids')",
+		},
+	}, {
+		in: "select * from t where 17 member of (data->'$.zips') or json_contains('[1]', data->'$.ids')",
+	}, {
+		in: "select * from t",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		var out []string
+		for _, pred := range MultiValuedIndexPredicates(index, stmt.(*Select).Where) {
+			out = append(out, String(pred))
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("MultiValuedIndexPredicates(%q): %q, want %q", tcase.in, out, tcase.out)
+		}
+	}
+}
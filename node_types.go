@@ -0,0 +1,151 @@
+package sqlparser
+
+// ForEachNodeType calls fn with an example of every type of SQLNode,
+// in the order of their names. The examples are zero values: they are
+// meant for type switches, like a test that a visitor handles every
+// type of node, and may not format or walk. fn gets new examples on
+// every call.
+func ForEachNodeType(fn func(node SQLNode)) {
+	for _, node := range []SQLNode{
+		&AliasedExpr{},
+		&AliasedTableExpr{},
+		&AlterSpec{},
+		&AndExpr{},
+		&Begin{},
+		&BinaryExpr{},
+		BoolVal(false),
+		&Call{},
+		&CaseExpr{},
+		ColIdent{},
+		&ColName{},
+		&CollateExpr{},
+		&ColumnDefinition{},
+		&ColumnType{},
+		Columns{},
+		Comments{},
+		&Commit{},
+		&CommonTableExpr{},
+		&ComparisonExpr{},
+		&ConvertExpr{},
+		&ConvertType{},
+		&ConvertUsingExpr{},
+		&CreateEvent{},
+		&CreateFunction{},
+		&CreateProcedure{},
+		&CreateTrigger{},
+		&DBDDL{},
+		&DDL{},
+		&Deallocate{},
+		&Default{},
+		&Delete{},
+		&DescribeTable{},
+		&Do{},
+		&DropEvent{},
+		&DropRoutine{},
+		&DropTrigger{},
+		&EmptyInExpr{},
+		&Execute{},
+		&ExistsExpr{},
+		&ExtensionExpr{},
+		Exprs{},
+		&ExtractExpr{},
+		&Flush{},
+		&FrameBound{},
+		&FuncExpr{},
+		GroupBy{},
+		&GroupConcatExpr{},
+		&Handler{},
+		&IndexCache{},
+		&IndexCacheTable{},
+		IndexCacheTables{},
+		&IndexDefinition{},
+		&ForeignKeyDefinition{},
+		IndexHintList{},
+		&IndexHints{},
+		&IndexInfo{},
+		&Insert{},
+		&IntervalExpr{},
+		&IsExpr{},
+		JoinCondition{},
+		&JoinTableExpr{},
+		&Kill{},
+		&Limit{},
+		ListArg{},
+		&LockTables{},
+		&MatchExpr{},
+		&NamedWindow{},
+		NamedWindows{},
+		Nextval{},
+		&NextValueExpr{},
+		&NotExpr{},
+		&NullVal{},
+		&OnConflict{},
+		OnDup{},
+		&OptLike{},
+		&OrExpr{},
+		&Order{},
+		OrderBy{},
+		&OtherAdmin{},
+		&OtherRead{},
+		&Over{},
+		&ParenExpr{},
+		&ParenSelect{},
+		&ParenTableExpr{},
+		&PartitionDefinition{},
+		&PartitionSpec{},
+		Partitions{},
+		&PositionExpr{},
+		&Prepare{},
+		&ProcParam{},
+		ProcParams{},
+		&RangeCond{},
+		&Replication{},
+		&Rollback{},
+		&RowAlias{},
+		&SQLVal{},
+		&Select{},
+		SelectExprs{},
+		&SelectInto{},
+		&Set{},
+		&SetExpr{},
+		SetExprs{},
+		&Show{},
+		&ShowFilter{},
+		&StarExpr{},
+		&Stream{},
+		&Subquery{},
+		&SubstrExpr{},
+		TableExprs{},
+		TableIdent{},
+		&TableLock{},
+		TableLocks{},
+		&TableMaintenance{},
+		TableName{},
+		TableNames{},
+		&TableSpec{},
+		&TrimExpr{},
+		&UnaryExpr{},
+		&Union{},
+		&UnlockTables{},
+		&Update{},
+		&UpdateExpr{},
+		UpdateExprs{},
+		&Use{},
+		ValTuple{},
+		Values{},
+		&ValuesFuncExpr{},
+		&ViewSpec{},
+		VindexParam{},
+		&VindexSpec{},
+		&WeightStringExpr{},
+		&When{},
+		&Where{},
+		&WindowFrame{},
+		&WindowSpec{},
+		&With{},
+		&XATransaction{},
+		&Xid{},
+	} {
+		fn(node)
+	}
+}
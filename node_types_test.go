@@ -0,0 +1,106 @@
+package sqlparser
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/corpus"
+)
+
+// nodeTypeNames returns the names of the types of the examples of
+// ForEachNodeType, and whether they are pointers.
+func nodeTypeNames() map[string]bool {
+	names := make(map[string]bool)
+	ForEachNodeType(func(node SQLNode) {
+		typ := reflect.TypeOf(node)
+		pointer := typ.Kind() == reflect.Ptr
+		if pointer {
+			typ = typ.Elem()
+		}
+		names[typ.Name()] = pointer
+	})
+	return names
+}
+
+func TestForEachNodeType(t *testing.T) {
+	// The types of nodes are the ones with a walkSubtree method.
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make(map[string]bool)
+	for _, file := range pkgs["sqlparser"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "walkSubtree" {
+				continue
+			}
+			typ := fn.Recv.List[0].Type
+			star, pointer := typ.(*ast.StarExpr)
+			if pointer {
+				typ = star.X
+			}
+			want[typ.(*ast.Ident).Name] = pointer
+		}
+	}
+	got := nodeTypeNames()
+	var missing, extra []string
+	for name, pointer := range want {
+		if gotPointer, ok := got[name]; !ok || gotPointer != pointer {
+			missing = append(missing, name)
+		}
+	}
+	for name := range got {
+		if _, ok := want[name]; !ok {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+	if len(missing) != 0 || len(extra) != 0 {
+		t.Errorf("ForEachNodeType: missing or wrong %v, extra %v", missing, extra)
+	}
+}
+
+func TestCorpusNodeTypes(t *testing.T) {
+	names := nodeTypeNames()
+	for _, query := range corpus.Queries() {
+		tree, err := Parse(query.Input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", query.Input, err)
+			continue
+		}
+		_ = Walk(func(node SQLNode) (bool, error) {
+			typ := reflect.TypeOf(node)
+			if typ.Kind() == reflect.Ptr {
+				typ = typ.Elem()
+			}
+			if _, ok := names[typ.Name()]; !ok {
+				t.Errorf("Parse(%q): node %T is not a node type of ForEachNodeType", query.Input, node)
+			}
+			return true, nil
+		}, tree)
+	}
+}
+
+func TestNodeNameComplete(t *testing.T) {
+	// NodeName must name every type of node like its Go type.
+	ForEachNodeType(func(node SQLNode) {
+		typ := reflect.TypeOf(node)
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if got := NodeName(node); got != typ.Name() {
+			t.Errorf("NodeName(%T): %q, want %q", node, got, typ.Name())
+		}
+	})
+}
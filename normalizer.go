@@ -17,7 +17,13 @@ limitations under the License.
 package sqlparser
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+	"unicode/utf8"
 
 	"github.com/xwb1989/sqlparser/dependency/sqltypes"
 
@@ -25,17 +31,183 @@ import (
 )
 
 // Normalize changes the statement to use bind values, and
-// updates the bind vars to those values. The supplied prefix
-// is used to generate the bind var names. The function ensures
-// that there are no collisions with existing bind vars.
-// Within Select constructs, bind vars are deduped. This allows
-// us to identify vindex equality. Otherwise, every value is
-// treated as distinct.
+// updates the bind vars to those values. It changes stmt in
+// place, including the values of its SQLVals: use Normalized to
+// normalize a statement that's shared, like a cached one. The
+// supplied prefix is used to generate the bind var names. The
+// function ensures that there are no collisions with existing
+// bind vars. Within Select constructs, bind vars are deduped.
+// This allows us to identify vindex equality. Identical IN lists
+// are deduped the same way, but never share a bind var with
+// scalar values. Otherwise, every value is treated as distinct.
+//
+// The boolean literals TRUE and FALSE are left in place: they're
+// usually part of the shape of a query, like in WHERE deleted = FALSE,
+// rather than a value that changes between executions. Use
+// NormalizeWithOptions to turn them into bind vars too.
+//
+// NULL is left in place as well, since a NULL bind var has no type,
+// except in the lists of IN and NOT IN, where it's a NULL_TYPE value
+// of the list bind var, like in a in (1, null), so that the other values
+// of the list are normalized too. See NormalizeOptions.SkipNullsInLists.
+//
+// The positions of ORDER BY and GROUP BY, like the 2 of ORDER BY 2,
+// are not values and are left in place too.
 func Normalize(stmt Statement, bindVars map[string]*querypb.BindVariable, prefix string) {
+	NormalizeWithOptions(stmt, bindVars, prefix, NormalizeOptions{})
+}
+
+// NormalizeOptions controls optional behavior of NormalizeWithOptions.
+type NormalizeOptions struct {
+	// BoolVals makes TRUE and FALSE bind vars like other
+	// literals. They're the Int64 values 1 and 0, which is
+	// what they stand for in MySQL.
+	BoolVals bool
+	// SkipNullsInLists drops the NULLs of the lists of IN, instead
+	// of adding NULL_TYPE values to the list bind var: a in (1, null)
+	// is true for the same rows as a in (1). The NULLs of NOT IN are
+	// kept, since a NOT IN with a NULL is never true, and so are the
+	// ones of row constructors, and of lists that only hold NULLs.
+	SkipNullsInLists bool
+}
+
+// NormalizeWithOptions is the same as Normalize except its behavior
+// is controlled by opts.
+func NormalizeWithOptions(stmt Statement, bindVars map[string]*querypb.BindVariable, prefix string, opts NormalizeOptions) {
 	nz := newNormalizer(stmt, bindVars, prefix)
+	nz.skipNulls = opts.SkipNullsInLists
+	nz.boolVals = opts.BoolVals
 	_ = Walk(nz.WalkStatement, stmt)
 }
 
+// Normalized is the same as Normalize, except that it leaves stmt
+// untouched: it returns a normalized copy of stmt, which shares
+// nothing with it, see DeepCopy, and its bind vars.
+func Normalized(stmt Statement, prefix string) (Statement, map[string]*querypb.BindVariable, error) {
+	return NormalizedWithOptions(stmt, prefix, NormalizeOptions{})
+}
+
+// NormalizedWithOptions is the same as Normalized except its behavior
+// is controlled by opts.
+func NormalizedWithOptions(stmt Statement, prefix string, opts NormalizeOptions) (Statement, map[string]*querypb.BindVariable, error) {
+	if stmt == nil {
+		return nil, nil, errors.New("cannot normalize a nil statement")
+	}
+	normalized := DeepCopy(stmt).(Statement)
+	bindVars := make(map[string]*querypb.BindVariable)
+	NormalizeWithOptions(normalized, bindVars, prefix, opts)
+	return normalized, bindVars, nil
+}
+
+// CanonicalPrefix is the prefix of the bind var names
+// of NormalizeCanonical.
+const CanonicalPrefix = "v"
+
+// NormalizeCanonical is the same as Normalize, except that the names
+// of the bind vars don't depend on the caller, so that the same query
+// is normalized to the same text everywhere: the bind vars are named
+// CanonicalPrefix followed by 1, 2, and so on, in the order in which
+// they appear in stmt. This includes the bind vars that stmt already
+// had, which are renamed: a = :id and b = 1 becomes a = :v1 and b = :v2,
+// and so does a = ? and b = 2.
+//
+// The values of bindVars that are named after the bind vars of stmt
+// are renamed too, and renames maps the old names to the new ones, so
+// that callers can remap the values they hold elsewhere.
+func NormalizeCanonical(stmt Statement, bindVars map[string]*querypb.BindVariable) (renames map[string]string) {
+	reserved := GetBindvars(stmt)
+	// The values are normalized first, with names that don't collide
+	// with the bind vars of stmt, and then every bind var is renamed.
+	Normalize(stmt, bindVars, CanonicalPrefix)
+
+	names := make(map[string]string)
+	rename := func(name string) string {
+		newName, ok := names[name]
+		if !ok {
+			newName = fmt.Sprintf("%s%d", CanonicalPrefix, len(names)+1)
+			names[name] = newName
+		}
+		return newName
+	}
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *SQLVal:
+			if node.Type == ValArg {
+				node.Val = append([]byte(":"), rename(string(node.Val[1:]))...)
+			}
+		case *ComparisonExpr:
+			// ListArgs are not pointers, so they're
+			// renamed through their comparison.
+			if list, ok := node.Right.(ListArg); ok {
+				node.Right = ListArg(append([]byte("::"), rename(string(list[2:]))...))
+			}
+		}
+		return true, nil
+	}, stmt)
+
+	// The new names can be the old names of other bind vars,
+	// so all of them are removed before they're added back.
+	renamed := make(map[string]*querypb.BindVariable, len(names))
+	for oldName, newName := range names {
+		if bv, ok := bindVars[oldName]; ok {
+			renamed[newName] = bv
+			delete(bindVars, oldName)
+		}
+	}
+	for name, bv := range renamed {
+		bindVars[name] = bv
+	}
+	renames = make(map[string]string)
+	for oldName := range reserved {
+		renames[oldName] = names[oldName]
+	}
+	return renames
+}
+
+// boolValsToInts replaces the BoolVals that are children of node
+// with the integers they stand for. BoolVals are values, so the walk
+// can't change them in place like SQLVals: they're replaced through
+// their parent before the walk gets to them, and are then normalized
+// like the other SQLVals.
+func boolValsToInts(node SQLNode) {
+	v := reflect.ValueOf(node)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			boolValToInt(v.Field(i))
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			boolValToInt(v.Index(i))
+		}
+	}
+}
+
+// boolValToInt replaces the BoolVal held by v, if it holds one,
+// with the integer it stands for.
+func boolValToInt(v reflect.Value) {
+	if v.Kind() != reflect.Interface || v.IsNil() || !v.CanSet() {
+		return
+	}
+	b, ok := v.Interface().(BoolVal)
+	if !ok {
+		return
+	}
+	val := NewIntVal([]byte("0"))
+	if b {
+		val = NewIntVal([]byte("1"))
+	}
+	if reflect.TypeOf(val).AssignableTo(v.Type()) {
+		v.Set(reflect.ValueOf(val))
+	}
+}
+
 type normalizer struct {
 	stmt     Statement
 	bindVars map[string]*querypb.BindVariable
@@ -43,6 +215,12 @@ type normalizer struct {
 	reserved map[string]struct{}
 	counter  int
 	vals     map[string]string
+	// skipNulls drops the NULLs of IN lists, see
+	// NormalizeOptions.SkipNullsInLists.
+	skipNulls bool
+	// boolVals makes bind vars of TRUE and FALSE, see
+	// NormalizeOptions.BoolVals.
+	boolVals bool
 }
 
 func newNormalizer(stmt Statement, bindVars map[string]*querypb.BindVariable, prefix string) *normalizer {
@@ -60,6 +238,7 @@ func newNormalizer(stmt Statement, bindVars map[string]*querypb.BindVariable, pr
 // If it encounters a Select, it switches to a mode
 // where variables are deduped.
 func (nz *normalizer) WalkStatement(node SQLNode) (bool, error) {
+	nz.convertBoolVals(node)
 	switch node := node.(type) {
 	case *Select:
 		_ = Walk(nz.WalkSelect, node)
@@ -68,22 +247,53 @@ func (nz *normalizer) WalkStatement(node SQLNode) (bool, error) {
 	case *SQLVal:
 		nz.convertSQLVal(node)
 	case *ComparisonExpr:
-		nz.convertComparison(node)
+		nz.convertComparison(node, false)
+	case *Order:
+		_, ok := positionalRef(node.Expr)
+		return !ok, nil
 	}
 	return true, nil
 }
 
 // WalkSelect normalizes the AST in Select mode.
 func (nz *normalizer) WalkSelect(node SQLNode) (bool, error) {
+	nz.convertBoolVals(node)
 	switch node := node.(type) {
 	case *SQLVal:
 		nz.convertSQLValDedup(node)
 	case *ComparisonExpr:
-		nz.convertComparison(node)
+		nz.convertComparison(node, true)
+	case *Order:
+		// ORDER BY 2 orders by the second column, and ORDER BY :bv1
+		// by a constant: positions are not values.
+		_, ok := positionalRef(node.Expr)
+		return !ok, nil
+	case GroupBy:
+		for _, expr := range node {
+			if _, ok := positionalRef(expr); !ok {
+				_ = Walk(nz.WalkSelect, expr)
+			}
+		}
+		return false, nil
 	}
 	return true, nil
 }
 
+// convertBoolVals makes SQLVals of the BoolVals that are children of
+// node, if boolVals is set. The ones of ORDER BY and GROUP BY are left
+// in place: ORDER BY TRUE orders by a constant, but ORDER BY 1 orders
+// by the first column.
+func (nz *normalizer) convertBoolVals(node SQLNode) {
+	if !nz.boolVals {
+		return
+	}
+	switch node.(type) {
+	case *Order, GroupBy:
+		return
+	}
+	boolValsToInts(node)
+}
+
 func (nz *normalizer) convertSQLValDedup(node *SQLVal) {
 	// If value is too long, don't dedup.
 	// Such values are most likely not for vindexes.
@@ -102,12 +312,20 @@ func (nz *normalizer) convertSQLValDedup(node *SQLVal) {
 
 	// Check if there's a bindvar for that value already.
 	var key string
-	if bval.Type == sqltypes.VarBinary {
+	switch bval.Type {
+	case sqltypes.VarBinary:
 		// Prefixing strings with "'" ensures that a string
 		// and number that have the same representation don't
 		// collide.
 		key = "'" + string(node.Val)
-	} else {
+	case sqltypes.Date, sqltypes.Time, sqltypes.Datetime:
+		// Likewise, dates and times must not collide with
+		// strings.
+		key = bval.Type.String() + "'" + string(node.Val)
+	case sqltypes.Decimal:
+		// And decimals must not collide with floats.
+		key = bval.Type.String() + ":" + string(node.Val)
+	default:
 		key = string(node.Val)
 	}
 	bvname, ok := nz.vals[key]
@@ -138,37 +356,130 @@ func (nz *normalizer) convertSQLVal(node *SQLVal) {
 }
 
 // convertComparison attempts to convert IN clauses to
-// use the list bind var construct. If it fails, it returns
-// with no change made. The walk function will then continue
-// and iterate on converting each individual value into separate
-// bind vars.
-func (nz *normalizer) convertComparison(node *ComparisonExpr) {
-	if node.Operator != InStr && node.Operator != NotInStr {
+// use the list bind var construct. The list is only changed once
+// all of its members are known to be values: if one isn't, like a
+// subquery or a column, it returns with no change made. The walk
+// function will then continue and iterate on converting each
+// individual value into separate bind vars, leaving the other
+// members as they are. If dedup is set, identical lists share
+// the same bind var.
+func (nz *normalizer) convertComparison(node *ComparisonExpr, dedup bool) {
+	bvals := nz.listBindvar(node)
+	if bvals == nil {
 		return
 	}
+	var bvname string
+	if dedup {
+		bvname = nz.tupleName(bvals)
+	} else {
+		bvname = nz.newName()
+		nz.bindVars[bvname] = bvals
+	}
+	// Modify RHS to be a list bindvar.
+	node.Right = ListArg(append([]byte("::"), bvname...))
+}
+
+// listBindvar returns the list bindvar for the right side of an
+// IN clause, or nil if it's not a tuple of values. For a row
+// constructor like (a, b) in ((1, 2), (3, 4)), the values of the
+// list are themselves tuples, see sqltypes.TupleToProto. NULLs
+// are NULL_TYPE values, or are dropped if skipNulls is set.
+func (nz *normalizer) listBindvar(node *ComparisonExpr) *querypb.BindVariable {
+	if node.Operator != InStr && node.Operator != NotInStr {
+		return nil
+	}
 	tupleVals, ok := node.Right.(ValTuple)
 	if !ok {
-		return
+		return nil
 	}
 	// The RHS is a tuple of values.
 	// Make a list bindvar.
 	bvals := &querypb.BindVariable{
 		Type: querypb.Type_TUPLE,
 	}
+	left, isRow := node.Left.(ValTuple)
+	var nulls []*querypb.Value
 	for _, val := range tupleVals {
-		bval := nz.sqlToBindvar(val)
-		if bval == nil {
-			return
+		if !isRow {
+			bval := nz.listElemBindvar(val)
+			if bval == nil {
+				return nil
+			}
+			value := &querypb.Value{
+				Type:  bval.Type,
+				Value: bval.Value,
+			}
+			if bval.Type == querypb.Type_NULL_TYPE && nz.skipNulls && node.Operator == InStr {
+				nulls = append(nulls, value)
+				continue
+			}
+			bvals.Values = append(bvals.Values, value)
+			continue
+		}
+		row, ok := val.(ValTuple)
+		if !ok || len(row) != len(left) {
+			return nil
 		}
-		bvals.Values = append(bvals.Values, &querypb.Value{
-			Type:  bval.Type,
-			Value: bval.Value,
-		})
+		values := make([]*querypb.Value, 0, len(row))
+		for _, rval := range row {
+			bval := nz.listElemBindvar(rval)
+			if bval == nil {
+				return nil
+			}
+			values = append(values, &querypb.Value{
+				Type:  bval.Type,
+				Value: bval.Value,
+			})
+		}
+		bvals.Values = append(bvals.Values, sqltypes.TupleToProto(values))
 	}
-	bvname := nz.newName()
-	nz.bindVars[bvname] = bvals
-	// Modify RHS to be a list bindvar.
-	node.Right = ListArg(append([]byte("::"), bvname...))
+	if len(bvals.Values) == 0 {
+		// A list bind var can't be empty.
+		bvals.Values = nulls
+	}
+	return bvals
+}
+
+// listElemBindvar is sqlToBindvar for the values of a list, where
+// NULL is a NULL_TYPE value.
+func (nz *normalizer) listElemBindvar(node Expr) *querypb.BindVariable {
+	if _, ok := node.(*NullVal); ok {
+		return sqltypes.NullBindVariable
+	}
+	if b, ok := node.(BoolVal); ok && nz.boolVals {
+		if b {
+			return sqltypes.Int64BindVariable(1)
+		}
+		return sqltypes.Int64BindVariable(0)
+	}
+	return nz.sqlToBindvar(node)
+}
+
+// tupleName returns the name of the list bindvar for bvals,
+// reusing the one of an identical list if there's one.
+func (nz *normalizer) tupleName(bvals *querypb.BindVariable) string {
+	// The key starts with "(", which can't be the start of
+	// a scalar key. Every value is prefixed with its type and
+	// length, so that different lists can't have the same key.
+	var key bytes.Buffer
+	key.WriteByte('(')
+	for _, val := range bvals.Values {
+		// Like for scalars, long values are not deduped.
+		if len(val.Value) > 256 {
+			bvname := nz.newName()
+			nz.bindVars[bvname] = bvals
+			return bvname
+		}
+		fmt.Fprintf(&key, "%d:%d:", val.Type, len(val.Value))
+		key.Write(val.Value)
+	}
+	bvname, ok := nz.vals[key.String()]
+	if !ok {
+		bvname = nz.newName()
+		nz.vals[key.String()] = bvname
+		nz.bindVars[bvname] = bvals
+	}
+	return bvname
 }
 
 func (nz *normalizer) sqlToBindvar(node SQLNode) *querypb.BindVariable {
@@ -180,8 +491,25 @@ func (nz *normalizer) sqlToBindvar(node SQLNode) *querypb.BindVariable {
 			v, err = sqltypes.NewValue(sqltypes.VarBinary, node.Val)
 		case IntVal:
 			v, err = sqltypes.NewValue(sqltypes.Int64, node.Val)
+			// The integers out of the range of an int64 are bound
+			// as unsigned, or else as decimals, like MySQL types
+			// them.
+			if err != nil {
+				v, err = sqltypes.NewValue(sqltypes.Uint64, node.Val)
+			}
+			if err != nil {
+				v, err = sqltypes.NewValue(sqltypes.Decimal, node.Val)
+			}
 		case FloatVal:
 			v, err = sqltypes.NewValue(sqltypes.Float64, node.Val)
+		case DecimalVal:
+			v, err = sqltypes.NewValue(sqltypes.Decimal, node.Val)
+		case DateVal:
+			v, err = sqltypes.NewValue(sqltypes.Date, node.Val)
+		case TimeVal:
+			v, err = sqltypes.NewValue(sqltypes.Time, node.Val)
+		case TimestampVal:
+			v, err = sqltypes.NewValue(sqltypes.Datetime, node.Val)
 		default:
 			return nil
 		}
@@ -222,3 +550,122 @@ func GetBindvars(stmt Statement) map[string]struct{} {
 	}, stmt)
 	return bindvars
 }
+
+// jsonBindVar is the JSON form of a bind variable, or of a value
+// of a tuple, see BindVarsToJSON.
+type jsonBindVar struct {
+	Type   string          `json:"type"`
+	Value  json.RawMessage `json:"value,omitempty"`
+	Hex    string          `json:"hex,omitempty"`
+	Values []*jsonBindVar  `json:"values,omitempty"`
+}
+
+// BindVarsToJSON returns the JSON form of bindVars, like the ones
+// Normalize produces, for logging and debugging. The names are sorted,
+// and every bind variable is an object with its type name and its
+// value: a number for numeric types, and a string for the others,
+// except for bytes that aren't valid UTF-8, which are a hex string
+// under "hex" instead. A tuple has the list of its values as "values",
+// which are tuples too for row constructors like (a, b) in ::bv1.
+// For example:
+//
+//	{"bv1":{"type":"INT64","value":1},"bv2":{"type":"TUPLE","values":[{"type":"VARBINARY","value":"a"}]}}
+func BindVarsToJSON(bindVars map[string]*querypb.BindVariable) ([]byte, error) {
+	out := make(map[string]*jsonBindVar, len(bindVars))
+	for name, bv := range bindVars {
+		if bv == nil {
+			return nil, &BindVarError{Name: name, msg: "nil bind var " + name, err: ErrInvalidBindVar}
+		}
+		jbv := newJSONBindVar(bv.Type, bv.Value)
+		for _, v := range bv.Values {
+			jv := newJSONBindVar(v.Type, v.Value)
+			if v.Type == querypb.Type_TUPLE {
+				values, err := sqltypes.ProtoToTuple(v)
+				if err != nil {
+					return nil, &BindVarError{Name: name, msg: fmt.Sprintf("invalid bind var %s: %v", name, err), err: ErrInvalidBindVar}
+				}
+				for _, tv := range values {
+					jv.Values = append(jv.Values, newJSONBindVar(tv.Type, tv.Value))
+				}
+			}
+			jbv.Values = append(jbv.Values, jv)
+		}
+		out[name] = jbv
+	}
+	// encoding/json sorts map keys.
+	return json.Marshal(out)
+}
+
+func newJSONBindVar(typ querypb.Type, val []byte) *jsonBindVar {
+	jbv := &jsonBindVar{Type: typ.String()}
+	switch {
+	case typ == querypb.Type_NULL_TYPE || typ == querypb.Type_TUPLE:
+	case (sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ)) && len(val) != 0 && val[0] != '"' && json.Valid(val):
+		jbv.Value = json.RawMessage(val)
+	case utf8.Valid(val):
+		// Marshaling a string can't fail.
+		jbv.Value, _ = json.Marshal(string(val))
+	default:
+		jbv.Hex = hex.EncodeToString(val)
+	}
+	return jbv
+}
+
+// BindVarsFromJSON is the inverse of BindVarsToJSON. Numbers can
+// also be given as strings. The errors about the bind variables
+// are a *BindVarError.
+func BindVarsFromJSON(data []byte) (map[string]*querypb.BindVariable, error) {
+	var in map[string]*jsonBindVar
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, err
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(in))
+	for name, jbv := range in {
+		bv, err := jbv.bindVar()
+		if err == nil {
+			err = sqltypes.ValidateBindVariable(bv)
+		}
+		if err != nil {
+			return nil, &BindVarError{Name: name, msg: fmt.Sprintf("invalid bind var %s: %v", name, err), err: ErrInvalidBindVar}
+		}
+		bindVars[name] = bv
+	}
+	return bindVars, nil
+}
+
+func (jbv *jsonBindVar) bindVar() (*querypb.BindVariable, error) {
+	if jbv == nil {
+		return nil, errors.New("null bind var")
+	}
+	typ, ok := querypb.Type_value[jbv.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", jbv.Type)
+	}
+	bv := &querypb.BindVariable{Type: querypb.Type(typ)}
+	var err error
+	switch {
+	case jbv.Hex != "":
+		bv.Value, err = hex.DecodeString(jbv.Hex)
+	case len(jbv.Value) != 0 && jbv.Value[0] == '"':
+		var s string
+		err = json.Unmarshal(jbv.Value, &s)
+		bv.Value = []byte(s)
+	case len(jbv.Value) != 0:
+		bv.Value = []byte(jbv.Value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, jv := range jbv.Values {
+		v, err := jv.bindVar()
+		if err != nil {
+			return nil, err
+		}
+		if v.Type == querypb.Type_TUPLE {
+			bv.Values = append(bv.Values, sqltypes.TupleToProto(v.Values))
+			continue
+		}
+		bv.Values = append(bv.Values, &querypb.Value{Type: v.Type, Value: v.Value})
+	}
+	return bv, nil
+}
@@ -38,6 +38,45 @@ func TestNormalize(t *testing.T) {
 		outbv: map[string]*querypb.BindVariable{
 			"bv1": sqltypes.BytesBindVariable([]byte("aa")),
 		},
+	}, {
+		// spatial functions
+		in:      "select * from t where st_contains(g, st_geomfromtext('POINT(1 2)', 4326)) and st_distance(g, point(1, 2)) < 10",
+		outstmt: "select * from t where st_contains(g, st_geomfromtext(:bv1, :bv2)) and st_distance(g, point(:bv3, :bv4)) < :bv5",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.BytesBindVariable([]byte("POINT(1 2)")),
+			"bv2": sqltypes.Int64BindVariable(4326),
+			"bv3": sqltypes.Int64BindVariable(1),
+			"bv4": sqltypes.Int64BindVariable(2),
+			"bv5": sqltypes.Int64BindVariable(10),
+		},
+	}, {
+		// NULL in an IN list
+		in:      "select * from t where v1 = null and v2 in (1, null, 'a')",
+		outstmt: "select * from t where v1 = null and v2 in ::bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": {
+				Type: querypb.Type_TUPLE,
+				Values: []*querypb.Value{
+					{Type: sqltypes.Int64, Value: []byte("1")},
+					{Type: sqltypes.Null},
+					{Type: sqltypes.VarBinary, Value: []byte("a")},
+				},
+			},
+		},
+	}, {
+		// parenthesized select
+		in:      "((select * from t where v1 = 1))",
+		outstmt: "((select * from t where v1 = :bv1))",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		// bind vars in limit are kept
+		in:      "select * from t where v1 = 1 limit :count offset :skip",
+		outstmt: "select * from t where v1 = :bv1 limit :skip, :count",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+		},
 	}, {
 		// int val
 		in:      "select * from t where v1 = 1",
@@ -47,17 +86,80 @@ func TestNormalize(t *testing.T) {
 		},
 	}, {
 		// float val
-		in:      "select * from t where v1 = 1.2",
+		in:      "select * from t where v1 = 1.2e0",
+		outstmt: "select * from t where v1 = :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Float64, []byte("1.2e0"))),
+		},
+	}, {
+		// numeric edge cases
+		in:      "select * from t where a = 1e10 and b = 1.5E-3 and c = .5 and d = 5. and e = 18446744073709551615 and f = 99999999999999999999999",
+		outstmt: "select * from t where a = :bv1 and b = :bv2 and c = :bv3 and d = :bv4 and e = :bv5 and f = :bv6",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Float64, []byte("1e10"))),
+			"bv2": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Float64, []byte("1.5E-3"))),
+			"bv3": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte(".5"))),
+			"bv4": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("5."))),
+			"bv5": sqltypes.Uint64BindVariable(18446744073709551615),
+			"bv6": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("99999999999999999999999"))),
+		},
+	}, {
+		// integers out of the range of an int64 in insert values
+		// and limits
+		in:      "insert into t values (9223372036854775808, 99999999999999999999999, .5)",
+		outstmt: "insert into t values (:bv1, :bv2, :bv3)",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Uint64BindVariable(9223372036854775808),
+			"bv2": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("99999999999999999999999"))),
+			"bv3": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte(".5"))),
+		},
+	}, {
+		in:      "select * from t limit 18446744073709551615",
+		outstmt: "select * from t limit :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Uint64BindVariable(18446744073709551615),
+		},
+	}, {
+		// decimal val
+		in:      "select * from t where v1 = 1.20",
 		outstmt: "select * from t where v1 = :bv1",
 		outbv: map[string]*querypb.BindVariable{
-			"bv1": sqltypes.Float64BindVariable(1.2),
+			"bv1": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.20"))),
+		},
+	}, {
+		// decimals are deduped by their text, and
+		// never share bind vars with floats
+		in:      "select * from t where a = 10.5 or b = 10.50 or c = 1.05e1 or d = 10.5",
+		outstmt: "select * from t where a = :bv1 or b = :bv2 or c = :bv3 or d = :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("10.5"))),
+			"bv2": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("10.50"))),
+			"bv3": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Float64, []byte("1.05e1"))),
+		},
+	}, {
+		// function arguments with keyword syntax
+		in:      "select * from t where extract(year from a) = 1 and position('x' in b) = 2 and trim(leading 'y' from c) = 'z'",
+		outstmt: "select * from t where extract(year from a) = :bv1 and position(:bv2 in b) = :bv3 and trim(leading :bv4 from c) = :bv5",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.BytesBindVariable([]byte("x")),
+			"bv3": sqltypes.Int64BindVariable(2),
+			"bv4": sqltypes.BytesBindVariable([]byte("y")),
+			"bv5": sqltypes.BytesBindVariable([]byte("z")),
+		},
+	}, {
+		// create table as select
+		in:      "create table a as select * from t where v1 = 1",
+		outstmt: "create table a as select * from t where v1 = :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
 		},
 	}, {
 		// multiple vals
 		in:      "select * from t where v1 = 1.2 and v2 = 2",
 		outstmt: "select * from t where v1 = :bv1 and v2 = :bv2",
 		outbv: map[string]*querypb.BindVariable{
-			"bv1": sqltypes.Float64BindVariable(1.2),
+			"bv1": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.2"))),
 			"bv2": sqltypes.Int64BindVariable(2),
 		},
 	}, {
@@ -90,6 +192,14 @@ func TestNormalize(t *testing.T) {
 			"bv1": sqltypes.Int64BindVariable(1),
 			"bv2": sqltypes.Int64BindVariable(1),
 		},
+	}, {
+		// insert ... set keeps its form
+		in:      "insert into a set v1 = 1, v2 = now(), v3 = :v on duplicate key update v1 = 'x'",
+		outstmt: "insert into a set v1 = :bv1, v2 = now(), v3 = :v on duplicate key update v1 = :bv2",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.BytesBindVariable([]byte("x")),
+		},
 	}, {
 		// val should be reused only in subqueries of DMLs
 		in:      "update a set v1=(select 5 from t), v2=5, v3=(select 5 from t), v4=5",
@@ -133,10 +243,12 @@ func TestNormalize(t *testing.T) {
 			"bv2": sqltypes.BytesBindVariable([]byte(fmt.Sprintf("%257s", "b"))),
 		},
 	}, {
-		// bad int
+		// int out of the range of an int64
 		in:      "select * from t where v1 = 12345678901234567890",
-		outstmt: "select * from t where v1 = 12345678901234567890",
-		outbv:   map[string]*querypb.BindVariable{},
+		outstmt: "select * from t where v1 = :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Uint64BindVariable(12345678901234567890),
+		},
 	}, {
 		// comparison with no vals
 		in:      "select * from t where v1 = v2",
@@ -168,6 +280,134 @@ func TestNormalize(t *testing.T) {
 		outbv: map[string]*querypb.BindVariable{
 			"bv1": sqltypes.TestBindVariable([]interface{}{1, []byte("2")}),
 		},
+	}, {
+		// Identical IN lists are reused
+		in:      "select * from t where a in (1, 2, 3) or b not in (1, 2, 3) or c in (1, 2, '3') or d = 1",
+		outstmt: "select * from t where a in ::bv1 or b not in ::bv1 or c in ::bv2 or d = :bv3",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.TestBindVariable([]interface{}{1, 2, 3}),
+			"bv2": sqltypes.TestBindVariable([]interface{}{1, 2, []byte("3")}),
+			"bv3": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		// Row constructor IN lists are lists of tuples
+		in:      "select * from t where (a, b) in ((1, 'x'), (2, 'y')) or (b, a) in ((1, 'x'), (2, 'y'))",
+		outstmt: "select * from t where (a, b) in ::bv1 or (b, a) in ::bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.TestBindVariable([]interface{}{
+				[]interface{}{1, []byte("x")},
+				[]interface{}{2, []byte("y")},
+			}),
+		},
+	}, {
+		// Only the values of mixed lists get bind vars, and the
+		// identical values of selects still share them
+		in:      "select * from t where a in (1, 2, (select max(id) from u where b = 1)) and c in (2, d)",
+		outstmt: "select * from t where a in (:bv1, :bv2, (select max(id) from u where b = :bv1)) and c in (:bv2, d)",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(2),
+		},
+	}, {
+		// A list that isn't all values is left untouched
+		// before its values get bind vars
+		in:      "update t set c = 1 where a in (1, 2, (select 3 from u)) or (a, b) in ((1, 2), (3, b))",
+		outstmt: "update t set c = :bv1 where a in (:bv2, :bv3, (select :bv4 from u)) or (a, b) in ((:bv5, :bv6), (:bv7, b))",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(1),
+			"bv3": sqltypes.Int64BindVariable(2),
+			"bv4": sqltypes.Int64BindVariable(3),
+			"bv5": sqltypes.Int64BindVariable(1),
+			"bv6": sqltypes.Int64BindVariable(2),
+			"bv7": sqltypes.Int64BindVariable(3),
+		},
+	}, {
+		// Rows that don't match the row constructor are left as values
+		in:      "select * from t where (a, b) in ((1, 2), (3))",
+		outstmt: "select * from t where (a, b) in ((:bv1, :bv2), (:bv3))",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(2),
+			"bv3": sqltypes.Int64BindVariable(3),
+		},
+	}, {
+		// Row comparisons get a bind var for each value
+		in:      "update t set c = 1 where (a, b) >= (1, 'x')",
+		outstmt: "update t set c = :bv1 where (a, b) >= (:bv2, :bv3)",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(1),
+			"bv3": sqltypes.BytesBindVariable([]byte("x")),
+		},
+	}, {
+		// Lists with the same concatenated values are distinct
+		in:      "select * from t where a in ('ab', 'c') or b in ('a', 'bc')",
+		outstmt: "select * from t where a in ::bv1 or b in ::bv2",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.TestBindVariable([]interface{}{[]byte("ab"), []byte("c")}),
+			"bv2": sqltypes.TestBindVariable([]interface{}{[]byte("a"), []byte("bc")}),
+		},
+	}, {
+		// Date and time literals are typed and don't share
+		// bind vars with strings
+		in:      "select * from t where a > {ts '2024-01-01 00:00:00'} and b = date '2024-01-01' and c = '2024-01-01' and d = time '10:00'",
+		outstmt: "select * from t where a > :bv1 and b = :bv2 and c = :bv3 and d = :bv4",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2024-01-01 00:00:00"))),
+			"bv2": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Date, []byte("2024-01-01"))),
+			"bv3": sqltypes.BytesBindVariable([]byte("2024-01-01")),
+			"bv4": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Time, []byte("10:00"))),
+		},
+	}, {
+		// Identical date literals are reused
+		in:      "select * from t where a = date '2024-01-01' or b = date '2024-01-01'",
+		outstmt: "select * from t where a = :bv1 or b = :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Date, []byte("2024-01-01"))),
+		},
+	}, {
+		// IN lists are not reused outside of selects
+		in:      "update a set v1 = 5 where v2 in (1, 4) or v3 in (1, 4)",
+		outstmt: "update a set v1 = :bv1 where v2 in ::bv2 or v3 in ::bv3",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(5),
+			"bv2": sqltypes.TestBindVariable([]interface{}{1, 4}),
+			"bv3": sqltypes.TestBindVariable([]interface{}{1, 4}),
+		},
+	}, {
+		// Boolean literals are left alone
+		in:      "select * from t where a = true and b is false and c in (true, false)",
+		outstmt: "select * from t where a = true and b is false and c in (true, false)",
+		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// DEFAULT is not a literal
+		in:      "insert into t(a, b) values (default, 1) on duplicate key update b = default(b)",
+		outstmt: "insert into t(a, b) values (default, :bv1) on duplicate key update b = default(b)",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		// Positions are not values
+		in:      "select a, b + 1 from t where c = 2 group by 1, b + 1 order by 2 desc, -1 asc limit 3",
+		outstmt: "select a, b + :bv1 from t where c = :bv2 group by 1, b + :bv1 order by 2 desc, :bv3 asc limit :bv4",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(2),
+			"bv3": sqltypes.Int64BindVariable(-1),
+			"bv4": sqltypes.Int64BindVariable(3),
+		},
+	}, {
+		in:      "select a from t union select b from u order by 1 asc",
+		outstmt: "select a from t union select b from u order by 1 asc",
+		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// event without a comment
+		in:      "create event e on schedule every 1 day do delete from t where a = 1",
+		outstmt: "create event e on schedule every 1 day do delete from t where a = :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+		},
 	}}
 	for _, tc := range testcases {
 		stmt, err := Parse(tc.in)
@@ -204,3 +444,360 @@ func TestGetBindVars(t *testing.T) {
 		t.Errorf("GetBindVars: %v, want: %v", got, want)
 	}
 }
+
+func TestBindVarsToJSON(t *testing.T) {
+	stmt, err := Parse("select * from t where a = 1 and b = 'x' and c in (1.5, 'y') and d = 'caf\xc3\xa9\xff' and (e, f) in ((1, 'y'))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"list": {
+			Type: querypb.Type_TUPLE,
+			Values: []*querypb.Value{
+				sqltypes.ValueToProto(sqltypes.NewInt64(-2)),
+				sqltypes.ValueToProto(sqltypes.NewVarChar("z")),
+				sqltypes.ValueToProto(sqltypes.NULL),
+			},
+		},
+	}
+	Normalize(stmt, bindVars, "bv")
+	got, err := BindVarsToJSON(bindVars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"bv1":{"type":"INT64","value":1},` +
+		`"bv2":{"type":"VARBINARY","value":"x"},` +
+		`"bv3":{"type":"TUPLE","values":[{"type":"DECIMAL","value":"1.5"},{"type":"VARBINARY","value":"y"}]},` +
+		`"bv4":{"type":"VARBINARY","hex":"636166c3a9ff"},` +
+		`"bv5":{"type":"TUPLE","values":[{"type":"TUPLE","values":[{"type":"INT64","value":1},{"type":"VARBINARY","value":"y"}]}]},` +
+		`"list":{"type":"TUPLE","values":[{"type":"INT64","value":-2},{"type":"VARCHAR","value":"z"},{"type":"NULL_TYPE"}]}}`
+	if string(got) != want {
+		t.Errorf("BindVarsToJSON:\n%s, want\n%s", got, want)
+	}
+
+	back, err := BindVarsFromJSON(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sqltypes.BindVariablesEqual(back, bindVars) {
+		t.Errorf("BindVarsFromJSON(%s): %v, want %v", got, back, bindVars)
+	}
+}
+
+func TestBindVarsFromJSON(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out map[string]*querypb.BindVariable
+		err string
+	}{{
+		in: `{"a":{"type":"INT64","value":"12"},"b":{"type":"VARCHAR","value":""}}`,
+		out: map[string]*querypb.BindVariable{
+			"a": sqltypes.Int64BindVariable(12),
+			"b": sqltypes.StringBindVariable(""),
+		},
+	}, {
+		in:  `{"a":{"type":"INT65","value":1}}`,
+		err: `invalid bind var a: unknown type "INT65"`,
+	}, {
+		in:  `{"a":{"type":"INT64","value":"x"}}`,
+		err: `invalid bind var a: strconv.ParseInt: parsing "x": invalid syntax`,
+	}, {
+		in:  `{"a":{"type":"TUPLE"}}`,
+		err: "invalid bind var a: empty tuple is not allowed",
+	}, {
+		in: `{"a":{"type":"TUPLE","values":[{"type":"TUPLE","values":[{"type":"INT64","value":1},{"type":"VARCHAR","value":"x"}]}]}}`,
+		out: map[string]*querypb.BindVariable{
+			"a": sqltypes.TestBindVariable([]interface{}{[]interface{}{1, "x"}}),
+		},
+	}, {
+		in:  `{"a":{"type":"TUPLE","values":[{"type":"TUPLE","values":[{"type":"TUPLE","values":[{"type":"INT64","value":1}]}]}]}}`,
+		err: "invalid bind var a: tuple not allowed inside another tuple",
+	}, {
+		in:  `{"a":null}`,
+		err: "invalid bind var a: null bind var",
+	}}
+	for _, tcase := range testcases {
+		got, err := BindVarsFromJSON([]byte(tcase.in))
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("BindVarsFromJSON(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("BindVarsFromJSON(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if !sqltypes.BindVariablesEqual(got, tcase.out) {
+			t.Errorf("BindVarsFromJSON(%s): %v, want %v", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestNormalizeReturning(t *testing.T) {
+	stmt, err := ParseWithDialect("update t set a = 'x' returning a, b + 5 as c", MariaDBDialect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bv := make(map[string]*querypb.BindVariable)
+	Normalize(stmt, bv, "bv")
+	if out, want := String(stmt), "update t set a = :bv1 returning a, b + :bv2 as c"; out != want {
+		t.Errorf("Normalize: %s, want %s", out, want)
+	}
+	if len(bv) != 2 {
+		t.Errorf("Normalize: %d bind variables, want 2", len(bv))
+	}
+}
+
+func TestNormalizeEmptyInList(t *testing.T) {
+	stmt, err := ParseWithOptions("select * from t where a + 1 in () and b = 2", ParseOptions{EmptyInLists: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bv := make(map[string]*querypb.BindVariable)
+	Normalize(stmt, bv, "bv")
+	// The values of the left side aren't formatted, so
+	// they don't get bind vars.
+	if out, want := String(stmt), "select * from t where false and b = :bv1"; out != want {
+		t.Errorf("Normalize: %s, want %s", out, want)
+	}
+	if len(bv) != 1 {
+		t.Errorf("Normalize: %d bind variables, want 1", len(bv))
+	}
+}
+
+func TestNormalizeBoolVals(t *testing.T) {
+	testcases := []struct {
+		in      string
+		outstmt string
+		outbv   map[string]*querypb.BindVariable
+	}{{
+		in:      "select * from t where a = true and b = 1 and c is not true and d in (false, 2)",
+		outstmt: "select * from t where a = :bv1 and b = :bv1 and c is not true and d in ::bv2",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.TestBindVariable([]interface{}{0, 2}),
+		},
+	}, {
+		in:      "update t set a = false, b = not true where c = false",
+		outstmt: "update t set a = :bv1, b = not :bv2 where c = :bv3",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(0),
+			"bv2": sqltypes.Int64BindVariable(1),
+			"bv3": sqltypes.Int64BindVariable(0),
+		},
+	}, {
+		// ORDER BY TRUE is not ORDER BY 1, nor GROUP BY FALSE
+		// GROUP BY 0.
+		in:      "select a from t where b in (true, c) group by false, a = true order by true asc, a = false asc",
+		outstmt: "select a from t where b in (:bv1, c) group by false, a = :bv1 order by true asc, a = :bv2 asc",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(0),
+		},
+	}, {
+		in:      "select a from t union select b from u order by true asc",
+		outstmt: "select a from t union select b from u order by true asc",
+		outbv:   map[string]*querypb.BindVariable{},
+	}}
+	for _, tc := range testcases {
+		stmt, err := Parse(tc.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		bv := make(map[string]*querypb.BindVariable)
+		NormalizeWithOptions(stmt, bv, "bv", NormalizeOptions{BoolVals: true})
+		if outstmt := String(stmt); outstmt != tc.outstmt {
+			t.Errorf("Query:\n%s:\n%s, want\n%s", tc.in, outstmt, tc.outstmt)
+		}
+		if !reflect.DeepEqual(tc.outbv, bv) {
+			t.Errorf("Query:\n%s:\n%v, want\n%v", tc.in, bv, tc.outbv)
+		}
+	}
+}
+
+func TestNormalizeSkipNullsInLists(t *testing.T) {
+	null := &querypb.Value{Type: sqltypes.Null}
+	testcases := []struct {
+		in      string
+		outstmt string
+		outbv   map[string]*querypb.BindVariable
+	}{{
+		in:      "select * from t where a in (1, null, 2) and b not in (null, 3) and c in (null)",
+		outstmt: "select * from t where a in ::bv1 and b not in ::bv2 and c in ::bv3",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.TestBindVariable([]interface{}{1, 2}),
+			"bv2": {
+				Type:   querypb.Type_TUPLE,
+				Values: []*querypb.Value{null, {Type: sqltypes.Int64, Value: []byte("3")}},
+			},
+			"bv3": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{null}},
+		},
+	}, {
+		in:      "update t set a = null where (b, c) in ((1, null))",
+		outstmt: "update t set a = null where (b, c) in ::bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": {
+				Type: querypb.Type_TUPLE,
+				Values: []*querypb.Value{
+					sqltypes.TupleToProto([]*querypb.Value{{Type: sqltypes.Int64, Value: []byte("1")}, null}),
+				},
+			},
+		},
+	}}
+	for _, tc := range testcases {
+		stmt, err := Parse(tc.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		bv := make(map[string]*querypb.BindVariable)
+		NormalizeWithOptions(stmt, bv, "bv", NormalizeOptions{SkipNullsInLists: true})
+		if outstmt := String(stmt); outstmt != tc.outstmt {
+			t.Errorf("Query:\n%s:\n%s, want\n%s", tc.in, outstmt, tc.outstmt)
+		}
+		if !reflect.DeepEqual(tc.outbv, bv) {
+			t.Errorf("Query:\n%s:\n%v, want\n%v", tc.in, bv, tc.outbv)
+		}
+		if err := sqltypes.ValidateBindVariables(bv); err != nil {
+			t.Errorf("Query:\n%s: %v", tc.in, err)
+		}
+	}
+}
+
+func TestNormalizeCanonical(t *testing.T) {
+	testcases := []struct {
+		in       string
+		bindVars map[string]*querypb.BindVariable
+		outstmt  string
+		outbv    map[string]*querypb.BindVariable
+		renames  map[string]string
+	}{{
+		in:      "select * from t where a = 1 and b in ('x', 2) and c = 1",
+		outstmt: "select * from t where a = :v1 and b in ::v2 and c = :v1",
+		outbv: map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(1),
+			"v2": sqltypes.TestBindVariable([]interface{}{[]byte("x"), 2}),
+		},
+		renames: map[string]string{},
+	}, {
+		// The user bind vars are renamed in order of appearance,
+		// even if they have the canonical names.
+		in: "select * from t where a = 5 and b = :v1 and c in ::v2 and d = :id and e = :v1",
+		bindVars: map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(10),
+			"v2": sqltypes.TestBindVariable([]interface{}{1, 2}),
+			"id": sqltypes.StringBindVariable("x"),
+		},
+		outstmt: "select * from t where a = :v1 and b = :v2 and c in ::v3 and d = :v4 and e = :v2",
+		outbv: map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(5),
+			"v2": sqltypes.Int64BindVariable(10),
+			"v3": sqltypes.TestBindVariable([]interface{}{1, 2}),
+			"v4": sqltypes.StringBindVariable("x"),
+		},
+		renames: map[string]string{"v1": "v2", "v2": "v3", "id": "v4"},
+	}, {
+		in:      "update t set a = :v2, b = 'x' where c = :v1",
+		outstmt: "update t set a = :v1, b = :v2 where c = :v3",
+		outbv: map[string]*querypb.BindVariable{
+			"v2": sqltypes.BytesBindVariable([]byte("x")),
+		},
+		renames: map[string]string{"v2": "v1", "v1": "v3"},
+	}}
+	for _, tc := range testcases {
+		stmt, err := Parse(tc.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		bv := tc.bindVars
+		if bv == nil {
+			bv = make(map[string]*querypb.BindVariable)
+		}
+		renames := NormalizeCanonical(stmt, bv)
+		if outstmt := String(stmt); outstmt != tc.outstmt {
+			t.Errorf("Query:\n%s:\n%s, want\n%s", tc.in, outstmt, tc.outstmt)
+		}
+		if !reflect.DeepEqual(tc.outbv, bv) {
+			t.Errorf("Query:\n%s:\n%v, want\n%v", tc.in, bv, tc.outbv)
+		}
+		if !reflect.DeepEqual(tc.renames, renames) {
+			t.Errorf("Query:\n%s renames:\n%v, want\n%v", tc.in, renames, tc.renames)
+		}
+	}
+}
+
+func TestNormalizeCanonicalIdentical(t *testing.T) {
+	// The same query with other values, bind var names or
+	// positional arguments normalizes to the same text.
+	want := "select * from t where a = :v1 and b in ::v2 limit :v3"
+	for _, in := range []string{
+		"select * from t where a = 1 and b in (1, 2) limit 10",
+		"select * from t where a = 'x' and b in ('y') limit 5",
+		"select * from t where a = :bv1 and b in ::bv2 limit :bv3",
+		"select * from t where a = :vtg2 and b in (1.5) limit :vtg1",
+		"select * from t where a = ? and b in (3) limit ?",
+	} {
+		stmt, err := Parse(in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		NormalizeCanonical(stmt, make(map[string]*querypb.BindVariable))
+		if out := String(stmt); out != want {
+			t.Errorf("NormalizeCanonical(%s): %s, want %s", in, out, want)
+		}
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	in := "select * from t where a = 1 and b in ('x', 'y')"
+	stmt, err := Parse(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	normalized, bv, err := Normalized(stmt, "bv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, want := String(normalized), "select * from t where a = :bv1 and b in ::bv2"; out != want {
+		t.Errorf("Normalized: %s, want %s", out, want)
+	}
+	if out := String(stmt); out != in {
+		t.Errorf("Normalized modified its input: %s", out)
+	}
+	wantbv := map[string]*querypb.BindVariable{
+		"bv1": sqltypes.Int64BindVariable(1),
+		"bv2": &querypb.BindVariable{
+			Type: querypb.Type_TUPLE,
+			Values: []*querypb.Value{
+				{Type: sqltypes.VarBinary, Value: []byte("x")},
+				{Type: sqltypes.VarBinary, Value: []byte("y")},
+			},
+		},
+	}
+	if !reflect.DeepEqual(bv, wantbv) {
+		t.Errorf("Normalized: %v, want %v", bv, wantbv)
+	}
+
+	// The statements share nothing.
+	cmp := stmt.(*Select).Where.Expr.(*AndExpr).Left.(*ComparisonExpr)
+	cmp.Right.(*SQLVal).Val[0] = '2'
+	cmp.Left.(*ColName).Name = NewColIdent("c")
+	if out, want := String(normalized), "select * from t where a = :bv1 and b in ::bv2"; out != want {
+		t.Errorf("Normalized: %s after changing its input, want %s", out, want)
+	}
+	if !reflect.DeepEqual(bv, wantbv) {
+		t.Errorf("Normalized: %v after changing its input, want %v", bv, wantbv)
+	}
+	normalized.(*Select).SelectExprs[0] = &AliasedExpr{Expr: NewIntVal([]byte("1"))}
+	if out, want := String(stmt), "select * from t where c = 2 and b in ('x', 'y')"; out != want {
+		t.Errorf("Normalized: input %s after changing the output, want %s", out, want)
+	}
+
+	if _, _, err := Normalized(nil, "bv"); err == nil || err.Error() != "cannot normalize a nil statement" {
+		t.Errorf("Normalized(nil): %v, want cannot normalize a nil statement", err)
+	}
+}
@@ -0,0 +1,145 @@
+package sqlparser
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Obfuscate returns a copy of stmt that doesn't reveal the schema,
+// to share the statement in a bug report: the tables, databases and
+// table aliases are renamed t<hash>, the columns and column aliases
+// c<hash>, and the string literals are replaced with s<hash>. The
+// hash is keyed with salt, so a name is renamed the same way in every
+// statement obfuscated with the same salt, and the names that refer to
+// each other, like an alias and the columns qualified with it, still
+// do. Columns and aliases are matched ignoring case, like MySQL does.
+// The keywords, functions, index names, numbers, bind variables and
+// variables are kept, and comments are emptied. stmt is not modified.
+//
+// The returned map gives the original of every generated name, to
+// de-obfuscate the responses.
+func Obfuscate(stmt Statement, salt []byte) (Statement, map[string]string, error) {
+	if stmt == nil {
+		return nil, nil, errors.New("cannot obfuscate a nil statement")
+	}
+	obf := &obfuscator{
+		salt:      salt,
+		originals: make(map[string]string),
+		generated: make(map[string]string),
+	}
+	stmt = DeepCopy(stmt).(Statement)
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName:
+			if !strings.HasPrefix(node.Name.String(), "@") {
+				node.Name = obf.column(node.Name)
+			}
+			node.Qualifier = obf.tableName(node.Qualifier)
+		case *AliasedExpr:
+			node.As = obf.column(node.As)
+		case Columns:
+			for i := range node {
+				node[i] = obf.column(node[i])
+			}
+		case *StarExpr:
+			node.TableName = obf.tableName(node.TableName)
+		case *AliasedTableExpr:
+			if name, ok := node.Expr.(TableName); ok && !isDual(node) {
+				node.Expr = obf.tableName(name)
+			}
+			node.As = obf.table(node.As)
+		case TableNames:
+			for i := range node {
+				node[i] = obf.tableName(node[i])
+			}
+		case *Insert:
+			node.Table = obf.tableName(node.Table)
+		case *RowAlias:
+			if node != nil {
+				node.Name = obf.table(node.Name)
+			}
+		case *DDL:
+			node.Table = obf.tableName(node.Table)
+			node.NewName = obf.tableName(node.NewName)
+			if node.TableSpec != nil {
+				for _, col := range node.TableSpec.Columns {
+					col.Name = obf.column(col.Name)
+				}
+				for _, index := range node.TableSpec.Indexes {
+					for _, col := range index.Columns {
+						if col.Expr == nil {
+							col.Column = obf.column(col.Column)
+						}
+					}
+				}
+			}
+		case *OptLike:
+			if node != nil {
+				node.LikeTable = obf.tableName(node.LikeTable)
+			}
+		case *SQLVal:
+			if node.Type == StrVal {
+				node.Val = []byte(obf.name("s", string(node.Val)))
+			}
+		case Comments:
+			for i := range node {
+				node[i] = []byte("/* */")
+			}
+		}
+		return true, nil
+	}, stmt)
+	if obf.err != nil {
+		return nil, nil, obf.err
+	}
+	return stmt, obf.originals, nil
+}
+
+// obfuscator generates the names of Obfuscate.
+type obfuscator struct {
+	salt []byte
+	// originals are the originals of the generated names, and
+	// generated the generated names of the originals, by kind.
+	originals map[string]string
+	generated map[string]string
+	err       error
+}
+
+func (obf *obfuscator) column(col ColIdent) ColIdent {
+	if col.IsEmpty() {
+		return col
+	}
+	return NewColIdent(obf.name("c", col.Lowered()))
+}
+
+func (obf *obfuscator) table(table TableIdent) TableIdent {
+	if table.IsEmpty() {
+		return table
+	}
+	return NewTableIdent(obf.name("t", table.String()))
+}
+
+func (obf *obfuscator) tableName(name TableName) TableName {
+	return TableName{Name: obf.table(name.Name), Qualifier: obf.table(name.Qualifier)}
+}
+
+// name returns the generated name of original, for the kind of
+// name prefix.
+func (obf *obfuscator) name(prefix, original string) string {
+	key := prefix + ":" + original
+	if name, ok := obf.generated[key]; ok {
+		return name
+	}
+	mac := hmac.New(sha256.New, obf.salt)
+	mac.Write([]byte(key))
+	name := prefix + hex.EncodeToString(mac.Sum(nil)[:6])
+	if other, ok := obf.originals[name]; ok && obf.err == nil {
+		obf.err = fmt.Errorf("cannot obfuscate: %s and %s have the same name %s", other, original, name)
+	}
+	obf.originals[name] = original
+	obf.generated[key] = name
+	return name
+}
@@ -0,0 +1,93 @@
+package sqlparser
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestObfuscate(t *testing.T) {
+	salt := []byte("salt")
+	testcases := []string{
+		"select t.a, u.b as x, count(*) from db.t join u as t2 on t.id = t2.id where t.name = 'secret' and b in ('secret', 'x') order by x asc",
+		"select t.a from u as t where t.b = @v and a = :bv and c > 10",
+		"select * from (select a from t) as d where exists (select 1 from dual where d.a = 1)",
+		"insert into t(a, b) values (1, 'x') as new on duplicate key update b = new.b, a = values(a)",
+		"insert into t(a, b) values (1, 'x') on duplicate key update b = concat(b, values(b))",
+		"update t set a = 1 where a = 2 limit 1",
+		"delete t from t join u using (id) where u.c = 'y'",
+		"create table t (\n\tid bigint,\n\tname varchar(10),\n\tkey idx (name)\n)",
+		"create table u like t",
+		"insert into t values (default, 1)",
+		"update t set a = default",
+	}
+	names := regexp.MustCompile(`\b[tcs][0-9a-f]{12}\b`)
+	for _, in := range testcases {
+		stmt, err := Parse(in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		out, originals, err := Obfuscate(stmt, salt)
+		if err != nil {
+			t.Errorf("Obfuscate(%s): %v", in, err)
+			continue
+		}
+		if got := String(stmt); got != in {
+			t.Errorf("Obfuscate(%s) modified its input: %s", in, got)
+		}
+		obfuscated := String(out)
+		for _, word := range []string{"secret", "db.", "name", " u "} {
+			if strings.Contains(obfuscated, word) {
+				t.Errorf("Obfuscate(%s): %s reveals %q", in, obfuscated, word)
+			}
+		}
+		if _, err := Parse(obfuscated); err != nil {
+			t.Errorf("Obfuscate(%s): %s: %v", in, obfuscated, err)
+		}
+		// The names refer to each other the way the originals do.
+		restored := names.ReplaceAllStringFunc(obfuscated, func(name string) string {
+			return originals[name]
+		})
+		if restored != in {
+			t.Errorf("Obfuscate(%s): %s restores to %s", in, obfuscated, restored)
+		}
+	}
+}
+
+func TestObfuscateConsistency(t *testing.T) {
+	// obfuscate returns the generated names by original.
+	obfuscate := func(sql string, salt string) map[string]string {
+		stmt, err := Parse(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, originals, err := Obfuscate(stmt, []byte(salt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		generated := make(map[string]string)
+		for name, original := range originals {
+			generated[name[:1]+original] = name
+		}
+		return generated
+	}
+	first := obfuscate("select a, b from t where c = 'x'", "salt")
+	second := obfuscate("select B, A from T as t where C = 'x'", "salt")
+	other := obfuscate("select a, b from t where c = 'x'", "pepper")
+	for _, key := range []string{"ca", "cb", "cc", "tt", "sx"} {
+		if first[key] == "" || first[key] != second[key] {
+			t.Errorf("Obfuscate: %s is %s then %s, want the same name", key[1:], first[key], second[key])
+		}
+		if first[key] == other[key] {
+			t.Errorf("Obfuscate: %s is %s with another salt", key[1:], other[key])
+		}
+	}
+	if first["tT"] != "" || second["tT"] == second["tt"] {
+		t.Errorf("Obfuscate: table names must be matched with their case")
+	}
+
+	if _, _, err := Obfuscate(nil, nil); err == nil {
+		t.Errorf("Obfuscate(nil): nil error")
+	}
+}
@@ -0,0 +1,240 @@
+package sqlparser
+
+import (
+	"fmt"
+)
+
+// CanMergeJoinCondition returns true if pred, a condition of the WHERE
+// clause of the select of join, can be moved to the ON condition of
+// join without changing the result of the select.
+//
+// For an inner join, that's the case if pred only refers to the tables
+// of join. A LEFT or RIGHT JOIN returns the rows of its outer side even
+// when no row of its inner side matches ON, so pred must also reject
+// those rows, that have NULL for all the columns of the inner side:
+// then the outer join returns the same rows as an inner join, and it
+// must be made one when pred is moved, see SimplifyOuterJoins.
+//
+// Columns must be qualified, see QualifyColumns, and the conditions
+// with subqueries are never moved. The conditions that may be true for
+// NULL columns, like the ones with functions such as COALESCE, or with
+// <=>, are taken to accept them.
+func CanMergeJoinCondition(join *JoinTableExpr, pred Expr) bool {
+	if containsSubquery(pred) {
+		return false
+	}
+	scope := newTableScope(TableExprs{join})
+	merged := true
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if col, ok := node.(*ColName); ok {
+			if _, _, found := scope.lookup(col.Qualifier); col.Qualifier.IsEmpty() || !found {
+				merged = false
+			}
+		}
+		return true, nil
+	}, pred)
+	if !merged {
+		return false
+	}
+	if inner := outerJoinInnerSide(join); inner != nil {
+		return nullRejects(pred, newTableScope(TableExprs{inner}))
+	}
+	return true
+}
+
+// SimplifyOuterJoins makes the LEFT and RIGHT JOINs of the FROM clause
+// of sel inner joins when they return the same rows: when the WHERE
+// clause, or the ON condition of a join that contains them, rejects
+// the rows whose columns of their inner side are NULL, like
+// a.x = 1 does for the b of "a left join b". See
+// CanMergeJoinCondition for the conditions that are taken to reject
+// them.
+//
+// The tables of the FROM clause must have different names or aliases,
+// so that the columns can be told apart. The subqueries are not
+// rewritten.
+func SimplifyOuterJoins(sel *Select) error {
+	if err := checkUniqueTableNames(sel.From); err != nil {
+		return err
+	}
+	var filters []Expr
+	if sel.Where != nil {
+		filters = splitConjuncts(sel.Where.Expr)
+	}
+	for _, expr := range sel.From {
+		simplifyOuterJoins(expr, filters)
+	}
+	return nil
+}
+
+// simplifyOuterJoins makes the outer joins of expr inner joins when
+// one of filters, the conditions that apply to the rows of expr,
+// rejects their NULL rows.
+func simplifyOuterJoins(expr TableExpr, filters []Expr) {
+	switch expr := expr.(type) {
+	case *ParenTableExpr:
+		for _, expr := range expr.Exprs {
+			simplifyOuterJoins(expr, filters)
+		}
+	case *JoinTableExpr:
+		var on []Expr
+		if expr.Condition.On != nil {
+			on = splitConjuncts(expr.Condition.On)
+		}
+		inner := outerJoinInnerSide(expr)
+		if inner != nil {
+			scope := newTableScope(TableExprs{inner})
+			for _, filter := range filters {
+				if nullRejects(filter, scope) {
+					expr.Join, expr.Outer = innerJoin(expr.Join), false
+					inner = nil
+					break
+				}
+			}
+		}
+		if inner == nil {
+			// The ON condition of an inner join applies to the rows
+			// of both sides, like the conditions above it.
+			filters = append(filters[:len(filters):len(filters)], on...)
+			simplifyOuterJoins(expr.LeftExpr, filters)
+			simplifyOuterJoins(expr.RightExpr, filters)
+			return
+		}
+		// The conditions above an outer join only apply to the rows of
+		// its outer side, and its ON condition to the ones of its inner
+		// side.
+		if inner == expr.RightExpr {
+			simplifyOuterJoins(expr.LeftExpr, filters)
+			simplifyOuterJoins(expr.RightExpr, on)
+		} else {
+			simplifyOuterJoins(expr.LeftExpr, on)
+			simplifyOuterJoins(expr.RightExpr, filters)
+		}
+	}
+}
+
+// outerJoinInnerSide returns the side of join whose columns are NULL
+// when no row matches, or nil if join is not an outer join.
+func outerJoinInnerSide(join *JoinTableExpr) TableExpr {
+	switch join.Join {
+	case LeftJoinStr, NaturalLeftJoinStr:
+		return join.RightExpr
+	case RightJoinStr, NaturalRightJoinStr:
+		return join.LeftExpr
+	}
+	return nil
+}
+
+// innerJoin returns the inner join of the outer join type join.
+func innerJoin(join string) string {
+	switch join {
+	case NaturalLeftJoinStr, NaturalRightJoinStr:
+		return NaturalJoinStr
+	}
+	return JoinStr
+}
+
+// checkUniqueTableNames returns an error if two tables of exprs have
+// the same name or alias, outside of their subqueries.
+func checkUniqueTableNames(exprs TableExprs) error {
+	seen := make(map[TableIdent]bool)
+	var check func(exprs ...TableExpr) error
+	check = func(exprs ...TableExpr) error {
+		for _, expr := range exprs {
+			var err error
+			switch expr := expr.(type) {
+			case *AliasedTableExpr:
+				name := expr.As
+				if table, ok := expr.Expr.(TableName); ok && name.IsEmpty() {
+					name = table.Name
+				}
+				if name.IsEmpty() {
+					continue
+				}
+				if seen[name] {
+					return fmt.Errorf("not unique table/alias: %s", name.String())
+				}
+				seen[name] = true
+			case *JoinTableExpr:
+				err = check(expr.LeftExpr, expr.RightExpr)
+			case *ParenTableExpr:
+				err = check(expr.Exprs...)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return check(exprs...)
+}
+
+// nullRejects returns true if expr is known to be NULL or FALSE when
+// the columns of the tables of scope are NULL.
+func nullRejects(expr Expr, scope *tableScope) bool {
+	if nullIfNullColumns(expr, scope) {
+		return true
+	}
+	switch expr := expr.(type) {
+	case *AndExpr:
+		return nullRejects(expr.Left, scope) || nullRejects(expr.Right, scope)
+	case *OrExpr:
+		return nullRejects(expr.Left, scope) && nullRejects(expr.Right, scope)
+	case *ParenExpr:
+		return nullRejects(expr.Expr, scope)
+	case *NotExpr:
+		// NOT (a IS NULL) is a IS NOT NULL.
+		if is, ok := unparen(expr.Expr).(*IsExpr); ok && is.Operator == IsNullStr {
+			return nullIfNullColumns(is.Expr, scope)
+		}
+	case *IsExpr:
+		switch expr.Operator {
+		case IsNotNullStr, IsFalseStr:
+			// NULL IS FALSE is false.
+			return nullIfNullColumns(expr.Expr, scope)
+		case IsTrueStr:
+			return nullRejects(expr.Expr, scope)
+		}
+	}
+	return false
+}
+
+// nullIfNullColumns returns true if expr is known to be NULL when the
+// columns of the tables of scope are NULL.
+func nullIfNullColumns(expr Expr, scope *tableScope) bool {
+	switch expr := expr.(type) {
+	case *ColName:
+		if expr.Qualifier.IsEmpty() {
+			return false
+		}
+		_, _, found := scope.lookup(expr.Qualifier)
+		return found
+	case *ParenExpr:
+		return nullIfNullColumns(expr.Expr, scope)
+	case *UnaryExpr:
+		return nullIfNullColumns(expr.Expr, scope)
+	case *NotExpr:
+		return nullIfNullColumns(expr.Expr, scope)
+	case *CollateExpr:
+		return nullIfNullColumns(expr.Expr, scope)
+	case *BinaryExpr:
+		return nullIfNullColumns(expr.Left, scope) || nullIfNullColumns(expr.Right, scope)
+	case *AndExpr:
+		return nullIfNullColumns(expr.Left, scope) && nullIfNullColumns(expr.Right, scope)
+	case *OrExpr:
+		return nullIfNullColumns(expr.Left, scope) && nullIfNullColumns(expr.Right, scope)
+	case *ComparisonExpr:
+		switch expr.Operator {
+		case NullSafeEqualStr:
+			return false
+		case InStr, NotInStr:
+			// 1 in (1, NULL) is true.
+			return nullIfNullColumns(expr.Left, scope)
+		}
+		return nullIfNullColumns(expr.Left, scope) || nullIfNullColumns(expr.Right, scope)
+	case *RangeCond:
+		// 2 between NULL and 1 is false.
+		return nullIfNullColumns(expr.Left, scope)
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestCanMergeJoinCondition(t *testing.T) {
+	testcases := []struct {
+		in   string
+		pred string
+		out  bool
+	}{{
+		in:   "select * from a join b on a.id = b.a_id",
+		pred: "a.x = 1 and b.y > a.y",
+		out:  true,
+	}, {
+		in:   "select * from a join b on a.id = b.a_id",
+		pred: "c.x = 1",
+	}, {
+		in:   "select * from a join b on a.id = b.a_id",
+		pred: "x = 1",
+	}, {
+		in:   "select * from a join b on a.id = b.a_id",
+		pred: "a.x in (select c.x from c)",
+	}, {
+		in:   "select * from a left join b on a.id = b.a_id",
+		pred: "b.x = 1",
+		out:  true,
+	}, {
+		in:   "select * from a left join b on a.id = b.a_id",
+		pred: "a.x = 1",
+	}, {
+		in:   "select * from a left join b on a.id = b.a_id",
+		pred: "b.x is null",
+	}, {
+		in:   "select * from a left join b as c on a.id = c.a_id",
+		pred: "c.x + 1 between 2 and 3 or c.y is not null",
+		out:  true,
+	}, {
+		in:   "select * from a left join b on a.id = b.a_id",
+		pred: "coalesce(b.x, 0) = 0",
+	}, {
+		in:   "select * from a left join b on a.id = b.a_id",
+		pred: "a.x <=> b.x",
+	}, {
+		in:   "select * from a left join b on a.id = b.a_id",
+		pred: "b.x = 1 or a.y = 2",
+	}, {
+		in:   "select * from a left join b on a.id = b.a_id",
+		pred: "not (b.x is null)",
+		out:  true,
+	}, {
+		in:   "select * from a right join b on a.id = b.a_id",
+		pred: "a.x in (1, 2)",
+		out:  true,
+	}, {
+		in:   "select * from a right join b on a.id = b.a_id",
+		pred: "b.x in (1, 2)",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		pred, err := ParseExpr(tcase.pred)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		join := stmt.(*Select).From[0].(*JoinTableExpr)
+		if got := CanMergeJoinCondition(join, pred); got != tcase.out {
+			t.Errorf("CanMergeJoinCondition(%s, %s): %v, want %v", tcase.in, tcase.pred, got, tcase.out)
+		}
+	}
+}
+
+func TestSimplifyOuterJoins(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select * from a left join b on a.id = b.a_id where b.x = 1",
+		out: "select * from a join b on a.id = b.a_id where b.x = 1",
+	}, {
+		in:  "select * from a left join b on a.id = b.a_id where b.x is null",
+		out: "select * from a left join b on a.id = b.a_id where b.x is null",
+	}, {
+		in:  "select * from a left join b on a.id = b.a_id where a.x = 1 and ifnull(b.x, 1) = 1",
+		out: "select * from a left join b on a.id = b.a_id where a.x = 1 and ifnull(b.x, 1) = 1",
+	}, {
+		in:  "select * from a natural left join b right join c on c.id = a.c_id where a.x > 0",
+		out: "select * from a natural left join b join c on c.id = a.c_id where a.x > 0",
+	}, {
+		in:  "select * from a left outer join b on a.id = b.a_id natural left outer join c where b.x = 1 and c.x = 1",
+		out: "select * from a join b on a.id = b.a_id natural join c where b.x = 1 and c.x = 1",
+	}, {
+		// the inner join's ON condition rejects the NULL rows of b
+		in:  "select * from a left join b on a.id = b.a_id join c on c.b_id = b.id",
+		out: "select * from a join b on a.id = b.a_id join c on c.b_id = b.id",
+	}, {
+		// the WHERE clause makes the outer join an inner join, and then
+		// applies to its inner side
+		in:  "select * from a left join (b left join c on b.id = c.b_id) on a.id = b.a_id where c.x = 1",
+		out: "select * from a join (b join c on b.id = c.b_id) on a.id = b.a_id where c.x = 1",
+	}, {
+		// the ON condition of the outer join applies to its inner side
+		in:  "select * from a left join (b left join c on b.id = c.b_id) on a.id = c.a_id",
+		out: "select * from a left join (b join c on b.id = c.b_id) on a.id = c.a_id",
+	}, {
+		// the WHERE clause doesn't apply to the inner side of an outer
+		// join
+		in:  "select * from a left join (b left join c on b.id = c.b_id) on a.id = b.a_id where c.x = 1 or a.x = 1",
+		out: "select * from a left join (b left join c on b.id = c.b_id) on a.id = b.a_id where c.x = 1 or a.x = 1",
+	}, {
+		in:  "select * from a, b left join c on b.id = c.b_id where c.x = a.x",
+		out: "select * from a, b join c on b.id = c.b_id where c.x = a.x",
+	}, {
+		in:  "select * from a left join b on a.id = b.a_id where x = 1",
+		out: "select * from a left join b on a.id = b.a_id where x = 1",
+	}, {
+		in:  "select * from a left join b on a.id = b.a_id where exists (select 1 from b where b.x = a.x)",
+		out: "select * from a left join b on a.id = b.a_id where exists (select 1 from b where b.x = a.x)",
+	}, {
+		in:  "select * from a left join a on a.id = a.parent_id where a.x = 1",
+		err: "not unique table/alias: a",
+	}, {
+		in:  "select * from t as a left join u as a on a.id = a.t_id",
+		err: "not unique table/alias: a",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		sel := stmt.(*Select)
+		err = SimplifyOuterJoins(sel)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("SimplifyOuterJoins(%s): %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("SimplifyOuterJoins(%s): %v", tcase.in, err)
+			continue
+		}
+		if got := String(sel); got != tcase.out {
+			t.Errorf("SimplifyOuterJoins(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+}
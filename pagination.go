@@ -0,0 +1,327 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PageInfo is what PaginationInfo found about the ordering and the
+// limit of a select.
+type PageInfo struct {
+	// OrderBy are the keys of the ORDER BY, in order.
+	OrderBy []SortKey
+	// Deterministic is true if the keys order the rows in a single
+	// way: for every table the select reads, they include the
+	// columns of one of its unique keys. If it's false, a column of
+	// a unique key has to be added to the ORDER BY as a tie-breaker
+	// for the keyset to not skip or repeat rows.
+	Deterministic bool
+	// Rowcount and Offset are those of the LIMIT, or nil.
+	Rowcount, Offset Expr
+}
+
+// SortKey is a key of an ORDER BY.
+type SortKey struct {
+	// Name is the key as written in the ORDER BY, like t.a, x for
+	// the alias of a select expression, or 2 for its position. The
+	// values of the keys are named with it, see ApplyKeyset.
+	Name string
+	// Expr is what the key orders by: the select expression of an
+	// alias or a position is resolved, since the WHERE clause can
+	// refer to neither.
+	Expr Expr
+	Desc bool
+}
+
+// NamedValue is the value of the sort key Name in the last row of a
+// page, see ApplyKeyset. Value is typically an SQLVal: a literal or
+// a bind variable.
+type NamedValue struct {
+	Name  string
+	Value Expr
+}
+
+// KeysetOptions are the options of ApplyKeysetWithOptions.
+type KeysetOptions struct {
+	// Expanded always builds the predicate of the keyset as an OR of
+	// comparisons, like a > 1 or a = 1 and b > 2, instead of a
+	// comparison of rows, like (a, b) > (1, 2). Some servers only use
+	// an index for the former.
+	Expanded bool
+}
+
+// PaginationInfo returns the keys of the ORDER BY of sel and its
+// LIMIT, to rewrite it into keyset pagination with ApplyKeyset. The
+// unique keys of the tables are looked up in schema, which can be nil:
+// the sort is then only known to be deterministic if it's not. It
+// returns an error if sel has no ORDER BY, or if a key can't be
+// compared in the WHERE clause, like an aggregate.
+func PaginationInfo(sel *Select, schema *Schema) (*PageInfo, error) {
+	keys, err := sortKeys(sel)
+	if err != nil {
+		return nil, err
+	}
+	info := &PageInfo{
+		OrderBy:       keys,
+		Deterministic: isDeterministicSort(sel, keys, schema),
+	}
+	if sel.Limit != nil {
+		info.Rowcount, info.Offset = sel.Limit.Rowcount, sel.Limit.Offset
+	}
+	return info, nil
+}
+
+// ApplyKeyset rewrites sel to return the page after the row whose sort
+// keys have the values after: it adds a predicate on the keys to the
+// WHERE clause, and removes the OFFSET of the LIMIT. The predicate
+// compares the keys as a row if they're all sorted in the same
+// direction, or else as an OR of comparisons. Every key of the ORDER
+// BY needs a value, which can't be NULL. sel is not modified if
+// ApplyKeyset returns an error.
+func ApplyKeyset(sel *Select, after []NamedValue) error {
+	return ApplyKeysetWithOptions(sel, after, KeysetOptions{})
+}
+
+// ApplyKeysetWithOptions is ApplyKeyset with options.
+func ApplyKeysetWithOptions(sel *Select, after []NamedValue, opts KeysetOptions) error {
+	keys, err := sortKeys(sel)
+	if err != nil {
+		return err
+	}
+	values := make([]Expr, len(keys))
+	for _, value := range after {
+		i := findSortKey(keys, value.Name)
+		if i < 0 {
+			return fmt.Errorf("%s is not a key of the order by", value.Name)
+		}
+		if value.Value == nil {
+			return fmt.Errorf("no value for order by key %s", value.Name)
+		}
+		if _, ok := value.Value.(*NullVal); ok {
+			return fmt.Errorf("cannot paginate after a null %s", value.Name)
+		}
+		values[i] = value.Value
+	}
+	for i, key := range keys {
+		if values[i] == nil {
+			return fmt.Errorf("no value for order by key %s", key.Name)
+		}
+	}
+
+	if opts.Expanded || !sameDirection(keys) {
+		sel.AddWhere(expandedKeyset(keys, values))
+	} else {
+		sel.AddWhere(rowKeyset(keys, values))
+	}
+	if sel.Limit != nil {
+		sel.Limit.Offset = nil
+	}
+	return nil
+}
+
+// sortKeys returns the keys of the ORDER BY of sel.
+func sortKeys(sel *Select) ([]SortKey, error) {
+	if sel == nil {
+		return nil, errors.New("cannot paginate a nil select")
+	}
+	if len(sel.OrderBy) == 0 {
+		return nil, errors.New("cannot paginate without an order by")
+	}
+	keys := make([]SortKey, 0, len(sel.OrderBy))
+	for _, order := range sel.OrderBy {
+		expr, err := resolveSortKey(sel, order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		aggregate := false
+		_ = Walk(func(node SQLNode) (bool, error) {
+			aggregate = aggregate || isAggregate(node)
+			return !aggregate, nil
+		}, expr)
+		if aggregate {
+			return nil, fmt.Errorf("cannot paginate on the aggregate %s", String(expr))
+		}
+		keys = append(keys, SortKey{
+			Name: String(order.Expr),
+			Expr: expr,
+			Desc: order.Direction == DescScr,
+		})
+	}
+	return keys, nil
+}
+
+// resolveSortKey returns the expression that the ORDER BY expression
+// expr refers to: the select expression of a position or an alias, or
+// else expr itself.
+func resolveSortKey(sel *Select, expr Expr) (Expr, error) {
+	switch expr := expr.(type) {
+	case *SQLVal:
+		if expr.Type != IntVal {
+			break
+		}
+		pos, err := strconv.Atoi(string(expr.Val))
+		if err != nil || pos < 1 || pos > len(sel.SelectExprs) {
+			return nil, fmt.Errorf("order by position %s is out of range", expr.Val)
+		}
+		aliased, ok := sel.SelectExprs[pos-1].(*AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("cannot paginate on order by position %d: %s", pos, String(sel.SelectExprs[pos-1]))
+		}
+		return aliased.Expr, nil
+	case *ColName:
+		if !expr.Qualifier.IsEmpty() {
+			break
+		}
+		for _, selectExpr := range sel.SelectExprs {
+			if aliased, ok := selectExpr.(*AliasedExpr); ok && aliased.As.Equal(expr.Name) {
+				return aliased.Expr, nil
+			}
+		}
+	}
+	return expr, nil
+}
+
+func findSortKey(keys []SortKey, name string) int {
+	for i, key := range keys {
+		if strings.EqualFold(key.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func sameDirection(keys []SortKey) bool {
+	for _, key := range keys {
+		if key.Desc != keys[0].Desc {
+			return false
+		}
+	}
+	return true
+}
+
+// afterOperator returns the operator that's true for the values of key
+// that come after value.
+func afterOperator(key SortKey) string {
+	if key.Desc {
+		return LessThanStr
+	}
+	return GreaterThanStr
+}
+
+// rowKeyset returns (k1, k2) > (v1, v2), or k1 > v1 for a single key.
+// The keys are sorted in the same direction.
+func rowKeyset(keys []SortKey, values []Expr) Expr {
+	if len(keys) == 1 {
+		return keysetComparison(afterOperator(keys[0]), keys[0].Expr, values[0])
+	}
+	left := make(ValTuple, len(keys))
+	right := make(ValTuple, len(keys))
+	for i, key := range keys {
+		left[i] = DeepCopy(key.Expr).(Expr)
+		right[i] = DeepCopy(values[i]).(Expr)
+	}
+	return &ComparisonExpr{Operator: afterOperator(keys[0]), Left: left, Right: right}
+}
+
+// expandedKeyset returns k1 > v1 or k1 = v1 and k2 > v2 or ...
+func expandedKeyset(keys []SortKey, values []Expr) Expr {
+	var result Expr
+	for i, key := range keys {
+		term := keysetComparison(afterOperator(key), key.Expr, values[i])
+		for j := i - 1; j >= 0; j-- {
+			term = &AndExpr{Left: keysetComparison(EqualStr, keys[j].Expr, values[j]), Right: term}
+		}
+		if result == nil {
+			result = term
+		} else {
+			result = &OrExpr{Left: result, Right: term}
+		}
+	}
+	return result
+}
+
+// keysetComparison returns a comparison of copies of key and value, so
+// that the predicate shares no node with the select or another term.
+func keysetComparison(operator string, key, value Expr) Expr {
+	return &ComparisonExpr{Operator: operator, Left: DeepCopy(key).(Expr), Right: DeepCopy(value).(Expr)}
+}
+
+// isDeterministicSort returns true if the keys include the columns of
+// a unique key of every table that sel reads.
+func isDeterministicSort(sel *Select, keys []SortKey, schema *Schema) bool {
+	if schema == nil {
+		return false
+	}
+	var tables []*AliasedTableExpr
+	if !collectBaseTables(sel.From, &tables) {
+		return false
+	}
+	for _, table := range tables {
+		name := table.Expr.(TableName)
+		ref := name.Name
+		if !table.As.IsEmpty() {
+			ref = table.As
+		}
+		covered := false
+		for _, key := range schema.uniqueKeys[name.Name.String()] {
+			if coversKey(keys, key, ref, len(tables) == 1) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// collectBaseTables appends the tables of exprs to tables, and returns
+// false if one is not a table, like a derived table.
+func collectBaseTables(exprs TableExprs, tables *[]*AliasedTableExpr) bool {
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *AliasedTableExpr:
+			if _, ok := expr.Expr.(TableName); !ok || isDual(expr) {
+				return false
+			}
+			*tables = append(*tables, expr)
+		case *ParenTableExpr:
+			if !collectBaseTables(expr.Exprs, tables) {
+				return false
+			}
+		case *JoinTableExpr:
+			if !collectBaseTables(TableExprs{expr.LeftExpr, expr.RightExpr}, tables) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return len(*tables) > 0
+}
+
+// coversKey returns true if the keys include every column of the
+// unique key of the table referred to as ref. Unqualified columns are
+// only taken to be those of the table if it's the only one.
+func coversKey(keys []SortKey, uniqueKey []string, ref TableIdent, onlyTable bool) bool {
+	for _, column := range uniqueKey {
+		found := false
+		for _, key := range keys {
+			col, ok := key.Expr.(*ColName)
+			if !ok || !col.Name.EqualString(column) {
+				continue
+			}
+			if col.Qualifier.IsEmpty() && onlyTable || col.Qualifier.Name == ref {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
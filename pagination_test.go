@@ -0,0 +1,203 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func TestPaginationInfo(t *testing.T) {
+	schema := NewSchema()
+	schema.AddColumn("u", "id", sqltypes.Int64)
+	schema.AddColumn("u", "name", sqltypes.VarChar)
+	schema.AddUniqueKey("u", "id")
+	ddl, err := Parse("create table t (a int primary key, b int, c int, unique key bc (b, c))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema.AddTable("t", ddl.(*DDL).TableSpec)
+
+	testcases := []struct {
+		in            string
+		keys          []string
+		deterministic bool
+		limit         string
+		err           string
+	}{{
+		in:            "select a, b from t order by a desc limit 10, 5",
+		keys:          []string{"a desc: a"},
+		deterministic: true,
+		limit:         "5 offset 10",
+	}, {
+		in:   "select a, b from t order by b",
+		keys: []string{"b asc: b"},
+	}, {
+		in:            "select a, b from t order by c, b desc limit 3",
+		keys:          []string{"c asc: c", "b desc: b"},
+		deterministic: true,
+		limit:         "3",
+	}, {
+		in:            "select t.b + 1 as x, c from t as t1 order by x, 2, t1.a",
+		keys:          []string{"x asc: t.b + 1", "2 asc: c", "t1.a asc: t1.a"},
+		deterministic: true,
+	}, {
+		in:   "select t.b, u.id from t join u on t.a = u.id order by t.a, u.name",
+		keys: []string{"t.a asc: t.a", "u.name asc: u.name"},
+	}, {
+		in:            "select t.b, u.id from t join u on t.a = u.id order by t.a, u.id",
+		keys:          []string{"t.a asc: t.a", "u.id asc: u.id"},
+		deterministic: true,
+	}, {
+		in:   "select t.b, u.id from t join u on t.a = u.id order by a, id",
+		keys: []string{"a asc: a", "id asc: id"},
+	}, {
+		in:   "select a from (select a from t) as d order by a",
+		keys: []string{"a asc: a"},
+	}, {
+		in:  "select a from t",
+		err: "cannot paginate without an order by",
+	}, {
+		in:  "select a from t order by 2",
+		err: "order by position 2 is out of range",
+	}, {
+		in:  "select * from t order by 1",
+		err: "cannot paginate on order by position 1: *",
+	}, {
+		in:  "select a, count(*) as n from t group by a order by n",
+		err: "cannot paginate on the aggregate count(*)",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		info, err := PaginationInfo(stmt.(*Select), schema)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("PaginationInfo(%s): %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("PaginationInfo(%s): %v", tcase.in, err)
+			continue
+		}
+		var keys []string
+		for _, key := range info.OrderBy {
+			direction := "asc"
+			if key.Desc {
+				direction = "desc"
+			}
+			keys = append(keys, key.Name+" "+direction+": "+String(key.Expr))
+		}
+		if !reflect.DeepEqual(keys, tcase.keys) {
+			t.Errorf("PaginationInfo(%s): %q, want %q", tcase.in, keys, tcase.keys)
+		}
+		if info.Deterministic != tcase.deterministic {
+			t.Errorf("PaginationInfo(%s): deterministic %v, want %v", tcase.in, info.Deterministic, tcase.deterministic)
+		}
+		var limit string
+		if info.Rowcount != nil {
+			limit = String(info.Rowcount)
+		}
+		if info.Offset != nil {
+			limit += " offset " + String(info.Offset)
+		}
+		if limit != tcase.limit {
+			t.Errorf("PaginationInfo(%s): limit %q, want %q", tcase.in, limit, tcase.limit)
+		}
+	}
+
+	stmt, err := Parse("select a from t order by a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := PaginationInfo(stmt.(*Select), nil); err != nil || info.Deterministic {
+		t.Errorf("PaginationInfo(nil schema): %+v, %v, want a non-deterministic sort", info, err)
+	}
+}
+
+func TestApplyKeyset(t *testing.T) {
+	one := NewIntVal([]byte("1"))
+	str := NewStrVal([]byte("x"))
+	arg := NewValArg([]byte(":last_id"))
+	testcases := []struct {
+		in       string
+		after    []NamedValue
+		expanded bool
+		out      string
+		err      string
+	}{{
+		in:    "select a from t order by a limit 10",
+		after: []NamedValue{{Name: "a", Value: one}},
+		out:   "select a from t where a > 1 order by a asc limit 10",
+	}, {
+		in:    "select a, b from t where c = 1 or d = 2 order by a desc, b desc limit 20, 10",
+		after: []NamedValue{{Name: "B", Value: str}, {Name: "a", Value: one}},
+		out:   "select a, b from t where (c = 1 or d = 2) and (a, b) < (1, 'x') order by a desc, b desc limit 10",
+	}, {
+		in:       "select a, b from t where c = 1 order by a, b limit 10",
+		after:    []NamedValue{{Name: "a", Value: one}, {Name: "b", Value: arg}},
+		expanded: true,
+		out:      "select a, b from t where c = 1 and (a > 1 or a = 1 and b > :last_id) order by a asc, b asc limit 10",
+	}, {
+		in:    "select a, b + 1 as x, c from t order by a, x desc, 3",
+		after: []NamedValue{{Name: "a", Value: one}, {Name: "x", Value: one}, {Name: "3", Value: str}},
+		out:   "select a, b + 1 as x, c from t where (a > 1 or a = 1 and b + 1 < 1 or a = 1 and b + 1 = 1 and c > 'x') order by a asc, x desc, 3 asc",
+	}, {
+		in:    "select a from t order by a",
+		after: []NamedValue{{Name: "b", Value: one}},
+		err:   "b is not a key of the order by",
+	}, {
+		in:    "select a, b from t order by a, b",
+		after: []NamedValue{{Name: "a", Value: one}},
+		err:   "no value for order by key b",
+	}, {
+		in:    "select a from t order by a",
+		after: []NamedValue{{Name: "a", Value: &NullVal{}}},
+		err:   "cannot paginate after a null a",
+	}, {
+		in:  "select a from t",
+		err: "cannot paginate without an order by",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		sel := stmt.(*Select)
+		err = ApplyKeysetWithOptions(sel, tcase.after, KeysetOptions{Expanded: tcase.expanded})
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ApplyKeyset(%s): %v, want %s", tcase.in, err, tcase.err)
+			}
+			if got := String(sel); got != String(mustParse(t, tcase.in)) {
+				t.Errorf("ApplyKeyset(%s) modified the select on error: %s", tcase.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ApplyKeyset(%s): %v", tcase.in, err)
+			continue
+		}
+		got := String(sel)
+		if got != tcase.out {
+			t.Errorf("ApplyKeyset(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+		if _, err := Parse(got); err != nil {
+			t.Errorf("ApplyKeyset(%s): %s: %v", tcase.in, got, err)
+		}
+	}
+}
+
+func mustParse(t *testing.T, sql string) Statement {
+	t.Helper()
+	stmt, err := Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stmt
+}
@@ -0,0 +1,69 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseTableName parses s as a table name, which can be qualified with
+// its database, like mydb.orders. The names are parsed like the grammar
+// does: they can be quoted with backquotes, in which case they can hold
+// dots, like in `my.db`.`or.ders`. It returns an error if s has anything
+// else, like an alias.
+func ParseTableName(s string) (TableName, error) {
+	if hasSemicolon(s) {
+		return TableName{}, fmt.Errorf("%s is not a table name", s)
+	}
+	const prefix = "select 1 from "
+	stmt, err := Parse(prefix + s)
+	if err != nil {
+		if parseErr, ok := err.(*ParseError); ok {
+			parseErr.Position -= len(prefix)
+		}
+		return TableName{}, err
+	}
+	if sel, ok := stmt.(*Select); ok && len(sel.From) == 1 {
+		table, ok := sel.From[0].(*AliasedTableExpr)
+		if ok && table.As.IsEmpty() && table.Hints == nil && table.Partitions == nil {
+			bare := &Select{SelectExprs: sel.SelectExprs, From: sel.From}
+			if name, ok := table.Expr.(TableName); ok && canonicalString(bare) == canonicalString(sel) {
+				return name, nil
+			}
+		}
+	}
+	return TableName{}, fmt.Errorf("%s is not a table name", s)
+}
+
+// ParseColName parses s as a column name, which can be qualified with
+// its table and database, like o.total or mydb.orders.total, with the
+// rules of ParseTableName. It returns an error if s has anything else,
+// or is a variable, like @total.
+func ParseColName(s string) (*ColName, error) {
+	if hasSemicolon(s) {
+		return nil, fmt.Errorf("%s is not a column name", s)
+	}
+	expr, err := ParseExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	col, ok := expr.(*ColName)
+	if !ok || strings.HasPrefix(col.Name.String(), "@") {
+		return nil, fmt.Errorf("%s is not a column name", s)
+	}
+	return col, nil
+}
+
+// hasSemicolon returns true if s has a ; token, which Parse accepts
+// after the statement, but is not part of a name.
+func hasSemicolon(s string) bool {
+	tokenizer := NewStringTokenizer(s)
+	for {
+		typ, _ := tokenizer.Scan()
+		switch typ {
+		case 0, LEX_ERROR:
+			return false
+		case ';':
+			return true
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package sqlparser
+
+import "testing"
+
+func TestParseTableName(t *testing.T) {
+	testcases := []struct {
+		in, name, qualifier, out string
+	}{{
+		in:   "orders",
+		name: "orders",
+		out:  "orders",
+	}, {
+		in:        "mydb.orders",
+		name:      "orders",
+		qualifier: "mydb",
+		out:       "mydb.orders",
+	}, {
+		in:        "`weird.db`.`ta.ble`",
+		name:      "ta.ble",
+		qualifier: "weird.db",
+		out:       "`weird.db`.`ta.ble`",
+	}, {
+		in:   "`a``b`",
+		name: "a`b",
+		out:  "`a``b`",
+	}, {
+		in:        "status.`select`",
+		name:      "select",
+		qualifier: "status",
+		out:       "`status`.`select`",
+	}, {
+		in:   " Orders ",
+		name: "Orders",
+		out:  "Orders",
+	}}
+	for _, tcase := range testcases {
+		got, err := ParseTableName(tcase.in)
+		if err != nil {
+			t.Errorf("ParseTableName(%s): %v", tcase.in, err)
+			continue
+		}
+		if got.Name.String() != tcase.name || got.Qualifier.String() != tcase.qualifier {
+			t.Errorf("ParseTableName(%s): %q.%q, want %q.%q", tcase.in, got.Qualifier.String(), got.Name.String(), tcase.qualifier, tcase.name)
+		}
+		if got.String() != tcase.out {
+			t.Errorf("ParseTableName(%s).String(): %s, want %s", tcase.in, got.String(), tcase.out)
+		}
+		if back, err := ParseTableName(got.String()); err != nil || back != got {
+			t.Errorf("ParseTableName(%s): %v, %v, want %v", got.String(), back, err, got)
+		}
+	}
+
+	for _, in := range []string{"", "a.b.c", "t as x", "t x", "t where 1 = 1", "t, u", "t join u", "t use index (i)", "t partition (p)", "select", "(select 1)", "t limit 1", "a.", ".a", "`a", "t;", "t; drop table u"} {
+		if got, err := ParseTableName(in); err == nil {
+			t.Errorf("ParseTableName(%s): %v, want an error", in, got)
+		}
+	}
+}
+
+func TestParseColName(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "total",
+		out: "total",
+	}, {
+		in:  "o.total",
+		out: "o.total",
+	}, {
+		in:  "mydb.orders.total",
+		out: "mydb.orders.total",
+	}, {
+		in:  "`weird.db`.`ta.ble`.`co.l`",
+		out: "`weird.db`.`ta.ble`.`co.l`",
+	}, {
+		in:  "t.`select`",
+		out: "t.`select`",
+	}}
+	for _, tcase := range testcases {
+		got, err := ParseColName(tcase.in)
+		if err != nil {
+			t.Errorf("ParseColName(%s): %v", tcase.in, err)
+			continue
+		}
+		if String(got) != tcase.out {
+			t.Errorf("ParseColName(%s): %s, want %s", tcase.in, String(got), tcase.out)
+		}
+	}
+	col, err := ParseColName("`weird.db`.`ta.ble`.`co.l`")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.Name.String() != "co.l" || col.Qualifier.Name.String() != "ta.ble" || col.Qualifier.Qualifier.String() != "weird.db" {
+		t.Errorf("ParseColName: %q.%q.%q", col.Qualifier.Qualifier.String(), col.Qualifier.Name.String(), col.Name.String())
+	}
+
+	for _, in := range []string{"", "a.b.c.d", "a b", "a as b", "a + 1", "@a", "@@sql_mode", "f(a)", "'a'", "1", "t.*", "a from t", "a;"} {
+		if got, err := ParseColName(in); err == nil {
+			t.Errorf("ParseColName(%s): %v, want an error", in, String(got))
+		}
+	}
+}
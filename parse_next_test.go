@@ -21,6 +21,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 // TestParseNextValid concatenates all the valid SQL test cases and check it can read
@@ -28,16 +29,16 @@ import (
 func TestParseNextValid(t *testing.T) {
 	var sql bytes.Buffer
 	for _, tcase := range validSQL {
-		sql.WriteString(strings.TrimSuffix(tcase.input, ";"))
+		sql.WriteString(strings.TrimSuffix(tcase.Input, ";"))
 		sql.WriteRune(';')
 	}
 
 	tokens := NewTokenizer(&sql)
 	for i, tcase := range validSQL {
-		input := tcase.input + ";"
-		want := tcase.output
+		input := tcase.Input + ";"
+		want := tcase.Output
 		if want == "" {
-			want = tcase.input
+			want = tcase.Input
 		}
 
 		tree, err := ParseNext(tokens)
@@ -125,6 +126,10 @@ func TestParseNextEdgeCases(t *testing.T) {
 		name:  "Semicolin inside a string",
 		input: "set character set ';'; select 1 from a",
 		want:  []string{"set charset ';'", "select 1 from a"},
+	}, {
+		name:  "Replication statement",
+		input: "change master to MASTER_PASSWORD = 'a;b'; start slave; select 1 from a",
+		want:  []string{"change master to MASTER_PASSWORD = 'a;b'", "start slave", "select 1 from a"},
 	}, {
 		name:  "Partial DDL",
 		input: "create table a; select 1 from a",
@@ -133,6 +138,14 @@ func TestParseNextEdgeCases(t *testing.T) {
 		name:  "Partial DDL",
 		input: "create table a ignore me this is garbage; select 1 from a",
 		want:  []string{"create table a", "select 1 from a"},
+	}, {
+		name:  "Semicolons inside a trigger body",
+		input: "create trigger t before insert on a for each row begin set NEW.b = 1; set NEW.c = 2; end; select 1 from a",
+		want:  []string{"create trigger t before insert on a for each row begin set NEW.b = 1; set NEW.c = 2; end", "select 1 from a"},
+	}, {
+		name:  "Trigger in mysqldump comments",
+		input: "/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `t` BEFORE INSERT ON `a` FOR EACH ROW BEGIN SET NEW.b = 1; END */; select 1 from a",
+		want:  []string{"create definer = `root`@`localhost` trigger t before insert on a for each row begin SET NEW.b = 1; END", "select 1 from a"},
 	}}
 
 	for _, test := range tests {
@@ -161,3 +174,91 @@ func TestParseNextEdgeCases(t *testing.T) {
 		}
 	}
 }
+
+// TestParseNextDelimiter tests that DELIMITER commands change
+// where statements end.
+func TestParseNextDelimiter(t *testing.T) {
+	input := "select 1 from a;\nDELIMITER $$\n" +
+		"create procedure p() begin select 1 from a; select 2 from b; end $$\n" +
+		"create function f() returns int return (select 1);$$\n" +
+		"select 3 from c$$\nDELIMITER ;\nselect 4 from d;\nDELIMITER ;\n"
+	want := []string{
+		"select 1 from a",
+		"create procedure p() begin select 1 from a; select 2 from b; end",
+		"create function f() returns int return (select 1);",
+		"select 3 from c",
+		"select 4 from d",
+	}
+
+	// One byte at a time makes the tokenizer read ahead to find the delimiter.
+	for _, tokens := range []*Tokenizer{NewStringTokenizer(input), NewTokenizer(iotest.OneByteReader(strings.NewReader(input)))} {
+		for i, w := range want {
+			tree, err := ParseNext(tokens)
+			if err != nil {
+				t.Fatalf("[%d] ParseNext(%q) err = %q, want nil", i, input, err)
+			}
+			if got := String(tree); got != w {
+				t.Errorf("[%d] ParseNext(%q) = %q, want %q", i, input, got, w)
+			}
+		}
+		if tree, err := ParseNext(tokens); err != io.EOF {
+			t.Errorf("ParseNext(%q) = (%q, %v) want io.EOF", input, String(tree), err)
+		}
+	}
+}
+
+// TestParseNextTrackSource tests the original text recorded
+// for each statement.
+func TestParseNextTrackSource(t *testing.T) {
+	input := "select 1 from a;\n  update a set b = 'x;y' ;select /* c */ 2\nfrom b ; set character set utf8;"
+	want := []struct {
+		text string
+		span Span
+	}{{
+		text: "select 1 from a",
+		span: Span{0, 15},
+	}, {
+		text: "update a set b = 'x;y'",
+		span: Span{19, 41},
+	}, {
+		text: "select /* c */ 2\nfrom b",
+		span: Span{43, 66},
+	}, {
+		text: "set character set utf8",
+		span: Span{69, 91},
+	}}
+
+	for _, tokens := range []*Tokenizer{NewStringTokenizer(input), NewTokenizer(strings.NewReader(input))} {
+		tokens.TrackSource = true
+		for i, w := range want {
+			tree, err := ParseNext(tokens)
+			if err != nil {
+				t.Fatalf("[%d] ParseNext(%q) err = %q, want nil", i, input, err)
+			}
+			if got := StatementSource(tree); got != w.text {
+				t.Errorf("[%d] StatementSource: %q, want %q", i, got, w.text)
+			}
+			if got := StatementSpan(tree); got == nil || *got != w.span {
+				t.Errorf("[%d] StatementSpan: %v, want %v", i, got, w.span)
+			}
+			if got := input[w.span.Start:w.span.End]; got != w.text {
+				t.Errorf("[%d] input[span]: %q, want %q", i, got, w.text)
+			}
+		}
+		if tree, err := ParseNext(tokens); err != io.EOF {
+			t.Errorf("ParseNext(%q) = (%q, %v) want io.EOF", input, String(tree), err)
+		}
+	}
+
+	// Nothing is recorded by default.
+	tree, err := ParseNext(NewStringTokenizer(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := StatementSource(tree); got != "" {
+		t.Errorf("StatementSource: %q, want empty", got)
+	}
+	if got := StatementSpan(tree); got != nil {
+		t.Errorf("StatementSpan: %v, want nil", got)
+	}
+}
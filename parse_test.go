@@ -19,1605 +19,1041 @@ package sqlparser
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/xwb1989/sqlparser/corpus"
 )
 
-var (
-	validSQL = []struct {
+var validSQL = corpus.Queries()
+
+func TestValid(t *testing.T) {
+	for _, tcase := range validSQL {
+		if tcase.Output == "" {
+			tcase.Output = tcase.Input
+		}
+		tree, err := Parse(tcase.Input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v, want nil", tcase.Input, err)
+			continue
+		}
+		out := String(tree)
+		if out != tcase.Output {
+			t.Errorf("Parse(%q) = %q, want: %q", tcase.Input, out, tcase.Output)
+		}
+		// This test just exercises the tree walking functionality.
+		// There's no way automated way to verify that a node calls
+		// all its children. But we can examine code coverage and
+		// ensure that all walkSubtree functions were called.
+		Walk(func(node SQLNode) (bool, error) {
+			return true, nil
+		}, tree)
+	}
+}
+
+func TestCaseSensitivity(t *testing.T) {
+	validSQL := []struct {
 		input  string
 		output string
 	}{{
-		input:  "select 1",
-		output: "select 1 from dual",
-	}, {
-		input: "select 1 from t",
+		input:  "create table A (\n\t`B` int\n)",
+		output: "create table A (\n\tB int\n)",
 	}, {
-		input: "select .1 from t",
+		input:  "create index b on A",
+		output: "alter table A",
 	}, {
-		input: "select 1.2e1 from t",
+		input:  "alter table A foo",
+		output: "alter table A",
 	}, {
-		input: "select 1.2e+1 from t",
+		input:  "alter table A convert",
+		output: "alter table A",
 	}, {
-		input: "select 1.2e-1 from t",
+		// View names get lower-cased.
+		input:  "alter view A foo",
+		output: "alter table a",
 	}, {
-		input: "select 08.3 from t",
+		input:  "alter table A rename to B",
+		output: "rename table A to B",
 	}, {
-		input: "select -1 from t where b = -2",
+		input: "rename table A to B",
 	}, {
-		input:  "select - -1 from t",
-		output: "select 1 from t",
+		input:  "drop table B",
+		output: "drop table B",
 	}, {
-		input:  "select 1 from t // aa\n",
-		output: "select 1 from t",
+		input:  "drop table if exists B",
+		output: "drop table if exists B",
 	}, {
-		input:  "select 1 from t -- aa\n",
-		output: "select 1 from t",
+		input:  "drop index b on A",
+		output: "alter table A drop index b",
 	}, {
-		input:  "select 1 from t # aa\n",
-		output: "select 1 from t",
+		input: "select a from B",
 	}, {
-		input:  "select 1 --aa\nfrom t",
-		output: "select 1 from t",
+		input: "select A as B from C",
 	}, {
-		input:  "select 1 #aa\nfrom t",
-		output: "select 1 from t",
+		input: "select B.* from c",
 	}, {
-		input: "select /* simplest */ 1 from t",
+		input: "select B.A from c",
 	}, {
-		input: "select /* double star **/ 1 from t",
+		input: "select * from B as C",
 	}, {
-		input: "select /* double */ /* comment */ 1 from t",
+		input: "select * from A.B",
 	}, {
-		input: "select /* back-quote keyword */ `By` from t",
+		input: "update A set b = 1",
 	}, {
-		input: "select /* back-quote num */ `2a` from t",
+		input: "update A.B set b = 1",
 	}, {
-		input: "select /* back-quote . */ `a.b` from t",
+		input: "select A() from b",
 	}, {
-		input: "select /* back-quote back-quote */ `a``b` from t",
+		input: "select A(B, C) from b",
 	}, {
-		input:  "select /* back-quote unnecessary */ 1 from `t`",
-		output: "select /* back-quote unnecessary */ 1 from t",
+		input: "select A(distinct B, C) from b",
 	}, {
-		input:  "select /* back-quote idnum */ 1 from `a1`",
-		output: "select /* back-quote idnum */ 1 from a1",
+		// IF is an exception. It's always lower-cased.
+		input:  "select IF(B, C) from b",
+		output: "select if(B, C) from b",
 	}, {
-		input: "select /* @ */ @@a from b",
+		input: "select * from b use index (A)",
 	}, {
-		input: "select /* \\0 */ '\\0' from a",
+		input: "insert into A(A, B) values (1, 2)",
 	}, {
-		input:  "select 1 /* drop this comment */ from t",
-		output: "select 1 from t",
+		input:  "CREATE TABLE A (\n\t`A` int\n)",
+		output: "create table A (\n\tA int\n)",
 	}, {
-		input: "select /* union */ 1 from t union select 1 from t",
+		input:  "create view A",
+		output: "create table a",
 	}, {
-		input: "select /* double union */ 1 from t union select 1 from t union select 1 from t",
+		input:  "alter view A",
+		output: "alter table a",
 	}, {
-		input: "select /* union all */ 1 from t union all select 1 from t",
+		input:  "drop view A",
+		output: "drop table a",
 	}, {
-		input: "select /* union distinct */ 1 from t union distinct select 1 from t",
+		input:  "drop view if exists A",
+		output: "drop table if exists a",
 	}, {
-		input:  "(select /* union parenthesized select */ 1 from t order by a) union select 1 from t",
-		output: "(select /* union parenthesized select */ 1 from t order by a asc) union select 1 from t",
+		input:  "select /* lock in SHARE MODE */ 1 from t lock in SHARE MODE",
+		output: "select /* lock in SHARE MODE */ 1 from t lock in share mode",
 	}, {
-		input: "select /* union parenthesized select 2 */ 1 from t union (select 1 from t)",
+		input:  "select next VALUE from t",
+		output: "select next 1 values from t",
 	}, {
-		input:  "select /* union order by */ 1 from t union select 1 from t order by a",
-		output: "select /* union order by */ 1 from t union select 1 from t order by a asc",
+		input: "select /* use */ 1 from t1 use index (A) where b = 1",
+	}}
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		out := String(tree)
+		if out != tcase.output {
+			t.Errorf("out: %s, want %s", out, tcase.output)
+		}
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		output string
+	}{{
+		input:  "select current_timestamp",
+		output: "select current_timestamp() from dual",
 	}, {
-		input:  "select /* union order by limit lock */ 1 from t union select 1 from t order by a limit 1 for update",
-		output: "select /* union order by limit lock */ 1 from t union select 1 from t order by a asc limit 1 for update",
+		input: "update t set a = current_timestamp()",
 	}, {
-		input: "select /* union with limit on lhs */ 1 from t limit 1 union select 1 from t",
+		input:  "select a, current_date from t",
+		output: "select a, current_date() from t",
 	}, {
-		input:  "(select id, a from t order by id limit 1) union (select id, b as a from s order by id limit 1) order by a limit 1",
-		output: "(select id, a from t order by id asc limit 1) union (select id, b as a from s order by id asc limit 1) order by a asc limit 1",
+		input:  "insert into t(a, b) values (current_date, current_date())",
+		output: "insert into t(a, b) values (current_date(), current_date())",
 	}, {
-		input: "select a from (select 1 as a from tbl1 union select 2 from tbl2) as t",
+		input: "select * from t where a > utc_timestmp()",
 	}, {
-		input: "select * from t1 join (select * from t2 union select * from t3) as t",
+		input:  "update t set b = utc_timestamp + 5",
+		output: "update t set b = utc_timestamp() + 5",
 	}, {
-		// Ensure this doesn't generate: ""select * from t1 join t2 on a = b join t3 on a = b".
-		input: "select * from t1 join t2 on a = b join t3",
+		input:  "select utc_time, utc_date",
+		output: "select utc_time(), utc_date() from dual",
 	}, {
-		input: "select * from t1 where col in (select 1 from dual union select 2 from dual)",
+		input:  "select 1 from dual where localtime > utc_time",
+		output: "select 1 from dual where localtime() > utc_time()",
 	}, {
-		input: "select * from t1 where exists (select a from t2 union select b from t3)",
+		input:  "update t set a = localtimestamp(), b = utc_timestamp",
+		output: "update t set a = localtimestamp(), b = utc_timestamp()",
 	}, {
-		input: "select /* distinct */ distinct 1 from t",
+		input: "insert into t(a) values (unix_timestamp)",
 	}, {
-		input: "select /* straight_join */ straight_join 1 from t",
+		input: "select replace(a, 'foo', 'bar') from t",
 	}, {
-		input: "select /* for update */ 1 from t for update",
+		input: "update t set a = replace('1234', '2', '1')",
 	}, {
-		input: "select /* lock in share mode */ 1 from t lock in share mode",
+		input: "insert into t(a, b) values ('foo', 'bar') on duplicate key update a = replace(hex('foo'), 'f', 'b')",
 	}, {
-		input: "select /* select list */ 1, 2 from t",
+		input: "update t set a = left('1234', 3)",
 	}, {
-		input: "select /* * */ * from t",
+		input: "select left(a, 5) from t",
 	}, {
-		input: "select /* a.* */ a.* from t",
+		input: "update t set d = adddate(date('2003-12-31 01:02:03'), interval 5 days)",
 	}, {
-		input: "select /* a.b.* */ a.b.* from t",
+		input: "insert into t(a, b) values (left('foo', 1), 'b')",
 	}, {
-		input:  "select /* column alias */ a b from t",
-		output: "select /* column alias */ a as b from t",
+		input: "insert /* qualified function */ into t(a, b) values (test.PI(), 'b')",
 	}, {
-		input: "select /* column alias with as */ a as b from t",
+		input:  "select /* keyword in qualified id */ * from t join z on t.key = z.key",
+		output: "select /* keyword in qualified id */ * from t join z on t.`key` = z.`key`",
 	}, {
-		input: "select /* keyword column alias */ a as `By` from t",
+		input:  "select /* non-reserved keywords as unqualified cols */ date, view, offset from t",
+		output: "select /* non-reserved keywords as unqualified cols */ `date`, `view`, `offset` from t",
 	}, {
-		input:  "select /* column alias as string */ a as \"b\" from t",
-		output: "select /* column alias as string */ a as b from t",
+		input:  "select /* share and mode as cols */ share, mode from t where share = 'foo'",
+		output: "select /* share and mode as cols */ `share`, `mode` from t where `share` = 'foo'",
 	}, {
-		input:  "select /* column alias as string without as */ a \"b\" from t",
-		output: "select /* column alias as string without as */ a as b from t",
+		input:  "select /* unused keywords as cols */ write, varying from t where trailing = 'foo'",
+		output: "select /* unused keywords as cols */ `write`, `varying` from t where `trailing` = 'foo'",
 	}, {
-		input: "select /* a.* */ a.* from t",
+		input:  "select status from t",
+		output: "select `status` from t",
 	}, {
-		input:  "select next value for t",
-		output: "select next 1 values from t",
+		input:  "select variables from t",
+		output: "select `variables` from t",
+	}}
+
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		out := String(tree)
+		if out != tcase.output {
+			t.Errorf("out: %s, want %s", out, tcase.output)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		output string
+	}{{
+		input:  "select cast('abc' as date) from t",
+		output: "select convert('abc', date) from t",
 	}, {
-		input:  "select next value from t",
-		output: "select next 1 values from t",
+		input: "select convert('abc', binary(4)) from t",
 	}, {
-		input: "select next 10 values from t",
+		input: "select convert('abc', binary) from t",
 	}, {
-		input: "select next :a values from t",
+		input: "select convert('abc', char character set binary) from t",
 	}, {
-		input: "select /* `By`.* */ `By`.* from t",
+		input: "select convert('abc', char(4) ascii) from t",
 	}, {
-		input: "select /* select with bool expr */ a = b from t",
+		input: "select convert('abc', char unicode) from t",
 	}, {
-		input: "select /* case_when */ case when a = b then c end from t",
+		input: "select convert('abc', char(4)) from t",
 	}, {
-		input: "select /* case_when_else */ case when a = b then c else d end from t",
+		input: "select convert('abc', char) from t",
 	}, {
-		input: "select /* case_when_when_else */ case when a = b then c when b = d then d else d end from t",
+		input: "select convert('abc', nchar(4)) from t",
 	}, {
-		input: "select /* case */ case aa when a = b then c end from t",
+		input: "select convert('abc', nchar) from t",
 	}, {
-		input: "select /* parenthesis */ 1 from (t)",
+		input: "select convert('abc', signed) from t",
 	}, {
-		input: "select /* parenthesis multi-table */ 1 from (t1, t2)",
+		input:  "select convert('abc', signed integer) from t",
+		output: "select convert('abc', signed) from t",
 	}, {
-		input: "select /* table list */ 1 from t1, t2",
+		input: "select convert('abc', unsigned) from t",
 	}, {
-		input: "select /* parenthessis in table list 1 */ 1 from (t1), t2",
+		input:  "select convert('abc', unsigned integer) from t",
+		output: "select convert('abc', unsigned) from t",
 	}, {
-		input: "select /* parenthessis in table list 2 */ 1 from t1, (t2)",
+		input: "select convert('abc', decimal(3, 4)) from t",
 	}, {
-		input: "select /* use */ 1 from t1 use index (a) where b = 1",
+		input: "select convert('abc', decimal(4)) from t",
 	}, {
-		input: "select /* keyword index */ 1 from t1 use index (`By`) where b = 1",
+		input: "select convert('abc', decimal) from t",
 	}, {
-		input: "select /* ignore */ 1 from t1 as t2 ignore index (a), t3 use index (b) where b = 1",
+		input: "select convert('abc', date) from t",
 	}, {
-		input: "select /* use */ 1 from t1 as t2 use index (a), t3 use index (b) where b = 1",
+		input: "select convert('abc', time(4)) from t",
 	}, {
-		input: "select /* force */ 1 from t1 as t2 force index (a), t3 force index (b) where b = 1",
+		input: "select convert('abc', time) from t",
 	}, {
-		input:  "select /* table alias */ 1 from t t1",
-		output: "select /* table alias */ 1 from t as t1",
+		input: "select convert('abc', datetime(9)) from t",
 	}, {
-		input: "select /* table alias with as */ 1 from t as t1",
+		input: "select convert('abc', datetime) from t",
 	}, {
-		input:  "select /* string table alias */ 1 from t as 't1'",
-		output: "select /* string table alias */ 1 from t as t1",
+		input: "select convert('abc', json) from t",
 	}, {
-		input:  "select /* string table alias without as */ 1 from t 't1'",
-		output: "select /* string table alias without as */ 1 from t as t1",
+		input: "select convert('abc' using ascii) from t",
+	}}
+
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		out := String(tree)
+		if out != tcase.output {
+			t.Errorf("out: %s, want %s", out, tcase.output)
+		}
+	}
+
+	invalidSQL := []struct {
+		input  string
+		output string
+	}{{
+		input:  "describe t a b",
+		output: "syntax error at position 15 near 'b'",
 	}, {
-		input: "select /* keyword table alias */ 1 from t as `By`",
+		input:  "explain db.t 'a%' b",
+		output: "syntax error at position 20 near 'b'",
 	}, {
-		input: "select /* join */ 1 from t1 join t2",
+		input:  "select convert('abc' as date) from t",
+		output: "syntax error at position 24 near 'as'",
 	}, {
-		input: "select /* join on */ 1 from t1 join t2 on a = b",
+		input:  "select convert from t",
+		output: "syntax error at position 20 near 'from'",
 	}, {
-		input: "select /* join on */ 1 from t1 join t2 using (a)",
+		input:  "select cast('foo', decimal) from t",
+		output: "syntax error at position 19",
 	}, {
-		input:  "select /* inner join */ 1 from t1 inner join t2",
-		output: "select /* inner join */ 1 from t1 join t2",
+		input:  "select convert('abc', datetime(4+9)) from t",
+		output: "syntax error at position 34",
 	}, {
-		input:  "select /* cross join */ 1 from t1 cross join t2",
-		output: "select /* cross join */ 1 from t1 join t2",
+		input:  "select convert('abc', decimal(4+9)) from t",
+		output: "syntax error at position 33",
+	}}
+
+	for _, tcase := range invalidSQL {
+		_, err := Parse(tcase.input)
+		if err == nil || err.Error() != tcase.output {
+			t.Errorf("%s: %v, want %s", tcase.input, err, tcase.output)
+		}
+	}
+}
+
+func TestPostgresDialect(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		output string
+	}{{
+		input:  "select created::date from t",
+		output: "select convert(created, date) from t",
 	}, {
-		input: "select /* straight_join */ 1 from t1 straight_join t2",
+		input:  "select a + b::decimal(10, 2) from t",
+		output: "select a + convert(b, decimal(10, 2)) from t",
 	}, {
-		input: "select /* straight_join on */ 1 from t1 straight_join t2 on a = b",
+		input:  "select '1'::signed::char(4) from t",
+		output: "select convert(convert('1', signed), char(4)) from t",
 	}, {
-		input: "select /* left join */ 1 from t1 left join t2 on a = b",
+		input:  "select * from t where a = :a::unsigned",
+		output: "select * from t where a = convert(:a, unsigned)",
 	}, {
-		input: "select /* left join */ 1 from t1 left join t2 using (a)",
+		input:  "select x::int, x::integer, x::bigint(20) from t",
+		output: "select convert(x, signed), convert(x, signed), convert(x, signed) from t",
 	}, {
-		input:  "select /* left outer join */ 1 from t1 left outer join t2 on a = b",
-		output: "select /* left outer join */ 1 from t1 left join t2 on a = b",
+		input:  "select x::text, x::varchar(10), x::varchar, x::blob from t",
+		output: "select convert(x, char), convert(x, char(10)), convert(x, char), convert(x, binary) from t",
 	}, {
-		input:  "select /* left outer join */ 1 from t1 left outer join t2 using (a)",
-		output: "select /* left outer join */ 1 from t1 left join t2 using (a)",
+		input:  "select x::timestamp, x::timestamp(3), x::time(3), x::date, x::datetime from t",
+		output: "select convert(x, datetime), convert(x, datetime(3)), convert(x, time(3)), convert(x, date), convert(x, datetime) from t",
 	}, {
-		input: "select /* right join */ 1 from t1 right join t2 on a = b",
+		input:  "select x::numeric(10, 2), x::decimal, x::double, x::float, x::real from t",
+		output: "select convert(x, decimal(10, 2)), convert(x, decimal), convert(x, double), convert(x, float), convert(x, real) from t",
 	}, {
-		input: "select /* right join */ 1 from t1 right join t2 using (a)",
+		input:  "select x::text as y, x::int z from t where x::varchar(3) = 'abc'",
+		output: "select convert(x, char) as y, convert(x, signed) as z from t where convert(x, char(3)) = 'abc'",
 	}, {
-		input:  "select /* right outer join */ 1 from t1 right outer join t2 on a = b",
-		output: "select /* right outer join */ 1 from t1 right join t2 on a = b",
+		input:  "select $$a 'quoted' $ string$$ from t",
+		output: "select 'a \\'quoted\\' $ string' from t",
 	}, {
-		input:  "select /* right outer join */ 1 from t1 right outer join t2 using (a)",
-		output: "select /* right outer join */ 1 from t1 right join t2 using (a)",
+		input:  "select $body$line one\nline $two$ $$\n$body$ from t",
+		output: "select 'line one\\nline $two$ $$\\n' from t",
 	}, {
-		input: "select /* natural join */ 1 from t1 natural join t2",
+		input:  "select /*! x::json */ from t",
+		output: "select convert(x, json) from t",
+	}}
+
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		tree, err := ParseWithDialect(tcase.input, PostgresDialect)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		out := String(tree)
+		if out != tcase.output {
+			t.Errorf("out: %s, want %s", out, tcase.output)
+		}
+	}
+
+	// The raw body of a dollar-quoted string is preserved.
+	tree, err := ParseWithDialect("select $q$a$b\n$q$ from t", PostgresDialect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val := tree.(*Select).SelectExprs[0].(*AliasedExpr).Expr.(*SQLVal)
+	if val.Type != StrVal || string(val.Val) != "a$b\n" {
+		t.Errorf("dollar-quoted string: %v %q, want StrVal %q", val.Type, val.Val, "a$b\n")
+	}
+
+	// In the default dialect :: still introduces a list bind variable.
+	tree, err = Parse("select * from t where a in ::list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, want := String(tree), "select * from t where a in ::list"; out != want {
+		t.Errorf("out: %s, want %s", out, want)
+	}
+
+	invalidSQL := []struct {
+		input   string
+		dialect Dialect
+		output  string
+	}{{
+		input:   "select created::date from t",
+		dialect: MySQLDialect,
+		output:  "syntax error at position 21 near '::date'",
 	}, {
-		input: "select /* natural left join */ 1 from t1 natural left join t2",
+		input:   "select $$abc$$ from t",
+		dialect: MySQLDialect,
+		output:  "syntax error at position 9 near '$'",
 	}, {
-		input:  "select /* natural left outer join */ 1 from t1 natural left join t2",
-		output: "select /* natural left outer join */ 1 from t1 natural left join t2",
+		input:   "select a in ::list from t",
+		dialect: PostgresDialect,
+		output:  "syntax error at position 15",
 	}, {
-		input: "select /* natural right join */ 1 from t1 natural right join t2",
+		input:   "select $$abc from t",
+		dialect: PostgresDialect,
+		output:  "unterminated string starting at line 1 at position 8",
 	}, {
-		input:  "select /* natural right outer join */ 1 from t1 natural right join t2",
-		output: "select /* natural right outer join */ 1 from t1 natural right join t2",
-	}, {
-		input: "select /* join on */ 1 from t1 join t2 on a = b",
-	}, {
-		input: "select /* join using */ 1 from t1 join t2 using (a)",
-	}, {
-		input: "select /* join using (a, b, c) */ 1 from t1 join t2 using (a, b, c)",
-	}, {
-		input: "select /* s.t */ 1 from s.t",
-	}, {
-		input: "select /* keyword schema & table name */ 1 from `By`.`bY`",
-	}, {
-		input: "select /* select in from */ 1 from (select 1 from t) as a",
-	}, {
-		input:  "select /* select in from with no as */ 1 from (select 1 from t) a",
-		output: "select /* select in from with no as */ 1 from (select 1 from t) as a",
-	}, {
-		input: "select /* where */ 1 from t where a = b",
-	}, {
-		input: "select /* and */ 1 from t where a = b and a = c",
-	}, {
-		input:  "select /* && */ 1 from t where a = b && a = c",
-		output: "select /* && */ 1 from t where a = b and a = c",
-	}, {
-		input: "select /* or */ 1 from t where a = b or a = c",
-	}, {
-		input:  "select /* || */ 1 from t where a = b || a = c",
-		output: "select /* || */ 1 from t where a = b or a = c",
-	}, {
-		input: "select /* not */ 1 from t where not a = b",
-	}, {
-		input: "select /* ! */ 1 from t where a = !1",
-	}, {
-		input: "select /* bool is */ 1 from t where a = b is null",
-	}, {
-		input: "select /* bool is not */ 1 from t where a = b is not false",
-	}, {
-		input: "select /* true */ 1 from t where true",
-	}, {
-		input: "select /* false */ 1 from t where false",
-	}, {
-		input: "select /* false on left */ 1 from t where false = 0",
-	}, {
-		input: "select /* exists */ 1 from t where exists (select 1 from t)",
-	}, {
-		input: "select /* (boolean) */ 1 from t where not (a = b)",
-	}, {
-		input: "select /* in value list */ 1 from t where a in (b, c)",
-	}, {
-		input: "select /* in select */ 1 from t where a in (select 1 from t)",
-	}, {
-		input: "select /* not in */ 1 from t where a not in (b, c)",
-	}, {
-		input: "select /* like */ 1 from t where a like b",
-	}, {
-		input: "select /* like escape */ 1 from t where a like b escape '!'",
-	}, {
-		input: "select /* not like */ 1 from t where a not like b",
-	}, {
-		input: "select /* not like escape */ 1 from t where a not like b escape '$'",
-	}, {
-		input: "select /* regexp */ 1 from t where a regexp b",
-	}, {
-		input: "select /* not regexp */ 1 from t where a not regexp b",
-	}, {
-		input:  "select /* rlike */ 1 from t where a rlike b",
-		output: "select /* rlike */ 1 from t where a regexp b",
-	}, {
-		input:  "select /* not rlike */ 1 from t where a not rlike b",
-		output: "select /* not rlike */ 1 from t where a not regexp b",
-	}, {
-		input: "select /* between */ 1 from t where a between b and c",
-	}, {
-		input: "select /* not between */ 1 from t where a not between b and c",
-	}, {
-		input: "select /* is null */ 1 from t where a is null",
-	}, {
-		input: "select /* is not null */ 1 from t where a is not null",
-	}, {
-		input: "select /* is true */ 1 from t where a is true",
-	}, {
-		input: "select /* is not true */ 1 from t where a is not true",
-	}, {
-		input: "select /* is false */ 1 from t where a is false",
-	}, {
-		input: "select /* is not false */ 1 from t where a is not false",
-	}, {
-		input: "select /* < */ 1 from t where a < b",
-	}, {
-		input: "select /* <= */ 1 from t where a <= b",
-	}, {
-		input: "select /* >= */ 1 from t where a >= b",
-	}, {
-		input: "select /* > */ 1 from t where a > b",
-	}, {
-		input: "select /* != */ 1 from t where a != b",
-	}, {
-		input:  "select /* <> */ 1 from t where a <> b",
-		output: "select /* <> */ 1 from t where a != b",
-	}, {
-		input: "select /* <=> */ 1 from t where a <=> b",
-	}, {
-		input: "select /* != */ 1 from t where a != b",
-	}, {
-		input: "select /* single value expre list */ 1 from t where a in (b)",
-	}, {
-		input: "select /* select as a value expression */ 1 from t where a = (select a from t)",
-	}, {
-		input: "select /* parenthesised value */ 1 from t where a = (b)",
-	}, {
-		input: "select /* over-parenthesize */ ((1)) from t where ((a)) in (((1))) and ((a, b)) in ((((1, 1))), ((2, 2)))",
-	}, {
-		input: "select /* dot-parenthesize */ (a.b) from t where (b.c) = 2",
-	}, {
-		input: "select /* & */ 1 from t where a = b & c",
-	}, {
-		input: "select /* & */ 1 from t where a = b & c",
-	}, {
-		input: "select /* | */ 1 from t where a = b | c",
-	}, {
-		input: "select /* ^ */ 1 from t where a = b ^ c",
-	}, {
-		input: "select /* + */ 1 from t where a = b + c",
-	}, {
-		input: "select /* - */ 1 from t where a = b - c",
-	}, {
-		input: "select /* * */ 1 from t where a = b * c",
-	}, {
-		input: "select /* / */ 1 from t where a = b / c",
-	}, {
-		input: "select /* % */ 1 from t where a = b % c",
-	}, {
-		input: "select /* div */ 1 from t where a = b div c",
-	}, {
-		input:  "select /* MOD */ 1 from t where a = b MOD c",
-		output: "select /* MOD */ 1 from t where a = b % c",
-	}, {
-		input: "select /* << */ 1 from t where a = b << c",
-	}, {
-		input: "select /* >> */ 1 from t where a = b >> c",
-	}, {
-		input:  "select /* % no space */ 1 from t where a = b%c",
-		output: "select /* % no space */ 1 from t where a = b % c",
-	}, {
-		input: "select /* u+ */ 1 from t where a = +b",
-	}, {
-		input: "select /* u- */ 1 from t where a = -b",
-	}, {
-		input: "select /* u~ */ 1 from t where a = ~b",
-	}, {
-		input: "select /* -> */ a.b -> 'ab' from t",
-	}, {
-		input: "select /* -> */ a.b ->> 'ab' from t",
-	}, {
-		input: "select /* empty function */ 1 from t where a = b()",
-	}, {
-		input: "select /* function with 1 param */ 1 from t where a = b(c)",
-	}, {
-		input: "select /* function with many params */ 1 from t where a = b(c, d)",
-	}, {
-		input: "select /* function with distinct */ count(distinct a) from t",
-	}, {
-		input: "select /* if as func */ 1 from t where a = if(b)",
-	}, {
-		input: "select /* current_timestamp as func */ current_timestamp() from t",
-	}, {
-		input: "select /* mod as func */ a from tab where mod(b, 2) = 0",
-	}, {
-		input: "select /* database as func no param */ database() from t",
-	}, {
-		input: "select /* database as func 1 param */ database(1) from t",
-	}, {
-		input: "select /* a */ a from t",
-	}, {
-		input: "select /* a.b */ a.b from t",
-	}, {
-		input: "select /* a.b.c */ a.b.c from t",
-	}, {
-		input: "select /* keyword a.b */ `By`.`bY` from t",
-	}, {
-		input: "select /* string */ 'a' from t",
-	}, {
-		input:  "select /* double quoted string */ \"a\" from t",
-		output: "select /* double quoted string */ 'a' from t",
-	}, {
-		input:  "select /* quote quote in string */ 'a''a' from t",
-		output: "select /* quote quote in string */ 'a\\'a' from t",
-	}, {
-		input:  "select /* double quote quote in string */ \"a\"\"a\" from t",
-		output: "select /* double quote quote in string */ 'a\\\"a' from t",
-	}, {
-		input:  "select /* quote in double quoted string */ \"a'a\" from t",
-		output: "select /* quote in double quoted string */ 'a\\'a' from t",
-	}, {
-		input: "select /* backslash quote in string */ 'a\\'a' from t",
-	}, {
-		input: "select /* literal backslash in string */ 'a\\\\na' from t",
-	}, {
-		input: "select /* all escapes */ '\\0\\'\\\"\\b\\n\\r\\t\\Z\\\\' from t",
-	}, {
-		input:  "select /* non-escape */ '\\x' from t",
-		output: "select /* non-escape */ 'x' from t",
-	}, {
-		input: "select /* unescaped backslash */ '\\n' from t",
-	}, {
-		input: "select /* value argument */ :a from t",
-	}, {
-		input: "select /* value argument with digit */ :a1 from t",
-	}, {
-		input: "select /* value argument with dot */ :a.b from t",
-	}, {
-		input:  "select /* positional argument */ ? from t",
-		output: "select /* positional argument */ :v1 from t",
-	}, {
-		input:  "select /* multiple positional arguments */ ?, ? from t",
-		output: "select /* multiple positional arguments */ :v1, :v2 from t",
-	}, {
-		input: "select /* list arg */ * from t where a in ::list",
-	}, {
-		input: "select /* list arg not in */ * from t where a not in ::list",
-	}, {
-		input: "select /* null */ null from t",
-	}, {
-		input: "select /* octal */ 010 from t",
-	}, {
-		input:  "select /* hex */ x'f0A1' from t",
-		output: "select /* hex */ X'f0A1' from t",
-	}, {
-		input: "select /* hex caps */ X'F0a1' from t",
-	}, {
-		input:  "select /* bit literal */ b'0101' from t",
-		output: "select /* bit literal */ B'0101' from t",
-	}, {
-		input: "select /* bit literal caps */ B'010011011010' from t",
-	}, {
-		input: "select /* 0x */ 0xf0 from t",
-	}, {
-		input: "select /* float */ 0.1 from t",
-	}, {
-		input: "select /* group by */ 1 from t group by a",
-	}, {
-		input: "select /* having */ 1 from t having a = b",
-	}, {
-		input:  "select /* simple order by */ 1 from t order by a",
-		output: "select /* simple order by */ 1 from t order by a asc",
-	}, {
-		input: "select /* order by asc */ 1 from t order by a asc",
-	}, {
-		input: "select /* order by desc */ 1 from t order by a desc",
-	}, {
-		input: "select /* order by null */ 1 from t order by null",
-	}, {
-		input: "select /* limit a */ 1 from t limit a",
-	}, {
-		input: "select /* limit a,b */ 1 from t limit a, b",
-	}, {
-		input:  "select /* binary unary */ a- -b from t",
-		output: "select /* binary unary */ a - -b from t",
-	}, {
-		input: "select /* - - */ - -b from t",
-	}, {
-		input: "select /* binary binary */ binary  binary b from t",
-	}, {
-		input: "select /* binary ~ */ binary  ~b from t",
-	}, {
-		input: "select /* ~ binary */ ~ binary b from t",
-	}, {
-		input: "select /* interval */ adddate('2008-01-02', interval 31 day) from t",
-	}, {
-		input: "select /* interval keyword */ adddate('2008-01-02', interval 1 year) from t",
-	}, {
-		input: "select /* dual */ 1 from dual",
-	}, {
-		input:  "select /* Dual */ 1 from Dual",
-		output: "select /* Dual */ 1 from dual",
-	}, {
-		input:  "select /* DUAL */ 1 from Dual",
-		output: "select /* DUAL */ 1 from dual",
-	}, {
-		input: "select /* column as bool in where */ a from t where b",
-	}, {
-		input: "select /* OR of columns in where */ * from t where a or b",
-	}, {
-		input: "select /* OR of mixed columns in where */ * from t where a = 5 or b and c is not null",
-	}, {
-		input: "select /* OR in select columns */ (a or b) from t where c = 5",
-	}, {
-		input: "select /* bool as select value */ a, true from t",
-	}, {
-		input: "select /* bool column in ON clause */ * from t join s on t.id = s.id and s.foo where t.bar",
-	}, {
-		input: "select /* bool in order by */ * from t order by a is null or b asc",
-	}, {
-		input: "select /* string in case statement */ if(max(case a when 'foo' then 1 else 0 end) = 1, 'foo', 'bar') as foobar from t",
-	}, {
-		input:  "/*!show databases*/",
-		output: "show databases",
-	}, {
-		input:  "select /*!40101 * from*/ t",
-		output: "select * from t",
-	}, {
-		input:  "select /*! * from*/ t",
-		output: "select * from t",
-	}, {
-		input:  "select /*!* from*/ t",
-		output: "select * from t",
-	}, {
-		input:  "select /*!401011 from*/ t",
-		output: "select 1 from t",
-	}, {
-		input: "select /* dual */ 1 from dual",
-	}, {
-		input: "insert /* simple */ into a values (1)",
-	}, {
-		input: "insert /* a.b */ into a.b values (1)",
-	}, {
-		input: "insert /* multi-value */ into a values (1, 2)",
-	}, {
-		input: "insert /* multi-value list */ into a values (1, 2), (3, 4)",
-	}, {
-		input: "insert /* no values */ into a values ()",
-	}, {
-		input:  "insert /* set */ into a set a = 1, b = 2",
-		output: "insert /* set */ into a(a, b) values (1, 2)",
-	}, {
-		input:  "insert /* set default */ into a set a = default, b = 2",
-		output: "insert /* set default */ into a(a, b) values (default, 2)",
-	}, {
-		input: "insert /* value expression list */ into a values (a + 1, 2 * 3)",
-	}, {
-		input: "insert /* default */ into a values (default, 2 * 3)",
-	}, {
-		input: "insert /* column list */ into a(a, b) values (1, 2)",
-	}, {
-		input: "insert into a(a, b) values (1, ifnull(null, default(b)))",
-	}, {
-		input: "insert /* qualified column list */ into a(a, b) values (1, 2)",
-	}, {
-		input:  "insert /* qualified columns */ into t (t.a, t.b) values (1, 2)",
-		output: "insert /* qualified columns */ into t(a, b) values (1, 2)",
-	}, {
-		input: "insert /* select */ into a select b, c from d",
-	}, {
-		input:  "insert /* no cols & paren select */ into a(select * from t)",
-		output: "insert /* no cols & paren select */ into a select * from t",
-	}, {
-		input:  "insert /* cols & paren select */ into a(a,b,c) (select * from t)",
-		output: "insert /* cols & paren select */ into a(a, b, c) select * from t",
-	}, {
-		input: "insert /* cols & union with paren select */ into a(b, c) (select d, e from f) union (select g from h)",
-	}, {
-		input: "insert /* on duplicate */ into a values (1, 2) on duplicate key update b = func(a), c = d",
-	}, {
-		input: "insert /* bool in insert value */ into a values (1, true, false)",
-	}, {
-		input: "insert /* bool in on duplicate */ into a values (1, 2) on duplicate key update b = false, c = d",
-	}, {
-		input: "insert /* bool in on duplicate */ into a values (1, 2, 3) on duplicate key update b = values(b), c = d",
-	}, {
-		input: "insert /* bool in on duplicate */ into a values (1, 2, 3) on duplicate key update b = values(a.b), c = d",
-	}, {
-		input: "insert /* bool expression on duplicate */ into a values (1, 2) on duplicate key update b = func(a), c = a > d",
-	}, {
-		input: "update /* simple */ a set b = 3",
-	}, {
-		input: "update /* a.b */ a.b set b = 3",
-	}, {
-		input: "update /* list */ a set b = 3, c = 4",
-	}, {
-		input: "update /* expression */ a set b = 3 + 4",
-	}, {
-		input: "update /* where */ a set b = 3 where a = b",
-	}, {
-		input: "update /* order */ a set b = 3 order by c desc",
-	}, {
-		input: "update /* limit */ a set b = 3 limit c",
-	}, {
-		input: "update /* bool in update */ a set b = true",
-	}, {
-		input: "update /* bool expr in update */ a set b = 5 > 2",
-	}, {
-		input: "update /* bool in update where */ a set b = 5 where c",
-	}, {
-		input: "update /* table qualifier */ a set a.b = 3",
-	}, {
-		input: "update /* table qualifier */ a set t.a.b = 3",
-	}, {
-		input:  "update /* table alias */ tt aa set aa.cc = 3",
-		output: "update /* table alias */ tt as aa set aa.cc = 3",
-	}, {
-		input:  "update (select id from foo) subqalias set id = 4",
-		output: "update (select id from foo) as subqalias set id = 4",
-	}, {
-		input:  "update foo f, bar b set f.id = b.id where b.name = 'test'",
-		output: "update foo as f, bar as b set f.id = b.id where b.name = 'test'",
-	}, {
-		input:  "update foo f join bar b on f.name = b.name set f.id = b.id where b.name = 'test'",
-		output: "update foo as f join bar as b on f.name = b.name set f.id = b.id where b.name = 'test'",
-	}, {
-		input: "delete /* simple */ from a",
-	}, {
-		input: "delete /* a.b */ from a.b",
-	}, {
-		input: "delete /* where */ from a where a = b",
-	}, {
-		input: "delete /* order */ from a order by b desc",
-	}, {
-		input: "delete /* limit */ from a limit b",
-	}, {
-		input: "delete a from a join b on a.id = b.id where b.name = 'test'",
-	}, {
-		input: "delete a, b from a, b where a.id = b.id and b.name = 'test'",
-	}, {
-		input:  "delete from a1, a2 using t1 as a1 inner join t2 as a2 where a1.id=a2.id",
-		output: "delete a1, a2 from t1 as a1 join t2 as a2 where a1.id = a2.id",
-	}, {
-		input: "set /* simple */ a = 3",
-	}, {
-		input: "set #simple\n b = 4",
-	}, {
-		input: "set character_set_results = utf8",
-	}, {
-		input: "set @@session.autocommit = true",
-	}, {
-		input: "set @@session.`autocommit` = true",
-	}, {
-		input: "set @@session.'autocommit' = true",
-	}, {
-		input: "set @@session.\"autocommit\" = true",
-	}, {
-		input:  "set names utf8 collate foo",
-		output: "set names 'utf8'",
-	}, {
-		input:  "set character set utf8",
-		output: "set charset 'utf8'",
-	}, {
-		input:  "set character set 'utf8'",
-		output: "set charset 'utf8'",
-	}, {
-		input:  "set character set \"utf8\"",
-		output: "set charset 'utf8'",
-	}, {
-		input:  "set charset default",
-		output: "set charset default",
-	}, {
-		input:  "set session wait_timeout = 3600",
-		output: "set session wait_timeout = 3600",
-	}, {
-		input: "set /* list */ a = 3, b = 4",
-	}, {
-		input: "set /* mixed list */ a = 3, names 'utf8', charset 'ascii', b = 4",
-	}, {
-		input:  "set session transaction isolation level repeatable read",
-		output: "set session tx_isolation = 'repeatable read'",
-	}, {
-		input:  "set global transaction isolation level repeatable read",
-		output: "set global tx_isolation = 'repeatable read'",
-	}, {
-		input:  "set transaction isolation level repeatable read",
-		output: "set tx_isolation = 'repeatable read'",
-	}, {
-		input:  "set transaction isolation level read committed",
-		output: "set tx_isolation = 'read committed'",
-	}, {
-		input:  "set transaction isolation level read uncommitted",
-		output: "set tx_isolation = 'read uncommitted'",
-	}, {
-		input:  "set transaction isolation level serializable",
-		output: "set tx_isolation = 'serializable'",
-	}, {
-		input:  "set transaction read write",
-		output: "set tx_read_only = 0",
-	}, {
-		input:  "set transaction read only",
-		output: "set tx_read_only = 1",
-	}, {
-		input: "set tx_read_only = 1",
-	}, {
-		input: "set tx_read_only = 0",
-	}, {
-		input: "set tx_isolation = 'repeatable read'",
-	}, {
-		input: "set tx_isolation = 'read committed'",
-	}, {
-		input: "set tx_isolation = 'read uncommitted'",
-	}, {
-		input: "set tx_isolation = 'serializable'",
-	}, {
-		input: "set sql_safe_updates = 0",
-	}, {
-		input: "set sql_safe_updates = 1",
-	}, {
-		input:  "alter ignore table a add foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add spatial key foo (column1)",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add unique key foo (column1)",
-		output: "alter table a",
-	}, {
-		input:  "alter table `By` add foo",
-		output: "alter table `By`",
-	}, {
-		input:  "alter table a alter foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a change foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a modify foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a disable foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a enable foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a order foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a default foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a discard foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a import foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table a rename b",
-		output: "rename table a to b",
-	}, {
-		input:  "alter table `By` rename `bY`",
-		output: "rename table `By` to `bY`",
-	}, {
-		input:  "alter table a rename to b",
-		output: "rename table a to b",
-	}, {
-		input:  "alter table a rename as b",
-		output: "rename table a to b",
-	}, {
-		input:  "alter table a rename index foo to bar",
-		output: "alter table a",
-	}, {
-		input:  "alter table a rename key foo to bar",
-		output: "alter table a",
-	}, {
-		input:  "alter table e auto_increment = 20",
-		output: "alter table e",
-	}, {
-		input:  "alter table e character set = 'ascii'",
-		output: "alter table e",
-	}, {
-		input:  "alter table e default character set = 'ascii'",
-		output: "alter table e",
-	}, {
-		input:  "alter table e comment = 'hello'",
-		output: "alter table e",
-	}, {
-		input:  "alter table a reorganize partition b into (partition c values less than (?), partition d values less than (maxvalue))",
-		output: "alter table a reorganize partition b into (partition c values less than (:v1), partition d values less than (maxvalue))",
-	}, {
-		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add column id int",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add index idx (id)",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add fulltext index idx (id)",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add spatial index idx (id)",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add foreign key",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add primary key",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add constraint",
-		output: "alter table a",
-	}, {
-		input:  "alter table a add id",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop column id int",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop partition p2712",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop index idx (id)",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop fulltext index idx (id)",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop spatial index idx (id)",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop foreign key",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop primary key",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop constraint",
-		output: "alter table a",
-	}, {
-		input:  "alter table a drop id",
-		output: "alter table a",
-	}, {
-		input: "alter table a add vindex hash (id)",
-	}, {
-		input:  "alter table a add vindex `hash` (`id`)",
-		output: "alter table a add vindex hash (id)",
-	}, {
-		input:  "alter table a add vindex hash (id) using `hash`",
-		output: "alter table a add vindex hash (id) using hash",
-	}, {
-		input: "alter table a add vindex `add` (`add`)",
-	}, {
-		input: "alter table a add vindex hash (id) using hash",
-	}, {
-		input:  "alter table a add vindex hash (id) using `hash`",
-		output: "alter table a add vindex hash (id) using hash",
-	}, {
-		input: "alter table user add vindex name_lookup_vdx (name) using lookup_hash with owner=user, table=name_user_idx, from=name, to=user_id",
-	}, {
-		input:  "alter table user2 add vindex name_lastname_lookup_vdx (name,lastname) using lookup with owner=`user`, table=`name_lastname_keyspace_id_map`, from=`name,lastname`, to=`keyspace_id`",
-		output: "alter table user2 add vindex name_lastname_lookup_vdx (name, lastname) using lookup with owner=user, table=name_lastname_keyspace_id_map, from=name,lastname, to=keyspace_id",
-	}, {
-		input: "alter table a drop vindex hash",
-	}, {
-		input:  "alter table a drop vindex `hash`",
-		output: "alter table a drop vindex hash",
-	}, {
-		input:  "alter table a drop vindex hash",
-		output: "alter table a drop vindex hash",
-	}, {
-		input:  "alter table a drop vindex `add`",
-		output: "alter table a drop vindex `add`",
-	}, {
-		input: "create table a",
-	}, {
-		input:  "create table a (\n\t`a` int\n)",
-		output: "create table a (\n\ta int\n)",
-	}, {
-		input: "create table `by` (\n\t`by` char\n)",
-	}, {
-		input:  "create table if not exists a (\n\t`a` int\n)",
-		output: "create table a (\n\ta int\n)",
-	}, {
-		input:  "create table a ignore me this is garbage",
-		output: "create table a",
-	}, {
-		input:  "create table a (a int, b char, c garbage)",
-		output: "create table a",
-	}, {
-		input: "create vindex hash_vdx using hash",
-	}, {
-		input: "create vindex lookup_vdx using lookup with owner=user, table=name_user_idx, from=name, to=user_id",
-	}, {
-		input: "create vindex xyz_vdx using xyz with param1=hello, param2='world', param3=123",
-	}, {
-		input:  "create index a on b",
-		output: "alter table b",
-	}, {
-		input:  "create unique index a on b",
-		output: "alter table b",
-	}, {
-		input:  "create unique index a using foo on b",
-		output: "alter table b",
-	}, {
-		input:  "create fulltext index a using foo on b",
-		output: "alter table b",
-	}, {
-		input:  "create spatial index a using foo on b",
-		output: "alter table b",
-	}, {
-		input:  "create view a",
-		output: "create table a",
-	}, {
-		input:  "create or replace view a",
-		output: "create table a",
-	}, {
-		input:  "alter view a",
-		output: "alter table a",
-	}, {
-		input:  "drop view a",
-		output: "drop table a",
-	}, {
-		input:  "drop table a",
-		output: "drop table a",
-	}, {
-		input:  "drop table if exists a",
-		output: "drop table if exists a",
-	}, {
-		input:  "drop view if exists a",
-		output: "drop table if exists a",
-	}, {
-		input:  "drop index b on a",
-		output: "alter table a",
-	}, {
-		input:  "analyze table a",
-		output: "alter table a",
-	}, {
-		input:  "show binary logs",
-		output: "show binary logs",
-	}, {
-		input:  "show binlog events",
-		output: "show binlog",
-	}, {
-		input:  "show character set",
-		output: "show character set",
-	}, {
-		input:  "show character set like '%foo'",
-		output: "show character set",
-	}, {
-		input:  "show collation",
-		output: "show collation",
-	}, {
-		input:  "show create database d",
-		output: "show create database",
-	}, {
-		input:  "show create event e",
-		output: "show create event",
-	}, {
-		input:  "show create function f",
-		output: "show create function",
-	}, {
-		input:  "show create procedure p",
-		output: "show create procedure",
-	}, {
-		input:  "show create table t",
-		output: "show create table",
-	}, {
-		input:  "show create trigger t",
-		output: "show create trigger",
-	}, {
-		input:  "show create user u",
-		output: "show create user",
-	}, {
-		input:  "show create view v",
-		output: "show create view",
-	}, {
-		input:  "show databases",
-		output: "show databases",
-	}, {
-		input:  "show engine INNODB",
-		output: "show engine",
-	}, {
-		input:  "show engines",
-		output: "show engines",
-	}, {
-		input:  "show storage engines",
-		output: "show storage",
-	}, {
-		input:  "show errors",
-		output: "show errors",
-	}, {
-		input:  "show events",
-		output: "show events",
-	}, {
-		input:  "show function code func",
-		output: "show function",
-	}, {
-		input:  "show function status",
-		output: "show function",
-	}, {
-		input:  "show grants for 'root@localhost'",
-		output: "show grants",
-	}, {
-		input:  "show index from table",
-		output: "show index",
-	}, {
-		input:  "show indexes from table",
-		output: "show indexes",
-	}, {
-		input:  "show keys from table",
-		output: "show keys",
-	}, {
-		input:  "show master status",
-		output: "show master",
-	}, {
-		input:  "show open tables",
-		output: "show open",
-	}, {
-		input:  "show plugins",
-		output: "show plugins",
-	}, {
-		input:  "show privileges",
-		output: "show privileges",
-	}, {
-		input:  "show procedure code p",
-		output: "show procedure",
-	}, {
-		input:  "show procedure status",
-		output: "show procedure",
-	}, {
-		input:  "show processlist",
-		output: "show processlist",
-	}, {
-		input:  "show full processlist",
-		output: "show processlist",
-	}, {
-		input:  "show profile cpu for query 1",
-		output: "show profile",
-	}, {
-		input:  "show profiles",
-		output: "show profiles",
-	}, {
-		input:  "show relaylog events",
-		output: "show relaylog",
-	}, {
-		input:  "show slave hosts",
-		output: "show slave",
-	}, {
-		input:  "show slave status",
-		output: "show slave",
-	}, {
-		input:  "show status",
-		output: "show status",
-	}, {
-		input:  "show global status",
-		output: "show global status",
-	}, {
-		input:  "show session status",
-		output: "show session status",
-	}, {
-		input:  "show table status",
-		output: "show table",
-	}, {
-		input: "show tables",
-	}, {
-		input: "show tables like '%keyspace%'",
-	}, {
-		input: "show tables where 1 = 0",
-	}, {
-		input: "show tables from a",
-	}, {
-		input: "show tables from a where 1 = 0",
-	}, {
-		input: "show tables from a like '%keyspace%'",
-	}, {
-		input: "show full tables",
-	}, {
-		input: "show full tables from a",
-	}, {
-		input:  "show full tables in a",
-		output: "show full tables from a",
-	}, {
-		input: "show full tables from a like '%keyspace%'",
-	}, {
-		input: "show full tables from a where 1 = 0",
-	}, {
-		input: "show full tables like '%keyspace%'",
-	}, {
-		input: "show full tables where 1 = 0",
-	}, {
-		input:  "show triggers",
-		output: "show triggers",
-	}, {
-		input:  "show variables",
-		output: "show variables",
-	}, {
-		input:  "show global variables",
-		output: "show global variables",
-	}, {
-		input:  "show session variables",
-		output: "show session variables",
-	}, {
-		input:  "show vindexes",
-		output: "show vindexes",
-	}, {
-		input:  "show vindexes on t",
-		output: "show vindexes on t",
-	}, {
-		input: "show vitess_keyspaces",
-	}, {
-		input: "show vitess_shards",
-	}, {
-		input: "show vitess_tablets",
-	}, {
-		input: "show vschema_tables",
-	}, {
-		input:  "show warnings",
-		output: "show warnings",
-	}, {
-		input:  "show foobar",
-		output: "show foobar",
-	}, {
-		input:  "show foobar like select * from table where syntax is 'ignored'",
-		output: "show foobar",
-	}, {
-		input:  "use db",
-		output: "use db",
-	}, {
-		input:  "use duplicate",
-		output: "use `duplicate`",
-	}, {
-		input:  "use `ks:-80@master`",
-		output: "use `ks:-80@master`",
-	}, {
-		input:  "describe foobar",
-		output: "otherread",
-	}, {
-		input:  "desc foobar",
-		output: "otherread",
-	}, {
-		input:  "explain foobar",
-		output: "otherread",
-	}, {
-		input:  "truncate table foo",
-		output: "truncate table foo",
-	}, {
-		input:  "truncate foo",
-		output: "truncate table foo",
-	}, {
-		input:  "repair foo",
-		output: "otheradmin",
-	}, {
-		input:  "optimize foo",
-		output: "otheradmin",
-	}, {
-		input: "select /* EQ true */ 1 from t where a = true",
-	}, {
-		input: "select /* EQ false */ 1 from t where a = false",
-	}, {
-		input: "select /* NE true */ 1 from t where a != true",
-	}, {
-		input: "select /* NE false */ 1 from t where a != false",
-	}, {
-		input: "select /* LT true */ 1 from t where a < true",
-	}, {
-		input: "select /* LT false */ 1 from t where a < false",
-	}, {
-		input: "select /* GT true */ 1 from t where a > true",
-	}, {
-		input: "select /* GT false */ 1 from t where a > false",
-	}, {
-		input: "select /* LE true */ 1 from t where a <= true",
-	}, {
-		input: "select /* LE false */ 1 from t where a <= false",
-	}, {
-		input: "select /* GE true */ 1 from t where a >= true",
-	}, {
-		input: "select /* GE false */ 1 from t where a >= false",
-	}, {
-		input:  "select * from t order by a collate utf8_general_ci",
-		output: "select * from t order by a collate utf8_general_ci asc",
-	}, {
-		input: "select k collate latin1_german2_ci as k1 from t1 order by k1 asc",
-	}, {
-		input: "select * from t group by a collate utf8_general_ci",
-	}, {
-		input: "select MAX(k collate latin1_german2_ci) from t1",
-	}, {
-		input: "select distinct k collate latin1_german2_ci from t1",
-	}, {
-		input: "select * from t1 where 'Müller' collate latin1_german2_ci = k",
-	}, {
-		input: "select * from t1 where k like 'Müller' collate latin1_german2_ci",
-	}, {
-		input: "select k from t1 group by k having k = 'Müller' collate latin1_german2_ci",
-	}, {
-		input: "select k from t1 join t2 order by a collate latin1_german2_ci asc, b collate latin1_german2_ci asc",
-	}, {
-		input:  "select k collate 'latin1_german2_ci' as k1 from t1 order by k1 asc",
-		output: "select k collate latin1_german2_ci as k1 from t1 order by k1 asc",
-	}, {
-		input:  "select /* drop trailing semicolon */ 1 from dual;",
-		output: "select /* drop trailing semicolon */ 1 from dual",
-	}, {
-		input: "select /* cache directive */ sql_no_cache 'foo' from t",
-	}, {
-		input: "select binary 'a' = 'A' from t",
-	}, {
-		input: "select 1 from t where foo = _binary 'bar'",
-	}, {
-		input:  "select 1 from t where foo = _binary'bar'",
-		output: "select 1 from t where foo = _binary 'bar'",
-	}, {
-		input: "select match(a) against ('foo') from t",
-	}, {
-		input: "select match(a1, a2) against ('foo' in natural language mode with query expansion) from t",
-	}, {
-		input: "select title from video as v where match(v.title, v.tag) against ('DEMO' in boolean mode)",
-	}, {
-		input: "select name, group_concat(score) from t group by name",
-	}, {
-		input: "select name, group_concat(distinct id, score order by id desc separator ':') from t group by name",
-	}, {
-		input: "select * from t partition (p0)",
-	}, {
-		input: "select * from t partition (p0, p1)",
-	}, {
-		input: "select e.id, s.city from employees as e join stores partition (p1) as s on e.store_id = s.id",
-	}, {
-		input: "select truncate(120.3333, 2) from dual",
-	}, {
-		input: "update t partition (p0) set a = 1",
-	}, {
-		input: "insert into t partition (p0) values (1, 'asdf')",
-	}, {
-		input: "insert into t1 select * from t2 partition (p0)",
+		input:   "select x::bit from t",
+		dialect: PostgresDialect,
+		output:  "cannot cast to bit at position 19 near 'from'",
+	}}
+
+	for _, tcase := range invalidSQL {
+		_, err := ParseWithDialect(tcase.input, tcase.dialect)
+		if err == nil || err.Error() != tcase.output {
+			t.Errorf("%s: %v, want %s", tcase.input, err, tcase.output)
+		}
+	}
+}
+
+func TestSQLServerDialect(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		mysql  string
+		output string
+	}{{
+		input:  "select * from [Order Details]",
+		mysql:  "select * from `Order Details`",
+		output: "select * from [Order Details]",
+	}, {
+		input:  "select [a]]b], [select], c from t",
+		mysql:  "select `a]b`, `select`, c from t",
+		output: "select [a]]b], [select], c from t",
+	}, {
+		input:  "select [top] from t",
+		mysql:  "select top from t",
+		output: "select [top] from t",
+	}, {
+		input:  "select top 10 * from t",
+		mysql:  "select * from t limit 10",
+		output: "select top 10 * from t",
+	}, {
+		input:  "select distinct top 10 a from t where b = 1 order by a asc",
+		mysql:  "select distinct a from t where b = 1 order by a asc limit 10",
+		output: "select distinct top 10 a from t where b = 1 order by a asc",
+	}, {
+		input:  "select top (:n) a from t",
+		mysql:  "select a from t limit :n",
+		output: "select top (:n) a from t",
+	}, {
+		// MySQL has no percent.
+		input:  "select top 5 percent a from t",
+		mysql:  "select top 5 percent a from t",
+		output: "select top 5 percent a from t",
+	}, {
+		input:  "select top (1+1) a from t",
+		mysql:  "select a from t limit (1 + 1)",
+		output: "select top (1 + 1) a from t",
+	}, {
+		input:  "select top 5 a from t union select top 1 b from u",
+		mysql:  "select a from t limit 5 union (select b from u limit 1)",
+		output: "select top 5 a from t union select top 1 b from u",
+	}, {
+		input:  "select a from t limit 1, 2",
+		mysql:  "select a from t limit 1, 2",
+		output: "select a from t limit 1, 2",
+	}, {
+		input:  "update t set a = 1 limit 3",
+		mysql:  "update t set a = 1 limit 3",
+		output: "update t set a = 1 limit 3",
+	}}
+
+	for _, tcase := range validSQL {
+		tree, err := ParseWithDialect(tcase.input, SQLServerDialect)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if out := String(tree); out != tcase.mysql {
+			t.Errorf("String(%s): %s, want %s", tcase.input, out, tcase.mysql)
+		}
+		if out := StringWithDialect(tree, SQLServerDialect); out != tcase.output {
+			t.Errorf("StringWithDialect(%s): %s, want %s", tcase.input, out, tcase.output)
+		}
+	}
+
+	// TOP n is parsed into the regular Limit node.
+	tree, err := ParseWithDialect("select top 3 a from t", SQLServerDialect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := String(tree.(*Select).Limit.Rowcount), "3"; got != want {
+		t.Errorf("Limit.Rowcount: %s, want %s", got, want)
+	}
+
+	invalidSQL := []struct {
+		input   string
+		dialect Dialect
+		output  string
+	}{{
+		input:   "select * from [Order Details]",
+		dialect: MySQLDialect,
+		output:  "syntax error at position 16 near '['",
 	}, {
-		input: "replace into t partition (p0) values (1, 'asdf')",
+		input:   "select top 10 * from t",
+		dialect: MySQLDialect,
+		output:  "syntax error at position 14 near '10'",
 	}, {
-		input: "delete from t partition (p0) where a = 1",
+		input:   "select top 10 * from t limit 5",
+		dialect: SQLServerDialect,
+		output:  "cannot use both top and limit at position 31",
 	}, {
-		input: "stream * from t",
+		input:   "select * from [t",
+		dialect: SQLServerDialect,
+		output:  "unterminated quoted identifier starting at line 1 at position 15",
+	}}
+
+	for _, tcase := range invalidSQL {
+		_, err := ParseWithDialect(tcase.input, tcase.dialect)
+		if err == nil || err.Error() != tcase.output {
+			t.Errorf("%s: %v, want %s", tcase.input, err, tcase.output)
+		}
+	}
+}
+
+func TestReturning(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		output string
+	}{{
+		input: "insert into t(a) values (1) returning *",
 	}, {
-		input: "stream /* comment */ * from t",
+		input: "insert into t(a) values (1), (2) returning id, a + 1 as b",
 	}, {
-		input: "begin",
+		input: "insert into t set a = 1 on duplicate key update a = 2 returning id",
 	}, {
-		input:  "start transaction",
-		output: "begin",
+		input: "insert into t(a) select b from u returning t.*",
 	}, {
-		input: "commit",
+		input:  "update t set a = 1 where b = 2 returning a AS old, upper(c) c",
+		output: "update t set a = 1 where b = 2 returning a as old, upper(c) as c",
 	}, {
-		input: "rollback",
+		input: "delete from t where a = 1 order by b asc limit 5 returning id",
 	}, {
-		input: "create database test_db",
+		input: "delete t from t join u on t.a = u.a returning t.id",
 	}, {
-		input:  "create schema test_db",
-		output: "create database test_db",
+		input:  "select `returning` from t",
+		output: "select `returning` from t",
+	}}
+
+	for _, dialect := range []Dialect{PostgresDialect, MariaDBDialect} {
+		for _, tcase := range validSQL {
+			if tcase.output == "" {
+				tcase.output = tcase.input
+			}
+			tree, err := ParseWithDialect(tcase.input, dialect)
+			if err != nil {
+				t.Errorf("input: %s, err: %v", tcase.input, err)
+				continue
+			}
+			if out := StringWithDialect(tree, dialect); out != tcase.output {
+				t.Errorf("out: %s, want %s", out, tcase.output)
+			}
+		}
+	}
+
+	// RETURNING is an identifier in the default dialect.
+	tree, err := Parse("select returning from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, want := String(tree), "select returning from t"; out != want {
+		t.Errorf("out: %s, want %s", out, want)
+	}
+	_, err = Parse("delete from t returning id")
+	if want := "syntax error at position 24 near 'returning'"; err == nil || err.Error() != want {
+		t.Errorf("delete from t returning id: %v, want %s", err, want)
+	}
+}
+
+func TestOnConflict(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		output string
+	}{{
+		input: "insert into t(a, b) values (1, 2) on conflict do nothing",
 	}, {
-		input:  "create database if not exists test_db",
-		output: "create database test_db",
+		input: "insert into t(a, b) values (1, 2) on conflict (a) do update set b = excluded.b",
 	}, {
-		input: "drop database test_db",
+		input:  "INSERT INTO t(a, b) VALUES (1, 2) ON CONFLICT (a, b) WHERE c > 0 DO UPDATE SET c = t.c + 1 WHERE t.d IS NULL RETURNING *",
+		output: "insert into t(a, b) values (1, 2) on conflict (a, b) where c > 0 do update set c = t.c + 1 where t.d is null returning *",
 	}, {
-		input:  "drop schema test_db",
-		output: "drop database test_db",
+		input: "insert into t(a) select a from u on conflict on constraint t_pkey do nothing",
 	}, {
-		input:  "drop database if exists test_db",
-		output: "drop database test_db",
+		input:  "select `nothing`, `conflict` from t",
+		output: "select `nothing`, `conflict` from t",
 	}}
-)
-
-func TestValid(t *testing.T) {
 	for _, tcase := range validSQL {
 		if tcase.output == "" {
 			tcase.output = tcase.input
 		}
-		tree, err := Parse(tcase.input)
+		tree, err := ParseWithDialect(tcase.input, PostgresDialect)
 		if err != nil {
-			t.Errorf("Parse(%q) err: %v, want nil", tcase.input, err)
+			t.Errorf("input: %s, err: %v", tcase.input, err)
 			continue
 		}
-		out := String(tree)
-		if out != tcase.output {
-			t.Errorf("Parse(%q) = %q, want: %q", tcase.input, out, tcase.output)
+		if out := StringWithDialect(tree, PostgresDialect); out != tcase.output {
+			t.Errorf("out: %s, want %s", out, tcase.output)
 		}
-		// This test just exercises the tree walking functionality.
-		// There's no way automated way to verify that a node calls
-		// all its children. But we can examine code coverage and
-		// ensure that all walkSubtree functions were called.
-		Walk(func(node SQLNode) (bool, error) {
-			return true, nil
-		}, tree)
+	}
+
+	tree, err := ParseWithDialect("insert into t(a) values (1) on conflict (a) do update set b = 2 where c = 3", PostgresDialect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &OnConflict{
+		Columns:     Columns{NewColIdent("a")},
+		Action:      DoUpdateStr,
+		Exprs:       UpdateExprs{&UpdateExpr{Name: &ColName{Name: NewColIdent("b")}, Expr: NewIntVal([]byte("2"))}},
+		UpdateWhere: NewWhere(WhereStr, &ComparisonExpr{Operator: EqualStr, Left: &ColName{Name: NewColIdent("c")}, Right: NewIntVal([]byte("3"))}),
+	}
+	if got := tree.(*Insert).OnConflict; !reflect.DeepEqual(got, want) {
+		t.Errorf("OnConflict: %s, want %s", String(got), String(want))
+	}
+
+	// ON CONFLICT is only accepted in the Postgres dialect, where
+	// conflict and nothing remain identifiers outside of it.
+	tree, err = Parse("select conflict, nothing from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, want := String(tree), "select conflict, nothing from t"; out != want {
+		t.Errorf("out: %s, want %s", out, want)
+	}
+	_, err = Parse("insert into t(a) values (1) on conflict do nothing")
+	if want := "syntax error at position 40 near 'conflict'"; err == nil || err.Error() != want {
+		t.Errorf("on conflict in the default dialect: %v, want %s", err, want)
 	}
 }
 
-func TestCaseSensitivity(t *testing.T) {
-	validSQL := []struct {
-		input  string
-		output string
+func TestDMLModifiers(t *testing.T) {
+	testcases := []struct {
+		// sql has a %s for the modifiers.
+		sql        string
+		priorities []string
+		quick      bool
+		ignore     bool
 	}{{
-		input:  "create table A (\n\t`B` int\n)",
-		output: "create table A (\n\tB int\n)",
-	}, {
-		input:  "create index b on A",
-		output: "alter table A",
-	}, {
-		input:  "alter table A foo",
-		output: "alter table A",
-	}, {
-		input:  "alter table A convert",
-		output: "alter table A",
-	}, {
-		// View names get lower-cased.
-		input:  "alter view A foo",
-		output: "alter table a",
-	}, {
-		input:  "alter table A rename to B",
-		output: "rename table A to B",
-	}, {
-		input: "rename table A to B",
-	}, {
-		input:  "drop table B",
-		output: "drop table B",
-	}, {
-		input:  "drop table if exists B",
-		output: "drop table if exists B",
-	}, {
-		input:  "drop index b on A",
-		output: "alter table A",
-	}, {
-		input: "select a from B",
-	}, {
-		input: "select A as B from C",
-	}, {
-		input: "select B.* from c",
-	}, {
-		input: "select B.A from c",
-	}, {
-		input: "select * from B as C",
-	}, {
-		input: "select * from A.B",
-	}, {
-		input: "update A set b = 1",
-	}, {
-		input: "update A.B set b = 1",
-	}, {
-		input: "select A() from b",
-	}, {
-		input: "select A(B, C) from b",
-	}, {
-		input: "select A(distinct B, C) from b",
-	}, {
-		// IF is an exception. It's always lower-cased.
-		input:  "select IF(B, C) from b",
-		output: "select if(B, C) from b",
-	}, {
-		input: "select * from b use index (A)",
-	}, {
-		input: "insert into A(A, B) values (1, 2)",
-	}, {
-		input:  "CREATE TABLE A (\n\t`A` int\n)",
-		output: "create table A (\n\tA int\n)",
-	}, {
-		input:  "create view A",
-		output: "create table a",
+		sql:        "insert %sinto t(a) values (1)",
+		priorities: []string{LowPriorityStr, DelayedStr, HighPriorityStr},
+		ignore:     true,
+	}, {
+		sql:        "insert %sinto t set a = 1",
+		priorities: []string{LowPriorityStr, DelayedStr, HighPriorityStr},
+		ignore:     true,
+	}, {
+		sql:        "replace %sinto t(a) select b from u",
+		priorities: []string{LowPriorityStr, DelayedStr, HighPriorityStr},
+		ignore:     true,
+	}, {
+		sql:        "update %st set a = 1 where b = 2",
+		priorities: []string{LowPriorityStr},
+		ignore:     true,
+	}, {
+		sql:        "delete %sfrom t where a = 1 order by b asc limit 1",
+		priorities: []string{LowPriorityStr},
+		quick:      true,
+		ignore:     true,
+	}, {
+		sql:        "delete %st from t join u on t.a = u.a",
+		priorities: []string{LowPriorityStr},
+		quick:      true,
+		ignore:     true,
+	}}
+	for _, tcase := range testcases {
+		quicks := []string{""}
+		if tcase.quick {
+			quicks = append(quicks, QuickStr)
+		}
+		ignores := []string{""}
+		if tcase.ignore {
+			ignores = append(ignores, IgnoreStr)
+		}
+		for _, priority := range append([]string{""}, tcase.priorities...) {
+			for _, quick := range quicks {
+				for _, ignore := range ignores {
+					sql := fmt.Sprintf(tcase.sql, priority+quick+ignore)
+					tree, err := Parse(sql)
+					if err != nil {
+						t.Errorf("Parse(%s) err: %v", sql, err)
+						continue
+					}
+					if out := String(tree); out != sql {
+						t.Errorf("Parse(%s): %s", sql, out)
+					}
+					var gotPriority, gotQuick, gotIgnore string
+					switch tree := tree.(type) {
+					case *Insert:
+						gotPriority, gotIgnore = tree.Priority, tree.Ignore
+					case *Update:
+						gotPriority, gotIgnore = tree.Priority, tree.Ignore
+					case *Delete:
+						gotPriority, gotQuick, gotIgnore = tree.Priority, tree.Quick, tree.Ignore
+					}
+					if gotPriority != priority || gotQuick != quick || gotIgnore != ignore {
+						t.Errorf("Parse(%s) modifiers: %q %q %q, want %q %q %q", sql, gotPriority, gotQuick, gotIgnore, priority, quick, ignore)
+					}
+					if got, want := IgnoresErrors(tree), ignore != ""; got != want {
+						t.Errorf("IgnoresErrors(%s): %v, want %v", sql, got, want)
+					}
+				}
+			}
+		}
+	}
+
+	for _, sql := range []string{
+		"select low_priority a from t",
+		"select ignore a from t",
+		"insert quick into t(a) values (1)",
+		"insert ignore low_priority into t(a) values (1)",
+		"update delayed t set a = 1",
+		"update high_priority t set a = 1",
+		"delete high_priority from t",
+		"delete ignore quick from t",
+		"delete quick low_priority from t",
+		"delete from quick",
+	} {
+		if _, err := Parse(sql); err == nil {
+			t.Errorf("Parse(%s): nil, want error", sql)
+		}
+	}
+
+	// QUICK is still a column name, but not a table name.
+	tree, err := Parse("select quick from t where quick = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, want := String(tree), "select `quick` from t where `quick` = 1"; out != want {
+		t.Errorf("out: %s, want %s", out, want)
+	}
+}
+
+func TestTriggerSetQualifier(t *testing.T) {
+	tree, err := Parse("create trigger trg before insert on t for each row set NEW.a = 1, b = 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exprs := tree.(*CreateTrigger).Body.(*Set).Exprs
+	if got := exprs[0].Qualifier.String(); got != "NEW" || exprs[0].Name.String() != "a" {
+		t.Errorf("SET NEW.a: qualifier %s, name %s, want NEW and a", got, exprs[0].Name.String())
+	}
+	if !exprs[1].Qualifier.IsEmpty() {
+		t.Errorf("SET b: qualifier %s, want none", exprs[1].Qualifier.String())
+	}
+}
+
+func TestParseTrackSource(t *testing.T) {
+	testcases := []struct {
+		input string
+		text  string
+		span  Span
+	}{{
+		input: "select a from t",
+		text:  "select a from t",
+		span:  Span{0, 15},
 	}, {
-		input:  "alter view A",
-		output: "alter table a",
+		input: "  /* lead */ select a from t ;  ",
+		text:  "/* lead */ select a from t",
+		span:  Span{2, 28},
 	}, {
-		input:  "drop view A",
-		output: "drop table a",
+		input: "insert into t(a) values (1), (2)",
+		text:  "insert into t(a) values (1), (2)",
+		span:  Span{0, 32},
 	}, {
-		input:  "drop view if exists A",
-		output: "drop table if exists a",
+		input: "begin",
+		text:  "begin",
+		span:  Span{0, 5},
 	}, {
-		input:  "select /* lock in SHARE MODE */ 1 from t lock in SHARE MODE",
-		output: "select /* lock in SHARE MODE */ 1 from t lock in share mode",
+		input: "create table t (garbage",
+		text:  "create table t (garbage",
+		span:  Span{0, 23},
+	}}
+
+	for _, tcase := range testcases {
+		tree, err := ParseWithOptions(tcase.input, ParseOptions{TrackSource: true})
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		// Mutating the tree does not affect the recorded source.
+		if sel, ok := tree.(*Select); ok {
+			sel.AddWhere(NewIntVal([]byte("1")))
+		}
+		if got := StatementSource(tree); got != tcase.text {
+			t.Errorf("StatementSource(%q): %q, want %q", tcase.input, got, tcase.text)
+		}
+		if got := StatementSpan(tree); got == nil || *got != tcase.span {
+			t.Errorf("StatementSpan(%q): %v, want %v", tcase.input, got, tcase.span)
+		}
+	}
+
+	tree, err := Parse("select a from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := StatementSource(tree); got != "" {
+		t.Errorf("StatementSource: %q, want empty", got)
+	}
+	if got := StatementSpan(tree); got != nil {
+		t.Errorf("StatementSpan: %v, want nil", got)
+	}
+}
+
+func TestParseEmptyInLists(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "select * from t where a in ()",
+		output: "select * from t where false",
 	}, {
-		input:  "select next VALUE from t",
-		output: "select next 1 values from t",
+		input:  "select * from t where a not in ( ) and b in (1)",
+		output: "select * from t where true and b in (1)",
 	}, {
-		input: "select /* use */ 1 from t1 use index (A) where b = 1",
+		input:  "delete from t where (a, b) in () or c = 1",
+		output: "delete from t where false or c = 1",
 	}}
-	for _, tcase := range validSQL {
-		if tcase.output == "" {
-			tcase.output = tcase.input
+	for _, tcase := range testcases {
+		if _, err := Parse(tcase.input); err == nil {
+			t.Errorf("Parse(%q): nil error", tcase.input)
 		}
-		tree, err := Parse(tcase.input)
+		tree, err := ParseWithOptions(tcase.input, ParseOptions{EmptyInLists: true})
 		if err != nil {
-			t.Errorf("input: %s, err: %v", tcase.input, err)
+			t.Errorf("ParseWithOptions(%q): %v", tcase.input, err)
 			continue
 		}
-		out := String(tree)
-		if out != tcase.output {
-			t.Errorf("out: %s, want %s", out, tcase.output)
+		if got := String(tree); got != tcase.output {
+			t.Errorf("ParseWithOptions(%q): %s, want %s", tcase.input, got, tcase.output)
 		}
 	}
+
+	tree, err := ParseWithOptions("select * from t where x.a not in ()", ParseOptions{EmptyInLists: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &EmptyInExpr{Left: &ColName{Name: NewColIdent("a"), Qualifier: TableName{Name: NewTableIdent("x")}}, Operator: NotInStr}
+	if got := tree.(*Select).Where.Expr; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWithOptions: %#v, want %#v", got, want)
+	}
 }
 
-func TestKeywords(t *testing.T) {
-	validSQL := []struct {
+func TestParseNestedComments(t *testing.T) {
+	testcases := []struct {
 		input  string
 		output string
+		err    string
 	}{{
-		input:  "select current_timestamp",
-		output: "select current_timestamp() from dual",
-	}, {
-		input: "update t set a = current_timestamp()",
-	}, {
-		input:  "select a, current_date from t",
-		output: "select a, current_date() from t",
-	}, {
-		input:  "insert into t(a, b) values (current_date, current_date())",
-		output: "insert into t(a, b) values (current_date(), current_date())",
-	}, {
-		input: "select * from t where a > utc_timestmp()",
-	}, {
-		input:  "update t set b = utc_timestamp + 5",
-		output: "update t set b = utc_timestamp() + 5",
-	}, {
-		input:  "select utc_time, utc_date",
-		output: "select utc_time(), utc_date() from dual",
+		input:  "select /* outer /* inner */ still comment */ a from t",
+		output: "select /* outer /* inner */ still comment */ a from t",
 	}, {
-		input:  "select 1 from dual where localtime > utc_time",
-		output: "select 1 from dual where localtime() > utc_time()",
-	}, {
-		input:  "update t set a = localtimestamp(), b = utc_timestamp",
-		output: "update t set a = localtimestamp(), b = utc_timestamp()",
-	}, {
-		input: "insert into t(a) values (unix_timestamp)",
-	}, {
-		input: "select replace(a, 'foo', 'bar') from t",
-	}, {
-		input: "update t set a = replace('1234', '2', '1')",
-	}, {
-		input: "insert into t(a, b) values ('foo', 'bar') on duplicate key update a = replace(hex('foo'), 'f', 'b')",
-	}, {
-		input: "update t set a = left('1234', 3)",
-	}, {
-		input: "select left(a, 5) from t",
-	}, {
-		input: "update t set d = adddate(date('2003-12-31 01:02:03'), interval 5 days)",
-	}, {
-		input: "insert into t(a, b) values (left('foo', 1), 'b')",
-	}, {
-		input: "insert /* qualified function */ into t(a, b) values (test.PI(), 'b')",
-	}, {
-		input:  "select /* keyword in qualified id */ * from t join z on t.key = z.key",
-		output: "select /* keyword in qualified id */ * from t join z on t.`key` = z.`key`",
-	}, {
-		input:  "select /* non-reserved keywords as unqualified cols */ date, view, offset from t",
-		output: "select /* non-reserved keywords as unqualified cols */ `date`, `view`, `offset` from t",
+		input:  "select a from t /* a // b /*/ c */ */ where b = 1",
+		output: "select a from t where b = 1",
 	}, {
-		input:  "select /* share and mode as cols */ share, mode from t where share = 'foo'",
-		output: "select /* share and mode as cols */ `share`, `mode` from t where `share` = 'foo'",
+		input: "select a from t\n/* outer /* inner */\nwhere b = 1",
+		err:   "unterminated comment starting at line 2 at position 17",
+	}}
+	for _, tcase := range testcases {
+		tree, err := ParseWithOptions(tcase.input, ParseOptions{NestedComments: true})
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ParseWithOptions(%q): %v, want %s", tcase.input, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseWithOptions(%q): %v", tcase.input, err)
+			continue
+		}
+		if got := String(tree); got != tcase.output {
+			t.Errorf("ParseWithOptions(%q): %s, want %s", tcase.input, got, tcase.output)
+		}
+	}
+}
+
+func TestParseSQLMode(t *testing.T) {
+	input := `select "a\b", 'it''s' from t where c = 'x\y'`
+	testcases := []struct {
+		mode   SQLMode
+		output string
+	}{{
+		mode:   0,
+		output: `select 'a\b', 'it\'s' from t where c = 'xy'`,
 	}, {
-		input:  "select /* unused keywords as cols */ write, varying from t where trailing = 'foo'",
-		output: "select /* unused keywords as cols */ `write`, `varying` from t where `trailing` = 'foo'",
+		mode:   SQLModeANSIQuotes,
+		output: "select `a\\b`, 'it\\'s' from t where c = 'xy'",
 	}, {
-		input:  "select status from t",
-		output: "select `status` from t",
+		mode:   SQLModeNoBackslashEscapes,
+		output: `select 'a\b', 'it''s' from t where c = 'x\y'`,
 	}, {
-		input:  "select variables from t",
-		output: "select `variables` from t",
+		mode:   SQLModeANSIQuotes | SQLModeNoBackslashEscapes,
+		output: "select `a\\b`, 'it''s' from t where c = 'x\\y'",
 	}}
-
-	for _, tcase := range validSQL {
-		if tcase.output == "" {
-			tcase.output = tcase.input
+	for _, tcase := range testcases {
+		tree, err := ParseWithOptions(input, ParseOptions{SQLMode: tcase.mode})
+		if err != nil {
+			t.Errorf("ParseWithOptions(%d): %v", tcase.mode, err)
+			continue
 		}
-		tree, err := Parse(tcase.input)
+		output := StringWithOptions(tree, FormatOptions{SQLMode: tcase.mode})
+		if output != tcase.output {
+			t.Errorf("ParseWithOptions(%d):\n%s, want\n%s", tcase.mode, output, tcase.output)
+		}
+		// The output parses to the same statement in the mode.
+		tree2, err := ParseWithOptions(output, ParseOptions{SQLMode: tcase.mode})
 		if err != nil {
-			t.Errorf("input: %s, err: %v", tcase.input, err)
+			t.Errorf("ParseWithOptions(%d, %s): %v", tcase.mode, output, err)
 			continue
 		}
-		out := String(tree)
-		if out != tcase.output {
-			t.Errorf("out: %s, want %s", out, tcase.output)
+		if !reflect.DeepEqual(tree, tree2) {
+			t.Errorf("ParseWithOptions(%d, %s): %s, want %s", tcase.mode, output, String(tree2), String(tree))
 		}
 	}
+
+	if got, want := ParseSQLMode("STRICT_TRANS_TABLES, ansi_quotes,NO_BACKSLASH_ESCAPES"), SQLModeANSIQuotes|SQLModeNoBackslashEscapes; got != want {
+		t.Errorf("ParseSQLMode: %d, want %d", got, want)
+	}
+	if got, want := ParseSQLMode("ANSI"), SQLModeANSIQuotes|SQLModePipesAsConcat; got != want {
+		t.Errorf("ParseSQLMode(ANSI): %d, want %d", got, want)
+	}
+	if got, want := ParseSQLMode("pipes_as_concat,ONLY_FULL_GROUP_BY"), SQLModePipesAsConcat; got != want {
+		t.Errorf("ParseSQLMode(PIPES_AS_CONCAT): %d, want %d", got, want)
+	}
+
+	mode := ParseSQLMode("ANSI")
+	tree, err := ParseWithOptions(`select "a" || b from t`, ParseOptions{SQLMode: mode})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := String(tree), "select concat(a, b) from t"; got != want {
+		t.Errorf("ParseWithOptions(ANSI): %s, want %s", got, want)
+	}
+	if got, want := StringWithOptions(tree, FormatOptions{SQLMode: mode}), "select a || b from t"; got != want {
+		t.Errorf("StringWithOptions(ANSI): %s, want %s", got, want)
+	}
 }
 
-func TestConvert(t *testing.T) {
-	validSQL := []struct {
+func TestUnionPositions(t *testing.T) {
+	// Every position that takes a select takes a union too. The
+	// positions are templates, where %s is the union; the output
+	// is the input, unless there's one.
+	union := "select a from x union all select b from y order by a asc limit 1"
+	testcases := []struct {
 		input  string
 		output string
 	}{{
-		input:  "select cast('abc' as date) from t",
-		output: "select convert('abc', date) from t",
-	}, {
-		input: "select convert('abc', binary(4)) from t",
-	}, {
-		input: "select convert('abc', binary) from t",
-	}, {
-		input: "select convert('abc', char character set binary) from t",
+		input: "%s",
 	}, {
-		input: "select convert('abc', char(4) ascii) from t",
-	}, {
-		input: "select convert('abc', char unicode) from t",
-	}, {
-		input: "select convert('abc', char(4)) from t",
-	}, {
-		input: "select convert('abc', char) from t",
-	}, {
-		input: "select convert('abc', nchar(4)) from t",
+		input: "(%s)",
 	}, {
-		input: "select convert('abc', nchar) from t",
+		input: "(%s) union select c from z",
 	}, {
-		input: "select convert('abc', signed) from t",
+		input: "insert into t(a) %s",
 	}, {
-		input:  "select convert('abc', signed integer) from t",
-		output: "select convert('abc', signed) from t",
+		input:  "insert into t(a) (%s)",
+		output: "insert into t(a) %s",
 	}, {
-		input: "select convert('abc', unsigned) from t",
+		input: "replace into t %s",
 	}, {
-		input:  "select convert('abc', unsigned integer) from t",
-		output: "select convert('abc', unsigned) from t",
+		input: "select * from t where id in (%s)",
 	}, {
-		input: "select convert('abc', decimal(3, 4)) from t",
+		input: "select * from t where exists (%s)",
 	}, {
-		input: "select convert('abc', decimal(4)) from t",
+		input: "select (%s) from t",
 	}, {
-		input: "select convert('abc', decimal) from t",
+		input: "select * from (%s) as d",
 	}, {
-		input: "select convert('abc', date) from t",
+		input: "select * from t join (%s) as d on t.a = d.a",
 	}, {
-		input: "select convert('abc', time(4)) from t",
+		input: "update t set a = 1 where id in (%s)",
 	}, {
-		input: "select convert('abc', time) from t",
+		input: "delete from t where id not in (%s)",
 	}, {
-		input: "select convert('abc', datetime(9)) from t",
+		input: "create table t as %s",
 	}, {
-		input: "select convert('abc', datetime) from t",
+		input: "create view v as %s",
 	}, {
-		input: "select convert('abc', json) from t",
+		input: "create or replace view v(a) as (%s)",
 	}, {
-		input: "select convert('abc' using ascii) from t",
+		input: "alter view v as %s",
 	}}
-
-	for _, tcase := range validSQL {
-		if tcase.output == "" {
-			tcase.output = tcase.input
+	for _, tcase := range testcases {
+		input := strings.Replace(tcase.input, "%s", union, 1)
+		output := input
+		if tcase.output != "" {
+			output = strings.Replace(tcase.output, "%s", union, 1)
 		}
-		tree, err := Parse(tcase.input)
+		tree, err := ParseStrictDDL(input)
 		if err != nil {
-			t.Errorf("input: %s, err: %v", tcase.input, err)
+			t.Errorf("Parse(%q): %v", input, err)
 			continue
 		}
-		out := String(tree)
-		if out != tcase.output {
-			t.Errorf("out: %s, want %s", out, tcase.output)
+		if got := String(tree); got != output {
+			t.Errorf("Parse(%q): %s, want %s", input, got, output)
 		}
-	}
-
-	invalidSQL := []struct {
-		input  string
-		output string
-	}{{
-		input:  "select convert('abc' as date) from t",
-		output: "syntax error at position 24 near 'as'",
-	}, {
-		input:  "select convert from t",
-		output: "syntax error at position 20 near 'from'",
-	}, {
-		input:  "select cast('foo', decimal) from t",
-		output: "syntax error at position 19",
-	}, {
-		input:  "select convert('abc', datetime(4+9)) from t",
-		output: "syntax error at position 34",
-	}, {
-		input:  "select convert('abc', decimal(4+9)) from t",
-		output: "syntax error at position 33",
-	}}
-
-	for _, tcase := range invalidSQL {
-		_, err := Parse(tcase.input)
-		if err == nil || err.Error() != tcase.output {
-			t.Errorf("%s: %v, want %s", tcase.input, err, tcase.output)
+		var unions int
+		_ = Walk(func(node SQLNode) (bool, error) {
+			if _, ok := node.(*Union); ok {
+				unions++
+			}
+			return true, nil
+		}, tree)
+		if unions == 0 {
+			t.Errorf("Walk(%q): the union is not walked", input)
+		}
+		tables := make(map[string]bool)
+		for _, table := range ExtractTables(tree) {
+			tables[String(table)] = true
+		}
+		if !tables["x"] || !tables["y"] {
+			t.Errorf("ExtractTables(%q): %v, want x and y", input, tables)
 		}
 	}
 }
@@ -1632,17 +1068,18 @@ func TestSubStr(t *testing.T) {
 	}, {
 		input: "select substr(a, 1, 6) from t",
 	}, {
-		input:  "select substring(a, 1) from t",
-		output: "select substr(a, 1) from t",
+		input: "select substring(a, 1) from t",
+	}, {
+		input: "select substring(a, 1, 6) from t",
 	}, {
-		input:  "select substring(a, 1, 6) from t",
-		output: "select substr(a, 1, 6) from t",
+		input: "select substr(a from 1 for 6) from t",
 	}, {
-		input:  "select substr(a from 1 for 6) from t",
-		output: "select substr(a, 1, 6) from t",
+		input: "select substring(a from 1 for 6) from t",
 	}, {
-		input:  "select substring(a from 1 for 6) from t",
-		output: "select substr(a, 1, 6) from t",
+		input: "select substring(a from 2) from t",
+	}, {
+		input:  "select SUBSTRING(a FROM :b FOR 3) from t",
+		output: "select substring(a from :b for 3) from t",
 	}}
 
 	for _, tcase := range validSQL {
@@ -1737,7 +1174,9 @@ func TestCreateTable(t *testing.T) {
 			"	col_multilinestring1 multilinestring,\n" +
 			"	col_multilinestring2 multilinestring not null,\n" +
 			"	col_multipolygon1 multipolygon,\n" +
-			"	col_multipolygon2 multipolygon not null\n" +
+			"	col_multipolygon2 multipolygon not null,\n" +
+			"	col_geometry3 geometry srid 4326,\n" +
+			"	col_point3 point srid 0 not null\n" +
 			")",
 
 		// test defaults
@@ -1944,6 +1383,45 @@ var (
 	}{{
 		input:  "select $ from t",
 		output: "syntax error at position 9 near '$'",
+	}, {
+		input:  "select 1.5e from t",
+		output: "syntax error at position 12 near '1.5e'",
+	}, {
+		input:  "select 1e+ from t",
+		output: "syntax error at position 11 near '1e+'",
+	}, {
+		input:  "repair foo",
+		output: "syntax error at position 11 near 'foo'",
+	}, {
+		input:  "check local table a",
+		output: "syntax error at position 12 near 'local'",
+	}, {
+		input:  "repair table a fast",
+		output: "unexpected option fast for repair at position 20",
+	}, {
+		input:  "check table a for upgrades",
+		output: "expecting upgrade after for at position 27 near 'upgrades'",
+	}, {
+		input:  "checksum table a fast",
+		output: "unexpected option fast for checksum at position 22",
+	}, {
+		input:  "cache index t1 ignore leaves in c",
+		output: "unexpected ignore leaves for cache index at position 34 near 'c'",
+	}, {
+		input:  "cache index t1",
+		output: "syntax error at position 15",
+	}, {
+		input:  "load index into cache t1 ignore all",
+		output: "syntax error at position 36 near 'all'",
+	}, {
+		input:  "load index into cache t1 ignore rows",
+		output: "syntax error at position 37 near 'rows'",
+	}, {
+		input:  "load index into cache t1 in c",
+		output: "syntax error at position 28 near 'in'",
+	}, {
+		input:  "analyze table a quick",
+		output: "syntax error at position 22 near 'quick'",
 	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
@@ -2006,7 +1484,7 @@ var (
 		output: "syntax error at position 37 near 'using'",
 	}, {
 		input:  "select next id from a",
-		output: "expecting value after next at position 15 near 'id'",
+		output: "expecting value after next at position 20 near 'from'",
 	}, {
 		input:  "select next 1+1 values from a",
 		output: "syntax error at position 15",
@@ -2028,6 +1506,18 @@ var (
 	}, {
 		input:  "select match(a1, a2) against ('foo' in boolean mode with query expansion) from t",
 		output: "syntax error at position 57 near 'with'",
+	}, {
+		input:  "with c as (select 1) insert into t select * from c",
+		output: "syntax error at position 28 near 'insert'",
+	}, {
+		input:  "select * from with",
+		output: "syntax error at position 19 near 'with'",
+	}, {
+		input:  "set a.b = 1",
+		output: "cannot set a qualified column outside of a trigger at position 12",
+	}, {
+		input:  "create trigger trg before insert on t for each row set t.a = 1",
+		output: "expecting new or old at position 63",
 	}, {
 		input:  "select /* reserved keyword as unqualified column */ * from t where key = 'test'",
 		output: "syntax error at position 71 near 'key'",
@@ -2035,25 +1525,125 @@ var (
 		input:  "select /* vitess-reserved keyword as unqualified column */ * from t where escape = 'test'",
 		output: "syntax error at position 81 near 'escape'",
 	}, {
-		input:  "(select /* parenthesized select */ * from t)",
-		output: "syntax error at position 45",
+		input:  "((select a from t)",
+		output: "syntax error at position 19",
+	}, {
+		input:  "(select a from t) order by a order by a",
+		output: "syntax error at position 35 near 'order'",
 	}, {
 		input:  "select * from t where id = ((select a from t1 union select b from t2) order by a limit 1)",
 		output: "syntax error at position 76 near 'order'",
 	}, {
 		input:  "select /* straight_join using */ 1 from t1 straight_join t2 using (a)",
 		output: "syntax error at position 66 near 'using'",
+	}, {
+		input:  "handler t foo",
+		output: "expecting open or close after handler table at position 14",
+	}, {
+		input:  "handler t read idx sideways",
+		output: "expecting first, next, prev or last at position 28 near 'sideways'",
+	}, {
+		input:  "kill process 42",
+		output: "expecting connection or query after kill at position 16",
+	}, {
+		input:  "xa forget 'xid'",
+		output: "expecting start, end, prepare, commit, rollback or recover after xa at position 16",
+	}, {
+		input:  "xa commit 'xid' two phase",
+		output: "expecting one phase at position 26 near 'phase'",
+	}, {
+		input:  "lock tables t",
+		output: "syntax error at position 14",
+	}, {
+		input:  "select {ts 1} from t",
+		output: "incorrect timestamp literal at position 14",
+	}, {
+		input:  "select date 'yesterday' from t",
+		output: "incorrect date literal at position 24 near 'yesterday'",
+	}, {
+		input:  "select timestamp '2024-01-01 garbage' from t",
+		output: "incorrect timestamp literal at position 38 near '2024-01-01 garbage'",
+	}, {
+		input:  "select 1 from {ts t1 left join t2 on t1.a = t2.a}",
+		output: "expecting oj at position 50",
+	}, {
+		input:  "execute stmt1 using @a, b",
+		output: "expecting a user variable at position 26 near 'b'",
+	}, {
+		input:  "select a from t where a = default",
+		output: "syntax error at position 34",
+	}, {
+		input:  "update t set a = default(1)",
+		output: "syntax error at position 27 near '1'",
+	}, {
+		input:  "select a from t into @x, b",
+		output: "expecting a user variable at position 27 near 'b'",
+	}, {
+		input:  "select a into @x from t into @y",
+		output: "cannot use into twice at position 32",
+	}, {
+		input:  "select distinct all a from t",
+		output: "incorrect usage of distinct and all at position 20 near 'all'",
+	}, {
+		input:  "select sql_cache distinctrow sql_no_cache a from t",
+		output: "incorrect usage of sql_cache and sql_no_cache at position 42 near 'sql_no_cache'",
+	}, {
+		input:  "select distinctrow distinct a from t",
+		output: "incorrect usage of distinctrow and distinct at position 28 near 'distinct'",
+	}, {
+		input:  "select a from t into dumpfiles '/tmp/x'",
+		output: "expecting dumpfile at position 40 near '/tmp/x'",
+	}, {
+		input:  "select a from t into outfile '/tmp/x' rows terminated by ','",
+		output: "syntax error at position 43 near 'rows'",
+	}, {
+		input:  "execute stmt1 using @@autocommit",
+		output: "expecting a user variable at position 33 near '@@autocommit'",
+	}, {
+		input:  "select {oj a} from t",
+		output: "expecting d, t, ts or fn in odbc escape at position 14",
+	}, {
+		input:  "create trigger trg during insert on t for each row set a = 1",
+		output: "expecting before or after at position 26 near 'during'",
+	}, {
+		input:  "create trigger trg before insert on t for each statement set a = 1",
+		output: "expecting for each row at position 57 near 'statement'",
+	}, {
+		input:        "create trigger trg before insert on t for each row begin set a = 1;",
+		output:       "unterminated begin block at position 68 near 'begin'",
+		excludeMulti: true,
+	}, {
+		input:  "create function f() returns int select 1",
+		output: "syntax error at position 39 near 'select'",
+	}, {
+		input:  "create procedure p() reads sql stuff select 1",
+		output: "expecting reads sql data at position 37 near 'stuff'",
+	}, {
+		input:  "create event ev on schedule every 1 day enabled do select 1",
+		output: "expecting enable or disable at position 51 near 'do'",
 	}, {
 		input:        "select 'aa",
-		output:       "syntax error at position 11 near 'aa'",
+		output:       "unterminated string starting at line 1 at position 8",
 		excludeMulti: true,
 	}, {
 		input:        "select 'aa\\",
-		output:       "syntax error at position 12 near 'aa'",
+		output:       "unterminated string starting at line 1 at position 8",
 		excludeMulti: true,
 	}, {
 		input:        "select /* aa",
-		output:       "syntax error at position 13 near '/* aa'",
+		output:       "unterminated comment starting at line 1 at position 8",
+		excludeMulti: true,
+	}, {
+		input:        "select a,\n  'it''s',\n  b\nfrom t where c = /*! 1 */ and d = 'x\ny' /* last\n",
+		output:       "unterminated comment starting at line 5 at position 66",
+		excludeMulti: true,
+	}, {
+		input:        "select a from t where b = 1 /* outer /* inner */ still comment */",
+		output:       "syntax error at position 55 near 'still'",
+		excludeMulti: true,
+	}, {
+		input:        "select `a\nfrom t",
+		output:       "unterminated quoted identifier starting at line 1 at position 8",
 		excludeMulti: true,
 	}}
 )
@@ -2124,3 +1714,46 @@ func BenchmarkParse3(b *testing.B) {
 		}
 	}
 }
+
+// Benchmark run on 10/16/26, before the Format methods of the common
+// nodes bypassed Myprintf:
+// BenchmarkStringInsert    3648    647144 ns/op    444728 B/op    14024 allocs/op
+// BenchmarkStringSelect  304374      6898 ns/op      2552 B/op       89 allocs/op
+// and after:
+// BenchmarkStringInsert   13076    161018 ns/op    123064 B/op        7 allocs/op
+// BenchmarkStringSelect  652688      3806 ns/op       816 B/op       15 allocs/op
+
+var benchInsert Statement
+
+func init() {
+	var buf bytes.Buffer
+	buf.WriteString("insert into t(id, name, price, created, note) values ")
+	for i := 0; i < 1000; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "(%d, 'name %d', %d.99, '2017-06-23 10:00:00', null)", i, i, i)
+	}
+	var err error
+	if benchInsert, err = Parse(buf.String()); err != nil {
+		panic(err)
+	}
+}
+
+func BenchmarkStringInsert(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = String(benchInsert)
+	}
+}
+
+func BenchmarkStringSelect(b *testing.B) {
+	ast, err := Parse("select aaaa, bbb, ccc, ddd, eeee, ffff, gggg, hhhh, iiii from tttt, ttt1, ttt3 where aaaa = bbbb and bbbb = cccc and dddd+1 = eeee group by fff, gggg having hhhh = iiii and iiii = jjjj order by kkkk, llll limit 3, 4")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = String(ast)
+	}
+}
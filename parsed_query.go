@@ -18,7 +18,9 @@ package sqlparser
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/xwb1989/sqlparser/dependency/querypb"
 	"github.com/xwb1989/sqlparser/dependency/sqltypes"
@@ -33,6 +35,9 @@ type ParsedQuery struct {
 
 type bindLocation struct {
 	offset, length int
+	// count is true for the bind variables in place of the
+	// row count or the offset of a LIMIT, see checkCount.
+	count bool
 }
 
 // NewParsedQuery returns a ParsedQuery of the ast.
@@ -57,10 +62,15 @@ func (pq *ParsedQuery) GenerateQuery(bindVariables map[string]*querypb.BindVaria
 		if encodable, ok := extras[name[1:]]; ok {
 			encodable.EncodeSQL(buf)
 		} else {
-			supplied, _, err := FetchBindVar(name, bindVariables)
+			supplied, isList, err := FetchBindVar(name, bindVariables)
 			if err != nil {
 				return nil, err
 			}
+			if loc.count {
+				if err := checkCount(supplied, isList); err != nil {
+					return nil, bindVarValueError(name, err)
+				}
+			}
 			EncodeValue(buf, supplied)
 		}
 		current = loc.offset + loc.length
@@ -69,6 +79,26 @@ func (pq *ParsedQuery) GenerateQuery(bindVariables map[string]*querypb.BindVaria
 	return buf.Bytes(), nil
 }
 
+// checkCount returns an error if the value of a bind variable in place
+// of the row count or the offset of a LIMIT is not a non-negative
+// integer, which MySQL would reject. A value of another type, like the
+// string '10', is rejected too: it would be encoded quoted.
+func checkCount(bv *querypb.BindVariable, isList bool) error {
+	if isList || bv.Type == querypb.Type_TUPLE {
+		return errors.New("limit expects a non-negative integer, got a list")
+	}
+	if bv.Type == querypb.Type_NULL_TYPE {
+		return errors.New("limit expects a non-negative integer, got NULL")
+	}
+	if !sqltypes.IsIntegral(bv.Type) {
+		return fmt.Errorf("limit expects a non-negative integer, got %s of type %v", bv.Value, bv.Type)
+	}
+	if _, err := strconv.ParseUint(string(bv.Value), 10, 64); err != nil {
+		return fmt.Errorf("limit expects a non-negative integer, got %s", bv.Value)
+	}
+	return nil
+}
+
 // EncodeValue encodes one bind variable value into the query.
 func EncodeValue(buf *bytes.Buffer, value *querypb.BindVariable) {
 	if value.Type != querypb.Type_TUPLE {
@@ -84,12 +114,32 @@ func EncodeValue(buf *bytes.Buffer, value *querypb.BindVariable) {
 		if i != 0 {
 			buf.WriteString(", ")
 		}
-		sqltypes.ProtoToValue(bv).EncodeSQL(buf)
+		encodeTupleValue(buf, bv)
+	}
+	buf.WriteByte(')')
+}
+
+// encodeTupleValue encodes one value of a TUPLE bind variable,
+// which is itself a tuple for row constructors like (a, b) in ::list.
+func encodeTupleValue(buf *bytes.Buffer, value *querypb.Value) {
+	if value.Type != querypb.Type_TUPLE {
+		sqltypes.ProtoToValue(value).EncodeSQL(buf)
+		return
+	}
+	// FetchBindVar has checked the encoding.
+	values, _ := sqltypes.ProtoToTuple(value)
+	buf.WriteByte('(')
+	for i, v := range values {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		sqltypes.ProtoToValue(v).EncodeSQL(buf)
 	}
 	buf.WriteByte(')')
 }
 
 // FetchBindVar resolves the bind variable by fetching it from bindVariables.
+// A nil bind variable is NULL. The error, if any, is a *BindVarError.
 func FetchBindVar(name string, bindVariables map[string]*querypb.BindVariable) (val *querypb.BindVariable, isList bool, err error) {
 	name = name[1:]
 	if name[0] == ':' {
@@ -98,21 +148,32 @@ func FetchBindVar(name string, bindVariables map[string]*querypb.BindVariable) (
 	}
 	supplied, ok := bindVariables[name]
 	if !ok {
-		return nil, false, fmt.Errorf("missing bind var %s", name)
+		return nil, false, &BindVarError{Name: name, msg: "missing bind var " + name, err: ErrMissingBindVar}
+	}
+	if supplied == nil {
+		supplied = sqltypes.NullBindVariable
 	}
 
 	if isList {
 		if supplied.Type != querypb.Type_TUPLE {
-			return nil, false, fmt.Errorf("unexpected list arg type (%v) for key %s", supplied.Type, name)
+			return nil, false, &BindVarError{Name: name, msg: fmt.Sprintf("unexpected list arg type (%v) for key %s", supplied.Type, name), err: ErrInvalidBindVar}
 		}
 		if len(supplied.Values) == 0 {
-			return nil, false, fmt.Errorf("empty list supplied for %s", name)
+			return nil, false, &BindVarError{Name: name, msg: "empty list supplied for " + name, err: ErrInvalidBindVar}
+		}
+		for _, v := range supplied.Values {
+			if v.Type != querypb.Type_TUPLE {
+				continue
+			}
+			if _, err := sqltypes.ProtoToTuple(v); err != nil {
+				return nil, false, &BindVarError{Name: name, msg: fmt.Sprintf("invalid tuple supplied for %s: %v", name, err), err: ErrInvalidBindVar}
+			}
 		}
 		return supplied, true, nil
 	}
 
 	if supplied.Type == querypb.Type_TUPLE {
-		return nil, false, fmt.Errorf("unexpected arg type (TUPLE) for non-list key %s", name)
+		return nil, false, &BindVarError{Name: name, msg: "unexpected arg type (TUPLE) for non-list key " + name, err: ErrInvalidBindVar}
 	}
 
 	return supplied, false, nil
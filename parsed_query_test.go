@@ -41,6 +41,31 @@ func TestNewParsedQuery(t *testing.T) {
 	}
 }
 
+func TestTrackedBufferReset(t *testing.T) {
+	buf := NewTrackedBuffer(nil)
+	buf.Myprintf("%v", NewValArg([]byte(":a")))
+	first := buf.ParsedQuery()
+
+	buf.Reset()
+	buf.Myprintf("%v = %v", NewIntVal([]byte("1")), NewValArg([]byte(":bc")))
+	second := buf.ParsedQuery()
+
+	want := &ParsedQuery{
+		Query:         ":a",
+		bindLocations: []bindLocation{{offset: 0, length: 2}},
+	}
+	if !reflect.DeepEqual(first, want) {
+		t.Errorf("first query: %+v, want %+v", first, want)
+	}
+	want = &ParsedQuery{
+		Query:         "1 = :bc",
+		bindLocations: []bindLocation{{offset: 4, length: 3}},
+	}
+	if !reflect.DeepEqual(second, want) {
+		t.Errorf("second query: %+v, want %+v", second, want)
+	}
+}
+
 func TestGenerateQuery(t *testing.T) {
 	tcases := []struct {
 		desc     string
@@ -71,6 +96,13 @@ func TestGenerateQuery(t *testing.T) {
 				"id2": sqltypes.NullBindVariable,
 			},
 			output: "select * from a where id1 = 1 and id2 = null",
+		}, {
+			desc:  "nil bindvar",
+			query: "select * from a where id1 = :id1",
+			bindVars: map[string]*querypb.BindVariable{
+				"id1": nil,
+			},
+			output: "select * from a where id1 = null",
 		}, {
 			desc:  "tuple *querypb.BindVariable",
 			query: "select * from a where id in ::vals",
@@ -78,6 +110,26 @@ func TestGenerateQuery(t *testing.T) {
 				"vals": sqltypes.TestBindVariable([]interface{}{1, "aa"}),
 			},
 			output: "select * from a where id in (1, 'aa')",
+		}, {
+			desc:  "tuple of tuples *querypb.BindVariable",
+			query: "select * from a where (id, name) in ::vals",
+			bindVars: map[string]*querypb.BindVariable{
+				"vals": sqltypes.TestBindVariable([]interface{}{
+					[]interface{}{1, "aa"},
+					[]interface{}{2, "bb"},
+				}),
+			},
+			output: "select * from a where (id, name) in ((1, 'aa'), (2, 'bb'))",
+		}, {
+			desc:  "invalid tuple of tuples",
+			query: "select * from a where (id, name) in ::vals",
+			bindVars: map[string]*querypb.BindVariable{
+				"vals": {
+					Type:   querypb.Type_TUPLE,
+					Values: []*querypb.Value{{Type: querypb.Type_TUPLE, Value: []byte{1, 5}}},
+				},
+			},
+			output: "invalid tuple supplied for vals: invalid tuple encoding",
 		}, {
 			desc:  "list bind vars 0 arguments",
 			query: "select * from a where id in ::vals",
@@ -131,6 +183,58 @@ func TestGenerateQuery(t *testing.T) {
 				},
 			},
 			output: "select * from a where b = (pk1 = 1 and pk2 = 'aa') or (pk1 = 2 and pk2 = 'bb')",
+		}, {
+			desc:  "limit bindvars",
+			query: "select * from a where id > :id limit :count offset :skip",
+			bindVars: map[string]*querypb.BindVariable{
+				"id":    sqltypes.Int64BindVariable(-1),
+				"count": sqltypes.Uint64BindVariable(10),
+				"skip":  sqltypes.Int64BindVariable(20),
+			},
+			output: "select * from a where id > -1 limit 20, 10",
+		}, {
+			desc:  "string limit bindvar",
+			query: "select * from a limit :count",
+			bindVars: map[string]*querypb.BindVariable{
+				"count": sqltypes.StringBindVariable("10"),
+			},
+			output: "invalid bind var count: limit expects a non-negative integer, got 10 of type VARCHAR",
+		}, {
+			desc:  "list limit bindvar",
+			query: "select * from a limit :count",
+			bindVars: map[string]*querypb.BindVariable{
+				"count": sqltypes.TestBindVariable([]interface{}{1, 2}),
+			},
+			output: "unexpected arg type (TUPLE) for non-list key count",
+		}, {
+			desc:  "negative limit bindvar",
+			query: "select * from a limit :skip, :count",
+			bindVars: map[string]*querypb.BindVariable{
+				"count": sqltypes.Int64BindVariable(-1),
+				"skip":  sqltypes.Int64BindVariable(0),
+			},
+			output: "invalid bind var count: limit expects a non-negative integer, got -1",
+		}, {
+			desc:  "null limit bindvar",
+			query: "select * from a limit :count",
+			bindVars: map[string]*querypb.BindVariable{
+				"count": nil,
+			},
+			output: "invalid bind var count: limit expects a non-negative integer, got NULL",
+		}, {
+			desc:  "fractional limit bindvar",
+			query: "select * from a limit :count",
+			bindVars: map[string]*querypb.BindVariable{
+				"count": sqltypes.Float64BindVariable(1.5),
+			},
+			output: "invalid bind var count: limit expects a non-negative integer, got 1.5 of type FLOAT64",
+		}, {
+			desc:  "bindvar in a limit expression",
+			query: "select * from a limit :count + 1",
+			bindVars: map[string]*querypb.BindVariable{
+				"count": sqltypes.Int64BindVariable(-1),
+			},
+			output: "select * from a limit (-1 + 1)",
 		},
 	}
 
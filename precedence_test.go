@@ -110,3 +110,57 @@ func TestIsPrecedence(t *testing.T) {
 		}
 	}
 }
+
+func TestPipesPrecedence(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		or     string
+		concat string
+	}{{
+		input:  "select * from a where a || b || c",
+		or:     "((a or b) or c)",
+		concat: "((a || b) || c)",
+	}, {
+		input:  "select * from a where a || b and c",
+		or:     "(a or (b and c))",
+		concat: "((a || b) and c)",
+	}, {
+		input:  "select * from a where a || b * c",
+		or:     "(a or (b * c))",
+		concat: "((a || b) * c)",
+	}, {
+		input:  "select * from a where a ^ b || c",
+		or:     "((a ^ b) or c)",
+		concat: "(a ^ (b || c))",
+	}, {
+		input:  "select * from a where a = b || c",
+		or:     "(a = b or c)",
+		concat: "a = concat(b, c)",
+	}}
+	for _, tcase := range validSQL {
+		for _, pipesAsConcat := range []bool{false, true} {
+			want := tcase.or
+			if pipesAsConcat {
+				want = tcase.concat
+			}
+			tree, err := ParseWithOptions(tcase.input, ParseOptions{PipesAsConcat: pipesAsConcat})
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			expr := readable(tree.(*Select).Where.Expr)
+			if expr != want {
+				t.Errorf("Parse(%s, PipesAsConcat: %v): \n%s, want: \n%s", tcase.input, pipesAsConcat, expr, want)
+			}
+		}
+		// || is always concatenation with Postgres.
+		tree, err := ParseWithDialect(tcase.input, PostgresDialect)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if expr := readable(tree.(*Select).Where.Expr); expr != tcase.concat {
+			t.Errorf("ParseWithDialect(%s, PostgresDialect): \n%s, want: \n%s", tcase.input, expr, tcase.concat)
+		}
+	}
+}
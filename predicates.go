@@ -0,0 +1,658 @@
+package sqlparser
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+// PredicateFindingKind is the kind of a PredicateFinding.
+type PredicateFindingKind int
+
+// These are the possible PredicateFindingKind values.
+const (
+	// PredicateContradiction is reported for two conditions that
+	// can't both be true: the AND that holds them is always false.
+	PredicateContradiction = PredicateFindingKind(iota + 1)
+	// PredicateRedundant is reported for a condition that is true
+	// whenever another one is, so that it can be removed.
+	PredicateRedundant
+	// PredicateEmptyRange is reported for a BETWEEN whose lower
+	// bound is greater than its upper bound.
+	PredicateEmptyRange
+)
+
+// PredicateFinding is a finding of AnalyzePredicates. Exprs are the
+// conditions involved, as they are in the AST: the redundant one first
+// for PredicateRedundant, followed by the one that implies it.
+type PredicateFinding struct {
+	Kind    PredicateFindingKind
+	Exprs   []Expr
+	Message string
+}
+
+// AnalyzePredicates looks for conditions of where that are dead code:
+// conditions that contradict each other, conditions that are implied
+// by others, and empty BETWEEN ranges.
+//
+// It compares the conditions of an AND that are on the same column
+// and have literal operands: comparisons, IN and NOT IN lists, BETWEEN
+// and IS [NOT] NULL. Columns are the same if they're written the same
+// way, up to the case of their names. The branches of an OR are
+// analyzed independently. Conditions with bind variables or with
+// other expressions are skipped.
+//
+// Numbers are compared by value, and strings byte by byte, as with
+// a binary collation. A number is not compared to a string. Since the
+// collations of the columns are not known, the conditions of =, !=,
+// IN and NOT IN on strings are not reported to contradict others:
+// 'a' and 'A' are different strings, but equal ones in a
+// case-insensitive collation. Use AnalyzePredicatesWithCollations to
+// compare strings with the collations of the columns.
+func AnalyzePredicates(where *Where) []PredicateFinding {
+	return AnalyzePredicatesWithCollations(where, Collations{})
+}
+
+// AnalyzePredicatesWithCollations is the same as AnalyzePredicates,
+// except that strings are compared with the collation of their column,
+// or with the one of a COLLATE clause of the condition, like in
+// a = 'x' collate utf8mb4_bin. Conditions on the same column with
+// different collations are not compared. The strings whose collation
+// is not known, when collations has no Default, are compared like with
+// AnalyzePredicates.
+func AnalyzePredicatesWithCollations(where *Where, collations Collations) []PredicateFinding {
+	if where == nil || where.Expr == nil {
+		return nil
+	}
+	var findings []PredicateFinding
+	analyzeConjuncts(where.Expr, &collations, &findings)
+	return findings
+}
+
+// Collation compares strings like a collation of the database does.
+// Callers can supply their own, with any Name.
+type Collation struct {
+	Name string
+	// Compare returns an integer comparing a and b, like bytes.Compare.
+	Compare func(a, b []byte) int
+}
+
+// The built-in collations.
+var (
+	// BinaryCollation compares strings byte by byte, like the
+	// binary collation.
+	BinaryCollation = &Collation{Name: "binary", Compare: bytes.Compare}
+	// CaseInsensitiveCollation compares strings like the
+	// utf8mb4_general_ci collation: letters are equal regardless of
+	// their case, and trailing spaces are ignored. Unlike MySQL,
+	// accented letters are not equal to the unaccented ones.
+	CaseInsensitiveCollation = &Collation{Name: "utf8mb4_general_ci", Compare: compareCaseInsensitive}
+)
+
+// LookupCollation returns the built-in collation that matches name:
+// BinaryCollation for binary and the names ending with _bin, and
+// CaseInsensitiveCollation for the names ending with _ci, like
+// latin1_swedish_ci. It returns nil for the other names, and for the
+// case-insensitive collations that CaseInsensitiveCollation doesn't
+// emulate: the accent-insensitive ones ending with _ai_ci, and the
+// NO PAD ones, like utf8mb4_0900_as_ci, which don't ignore trailing
+// spaces.
+func LookupCollation(name string) *Collation {
+	name = strings.ToLower(name)
+	switch {
+	case name == "binary" || strings.HasSuffix(name, "_bin"):
+		return BinaryCollation
+	case strings.HasSuffix(name, "_ai_ci") || strings.Contains(name, "_0900_") || strings.Contains(name, "nopad"):
+		return nil
+	case strings.HasSuffix(name, "_ci"):
+		return CaseInsensitiveCollation
+	}
+	return nil
+}
+
+// Collations tells the analysis functions how to compare strings.
+// The zero value compares all of them with BinaryCollation.
+type Collations struct {
+	// Default is the collation of the columns that Column doesn't
+	// know. It's BinaryCollation if nil.
+	Default *Collation
+	// Column returns the collation of col from the schema, or nil if
+	// it doesn't know it. It can be nil.
+	Column func(col *ColName) *Collation
+	// Lookup returns the collation named by a COLLATE clause, or nil
+	// if it's unknown, in which case the condition is skipped. It's
+	// LookupCollation if nil.
+	Lookup func(name string) *Collation
+}
+
+// collation returns the collation of the strings compared to col,
+// given the name of the COLLATE clause of the condition, if any.
+// known is false if it's BinaryCollation for lack of another.
+func (c *Collations) collation(col *ColName, explicit string) (coll *Collation, known bool) {
+	if explicit != "" {
+		if c.Lookup != nil {
+			return c.Lookup(explicit), true
+		}
+		return LookupCollation(explicit), true
+	}
+	if c.Column != nil {
+		if coll := c.Column(col); coll != nil {
+			return coll, true
+		}
+	}
+	if c.Default != nil {
+		return c.Default, true
+	}
+	return BinaryCollation, false
+}
+
+func compareCaseInsensitive(a, b []byte) int {
+	a, b = bytes.TrimRight(a, " "), bytes.TrimRight(b, " ")
+	for len(a) > 0 && len(b) > 0 {
+		ra, na := utf8.DecodeRune(a)
+		rb, nb := utf8.DecodeRune(b)
+		ra, rb = unicode.ToUpper(unicode.ToLower(ra)), unicode.ToUpper(unicode.ToLower(rb))
+		if ra != rb {
+			if ra < rb {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[na:], b[nb:]
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// analyzeConjuncts compares the conditions of the AND expr,
+// and analyzes the branches of the ORs it holds.
+func analyzeConjuncts(expr Expr, collations *Collations, findings *[]PredicateFinding) {
+	var preds []*columnPredicate
+	for _, conjunct := range splitConjuncts(expr) {
+		if or, ok := unparen(conjunct).(*OrExpr); ok {
+			analyzeConjuncts(or.Left, collations, findings)
+			analyzeConjuncts(or.Right, collations, findings)
+			continue
+		}
+		pred := newColumnPredicate(conjunct, collations)
+		if pred == nil {
+			continue
+		}
+		if pred.kind == predRange && pred.emptyRange() {
+			*findings = append(*findings, PredicateFinding{
+				Kind:    PredicateEmptyRange,
+				Exprs:   []Expr{conjunct},
+				Message: fmt.Sprintf("%s is always false", String(conjunct)),
+			})
+			continue
+		}
+		preds = append(preds, pred)
+	}
+
+	redundant := make(map[*columnPredicate]bool)
+	for i, a := range preds {
+		for _, b := range preds[i+1:] {
+			if a.key != b.key || a.coll != b.coll || redundant[a] || redundant[b] {
+				continue
+			}
+			if empty, ok := a.disjoint(b); ok && empty {
+				if a.equalsUncollated() || b.equalsUncollated() {
+					// The strings may be equal in the
+					// collation of the column.
+					continue
+				}
+				*findings = append(*findings, PredicateFinding{
+					Kind:    PredicateContradiction,
+					Exprs:   []Expr{a.expr, b.expr},
+					Message: fmt.Sprintf("%s and %s can't both be true", String(a.expr), String(b.expr)),
+				})
+				continue
+			}
+			// If both imply each other, the second one is redundant.
+			if implied, ok := a.implies(b); ok && implied {
+				redundant[b] = true
+				*findings = append(*findings, redundantFinding(b, a))
+			} else if implied, ok := b.implies(a); ok && implied {
+				redundant[a] = true
+				*findings = append(*findings, redundantFinding(a, b))
+			}
+		}
+	}
+}
+
+func redundantFinding(pred, by *columnPredicate) PredicateFinding {
+	return PredicateFinding{
+		Kind:    PredicateRedundant,
+		Exprs:   []Expr{pred.expr, by.expr},
+		Message: fmt.Sprintf("%s is implied by %s", String(pred.expr), String(by.expr)),
+	}
+}
+
+func unparen(expr Expr) Expr {
+	for {
+		paren, ok := expr.(*ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.Expr
+	}
+}
+
+// columnPredicate.kind
+const (
+	// predIn is the condition of = and IN: the column is one
+	// of the values.
+	predIn = iota
+	// predNotIn is the condition of != and NOT IN.
+	predNotIn
+	// predRange is the condition of <, <=, >, >= and BETWEEN.
+	predRange
+	predIsNull
+	predIsNotNull
+)
+
+// columnPredicate is a condition on a column with literal operands.
+type columnPredicate struct {
+	expr Expr
+	// key identifies the column.
+	key string
+	// coll compares the strings of the condition, and uncollated
+	// is set if it's not the known collation of the column.
+	coll       *Collation
+	uncollated bool
+	kind       int
+	values     []sqltypes.Value
+	// lower and upper are the bounds of a range,
+	// or nil if it's unbounded.
+	lower, upper *predicateBound
+}
+
+type predicateBound struct {
+	val       sqltypes.Value
+	inclusive bool
+}
+
+// newColumnPredicate returns nil if expr is not a condition on
+// a column with literal operands, or if its collation is unknown.
+func newColumnPredicate(expr Expr, collations *Collations) *columnPredicate {
+	pred := newUncollatedPredicate(expr)
+	if pred == nil || pred.mixed {
+		return nil
+	}
+	var known bool
+	pred.coll, known = collations.collation(pred.col, pred.explicit)
+	if pred.coll == nil {
+		return nil
+	}
+	pred.uncollated = !known
+	return pred.columnPredicate
+}
+
+// equalsUncollated returns true if p is a condition of =, !=, IN or
+// NOT IN on strings whose collation is not known.
+func (p *columnPredicate) equalsUncollated() bool {
+	if !p.uncollated || p.kind != predIn && p.kind != predNotIn {
+		return false
+	}
+	for _, val := range p.values {
+		if val.IsText() {
+			return true
+		}
+	}
+	return false
+}
+
+// uncollatedPredicate is a columnPredicate before its collation
+// is known. explicit is the collation of the COLLATE clauses of the
+// condition, and mixed is set if they name several collations.
+type uncollatedPredicate struct {
+	*columnPredicate
+	col      *ColName
+	explicit string
+	mixed    bool
+}
+
+// stripCollate returns expr without its COLLATE clauses,
+// whose collation it records in explicit.
+func (p *uncollatedPredicate) stripCollate(expr Expr) Expr {
+	for {
+		collate, ok := expr.(*CollateExpr)
+		if !ok {
+			return expr
+		}
+		name := strings.ToLower(collate.Charset)
+		if p.explicit != "" && p.explicit != name {
+			p.mixed = true
+		}
+		p.explicit = name
+		expr = collate.Expr
+	}
+}
+
+func newUncollatedPredicate(expr Expr) *uncollatedPredicate {
+	p := &uncollatedPredicate{}
+	switch cond := unparen(expr).(type) {
+	case *ComparisonExpr:
+		if cond.Escape != nil {
+			return nil
+		}
+		col, operator, operand := comparedColumn(&ComparisonExpr{
+			Operator: cond.Operator,
+			Left:     p.stripCollate(cond.Left),
+			Right:    p.stripCollate(cond.Right),
+		})
+		if col == nil {
+			return nil
+		}
+		p.col = col
+		pred := &columnPredicate{expr: expr, key: columnKey(col)}
+		p.columnPredicate = pred
+		switch operator {
+		case EqualStr, NullSafeEqualStr, NotEqualStr, InStr, NotInStr:
+			pred.kind = predIn
+			if operator == NotEqualStr || operator == NotInStr {
+				pred.kind = predNotIn
+			}
+			operands := []Expr{operand}
+			if tuple, ok := operand.(ValTuple); ok && (operator == InStr || operator == NotInStr) {
+				operands = tuple
+			}
+			for _, operand := range operands {
+				val, ok := predicateLiteral(p.stripCollate(operand))
+				if !ok {
+					return nil
+				}
+				pred.values = append(pred.values, val)
+			}
+		case LessThanStr, LessEqualStr, GreaterThanStr, GreaterEqualStr:
+			val, ok := predicateLiteral(operand)
+			if !ok {
+				return nil
+			}
+			pred.kind = predRange
+			bound := &predicateBound{val: val, inclusive: operator == LessEqualStr || operator == GreaterEqualStr}
+			if operator == LessThanStr || operator == LessEqualStr {
+				pred.upper = bound
+			} else {
+				pred.lower = bound
+			}
+		default:
+			return nil
+		}
+		return p
+	case *RangeCond:
+		col, ok := p.stripCollate(cond.Left).(*ColName)
+		if !ok || cond.Operator != BetweenStr {
+			return nil
+		}
+		from, ok := predicateLiteral(p.stripCollate(cond.From))
+		if !ok {
+			return nil
+		}
+		to, ok := predicateLiteral(p.stripCollate(cond.To))
+		if !ok {
+			return nil
+		}
+		p.col = col
+		p.columnPredicate = &columnPredicate{
+			expr:  expr,
+			key:   columnKey(col),
+			kind:  predRange,
+			lower: &predicateBound{val: from, inclusive: true},
+			upper: &predicateBound{val: to, inclusive: true},
+		}
+		return p
+	case *IsExpr:
+		col, ok := cond.Expr.(*ColName)
+		if !ok {
+			return nil
+		}
+		p.col = col
+		pred := &columnPredicate{expr: expr, key: columnKey(col)}
+		switch cond.Operator {
+		case IsNullStr:
+			pred.kind = predIsNull
+		case IsNotNullStr:
+			pred.kind = predIsNotNull
+		default:
+			return nil
+		}
+		p.columnPredicate = pred
+		return p
+	}
+	return nil
+}
+
+// comparedColumn returns the column of a comparison and its operand,
+// with the operator reversed if the column is on the right.
+func comparedColumn(cmp *ComparisonExpr) (*ColName, string, Expr) {
+	if col, ok := cmp.Left.(*ColName); ok {
+		return col, cmp.Operator, cmp.Right
+	}
+	col, ok := cmp.Right.(*ColName)
+	if !ok {
+		return nil, "", nil
+	}
+	switch cmp.Operator {
+	case LessThanStr:
+		return col, GreaterThanStr, cmp.Left
+	case LessEqualStr:
+		return col, GreaterEqualStr, cmp.Left
+	case GreaterThanStr:
+		return col, LessThanStr, cmp.Left
+	case GreaterEqualStr:
+		return col, LessEqualStr, cmp.Left
+	case EqualStr, NullSafeEqualStr, NotEqualStr:
+		return col, cmp.Operator, cmp.Left
+	}
+	return nil, "", nil
+}
+
+func columnKey(col *ColName) string {
+	return canonicalString(col.Qualifier) + "." + col.Name.Lowered()
+}
+
+// predicateLiteral returns the value of a number or string literal.
+func predicateLiteral(expr Expr) (sqltypes.Value, bool) {
+	val, ok := expr.(*SQLVal)
+	if !ok {
+		return sqltypes.Value{}, false
+	}
+	switch val.Type {
+	case IntVal:
+		n, err := sqltypes.NewIntegral(string(val.Val))
+		return n, err == nil
+	case FloatVal, DecimalVal:
+		return sqltypes.MakeTrusted(sqltypes.Float64, val.Val), true
+	case StrVal:
+		return sqltypes.MakeTrusted(sqltypes.VarChar, val.Val), true
+	}
+	return sqltypes.Value{}, false
+}
+
+// compareLiterals compares two numbers, or two strings with coll.
+// ok is false if the values can't be compared.
+func compareLiterals(a, b sqltypes.Value, coll *Collation) (cmp int, ok bool) {
+	if a.IsText() && b.IsText() {
+		return coll.Compare(a.Raw(), b.Raw()), true
+	}
+	if a.IsText() || b.IsText() {
+		return 0, false
+	}
+	x, ok := new(big.Rat).SetString(a.ToString())
+	if !ok {
+		return 0, false
+	}
+	y, ok := new(big.Rat).SetString(b.ToString())
+	if !ok {
+		return 0, false
+	}
+	return x.Cmp(y), true
+}
+
+// emptyRange returns true if the lower bound of a range
+// is greater than its upper bound.
+func (p *columnPredicate) emptyRange() bool {
+	if p.lower == nil || p.upper == nil {
+		return false
+	}
+	cmp, ok := compareLiterals(p.lower.val, p.upper.val, p.coll)
+	return ok && (cmp > 0 || cmp == 0 && !(p.lower.inclusive && p.upper.inclusive))
+}
+
+// matches returns whether val satisfies p.
+func (p *columnPredicate) matches(val sqltypes.Value) (matched, ok bool) {
+	switch p.kind {
+	case predIn, predNotIn:
+		found := false
+		for _, v := range p.values {
+			cmp, ok := compareLiterals(val, v, p.coll)
+			if !ok {
+				return false, false
+			}
+			found = found || cmp == 0
+		}
+		return found == (p.kind == predIn), true
+	case predRange:
+		if p.lower != nil {
+			cmp, ok := compareLiterals(val, p.lower.val, p.coll)
+			if !ok {
+				return false, false
+			}
+			if cmp < 0 || cmp == 0 && !p.lower.inclusive {
+				return false, true
+			}
+		}
+		if p.upper != nil {
+			cmp, ok := compareLiterals(val, p.upper.val, p.coll)
+			if !ok {
+				return false, false
+			}
+			if cmp > 0 || cmp == 0 && !p.upper.inclusive {
+				return false, true
+			}
+		}
+		return true, true
+	case predIsNotNull:
+		return true, true
+	}
+	return false, true
+}
+
+// matchesAll returns whether all the values satisfy p,
+// or if any does if any is set.
+func (p *columnPredicate) matchesAll(values []sqltypes.Value, any bool) (matched, ok bool) {
+	for _, val := range values {
+		matched, ok := p.matches(val)
+		if !ok {
+			return false, false
+		}
+		if matched == any {
+			return any, true
+		}
+	}
+	return !any, true
+}
+
+// disjoint returns whether p and other can't both be true.
+func (p *columnPredicate) disjoint(other *columnPredicate) (empty, ok bool) {
+	if p.kind > other.kind {
+		return other.disjoint(p)
+	}
+	switch {
+	case p.kind == predIsNull:
+		return other.kind == predIsNotNull, true
+	case other.kind == predIsNull:
+		// Comparisons with NULL are never true.
+		return true, true
+	case p.kind == predIn:
+		matched, ok := other.matchesAll(p.values, true)
+		return !matched, ok
+	case p.kind == predRange && other.kind == predRange:
+		lower, upper := p.lower, p.upper
+		if tighter(other.lower, lower, 1, p.coll) {
+			lower = other.lower
+		}
+		if tighter(other.upper, upper, -1, p.coll) {
+			upper = other.upper
+		}
+		return (&columnPredicate{kind: predRange, coll: p.coll, lower: lower, upper: upper}).emptyRange(), true
+	}
+	return false, true
+}
+
+// tighter returns true if bound a restricts more than bound b. The
+// sign is 1 for lower bounds and -1 for upper bounds. A bound that
+// can't be compared is never tighter.
+func tighter(a, b *predicateBound, sign int, coll *Collation) bool {
+	switch {
+	case a == nil:
+		return false
+	case b == nil:
+		return true
+	}
+	cmp, ok := compareLiterals(a.val, b.val, coll)
+	if !ok {
+		return false
+	}
+	return cmp*sign > 0 || cmp == 0 && b.inclusive && !a.inclusive
+}
+
+// implies returns whether other is true whenever p is.
+func (p *columnPredicate) implies(other *columnPredicate) (implied, ok bool) {
+	switch p.kind {
+	case predIn:
+		return other.matchesAll(p.values, false)
+	case predNotIn:
+		switch other.kind {
+		case predNotIn:
+			// The values other excludes are excluded by p.
+			for _, val := range other.values {
+				matched, ok := p.matches(val)
+				if !ok || matched {
+					return false, ok
+				}
+			}
+			return true, true
+		case predIsNotNull:
+			return true, true
+		}
+	case predRange:
+		switch other.kind {
+		case predRange:
+			for _, bound := range []struct {
+				a, b *predicateBound
+				sign int
+			}{{p.lower, other.lower, 1}, {p.upper, other.upper, -1}} {
+				if bound.b != nil && (bound.a == nil || tighter(bound.b, bound.a, bound.sign, p.coll)) {
+					return false, true
+				}
+				if bound.b != nil {
+					if _, ok := compareLiterals(bound.a.val, bound.b.val, p.coll); !ok {
+						return false, false
+					}
+				}
+			}
+			return true, true
+		case predNotIn:
+			// The values other excludes are out of the range.
+			matched, ok := p.matchesAll(other.values, true)
+			return !matched, ok
+		case predIsNotNull:
+			return true, true
+		}
+	case predIsNull, predIsNotNull:
+		return p.kind == other.kind, true
+	}
+	return false, true
+}
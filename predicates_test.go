@@ -0,0 +1,250 @@
+package sqlparser
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzePredicates(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in: "a = 1 and b = 2 and c > 3",
+	}, {
+		// the collation of s is not known
+		in: "s = 'a' and s = 'b' and s in ('A', 'c') and s > 'x'",
+	}, {
+		in:  "s = 'a' collate utf8mb4_bin and s = 'b' collate utf8mb4_bin",
+		out: []string{"contradiction: s = 'a' collate utf8mb4_bin and s = 'b' collate utf8mb4_bin can't both be true"},
+	}, {
+		in:  "x > 5 and x > 3",
+		out: []string{"redundant: x > 3 is implied by x > 5"},
+	}, {
+		in:  "x >= 3 and 5 < x",
+		out: []string{"redundant: x >= 3 is implied by 5 < x"},
+	}, {
+		in:  "x > 5 and x < 3",
+		out: []string{"contradiction: x > 5 and x < 3 can't both be true"},
+	}, {
+		in: "x >= 5 and x <= 5",
+	}, {
+		in:  "x > 5 and x <= 5",
+		out: []string{"contradiction: x > 5 and x <= 5 can't both be true"},
+	}, {
+		in:  "x between 10 and 1",
+		out: []string{"empty range: x between 10 and 1 is always false"},
+	}, {
+		in:  "x between 1.5 and 2e1 and x > 1",
+		out: []string{"redundant: x > 1 is implied by x between 1.5 and 2e1"},
+	}, {
+		in:  "x in (1, 2) and x < 10",
+		out: []string{"redundant: x < 10 is implied by x in (1, 2)"},
+	}, {
+		in:  "x in (1, 2) and x in (3, 4)",
+		out: []string{"contradiction: x in (1, 2) and x in (3, 4) can't both be true"},
+	}, {
+		in:  "x = 1 and x not in (1, 2)",
+		out: []string{"contradiction: x = 1 and x not in (1, 2) can't both be true"},
+	}, {
+		in:  "x != 1 and x not in (1, 2)",
+		out: []string{"redundant: x != 1 is implied by x not in (1, 2)"},
+	}, {
+		in:  "x > 5 and x != 3",
+		out: []string{"redundant: x != 3 is implied by x > 5"},
+	}, {
+		in:  "x is null and x = 1",
+		out: []string{"contradiction: x is null and x = 1 can't both be true"},
+	}, {
+		in:  "x is not null and x = 1",
+		out: []string{"redundant: x is not null is implied by x = 1"},
+	}, {
+		in:  "x = 1 and x = 1",
+		out: []string{"redundant: x = 1 is implied by x = 1"},
+	}, {
+		in:  "name > 'b' and name > 'abc'",
+		out: []string{"redundant: name > 'abc' is implied by name > 'b'"},
+	}, {
+		// numbers and strings are not compared
+		in: "x = 1 and x = '2'",
+	}, {
+		// bind variables and expressions are skipped
+		in: "x = :a and x = 2 and x + 1 = 5 and x = y",
+	}, {
+		// different columns
+		in: "t.x = 1 and u.x = 2 and x = 3",
+	}, {
+		in:  "T.X = 1 and T.x = 2",
+		out: []string{"contradiction: T.X = 1 and T.x = 2 can't both be true"},
+	}, {
+		// or branches are analyzed independently
+		in: "(x = 1 and x = 2 or x > 1 and x > 0) and x = 3",
+		out: []string{
+			"contradiction: x = 1 and x = 2 can't both be true",
+			"redundant: x > 0 is implied by x > 1",
+		},
+	}}
+	kinds := map[PredicateFindingKind]string{
+		PredicateContradiction: "contradiction",
+		PredicateRedundant:     "redundant",
+		PredicateEmptyRange:    "empty range",
+	}
+	for _, tcase := range testcases {
+		tree, err := Parse("select * from t where " + tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		where := tree.(*Select).Where
+		var out []string
+		for _, finding := range AnalyzePredicates(where) {
+			out = append(out, kinds[finding.Kind]+": "+finding.Message)
+			// The findings reference the nodes of the AST.
+			for _, expr := range finding.Exprs {
+				found := false
+				_ = Walk(func(node SQLNode) (bool, error) {
+					found = found || node == SQLNode(expr)
+					return !found, nil
+				}, where)
+				if !found {
+					t.Errorf("AnalyzePredicates(%s): %s is not in the where clause", tcase.in, String(expr))
+				}
+			}
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("AnalyzePredicates(%s):\n%q, want\n%q", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestAnalyzePredicatesWithCollations(t *testing.T) {
+	reverse := &Collation{Name: "reverse", Compare: func(a, b []byte) int {
+		return -bytes.Compare(a, b)
+	}}
+	collations := Collations{
+		Column: func(col *ColName) *Collation {
+			switch col.Name.Lowered() {
+			case "ci":
+				return CaseInsensitiveCollation
+			case "rev":
+				return reverse
+			}
+			return nil
+		},
+		Lookup: func(name string) *Collation {
+			if name == "reverse" {
+				return reverse
+			}
+			return LookupCollation(name)
+		},
+	}
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in:  "ci = 'A' and ci = 'a'",
+		out: []string{"redundant: ci = 'a' is implied by ci = 'A'"},
+	}, {
+		in:  "ci = 'A' and ci in ('b', 'c ')",
+		out: []string{"contradiction: ci = 'A' and ci in ('b', 'c ') can't both be true"},
+	}, {
+		in:  "ci = 'a' and ci in ('B', 'A ')",
+		out: []string{"redundant: ci in ('B', 'A ') is implied by ci = 'a'"},
+	}, {
+		in:  "ci = 'Straße' and ci > 'STRASSE'",
+		out: []string{"redundant: ci > 'STRASSE' is implied by ci = 'Straße'"},
+	}, {
+		// the collation of s is not known
+		in: "s = 'A' and s = 'a'",
+	}, {
+		in:  "s = 'A' collate utf8mb4_bin and s = 'a' collate utf8mb4_bin",
+		out: []string{"contradiction: s = 'A' collate utf8mb4_bin and s = 'a' collate utf8mb4_bin can't both be true"},
+	}, {
+		in:  "s collate utf8mb4_general_ci = 'A' and s = 'a' collate latin1_swedish_ci",
+		out: []string{"redundant: s = 'a' collate latin1_swedish_ci is implied by s collate utf8mb4_general_ci = 'A'"},
+	}, {
+		in: "s collate utf8mb4_general_ci = 'A' and s collate utf8mb4_general_ci = 'a'",
+		out: []string{
+			"redundant: s collate utf8mb4_general_ci = 'a' is implied by s collate utf8mb4_general_ci = 'A'",
+		},
+	}, {
+		// different collations are not compared
+		in: "ci = 'A' and ci collate utf8mb4_bin = 'a' and s = 'A' and s collate utf8mb4_general_ci = 'a'",
+	}, {
+		// unknown and mixed collations are skipped
+		in: "s collate utf8mb4_0900_as_cs = 'a' and s collate utf8mb4_0900_as_cs = 'b' and s collate utf8mb4_bin = 'x' collate utf8mb4_general_ci",
+	}, {
+		in:  "rev > 'b' and rev > 'a'",
+		out: []string{"redundant: rev > 'b' is implied by rev > 'a'"},
+	}, {
+		in: "s between 'b' and 'a' collate reverse",
+	}, {
+		in:  "s between 'a' and 'b' collate reverse",
+		out: []string{"empty range: s between 'a' and 'b' collate reverse is always false"},
+	}, {
+		in:  "ci = 1 and ci = 2",
+		out: []string{"contradiction: ci = 1 and ci = 2 can't both be true"},
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse("select * from t where " + tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		var out []string
+		for _, finding := range AnalyzePredicatesWithCollations(tree.(*Select).Where, collations) {
+			out = append(out, map[PredicateFindingKind]string{
+				PredicateContradiction: "contradiction",
+				PredicateRedundant:     "redundant",
+				PredicateEmptyRange:    "empty range",
+			}[finding.Kind]+": "+finding.Message)
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("AnalyzePredicatesWithCollations(%s):\n%q, want\n%q", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestCompareCaseInsensitive(t *testing.T) {
+	testcases := []struct {
+		a, b string
+		cmp  int
+	}{
+		{"abc", "ABC", 0},
+		{"abc ", "ABC", 0},
+		{"ÉTÉ", "été", 0},
+		{"abc", "abd", -1},
+		{"b", "A", 1},
+		{"ab", "abc", -1},
+		{" a", "a", -1},
+	}
+	for _, tc := range testcases {
+		if got := CaseInsensitiveCollation.Compare([]byte(tc.a), []byte(tc.b)); got != tc.cmp {
+			t.Errorf("Compare(%q, %q): %d, want %d", tc.a, tc.b, got, tc.cmp)
+		}
+	}
+}
+
+func TestLookupCollation(t *testing.T) {
+	testcases := []struct {
+		name string
+		want *Collation
+	}{
+		{"binary", BinaryCollation},
+		{"utf8mb4_bin", BinaryCollation},
+		{"utf8mb4_0900_bin", BinaryCollation},
+		{"utf8mb4_general_ci", CaseInsensitiveCollation},
+		{"LATIN1_SWEDISH_CI", CaseInsensitiveCollation},
+		{"utf8mb4_0900_ai_ci", nil},
+		{"utf8mb4_0900_as_ci", nil},
+		{"utf8mb4_unicode_520_ai_ci", nil},
+		{"utf8mb4_general_nopad_ci", nil},
+		{"utf8mb4_0900_as_cs", nil},
+	}
+	for _, tc := range testcases {
+		if got := LookupCollation(tc.name); got != tc.want {
+			t.Errorf("LookupCollation(%s): %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package sqlparser
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+// PlaceholderStyle is the placeholder syntax of a database/sql
+// driver, see PrepareAST.
+type PlaceholderStyle int
+
+// PlaceholderStyle values
+const (
+	// QuestionPlaceholders are the ? of MySQL drivers.
+	QuestionPlaceholders PlaceholderStyle = iota
+	// DollarPlaceholders are the numbered $1, $2... of Postgres
+	// drivers, like pgx.
+	DollarPlaceholders
+)
+
+// PrepareAST returns the text of stmt and its arguments, in the form
+// database/sql expects them: the bind variables, like the ones of
+// Normalize, are replaced by placeholders, and args holds their values
+// in the order of the placeholders. A list bind variable is expanded
+// into a placeholder for each of its values, (?, ?, ?), and so are the
+// tuples of a row constructor list, ((?, ?), (?, ?)).
+//
+// The statement is formatted in the dialect of the driver: MySQL for
+// QuestionPlaceholders and Postgres for DollarPlaceholders.
+//
+// The values are int64, uint64, float64 or string, and nil for NULL
+// or for a nil bind variable. Binary values are strings too, unless
+// they're not valid UTF-8, in which case they're []byte.
+//
+// The error, if any, is a *BindVarError.
+func PrepareAST(stmt Statement, bindVars map[string]*querypb.BindVariable, placeholder PlaceholderStyle) (query string, args []interface{}, err error) {
+	buf := NewTrackedBuffer(nil)
+	if placeholder == DollarPlaceholders {
+		buf.Dialect = PostgresDialect
+	}
+	buf.Myprintf("%v", stmt)
+	pq := buf.ParsedQuery()
+	if len(pq.bindLocations) == 0 {
+		return pq.Query, nil, nil
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(pq.Query)))
+	p := &preparer{buf: out, style: placeholder}
+	current := 0
+	for _, loc := range pq.bindLocations {
+		out.WriteString(pq.Query[current:loc.offset])
+		name := pq.Query[loc.offset : loc.offset+loc.length]
+		supplied, isList, err := FetchBindVar(name, bindVars)
+		if err != nil {
+			return "", nil, err
+		}
+		if loc.count {
+			if err := checkCount(supplied, isList); err != nil {
+				return "", nil, bindVarValueError(name, err)
+			}
+		}
+		if !isList {
+			if err := p.addValue(supplied.Type, supplied.Value); err != nil {
+				return "", nil, bindVarValueError(name, err)
+			}
+		} else if err := p.addList(supplied); err != nil {
+			return "", nil, bindVarValueError(name, err)
+		}
+		current = loc.offset + loc.length
+	}
+	out.WriteString(pq.Query[current:])
+	return out.String(), p.args, nil
+}
+
+// preparer writes the placeholders of PrepareAST
+// and collects their arguments.
+type preparer struct {
+	buf   *bytes.Buffer
+	style PlaceholderStyle
+	args  []interface{}
+}
+
+func (p *preparer) addList(bv *querypb.BindVariable) error {
+	p.buf.WriteByte('(')
+	for i, v := range bv.Values {
+		if i != 0 {
+			p.buf.WriteString(", ")
+		}
+		if v.Type != querypb.Type_TUPLE {
+			if err := p.addValue(v.Type, v.Value); err != nil {
+				return err
+			}
+			continue
+		}
+		// FetchBindVar has checked the encoding.
+		values, _ := sqltypes.ProtoToTuple(v)
+		p.buf.WriteByte('(')
+		for j, tv := range values {
+			if j != 0 {
+				p.buf.WriteString(", ")
+			}
+			if err := p.addValue(tv.Type, tv.Value); err != nil {
+				return err
+			}
+		}
+		p.buf.WriteByte(')')
+	}
+	p.buf.WriteByte(')')
+	return nil
+}
+
+func (p *preparer) addValue(typ querypb.Type, val []byte) error {
+	arg, err := driverValue(typ, val)
+	if err != nil {
+		return err
+	}
+	p.args = append(p.args, arg)
+	if p.style == DollarPlaceholders {
+		p.buf.WriteByte('$')
+		p.buf.WriteString(strconv.Itoa(len(p.args)))
+		return nil
+	}
+	p.buf.WriteByte('?')
+	return nil
+}
+
+// driverValue converts a value to one of the types
+// that database/sql accepts as an argument.
+func driverValue(typ querypb.Type, val []byte) (interface{}, error) {
+	switch {
+	case typ == querypb.Type_NULL_TYPE:
+		return nil, nil
+	case sqltypes.IsSigned(typ):
+		return strconv.ParseInt(string(val), 10, 64)
+	case sqltypes.IsUnsigned(typ):
+		return strconv.ParseUint(string(val), 10, 64)
+	case sqltypes.IsFloat(typ):
+		return strconv.ParseFloat(string(val), 64)
+	case typ == querypb.Type_TUPLE || typ == querypb.Type_EXPRESSION:
+		return nil, fmt.Errorf("unexpected value type %v", typ)
+	case sqltypes.IsBinary(typ) && !utf8.Valid(val):
+		return val, nil
+	}
+	return string(val), nil
+}
+
+func bindVarValueError(name string, err error) error {
+	name = name[1:]
+	if name[0] == ':' {
+		name = name[1:]
+	}
+	return &BindVarError{Name: name, msg: fmt.Sprintf("invalid bind var %s: %v", name, err), err: ErrInvalidBindVar}
+}
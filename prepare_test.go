@@ -0,0 +1,169 @@
+package sqlparser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func TestPrepareAST(t *testing.T) {
+	testcases := []struct {
+		in       string
+		bindVars map[string]*querypb.BindVariable
+		style    PlaceholderStyle
+		query    string
+		args     []interface{}
+	}{{
+		in:    "select * from t",
+		query: "select * from t",
+	}, {
+		in: "select * from t where a = :a and b in ::list and c = :a",
+		bindVars: map[string]*querypb.BindVariable{
+			"a":    sqltypes.Int64BindVariable(1),
+			"list": sqltypes.TestBindVariable([]interface{}{"x", 2.5, uint64(3)}),
+		},
+		query: "select * from t where a = ? and b in (?, ?, ?) and c = ?",
+		args:  []interface{}{int64(1), "x", 2.5, uint64(3), int64(1)},
+	}, {
+		in: "select * from t where a = :a and b in ::list and c = :a",
+		bindVars: map[string]*querypb.BindVariable{
+			"a":    sqltypes.Int64BindVariable(1),
+			"list": sqltypes.TestBindVariable([]interface{}{"x", 2.5}),
+		},
+		style: DollarPlaceholders,
+		query: "select * from t where a = $1 and b in ($2, $3) and c = $4",
+		args:  []interface{}{int64(1), "x", 2.5, int64(1)},
+	}, {
+		in: "select * from t where (a, b) in ::rows",
+		bindVars: map[string]*querypb.BindVariable{
+			"rows": sqltypes.TestBindVariable([]interface{}{
+				[]interface{}{1, []byte("x")},
+				[]interface{}{2, []byte("\xff")},
+			}),
+		},
+		query: "select * from t where (a, b) in ((?, ?), (?, ?))",
+		args:  []interface{}{int64(1), "x", int64(2), []byte("\xff")},
+	}, {
+		in: "update t set a = :a, b = :b where c = :c",
+		bindVars: map[string]*querypb.BindVariable{
+			"a": sqltypes.NullBindVariable,
+			"b": nil,
+			"c": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Date, []byte("2024-01-01"))),
+		},
+		style: DollarPlaceholders,
+		query: "update t set a = $1, b = $2 where c = $3",
+		args:  []interface{}{nil, nil, "2024-01-01"},
+	}, {
+		in: "select * from t limit :count offset :skip",
+		bindVars: map[string]*querypb.BindVariable{
+			"count": sqltypes.Int64BindVariable(10),
+			"skip":  sqltypes.Uint64BindVariable(20),
+		},
+		query: "select * from t limit ?, ?",
+		args:  []interface{}{uint64(20), int64(10)},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		query, args, err := PrepareAST(stmt, tcase.bindVars, tcase.style)
+		if err != nil {
+			t.Errorf("PrepareAST(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if query != tcase.query {
+			t.Errorf("PrepareAST(%s) query: %s, want %s", tcase.in, query, tcase.query)
+		}
+		if !reflect.DeepEqual(args, tcase.args) {
+			t.Errorf("PrepareAST(%s) args: %#v, want %#v", tcase.in, args, tcase.args)
+		}
+	}
+}
+
+func TestPrepareASTNormalized(t *testing.T) {
+	stmt, err := Parse("select * from t where a = 'x' and b in (1, 2) and (c, d) in ((1, 'y'))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindVars := make(map[string]*querypb.BindVariable)
+	Normalize(stmt, bindVars, "bv")
+	query, args, err := PrepareAST(stmt, bindVars, QuestionPlaceholders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "select * from t where a = ? and b in (?, ?) and (c, d) in ((?, ?))"
+	if query != want {
+		t.Errorf("PrepareAST query: %s, want %s", query, want)
+	}
+	wantArgs := []interface{}{"x", int64(1), int64(2), int64(1), "y"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("PrepareAST args: %#v, want %#v", args, wantArgs)
+	}
+}
+
+func TestPrepareASTErrors(t *testing.T) {
+	testcases := []struct {
+		in       string
+		bindVars map[string]*querypb.BindVariable
+		name     string
+		err      string
+		sentinel error
+	}{{
+		in: "select * from t where a = :a and b = :b",
+		bindVars: map[string]*querypb.BindVariable{
+			"a": sqltypes.Int64BindVariable(1),
+		},
+		name:     "b",
+		err:      "missing bind var b",
+		sentinel: ErrMissingBindVar,
+	}, {
+		in: "select * from t where a in ::a",
+		bindVars: map[string]*querypb.BindVariable{
+			"a": sqltypes.Int64BindVariable(1),
+		},
+		name:     "a",
+		err:      "unexpected list arg type (INT64) for key a",
+		sentinel: ErrInvalidBindVar,
+	}, {
+		in: "select * from t where a = :a",
+		bindVars: map[string]*querypb.BindVariable{
+			"a": {Type: querypb.Type_INT64, Value: []byte("x")},
+		},
+		name:     "a",
+		err:      `invalid bind var a: strconv.ParseInt: parsing "x": invalid syntax`,
+		sentinel: ErrInvalidBindVar,
+	}, {
+		in: "select * from t limit ?, ?",
+		bindVars: map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(-5),
+			"v2": sqltypes.Int64BindVariable(10),
+		},
+		name:     "v1",
+		err:      "invalid bind var v1: limit expects a non-negative integer, got -5",
+		sentinel: ErrInvalidBindVar,
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		_, _, err = PrepareAST(stmt, tcase.bindVars, QuestionPlaceholders)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("PrepareAST(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			continue
+		}
+		if !errors.Is(err, tcase.sentinel) {
+			t.Errorf("PrepareAST(%s) err: %v, want %v", tcase.in, err, tcase.sentinel)
+		}
+		var bvErr *BindVarError
+		if !errors.As(err, &bvErr) || bvErr.Name != tcase.name {
+			t.Errorf("PrepareAST(%s) err: %#v, want a *BindVarError for %s", tcase.in, err, tcase.name)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QualifyColumns qualifies the unqualified columns of sel, and of its
+// subqueries, with the table or alias they belong to. columns returns
+// the columns of a table, or nil if the table doesn't exist.
+//
+// The columns that a JOIN ... USING or a NATURAL JOIN merges are
+// coalesced, like MySQL does: unqualified references to them are left
+// unqualified since they belong to neither table. A column that's in
+// more than one table and isn't coalesced is ambiguous. A name that
+// isn't a column of any table in scope is left alone if it's a select
+// alias, since ORDER BY, GROUP BY and HAVING can refer to those.
+func QualifyColumns(sel *Select, columns func(TableName) []string) error {
+	scopes := make(map[*Select]*columnScope)
+	joins := make(map[*JoinTableExpr]*columnScope)
+	return WalkWithPath(func(node SQLNode, path []SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Select:
+			scope, err := newColumnScope(node, columns)
+			if err != nil {
+				return false, err
+			}
+			scopes[node] = scope
+			for join, joinScope := range scope.joins {
+				joins[join] = joinScope
+			}
+		case *ColName:
+			if !node.Qualifier.IsEmpty() {
+				return true, nil
+			}
+			// The ON condition of a join can only refer to the tables
+			// of the join, and to those of the outer selects: the
+			// other tables of its select are skipped.
+			skip := false
+			for i := len(path) - 1; i >= 0; i-- {
+				var scope *columnScope
+				switch outer := path[i].(type) {
+				case JoinCondition:
+					if skip {
+						continue
+					}
+					scope, skip = joins[path[i-1].(*JoinTableExpr)], true
+				case *Select:
+					if skip {
+						skip = false
+						continue
+					}
+					scope = scopes[outer]
+				default:
+					continue
+				}
+				qualifier, found, err := scope.resolve(node.Name)
+				if err != nil {
+					return false, err
+				}
+				if found {
+					node.Qualifier = qualifier
+					return true, nil
+				}
+			}
+			for i := len(path) - 1; i >= 0; i-- {
+				if outer, ok := path[i].(*Select); ok && findSelectAlias(outer.SelectExprs, node.Name) != nil {
+					return true, nil
+				}
+			}
+			return false, fmt.Errorf("unknown column %s", String(node))
+		}
+		return true, nil
+	}, sel)
+}
+
+// QualifyInsertColumns qualifies the unqualified columns of ins like
+// QualifyColumns: the ones of its select, if it has one, and the ones
+// of its ON DUPLICATE KEY UPDATE clause. The latter belong to the table
+// of ins, except for the column aliases of its row alias, which are
+// qualified with the row alias since they refer to the inserted values,
+// see IsInsertValueRef. The arguments of VALUES() and the subqueries
+// of the clause are left alone.
+func QualifyInsertColumns(ins *Insert, columns func(TableName) []string) error {
+	if rows, ok := ins.Rows.(SelectStatement); ok {
+		err := WalkSelects(rows, func(sel SelectStatement) (bool, error) {
+			if sel, ok := sel.(*Select); ok {
+				return false, QualifyColumns(sel, columns)
+			}
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if ins.OnDup == nil {
+		return nil
+	}
+	table := columnTable{qualifier: ins.Table, columns: columns(ins.Table)}
+	if table.columns == nil {
+		return fmt.Errorf("unknown table %s", String(ins.Table))
+	}
+	target := newEmptyColumnScope()
+	target.tables = append(target.tables, table)
+	scope := newEmptyColumnScope()
+	scope.merge(target)
+	if alias := ins.RowAlias; alias != nil && alias.Columns != nil {
+		values := columnTable{qualifier: TableName{Name: alias.Name}}
+		for _, col := range alias.Columns {
+			values.columns = append(values.columns, col.String())
+		}
+		scope.tables = append(scope.tables, values)
+	}
+	qualify := func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ValuesFuncExpr, *Subquery:
+			return false, nil
+		case *ColName:
+			if !node.Qualifier.IsEmpty() {
+				return true, nil
+			}
+			qualifier, found, err := scope.resolve(node.Name)
+			if err != nil {
+				return false, err
+			}
+			if !found {
+				return false, fmt.Errorf("unknown column %s", String(node))
+			}
+			node.Qualifier = qualifier
+		}
+		return true, nil
+	}
+	for _, expr := range ins.OnDup {
+		// The columns assigned are always the ones of the table.
+		if expr.Name.Qualifier.IsEmpty() {
+			if _, found, _ := target.resolve(expr.Name.Name); !found {
+				return fmt.Errorf("unknown column %s", String(expr.Name))
+			}
+			expr.Name.Qualifier = table.qualifier
+		}
+		if err := Walk(qualify, expr.Expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columnScope holds the tables that the columns of a select can refer to.
+type columnScope struct {
+	tables []columnTable
+	// coalesced are the lowercased names of the columns
+	// merged by USING and NATURAL joins.
+	coalesced map[string]bool
+	// joins are the scopes of the ON conditions of the joins:
+	// the tables of both sides of a join.
+	joins map[*JoinTableExpr]*columnScope
+}
+
+// columnTable is a table of a FROM clause, with the qualifier
+// its columns are referred to with.
+type columnTable struct {
+	qualifier TableName
+	columns   []string
+}
+
+func newColumnScope(sel *Select, columns func(TableName) []string) (*columnScope, error) {
+	scope := newEmptyColumnScope()
+	for _, expr := range sel.From {
+		if _, err := scope.addTables(expr, columns); err != nil {
+			return nil, err
+		}
+	}
+	return scope, nil
+}
+
+func newEmptyColumnScope() *columnScope {
+	return &columnScope{
+		coalesced: make(map[string]bool),
+		joins:     make(map[*JoinTableExpr]*columnScope),
+	}
+}
+
+// addTables adds the tables of expr to the scope, and returns
+// the scope of expr on its own.
+func (scope *columnScope) addTables(expr TableExpr, columns func(TableName) []string) (*columnScope, error) {
+	added := newEmptyColumnScope()
+	switch expr := expr.(type) {
+	case *AliasedTableExpr:
+		table := columnTable{qualifier: TableName{Name: expr.As}}
+		switch inner := expr.Expr.(type) {
+		case TableName:
+			table.columns = columns(inner)
+			if table.columns == nil {
+				return nil, fmt.Errorf("unknown table %s", String(inner))
+			}
+			if expr.As.IsEmpty() {
+				table.qualifier = inner
+			}
+		case *Subquery:
+			cols, err := selectColumns(inner.Select, columns)
+			if err != nil {
+				return nil, err
+			}
+			table.columns = cols
+		}
+		scope.tables = append(scope.tables, table)
+		added.tables = append(added.tables, table)
+	case *ParenTableExpr:
+		for _, expr := range expr.Exprs {
+			inner, err := scope.addTables(expr, columns)
+			if err != nil {
+				return nil, err
+			}
+			added.merge(inner)
+		}
+	case *JoinTableExpr:
+		left, err := scope.addTables(expr.LeftExpr, columns)
+		if err != nil {
+			return nil, err
+		}
+		right, err := scope.addTables(expr.RightExpr, columns)
+		if err != nil {
+			return nil, err
+		}
+		added.merge(left)
+		added.merge(right)
+		if expr.Condition.On != nil {
+			scope.joins[expr] = added
+		}
+		var coalesced []string
+		for _, col := range expr.Condition.Using {
+			coalesced = append(coalesced, col.Lowered())
+		}
+		if expr.IsNatural() {
+			names := columnNames(left.tables)
+			for name := range columnNames(right.tables) {
+				if names[name] {
+					coalesced = append(coalesced, name)
+				}
+			}
+		}
+		for _, name := range coalesced {
+			scope.coalesced[name] = true
+			added.coalesced[name] = true
+		}
+	}
+	return added, nil
+}
+
+// merge adds the tables and the coalesced columns of other.
+func (scope *columnScope) merge(other *columnScope) {
+	scope.tables = append(scope.tables, other.tables...)
+	for name := range other.coalesced {
+		scope.coalesced[name] = true
+	}
+}
+
+// resolve returns the qualifier of the column called name. found is
+// set if it's a column of the scope, and the qualifier is empty if
+// it's coalesced.
+func (scope *columnScope) resolve(name ColIdent) (qualifier TableName, found bool, err error) {
+	lowered := name.Lowered()
+	if scope.coalesced[lowered] {
+		return TableName{}, true, nil
+	}
+	for _, table := range scope.tables {
+		for _, col := range table.columns {
+			if strings.ToLower(col) != lowered {
+				continue
+			}
+			if found {
+				return TableName{}, false, fmt.Errorf("column %s is ambiguous", name.String())
+			}
+			qualifier, found = table.qualifier, true
+			break
+		}
+	}
+	return qualifier, found, nil
+}
+
+// columnNames returns the lowercased column names of tables.
+func columnNames(tables []columnTable) map[string]bool {
+	names := make(map[string]bool)
+	for _, table := range tables {
+		for _, col := range table.columns {
+			names[strings.ToLower(col)] = true
+		}
+	}
+	return names
+}
+
+// selectColumns returns the column names of a derived table. A UNION
+// has the columns of its first select.
+func selectColumns(stmt SelectStatement, columns func(TableName) []string) ([]string, error) {
+	switch stmt := stmt.(type) {
+	case *Union:
+		return selectColumns(stmt.Left, columns)
+	case *ParenSelect:
+		return selectColumns(stmt.Select, columns)
+	case *Select:
+		var scope *columnScope
+		var names []string
+		for _, expr := range stmt.SelectExprs {
+			switch expr := expr.(type) {
+			case *AliasedExpr:
+				switch {
+				case !expr.As.IsEmpty():
+					names = append(names, expr.As.String())
+				case IsColName(expr.Expr):
+					names = append(names, expr.Expr.(*ColName).Name.String())
+				default:
+					names = append(names, String(expr.Expr))
+				}
+			case *StarExpr:
+				if scope == nil {
+					var err error
+					if scope, err = newColumnScope(stmt, columns); err != nil {
+						return nil, err
+					}
+				}
+				for _, table := range scope.tables {
+					if expr.TableName.IsEmpty() || table.qualifier == expr.TableName {
+						names = append(names, table.columns...)
+					}
+				}
+			}
+		}
+		return names, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,150 @@
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestQualifyColumns(t *testing.T) {
+	schema := map[string][]string{
+		"t1": {"id", "a", "b"},
+		"t2": {"id", "a", "c"},
+		"t3": {"id", "d"},
+	}
+	columns := func(name TableName) []string {
+		return schema[name.Name.String()]
+	}
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "select b, c from t1, t2 where d = 1",
+		err: "unknown column d",
+	}, {
+		in:  "select b, c from t1 join t2 on t1.id = t2.id where t1.a = 1",
+		out: "select t1.b, t2.c from t1 join t2 on t1.id = t2.id where t1.a = 1",
+	}, {
+		in:  "select a from t1 join t2 on t1.id = t2.id",
+		err: "column a is ambiguous",
+	}, {
+		in:  "select id, a, b, c from t1 join t2 using (id, a)",
+		out: "select id, a, t1.b, t2.c from t1 join t2 using (id, a)",
+	}, {
+		in:  "select id, a, b, c from t1 straight_join t2 on t1.id = t2.id and t1.a = t2.a where b = c",
+		err: "column id is ambiguous",
+	}, {
+		in:  "select id, a, b, c, d from t1 natural left join t2 join t3 using (id)",
+		out: "select id, a, t1.b, t2.c, t3.d from t1 natural left join t2 join t3 using (id)",
+	}, {
+		in:  "select a, d from t1 natural join t3",
+		out: "select t1.a, t3.d from t1 natural join t3",
+	}, {
+		in:  "select default(b), default(d) from t1 join t3 on t1.id = t3.id",
+		out: "select default(t1.b), default(t3.d) from t1 join t3 on t1.id = t3.id",
+	}, {
+		in:  "select default(c) from t1",
+		err: "unknown column c",
+	}, {
+		in:  "select x.a, b + 1 as total from db.t1 as x join t3 on x.id = t3.id order by total, d",
+		out: "select x.a, x.b + 1 as total from db.t1 as x join t3 on x.id = t3.id order by total asc, t3.d asc",
+	}, {
+		// correlated subquery and derived table
+		in:  "select c, n from t2, (select b as n, t1.* from t1) as s where exists (select 1 from t3 where d = c)",
+		out: "select t2.c, s.n from t2, (select t1.b as n, t1.* from t1) as s where exists (select 1 from t3 where t3.d = t2.c)",
+	}, {
+		// the ON conditions of nested joins
+		in:  "select b from ((t1 join t2 on t1.id = t2.id) left join t3 on d = c)",
+		out: "select t1.b from ((t1 join t2 on t1.id = t2.id) left join t3 on t3.d = t2.c)",
+	}, {
+		in:  "select b from {oj t1 left join t3 on t1.id = t3.id} where d = 1",
+		out: "select t1.b from {oj t1 left join t3 on t1.id = t3.id} where t3.d = 1",
+	}, {
+		// only t2 and t3 are in scope in the on condition
+		in:  "select t1.b from t1, t2 join t3 on t2.id = t3.id and a = 1",
+		out: "select t1.b from t1, t2 join t3 on t2.id = t3.id and t2.a = 1",
+	}, {
+		in:  "select t1.id from t1, t2 join t3 on b = d",
+		err: "unknown column b",
+	}, {
+		in:  "select a from t1 where exists (select 1 from t2 join t3 on t3.d = b)",
+		out: "select t1.a from t1 where exists (select 1 from t2 join t3 on t3.d = t1.b)",
+	}, {
+		in:  "select a from t4",
+		err: "unknown table t4",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		err = QualifyColumns(tree.(*Select), columns)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("QualifyColumns(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("QualifyColumns(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("QualifyColumns(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestQualifyInsertColumns(t *testing.T) {
+	schema := map[string][]string{
+		"t1": {"id", "a", "b"},
+		"t2": {"id", "a", "c"},
+	}
+	columns := func(name TableName) []string {
+		return schema[name.Name.String()]
+	}
+	testcases := []struct {
+		in  string
+		out string
+		err string
+	}{{
+		in:  "insert into t1(id, a) values (1, 2) as new on duplicate key update a = new.a + b, b = values(b)",
+		out: "insert into t1(id, a) values (1, 2) as new on duplicate key update t1.a = new.a + t1.b, t1.b = values(b)",
+	}, {
+		in:  "insert into t1(id, a) values (1, 2) as new(x, y) on duplicate key update a = y + a",
+		out: "insert into t1(id, a) values (1, 2) as new(x, y) on duplicate key update t1.a = new.y + t1.a",
+	}, {
+		in:  "insert into t1(id, a) values (1, 2) as new(id, y) on duplicate key update id = id + 1",
+		err: "column id is ambiguous",
+	}, {
+		in:  "insert into t1(id, a) values (1, 2) as new(x, y) on duplicate key update y = 1",
+		err: "unknown column y",
+	}, {
+		in:  "insert into t1(id, a) select id, c from t2 on duplicate key update a = t1.a + 1",
+		out: "insert into t1(id, a) select t2.id, t2.c from t2 on duplicate key update t1.a = t1.a + 1",
+	}, {
+		in:  "insert into t3(id) values (1) on duplicate key update id = 2",
+		err: "unknown table t3",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		err = QualifyInsertColumns(tree.(*Insert), columns)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("QualifyInsertColumns(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("QualifyInsertColumns(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("QualifyInsertColumns(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+}
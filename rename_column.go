@@ -0,0 +1,369 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenameColumn renames the column from of table to to in stmt: the
+// references to the column in expressions, including the ones through
+// an alias of the table, the JOIN ... USING lists, the column list of
+// an INSERT into the table and the targets of SET and ON DUPLICATE KEY
+// UPDATE clauses. The columns of other tables with the same name are
+// left alone. changed is set if stmt was modified.
+//
+// Without a schema, an unqualified reference is only taken to refer to
+// the column if the table is the only one it can belong to: use
+// RenameColumnWithSchema to resolve the others. RenameColumn returns
+// an error listing the references it can't resolve, and leaves stmt
+// unchanged in that case.
+//
+// The column of a derived table keeps its name: a renamed column of
+// its select list is aliased to the old name. References to the column
+// through a derived table that selects * from table are renamed too.
+func RenameColumn(stmt Statement, table TableName, from, to ColIdent) (changed bool, err error) {
+	return RenameColumnWithSchema(stmt, table, from, to, nil)
+}
+
+// RenameColumnWithSchema is the same as RenameColumn, except that
+// columns returns the columns of a table, like for QualifyColumns, so
+// that unqualified references can be resolved. It can return nil for
+// a table it doesn't know.
+func RenameColumnWithSchema(stmt Statement, table TableName, from, to ColIdent, columns func(TableName) []string) (changed bool, err error) {
+	r := &columnRenamer{table: table, from: from, to: to, columns: columns}
+	r.renameStatement(stmt)
+	if len(r.problems) != 0 {
+		return false, fmt.Errorf("cannot rename column %s of %s: %s", from.String(), String(table), strings.Join(r.problems, ", "))
+	}
+	for _, edit := range r.edits {
+		edit()
+	}
+	return len(r.edits) != 0, nil
+}
+
+// columnRenamer collects the edits that rename the column, which
+// are only applied if there are no problems.
+type columnRenamer struct {
+	table    TableName
+	from, to ColIdent
+	columns  func(TableName) []string
+	edits    []func()
+	problems []string
+}
+
+// renameScope holds the tables that the columns of a statement
+// can refer to.
+type renameScope struct {
+	tables []renameTable
+}
+
+// renameTable is a table of a FROM clause.
+type renameTable struct {
+	// qualifier is the alias of the table, or its name.
+	qualifier TableName
+	alias     bool
+	// renamed is set if the column of the table is renamed:
+	// it's the table, or a derived table that selects its *.
+	renamed bool
+	// columns are the columns of the table, or nil if unknown.
+	columns []string
+}
+
+// hasColumn returns whether the table has the column called name,
+// and known if its columns are known.
+func (t *renameTable) hasColumn(name ColIdent) (has, known bool) {
+	if t.columns == nil {
+		return false, false
+	}
+	for _, col := range t.columns {
+		if strings.EqualFold(col, name.String()) {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+func (r *columnRenamer) isTable(name TableName) bool {
+	return name.Name == r.table.Name && (name.Qualifier.IsEmpty() || r.table.Qualifier.IsEmpty() || name.Qualifier == r.table.Qualifier)
+}
+
+func (r *columnRenamer) tableColumns(name TableName) []string {
+	if r.columns == nil {
+		return nil
+	}
+	return r.columns(name)
+}
+
+func (r *columnRenamer) renameStatement(stmt Statement) {
+	switch stmt := stmt.(type) {
+	case SelectStatement:
+		r.renameSelect(stmt, nil, false)
+	case *Update:
+		scope := r.newScope(stmt.TableExprs)
+		r.renameExprs([]*renameScope{scope}, stmt.TableExprs, stmt.Exprs, stmt.Where, stmt.OrderBy, stmt.Limit)
+	case *Delete:
+		scope := r.newScope(stmt.TableExprs)
+		r.renameExprs([]*renameScope{scope}, stmt.TableExprs, stmt.Where, stmt.OrderBy, stmt.Limit)
+	case *Insert:
+		r.renameInsert(stmt)
+	}
+}
+
+func (r *columnRenamer) renameInsert(ins *Insert) {
+	target := r.isTable(ins.Table)
+	if target {
+		for i, col := range ins.Columns {
+			if col.Equal(r.from) {
+				i := i
+				r.edits = append(r.edits, func() { ins.Columns[i] = r.to })
+			}
+		}
+	}
+	if sel, ok := ins.Rows.(SelectStatement); ok {
+		r.renameSelect(sel, nil, false)
+	}
+	scope := &renameScope{tables: []renameTable{{
+		qualifier: ins.Table,
+		renamed:   target,
+		columns:   r.tableColumns(ins.Table),
+	}}}
+	// The columns qualified with a row alias refer to the columns of
+	// the table, unless the alias renames them.
+	if alias := ins.RowAlias; alias != nil {
+		table := renameTable{qualifier: TableName{Name: alias.Name}, alias: true, renamed: target, columns: scope.tables[0].columns}
+		if alias.Columns != nil {
+			table.renamed = false
+			table.columns = nil
+			for _, col := range alias.Columns {
+				table.columns = append(table.columns, col.String())
+			}
+		}
+		scope.tables = append(scope.tables, table)
+	}
+	r.renameExprs([]*renameScope{scope}, ins.SetExprs, ins.OnDup)
+}
+
+// renameSelect renames the column in stmt, whose outer selects have
+// the scopes outer. derived is set if stmt is the select of a derived
+// table. It returns whether the * of stmt selects the column.
+func (r *columnRenamer) renameSelect(stmt SelectStatement, outer []*renameScope, derived bool) (selectsRenamed bool) {
+	switch stmt := stmt.(type) {
+	case *Union:
+		// The columns of a union are the ones of its first select.
+		selectsRenamed = r.renameSelect(stmt.Left, outer, derived)
+		r.renameSelect(stmt.Right, outer, false)
+		return selectsRenamed
+	case *ParenSelect:
+		return r.renameSelect(stmt.Select, outer, derived)
+	case *Select:
+		scope := r.newScope(stmt.From)
+		scopes := append(outer[:len(outer):len(outer)], scope)
+		for _, expr := range stmt.SelectExprs {
+			switch expr := expr.(type) {
+			case *StarExpr:
+				for _, table := range scope.tables {
+					if table.renamed && (expr.TableName.IsEmpty() || r.qualifies(expr.TableName, &table)) {
+						selectsRenamed = true
+					}
+				}
+			case *AliasedExpr:
+				col, ok := expr.Expr.(*ColName)
+				if !derived || !ok || !expr.As.IsEmpty() || !col.Name.Equal(r.from) {
+					break
+				}
+				before := len(r.edits)
+				r.renameExprs(scopes, expr)
+				if len(r.edits) != before {
+					expr := expr
+					r.edits = append(r.edits, func() { expr.As = r.from })
+				}
+				continue
+			}
+			r.renameExprs(scopes, expr)
+		}
+		r.renameExprs(scopes, stmt.From, stmt.Where)
+		// GROUP BY, HAVING and ORDER BY can refer to select aliases.
+		aliased := findSelectAlias(stmt.SelectExprs, r.from) != nil
+		r.renameAliasedExprs(scopes, aliased, stmt.GroupBy, stmt.Having, stmt.OrderBy)
+	}
+	return selectsRenamed
+}
+
+// newScope returns the scope of the tables of exprs. The derived
+// tables are renamed along the way.
+func (r *columnRenamer) newScope(exprs TableExprs) *renameScope {
+	scope := &renameScope{}
+	var add func(exprs ...TableExpr) []renameTable
+	add = func(exprs ...TableExpr) []renameTable {
+		var added []renameTable
+		for _, expr := range exprs {
+			switch expr := expr.(type) {
+			case *AliasedTableExpr:
+				table := renameTable{qualifier: TableName{Name: expr.As}, alias: true}
+				switch inner := expr.Expr.(type) {
+				case TableName:
+					table.renamed = r.isTable(inner)
+					table.columns = r.tableColumns(inner)
+					if expr.As.IsEmpty() {
+						table.qualifier, table.alias = inner, false
+					}
+				case *Subquery:
+					table.renamed = r.renameSelect(inner.Select, nil, true)
+					if r.columns != nil {
+						table.columns, _ = selectColumns(inner.Select, r.columns)
+					}
+				}
+				added = append(added, table)
+			case *ParenTableExpr:
+				added = append(added, add(expr.Exprs...)...)
+			case *JoinTableExpr:
+				left := add(expr.LeftExpr)
+				right := add(expr.RightExpr)
+				r.renameJoin(expr, left, right)
+				added = append(added, left...)
+				added = append(added, right...)
+			}
+		}
+		return added
+	}
+	for _, expr := range exprs {
+		scope.tables = append(scope.tables, add(expr)...)
+	}
+	return scope
+}
+
+// renameJoin renames the column in the USING list of join. The list
+// names columns of both sides, so it can only be renamed if all the
+// tables of both sides have the renamed column.
+func (r *columnRenamer) renameJoin(join *JoinTableExpr, left, right []renameTable) {
+	tables := append(left[:len(left):len(left)], right...)
+	renamed, all := false, true
+	for _, table := range tables {
+		renamed = renamed || table.renamed
+		all = all && table.renamed
+	}
+	if !renamed {
+		return
+	}
+	for i, col := range join.Condition.Using {
+		if !col.Equal(r.from) {
+			continue
+		}
+		if !all {
+			r.problems = append(r.problems, fmt.Sprintf("%s joins it with the column of another table", strings.TrimSpace(String(join.Condition))))
+			continue
+		}
+		i := i
+		r.edits = append(r.edits, func() { join.Condition.Using[i] = r.to })
+	}
+	if join.IsNatural() && !all {
+		for _, table := range tables {
+			if has, known := table.hasColumn(r.from); !table.renamed && (has || !known) {
+				r.problems = append(r.problems, fmt.Sprintf("%s may join it with the column of another table", join.Join))
+				return
+			}
+		}
+	}
+}
+
+// renameAliasedExprs renames the column in the GROUP BY, HAVING and
+// ORDER BY clauses of a select, where an unqualified name that is a
+// select alias refers to the alias.
+func (r *columnRenamer) renameAliasedExprs(scopes []*renameScope, aliased bool, nodes ...SQLNode) {
+	for _, node := range nodes {
+		_ = Walk(func(node SQLNode) (bool, error) {
+			if col, ok := node.(*ColName); ok && aliased && col.Qualifier.IsEmpty() && col.Name.Equal(r.from) {
+				return false, nil
+			}
+			return r.visit(scopes, node), nil
+		}, node)
+	}
+}
+
+// renameExprs renames the column in nodes, and in their subqueries.
+func (r *columnRenamer) renameExprs(scopes []*renameScope, nodes ...SQLNode) {
+	for _, node := range nodes {
+		_ = Walk(func(node SQLNode) (bool, error) {
+			return r.visit(scopes, node), nil
+		}, node)
+	}
+}
+
+// visit renames node if it's a reference to the column, and returns
+// whether the walk should go on with the nodes under it.
+func (r *columnRenamer) visit(scopes []*renameScope, node SQLNode) bool {
+	switch node := node.(type) {
+	case *Subquery:
+		r.renameSelect(node.Select, scopes, false)
+		return false
+	case *AliasedTableExpr:
+		// The derived tables are renamed with their scope.
+		return false
+	case *ColName:
+		if !node.Name.Equal(r.from) {
+			return false
+		}
+		if renamed, ok := r.resolve(scopes, node); ok && renamed {
+			r.edits = append(r.edits, func() { node.Name = r.to })
+		} else if !ok {
+			r.problems = append(r.problems, fmt.Sprintf("%s is ambiguous", String(node)))
+		}
+		return false
+	}
+	return true
+}
+
+// resolve returns whether col refers to the renamed column. ok is
+// false if that can't be told.
+func (r *columnRenamer) resolve(scopes []*renameScope, col *ColName) (renamed, ok bool) {
+	for i := len(scopes) - 1; i >= 0; i-- {
+		tables := scopes[i].tables
+		if !col.Qualifier.IsEmpty() {
+			for j := range tables {
+				if r.qualifies(col.Qualifier, &tables[j]) {
+					return tables[j].renamed, true
+				}
+			}
+			continue
+		}
+		var candidates []*renameTable
+		unknown := 0
+		for j := range tables {
+			has, known := tables[j].hasColumn(col.Name)
+			if has || !known {
+				candidates = append(candidates, &tables[j])
+			}
+			if !known {
+				unknown++
+			}
+		}
+		switch {
+		case len(candidates) == 0:
+			continue
+		case len(candidates) == 1 && (unknown == 0 || len(tables) == 1):
+			return candidates[0].renamed, true
+		}
+		// The column can belong to several tables: it doesn't matter
+		// if none of them is renamed, or if all of them are.
+		renamed, all := false, true
+		for _, table := range candidates {
+			renamed = renamed || table.renamed
+			all = all && table.renamed
+		}
+		if renamed == all {
+			return renamed, true
+		}
+		return false, false
+	}
+	// The column is not in scope: it may be a select alias.
+	return false, true
+}
+
+// qualifies returns whether qualifier names table.
+func (r *columnRenamer) qualifies(qualifier TableName, table *renameTable) bool {
+	if table.alias {
+		return qualifier.Qualifier.IsEmpty() && qualifier.Name == table.qualifier.Name
+	}
+	return qualifier.Name == table.qualifier.Name &&
+		(qualifier.Qualifier.IsEmpty() || table.qualifier.Qualifier.IsEmpty() || qualifier.Qualifier == table.qualifier.Qualifier)
+}
@@ -0,0 +1,164 @@
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestRenameColumn(t *testing.T) {
+	schema := map[string][]string{
+		"t": {"id", "a", "b"},
+		"u": {"id", "c"},
+		"v": {"id", "a"},
+	}
+	columns := func(table TableName) []string {
+		return schema[table.Name.String()]
+	}
+	testcases := []struct {
+		in      string
+		schema  bool
+		out     string
+		changed bool
+		err     string
+	}{{
+		in:      "select a, t.a, b from t where a = 1 order by a",
+		out:     "select b, t.b, b from t where b = 1 order by b asc",
+		changed: true,
+	}, {
+		in:  "select c from u where c = 1",
+		out: "select c from u where c = 1",
+	}, {
+		in:      "select x.a from t as x join v on x.id = v.id where v.a = 1",
+		out:     "select x.b from t as x join v on x.id = v.id where v.a = 1",
+		changed: true,
+	}, {
+		in:  "select a from t join v on t.id = v.id",
+		err: "cannot rename column a of t: a is ambiguous",
+	}, {
+		in:      "select a, c from t join u on t.id = u.id",
+		schema:  true,
+		out:     "select b, c from t join u on t.id = u.id",
+		changed: true,
+	}, {
+		in:  "select a, c from t join u on t.id = u.id",
+		err: "cannot rename column a of t: a is ambiguous",
+	}, {
+		in:      "select a from t as x join t as y using (a)",
+		out:     "select b from t as x join t as y using (b)",
+		changed: true,
+	}, {
+		in:  "select t.id from t join v using (a)",
+		err: "cannot rename column a of t: using (a) joins it with the column of another table",
+	}, {
+		in:     "select t.id from t natural join v",
+		schema: true,
+		err:    "cannot rename column a of t: natural join may join it with the column of another table",
+	}, {
+		in:      "select d.a from (select a from t) as d where d.a > 1",
+		out:     "select d.a from (select b as a from t) as d where d.a > 1",
+		changed: true,
+	}, {
+		in:      "select d.a from (select * from t) as d",
+		out:     "select d.b from (select * from t) as d",
+		changed: true,
+	}, {
+		in:      "select id from u where exists (select 1 from t where t.a = u.id and a > 0)",
+		out:     "select id from u where exists (select 1 from t where t.b = u.id and b > 0)",
+		changed: true,
+	}, {
+		in:      "select id, a + 1 as a from t order by a",
+		out:     "select id, b + 1 as a from t order by a asc",
+		changed: true,
+	}, {
+		in:      "update t set a = a + 1 where a > 0 order by a limit 1",
+		out:     "update t set b = b + 1 where b > 0 order by b asc limit 1",
+		changed: true,
+	}, {
+		in:      "delete from t where a = 1",
+		out:     "delete from t where b = 1",
+		changed: true,
+	}, {
+		in:      "insert into t(id, a) values (1, 2) on duplicate key update a = values(a) + 1",
+		out:     "insert into t(id, b) values (1, 2) on duplicate key update b = values(b) + 1",
+		changed: true,
+	}, {
+		in:      "insert into t(id, a) values (1, 2) as new on duplicate key update a = new.a",
+		out:     "insert into t(id, b) values (1, 2) as new on duplicate key update b = new.b",
+		changed: true,
+	}, {
+		in:      "insert into t(id, a) values (1, 2) as new(x, y) on duplicate key update a = new.y",
+		out:     "insert into t(id, b) values (1, 2) as new(x, y) on duplicate key update b = new.y",
+		changed: true,
+	}, {
+		// The row alias t is not the table t.
+		in:  "insert into u(id, a) values (1, 2) as t on duplicate key update a = t.a",
+		out: "insert into u(id, a) values (1, 2) as t on duplicate key update a = t.a",
+	}, {
+		in:      "insert into t set id = 1, a = 2",
+		out:     "insert into t set id = 1, b = 2",
+		changed: true,
+	}, {
+		in:      "insert into u(id, c) select id, a from t",
+		out:     "insert into u(id, c) select id, b from t",
+		changed: true,
+	}, {
+		in:  "insert into u(id, a) values (1, 2)",
+		out: "insert into u(id, a) values (1, 2)",
+	}, {
+		in:      "select a from t union select a from v",
+		out:     "select b from t union select a from v",
+		changed: true,
+	}, {
+		in:  "drop table t",
+		out: "drop table t",
+	}, {
+		in:      "insert into t(id, a) values (default, 1)",
+		out:     "insert into t(id, b) values (default, 1)",
+		changed: true,
+	}, {
+		in:      "insert into t set a = default",
+		out:     "insert into t set b = default",
+		changed: true,
+	}, {
+		in:      "update t set a = default",
+		out:     "update t set b = default",
+		changed: true,
+	}, {
+		in:  "set charset default",
+		out: "set charset default",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		tree2, _ := Parse(tcase.in)
+		var cols func(TableName) []string
+		if tcase.schema {
+			cols = columns
+		}
+		table := TableName{Name: NewTableIdent("t")}
+		changed, err := RenameColumnWithSchema(tree, table, NewColIdent("a"), NewColIdent("b"), cols)
+		var errStr string
+		if err != nil {
+			errStr = err.Error()
+		}
+		if errStr != tcase.err {
+			t.Errorf("RenameColumn(%s) err: %q, want %q", tcase.in, errStr, tcase.err)
+			continue
+		}
+		if changed != tcase.changed {
+			t.Errorf("RenameColumn(%s) changed: %v, want %v", tcase.in, changed, tcase.changed)
+		}
+		if err != nil {
+			// The statement is left unchanged.
+			if got, want := String(tree), String(tree2); got != want {
+				t.Errorf("RenameColumn(%s) changed the statement to %s", tcase.in, got)
+			}
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("RenameColumn(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
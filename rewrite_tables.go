@@ -0,0 +1,269 @@
+package sqlparser
+
+// RewriteTableNames replaces every table reference in stmt with the
+// result of mapper: the tables of FROM clauses and joins, including the
+// ones of subqueries, the targets of INSERT, UPDATE and DELETE, and the
+// tables of DDL and other statements, like the ones that foreign keys
+// reference.
+//
+// Column qualifiers are rewritten only if they refer to a table that's
+// in scope without an alias. Aliases, and the columns qualified with
+// them, are left untouched, and so are the references to the common
+// table expressions of WITH clauses.
+func RewriteTableNames(stmt Statement, mapper func(TableName) TableName) error {
+	scopes := make(map[SQLNode]*tableScope)
+	// resolve rewrites the qualifier of a column, looking it up in the
+	// scopes of the statements in path, from the innermost outwards.
+	resolve := func(qualifier TableName, path []SQLNode) TableName {
+		if qualifier.IsEmpty() {
+			return qualifier
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			switch path[i].(type) {
+			case *Select, *Insert, *Update, *Delete:
+				if rewritten, found := scopes[path[i]].rewrite(qualifier, mapper); found {
+					return rewritten
+				}
+			}
+		}
+		return qualifier
+	}
+
+	return WalkWithPath(func(node SQLNode, path []SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Select:
+			scope := newTableScope(node.From)
+			scope.hideCTEs(visibleCTEs(append(path[:len(path):len(path)], node)))
+			scopes[node] = scope
+		case *Update:
+			scope := newTableScope(node.TableExprs)
+			scope.hideCTEs(visibleCTEs(append(path[:len(path):len(path)], node)))
+			scopes[node] = scope
+		case *Delete:
+			scope := newTableScope(node.TableExprs)
+			scope.hideCTEs(visibleCTEs(append(path[:len(path):len(path)], node)))
+			scopes[node] = scope
+			for i, target := range node.Targets {
+				node.Targets[i], _ = scope.rewrite(target, mapper)
+			}
+		case *Insert:
+			scope := &tableScope{tables: []TableName{node.Table}, aliases: make(map[TableIdent]bool)}
+			if node.RowAlias != nil {
+				scope.aliases[node.RowAlias.Name] = true
+			}
+			// The ON DUPLICATE KEY UPDATE of an INSERT ... SELECT
+			// can refer to the tables of the select too.
+			rows := node.Rows
+			for {
+				paren, ok := rows.(*ParenSelect)
+				if !ok {
+					break
+				}
+				rows = paren.Select
+			}
+			if sel, ok := rows.(*Select); ok {
+				from := newTableScope(sel.From)
+				from.hideCTEs(visibleCTEs(append(path[:len(path):len(path)], node, sel)))
+				scope.tables = append(scope.tables, from.tables...)
+				for alias := range from.aliases {
+					scope.aliases[alias] = true
+				}
+			}
+			scopes[node] = scope
+			node.Table = mapper(node.Table)
+		case *AliasedTableExpr:
+			if name, ok := node.Expr.(TableName); ok {
+				if name.Qualifier.IsEmpty() && visibleCTEs(path)[name.Name] {
+					break
+				}
+				node.Expr = mapper(name)
+			}
+		case *ColName:
+			node.Qualifier = resolve(node.Qualifier, path)
+		case *StarExpr:
+			node.TableName = resolve(node.TableName, path)
+		case *Stream:
+			node.Table = mapper(node.Table)
+		case *DDL:
+			if !node.Table.IsEmpty() {
+				node.Table = mapper(node.Table)
+			}
+			if !node.NewName.IsEmpty() {
+				node.NewName = mapper(node.NewName)
+			}
+			// The walk doesn't go into the table spec, but the
+			// foreign keys of an ALTER TABLE are walked.
+			if node.TableSpec != nil {
+				for _, fk := range node.TableSpec.ForeignKeys {
+					fk.ReferencedTable = mapper(fk.ReferencedTable)
+				}
+			}
+		case *ForeignKeyDefinition:
+			node.ReferencedTable = mapper(node.ReferencedTable)
+		case *OptLike:
+			if node != nil {
+				node.LikeTable = mapper(node.LikeTable)
+			}
+		case *Show:
+			if !node.OnTable.IsEmpty() {
+				node.OnTable = mapper(node.OnTable)
+			}
+		case *Handler:
+			node.Table = mapper(node.Table)
+		case *DescribeTable:
+			node.Table = mapper(node.Table)
+		case *Flush:
+			for i, name := range node.TableNames {
+				node.TableNames[i] = mapper(name)
+			}
+		case *TableMaintenance:
+			for i, name := range node.Tables {
+				node.Tables[i] = mapper(name)
+			}
+		case *IndexCacheTable:
+			node.Table = mapper(node.Table)
+		case *TableLock:
+			node.Table = mapper(node.Table)
+		case *CreateTrigger:
+			node.Table = mapper(node.Table)
+		}
+		return true, nil
+	}, stmt)
+}
+
+// ExtractTables returns the tables that stmt refers to, the ones that
+// RewriteTableNames rewrites, in the order they're written and without
+// duplicates. stmt is not modified.
+func ExtractTables(stmt Statement) []TableName {
+	var tables []TableName
+	seen := make(map[TableName]bool)
+	_ = RewriteTableNames(DeepCopy(stmt).(Statement), func(name TableName) TableName {
+		if !seen[name] {
+			seen[name] = true
+			tables = append(tables, name)
+		}
+		return name
+	})
+	return tables
+}
+
+// tableScope holds the tables that the columns of a statement
+// can refer to.
+type tableScope struct {
+	// tables are the tables referenced without an alias.
+	tables  []TableName
+	aliases map[TableIdent]bool
+}
+
+func newTableScope(exprs TableExprs) *tableScope {
+	scope := &tableScope{aliases: make(map[TableIdent]bool)}
+	var add func(exprs ...TableExpr)
+	add = func(exprs ...TableExpr) {
+		for _, expr := range exprs {
+			switch expr := expr.(type) {
+			case *AliasedTableExpr:
+				if !expr.As.IsEmpty() {
+					scope.aliases[expr.As] = true
+				} else if name, ok := expr.Expr.(TableName); ok {
+					scope.tables = append(scope.tables, name)
+				}
+			case *JoinTableExpr:
+				add(expr.LeftExpr, expr.RightExpr)
+			case *ParenTableExpr:
+				add(expr.Exprs...)
+			}
+		}
+	}
+	add(exprs...)
+	return scope
+}
+
+// hideCTEs turns the tables of the scope that refer to one of the
+// common table expressions in ctes into aliases, which aren't
+// rewritten.
+func (scope *tableScope) hideCTEs(ctes map[TableIdent]bool) {
+	if len(ctes) == 0 {
+		return
+	}
+	tables := scope.tables[:0]
+	for _, table := range scope.tables {
+		if table.Qualifier.IsEmpty() && ctes[table.Name] {
+			scope.aliases[table.Name] = true
+			continue
+		}
+		tables = append(tables, table)
+	}
+	scope.tables = tables
+}
+
+// visibleCTEs returns the names of the common table expressions that
+// the FROM clauses of the last node of path can refer to: the ones of
+// the WITH clauses of the statements of path. Inside a WITH clause, a
+// common table expression can only refer to the ones before it, and
+// to itself if the clause is recursive.
+func visibleCTEs(path []SQLNode) map[TableIdent]bool {
+	var names map[TableIdent]bool
+	for i, node := range path {
+		var with *With
+		switch node := node.(type) {
+		case *Select:
+			with = node.With
+		case *Union:
+			with = node.With
+		case *Update:
+			with = node.With
+		case *Delete:
+			with = node.With
+		}
+		if with == nil {
+			continue
+		}
+		var inside *CommonTableExpr
+		if i+2 < len(path) && path[i+1] == SQLNode(with) {
+			inside, _ = path[i+2].(*CommonTableExpr)
+		}
+		if names == nil {
+			names = make(map[TableIdent]bool)
+		}
+		for _, cte := range with.CTEs {
+			if cte == inside {
+				if with.Recursive {
+					names[cte.Name] = true
+				}
+				break
+			}
+			names[cte.Name] = true
+		}
+	}
+	return names
+}
+
+// rewrite returns the qualifier rewritten by mapper if it refers
+// to a table of the scope. found is set if the qualifier names a
+// table or an alias of the scope.
+func (scope *tableScope) rewrite(qualifier TableName, mapper func(TableName) TableName) (rewritten TableName, found bool) {
+	table, isTable, found := scope.lookup(qualifier)
+	if !isTable {
+		return qualifier, found
+	}
+	mapped := mapper(table)
+	if qualifier.Qualifier.IsEmpty() {
+		return TableName{Name: mapped.Name}, true
+	}
+	return mapped, true
+}
+
+// lookup returns the table that qualifier refers to, if any. found is
+// set if the qualifier names a table or an alias of the scope, and
+// isTable if it names a table.
+func (scope *tableScope) lookup(qualifier TableName) (table TableName, isTable, found bool) {
+	if qualifier.Qualifier.IsEmpty() && scope.aliases[qualifier.Name] {
+		return TableName{}, false, true
+	}
+	for _, table := range scope.tables {
+		if table.Name == qualifier.Name && (qualifier.Qualifier.IsEmpty() || table.Qualifier == qualifier.Qualifier) {
+			return table, true, true
+		}
+	}
+	return TableName{}, false, false
+}
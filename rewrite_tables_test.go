@@ -0,0 +1,211 @@
+package sqlparser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRewriteTableNames(t *testing.T) {
+	mapper := func(name TableName) TableName {
+		return TableName{
+			Qualifier: name.Qualifier,
+			Name:      NewTableIdent("tenant_42_" + name.Name.String()),
+		}
+	}
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select orders.id, o.* from orders, items as o where orders.id = o.order_id",
+		out: "select tenant_42_orders.id, o.* from tenant_42_orders, tenant_42_items as o where tenant_42_orders.id = o.order_id",
+	}, {
+		// self-join
+		in:  "select o1.id, o2.id from orders as o1 join orders as o2 on o1.parent_id = o2.id",
+		out: "select o1.id, o2.id from tenant_42_orders as o1 join tenant_42_orders as o2 on o1.parent_id = o2.id",
+	}, {
+		// correlated subquery
+		in:  "select id from orders where exists (select 1 from items where items.order_id = orders.id)",
+		out: "select id from tenant_42_orders where exists (select 1 from tenant_42_items where tenant_42_items.order_id = tenant_42_orders.id)",
+	}, {
+		// an alias shadows the table of an outer query
+		in:  "select orders.id from orders where orders.id in (select orders.id from customers as orders)",
+		out: "select tenant_42_orders.id from tenant_42_orders where tenant_42_orders.id in (select orders.id from tenant_42_customers as orders)",
+	}, {
+		in:  "select db.orders.id, orders.*, x.id from db.orders join (select id from items) as x on db.orders.id = x.id",
+		out: "select db.tenant_42_orders.id, tenant_42_orders.*, x.id from db.tenant_42_orders join (select id from tenant_42_items) as x on db.tenant_42_orders.id = x.id",
+	}, {
+		// a common table expression is not a table
+		in:  "with c as (select * from t) select * from c join u on c.a = u.a",
+		out: "with c as (select * from tenant_42_t) select * from c join tenant_42_u on c.a = tenant_42_u.a",
+	}, {
+		// it can refer to the ones before it, but not to itself
+		// unless the with clause is recursive
+		in:  "with c as (select c.a from c), d as (select a from c) select d.a from d, c",
+		out: "with c as (select tenant_42_c.a from tenant_42_c), d as (select a from c) select d.a from d, c",
+	}, {
+		in:  "with recursive c as (select t.n from t union all select c.n + 1 from c where n < 3) select n from c",
+		out: "with recursive c as (select tenant_42_t.n from tenant_42_t union all select c.n + 1 from c where n < 3) select n from c",
+	}, {
+		in:  "with c as (select a from t) update u join c on u.a = c.a set u.b = c.a",
+		out: "with c as (select a from tenant_42_t) update tenant_42_u join c on tenant_42_u.a = c.a set tenant_42_u.b = c.a",
+	}, {
+		in:  "with c as (select a from t) delete u from u join c on u.a = c.a",
+		out: "with c as (select a from tenant_42_t) delete tenant_42_u from tenant_42_u join c on tenant_42_u.a = c.a",
+	}, {
+		in:  "select * from c where a in (with c as (select a from t) select * from c)",
+		out: "select * from tenant_42_c where a in (with c as (select a from tenant_42_t) select * from c)",
+	}, {
+		// a qualifier that isn't in scope is left alone
+		in:  "select other.id from orders",
+		out: "select other.id from tenant_42_orders",
+	}, {
+		in:  "insert into orders(id) select id from items on duplicate key update orders.id = orders.id + 1",
+		out: "insert into tenant_42_orders(id) select id from tenant_42_items on duplicate key update tenant_42_orders.id = tenant_42_orders.id + 1",
+	}, {
+		in:  "insert into orders(id, total) values (1, 2) as orders_new on duplicate key update total = orders.total + orders_new.total",
+		out: "insert into tenant_42_orders(id, total) values (1, 2) as orders_new on duplicate key update total = tenant_42_orders.total + orders_new.total",
+	}, {
+		// the select's tables are in the scope of on duplicate key update
+		in:  "insert into orders(id, total) select items.order_id, i.price from items join prices as i on items.id = i.item_id on duplicate key update total = orders.total + items.price + i.price",
+		out: "insert into tenant_42_orders(id, total) select tenant_42_items.order_id, i.price from tenant_42_items join tenant_42_prices as i on tenant_42_items.id = i.item_id on duplicate key update total = tenant_42_orders.total + tenant_42_items.price + i.price",
+	}, {
+		in:  "insert into orders(id) (select c.id from c) on duplicate key update id = c.id",
+		out: "insert into tenant_42_orders(id) select tenant_42_c.id from tenant_42_c on duplicate key update id = tenant_42_c.id",
+	}, {
+		in:  "update orders set orders.total = 0 where orders.id = 1",
+		out: "update tenant_42_orders set tenant_42_orders.total = 0 where tenant_42_orders.id = 1",
+	}, {
+		in:  "delete orders, i from orders join items as i on orders.id = i.order_id",
+		out: "delete tenant_42_orders, i from tenant_42_orders join tenant_42_items as i on tenant_42_orders.id = i.order_id",
+	}, {
+		in:  "rename table orders to old_orders",
+		out: "rename table tenant_42_orders to tenant_42_old_orders",
+	}, {
+		in:  "lock tables orders read, items as i write",
+		out: "lock tables tenant_42_orders read, tenant_42_items as i write",
+	}, {
+		in:  "describe db.orders id",
+		out: "describe db.tenant_42_orders id",
+	}, {
+		in:  "check table orders, db.items quick",
+		out: "check table tenant_42_orders, db.tenant_42_items quick",
+	}, {
+		in:  "cache index orders index (i1), db.items in hot_cache",
+		out: "cache index tenant_42_orders index (i1), db.tenant_42_items in hot_cache",
+	}, {
+		in:  "insert into orders values (default, 1)",
+		out: "insert into tenant_42_orders values (default, 1)",
+	}, {
+		in:  "create table items (id int, order_id int, foreign key (order_id) references orders (id))",
+		out: "create table tenant_42_items (\n\tid int,\n\torder_id int,\n\tforeign key (order_id) references tenant_42_orders (id)\n)",
+	}, {
+		in:  "alter table items add constraint fk foreign key (order_id) references db.orders (id)",
+		out: "alter table tenant_42_items add constraint fk foreign key (order_id) references db.tenant_42_orders (id)",
+	}, {
+		in:  "insert into orders set total = default",
+		out: "insert into tenant_42_orders set total = default",
+	}, {
+		in:  "update orders set total = default",
+		out: "update tenant_42_orders set total = default",
+	}, {
+		in:  "set charset default",
+		out: "set charset default",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if err := RewriteTableNames(tree, mapper); err != nil {
+			t.Errorf("RewriteTableNames(%s) err: %v", tcase.in, err)
+			continue
+		}
+		if got := String(tree); got != tcase.out {
+			t.Errorf("RewriteTableNames(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestRewriteTableNamesUnmapped(t *testing.T) {
+	// The mapper is only called for tables, never for aliases.
+	tree, err := Parse("select a.id from orders as a join items on a.id = items.order_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seen []string
+	_ = RewriteTableNames(tree, func(name TableName) TableName {
+		seen = append(seen, String(name))
+		return name
+	})
+	if got, want := strings.Join(seen, ","), "orders,items,items"; got != want {
+		t.Errorf("mapped: %s, want %s", got, want)
+	}
+}
+
+func TestExtractTables(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select o.id from orders as o join db.items on o.id = items.order_id where exists (select 1 from orders)",
+		out: "orders,db.items",
+	}, {
+		in:  "insert into orders(id) select id from items",
+		out: "orders,items",
+	}, {
+		// The row alias is not a table, even if a table has its name.
+		in:  "insert into orders(id, total) values (1, 2) as items on duplicate key update total = items.total + orders.total",
+		out: "orders",
+	}, {
+		in:  "with c as (select * from t) select * from c join u on c.a = u.a",
+		out: "t,u",
+	}, {
+		in:  "checksum table orders, items",
+		out: "orders,items",
+	}, {
+		in:  "load index into cache orders partition (p1), db.items ignore leaves",
+		out: "orders,db.items",
+	}, {
+		in:  "((select id from orders) union select id from db.items)",
+		out: "orders,db.items",
+	}, {
+		in:  "select 1 from dual",
+		out: "dual",
+	}, {
+		in:  "set autocommit = 1",
+		out: "",
+	}, {
+		in:  "insert into orders values (default, 1)",
+		out: "orders",
+	}, {
+		in:  "create table items (\n\tid int,\n\torder_id int,\n\tforeign key (order_id) references orders (id)\n)",
+		out: "items,orders",
+	}, {
+		in:  "alter table items add foreign key (order_id) references orders (id)",
+		out: "items,orders",
+	}, {
+		in:  "update orders set total = default",
+		out: "orders",
+	}, {
+		in:  "set charset default",
+		out: "",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		var names []string
+		for _, name := range ExtractTables(tree) {
+			names = append(names, String(name))
+		}
+		if got := strings.Join(names, ","); got != tcase.out {
+			t.Errorf("ExtractTables(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+		if got := String(tree); got != tcase.in {
+			t.Errorf("ExtractTables(%s) modified its input: %s", tcase.in, got)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package sqlparser
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+// sampleTupleSize is the number of values of the samples of the list
+// bind variables, like the ::bv1 of a in ::bv1.
+const sampleTupleSize = 2
+
+// SampleBindVariables returns plausible values for the bind variables
+// of stmt, like the ones Normalize makes, to run EXPLAIN on it: see
+// SampleQuery. The value of a bind variable depends on how it's used:
+//
+//   - compared with a column, or assigned to one, it's a value of the
+//     type of the column in schema, like 1 for an INT or '2000-01-01'
+//     for a DATE;
+//   - in a LIMIT, it's 10, or 0 for the offset;
+//   - as the pattern of a LIKE, it's 'sample%'.
+//
+// A list bind variable, like the ::bv1 of a in ::bv1, gets a tuple of
+// such values. The bind variables used in other ways, or compared with
+// columns of different types, get a string: use
+// SampleBindVariablesWithReport to find them.
+func SampleBindVariables(stmt Statement, schema *Schema) map[string]*querypb.BindVariable {
+	bindVars, _ := SampleBindVariablesWithReport(stmt, schema)
+	return bindVars
+}
+
+// SampleBindVariablesWithReport is SampleBindVariables, except that it
+// also returns the bind variables whose usage is unknown, and why, by
+// name.
+func SampleBindVariablesWithReport(stmt Statement, schema *Schema) (map[string]*querypb.BindVariable, []SkippedBindVar) {
+	c := &bindVarCoercer{schema: schema, types: make(map[string][]querypb.Type)}
+	lists := make(map[string]bool)
+	samples := make(map[string]sqltypes.Value)
+	_ = WalkWithPath(func(node SQLNode, path []SQLNode) (bool, error) {
+		c.collect(node, path)
+		switch node := node.(type) {
+		case *SQLVal:
+			if name, ok := valArgName(node); ok && !lists[name] {
+				lists[name] = false
+			}
+		case ListArg:
+			lists[string(node[2:])] = true
+		case *Limit:
+			if node == nil {
+				break
+			}
+			if name, ok := valArgName(node.Rowcount); ok {
+				samples[name] = sqltypes.NewInt64(10)
+			}
+			if name, ok := valArgName(node.Offset); ok {
+				samples[name] = sqltypes.NewInt64(0)
+			}
+		case *ComparisonExpr:
+			if node.Operator != LikeStr && node.Operator != NotLikeStr {
+				break
+			}
+			if name, ok := valArgName(node.Right); ok {
+				if _, ok := samples[name]; !ok {
+					samples[name] = sqltypes.NewVarChar("sample%")
+				}
+			}
+		}
+		return true, nil
+	}, stmt)
+
+	names := make([]string, 0, len(lists))
+	for name := range lists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	bindVars := make(map[string]*querypb.BindVariable, len(names))
+	var unknown []SkippedBindVar
+	for _, name := range names {
+		if sample, ok := samples[name]; ok {
+			bindVars[name] = sampleBindVariable(lists[name], func(int) sqltypes.Value { return sample })
+			continue
+		}
+		types := c.types[name]
+		typ := sqltypes.VarChar
+		switch {
+		case len(types) == 1:
+			typ = types[0]
+		case len(types) > 1:
+			typeNames := make([]string, len(types))
+			for i, typ := range types {
+				typeNames[i] = typ.String()
+			}
+			unknown = append(unknown, SkippedBindVar{
+				Name:   name,
+				Reason: "compared with columns of different types " + strings.Join(typeNames, ", "),
+			})
+		default:
+			unknown = append(unknown, SkippedBindVar{Name: name, Reason: "unknown usage"})
+		}
+		bindVars[name] = sampleBindVariable(lists[name], func(n int) sqltypes.Value { return sampleValue(typ, n) })
+	}
+	return bindVars, unknown
+}
+
+// SampleQuery returns the SQL of stmt with the values of
+// SampleBindVariables in place of its bind variables, to run EXPLAIN
+// on it.
+func SampleQuery(stmt Statement, schema *Schema) (string, error) {
+	query, err := NewParsedQuery(stmt).GenerateQuery(SampleBindVariables(stmt, schema), nil)
+	if err != nil {
+		return "", err
+	}
+	return string(query), nil
+}
+
+// valArgName returns the name of the bind variable expr, if it's one.
+func valArgName(expr Expr) (string, bool) {
+	if val, ok := expr.(*SQLVal); ok && val.Type == ValArg {
+		return string(val.Val[1:]), true
+	}
+	return "", false
+}
+
+// sampleBindVariable returns the bind variable of the first sample
+// value, or a tuple of the first values for a list.
+func sampleBindVariable(list bool, value func(n int) sqltypes.Value) *querypb.BindVariable {
+	if !list {
+		return sqltypes.ValueBindVariable(value(1))
+	}
+	bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	for n := 1; n <= sampleTupleSize; n++ {
+		v := value(n)
+		bv.Values = append(bv.Values, &querypb.Value{Type: v.Type(), Value: v.Raw()})
+	}
+	return bv
+}
+
+// sampleValue returns the n-th sample value of typ, from 1: the values
+// differ so that the tuples don't repeat one.
+func sampleValue(typ querypb.Type, n int) sqltypes.Value {
+	num := strconv.Itoa(n)
+	switch {
+	case typ == sqltypes.Year:
+		return sqltypes.MakeTrusted(typ, []byte(strconv.Itoa(2000+n)))
+	case sqltypes.IsIntegral(typ), sqltypes.IsFloat(typ), typ == sqltypes.Decimal, typ == sqltypes.Bit:
+		return sqltypes.MakeTrusted(typ, []byte(num))
+	case typ == sqltypes.Date:
+		return sqltypes.MakeTrusted(typ, []byte("2000-01-0"+num))
+	case typ == sqltypes.Datetime, typ == sqltypes.Timestamp:
+		return sqltypes.MakeTrusted(typ, []byte("2000-01-0"+num+" 00:00:00"))
+	case typ == sqltypes.Time:
+		return sqltypes.MakeTrusted(typ, []byte("00:00:0"+num))
+	case typ == sqltypes.TypeJSON:
+		return sqltypes.MakeTrusted(typ, []byte("{}"))
+	case sqltypes.IsBinary(typ):
+		return sqltypes.MakeTrusted(typ, []byte("sample"+num))
+	}
+	return sqltypes.NewVarChar("sample" + num)
+}
@@ -0,0 +1,107 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func TestSampleBindVariables(t *testing.T) {
+	ddl, err := Parse("create table t (id bigint unsigned, name varchar(10), code varbinary(10), price decimal(10, 2), created datetime, day date, y year)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := NewSchema()
+	schema.AddTable("t", ddl.(*DDL).TableSpec)
+	schema.AddColumn("u", "id", sqltypes.Int32)
+
+	testcases := []struct {
+		in      string
+		out     map[string]*querypb.BindVariable
+		unknown []SkippedBindVar
+	}{{
+		in: "select * from t where id = :id and name = :name and code = :code and price > :price and created < :created and day = :day and y = :y",
+		out: map[string]*querypb.BindVariable{
+			"id":      {Type: sqltypes.Uint64, Value: []byte("1")},
+			"name":    {Type: sqltypes.VarChar, Value: []byte("sample1")},
+			"code":    {Type: sqltypes.VarBinary, Value: []byte("sample1")},
+			"price":   {Type: sqltypes.Decimal, Value: []byte("1")},
+			"created": {Type: sqltypes.Datetime, Value: []byte("2000-01-01 00:00:00")},
+			"day":     {Type: sqltypes.Date, Value: []byte("2000-01-01")},
+			"y":       {Type: sqltypes.Year, Value: []byte("2001")},
+		},
+	}, {
+		in: "select * from t where name like :pattern and id in ::ids limit :offset, :count",
+		out: map[string]*querypb.BindVariable{
+			"pattern": sqltypes.StringBindVariable("sample%"),
+			"ids": {
+				Type: querypb.Type_TUPLE,
+				Values: []*querypb.Value{
+					{Type: sqltypes.Uint64, Value: []byte("1")},
+					{Type: sqltypes.Uint64, Value: []byte("2")},
+				},
+			},
+			"offset": sqltypes.Int64BindVariable(0),
+			"count":  sqltypes.Int64BindVariable(10),
+		},
+	}, {
+		in: "update t set name = :name where day in ::days",
+		out: map[string]*querypb.BindVariable{
+			"name": {Type: sqltypes.VarChar, Value: []byte("sample1")},
+			"days": {
+				Type: querypb.Type_TUPLE,
+				Values: []*querypb.Value{
+					{Type: sqltypes.Date, Value: []byte("2000-01-01")},
+					{Type: sqltypes.Date, Value: []byte("2000-01-02")},
+				},
+			},
+		},
+	}, {
+		in: "select * from t join u on t.id = u.id where t.id = :a or u.id = :b or t.id = :c or u.id = :c or concat(name, :d) = 'x'",
+		out: map[string]*querypb.BindVariable{
+			"a": {Type: sqltypes.Uint64, Value: []byte("1")},
+			"b": {Type: sqltypes.Int32, Value: []byte("1")},
+			"c": sqltypes.StringBindVariable("sample1"),
+			"d": sqltypes.StringBindVariable("sample1"),
+		},
+		unknown: []SkippedBindVar{
+			{Name: "c", Reason: "compared with columns of different types UINT64, INT32"},
+			{Name: "d", Reason: "unknown usage"},
+		},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		out, unknown := SampleBindVariablesWithReport(stmt, schema)
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("SampleBindVariables(%s):\n%v, want\n%v", tcase.in, out, tcase.out)
+		}
+		if !reflect.DeepEqual(unknown, tcase.unknown) {
+			t.Errorf("SampleBindVariables(%s) unknown: %v, want %v", tcase.in, unknown, tcase.unknown)
+		}
+	}
+}
+
+func TestSampleQuery(t *testing.T) {
+	schema := NewSchema()
+	schema.AddColumn("t", "id", sqltypes.Int64)
+	schema.AddColumn("t", "day", sqltypes.Date)
+
+	stmt, err := Parse("select * from t where id in ::ids and day > :day and name like :name limit :n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := SampleQuery(stmt, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "select * from t where id in (1, 2) and day > '2000-01-01' and name like 'sample%' limit 10"
+	if got != want {
+		t.Errorf("SampleQuery: %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,237 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+// FindingKind is the kind of a Finding.
+type FindingKind int
+
+// These are the possible FindingKind values.
+const (
+	// FindingWrappedColumn is reported for a column that is an
+	// argument of a function or an operand of an operator on its side
+	// of a comparison, like date(created) = '2020-01-01': the index on
+	// the column can't be used.
+	FindingWrappedColumn = FindingKind(iota + 1)
+	// FindingImplicitConversion is reported for a column compared to
+	// a literal of another type, like a numeric column to a string.
+	// A string column compared to a number is converted for every
+	// row, and can't use its index.
+	FindingImplicitConversion
+	// FindingLeadingWildcard is reported for a LIKE on a column whose
+	// pattern starts with a wildcard, see AnalyzeLikePattern.
+	FindingLeadingWildcard
+)
+
+// Finding is a finding of FindNonSargablePredicates. Expr is the
+// predicate, as it is in the AST, and Column the column that it keeps
+// from using an index. Message says why, and Suggestion how the
+// predicate could be written instead.
+type Finding struct {
+	Kind       FindingKind
+	Column     *ColName
+	Expr       Expr
+	Message    string
+	Suggestion string
+}
+
+// FindNonSargablePredicates looks for the predicates of where that
+// can't use an index on their column: comparisons, BETWEEN and LIKE
+// whose column is wrapped in a function or an expression, and LIKE
+// patterns that start with a wildcard. A predicate that wraps several
+// columns gets a finding for each. The predicates of subqueries are
+// not analyzed.
+//
+// Use FindNonSargablePredicatesWithSchema to also find the columns
+// compared to literals of another type.
+func FindNonSargablePredicates(where *Where) []Finding {
+	return FindNonSargablePredicatesWithSchema(where, nil)
+}
+
+// FindNonSargablePredicatesWithSchema is the same as
+// FindNonSargablePredicates, except that the types of the columns are
+// looked up in schema to find the columns compared to a literal of
+// another type: a string to a numeric column, or a number to a string
+// column.
+func FindNonSargablePredicatesWithSchema(where *Where, schema *Schema) []Finding {
+	if where == nil || where.Expr == nil {
+		return nil
+	}
+	var findings []Finding
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *ComparisonExpr:
+			findings = append(findings, wrappedColumnFindings(node, node.Left, node.Right)...)
+			findings = append(findings, wrappedColumnFindings(node, node.Right, node.Left)...)
+			if finding, ok := leadingWildcardFinding(node); ok {
+				findings = append(findings, finding)
+			}
+			findings = append(findings, conversionFindings(node, schema)...)
+		case *RangeCond:
+			findings = append(findings, wrappedColumnFindings(node, node.Left, nil)...)
+		}
+		return true, nil
+	}, where.Expr)
+	return findings
+}
+
+// wrappedColumnFindings returns a finding for every column of side, the
+// side of the predicate pred that is compared to other, if side is not
+// just a column. other is nil for a BETWEEN.
+func wrappedColumnFindings(pred Expr, side, other Expr) []Finding {
+	side = unwrapColumnSide(side)
+	if tuple, ok := side.(ValTuple); ok {
+		var findings []Finding
+		for _, expr := range tuple {
+			findings = append(findings, wrappedColumnFindings(pred, expr, nil)...)
+		}
+		return findings
+	}
+	switch side.(type) {
+	case *ColName, *SQLVal, *NullVal, BoolVal, ValTuple, *Subquery, ListArg:
+		return nil
+	}
+	var findings []Finding
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *ColName:
+			findings = append(findings, Finding{
+				Kind:       FindingWrappedColumn,
+				Column:     node,
+				Expr:       pred,
+				Message:    fmt.Sprintf("%s is wrapped in %s", String(node), String(side)),
+				Suggestion: wrappedColumnSuggestion(pred, side, other, node),
+			})
+		}
+		return true, nil
+	}, side)
+	return findings
+}
+
+// unwrapColumnSide removes the parentheses and the COLLATE clauses
+// around expr, which don't keep an index from being used.
+func unwrapColumnSide(expr Expr) Expr {
+	for {
+		switch node := expr.(type) {
+		case *ParenExpr:
+			expr = node.Expr
+		case *CollateExpr:
+			expr = node.Expr
+		default:
+			return expr
+		}
+	}
+}
+
+// wrappedColumnSuggestion returns how the predicate pred on col could
+// use an index.
+func wrappedColumnSuggestion(pred, side, other Expr, col *ColName) string {
+	colStr := String(col)
+	cmp, isCmp := pred.(*ComparisonExpr)
+	fn, isFunc := side.(*FuncExpr)
+	if isFunc && len(fn.Exprs) == 1 && isCmp && cmp.Operator == EqualStr {
+		lit, isLit := unwrapColumnSide(other).(*SQLVal)
+		switch {
+		case fn.Name.EqualString("date") && isLit && lit.Type == StrVal:
+			return fmt.Sprintf("compare the column to a range: %s >= %s and %s < %s + interval 1 day", colStr, String(lit), colStr, String(lit))
+		case fn.Name.EqualString("year") && isLit && lit.Type == IntVal:
+			if year, err := strconv.Atoi(string(lit.Val)); err == nil {
+				return fmt.Sprintf("compare the column to a range: %s >= '%d-01-01' and %s < '%d-01-01'", colStr, year, colStr, year+1)
+			}
+		}
+	}
+	if isFunc && (fn.Name.EqualString("lower") || fn.Name.EqualString("upper")) {
+		return fmt.Sprintf("compare %s with a case-insensitive collation, or index a generated column on %s", colStr, String(side))
+	}
+	return fmt.Sprintf("move the computation to the other side of the comparison, or index a generated column on %s", String(side))
+}
+
+// leadingWildcardFinding returns a finding if cmp is a LIKE of a column
+// whose pattern starts with a wildcard.
+func leadingWildcardFinding(cmp *ComparisonExpr) (Finding, bool) {
+	col, ok := unwrapColumnSide(cmp.Left).(*ColName)
+	if !ok || cmp.Operator != LikeStr || AnalyzeLikePattern(cmp).Type != LikeLeadingWildcard {
+		return Finding{}, false
+	}
+	return Finding{
+		Kind:       FindingLeadingWildcard,
+		Column:     col,
+		Expr:       cmp,
+		Message:    fmt.Sprintf("%s is matched with the leading wildcard of %s", String(col), String(cmp.Right)),
+		Suggestion: "use a full-text index, or match a column that stores the reversed value to search by suffix",
+	}, true
+}
+
+// conversionFindings returns a finding for every column of cmp that is
+// compared to a literal of another type. The types of the columns are
+// looked up in schema.
+func conversionFindings(cmp *ComparisonExpr, schema *Schema) []Finding {
+	if schema == nil {
+		return nil
+	}
+	var findings []Finding
+	check := func(side, other Expr) {
+		col, ok := unwrapColumnSide(side).(*ColName)
+		if !ok {
+			return
+		}
+		typ, ok := schema.ColumnType(col)
+		if !ok {
+			return
+		}
+		var values []Expr
+		switch other := other.(type) {
+		case ValTuple:
+			values = other
+		default:
+			values = []Expr{other}
+		}
+		for _, value := range values {
+			lit, ok := value.(*SQLVal)
+			if !ok {
+				continue
+			}
+			switch {
+			case isNumericType(typ) && lit.Type == StrVal:
+				findings = append(findings, Finding{
+					Kind:       FindingImplicitConversion,
+					Column:     col,
+					Expr:       cmp,
+					Message:    fmt.Sprintf("%s is numeric but compared to the string %s", String(col), String(lit)),
+					Suggestion: "compare the column to a number",
+				})
+			case (sqltypes.IsText(typ) || sqltypes.IsBinary(typ)) && (lit.Type == IntVal || lit.Type == FloatVal || lit.Type == DecimalVal):
+				findings = append(findings, Finding{
+					Kind:       FindingImplicitConversion,
+					Column:     col,
+					Expr:       cmp,
+					Message:    fmt.Sprintf("%s is a string but compared to the number %s, which converts it for every row", String(col), String(lit)),
+					Suggestion: "compare the column to a string",
+				})
+			default:
+				continue
+			}
+			return
+		}
+	}
+	check(cmp.Left, cmp.Right)
+	if _, ok := cmp.Right.(ValTuple); !ok {
+		check(cmp.Right, cmp.Left)
+	}
+	return findings
+}
+
+// isNumericType returns true for the integral, floating point and
+// decimal types.
+func isNumericType(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
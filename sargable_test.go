@@ -0,0 +1,91 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func TestFindNonSargablePredicates(t *testing.T) {
+	schema := NewSchema()
+	schema.AddColumn("t", "id", sqltypes.Int64)
+	schema.AddColumn("t", "price", sqltypes.Decimal)
+	schema.AddColumn("t", "code", sqltypes.VarChar)
+	schema.AddColumn("t", "created", sqltypes.Datetime)
+
+	kinds := map[FindingKind]string{
+		FindingWrappedColumn:      "wrapped",
+		FindingImplicitConversion: "conversion",
+		FindingLeadingWildcard:    "wildcard",
+	}
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in: "select * from t where id = 1 and (code) like 'a%' and created >= now() - interval 1 day and code = 'x' collate utf8mb4_bin",
+	}, {
+		in: "select * from t where date(created) = '2020-01-01'",
+		out: []string{
+			"wrapped created: created is wrapped in date(created); compare the column to a range: created >= '2020-01-01' and created < '2020-01-01' + interval 1 day",
+		},
+	}, {
+		in: "select * from t where 2020 = year(t.created)",
+		out: []string{
+			"wrapped t.created: t.created is wrapped in year(t.created); compare the column to a range: t.created >= '2020-01-01' and t.created < '2021-01-01'",
+		},
+	}, {
+		in: "select * from t where lower(code) = 'abc' or id + 1 between 2 and 5",
+		out: []string{
+			"wrapped code: code is wrapped in lower(code); compare code with a case-insensitive collation, or index a generated column on lower(code)",
+			"wrapped id: id is wrapped in id + 1; move the computation to the other side of the comparison, or index a generated column on id + 1",
+		},
+	}, {
+		in: "select * from t where concat(code, id) = code",
+		out: []string{
+			"wrapped code: code is wrapped in concat(code, id); move the computation to the other side of the comparison, or index a generated column on concat(code, id)",
+			"wrapped id: id is wrapped in concat(code, id); move the computation to the other side of the comparison, or index a generated column on concat(code, id)",
+		},
+	}, {
+		in: "select * from t where code like '%abc' and code not like '%abc' and code like '\\%abc'",
+		out: []string{
+			"wildcard code: code is matched with the leading wildcard of '%abc'; use a full-text index, or match a column that stores the reversed value to search by suffix",
+		},
+	}, {
+		in: "select * from t where id = '1' and price in (1, '2.5') and code = 12 and '3' < t.id and created = '2020-01-01'",
+		out: []string{
+			"conversion id: id is numeric but compared to the string '1'; compare the column to a number",
+			"conversion price: price is numeric but compared to the string '2.5'; compare the column to a number",
+			"conversion code: code is a string but compared to the number 12, which converts it for every row; compare the column to a string",
+			"conversion t.id: t.id is numeric but compared to the string '3'; compare the column to a number",
+		},
+	}, {
+		in: "select * from t where id in (select id from u where lower(name) = 'x')",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		var out []string
+		for _, finding := range FindNonSargablePredicatesWithSchema(stmt.(*Select).Where, schema) {
+			out = append(out, kinds[finding.Kind]+" "+String(finding.Column)+": "+finding.Message+"; "+finding.Suggestion)
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("FindNonSargablePredicates(%s):\n%q, want\n%q", tcase.in, out, tcase.out)
+		}
+	}
+
+	stmt, err := Parse("select * from t where id = '1' and upper(code) = 'X'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	findings := FindNonSargablePredicates(stmt.(*Select).Where)
+	if len(findings) != 1 || findings[0].Kind != FindingWrappedColumn || String(findings[0].Expr) != "upper(code) = 'X'" {
+		t.Errorf("FindNonSargablePredicates without a schema: %+v", findings)
+	}
+	if findings := FindNonSargablePredicates(nil); findings != nil {
+		t.Errorf("FindNonSargablePredicates(nil): %+v, want nil", findings)
+	}
+}
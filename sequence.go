@@ -0,0 +1,108 @@
+package sqlparser
+
+import "strconv"
+
+// SequenceAccess describes a statement that fetches values of a
+// sequence: "select next N values from seq", or "select next value
+// for seq" to fetch a single value.
+type SequenceAccess struct {
+	Sequence TableName
+	// Count is the number of values: an integer,
+	// or a bind variable.
+	Count Expr
+}
+
+// GetSequenceAccess returns the sequence access of stmt,
+// if it's a statement that fetches values of a sequence.
+// A NEXT VALUE FOR that is selected from a table, or
+// with other expressions, is not a sequence access.
+func GetSequenceAccess(stmt Statement) (*SequenceAccess, bool) {
+	sel, ok := stmt.(*Select)
+	if !ok || len(sel.SelectExprs) != 1 || len(sel.From) != 1 {
+		return nil, false
+	}
+	table, ok := sel.From[0].(*AliasedTableExpr)
+	if !ok {
+		return nil, false
+	}
+	name, ok := table.Expr.(TableName)
+	if !ok {
+		return nil, false
+	}
+	switch expr := sel.SelectExprs[0].(type) {
+	case Nextval:
+		return &SequenceAccess{Sequence: name, Count: expr.Expr}, true
+	case *AliasedExpr:
+		next, ok := expr.Expr.(*NextValueExpr)
+		if !ok || !expr.As.IsEmpty() || !name.Qualifier.IsEmpty() || name.Name.String() != "dual" {
+			return nil, false
+		}
+		return &SequenceAccess{Sequence: next.Sequence, Count: NewIntVal([]byte("1"))}, true
+	}
+	return nil, false
+}
+
+// AutoIncrementColumn returns the auto_increment column of spec,
+// if it has one.
+func AutoIncrementColumn(spec *TableSpec) (ColIdent, bool) {
+	for _, col := range spec.Columns {
+		if col.Type.Autoincrement {
+			return col.Name, true
+		}
+	}
+	return ColIdent{}, false
+}
+
+// OmitsAutoIncrement returns true if ins doesn't set the auto_increment
+// column of spec, the table it inserts into, so that the value has to
+// be generated, by a sequence for example. An insert without a column
+// list sets all the columns.
+func OmitsAutoIncrement(ins *Insert, spec *TableSpec) bool {
+	col, ok := AutoIncrementColumn(spec)
+	if !ok {
+		return false
+	}
+	if len(ins.SetExprs) != 0 {
+		for _, expr := range ins.SetExprs {
+			if expr.Name.Name.Equal(col) {
+				return false
+			}
+		}
+		return true
+	}
+	return len(ins.Columns) != 0 && ins.Columns.FindColumn(col) < 0
+}
+
+// AddAutoIncrementValues adds the auto_increment column of spec to ins
+// if OmitsAutoIncrement, with a bind variable for the value of every
+// row. The bind variables are named after prefix followed by the
+// number of the row, starting at 1, and their names are returned.
+// It returns nothing if ins already sets the column.
+//
+// The values of an INSERT ... SELECT can't be added.
+func AddAutoIncrementValues(ins *Insert, spec *TableSpec, prefix string) ([]string, error) {
+	if !OmitsAutoIncrement(ins, spec) {
+		return nil, nil
+	}
+	col, _ := AutoIncrementColumn(spec)
+	if len(ins.SetExprs) != 0 {
+		name := prefix + "1"
+		ins.SetExprs = append(ins.SetExprs, &UpdateExpr{
+			Name: &ColName{Name: col},
+			Expr: NewValArg([]byte(":" + name)),
+		})
+		return []string{name}, nil
+	}
+	rows, ok := ins.Rows.(Values)
+	if !ok {
+		return nil, newError(ErrUnsupported, "cannot add auto increment values to %s", String(ins.Rows))
+	}
+	names := make([]string, 0, len(rows))
+	for i := range rows {
+		name := prefix + strconv.Itoa(i+1)
+		rows[i] = append(rows[i], NewValArg([]byte(":"+name)))
+		names = append(names, name)
+	}
+	ins.Columns = append(ins.Columns, col)
+	return names, nil
+}
@@ -0,0 +1,120 @@
+package sqlparser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGetSequenceAccess(t *testing.T) {
+	testcases := []struct {
+		in       string
+		sequence string
+		count    string
+	}{{
+		in:       "select next 5 values from seq",
+		sequence: "seq",
+		count:    "5",
+	}, {
+		in:       "select next value for ks.seq",
+		sequence: "ks.seq",
+		count:    "1",
+	}, {
+		in:       "select next value for seq from dual",
+		sequence: "seq",
+		count:    "1",
+	}, {
+		in:       "select next value from seq",
+		sequence: "seq",
+		count:    "1",
+	}, {
+		in: "select next value for seq from t",
+	}, {
+		in: "select next value for seq as id",
+	}, {
+		in:       "select next :n values from seq",
+		sequence: "seq",
+		count:    ":n",
+	}, {
+		in: "select a from seq",
+	}, {
+		in: "insert into seq values (1)",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		access, ok := GetSequenceAccess(stmt)
+		if !ok {
+			if tcase.sequence != "" {
+				t.Errorf("GetSequenceAccess(%s) failed", tcase.in)
+			}
+			continue
+		}
+		if String(access.Sequence) != tcase.sequence || String(access.Count) != tcase.count {
+			t.Errorf("GetSequenceAccess(%s): %v, %v, want %s, %s", tcase.in, String(access.Sequence), String(access.Count), tcase.sequence, tcase.count)
+		}
+	}
+}
+
+func TestAddAutoIncrementValues(t *testing.T) {
+	ddl, err := Parse("create table t (id bigint auto_increment, a int, b int, primary key (id))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := ddl.(*DDL).TableSpec
+	testcases := []struct {
+		in      string
+		omitted bool
+		out     string
+		names   []string
+		err     error
+	}{{
+		in:      "insert into t(a, b) values (1, 2), (3, 4)",
+		omitted: true,
+		out:     "insert into t(a, b, id) values (1, 2, :seq1), (3, 4, :seq2)",
+		names:   []string{"seq1", "seq2"},
+	}, {
+		in:      "insert into t set a = 1, b = 2",
+		omitted: true,
+		out:     "insert into t set a = 1, b = 2, id = :seq1",
+		names:   []string{"seq1"},
+	}, {
+		in:  "insert into t(ID, a) values (null, 1)",
+		out: "insert into t(ID, a) values (null, 1)",
+	}, {
+		in:  "insert into t set id = 1, a = 1",
+		out: "insert into t set id = 1, a = 1",
+	}, {
+		in:  "insert into t values (1, 2, 3)",
+		out: "insert into t values (1, 2, 3)",
+	}, {
+		in:      "insert into t(a) select a from u",
+		omitted: true,
+		out:     "insert into t(a) select a from u",
+		err:     ErrUnsupported,
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		ins := stmt.(*Insert)
+		if got := OmitsAutoIncrement(ins, spec); got != tcase.omitted {
+			t.Errorf("OmitsAutoIncrement(%s): %v, want %v", tcase.in, got, tcase.omitted)
+		}
+		names, err := AddAutoIncrementValues(ins, spec, "seq")
+		if !errors.Is(err, tcase.err) {
+			t.Errorf("AddAutoIncrementValues(%s) err: %v, want %v", tcase.in, err, tcase.err)
+		}
+		if String(ins) != tcase.out {
+			t.Errorf("AddAutoIncrementValues(%s):\n%s, want\n%s", tcase.in, String(ins), tcase.out)
+		}
+		if !reflect.DeepEqual(names, tcase.names) {
+			t.Errorf("AddAutoIncrementValues(%s): %v, want %v", tcase.in, names, tcase.names)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package sqlparser
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// ServerLimits are the limits of a server that ValidateLimits checks.
+// The lengths count characters, not bytes. A limit of 0 is not checked.
+type ServerLimits struct {
+	// MaxIdentifierLen is the longest name of a database, a table,
+	// a column, or of another object, and of a table alias.
+	MaxIdentifierLen int
+	// MaxAliasLen is the longest alias of a select expression.
+	MaxAliasLen int
+	// MaxIndexNameLen is the longest name of an index or of a
+	// constraint.
+	MaxIndexNameLen int
+	// MaxJoinTables is the largest number of tables that a single
+	// select, update or delete can join.
+	MaxJoinTables int
+	// MaxIndexColumns is the largest number of columns of an index.
+	MaxIndexColumns int
+	// MaxTableKeyParts is the largest number of columns of all the
+	// indexes of a table. Only the indexes that the statement
+	// defines are counted.
+	MaxTableKeyParts int
+}
+
+// DialectServerLimits holds the ServerLimits that ValidateLimits
+// checks for each dialect. The entries can be changed, like for a
+// server built with other limits. The dialects without an entry are
+// not checked.
+var DialectServerLimits = map[Dialect]ServerLimits{
+	MySQLDialect: {
+		MaxIdentifierLen: 64,
+		MaxAliasLen:      256,
+		MaxIndexNameLen:  64,
+		MaxJoinTables:    61,
+		MaxIndexColumns:  16,
+		MaxTableKeyParts: 64 * 16,
+	},
+	MariaDBDialect: {
+		MaxIdentifierLen: 64,
+		MaxAliasLen:      256,
+		MaxIndexNameLen:  64,
+		MaxJoinTables:    61,
+		MaxIndexColumns:  32,
+		MaxTableKeyParts: 64 * 32,
+	},
+	PostgresDialect: {
+		MaxIdentifierLen: 63,
+		MaxAliasLen:      63,
+		MaxIndexNameLen:  63,
+		MaxIndexColumns:  32,
+	},
+	SQLServerDialect: {
+		MaxIdentifierLen: 128,
+		MaxAliasLen:      128,
+		MaxIndexNameLen:  128,
+		MaxIndexColumns:  32,
+	},
+}
+
+// ValidationError is a part of a statement that exceeds one of the
+// ServerLimits, see ValidateLimits.
+type ValidationError struct {
+	// Node is the node that exceeds the limit: the identifier, the
+	// FROM clause that joins too many tables, or the definition of
+	// the index.
+	Node SQLNode
+	// Limit is the name of the field of ServerLimits that is
+	// exceeded, like "MaxIdentifierLen", and Max its value.
+	Limit   string
+	Max     int
+	Message string
+}
+
+// Error returns the message of the error.
+func (e ValidationError) Error() string {
+	return e.Message
+}
+
+// ValidateLimits returns the parts of stmt that exceed the limits of
+// MySQL, see ValidateLimitsWithDialect.
+func ValidateLimits(stmt Statement) []ValidationError {
+	return ValidateLimitsWithDialect(stmt, MySQLDialect)
+}
+
+// ValidateLimitsWithDialect returns the parts of stmt that exceed the
+// ServerLimits of dialect in DialectServerLimits, in the order they're
+// found: the identifiers and the aliases that are too long, the
+// selects, updates and deletes that join too many tables, and the
+// indexes that have too long a name or too many columns. The tables
+// of the subqueries are not counted with the ones of their query.
+func ValidateLimitsWithDialect(stmt Statement, dialect Dialect) []ValidationError {
+	limits, ok := DialectServerLimits[dialect]
+	if !ok {
+		return nil
+	}
+	v := &limitValidator{limits: limits}
+	var walk func(parent SQLNode) Visit
+	walk = func(parent SQLNode) Visit {
+		return func(node SQLNode) (bool, error) {
+			v.checkNode(node, parent)
+			return false, node.walkSubtree(walk(node))
+		}
+	}
+	_ = Walk(walk(nil), stmt)
+	if ddl, ok := stmt.(*DDL); ok {
+		_ = Walk(walk(ddl), ddl.TableSpec)
+		v.checkIndexes(ddl)
+	}
+	return v.errs
+}
+
+type limitValidator struct {
+	limits ServerLimits
+	errs   []ValidationError
+}
+
+func (v *limitValidator) add(node SQLNode, limit string, max int, format string, args ...interface{}) {
+	v.errs = append(v.errs, ValidationError{
+		Node:    node,
+		Limit:   limit,
+		Max:     max,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// checkNode checks node, whose parent is parent.
+func (v *limitValidator) checkNode(node, parent SQLNode) {
+	switch node := node.(type) {
+	case ColIdent:
+		v.checkIdentifier(node, node.String(), parent)
+	case TableIdent:
+		v.checkIdentifier(node, node.String(), parent)
+	case *Select:
+		v.checkJoin(node.From)
+	case *Update:
+		v.checkJoin(node.TableExprs)
+	case *Delete:
+		v.checkJoin(node.TableExprs)
+	}
+}
+
+// checkIdentifier checks the length of name, the identifier node
+// whose parent is parent.
+func (v *limitValidator) checkIdentifier(node SQLNode, name string, parent SQLNode) {
+	what, limit, max := "identifier", "MaxIdentifierLen", v.limits.MaxIdentifierLen
+	switch parent := parent.(type) {
+	case *AliasedExpr:
+		what, limit, max = "alias", "MaxAliasLen", v.limits.MaxAliasLen
+	case *AliasedTableExpr:
+		what = "table alias"
+	case *ForeignKeyDefinition:
+		if fieldName(parent, node) != ".Source" {
+			// See checkIndexes.
+			return
+		}
+	case *AlterSpec:
+		switch parent.Action {
+		case RenameIndexStr, DropIndexStr, DropForeignKeyStr:
+			// See checkIndexes.
+			return
+		}
+	}
+	if n := utf8.RuneCountInString(name); max > 0 && n > max {
+		v.add(node, limit, max, "%s %s is %d characters long, which exceeds the limit of %d", what, truncateName(name), n, max)
+	}
+}
+
+// checkJoin checks the number of tables of exprs, the tables
+// of a select, an update or a delete.
+func (v *limitValidator) checkJoin(exprs TableExprs) {
+	max := v.limits.MaxJoinTables
+	if max == 0 {
+		return
+	}
+	n := 0
+	var count func(exprs ...TableExpr)
+	count = func(exprs ...TableExpr) {
+		for _, expr := range exprs {
+			switch expr := expr.(type) {
+			case *AliasedTableExpr:
+				n++
+			case *JoinTableExpr:
+				count(expr.LeftExpr, expr.RightExpr)
+			case *ParenTableExpr:
+				count(expr.Exprs...)
+			}
+		}
+	}
+	count(exprs...)
+	if n > max {
+		v.add(exprs, "MaxJoinTables", max, "join of %d tables exceeds the limit of %d", n, max)
+	}
+}
+
+// checkIndexes checks the names and the columns of the indexes
+// and of the constraints that ddl defines.
+func (v *limitValidator) checkIndexes(ddl *DDL) {
+	keyParts := make(map[TableName]int)
+	for _, def := range ListIndexDefinitions(ddl) {
+		what := "index"
+		if def.Kind == IndexDefForeignKey {
+			what = "constraint"
+		}
+		if n, max := utf8.RuneCountInString(def.Name), v.limits.MaxIndexNameLen; max > 0 && n > max {
+			v.add(def.Node, "MaxIndexNameLen", max, "%s name %s is %d characters long, which exceeds the limit of %d", what, truncateName(def.Name), n, max)
+		}
+		switch def.Kind {
+		case IndexDefForeignKey, IndexDefRename:
+			continue
+		}
+		if n, max := len(def.Columns), v.limits.MaxIndexColumns; max > 0 && n > max {
+			v.add(def.Node, "MaxIndexColumns", max, "index of %d columns exceeds the limit of %d", n, max)
+		}
+		keyParts[def.Table] += len(def.Columns)
+	}
+	for table, n := range keyParts {
+		if max := v.limits.MaxTableKeyParts; max > 0 && n > max {
+			v.add(ddl, "MaxTableKeyParts", max, "indexes of %d columns of table %s exceed the limit of %d", n, String(table), max)
+		}
+	}
+}
+
+// truncateName returns name quoted for a message, shortened if it's
+// long.
+func truncateName(name string) string {
+	const max = 16
+	if utf8.RuneCountInString(name) <= max {
+		return fmt.Sprintf("'%s'", name)
+	}
+	runes := []rune(name)
+	return fmt.Sprintf("'%s...'", string(runes[:max]))
+}
@@ -0,0 +1,114 @@
+package sqlparser
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateLimits(t *testing.T) {
+	long := strings.Repeat("a", 65)
+	// 64 characters, but 128 bytes.
+	wide := strings.Repeat("é", 64)
+	columns := make([]string, 17)
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%d", i)
+	}
+	tables := make([]string, 62)
+	for i := range tables {
+		tables[i] = fmt.Sprintf("t%d", i)
+	}
+	testcases := []struct {
+		in  string
+		out []string
+	}{{
+		in: "select a from t where b = 1",
+	}, {
+		in: "select `" + wide + "` from `" + wide + "`",
+	}, {
+		in:  "select " + long + " from t",
+		out: []string{"MaxIdentifierLen: identifier 'aaaaaaaaaaaaaaaa...' is 65 characters long, which exceeds the limit of 64"},
+	}, {
+		in: "select a as " + long + " from t",
+	}, {
+		in:  "select a as " + strings.Repeat("a", 257) + " from t",
+		out: []string{"MaxAliasLen: alias 'aaaaaaaaaaaaaaaa...' is 257 characters long, which exceeds the limit of 256"},
+	}, {
+		in:  "select a from t as " + long,
+		out: []string{"MaxIdentifierLen: table alias 'aaaaaaaaaaaaaaaa...' is 65 characters long, which exceeds the limit of 64"},
+	}, {
+		in:  "update " + long + ".t set a = 1",
+		out: []string{"MaxIdentifierLen: identifier 'aaaaaaaaaaaaaaaa...' is 65 characters long, which exceeds the limit of 64"},
+	}, {
+		in:  "select * from " + strings.Join(tables, ", "),
+		out: []string{"MaxJoinTables: join of 62 tables exceeds the limit of 61"},
+	}, {
+		in:  "select * from " + strings.Join(tables[:60], " join ") + " join (t60, t61)",
+		out: []string{"MaxJoinTables: join of 62 tables exceeds the limit of 61"},
+	}, {
+		// The tables of a subquery are counted on their own.
+		in: "select * from " + strings.Join(tables[:31], ", ") + " where a in (select a from " + strings.Join(tables[31:], ", ") + ")",
+	}, {
+		in:  "create table t (" + long + " int, key (a))",
+		out: []string{"MaxIdentifierLen: identifier 'aaaaaaaaaaaaaaaa...' is 65 characters long, which exceeds the limit of 64"},
+	}, {
+		in: "create table t (a int, key " + long + " (a), constraint " + long + " foreign key (a) references u (id))",
+		out: []string{
+			"MaxIndexNameLen: index name 'aaaaaaaaaaaaaaaa...' is 65 characters long, which exceeds the limit of 64",
+			"MaxIndexNameLen: constraint name 'aaaaaaaaaaaaaaaa...' is 65 characters long, which exceeds the limit of 64",
+		},
+	}, {
+		in:  "alter table t rename index a to " + long,
+		out: []string{"MaxIndexNameLen: index name 'aaaaaaaaaaaaaaaa...' is 65 characters long, which exceeds the limit of 64"},
+	}, {
+		in:  "alter table t add index i (" + strings.Join(columns, ", ") + ")",
+		out: []string{"MaxIndexColumns: index of 17 columns exceeds the limit of 16"},
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tcase.in, err)
+			continue
+		}
+		var out []string
+		for _, e := range ValidateLimits(stmt) {
+			out = append(out, e.Limit+": "+e.Error())
+		}
+		if !reflect.DeepEqual(out, tcase.out) {
+			t.Errorf("ValidateLimits(%q):\n%q, want\n%q", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestValidateLimitsWithDialect(t *testing.T) {
+	stmt, err := Parse("create table t (" + strings.Repeat("a", 64) + " int, key " + strings.Repeat("i", 64) + " (a))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := ValidateLimits(stmt); errs != nil {
+		t.Errorf("ValidateLimits: %v, want none", errs)
+	}
+	var limits []string
+	for _, e := range ValidateLimitsWithDialect(stmt, PostgresDialect) {
+		limits = append(limits, e.Limit)
+	}
+	if want := []string{"MaxIdentifierLen", "MaxIndexNameLen"}; !reflect.DeepEqual(limits, want) {
+		t.Errorf("ValidateLimitsWithDialect(Postgres): %v, want %v", limits, want)
+	}
+
+	// The limits can be changed.
+	saved := DialectServerLimits[MySQLDialect]
+	defer func() { DialectServerLimits[MySQLDialect] = saved }()
+	changed := saved
+	changed.MaxTableKeyParts = 1
+	DialectServerLimits[MySQLDialect] = changed
+	stmt, err = Parse("create table t (a int, b int, key (a, b))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := ValidateLimits(stmt)
+	if len(errs) != 1 || errs[0].Limit != "MaxTableKeyParts" || errs[0].Error() != "indexes of 2 columns of table t exceed the limit of 1" {
+		t.Errorf("ValidateLimits: %v, want MaxTableKeyParts", errs)
+	}
+}
@@ -0,0 +1,97 @@
+package sqlparser
+
+import "reflect"
+
+// EstimateSize returns the approximate number of heap bytes retained
+// by the AST of stmt: the sizes of its nodes, plus the capacities of
+// their slices and the lengths of their strings. Nodes and slices
+// that are shared by several parents are only counted once, so the
+// result doesn't depend on how the AST was built.
+func EstimateSize(stmt Statement) int {
+	if stmt == nil {
+		return 0
+	}
+	e := sizeEstimator{seen: make(map[sizeKey]bool)}
+	return e.boxed(reflect.ValueOf(stmt))
+}
+
+// sizeKey identifies an allocation: the address of a pointer target
+// or of the backing array of a slice, and its type.
+type sizeKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+type sizeEstimator struct {
+	seen map[sizeKey]bool
+}
+
+// boxed returns the size of the value held by an interface,
+// which is allocated on its own unless it's a pointer.
+func (e *sizeEstimator) boxed(v reflect.Value) int {
+	if v.Kind() == reflect.Ptr {
+		return e.referenced(v)
+	}
+	return int(v.Type().Size()) + e.referenced(v)
+}
+
+// referenced returns the size of the memory that v refers to,
+// not counting v itself.
+func (e *sizeEstimator) referenced(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || !e.first(v.Pointer(), v.Type()) {
+			return 0
+		}
+		return int(v.Elem().Type().Size()) + e.referenced(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return e.boxed(v.Elem())
+	case reflect.Struct:
+		size := 0
+		for i := 0; i < v.NumField(); i++ {
+			size += e.referenced(v.Field(i))
+		}
+		return size
+	case reflect.Slice:
+		if v.Cap() == 0 || !e.first(v.Pointer(), v.Type()) {
+			return 0
+		}
+		size := v.Cap() * int(v.Type().Elem().Size())
+		for i := 0; i < v.Len(); i++ {
+			size += e.referenced(v.Index(i))
+		}
+		return size
+	case reflect.Array:
+		size := 0
+		for i := 0; i < v.Len(); i++ {
+			size += e.referenced(v.Index(i))
+		}
+		return size
+	case reflect.String:
+		return v.Len()
+	case reflect.Map:
+		if v.IsNil() {
+			return 0
+		}
+		size := v.Len() * int(v.Type().Key().Size()+v.Type().Elem().Size())
+		iter := v.MapRange()
+		for iter.Next() {
+			size += e.referenced(iter.Key()) + e.referenced(iter.Value())
+		}
+		return size
+	}
+	return 0
+}
+
+// first returns true the first time it's called for an allocation.
+func (e *sizeEstimator) first(addr uintptr, typ reflect.Type) bool {
+	key := sizeKey{addr: addr, typ: typ}
+	if e.seen[key] {
+		return false
+	}
+	e.seen[key] = true
+	return true
+}
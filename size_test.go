@@ -0,0 +1,63 @@
+package sqlparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEstimateSize(t *testing.T) {
+	if got := EstimateSize(nil); got != 0 {
+		t.Errorf("EstimateSize(nil): %d, want 0", got)
+	}
+
+	small, err := Parse("insert into t(a, b) values (1, 'abc')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := Parse("insert into t(a, b) values " + strings.TrimSuffix(strings.Repeat("(1, 'abc'), ", 1000), ", "))
+	if err != nil {
+		t.Fatal(err)
+	}
+	smallSize, largeSize := EstimateSize(small), EstimateSize(large)
+	if smallSize <= 0 || largeSize < 500*smallSize/2 {
+		t.Errorf("EstimateSize: %d for 1 row and %d for 1000 rows", smallSize, largeSize)
+	}
+	if again := EstimateSize(large); again != largeSize {
+		t.Errorf("EstimateSize is not deterministic: %d, then %d", largeSize, again)
+	}
+
+	// A node shared by two parents is counted once.
+	sel, err := Parse("select a from t where b = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := EstimateSize(sel)
+	sel.(*Select).Having = sel.(*Select).Where
+	if after := EstimateSize(sel); after != before {
+		t.Errorf("EstimateSize with a shared node: %d, want %d", after, before)
+	}
+}
+
+func TestParseMaxSize(t *testing.T) {
+	sql := "insert into t(a, b) values " + strings.TrimSuffix(strings.Repeat("(1, 'abc'), ", 1000), ", ")
+	stmt, err := ParseWithOptions(sql, ParseOptions{MaxSize: 1 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := EstimateSize(stmt)
+
+	_, err = ParseWithOptions(sql, ParseOptions{MaxSize: size / 2})
+	if !errors.Is(err, ErrTooComplex) {
+		t.Errorf("ParseWithOptions err: %v, want %v", err, ErrTooComplex)
+	}
+
+	// The budget applies to each statement.
+	tokenizer := NewStringTokenizer("select 1 from t; select 2 from t")
+	tokenizer.MaxSize = 300
+	for i := 0; i < 2; i++ {
+		if _, err := ParseNext(tokenizer); err != nil {
+			t.Errorf("ParseNext err: %v", err)
+		}
+	}
+}
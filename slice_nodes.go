@@ -0,0 +1,225 @@
+package sqlparser
+
+import "reflect"
+
+// The slice nodes, ValTuple, Exprs, SelectExprs, Columns, TableExprs,
+// GroupBy and OrderBy, have the same helpers: Len, At, Append, Insert,
+// Remove, and Find, which returns the index of the first element that
+// match returns true for, or -1. The ones that change the list, Append,
+// Insert and Remove, return a new slice and leave the receiver and its
+// backing array alone, so that a list shared with another AST, like a
+// cached one, can't be changed by accident. The elements themselves
+// are shared: use DeepCopy on the node before changing them.
+
+// spliceList returns a new slice of the type of list, in which the
+// elements from index i to j are replaced with the ones of elems.
+func spliceList(list interface{}, i, j int, elems interface{}) interface{} {
+	l, e := reflect.ValueOf(list), reflect.ValueOf(elems)
+	spliced := reflect.MakeSlice(l.Type(), 0, l.Len()-(j-i)+e.Len())
+	spliced = reflect.AppendSlice(spliced, l.Slice(0, i))
+	spliced = reflect.AppendSlice(spliced, e)
+	return reflect.AppendSlice(spliced, l.Slice(j, l.Len())).Interface()
+}
+
+func (node ValTuple) Len() int {
+	return len(node)
+}
+
+func (node ValTuple) At(i int) Expr {
+	return node[i]
+}
+
+func (node ValTuple) Append(elems ...Expr) ValTuple {
+	return spliceList(node, len(node), len(node), elems).(ValTuple)
+}
+
+func (node ValTuple) Insert(i int, elems ...Expr) ValTuple {
+	return spliceList(node, i, i, elems).(ValTuple)
+}
+
+func (node ValTuple) Remove(i int) ValTuple {
+	return spliceList(node, i, i+1, node[:0]).(ValTuple)
+}
+
+func (node ValTuple) Find(match func(Expr) bool) int {
+	for i, elem := range node {
+		if match(elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (node Exprs) Len() int {
+	return len(node)
+}
+
+func (node Exprs) At(i int) Expr {
+	return node[i]
+}
+
+func (node Exprs) Append(elems ...Expr) Exprs {
+	return spliceList(node, len(node), len(node), elems).(Exprs)
+}
+
+func (node Exprs) Insert(i int, elems ...Expr) Exprs {
+	return spliceList(node, i, i, elems).(Exprs)
+}
+
+func (node Exprs) Remove(i int) Exprs {
+	return spliceList(node, i, i+1, node[:0]).(Exprs)
+}
+
+func (node Exprs) Find(match func(Expr) bool) int {
+	for i, elem := range node {
+		if match(elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (node SelectExprs) Len() int {
+	return len(node)
+}
+
+func (node SelectExprs) At(i int) SelectExpr {
+	return node[i]
+}
+
+func (node SelectExprs) Append(elems ...SelectExpr) SelectExprs {
+	return spliceList(node, len(node), len(node), elems).(SelectExprs)
+}
+
+func (node SelectExprs) Insert(i int, elems ...SelectExpr) SelectExprs {
+	return spliceList(node, i, i, elems).(SelectExprs)
+}
+
+func (node SelectExprs) Remove(i int) SelectExprs {
+	return spliceList(node, i, i+1, node[:0]).(SelectExprs)
+}
+
+func (node SelectExprs) Find(match func(SelectExpr) bool) int {
+	for i, elem := range node {
+		if match(elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (node Columns) Len() int {
+	return len(node)
+}
+
+func (node Columns) At(i int) ColIdent {
+	return node[i]
+}
+
+func (node Columns) Append(elems ...ColIdent) Columns {
+	return spliceList(node, len(node), len(node), elems).(Columns)
+}
+
+func (node Columns) Insert(i int, elems ...ColIdent) Columns {
+	return spliceList(node, i, i, elems).(Columns)
+}
+
+func (node Columns) Remove(i int) Columns {
+	return spliceList(node, i, i+1, node[:0]).(Columns)
+}
+
+func (node Columns) Find(match func(ColIdent) bool) int {
+	for i, elem := range node {
+		if match(elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (node TableExprs) Len() int {
+	return len(node)
+}
+
+func (node TableExprs) At(i int) TableExpr {
+	return node[i]
+}
+
+func (node TableExprs) Append(elems ...TableExpr) TableExprs {
+	return spliceList(node, len(node), len(node), elems).(TableExprs)
+}
+
+func (node TableExprs) Insert(i int, elems ...TableExpr) TableExprs {
+	return spliceList(node, i, i, elems).(TableExprs)
+}
+
+func (node TableExprs) Remove(i int) TableExprs {
+	return spliceList(node, i, i+1, node[:0]).(TableExprs)
+}
+
+func (node TableExprs) Find(match func(TableExpr) bool) int {
+	for i, elem := range node {
+		if match(elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (node GroupBy) Len() int {
+	return len(node)
+}
+
+func (node GroupBy) At(i int) Expr {
+	return node[i]
+}
+
+func (node GroupBy) Append(elems ...Expr) GroupBy {
+	return spliceList(node, len(node), len(node), elems).(GroupBy)
+}
+
+func (node GroupBy) Insert(i int, elems ...Expr) GroupBy {
+	return spliceList(node, i, i, elems).(GroupBy)
+}
+
+func (node GroupBy) Remove(i int) GroupBy {
+	return spliceList(node, i, i+1, node[:0]).(GroupBy)
+}
+
+func (node GroupBy) Find(match func(Expr) bool) int {
+	for i, elem := range node {
+		if match(elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (node OrderBy) Len() int {
+	return len(node)
+}
+
+func (node OrderBy) At(i int) *Order {
+	return node[i]
+}
+
+func (node OrderBy) Append(elems ...*Order) OrderBy {
+	return spliceList(node, len(node), len(node), elems).(OrderBy)
+}
+
+func (node OrderBy) Insert(i int, elems ...*Order) OrderBy {
+	return spliceList(node, i, i, elems).(OrderBy)
+}
+
+func (node OrderBy) Remove(i int) OrderBy {
+	return spliceList(node, i, i+1, node[:0]).(OrderBy)
+}
+
+func (node OrderBy) Find(match func(*Order) bool) int {
+	for i, elem := range node {
+		if match(elem) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,103 @@
+package sqlparser
+
+import "testing"
+
+func TestSliceNodes(t *testing.T) {
+	tree, err := Parse("select a, b, c from t order by a, b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := tree.(*Select)
+	exprs := sel.SelectExprs
+	isColumn := func(name string) func(SelectExpr) bool {
+		return func(expr SelectExpr) bool {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				return false
+			}
+			col, ok := aliased.Expr.(*ColName)
+			return ok && col.Name.EqualString(name)
+		}
+	}
+
+	if got := exprs.Len(); got != 3 {
+		t.Errorf("Len: %d, want 3", got)
+	}
+	if got := String(exprs.At(1)); got != "b" {
+		t.Errorf("At(1): %s, want b", got)
+	}
+	if got := exprs.Find(isColumn("c")); got != 2 {
+		t.Errorf("Find(c): %d, want 2", got)
+	}
+	if got := exprs.Find(isColumn("d")); got != -1 {
+		t.Errorf("Find(d): %d, want -1", got)
+	}
+
+	d := &AliasedExpr{Expr: NewIntVal([]byte("1"))}
+	testcases := []struct {
+		name string
+		got  SelectExprs
+		want string
+	}{{
+		name: "Remove(0)",
+		got:  exprs.Remove(0),
+		want: "b, c",
+	}, {
+		name: "Remove(2)",
+		got:  exprs.Remove(2),
+		want: "a, b",
+	}, {
+		name: "Insert(0)",
+		got:  exprs.Insert(0, d),
+		want: "1, a, b, c",
+	}, {
+		name: "Insert(1)",
+		got:  exprs.Insert(1, d, d),
+		want: "a, 1, 1, b, c",
+	}, {
+		name: "Insert(3)",
+		got:  exprs.Insert(3, d),
+		want: "a, b, c, 1",
+	}, {
+		name: "Append",
+		got:  exprs.Append(d),
+		want: "a, b, c, 1",
+	}}
+	for _, tcase := range testcases {
+		if got := String(tcase.got); got != tcase.want {
+			t.Errorf("%s: %s, want %s", tcase.name, got, tcase.want)
+		}
+		// The result doesn't share its backing array with the receiver.
+		tcase.got[0] = &StarExpr{}
+		if got := String(sel); got != "select a, b, c from t order by a asc, b asc" {
+			t.Errorf("%s modified its receiver: %s", tcase.name, got)
+		}
+	}
+
+	orderBy := sel.OrderBy.Remove(sel.OrderBy.Find(func(order *Order) bool {
+		return String(order.Expr) == "a"
+	}))
+	if got, want := String(orderBy), " order by b asc"; got != want {
+		t.Errorf("OrderBy.Remove: %q, want %q", got, want)
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	tree, err := Parse("select a, b from t where c in (1, 2)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := DeepCopy(tree).(*Select)
+	sel.SelectExprs[0].(*AliasedExpr).Expr.(*ColName).Name = NewColIdent("x")
+	tuple := sel.Where.Expr.(*ComparisonExpr).Right.(ValTuple)
+	tuple[0] = NewIntVal([]byte("3"))
+	if got, want := String(sel), "select x, b from t where c in (3, 2)"; got != want {
+		t.Errorf("DeepCopy: %s, want %s", got, want)
+	}
+	if got, want := String(tree), "select a, b from t where c in (1, 2)"; got != want {
+		t.Errorf("DeepCopy modified its input: %s", got)
+	}
+	if got := DeepCopy(nil); got != nil {
+		t.Errorf("DeepCopy(nil): %v, want nil", got)
+	}
+}
@@ -0,0 +1,189 @@
+package sqlparser
+
+// sniffVerbs are the statement types that Sniff tells from the first
+// token alone.
+var sniffVerbs = map[int]int{
+	'(':    StmtSelect,
+	STREAM: StmtStream,
+	SET:    StmtSet,
+	SHOW:   StmtShow,
+	USE:    StmtUse,
+}
+
+// sniffSelectOptions are the tokens that can be between SELECT and
+// the NEXT of a select that fetches values of a sequence.
+var sniffSelectOptions = map[int]bool{
+	ALL:                 true,
+	DISTINCT:            true,
+	DISTINCTROW:         true,
+	HIGH_PRIORITY:       true,
+	STRAIGHT_JOIN:       true,
+	SQL_SMALL_RESULT:    true,
+	SQL_BIG_RESULT:      true,
+	SQL_BUFFER_RESULT:   true,
+	SQL_CACHE:           true,
+	SQL_NO_CACHE:        true,
+	SQL_CALC_FOUND_ROWS: true,
+}
+
+// sniffDeleteEnds are the tokens that can follow the table of a DELETE
+// of a single table.
+var sniffDeleteEnds = map[int]bool{
+	0:         true,
+	';':       true,
+	WHERE:     true,
+	ORDER:     true,
+	LIMIT:     true,
+	PARTITION: true,
+	RETURNING: true,
+}
+
+// Sniff returns the type of the statement sql, like StatementType
+// does for the parsed statement, and for an INSERT, a REPLACE, or an
+// UPDATE or DELETE of a single table, the table it writes. It only
+// tokenizes the beginning of sql, skipping comments, which makes it
+// much cheaper than Parse for classifying statements, like in a proxy.
+// The statements it can't tell from their first tokens, like the
+// UPDATE of several tables, or the less common verbs, are parsed.
+//
+// Unlike Parse, Sniff doesn't check the rest of the statement: sql
+// may not parse even if Sniff returns no error. The errors are the
+// ones of Parse, for the statements it parses.
+func Sniff(sql string) (int, TableName, error) {
+	s := &sniffer{tkn: NewStringTokenizer(sql)}
+	typ := s.next()
+	switch typ {
+	case SELECT:
+		for typ = s.next(); sniffSelectOptions[typ]; typ = s.next() {
+		}
+		if typ != NEXT {
+			return StmtSelect, TableName{}, nil
+		}
+	case INSERT, REPLACE:
+		stmtType := StmtInsert
+		if typ == REPLACE {
+			stmtType = StmtReplace
+		}
+		typ = s.next()
+		if typ == LOW_PRIORITY || typ == DELAYED || typ == HIGH_PRIORITY {
+			typ = s.next()
+		}
+		if typ == IGNORE {
+			typ = s.next()
+		}
+		if typ == INTO {
+			typ = s.next()
+		}
+		if table, _, ok := s.tableName(typ); ok {
+			return stmtType, table, nil
+		}
+	case UPDATE:
+		typ = s.next()
+		if typ == LOW_PRIORITY {
+			typ = s.next()
+		}
+		if typ == IGNORE {
+			typ = s.next()
+		}
+		table, typ, ok := s.tableName(typ)
+		if typ == AS {
+			typ = s.next()
+		}
+		if typ == ID {
+			typ = s.next()
+		}
+		if ok && typ == SET {
+			return StmtUpdate, table, nil
+		}
+	case DELETE:
+		typ = s.next()
+		if typ == LOW_PRIORITY {
+			typ = s.next()
+		}
+		if typ == QUICK {
+			typ = s.next()
+		}
+		if typ == IGNORE {
+			typ = s.next()
+		}
+		if typ == FROM {
+			table, typ, ok := s.tableName(s.next())
+			if ok && sniffDeleteEnds[typ] {
+				return StmtDelete, table, nil
+			}
+		}
+	default:
+		if stmtType, ok := sniffVerbs[typ]; ok {
+			return stmtType, TableName{}, nil
+		}
+	}
+	stmt, err := Parse(sql)
+	if err != nil {
+		return StmtUnknown, TableName{}, err
+	}
+	return StatementType(stmt), primaryTable(stmt), nil
+}
+
+// sniffer scans the tokens of a statement for Sniff.
+type sniffer struct {
+	tkn *Tokenizer
+	val []byte
+}
+
+// next returns the next token that's not a comment, and keeps its
+// value in val.
+func (s *sniffer) next() int {
+	for {
+		typ, val := s.tkn.Scan()
+		if typ != COMMENT && typ != DELIMITER {
+			s.val = val
+			return typ
+		}
+	}
+}
+
+// tableName scans the name of a table, qualified or not, whose first
+// token is typ, and the token after it. It returns false if the name
+// is not an identifier, like the keywords that are not reserved, which
+// are left to Parse.
+func (s *sniffer) tableName(typ int) (TableName, int, bool) {
+	if typ != ID {
+		return TableName{}, typ, false
+	}
+	name := NewTableIdent(string(s.val))
+	if typ = s.next(); typ != '.' {
+		return TableName{Name: name}, typ, true
+	}
+	if typ = s.next(); typ != ID {
+		return TableName{}, typ, false
+	}
+	table := TableName{Qualifier: name, Name: NewTableIdent(string(s.val))}
+	return table, s.next(), true
+}
+
+// primaryTable returns the table that Sniff returns for stmt: the one
+// of an INSERT or a REPLACE, or the one an UPDATE or a DELETE of a
+// single table writes.
+func primaryTable(stmt Statement) TableName {
+	var exprs TableExprs
+	switch stmt := stmt.(type) {
+	case *Insert:
+		return stmt.Table
+	case *Update:
+		exprs = stmt.TableExprs
+	case *Delete:
+		if len(stmt.Targets) != 0 {
+			return TableName{}
+		}
+		exprs = stmt.TableExprs
+	}
+	if len(exprs) != 1 {
+		return TableName{}
+	}
+	if table, ok := exprs[0].(*AliasedTableExpr); ok {
+		if name, ok := table.Expr.(TableName); ok {
+			return name
+		}
+	}
+	return TableName{}
+}
@@ -0,0 +1,131 @@
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestSniff(t *testing.T) {
+	testcases := []struct {
+		in    string
+		typ   int
+		table string
+		err   string
+	}{{
+		in:  "/* leading */ select /* hint */ sql_no_cache a from t",
+		typ: StmtSelect,
+	}, {
+		in:  "((select a from t) union select b from u)",
+		typ: StmtSelect,
+	}, {
+		in:  "select /* c */ distinct next 5 values from seq",
+		typ: StmtNextval,
+	}, {
+		in:    "insert /* c */ low_priority ignore into db.`my table` (a) values (1)",
+		typ:   StmtInsert,
+		table: "db.`my table`",
+	}, {
+		in:    "replace t values (1)",
+		typ:   StmtReplace,
+		table: "t",
+	}, {
+		in:    "insert into status values (1)",
+		typ:   StmtInsert,
+		table: "`status`",
+	}, {
+		in:    "update ignore t as x set a = 1 where b = 2",
+		typ:   StmtUpdate,
+		table: "t",
+	}, {
+		in:  "update t join u on t.id = u.id set a = 1",
+		typ: StmtUpdate,
+	}, {
+		in:    "delete quick from db.t where a = 1",
+		typ:   StmtDelete,
+		table: "db.t",
+	}, {
+		in:    "delete from t",
+		typ:   StmtDelete,
+		table: "t",
+	}, {
+		in:  "delete t from t join u on t.id = u.id",
+		typ: StmtDelete,
+	}, {
+		in:  "set @@session.autocommit = 1",
+		typ: StmtSet,
+	}, {
+		in:  "begin",
+		typ: StmtBegin,
+	}, {
+		in:  "create table t (a int)",
+		typ: StmtDDL,
+	}, {
+		in:  "",
+		err: "syntax error at position 1",
+	}, {
+		in:  "frobnicate the table",
+		err: "syntax error at position 11 near 'frobnicate'",
+	}}
+	for _, tcase := range testcases {
+		typ, table, err := Sniff(tcase.in)
+		if err != nil {
+			if err.Error() != tcase.err {
+				t.Errorf("Sniff(%q): %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if tcase.err != "" {
+			t.Errorf("Sniff(%q): nil error, want %s", tcase.in, tcase.err)
+			continue
+		}
+		if typ != tcase.typ || String(table) != tcase.table {
+			t.Errorf("Sniff(%q): %s %s, want %s %s", tcase.in, StmtType(typ), String(table), StmtType(tcase.typ), tcase.table)
+		}
+	}
+}
+
+// TestSniffCorpus checks that Sniff agrees with the parsed statements
+// of the corpus.
+func TestSniffCorpus(t *testing.T) {
+	for _, tcase := range validSQL {
+		stmt, err := Parse(tcase.Input)
+		if err != nil {
+			continue
+		}
+		typ, table, err := Sniff(tcase.Input)
+		if err != nil {
+			t.Errorf("Sniff(%q): %v", tcase.Input, err)
+			continue
+		}
+		if want := StatementType(stmt); typ != want {
+			t.Errorf("Sniff(%q): %s, want %s", tcase.Input, StmtType(typ), StmtType(want))
+		}
+		if want := primaryTable(stmt); table != want {
+			t.Errorf("Sniff(%q): table %s, want %s", tcase.Input, String(table), String(want))
+		}
+	}
+}
+
+func BenchmarkSniff(b *testing.B) {
+	for _, sql := range []string{
+		"select /* c */ a, b, c from t where id = 1 and name = 'x'",
+		"insert into db.t (a, b, c) values (1, 2, 3), (4, 5, 6)",
+		"update t set a = 1, b = 2 where id = 3",
+	} {
+		b.Run(sql[:6]+"/sniff", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, _, err := Sniff(sql); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(sql[:6]+"/parse", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				stmt, err := Parse(sql)
+				if err != nil {
+					b.Fatal(err)
+				}
+				StatementType(stmt)
+			}
+		})
+	}
+}
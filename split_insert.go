@@ -0,0 +1,62 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SplitInsert splits the VALUES list of ins into inserts with at most
+// maxRows rows each, and whose String is at most maxBytes long.
+// A limit of 0 or less means no limit. The comments, column list, row
+// alias and ON DUPLICATE KEY UPDATE or ON CONFLICT clause are copied
+// into every insert, and the rows keep their order. The rows themselves are shared with ins.
+// It returns an error for INSERT ... SELECT, or if a single row
+// doesn't fit in maxBytes.
+func SplitInsert(ins *Insert, maxRows int, maxBytes int) ([]*Insert, error) {
+	rows, ok := ins.Rows.(Values)
+	if !ok {
+		return nil, errors.New("cannot split insert without values")
+	}
+	template := &Insert{
+		Action:     ins.Action,
+		Comments:   ins.Comments,
+		Priority:   ins.Priority,
+		Ignore:     ins.Ignore,
+		Table:      ins.Table,
+		Partitions: ins.Partitions,
+		Columns:    ins.Columns,
+		Rows:       Values{},
+		RowAlias:   ins.RowAlias,
+		OnDup:      ins.OnDup,
+		OnConflict: ins.OnConflict,
+		Returning:  ins.Returning,
+	}
+	// An insert with values is as long as the insert without
+	// values, plus "values " and the rows separated by ", ".
+	overhead := len(String(template)) + len("values ")
+
+	var inserts []*Insert
+	start, size := 0, overhead
+	flush := func(end int) {
+		chunk := cloneNode(template).(*Insert)
+		chunk.Rows = rows[start:end:end]
+		inserts = append(inserts, chunk)
+		start, size = end, overhead
+	}
+	for i, row := range rows {
+		rowSize := len(String(row))
+		if maxBytes > 0 && overhead+rowSize > maxBytes {
+			return nil, fmt.Errorf("row %d is longer than %d bytes", i+1, maxBytes)
+		}
+		if i > start {
+			rowSize += len(", ")
+			if (maxRows > 0 && i-start >= maxRows) || (maxBytes > 0 && size+rowSize > maxBytes) {
+				flush(i)
+				rowSize -= len(", ")
+			}
+		}
+		size += rowSize
+	}
+	flush(len(rows))
+	return inserts, nil
+}
@@ -0,0 +1,105 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitInsert(t *testing.T) {
+	testcases := []struct {
+		in       string
+		maxRows  int
+		maxBytes int
+		out      []string
+		err      string
+	}{{
+		in:      "insert into t(a, b) values (1, 2), (3, 4), (5, 6)",
+		maxRows: 2,
+		out: []string{
+			"insert into t(a, b) values (1, 2), (3, 4)",
+			"insert into t(a, b) values (5, 6)",
+		},
+	}, {
+		in:  "insert into t(a, b) values (1, 2), (3, 4)",
+		out: []string{"insert into t(a, b) values (1, 2), (3, 4)"},
+	}, {
+		in:       "insert /* x */ ignore into t(a) values (1), (22), (333) on duplicate key update a = values(a)",
+		maxBytes: len("insert /* x */ ignore into t(a) values (1), (22) on duplicate key update a = values(a)"),
+		out: []string{
+			"insert /* x */ ignore into t(a) values (1), (22) on duplicate key update a = values(a)",
+			"insert /* x */ ignore into t(a) values (333) on duplicate key update a = values(a)",
+		},
+	}, {
+		in:       "insert into t(a) values (1), (2), (3), (4), (5)",
+		maxRows:  2,
+		maxBytes: len("insert into t(a) values (1)"),
+		out: []string{
+			"insert into t(a) values (1)",
+			"insert into t(a) values (2)",
+			"insert into t(a) values (3)",
+			"insert into t(a) values (4)",
+			"insert into t(a) values (5)",
+		},
+	}, {
+		in:      "insert into t(a, b) values (default, 1), (default(b), default) on duplicate key update b = default(b)",
+		maxRows: 1,
+		out: []string{
+			"insert into t(a, b) values (default, 1) on duplicate key update b = default(b)",
+			"insert into t(a, b) values (default(b), default) on duplicate key update b = default(b)",
+		},
+	}, {
+		in:       "insert into t(a) values (1), ('long value')",
+		maxBytes: len("insert into t(a) values (1)"),
+		err:      "row 2 is longer than 27 bytes",
+	}, {
+		in:  "insert into t(a) select a from u",
+		err: "cannot split insert without values",
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		inserts, err := SplitInsert(tree.(*Insert), tcase.maxRows, tcase.maxBytes)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("SplitInsert(%s) err: %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("SplitInsert(%s) err: %v", tcase.in, err)
+			continue
+		}
+		var got []string
+		for _, ins := range inserts {
+			got = append(got, String(ins))
+		}
+		if !reflect.DeepEqual(got, tcase.out) {
+			t.Errorf("SplitInsert(%s):\n%q, want\n%q", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestSplitInsertCopiesOnDup(t *testing.T) {
+	tree, err := Parse("insert into t(a) values (1), (2) on duplicate key update a = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ins := tree.(*Insert)
+	inserts, err := SplitInsert(ins, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inserts[0].OnDup[0].Expr = NewIntVal([]byte("2"))
+	inserts[0].Columns[0] = NewColIdent("b")
+	want := "insert into t(a) values (2) on duplicate key update a = 1"
+	if got := String(inserts[1]); got != want {
+		t.Errorf("second insert: %s, want %s", got, want)
+	}
+	want = "insert into t(a) values (1), (2) on duplicate key update a = 1"
+	if got := String(ins); got != want {
+		t.Errorf("original insert: %s, want %s", got, want)
+	}
+}
@@ -7,10 +7,28 @@ import __yyfmt__ "fmt"
 
 //line sql.y:18
 
+import "strings"
+
 func setParseTree(yylex interface{}, stmt Statement) {
 	yylex.(*Tokenizer).ParseTree = stmt
 }
 
+// newNotExpr returns NOT expr. The parentheses of NOT (EXISTS ...)
+// are dropped, so that it's the same as NOT EXISTS, see ExistsExpr.
+func newNotExpr(expr Expr) Expr {
+	if exists, ok := unparen(expr).(*ExistsExpr); ok {
+		expr = exists
+	}
+	return &NotExpr{Expr: expr}
+}
+
+// groupByClause is the GROUP BY clause of a select, and whether it
+// has a WITH ROLLUP.
+type groupByClause struct {
+	exprs  Exprs
+	rollup bool
+}
+
 func setAllowComments(yylex interface{}, allow bool) {
 	yylex.(*Tokenizer).AllowComments = allow
 }
@@ -19,9 +37,55 @@ func setDDL(yylex interface{}, ddl *DDL) {
 	yylex.(*Tokenizer).partialDDL = ddl
 }
 
+// withSelect sets the WITH clause of sel, a select or a union, to
+// with, and returns sel.
+func withSelect(with *With, sel SelectStatement) SelectStatement {
+	switch sel := sel.(type) {
+	case *Select:
+		sel.With = with
+	case *Union:
+		sel.With = with
+	}
+	return sel
+}
+
+// setInTrigger records that the parser is in the body of a trigger,
+// where the columns of the NEW row can be set.
+// castType returns the type of the CONVERT that a postgres style ::
+// cast to the column type t is, or nil if CONVERT has no equivalent
+// type: the integers are SIGNED, TIMESTAMP is DATETIME, and the
+// strings are CHAR or BINARY, which keep the length of t.
+func castType(t ColumnType) *ConvertType {
+	ct := &ConvertType{Type: t.Type, Length: t.Length, Scale: t.Scale}
+	switch strings.ToLower(t.Type) {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint":
+		return &ConvertType{Type: "signed"}
+	case "numeric":
+		ct.Type = "decimal"
+	case "timestamp":
+		ct.Type = "datetime"
+	case "varchar", "text", "tinytext", "mediumtext", "longtext":
+		ct.Type = "char"
+	case "varbinary", "blob", "tinyblob", "mediumblob", "longblob":
+		ct.Type = "binary"
+	case "real", "double", "float", "decimal", "date", "time", "datetime", "year":
+	default:
+		return nil
+	}
+	return ct
+}
+
+func setInTrigger(yylex interface{}) {
+	yylex.(*Tokenizer).inTrigger = true
+}
+
+func inTrigger(yylex interface{}) bool {
+	return yylex.(*Tokenizer).inTrigger
+}
+
 func incNesting(yylex interface{}) bool {
 	yylex.(*Tokenizer).nesting++
-	if yylex.(*Tokenizer).nesting == 200 {
+	if yylex.(*Tokenizer).nesting == maxNesting {
 		return true
 	}
 	return false
@@ -38,299 +102,430 @@ func forceEOF(yylex interface{}) {
 	yylex.(*Tokenizer).ForceEOF = true
 }
 
-//line sql.y:53
+// scanRest returns the text of the rest of the statement as written,
+// and makes the lexer return EOF after it.
+func scanRest(yylex interface{}) string {
+	return yylex.(*Tokenizer).scanRest()
+}
+
+//line sql.y:123
 type yySymType struct {
-	yys               int
-	empty             struct{}
-	statement         Statement
-	selStmt           SelectStatement
-	ddl               *DDL
-	ins               *Insert
-	byt               byte
-	bytes             []byte
-	bytes2            [][]byte
-	str               string
-	strs              []string
-	selectExprs       SelectExprs
-	selectExpr        SelectExpr
-	columns           Columns
-	partitions        Partitions
-	colName           *ColName
-	tableExprs        TableExprs
-	tableExpr         TableExpr
-	joinCondition     JoinCondition
-	tableName         TableName
-	tableNames        TableNames
-	indexHints        *IndexHints
-	expr              Expr
-	exprs             Exprs
-	boolVal           BoolVal
-	colTuple          ColTuple
-	values            Values
-	valTuple          ValTuple
-	subquery          *Subquery
-	whens             []*When
-	when              *When
-	orderBy           OrderBy
-	order             *Order
-	limit             *Limit
-	updateExprs       UpdateExprs
-	setExprs          SetExprs
-	updateExpr        *UpdateExpr
-	setExpr           *SetExpr
-	colIdent          ColIdent
-	tableIdent        TableIdent
-	convertType       *ConvertType
-	aliasedTableName  *AliasedTableExpr
-	TableSpec         *TableSpec
-	columnType        ColumnType
-	colKeyOpt         ColumnKeyOption
-	optVal            *SQLVal
-	LengthScaleOption LengthScaleOption
-	columnDefinition  *ColumnDefinition
-	indexDefinition   *IndexDefinition
-	indexInfo         *IndexInfo
-	indexOption       *IndexOption
-	indexOptions      []*IndexOption
-	indexColumn       *IndexColumn
-	indexColumns      []*IndexColumn
-	partDefs          []*PartitionDefinition
-	partDef           *PartitionDefinition
-	partSpec          *PartitionSpec
-	vindexParam       VindexParam
-	vindexParams      []VindexParam
-	showFilter        *ShowFilter
+	yys                  int
+	empty                struct{}
+	statement            Statement
+	selStmt              SelectStatement
+	parenSelect          *ParenSelect
+	ddl                  *DDL
+	ins                  *Insert
+	byt                  byte
+	bytes                []byte
+	bytes2               [][]byte
+	str                  string
+	strs                 []string
+	selectExprs          SelectExprs
+	selectExpr           SelectExpr
+	columns              Columns
+	partitions           Partitions
+	colName              *ColName
+	tableExprs           TableExprs
+	tableExpr            TableExpr
+	joinTableExpr        *JoinTableExpr
+	joinCondition        JoinCondition
+	tableName            TableName
+	tableNames           TableNames
+	indexHints           *IndexHints
+	indexHintList        IndexHintList
+	expr                 Expr
+	exprs                Exprs
+	boolVal              BoolVal
+	colTuple             ColTuple
+	values               Values
+	valTuple             ValTuple
+	subquery             *Subquery
+	whens                []*When
+	when                 *When
+	orderBy              OrderBy
+	order                *Order
+	limit                *Limit
+	updateExprs          UpdateExprs
+	setExprs             SetExprs
+	updateExpr           *UpdateExpr
+	setExpr              *SetExpr
+	colIdent             ColIdent
+	colIdents            []ColIdent
+	tableIdent           TableIdent
+	convertType          *ConvertType
+	aliasedTableName     *AliasedTableExpr
+	TableSpec            *TableSpec
+	columnType           ColumnType
+	colKeyOpt            ColumnKeyOption
+	optVal               *SQLVal
+	LengthScaleOption    LengthScaleOption
+	columnDefinition     *ColumnDefinition
+	indexDefinition      *IndexDefinition
+	foreignKeyDefinition *ForeignKeyDefinition
+	indexInfo            *IndexInfo
+	alterSpec            *AlterSpec
+	alterSpecs           []*AlterSpec
+	indexOption          *IndexOption
+	indexOptions         []*IndexOption
+	indexColumn          *IndexColumn
+	indexColumns         []*IndexColumn
+	partDefs             []*PartitionDefinition
+	partDef              *PartitionDefinition
+	partSpec             *PartitionSpec
+	vindexParam          VindexParam
+	vindexParams         []VindexParam
+	showFilter           *ShowFilter
+	xid                  *Xid
+	tableLocks           TableLocks
+	tableLock            *TableLock
+	indexCacheTables     IndexCacheTables
+	indexCacheTable      *IndexCacheTable
+	createTrigger        *CreateTrigger
+	createEvent          *CreateEvent
+	createProcedure      *CreateProcedure
+	createFunction       *CreateFunction
+	procParams           ProcParams
+	procParam            *ProcParam
+	rowAlias             *RowAlias
+	onConflict           *OnConflict
+	selectInto           *SelectInto
+	selectOption         SelectOption
+	selectOptions        SelectOptions
+	over                 *Over
+	windowSpec           *WindowSpec
+	windowFrame          *WindowFrame
+	frameBound           *FrameBound
+	namedWindows         NamedWindows
+	namedWindow          *NamedWindow
+	groupBy              groupByClause
+	with                 *With
+	ctes                 []*CommonTableExpr
+	cte                  *CommonTableExpr
 }
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const AS = 57364
-const EXISTS = 57365
-const ASC = 57366
-const DESC = 57367
-const INTO = 57368
-const DUPLICATE = 57369
-const KEY = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const ID = 57395
-const HEX = 57396
-const STRING = 57397
-const INTEGRAL = 57398
-const FLOAT = 57399
-const HEXNUM = 57400
-const VALUE_ARG = 57401
-const LIST_ARG = 57402
-const COMMENT = 57403
-const COMMENT_KEYWORD = 57404
-const BIT_LITERAL = 57405
-const NULL = 57406
-const TRUE = 57407
-const FALSE = 57408
-const OR = 57409
-const AND = 57410
-const NOT = 57411
-const BETWEEN = 57412
-const CASE = 57413
-const WHEN = 57414
-const THEN = 57415
-const ELSE = 57416
-const END = 57417
-const LE = 57418
-const GE = 57419
-const NE = 57420
-const NULL_SAFE_EQUAL = 57421
-const IS = 57422
-const LIKE = 57423
-const REGEXP = 57424
-const IN = 57425
-const SHIFT_LEFT = 57426
-const SHIFT_RIGHT = 57427
-const DIV = 57428
-const MOD = 57429
-const UNARY = 57430
-const COLLATE = 57431
-const BINARY = 57432
-const UNDERSCORE_BINARY = 57433
-const INTERVAL = 57434
-const JSON_EXTRACT_OP = 57435
-const JSON_UNQUOTE_EXTRACT_OP = 57436
-const CREATE = 57437
-const ALTER = 57438
-const DROP = 57439
-const RENAME = 57440
-const ANALYZE = 57441
-const ADD = 57442
-const SCHEMA = 57443
-const TABLE = 57444
-const INDEX = 57445
-const VIEW = 57446
-const TO = 57447
-const IGNORE = 57448
-const IF = 57449
-const UNIQUE = 57450
-const PRIMARY = 57451
-const COLUMN = 57452
-const CONSTRAINT = 57453
-const SPATIAL = 57454
-const FULLTEXT = 57455
-const FOREIGN = 57456
-const KEY_BLOCK_SIZE = 57457
-const SHOW = 57458
-const DESCRIBE = 57459
-const EXPLAIN = 57460
-const DATE = 57461
-const ESCAPE = 57462
-const REPAIR = 57463
-const OPTIMIZE = 57464
-const TRUNCATE = 57465
-const MAXVALUE = 57466
-const PARTITION = 57467
-const REORGANIZE = 57468
-const LESS = 57469
-const THAN = 57470
-const PROCEDURE = 57471
-const TRIGGER = 57472
-const VINDEX = 57473
-const VINDEXES = 57474
-const STATUS = 57475
-const VARIABLES = 57476
-const BEGIN = 57477
-const START = 57478
-const TRANSACTION = 57479
-const COMMIT = 57480
-const ROLLBACK = 57481
-const BIT = 57482
-const TINYINT = 57483
-const SMALLINT = 57484
-const MEDIUMINT = 57485
-const INT = 57486
-const INTEGER = 57487
-const BIGINT = 57488
-const INTNUM = 57489
-const REAL = 57490
-const DOUBLE = 57491
-const FLOAT_TYPE = 57492
-const DECIMAL = 57493
-const NUMERIC = 57494
-const TIME = 57495
-const TIMESTAMP = 57496
-const DATETIME = 57497
-const YEAR = 57498
-const CHAR = 57499
-const VARCHAR = 57500
-const BOOL = 57501
-const CHARACTER = 57502
-const VARBINARY = 57503
-const NCHAR = 57504
-const TEXT = 57505
-const TINYTEXT = 57506
-const MEDIUMTEXT = 57507
-const LONGTEXT = 57508
-const BLOB = 57509
-const TINYBLOB = 57510
-const MEDIUMBLOB = 57511
-const LONGBLOB = 57512
-const JSON = 57513
-const ENUM = 57514
-const GEOMETRY = 57515
-const POINT = 57516
-const LINESTRING = 57517
-const POLYGON = 57518
-const GEOMETRYCOLLECTION = 57519
-const MULTIPOINT = 57520
-const MULTILINESTRING = 57521
-const MULTIPOLYGON = 57522
-const NULLX = 57523
-const AUTO_INCREMENT = 57524
-const APPROXNUM = 57525
-const SIGNED = 57526
-const UNSIGNED = 57527
-const ZEROFILL = 57528
-const DATABASES = 57529
-const TABLES = 57530
-const VITESS_KEYSPACES = 57531
-const VITESS_SHARDS = 57532
-const VITESS_TABLETS = 57533
-const VSCHEMA_TABLES = 57534
-const EXTENDED = 57535
-const FULL = 57536
-const PROCESSLIST = 57537
-const NAMES = 57538
-const CHARSET = 57539
-const GLOBAL = 57540
-const SESSION = 57541
-const ISOLATION = 57542
-const LEVEL = 57543
-const READ = 57544
-const WRITE = 57545
-const ONLY = 57546
-const REPEATABLE = 57547
-const COMMITTED = 57548
-const UNCOMMITTED = 57549
-const SERIALIZABLE = 57550
-const CURRENT_TIMESTAMP = 57551
-const DATABASE = 57552
-const CURRENT_DATE = 57553
-const CURRENT_TIME = 57554
-const LOCALTIME = 57555
-const LOCALTIMESTAMP = 57556
-const UTC_DATE = 57557
-const UTC_TIME = 57558
-const UTC_TIMESTAMP = 57559
-const REPLACE = 57560
-const CONVERT = 57561
-const CAST = 57562
-const SUBSTR = 57563
-const SUBSTRING = 57564
-const GROUP_CONCAT = 57565
-const SEPARATOR = 57566
-const MATCH = 57567
-const AGAINST = 57568
-const BOOLEAN = 57569
-const LANGUAGE = 57570
-const WITH = 57571
-const QUERY = 57572
-const EXPANSION = 57573
-const UNUSED = 57574
+const STREAM = 57348
+const INSERT = 57349
+const UPDATE = 57350
+const DELETE = 57351
+const FROM = 57352
+const WHERE = 57353
+const GROUP = 57354
+const HAVING = 57355
+const ORDER = 57356
+const BY = 57357
+const LIMIT = 57358
+const OFFSET = 57359
+const FOR = 57360
+const ALL = 57361
+const DISTINCT = 57362
+const EXISTS = 57363
+const ASC = 57364
+const DESC = 57365
+const DUPLICATE = 57366
+const KEY = 57367
+const DEFAULT = 57368
+const SET = 57369
+const LOCK = 57370
+const KEYS = 57371
+const VALUES = 57372
+const LAST_INSERT_ID = 57373
+const NEXT = 57374
+const VALUE = 57375
+const SHARE = 57376
+const MODE = 57377
+const WINDOW = 57378
+const OVER = 57379
+const RECURSIVE = 57380
+const ROWS = 57381
+const RANGE = 57382
+const ROW = 57383
+const CURRENT = 57384
+const SQL_NO_CACHE = 57385
+const SQL_CACHE = 57386
+const SQL_CALC_FOUND_ROWS = 57387
+const SQL_SMALL_RESULT = 57388
+const SQL_BIG_RESULT = 57389
+const SQL_BUFFER_RESULT = 57390
+const DISTINCTROW = 57391
+const JOIN = 57392
+const STRAIGHT_JOIN = 57393
+const LEFT = 57394
+const RIGHT = 57395
+const INNER = 57396
+const OUTER = 57397
+const CROSS = 57398
+const NATURAL = 57399
+const USE = 57400
+const FORCE = 57401
+const ON = 57402
+const USING = 57403
+const SELECT = 57404
+const AS = 57405
+const IGNORE = 57406
+const REPLACE = 57407
+const TABLE_OPTIONS_END = 57408
+const INTO_END = 57409
+const INTO = 57410
+const DATE = 57411
+const TIME = 57412
+const TIMESTAMP = 57413
+const STRING = 57414
+const WITH = 57415
+const QUERY = 57416
+const ID = 57417
+const UNBOUNDED = 57418
+const PRECEDING = 57419
+const FOLLOWING = 57420
+const HEX = 57421
+const INTEGRAL = 57422
+const FLOAT = 57423
+const DECIMAL_LITERAL = 57424
+const HEXNUM = 57425
+const VALUE_ARG = 57426
+const LIST_ARG = 57427
+const COMMENT = 57428
+const COMMENT_KEYWORD = 57429
+const BIT_LITERAL = 57430
+const NULL = 57431
+const TRUE = 57432
+const FALSE = 57433
+const UNKNOWN = 57434
+const OR = 57435
+const AND = 57436
+const NOT = 57437
+const BETWEEN = 57438
+const CASE = 57439
+const WHEN = 57440
+const THEN = 57441
+const ELSE = 57442
+const END = 57443
+const LE = 57444
+const GE = 57445
+const NE = 57446
+const NULL_SAFE_EQUAL = 57447
+const IS = 57448
+const LIKE = 57449
+const REGEXP = 57450
+const IN = 57451
+const MEMBER = 57452
+const SHIFT_LEFT = 57453
+const SHIFT_RIGHT = 57454
+const DIV = 57455
+const MOD = 57456
+const PIPE_CONCAT = 57457
+const UNARY = 57458
+const COLLATE = 57459
+const BINARY = 57460
+const UNDERSCORE_BINARY = 57461
+const INTERVAL = 57462
+const TYPECAST = 57463
+const JSON_EXTRACT_OP = 57464
+const JSON_UNQUOTE_EXTRACT_OP = 57465
+const CREATE = 57466
+const ALTER = 57467
+const DROP = 57468
+const RENAME = 57469
+const ANALYZE = 57470
+const ADD = 57471
+const FIRST = 57472
+const AFTER = 57473
+const SCHEMA = 57474
+const TABLE = 57475
+const INDEX = 57476
+const VIEW = 57477
+const TO = 57478
+const IF = 57479
+const UNIQUE = 57480
+const PRIMARY = 57481
+const COLUMN = 57482
+const CONSTRAINT = 57483
+const SPATIAL = 57484
+const FULLTEXT = 57485
+const FOREIGN = 57486
+const KEY_BLOCK_SIZE = 57487
+const REFERENCES = 57488
+const CASCADE = 57489
+const RESTRICT = 57490
+const SHOW = 57491
+const DESCRIBE = 57492
+const EXPLAIN = 57493
+const ESCAPE = 57494
+const REPAIR = 57495
+const OPTIMIZE = 57496
+const CHECK = 57497
+const TRUNCATE = 57498
+const MAXVALUE = 57499
+const PARTITION = 57500
+const REORGANIZE = 57501
+const LESS = 57502
+const THAN = 57503
+const PROCEDURE = 57504
+const TRIGGER = 57505
+const FUNCTION = 57506
+const EVENT = 57507
+const DEFINER = 57508
+const BEFORE = 57509
+const EACH = 57510
+const EVERY = 57511
+const STARTS = 57512
+const ENDS = 57513
+const OUT = 57514
+const INOUT = 57515
+const RETURN = 57516
+const DETERMINISTIC = 57517
+const SQL = 57518
+const READS = 57519
+const MODIFIES = 57520
+const VINDEX = 57521
+const VINDEXES = 57522
+const STATUS = 57523
+const VARIABLES = 57524
+const BEGIN = 57525
+const START = 57526
+const TRANSACTION = 57527
+const COMMIT = 57528
+const ROLLBACK = 57529
+const XA = 57530
+const DO = 57531
+const HANDLER = 57532
+const FLUSH = 57533
+const KILL = 57534
+const LOCAL = 57535
+const NO_WRITE_TO_BINLOG = 57536
+const UNLOCK = 57537
+const LOW_PRIORITY = 57538
+const CALL = 57539
+const CHANGE = 57540
+const STOP = 57541
+const RESET = 57542
+const PURGE = 57543
+const DELAYED = 57544
+const HIGH_PRIORITY = 57545
+const QUICK = 57546
+const CHECKSUM = 57547
+const CACHE = 57548
+const LOAD = 57549
+const PREPARE = 57550
+const EXECUTE = 57551
+const DEALLOCATE = 57552
+const IMMEDIATE = 57553
+const TOP = 57554
+const PERCENT = 57555
+const RETURNING = 57556
+const CONFLICT = 57557
+const NOTHING = 57558
+const OUTFILE = 57559
+const TERMINATED = 57560
+const ENCLOSED = 57561
+const OPTIONALLY = 57562
+const ESCAPED = 57563
+const LINES = 57564
+const STARTING = 57565
+const BIT = 57566
+const TINYINT = 57567
+const SMALLINT = 57568
+const MEDIUMINT = 57569
+const INT = 57570
+const INTEGER = 57571
+const BIGINT = 57572
+const INTNUM = 57573
+const REAL = 57574
+const DOUBLE = 57575
+const FLOAT_TYPE = 57576
+const DECIMAL = 57577
+const NUMERIC = 57578
+const DATETIME = 57579
+const YEAR = 57580
+const CHAR = 57581
+const VARCHAR = 57582
+const BOOL = 57583
+const CHARACTER = 57584
+const VARBINARY = 57585
+const NCHAR = 57586
+const TEXT = 57587
+const TINYTEXT = 57588
+const MEDIUMTEXT = 57589
+const LONGTEXT = 57590
+const BLOB = 57591
+const TINYBLOB = 57592
+const MEDIUMBLOB = 57593
+const LONGBLOB = 57594
+const JSON = 57595
+const ENUM = 57596
+const GEOMETRY = 57597
+const POINT = 57598
+const LINESTRING = 57599
+const POLYGON = 57600
+const GEOMETRYCOLLECTION = 57601
+const MULTIPOINT = 57602
+const MULTILINESTRING = 57603
+const MULTIPOLYGON = 57604
+const NULLX = 57605
+const AUTO_INCREMENT = 57606
+const APPROXNUM = 57607
+const SIGNED = 57608
+const UNSIGNED = 57609
+const ZEROFILL = 57610
+const DATABASES = 57611
+const TABLES = 57612
+const VITESS_KEYSPACES = 57613
+const VITESS_SHARDS = 57614
+const VITESS_TABLETS = 57615
+const VSCHEMA_TABLES = 57616
+const EXTENDED = 57617
+const FULL = 57618
+const PROCESSLIST = 57619
+const NAMES = 57620
+const CHARSET = 57621
+const GLOBAL = 57622
+const SESSION = 57623
+const ISOLATION = 57624
+const LEVEL = 57625
+const READ = 57626
+const WRITE = 57627
+const ONLY = 57628
+const REPEATABLE = 57629
+const COMMITTED = 57630
+const UNCOMMITTED = 57631
+const SERIALIZABLE = 57632
+const CURRENT_TIMESTAMP = 57633
+const DATABASE = 57634
+const CURRENT_DATE = 57635
+const CURRENT_USER = 57636
+const CURRENT_TIME = 57637
+const LOCALTIME = 57638
+const LOCALTIMESTAMP = 57639
+const UTC_DATE = 57640
+const UTC_TIME = 57641
+const UTC_TIMESTAMP = 57642
+const CONVERT = 57643
+const CAST = 57644
+const ARRAY = 57645
+const SUBSTR = 57646
+const SUBSTRING = 57647
+const EXTRACT = 57648
+const POSITION = 57649
+const TRIM = 57650
+const WEIGHT_STRING = 57651
+const BOTH = 57652
+const LEADING = 57653
+const TRAILING = 57654
+const GROUP_CONCAT = 57655
+const SEPARATOR = 57656
+const ROLLUP = 57657
+const OF = 57658
+const MATCH = 57659
+const AGAINST = 57660
+const BOOLEAN = 57661
+const LANGUAGE = 57662
+const EXPANSION = 57663
+const UNUSED = 57664
+const DELIMITER = 57665
+const EXTENSION_FUNC = 57666
 
 var yyToknames = [...]string{
 	"$end",
@@ -338,7 +533,6 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
-	"SELECT",
 	"STREAM",
 	"INSERT",
 	"UPDATE",
@@ -354,11 +548,9 @@ var yyToknames = [...]string{
 	"FOR",
 	"ALL",
 	"DISTINCT",
-	"AS",
 	"EXISTS",
 	"ASC",
 	"DESC",
-	"INTO",
 	"DUPLICATE",
 	"KEY",
 	"DEFAULT",
@@ -371,8 +563,20 @@ var yyToknames = [...]string{
 	"VALUE",
 	"SHARE",
 	"MODE",
+	"WINDOW",
+	"OVER",
+	"RECURSIVE",
+	"ROWS",
+	"RANGE",
+	"ROW",
+	"CURRENT",
 	"SQL_NO_CACHE",
 	"SQL_CACHE",
+	"SQL_CALC_FOUND_ROWS",
+	"SQL_SMALL_RESULT",
+	"SQL_BIG_RESULT",
+	"SQL_BUFFER_RESULT",
+	"DISTINCTROW",
 	"JOIN",
 	"STRAIGHT_JOIN",
 	"LEFT",
@@ -385,14 +589,30 @@ var yyToknames = [...]string{
 	"FORCE",
 	"ON",
 	"USING",
-	"'('",
 	"','",
 	"')'",
+	"SELECT",
+	"AS",
+	"IGNORE",
+	"REPLACE",
+	"TABLE_OPTIONS_END",
+	"INTO_END",
+	"INTO",
+	"DATE",
+	"TIME",
+	"TIMESTAMP",
+	"STRING",
+	"WITH",
+	"QUERY",
+	"'('",
 	"ID",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
 	"HEX",
-	"STRING",
 	"INTEGRAL",
 	"FLOAT",
+	"DECIMAL_LITERAL",
 	"HEXNUM",
 	"VALUE_ARG",
 	"LIST_ARG",
@@ -402,6 +622,7 @@ var yyToknames = [...]string{
 	"NULL",
 	"TRUE",
 	"FALSE",
+	"UNKNOWN",
 	"OR",
 	"AND",
 	"NOT",
@@ -423,6 +644,7 @@ var yyToknames = [...]string{
 	"LIKE",
 	"REGEXP",
 	"IN",
+	"MEMBER",
 	"'|'",
 	"'&'",
 	"SHIFT_LEFT",
@@ -435,12 +657,14 @@ var yyToknames = [...]string{
 	"'%'",
 	"MOD",
 	"'^'",
+	"PIPE_CONCAT",
 	"'~'",
 	"UNARY",
 	"COLLATE",
 	"BINARY",
 	"UNDERSCORE_BINARY",
 	"INTERVAL",
+	"TYPECAST",
 	"'.'",
 	"JSON_EXTRACT_OP",
 	"JSON_UNQUOTE_EXTRACT_OP",
@@ -450,12 +674,13 @@ var yyToknames = [...]string{
 	"RENAME",
 	"ANALYZE",
 	"ADD",
+	"FIRST",
+	"AFTER",
 	"SCHEMA",
 	"TABLE",
 	"INDEX",
 	"VIEW",
 	"TO",
-	"IGNORE",
 	"IF",
 	"UNIQUE",
 	"PRIMARY",
@@ -465,13 +690,16 @@ var yyToknames = [...]string{
 	"FULLTEXT",
 	"FOREIGN",
 	"KEY_BLOCK_SIZE",
+	"REFERENCES",
+	"CASCADE",
+	"RESTRICT",
 	"SHOW",
 	"DESCRIBE",
 	"EXPLAIN",
-	"DATE",
 	"ESCAPE",
 	"REPAIR",
 	"OPTIMIZE",
+	"CHECK",
 	"TRUNCATE",
 	"MAXVALUE",
 	"PARTITION",
@@ -480,6 +708,21 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"FUNCTION",
+	"EVENT",
+	"DEFINER",
+	"BEFORE",
+	"EACH",
+	"EVERY",
+	"STARTS",
+	"ENDS",
+	"OUT",
+	"INOUT",
+	"RETURN",
+	"DETERMINISTIC",
+	"SQL",
+	"READS",
+	"MODIFIES",
 	"VINDEX",
 	"VINDEXES",
 	"STATUS",
@@ -489,6 +732,42 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"XA",
+	"DO",
+	"HANDLER",
+	"FLUSH",
+	"KILL",
+	"LOCAL",
+	"NO_WRITE_TO_BINLOG",
+	"UNLOCK",
+	"LOW_PRIORITY",
+	"CALL",
+	"CHANGE",
+	"STOP",
+	"RESET",
+	"PURGE",
+	"DELAYED",
+	"HIGH_PRIORITY",
+	"QUICK",
+	"CHECKSUM",
+	"CACHE",
+	"LOAD",
+	"PREPARE",
+	"EXECUTE",
+	"DEALLOCATE",
+	"IMMEDIATE",
+	"TOP",
+	"PERCENT",
+	"RETURNING",
+	"CONFLICT",
+	"NOTHING",
+	"OUTFILE",
+	"TERMINATED",
+	"ENCLOSED",
+	"OPTIONALLY",
+	"ESCAPED",
+	"LINES",
+	"STARTING",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -502,8 +781,6 @@ var yyToknames = [...]string{
 	"FLOAT_TYPE",
 	"DECIMAL",
 	"NUMERIC",
-	"TIME",
-	"TIMESTAMP",
 	"DATETIME",
 	"YEAR",
 	"CHAR",
@@ -561,29 +838,42 @@ var yyToknames = [...]string{
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_DATE",
+	"CURRENT_USER",
 	"CURRENT_TIME",
 	"LOCALTIME",
 	"LOCALTIMESTAMP",
 	"UTC_DATE",
 	"UTC_TIME",
 	"UTC_TIMESTAMP",
-	"REPLACE",
 	"CONVERT",
 	"CAST",
+	"ARRAY",
 	"SUBSTR",
 	"SUBSTRING",
+	"EXTRACT",
+	"POSITION",
+	"TRIM",
+	"WEIGHT_STRING",
+	"BOTH",
+	"LEADING",
+	"TRAILING",
 	"GROUP_CONCAT",
 	"SEPARATOR",
+	"ROLLUP",
+	"OF",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
 	"LANGUAGE",
-	"WITH",
-	"QUERY",
 	"EXPANSION",
 	"UNUSED",
+	"DELIMITER",
+	"EXTENSION_FUNC",
 	"';'",
+	"'{'",
+	"'}'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
@@ -591,1455 +881,3199 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 3,
-	5, 27,
-	-2, 4,
-	-1, 36,
-	150, 263,
-	151, 263,
-	-2, 253,
-	-1, 238,
-	109, 588,
-	-2, 584,
-	-1, 239,
-	109, 589,
-	-2, 585,
-	-1, 308,
-	80, 747,
-	-2, 58,
-	-1, 309,
-	80, 708,
-	-2, 59,
-	-1, 314,
-	80, 692,
-	-2, 550,
-	-1, 316,
-	80, 729,
-	-2, 552,
-	-1, 576,
-	52, 41,
-	54, 41,
-	-2, 43,
-	-1, 709,
-	109, 591,
-	-2, 587,
-	-1, 914,
-	5, 28,
-	-2, 396,
-	-1, 939,
-	5, 27,
-	-2, 525,
-	-1, 1164,
-	5, 28,
-	-2, 526,
-	-1, 1208,
-	5, 27,
-	-2, 528,
-	-1, 1270,
-	5, 28,
-	-2, 529,
+	-1, 39,
+	5, 55,
+	-2, 46,
+	-1, 48,
+	65, 193,
+	-2, 98,
+	-1, 51,
+	65, 193,
+	-2, 352,
+	-1, 62,
+	198, 476,
+	199, 476,
+	-2, 466,
+	-1, 99,
+	5, 55,
+	-2, 47,
+	-1, 250,
+	1, 89,
+	342, 89,
+	-2, 1003,
+	-1, 253,
+	5, 55,
+	-2, 92,
+	-1, 414,
+	151, 1191,
+	-2, 1001,
+	-1, 415,
+	151, 1243,
+	-2, 1001,
+	-1, 416,
+	151, 1201,
+	-2, 1001,
+	-1, 555,
+	138, 1032,
+	-2, 1027,
+	-1, 556,
+	138, 1033,
+	-2, 1028,
+	-1, 563,
+	138, 1032,
+	-2, 1027,
+	-1, 633,
+	106, 1254,
+	138, 1254,
+	-2, 87,
+	-1, 634,
+	106, 1204,
+	138, 1204,
+	-2, 88,
+	-1, 638,
+	106, 1173,
+	138, 1173,
+	-2, 993,
+	-1, 640,
+	106, 1229,
+	138, 1229,
+	-2, 995,
+	-1, 645,
+	5, 55,
+	-2, 93,
+	-1, 908,
+	77, 1027,
+	138, 1032,
+	-2, 517,
+	-1, 919,
+	5, 56,
+	-2, 10,
+	-1, 970,
+	5, 55,
+	-2, 94,
+	-1, 1026,
+	5, 55,
+	-2, 191,
+	-1, 1220,
+	138, 1035,
+	-2, 1031,
+	-1, 1221,
+	138, 1036,
+	-2, 1029,
+	-1, 1235,
+	10, 1169,
+	63, 1169,
+	77, 1169,
+	96, 1169,
+	97, 1169,
+	98, 1169,
+	100, 1169,
+	106, 1169,
+	107, 1169,
+	108, 1169,
+	109, 1169,
+	110, 1169,
+	111, 1169,
+	112, 1169,
+	113, 1169,
+	114, 1169,
+	115, 1169,
+	116, 1169,
+	117, 1169,
+	118, 1169,
+	119, 1169,
+	120, 1169,
+	121, 1169,
+	122, 1169,
+	123, 1169,
+	124, 1169,
+	125, 1169,
+	126, 1169,
+	127, 1169,
+	128, 1169,
+	129, 1169,
+	130, 1169,
+	133, 1169,
+	137, 1169,
+	138, 1169,
+	139, 1169,
+	140, 1169,
+	-2, 820,
+	-1, 1236,
+	10, 1215,
+	63, 1215,
+	77, 1215,
+	96, 1215,
+	97, 1215,
+	98, 1215,
+	100, 1215,
+	106, 1215,
+	107, 1215,
+	108, 1215,
+	109, 1215,
+	110, 1215,
+	111, 1215,
+	112, 1215,
+	113, 1215,
+	114, 1215,
+	115, 1215,
+	116, 1215,
+	117, 1215,
+	118, 1215,
+	119, 1215,
+	120, 1215,
+	121, 1215,
+	122, 1215,
+	123, 1215,
+	124, 1215,
+	125, 1215,
+	126, 1215,
+	127, 1215,
+	128, 1215,
+	129, 1215,
+	130, 1215,
+	133, 1215,
+	137, 1215,
+	138, 1215,
+	139, 1215,
+	140, 1215,
+	-2, 821,
+	-1, 1237,
+	10, 1271,
+	63, 1271,
+	77, 1271,
+	96, 1271,
+	97, 1271,
+	98, 1271,
+	100, 1271,
+	106, 1271,
+	107, 1271,
+	108, 1271,
+	109, 1271,
+	110, 1271,
+	111, 1271,
+	112, 1271,
+	113, 1271,
+	114, 1271,
+	115, 1271,
+	116, 1271,
+	117, 1271,
+	118, 1271,
+	119, 1271,
+	120, 1271,
+	121, 1271,
+	122, 1271,
+	123, 1271,
+	124, 1271,
+	125, 1271,
+	126, 1271,
+	127, 1271,
+	128, 1271,
+	129, 1271,
+	130, 1271,
+	133, 1271,
+	137, 1271,
+	138, 1271,
+	139, 1271,
+	140, 1271,
+	-2, 822,
+	-1, 1280,
+	213, 1245,
+	301, 1245,
+	302, 1245,
+	-2, 564,
+	-1, 1281,
+	213, 1290,
+	301, 1290,
+	302, 1290,
+	-2, 566,
+	-1, 1352,
+	5, 55,
+	-2, 95,
+	-1, 1406,
+	63, 154,
+	-2, 159,
+	-1, 1407,
+	63, 154,
+	-2, 159,
+	-1, 1480,
+	5, 56,
+	-2, 740,
+	-1, 1755,
+	61, 70,
+	62, 70,
+	-2, 72,
+	-1, 1957,
+	5, 56,
+	-2, 958,
+	-1, 2158,
+	5, 56,
+	-2, 961,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 10902
-
-var yyAct = [...]int{
+const yyLast = 24472
 
-	269, 47, 1261, 856, 647, 771, 1072, 522, 1219, 1100,
-	836, 268, 790, 1073, 1001, 570, 812, 850, 808, 1069,
-	568, 811, 1170, 211, 453, 53, 521, 3, 772, 959,
-	313, 744, 217, 906, 1046, 1004, 942, 734, 741, 992,
-	822, 947, 586, 760, 243, 946, 455, 461, 47, 711,
-	846, 410, 585, 307, 295, 768, 222, 557, 294, 572,
-	888, 475, 299, 226, 241, 304, 302, 212, 213, 214,
-	215, 52, 1290, 1280, 216, 467, 1288, 1268, 830, 1286,
-	873, 857, 293, 1279, 1064, 1158, 414, 967, 1228, 1094,
-	966, 230, 803, 968, 872, 435, 450, 536, 1267, 245,
-	1243, 488, 487, 497, 498, 490, 491, 492, 493, 494,
-	495, 496, 489, 57, 983, 499, 181, 177, 178, 179,
-	1182, 877, 1095, 1096, 676, 1106, 1107, 1108, 804, 805,
-	871, 677, 829, 1111, 1109, 587, 423, 588, 59, 60,
-	61, 62, 63, 837, 1197, 1147, 1145, 210, 1287, 446,
-	447, 1285, 1220, 1262, 1025, 769, 824, 424, 437, 417,
-	439, 298, 1226, 175, 174, 1222, 175, 239, 655, 791,
-	793, 824, 646, 958, 957, 824, 956, 412, 868, 865,
-	866, 420, 864, 189, 411, 436, 438, 176, 511, 512,
-	1248, 1167, 1033, 922, 441, 441, 441, 441, 76, 441,
-	900, 683, 186, 977, 1022, 186, 441, 875, 878, 809,
-	1024, 487, 497, 498, 490, 491, 492, 493, 494, 495,
-	496, 489, 479, 47, 499, 180, 430, 499, 680, 186,
-	186, 76, 1221, 489, 883, 186, 499, 76, 508, 472,
-	474, 510, 870, 792, 1253, 1115, 463, 1029, 823, 464,
-	1125, 945, 589, 821, 819, 474, 1244, 820, 1066, 1227,
-	1225, 761, 650, 823, 869, 837, 434, 823, 520, 981,
-	524, 525, 526, 527, 528, 529, 530, 531, 532, 416,
-	535, 537, 537, 537, 537, 537, 537, 537, 537, 545,
-	546, 547, 548, 1110, 1266, 1116, 761, 1256, 929, 1272,
-	569, 874, 1023, 682, 1021, 490, 491, 492, 493, 494,
-	495, 496, 489, 884, 876, 499, 426, 427, 428, 473,
-	472, 469, 826, 1028, 1047, 1188, 1068, 827, 1187, 195,
-	492, 493, 494, 495, 496, 489, 474, 465, 499, 681,
-	509, 173, 186, 735, 186, 736, 1273, 686, 687, 50,
-	186, 418, 419, 205, 1049, 473, 472, 186, 996, 714,
-	718, 76, 76, 76, 76, 995, 76, 984, 577, 1254,
-	1204, 583, 474, 76, 716, 717, 715, 919, 236, 538,
-	539, 540, 541, 542, 543, 544, 1051, 1185, 1055, 918,
-	1050, 917, 1048, 473, 472, 473, 472, 1053, 1133, 298,
-	76, 993, 292, 190, 1276, 454, 1052, 473, 472, 192,
-	474, 454, 474, 1232, 441, 1251, 198, 194, 1103, 1054,
-	1056, 1102, 441, 978, 474, 473, 472, 701, 703, 704,
-	1212, 1259, 702, 441, 441, 441, 441, 441, 441, 441,
-	441, 969, 474, 196, 1212, 454, 200, 441, 441, 859,
-	497, 498, 490, 491, 492, 493, 494, 495, 496, 489,
-	186, 664, 499, 897, 898, 899, 737, 186, 186, 186,
-	661, 267, 660, 76, 191, 1212, 1213, 1179, 1178, 76,
-	651, 1091, 454, 258, 257, 260, 261, 262, 263, 689,
-	649, 662, 259, 264, 712, 644, 688, 1166, 454, 1122,
-	1121, 193, 74, 201, 202, 203, 204, 208, 1118, 1119,
-	709, 47, 207, 206, 1118, 1117, 559, 562, 563, 564,
-	560, 23, 561, 565, 580, 524, 948, 949, 432, 690,
-	912, 454, 553, 454, 21, 312, 746, 454, 705, 425,
-	707, 415, 596, 595, 1231, 937, 745, 747, 938, 411,
-	1070, 748, 1112, 943, 299, 299, 299, 299, 299, 299,
-	552, 232, 763, 943, 797, 581, 579, 579, 50, 50,
-	569, 746, 794, 1162, 753, 756, 738, 739, 553, 299,
-	762, 76, 713, 1124, 553, 765, 1120, 186, 186, 76,
-	221, 186, 970, 789, 186, 23, 748, 773, 186, 798,
-	76, 76, 76, 76, 76, 76, 76, 76, 758, 775,
-	776, 777, 774, 779, 76, 76, 778, 944, 802, 186,
-	838, 839, 840, 787, 23, 513, 514, 515, 516, 517,
-	518, 519, 796, 795, 76, 54, 800, 801, 186, 1036,
-	912, 582, 50, 1192, 76, 684, 944, 924, 816, 223,
-	441, 1207, 441, 298, 298, 298, 298, 298, 298, 553,
-	441, 852, 921, 831, 851, 312, 312, 312, 312, 298,
-	312, 50, 1085, 648, 912, 973, 847, 312, 298, 842,
-	948, 949, 912, 832, 833, 834, 835, 76, 943, 854,
-	923, 848, 849, 841, 65, 1105, 50, 1070, 997, 843,
-	844, 845, 696, 952, 477, 920, 658, 451, 784, 901,
-	782, 955, 954, 785, 709, 783, 781, 786, 186, 563,
-	564, 186, 186, 186, 186, 186, 186, 712, 780, 227,
-	228, 1284, 889, 1278, 186, 890, 1032, 186, 885, 1283,
-	895, 186, 468, 988, 894, 594, 186, 186, 456, 433,
-	76, 559, 562, 563, 564, 560, 466, 561, 565, 980,
-	457, 1258, 909, 76, 1257, 902, 910, 1205, 442, 940,
-	941, 974, 1160, 914, 915, 916, 1193, 312, 861, 657,
-	458, 462, 925, 591, 567, 224, 225, 931, 468, 932,
-	933, 934, 935, 893, 218, 939, 1237, 480, 299, 219,
-	54, 892, 1236, 928, 1195, 944, 470, 1245, 1183, 679,
-	56, 58, 578, 51, 186, 713, 1, 76, 962, 76,
-	953, 858, 950, 186, 1000, 867, 186, 76, 971, 1260,
-	743, 523, 310, 961, 1218, 963, 1099, 818, 810, 409,
-	534, 64, 1252, 985, 986, 964, 817, 1224, 1181, 825,
-	982, 828, 1104, 1255, 979, 987, 441, 989, 990, 991,
-	710, 975, 976, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 729, 730, 731, 732, 733, 601, 599,
-	600, 441, 994, 598, 603, 312, 602, 1003, 597, 197,
-	305, 566, 590, 312, 853, 471, 66, 298, 1020, 1019,
-	863, 1017, 1027, 675, 312, 312, 312, 312, 312, 312,
-	312, 312, 882, 449, 199, 507, 891, 965, 312, 312,
-	488, 487, 497, 498, 490, 491, 492, 493, 494, 495,
-	496, 489, 311, 1077, 499, 685, 1044, 1040, 692, 1075,
-	1039, 47, 460, 1071, 1045, 1235, 1194, 927, 477, 533,
-	1074, 312, 1065, 709, 1058, 759, 1057, 1087, 1088, 1089,
-	244, 700, 76, 256, 253, 186, 907, 1076, 1080, 255,
-	254, 1079, 1081, 691, 936, 481, 242, 234, 1012, 76,
-	297, 549, 773, 555, 1090, 558, 556, 1092, 773, 1098,
-	554, 740, 1097, 951, 296, 1035, 1157, 1242, 695, 1093,
-	25, 754, 754, 55, 1113, 1114, 1010, 754, 229, 19,
-	18, 17, 20, 16, 15, 14, 29, 13, 12, 11,
-	10, 9, 76, 76, 754, 76, 8, 7, 1126, 6,
-	5, 4, 220, 440, 698, 699, 22, 299, 2, 0,
-	0, 1128, 0, 0, 1131, 0, 0, 0, 76, 0,
-	0, 186, 186, 0, 312, 0, 0, 1136, 0, 186,
-	0, 0, 0, 0, 1137, 1156, 0, 312, 76, 1143,
-	1011, 1139, 1135, 0, 310, 1016, 1013, 1006, 1007, 1014,
-	1009, 1008, 1148, 1149, 1150, 0, 523, 1153, 0, 751,
-	752, 1161, 1015, 0, 0, 903, 904, 905, 1018, 0,
-	1163, 1164, 1165, 0, 1168, 0, 1175, 0, 76, 76,
-	1172, 1173, 1174, 1169, 971, 0, 0, 0, 0, 0,
-	0, 312, 0, 312, 441, 1177, 1140, 1141, 1184, 1142,
-	1186, 312, 1144, 0, 1146, 0, 298, 0, 76, 1190,
-	76, 76, 1191, 0, 0, 0, 0, 0, 0, 0,
-	0, 807, 1196, 0, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 1075, 0, 186, 1209, 0, 0, 0,
-	0, 0, 0, 76, 1074, 0, 1206, 0, 0, 0,
-	1180, 0, 1203, 0, 0, 0, 76, 186, 1217, 1223,
-	0, 0, 1208, 76, 1234, 0, 0, 1214, 1215, 1216,
-	459, 76, 0, 1229, 186, 1230, 0, 0, 1075, 0,
-	47, 0, 0, 0, 1246, 1233, 0, 0, 0, 1074,
-	0, 0, 0, 1238, 1239, 1240, 1241, 1250, 443, 444,
-	445, 0, 448, 0, 0, 184, 1247, 0, 209, 452,
-	0, 0, 0, 886, 887, 1264, 462, 0, 708, 0,
-	0, 1269, 0, 76, 0, 76, 76, 76, 186, 76,
-	233, 0, 184, 184, 1274, 76, 960, 1265, 184, 0,
-	0, 0, 1270, 0, 0, 0, 0, 0, 1281, 1282,
-	0, 0, 0, 312, 0, 1275, 1042, 1043, 1289, 0,
-	773, 76, 76, 76, 0, 0, 0, 0, 0, 1059,
-	1060, 0, 1062, 1063, 0, 1012, 0, 0, 913, 0,
-	0, 0, 0, 0, 0, 0, 1293, 1294, 0, 0,
-	0, 0, 0, 930, 0, 0, 998, 312, 0, 312,
-	0, 0, 0, 1010, 76, 76, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 76, 0, 0,
-	0, 310, 312, 0, 0, 0, 749, 750, 0, 0,
-	76, 300, 757, 0, 813, 0, 0, 0, 0, 0,
-	0, 0, 312, 0, 0, 184, 764, 184, 766, 767,
-	76, 0, 0, 184, 0, 0, 0, 0, 0, 0,
-	184, 0, 0, 0, 312, 0, 183, 1011, 0, 0,
-	0, 0, 1016, 1013, 1006, 1007, 1014, 1009, 1008, 754,
-	0, 0, 1078, 960, 0, 754, 76, 0, 0, 1015,
-	1138, 0, 0, 0, 303, 1005, 0, 0, 0, 413,
-	76, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 0, 312, 1101, 0, 645, 0, 0,
-	0, 0, 708, 0, 0, 654, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 665, 666, 667, 668,
-	669, 670, 671, 672, 0, 0, 0, 1127, 0, 0,
-	673, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	1129, 1067, 0, 184, 0, 0, 0, 1132, 0, 0,
-	184, 574, 184, 0, 0, 312, 1082, 1083, 0, 0,
-	1084, 0, 0, 0, 1086, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 896, 0, 0, 0,
-	1198, 1199, 0, 1200, 1201, 1202, 421, 0, 422, 0,
-	0, 0, 0, 0, 429, 0, 0, 0, 0, 0,
-	0, 431, 0, 0, 0, 0, 0, 1171, 0, 1171,
-	1171, 1171, 0, 1176, 1154, 454, 0, 0, 0, 312,
-	0, 0, 0, 911, 0, 0, 0, 0, 0, 0,
-	813, 0, 0, 0, 0, 0, 0, 0, 0, 926,
-	0, 1134, 0, 0, 0, 312, 312, 312, 0, 0,
-	0, 488, 487, 497, 498, 490, 491, 492, 493, 494,
-	495, 496, 489, 0, 0, 499, 0, 0, 0, 0,
-	184, 184, 0, 0, 184, 0, 1002, 184, 0, 0,
-	1159, 663, 0, 0, 0, 0, 0, 523, 1210, 1211,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1101, 184, 0, 551, 0, 0, 0, 0, 0,
-	0, 0, 0, 576, 1171, 0, 0, 0, 1291, 1038,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	663, 0, 0, 860, 1249, 862, 483, 0, 486, 0,
-	0, 1061, 0, 881, 500, 501, 502, 503, 504, 505,
-	506, 0, 484, 485, 482, 488, 487, 497, 498, 490,
-	491, 492, 493, 494, 495, 496, 489, 754, 0, 499,
-	1271, 233, 0, 0, 0, 0, 233, 233, 0, 0,
-	755, 755, 233, 0, 1277, 0, 755, 0, 0, 813,
-	0, 813, 0, 0, 0, 0, 233, 233, 233, 233,
-	0, 184, 0, 755, 184, 184, 184, 184, 184, 184,
-	0, 0, 0, 0, 0, 0, 0, 788, 0, 0,
-	184, 0, 0, 0, 574, 0, 0, 0, 0, 184,
-	184, 652, 653, 0, 0, 656, 0, 0, 659, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1263, 523,
-	0, 0, 1038, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 678, 0, 0, 0, 23, 24, 48,
-	26, 27, 0, 0, 0, 0, 0, 0, 0, 0,
-	1151, 454, 697, 0, 0, 0, 42, 0, 0, 0,
-	0, 28, 0, 0, 0, 0, 0, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 184, 0, 0, 184,
-	37, 0, 0, 454, 50, 0, 813, 488, 487, 497,
-	498, 490, 491, 492, 493, 494, 495, 496, 489, 0,
-	0, 499, 0, 0, 663, 0, 0, 0, 0, 999,
-	0, 0, 0, 1002, 813, 0, 233, 618, 0, 488,
-	487, 497, 498, 490, 491, 492, 493, 494, 495, 496,
-	489, 1155, 770, 499, 1026, 0, 0, 0, 0, 0,
-	0, 0, 0, 30, 31, 33, 32, 35, 488, 487,
-	497, 498, 490, 491, 492, 493, 494, 495, 496, 489,
-	0, 799, 499, 233, 36, 43, 44, 0, 0, 45,
-	46, 34, 0, 0, 0, 0, 0, 0, 0, 233,
-	0, 0, 0, 38, 39, 0, 40, 41, 0, 0,
-	0, 0, 0, 606, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 488, 487, 497, 498, 490, 491, 492,
-	493, 494, 495, 496, 489, 1152, 0, 499, 184, 0,
-	0, 0, 0, 619, 0, 0, 0, 0, 855, 0,
-	0, 0, 0, 0, 0, 0, 0, 879, 0, 0,
-	880, 0, 0, 0, 632, 633, 634, 635, 636, 637,
-	638, 0, 639, 640, 641, 642, 643, 620, 621, 622,
-	623, 604, 605, 0, 0, 607, 49, 608, 609, 610,
-	611, 612, 613, 614, 615, 616, 617, 624, 625, 626,
-	627, 628, 629, 630, 631, 0, 0, 488, 487, 497,
-	498, 490, 491, 492, 493, 494, 495, 496, 489, 0,
-	0, 499, 0, 0, 1030, 1031, 0, 0, 0, 0,
-	0, 0, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 1041, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 663, 0, 488, 487, 497, 498, 490,
-	491, 492, 493, 494, 495, 496, 489, 0, 755, 499,
-	908, 0, 0, 0, 755, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1189, 0, 0,
-	488, 487, 497, 498, 490, 491, 492, 493, 494, 495,
-	496, 489, 0, 0, 499, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1034, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 574, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 398, 388, 0, 360, 400, 338,
-	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
-	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
-	371, 107, 406, 109, 376, 0, 142, 118, 0, 1123,
-	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
-	379, 402, 0, 0, 0, 75, 0, 814, 815, 0,
-	0, 1130, 0, 0, 86, 0, 378, 397, 349, 380,
-	318, 377, 0, 322, 325, 407, 395, 344, 345, 972,
-	0, 0, 0, 0, 0, 0, 363, 367, 383, 357,
-	0, 0, 0, 0, 0, 0, 0, 0, 342, 0,
-	374, 0, 0, 0, 326, 323, 0, 361, 0, 0,
-	0, 328, 0, 343, 384, 0, 317, 387, 393, 358,
-	187, 396, 356, 355, 399, 131, 755, 0, 145, 98,
-	97, 106, 391, 340, 348, 89, 346, 137, 127, 157,
-	373, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 321, 0,
-	143, 159, 172, 336, 394, 165, 166, 167, 168, 0,
-	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 332, 335, 330, 331, 369,
-	370, 403, 404, 405, 385, 327, 0, 333, 334, 0,
-	389, 372, 77, 0, 108, 169, 133, 95, 160, 398,
-	388, 0, 360, 400, 338, 352, 408, 353, 354, 381,
-	324, 368, 125, 350, 0, 341, 319, 347, 320, 339,
-	362, 93, 365, 337, 390, 371, 107, 406, 109, 376,
-	0, 142, 118, 0, 0, 364, 392, 366, 386, 359,
-	382, 329, 375, 401, 351, 379, 402, 0, 0, 0,
-	75, 0, 814, 815, 0, 0, 0, 0, 0, 86,
-	0, 378, 397, 349, 380, 318, 377, 0, 322, 325,
-	407, 395, 344, 345, 0, 0, 0, 0, 0, 0,
-	0, 363, 367, 383, 357, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 374, 0, 0, 0, 326,
-	323, 0, 361, 0, 0, 0, 328, 0, 343, 384,
-	0, 317, 387, 393, 358, 187, 396, 356, 355, 399,
-	131, 0, 0, 145, 98, 97, 106, 391, 340, 348,
-	89, 346, 137, 127, 157, 373, 128, 136, 110, 149,
-	132, 156, 188, 164, 147, 163, 78, 146, 155, 87,
-	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
-	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
-	82, 84, 161, 123, 148, 154, 117, 114, 81, 152,
-	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
-	119, 121, 0, 321, 0, 143, 159, 172, 336, 394,
-	165, 166, 167, 168, 0, 0, 0, 122, 85, 101,
-	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
-	332, 335, 330, 331, 369, 370, 403, 404, 405, 385,
-	327, 0, 333, 334, 0, 389, 372, 77, 0, 108,
-	169, 133, 95, 160, 398, 388, 0, 360, 400, 338,
-	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
-	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
-	371, 107, 406, 109, 376, 0, 142, 118, 0, 0,
-	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
-	379, 402, 50, 0, 0, 75, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 0, 378, 397, 349, 380,
-	318, 377, 0, 322, 325, 407, 395, 344, 345, 0,
-	0, 0, 0, 0, 0, 0, 363, 367, 383, 357,
-	0, 0, 0, 0, 0, 0, 0, 0, 342, 0,
-	374, 0, 0, 0, 326, 323, 0, 361, 0, 0,
-	0, 328, 0, 343, 384, 0, 317, 387, 393, 358,
-	187, 396, 356, 355, 399, 131, 0, 0, 145, 98,
-	97, 106, 391, 340, 348, 89, 346, 137, 127, 157,
-	373, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 321, 0,
-	143, 159, 172, 336, 394, 165, 166, 167, 168, 0,
-	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 332, 335, 330, 331, 369,
-	370, 403, 404, 405, 385, 327, 0, 333, 334, 0,
-	389, 372, 77, 0, 108, 169, 133, 95, 160, 398,
-	388, 0, 360, 400, 338, 352, 408, 353, 354, 381,
-	324, 368, 125, 350, 0, 341, 319, 347, 320, 339,
-	362, 93, 365, 337, 390, 371, 107, 406, 109, 376,
-	0, 142, 118, 0, 0, 364, 392, 366, 386, 359,
-	382, 329, 375, 401, 351, 379, 402, 0, 0, 0,
-	75, 0, 0, 0, 0, 0, 0, 0, 0, 86,
-	0, 378, 397, 349, 380, 318, 377, 0, 322, 325,
-	407, 395, 344, 345, 0, 0, 0, 0, 0, 0,
-	0, 363, 367, 383, 357, 0, 0, 0, 0, 0,
-	0, 1037, 0, 342, 0, 374, 0, 0, 0, 326,
-	323, 0, 361, 0, 0, 0, 328, 0, 343, 384,
-	0, 317, 387, 393, 358, 187, 396, 356, 355, 399,
-	131, 0, 0, 145, 98, 97, 106, 391, 340, 348,
-	89, 346, 137, 127, 157, 373, 128, 136, 110, 149,
-	132, 156, 188, 164, 147, 163, 78, 146, 155, 87,
-	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
-	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
-	82, 84, 161, 123, 148, 154, 117, 114, 81, 152,
-	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
-	119, 121, 0, 321, 0, 143, 159, 172, 336, 394,
-	165, 166, 167, 168, 0, 0, 0, 122, 85, 101,
-	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
-	332, 335, 330, 331, 369, 370, 403, 404, 405, 385,
-	327, 0, 333, 334, 0, 389, 372, 77, 0, 108,
-	169, 133, 95, 160, 398, 388, 0, 360, 400, 338,
-	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
-	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
-	371, 107, 406, 109, 376, 0, 142, 118, 0, 0,
-	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
-	379, 402, 0, 0, 0, 238, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 0, 378, 397, 349, 380,
-	318, 377, 0, 322, 325, 407, 395, 344, 345, 0,
-	0, 0, 0, 0, 0, 0, 363, 367, 383, 357,
-	0, 0, 0, 0, 0, 0, 706, 0, 342, 0,
-	374, 0, 0, 0, 326, 323, 0, 361, 0, 0,
-	0, 328, 0, 343, 384, 0, 317, 387, 393, 358,
-	187, 396, 356, 355, 399, 131, 0, 0, 145, 98,
-	97, 106, 391, 340, 348, 89, 346, 137, 127, 157,
-	373, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 321, 0,
-	143, 159, 172, 336, 394, 165, 166, 167, 168, 0,
-	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 332, 335, 330, 331, 369,
-	370, 403, 404, 405, 385, 327, 0, 333, 334, 0,
-	389, 372, 77, 0, 108, 169, 133, 95, 160, 398,
-	388, 0, 360, 400, 338, 352, 408, 353, 354, 381,
-	324, 368, 125, 350, 0, 341, 319, 347, 320, 339,
-	362, 93, 365, 337, 390, 371, 107, 406, 109, 376,
-	0, 142, 118, 0, 0, 364, 392, 366, 386, 359,
-	382, 329, 375, 401, 351, 379, 402, 0, 0, 0,
-	75, 0, 0, 0, 0, 0, 0, 0, 0, 86,
-	0, 378, 397, 349, 380, 318, 377, 0, 322, 325,
-	407, 395, 344, 345, 0, 0, 0, 0, 0, 0,
-	0, 363, 367, 383, 357, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 374, 0, 0, 0, 326,
-	323, 0, 361, 0, 0, 0, 328, 0, 343, 384,
-	0, 317, 387, 393, 358, 187, 396, 356, 355, 399,
-	131, 0, 0, 145, 98, 97, 106, 391, 340, 348,
-	89, 346, 137, 127, 157, 373, 128, 136, 110, 149,
-	132, 156, 188, 164, 147, 163, 78, 146, 155, 87,
-	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
-	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
-	82, 84, 161, 123, 148, 154, 117, 114, 81, 152,
-	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
-	119, 121, 0, 321, 0, 143, 159, 172, 336, 394,
-	165, 166, 167, 168, 0, 0, 0, 122, 85, 101,
-	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
-	332, 335, 330, 331, 369, 370, 403, 404, 405, 385,
-	327, 0, 333, 334, 0, 389, 372, 77, 0, 108,
-	169, 133, 95, 160, 398, 388, 0, 360, 400, 338,
-	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
-	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
-	371, 107, 406, 109, 376, 0, 142, 118, 0, 0,
-	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
-	379, 402, 0, 0, 0, 238, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 0, 378, 397, 349, 380,
-	318, 377, 0, 322, 325, 407, 395, 344, 345, 0,
-	0, 0, 0, 0, 0, 0, 363, 367, 383, 357,
-	0, 0, 0, 0, 0, 0, 0, 0, 342, 0,
-	374, 0, 0, 0, 326, 323, 0, 361, 0, 0,
-	0, 328, 0, 343, 384, 0, 317, 387, 393, 358,
-	187, 396, 356, 355, 399, 131, 0, 0, 145, 98,
-	97, 106, 391, 340, 348, 89, 346, 137, 127, 157,
-	373, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 321, 0,
-	143, 159, 172, 336, 394, 165, 166, 167, 168, 0,
-	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 332, 335, 330, 331, 369,
-	370, 403, 404, 405, 385, 327, 0, 333, 334, 0,
-	389, 372, 77, 0, 108, 169, 133, 95, 160, 398,
-	388, 0, 360, 400, 338, 352, 408, 353, 354, 381,
-	324, 368, 125, 350, 0, 341, 319, 347, 320, 339,
-	362, 93, 365, 337, 390, 371, 107, 406, 109, 376,
-	0, 142, 118, 0, 0, 364, 392, 366, 386, 359,
-	382, 329, 375, 401, 351, 379, 402, 0, 0, 0,
-	75, 0, 0, 0, 0, 0, 0, 0, 0, 86,
-	0, 378, 397, 349, 380, 318, 377, 0, 322, 325,
-	407, 395, 344, 345, 0, 0, 0, 0, 0, 0,
-	0, 363, 367, 383, 357, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 374, 0, 0, 0, 326,
-	323, 0, 361, 0, 0, 0, 328, 0, 343, 384,
-	0, 317, 387, 393, 358, 187, 396, 356, 355, 399,
-	131, 0, 0, 145, 98, 97, 106, 391, 340, 348,
-	89, 346, 137, 127, 157, 373, 128, 136, 110, 149,
-	132, 156, 188, 164, 147, 163, 78, 146, 155, 87,
-	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
-	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
-	82, 315, 161, 123, 148, 154, 117, 114, 81, 152,
-	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
-	119, 121, 0, 321, 0, 143, 159, 172, 336, 394,
-	165, 166, 167, 168, 0, 0, 0, 316, 314, 101,
-	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
-	332, 335, 330, 331, 369, 370, 403, 404, 405, 385,
-	327, 0, 333, 334, 0, 389, 372, 77, 0, 108,
-	169, 133, 95, 160, 398, 388, 0, 360, 400, 338,
-	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
-	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
-	371, 107, 406, 109, 376, 0, 142, 118, 0, 0,
-	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
-	379, 402, 0, 0, 0, 185, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 0, 378, 397, 349, 380,
-	318, 377, 0, 322, 325, 407, 395, 344, 345, 0,
-	0, 0, 0, 0, 0, 0, 363, 367, 383, 357,
-	0, 0, 0, 0, 0, 0, 0, 0, 342, 0,
-	374, 0, 0, 0, 326, 323, 0, 361, 0, 0,
-	0, 328, 0, 343, 384, 0, 317, 387, 393, 358,
-	187, 396, 356, 355, 399, 131, 0, 0, 145, 98,
-	97, 106, 391, 340, 348, 89, 346, 137, 127, 157,
-	373, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 321, 0,
-	143, 159, 172, 336, 394, 165, 166, 167, 168, 0,
-	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 332, 335, 330, 331, 369,
-	370, 403, 404, 405, 385, 327, 0, 333, 334, 0,
-	389, 372, 77, 0, 108, 169, 133, 95, 160, 398,
-	388, 0, 360, 400, 338, 352, 408, 353, 354, 381,
-	324, 368, 125, 350, 0, 341, 319, 347, 320, 339,
-	362, 93, 365, 337, 390, 371, 107, 406, 109, 376,
-	0, 142, 118, 0, 0, 364, 392, 366, 386, 359,
-	382, 329, 375, 401, 351, 379, 402, 0, 0, 0,
-	75, 0, 0, 0, 0, 0, 0, 0, 0, 86,
-	0, 378, 397, 349, 380, 318, 377, 0, 322, 325,
-	407, 395, 344, 345, 0, 0, 0, 0, 0, 0,
-	0, 363, 367, 383, 357, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 374, 0, 0, 0, 326,
-	323, 0, 361, 0, 0, 0, 328, 0, 343, 384,
-	0, 317, 387, 393, 358, 187, 396, 356, 355, 399,
-	131, 0, 0, 145, 98, 97, 106, 391, 340, 348,
-	89, 346, 137, 127, 157, 373, 128, 136, 110, 149,
-	132, 156, 188, 164, 147, 163, 78, 146, 584, 87,
-	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
-	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
-	82, 315, 161, 123, 148, 154, 117, 114, 81, 152,
-	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
-	119, 121, 0, 321, 0, 143, 159, 172, 336, 394,
-	165, 166, 167, 168, 0, 0, 0, 316, 314, 101,
-	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
-	332, 335, 330, 331, 369, 370, 403, 404, 405, 385,
-	327, 0, 333, 334, 0, 389, 372, 77, 0, 108,
-	169, 133, 95, 160, 398, 388, 0, 360, 400, 338,
-	352, 408, 353, 354, 381, 324, 368, 125, 350, 0,
-	341, 319, 347, 320, 339, 362, 93, 365, 337, 390,
-	371, 107, 406, 109, 376, 0, 142, 118, 0, 0,
-	364, 392, 366, 386, 359, 382, 329, 375, 401, 351,
-	379, 402, 0, 0, 0, 75, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 0, 378, 397, 349, 380,
-	318, 377, 0, 322, 325, 407, 395, 344, 345, 0,
-	0, 0, 0, 0, 0, 0, 363, 367, 383, 357,
-	0, 0, 0, 0, 0, 0, 0, 0, 342, 0,
-	374, 0, 0, 0, 326, 323, 0, 361, 0, 0,
-	0, 328, 0, 343, 384, 0, 317, 387, 393, 358,
-	187, 396, 356, 355, 399, 131, 0, 0, 145, 98,
-	97, 106, 391, 340, 348, 89, 346, 137, 127, 157,
-	373, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 306, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 315, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 321, 0,
-	143, 159, 172, 336, 394, 165, 166, 167, 168, 0,
-	0, 0, 316, 314, 309, 308, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 332, 335, 330, 331, 369,
-	370, 403, 404, 405, 385, 327, 0, 333, 334, 0,
-	389, 372, 77, 0, 108, 169, 133, 95, 160, 125,
-	0, 0, 742, 0, 240, 0, 0, 0, 93, 0,
-	237, 0, 0, 107, 279, 109, 0, 0, 142, 118,
-	0, 0, 0, 0, 270, 271, 0, 0, 0, 0,
-	0, 0, 0, 0, 50, 0, 0, 238, 258, 257,
-	260, 261, 262, 263, 0, 0, 86, 259, 264, 265,
-	266, 0, 0, 235, 251, 0, 278, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 248, 249, 231, 0,
-	0, 0, 290, 0, 250, 0, 0, 246, 247, 252,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 288, 0, 131, 0, 0,
-	145, 98, 97, 106, 0, 0, 0, 89, 0, 137,
-	127, 157, 0, 128, 136, 110, 149, 132, 156, 188,
-	164, 147, 163, 78, 146, 155, 87, 139, 80, 153,
-	144, 116, 102, 103, 79, 0, 135, 92, 96, 91,
-	124, 150, 151, 90, 171, 83, 162, 82, 84, 161,
-	123, 148, 154, 117, 114, 81, 152, 115, 113, 105,
-	94, 99, 129, 112, 130, 100, 120, 119, 121, 0,
-	0, 0, 143, 159, 172, 0, 0, 165, 166, 167,
-	168, 0, 0, 0, 122, 85, 101, 140, 104, 111,
-	134, 170, 126, 138, 88, 158, 141, 280, 289, 286,
-	287, 284, 285, 283, 282, 281, 291, 272, 273, 274,
-	275, 277, 0, 276, 77, 0, 108, 169, 133, 95,
-	160, 125, 0, 0, 0, 0, 240, 0, 0, 0,
-	93, 0, 237, 0, 0, 107, 279, 109, 0, 0,
-	142, 118, 0, 0, 0, 0, 270, 271, 0, 0,
-	0, 0, 0, 0, 0, 0, 50, 0, 454, 238,
-	258, 257, 260, 261, 262, 263, 0, 0, 86, 259,
-	264, 265, 266, 0, 0, 235, 251, 0, 278, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 248, 249,
-	0, 0, 0, 0, 290, 0, 250, 0, 0, 246,
-	247, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 288, 0, 131,
-	0, 0, 145, 98, 97, 106, 0, 0, 0, 89,
-	0, 137, 127, 157, 0, 128, 136, 110, 149, 132,
-	156, 188, 164, 147, 163, 78, 146, 155, 87, 139,
-	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
-	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
-	84, 161, 123, 148, 154, 117, 114, 81, 152, 115,
-	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
-	121, 0, 0, 0, 143, 159, 172, 0, 0, 165,
-	166, 167, 168, 0, 0, 0, 122, 85, 101, 140,
-	104, 111, 134, 170, 126, 138, 88, 158, 141, 280,
-	289, 286, 287, 284, 285, 283, 282, 281, 291, 272,
-	273, 274, 275, 277, 0, 276, 77, 0, 108, 169,
-	133, 95, 160, 125, 0, 0, 0, 0, 240, 0,
-	0, 0, 93, 0, 237, 0, 0, 107, 279, 109,
-	0, 0, 142, 118, 0, 0, 0, 0, 270, 271,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 238, 258, 257, 260, 261, 262, 263, 0, 0,
-	86, 259, 264, 265, 266, 0, 0, 235, 251, 0,
-	278, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	248, 249, 231, 0, 0, 0, 290, 0, 250, 0,
-	0, 246, 247, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 288,
-	0, 131, 0, 0, 145, 98, 97, 106, 0, 0,
-	0, 89, 0, 137, 127, 157, 0, 128, 136, 110,
-	149, 132, 156, 188, 164, 147, 163, 78, 146, 155,
-	87, 139, 80, 153, 144, 116, 102, 103, 79, 0,
-	135, 92, 96, 91, 124, 150, 151, 90, 171, 83,
-	162, 82, 84, 161, 123, 148, 154, 117, 114, 81,
-	152, 115, 113, 105, 94, 99, 129, 112, 130, 100,
-	120, 119, 121, 0, 0, 0, 143, 159, 172, 0,
-	0, 165, 166, 167, 168, 0, 0, 0, 122, 85,
-	101, 140, 104, 111, 134, 170, 126, 138, 88, 158,
-	141, 280, 289, 286, 287, 284, 285, 283, 282, 281,
-	291, 272, 273, 274, 275, 277, 0, 276, 77, 0,
-	108, 169, 133, 95, 160, 125, 0, 0, 0, 0,
-	240, 0, 0, 0, 93, 0, 237, 0, 0, 107,
-	279, 109, 0, 0, 142, 118, 0, 0, 0, 0,
-	270, 271, 0, 0, 0, 0, 0, 0, 806, 0,
-	50, 0, 0, 238, 258, 257, 260, 261, 262, 263,
-	0, 0, 86, 259, 264, 265, 266, 0, 0, 235,
-	251, 0, 278, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 248, 249, 0, 0, 0, 0, 290, 0,
-	250, 0, 0, 246, 247, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 288, 0, 131, 0, 0, 145, 98, 97, 106,
-	0, 0, 0, 89, 0, 137, 127, 157, 0, 128,
-	136, 110, 149, 132, 156, 188, 164, 147, 163, 78,
-	146, 155, 87, 139, 80, 153, 144, 116, 102, 103,
-	79, 0, 135, 92, 96, 91, 124, 150, 151, 90,
-	171, 83, 162, 82, 84, 161, 123, 148, 154, 117,
-	114, 81, 152, 115, 113, 105, 94, 99, 129, 112,
-	130, 100, 120, 119, 121, 0, 0, 0, 143, 159,
-	172, 0, 0, 165, 166, 167, 168, 0, 0, 0,
-	122, 85, 101, 140, 104, 111, 134, 170, 126, 138,
-	88, 158, 141, 280, 289, 286, 287, 284, 285, 283,
-	282, 281, 291, 272, 273, 274, 275, 277, 23, 276,
-	77, 0, 108, 169, 133, 95, 160, 0, 0, 0,
-	125, 0, 0, 0, 0, 240, 0, 0, 0, 93,
-	0, 237, 0, 0, 107, 279, 109, 0, 0, 142,
-	118, 0, 0, 0, 0, 270, 271, 0, 0, 0,
-	0, 0, 0, 0, 0, 50, 0, 0, 238, 258,
-	257, 260, 261, 262, 263, 0, 0, 86, 259, 264,
-	265, 266, 0, 0, 235, 251, 0, 278, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 248, 249, 0,
-	0, 0, 0, 290, 0, 250, 0, 0, 246, 247,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 288, 0, 131, 0,
-	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
-	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
-	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
-	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
-	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
-	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
-	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
-	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
-	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
-	111, 134, 170, 126, 138, 88, 158, 141, 280, 289,
-	286, 287, 284, 285, 283, 282, 281, 291, 272, 273,
-	274, 275, 277, 0, 276, 77, 0, 108, 169, 133,
-	95, 160, 125, 0, 0, 0, 0, 240, 0, 0,
-	0, 93, 0, 237, 0, 0, 107, 279, 109, 0,
-	0, 142, 118, 0, 0, 0, 0, 270, 271, 0,
-	0, 0, 0, 0, 0, 0, 0, 50, 0, 0,
-	238, 258, 257, 260, 261, 262, 263, 0, 0, 86,
-	259, 264, 265, 266, 0, 0, 235, 251, 0, 278,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 248,
-	249, 0, 0, 0, 0, 290, 0, 250, 0, 0,
-	246, 247, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 0, 0, 288, 0,
-	131, 0, 0, 145, 98, 97, 106, 0, 0, 0,
-	89, 0, 137, 127, 157, 0, 128, 136, 110, 149,
-	132, 156, 188, 164, 147, 163, 78, 146, 155, 87,
-	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
-	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
-	82, 84, 161, 123, 148, 154, 117, 114, 81, 152,
-	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
-	119, 121, 0, 0, 0, 143, 159, 172, 0, 0,
-	165, 166, 167, 168, 0, 0, 0, 122, 85, 101,
-	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
-	280, 289, 286, 287, 284, 285, 283, 282, 281, 291,
-	272, 273, 274, 275, 277, 125, 276, 77, 0, 108,
-	169, 133, 95, 160, 93, 0, 0, 0, 0, 107,
-	279, 109, 0, 0, 142, 118, 0, 0, 0, 0,
-	270, 271, 0, 0, 0, 0, 0, 0, 0, 0,
-	50, 0, 0, 238, 258, 257, 260, 261, 262, 263,
-	0, 0, 86, 259, 264, 265, 266, 0, 0, 0,
-	251, 0, 278, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 248, 249, 0, 0, 0, 0, 290, 0,
-	250, 0, 0, 246, 247, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 288, 0, 131, 0, 0, 145, 98, 97, 106,
-	0, 0, 0, 89, 0, 137, 127, 157, 1292, 128,
-	136, 110, 149, 132, 156, 188, 164, 147, 163, 78,
-	146, 155, 87, 139, 80, 153, 144, 116, 102, 103,
-	79, 0, 135, 92, 96, 91, 124, 150, 151, 90,
-	171, 83, 162, 82, 84, 161, 123, 148, 154, 117,
-	114, 81, 152, 115, 113, 105, 94, 99, 129, 112,
-	130, 100, 120, 119, 121, 0, 0, 0, 143, 159,
-	172, 0, 0, 165, 166, 167, 168, 0, 0, 0,
-	122, 85, 101, 140, 104, 111, 134, 170, 126, 138,
-	88, 158, 141, 280, 289, 286, 287, 284, 285, 283,
-	282, 281, 291, 272, 273, 274, 275, 277, 125, 276,
-	77, 0, 108, 169, 133, 95, 160, 93, 0, 0,
-	0, 0, 107, 279, 109, 0, 0, 142, 118, 0,
-	0, 0, 0, 270, 271, 0, 0, 0, 0, 0,
-	0, 0, 0, 50, 0, 0, 238, 258, 257, 260,
-	261, 262, 263, 0, 0, 86, 259, 264, 265, 266,
-	0, 0, 0, 251, 0, 278, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 248, 249, 0, 0, 0,
-	0, 290, 0, 250, 0, 0, 246, 247, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 0, 0, 288, 0, 131, 0, 0, 145,
-	98, 97, 106, 0, 0, 0, 89, 0, 137, 127,
-	157, 0, 128, 136, 110, 149, 132, 156, 188, 164,
-	147, 163, 78, 146, 155, 87, 139, 80, 153, 144,
-	116, 102, 103, 79, 0, 135, 92, 96, 91, 124,
-	150, 151, 90, 171, 83, 162, 82, 84, 161, 123,
-	148, 154, 117, 114, 81, 152, 115, 113, 105, 94,
-	99, 129, 112, 130, 100, 120, 119, 121, 0, 0,
-	0, 143, 159, 172, 0, 0, 165, 166, 167, 168,
-	0, 0, 0, 122, 85, 101, 140, 104, 111, 134,
-	170, 126, 138, 88, 158, 141, 280, 289, 286, 287,
-	284, 285, 283, 282, 281, 291, 272, 273, 274, 275,
-	277, 125, 276, 77, 0, 108, 169, 133, 95, 160,
-	93, 0, 0, 0, 0, 107, 0, 109, 0, 0,
-	142, 118, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 75,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 488, 487, 497, 498, 490, 491,
-	492, 493, 494, 495, 496, 489, 0, 0, 499, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 0, 0, 131,
-	0, 0, 145, 98, 97, 106, 0, 0, 0, 89,
-	0, 137, 127, 157, 0, 128, 136, 110, 149, 132,
-	156, 188, 164, 147, 163, 78, 146, 155, 87, 139,
-	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
-	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
-	84, 161, 123, 148, 154, 117, 114, 81, 152, 115,
-	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
-	121, 0, 0, 0, 143, 159, 172, 0, 0, 165,
-	166, 167, 168, 0, 0, 0, 122, 85, 101, 140,
-	104, 111, 134, 170, 126, 138, 88, 158, 141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 77, 0, 108, 169,
-	133, 95, 160, 125, 0, 0, 0, 476, 0, 0,
-	0, 0, 93, 0, 0, 0, 0, 107, 0, 109,
-	0, 0, 142, 118, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 75, 0, 478, 0, 0, 0, 0, 0, 0,
-	86, 0, 0, 0, 0, 473, 472, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 474, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	0, 131, 0, 0, 145, 98, 97, 106, 0, 0,
-	0, 89, 0, 137, 127, 157, 0, 128, 136, 110,
-	149, 132, 156, 188, 164, 147, 163, 78, 146, 155,
-	87, 139, 80, 153, 144, 116, 102, 103, 79, 0,
-	135, 92, 96, 91, 124, 150, 151, 90, 171, 83,
-	162, 82, 84, 161, 123, 148, 154, 117, 114, 81,
-	152, 115, 113, 105, 94, 99, 129, 112, 130, 100,
-	120, 119, 121, 0, 0, 0, 143, 159, 172, 0,
-	0, 165, 166, 167, 168, 0, 0, 0, 122, 85,
-	101, 140, 104, 111, 134, 170, 126, 138, 88, 158,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 125, 0, 77, 0,
-	108, 169, 133, 95, 160, 93, 0, 0, 0, 0,
-	107, 0, 109, 0, 0, 142, 118, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 75, 0, 0, 0, 0, 0,
-	0, 0, 0, 86, 0, 0, 0, 0, 68, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 71, 72, 0, 67,
-	0, 0, 0, 73, 131, 0, 0, 145, 98, 97,
-	106, 0, 0, 0, 89, 0, 137, 127, 157, 0,
-	128, 136, 110, 149, 132, 156, 69, 164, 147, 163,
-	78, 146, 155, 87, 139, 80, 153, 144, 116, 102,
-	103, 79, 0, 135, 92, 96, 91, 124, 150, 151,
-	90, 171, 83, 162, 82, 84, 161, 123, 148, 154,
-	117, 114, 81, 152, 115, 113, 105, 94, 99, 129,
-	112, 130, 100, 120, 119, 121, 0, 0, 0, 143,
-	159, 172, 0, 0, 165, 166, 167, 168, 0, 0,
-	0, 122, 85, 101, 140, 104, 111, 134, 170, 126,
-	138, 88, 158, 141, 0, 70, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 77, 0, 108, 169, 133, 95, 160, 125, 0,
-	0, 0, 573, 0, 0, 0, 0, 93, 0, 0,
-	0, 0, 107, 0, 109, 0, 0, 142, 118, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 185, 0, 575, 0,
-	0, 0, 0, 0, 0, 86, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 0, 0, 0, 0, 131, 0, 0, 145,
-	98, 97, 106, 0, 0, 0, 89, 0, 137, 127,
-	157, 0, 128, 136, 110, 149, 132, 156, 188, 164,
-	147, 163, 78, 146, 155, 87, 139, 80, 153, 144,
-	116, 102, 103, 79, 0, 135, 92, 96, 91, 124,
-	150, 151, 90, 171, 83, 162, 82, 84, 161, 123,
-	148, 154, 117, 114, 81, 152, 115, 113, 105, 94,
-	99, 129, 112, 130, 100, 120, 119, 121, 0, 0,
-	0, 143, 159, 172, 0, 0, 165, 166, 167, 168,
-	0, 0, 0, 122, 85, 101, 140, 104, 111, 134,
-	170, 126, 138, 88, 158, 141, 0, 0, 0, 23,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 125, 0, 77, 0, 108, 169, 133, 95, 160,
-	93, 0, 0, 0, 0, 107, 0, 109, 0, 0,
-	142, 118, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 50, 0, 0, 75,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 0, 0, 131,
-	0, 0, 145, 98, 97, 106, 0, 0, 0, 89,
-	0, 137, 127, 157, 0, 128, 136, 110, 149, 132,
-	156, 188, 164, 147, 163, 78, 146, 155, 87, 139,
-	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
-	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
-	84, 161, 123, 148, 154, 117, 114, 81, 152, 115,
-	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
-	121, 0, 0, 0, 143, 159, 172, 0, 0, 165,
-	166, 167, 168, 0, 0, 0, 122, 85, 101, 140,
-	104, 111, 134, 170, 126, 138, 88, 158, 141, 0,
-	0, 0, 23, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 125, 0, 77, 0, 108, 169,
-	133, 95, 160, 93, 0, 0, 0, 0, 107, 0,
-	109, 0, 0, 142, 118, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 50,
-	0, 0, 185, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
+var yyAct = [...]int16{
+	556, 1813, 488, 2210, 1513, 2181, 1728, 493, 2131, 1750,
+	1947, 1952, 2072, 2017, 840, 659, 1862, 602, 1906, 1253,
+	1749, 524, 922, 1858, 1748, 1924, 6, 1646, 1642, 1798,
+	973, 839, 10, 1863, 1393, 1566, 1968, 1729, 1443, 1793,
+	733, 1350, 1607, 1030, 115, 1128, 1385, 1661, 265, 1356,
+	265, 1615, 1629, 1873, 1872, 115, 1355, 1533, 1556, 1216,
+	1553, 1277, 906, 1667, 115, 1295, 756, 1183, 115, 1301,
+	1217, 1462, 561, 1370, 115, 1613, 265, 265, 1331, 585,
+	115, 484, 1214, 1600, 646, 700, 39, 958, 936, 931,
+	1059, 263, 699, 876, 1332, 1287, 491, 99, 895, 1243,
+	759, 1157, 760, 1118, 654, 650, 1120, 714, 1042, 708,
+	655, 698, 115, 957, 632, 106, 1381, 904, 608, 942,
+	935, 883, 902, 915, 898, 1219, 696, 507, 1266, 1025,
+	629, 112, 976, 253, 1259, 791, 423, 587, 81, 894,
+	979, 978, 1254, 457, 401, 611, 678, 400, 399, 397,
+	1992, 3, 40, 84, 42, 44, 1530, 108, 109, 96,
+	248, 249, 1988, 2209, 2139, 2138, 2080, 600, 1690, 91,
+	1991, 1166, 2196, 45, 71, 1843, 740, 614, 1558, 1561,
+	1562, 1563, 1559, 2052, 1560, 1564, 920, 610, 690, 788,
+	787, 436, 1044, 431, 1282, 1576, 1043, 1528, 1575, 606,
+	884, 1577, 872, 460, 63, 959, 789, 960, 885, 1760,
+	83, 1700, 886, 85, 1761, 1762, 443, 439, 440, 441,
+	1518, 43, 768, 94, 429, 1345, 1346, 1344, 1144, 1928,
+	259, 783, 1371, 737, 1590, 1145, 1094, 458, 575, 1124,
+	573, 1364, 1977, 426, 1689, 255, 446, 444, 447, 445,
+	623, 1825, 115, 1823, 2083, 433, 2016, 2143, 2085, 2086,
+	2145, 265, 2018, 1537, 1990, 1995, 1993, 1994, 1630, 1372,
+	436, 495, 431, 1095, 1948, 2153, 1950, 1631, 1632, 1633,
+	1946, 1718, 1524, 1525, 581, 1636, 1634, 49, 86, 53,
+	52, 55, 448, 1130, 770, 620, 772, 953, 577, 1121,
+	2117, 621, 622, 429, 624, 453, 454, 427, 1056, 1057,
+	2092, 1055, 62, 92, 93, 1527, 57, 56, 58, 54,
+	882, 1124, 426, 769, 771, 767, 766, 434, 877, 688,
+	779, 780, 102, 2065, 433, 2064, 2063, 877, 679, 610,
+	2061, 645, 2062, 1617, 2122, 2059, 679, 680, 1997, 64,
+	65, 70, 66, 67, 68, 69, 1783, 1053, 72, 735,
+	73, 87, 88, 89, 90, 1117, 1688, 2129, 59, 60,
+	61, 75, 76, 77, 459, 574, 442, 572, 2071, 2006,
+	425, 1121, 2127, 649, 879, 1799, 427, 420, 421, 1549,
+	419, 1129, 724, 879, 265, 265, 736, 1794, 1999, 2094,
+	458, 663, 741, 1036, 115, 1430, 434, 676, 1423, 1989,
+	1371, 670, 672, 671, 669, 1422, 1618, 1619, 115, 1536,
+	424, 591, 593, 594, 1099, 873, 1796, 1052, 732, 1040,
+	857, 2213, 598, 609, 462, 461, 933, 1473, 10, 115,
+	10, 10, 1123, 1828, 891, 2079, 432, 1372, 878, 265,
+	115, 1670, 1676, 765, 1351, 261, 101, 878, 115, 425,
+	718, 115, 115, 1051, 1784, 651, 438, 265, 265, 265,
+	265, 265, 1882, 265, 1091, 710, 2050, 1445, 590, 2099,
+	265, 592, 2214, 428, 1951, 1751, 1753, 2126, 569, 74,
+	710, 599, 1960, 1752, 1060, 1061, 642, 1547, 1795, 628,
+	2152, 651, 1635, 829, 830, 831, 832, 833, 834, 835,
+	651, 718, 1478, 1122, 1668, 567, 823, 824, 1127, 607,
+	917, 1431, 801, 799, 1123, 432, 811, 758, 1072, 721,
+	812, 725, 1472, 730, 652, 653, 811, 459, 729, 1291,
+	812, 265, 1449, 668, 731, 710, 667, 666, 665, 962,
+	946, 723, 1708, 727, 837, 683, 685, 686, 677, 596,
+	682, 684, 428, 752, 595, 880, 881, 1651, 2212, 2211,
+	652, 653, 1126, 115, 115, 1771, 789, 1595, 115, 652,
+	653, 787, 265, 110, 662, 664, 717, 265, 392, 728,
+	1092, 673, 720, 734, 1871, 1122, 753, 789, 651, 754,
+	755, 709, 1883, 1444, 691, 692, 2051, 2049, 115, 1780,
+	566, 565, 838, 570, 571, 651, 709, 265, 743, 744,
+	745, 746, 747, 748, 749, 1578, 115, 961, 1304, 1305,
+	681, 1772, 1672, 1596, 1671, 568, 1669, 717, 265, 722,
+	437, 1674, 1244, 720, 799, 115, 1692, 811, 1450, 1033,
+	1673, 812, 1313, 1312, 1314, 1309, 1310, 1311, 1306, 1244,
+	1308, 1500, 2190, 1675, 1677, 2218, 1768, 652, 653, 83,
+	1650, 709, 1165, 115, 115, 707, 704, 697, 701, 705,
+	706, 702, 94, 2114, 652, 653, 1163, 1164, 1162, 2056,
+	115, 115, 115, 115, 726, 626, 265, 773, 775, 776,
+	777, 778, 627, 781, 875, 635, 597, 2054, 907, 2057,
+	785, 1586, 265, 897, 265, 265, 695, 1587, 94, 644,
+	115, 947, 265, 1484, 1483, 265, 968, 918, 912, 1161,
+	265, 2217, 2000, 970, 265, 718, 1848, 1935, 948, 1849,
+	94, 115, 949, 926, 115, 1026, 1544, 115, 115, 115,
+	115, 1934, 1899, 115, 115, 115, 115, 950, 788, 787,
+	1898, 955, 821, 1604, 265, 265, 265, 265, 265, 265,
+	265, 265, 265, 265, 1603, 789, 1588, 1390, 884, 1087,
+	265, 265, 710, 1096, 1063, 115, 885, 2219, 712, 2125,
+	886, 1064, 2124, 1491, 1156, 2169, 2170, 2120, 1167, 1168,
+	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
+	1179, 1180, 1181, 1182, 1159, 1069, 1070, 1071, 1085, 1185,
+	1114, 1115, 1116, 1184, 1062, 1026, 1080, 1722, 1207, 1307,
+	1723, 1054, 1084, 2068, 525, 80, 265, 1093, 1303, 788,
+	787, 1027, 5, 1044, 1112, 100, 920, 1043, 265, 1319,
+	1320, 1232, 1083, 1485, 1230, 1086, 789, 718, 2119, 691,
+	692, 717, 1125, 1245, 83, 1267, 715, 713, 1098, 2066,
+	2069, 716, 1985, 115, 265, 43, 115, 94, 1110, 788,
+	787, 252, 260, 393, 788, 787, 393, 887, 888, 889,
+	890, 892, 893, 1268, 1984, 1942, 789, 115, 1914, 1909,
+	1802, 789, 1226, 1227, 1289, 920, 711, 1495, 709, 838,
+	712, 1239, 707, 704, 1284, 80, 705, 706, 702, 83,
+	1210, 1211, 601, 788, 787, 1704, 1223, 1248, 1225, 1220,
+	1251, 1252, 94, 94, 115, 100, 42, 44, 788, 787,
+	789, 115, 1262, 1298, 265, 1196, 1701, 2025, 393, 115,
+	115, 1249, 1250, 1195, 1194, 789, 1612, 920, 1316, 1151,
+	1153, 1154, 1155, 265, 94, 1152, 1288, 1579, 83, 254,
+	257, 258, 265, 859, 860, 861, 862, 863, 864, 865,
+	866, 256, 2128, 717, 1568, 1330, 1521, 2030, 715, 713,
+	788, 787, 83, 716, 1131, 1132, 1133, 1134, 1135, 1136,
+	1137, 1138, 1139, 1140, 83, 94, 1965, 789, 1440, 1420,
+	1141, 1142, 1419, 1418, 1299, 43, 1270, 94, 251, 1286,
+	1416, 83, 265, 1396, 1362, 1285, 1275, 1290, 1273, 907,
+	265, 1272, 43, 265, 94, 265, 1265, 115, 1220, 1278,
+	1292, 1293, 1294, 939, 1300, 1297, 1264, 1105, 1104, 469,
+	788, 787, 1073, 1065, 102, 1352, 1037, 1694, 1358, 1373,
+	1374, 1375, 265, 1324, 1160, 1360, 1387, 789, 265, 1341,
+	1342, 1340, 1326, 1451, 1452, 1453, 1454, 1034, 1032, 265,
+	928, 916, 828, 763, 1359, 742, 635, 100, 938, 100,
+	689, 1023, 1386, 94, 1623, 657, 265, 2203, 479, 1357,
+	115, 1412, 1517, 115, 265, 800, 798, 809, 810, 802,
+	803, 804, 805, 806, 807, 808, 801, 799, 115, 1382,
+	811, 1377, 788, 787, 812, 1376, 1067, 265, 115, 2081,
+	786, 115, 1389, 1383, 1384, 2191, 1394, 2193, 2192, 789,
+	2178, 1424, 1296, 2183, 1388, 1433, 1117, 2176, 2164, 2146,
+	1404, 2118, 2060, 1414, 463, 1938, 1463, 1916, 452, 1516,
+	1896, 465, 1808, 1710, 1709, 721, 1458, 1459, 1460, 1601,
+	472, 468, 1417, 827, 1425, 826, 825, 1427, 1476, 762,
+	1751, 1753, 675, 1643, 1159, 1088, 1296, 1955, 1752, 1088,
+	1953, 804, 805, 806, 807, 808, 801, 799, 609, 470,
+	811, 467, 257, 258, 812, 1870, 2195, 647, 1953, 951,
+	738, 1426, 658, 1029, 2187, 455, 456, 474, 1029, 920,
+	1437, 1335, 1758, 1339, 1022, 1514, 1439, 560, 920, 2194,
+	1442, 1447, 1806, 920, 1029, 2101, 1959, 920, 1918, 920,
+	511, 100, 1493, 100, 100, 838, 1514, 115, 512, 514,
+	515, 516, 517, 518, 1029, 1912, 115, 513, 519, 265,
+	1029, 1790, 1982, 1397, 1456, 1399, 1778, 1777, 1774, 1775,
+	1774, 1773, 1981, 1759, 1117, 1475, 1870, 115, 1605, 464,
+	115, 809, 810, 802, 803, 804, 805, 806, 807, 808,
+	801, 799, 265, 1218, 811, 1552, 920, 1552, 812, 1487,
+	1497, 774, 774, 774, 774, 774, 466, 774, 475, 476,
+	477, 478, 482, 1870, 774, 1476, 920, 481, 480, 1021,
+	1624, 1539, 786, 920, 820, 822, 1021, 920, 786, 115,
+	1476, 1492, 972, 971, 1436, 1890, 1117, 115, 1552, 115,
+	1523, 115, 1499, 1558, 1561, 1562, 1563, 1559, 603, 1560,
+	1564, 1486, 1786, 1874, 1875, 1515, 265, 836, 1569, 1288,
+	841, 1543, 842, 843, 844, 845, 846, 847, 848, 849,
+	850, 851, 852, 1548, 855, 1522, 858, 858, 858, 858,
+	858, 858, 858, 858, 858, 867, 868, 869, 870, 871,
+	1541, 1519, 1538, 1511, 1510, 265, 1476, 1551, 1509, 820,
+	105, 1779, 1218, 265, 1776, 1581, 1546, 1572, 1874, 1875,
+	635, 899, 1593, 265, 1531, 1597, 1598, 1599, 1349, 1703,
+	1565, 1127, 1526, 838, 265, 1580, 1343, 1088, 1622, 1317,
+	1117, 1573, 1552, 265, 1160, 954, 265, 1276, 1269, 927,
+	1591, 1592, 1261, 625, 694, 1583, 1317, 265, 1031, 1402,
+	115, 115, 1584, 903, 2220, 2216, 710, 2172, 2173, 2199,
+	2055, 1602, 2027, 1903, 1893, 1878, 1658, 1659, 1860, 1621,
+	1558, 1561, 1562, 1563, 1559, 1640, 1560, 1564, 1609, 265,
+	1881, 100, 1639, 1620, 1405, 1102, 784, 1880, 1738, 1680,
+	1681, 1737, 1683, 80, 2171, 800, 798, 809, 810, 802,
+	803, 804, 805, 806, 807, 808, 801, 799, 1741, 651,
+	811, 2074, 2075, 1742, 812, 1691, 1697, 1628, 802, 803,
+	804, 805, 806, 807, 808, 801, 799, 2148, 1625, 811,
+	1655, 923, 1695, 812, 1662, 1558, 1698, 1562, 1563, 1559,
+	1739, 2175, 2137, 265, 838, 1740, 1940, 1664, 265, 1679,
+	1850, 1088, 1678, 115, 115, 115, 115, 115, 115, 1730,
+	1665, 2002, 1854, 710, 1220, 1853, 115, 1534, 115, 115,
+	104, 1847, 115, 80, 2041, 1724, 2040, 1535, 652, 653,
+	1726, 265, 709, 265, 1702, 2184, 707, 704, 1705, 701,
+	705, 706, 702, 522, 1706, 1594, 1432, 1711, 774, 774,
+	774, 774, 774, 774, 774, 774, 774, 774, 1335, 1717,
+	115, 1712, 2039, 1097, 774, 774, 651, 265, 967, 764,
+	1767, 1638, 115, 1744, 265, 1743, 1637, 1158, 1429, 1428,
+	2116, 1731, 1765, 1769, 1770, 1735, 2115, 1764, 1756, 1791,
+	2022, 262, 1078, 412, 1077, 265, 265, 1747, 1732, 1733,
+	1734, 1068, 1736, 1066, 1225, 1950, 1398, 100, 1101, 1810,
+	1803, 1804, 1241, 265, 929, 930, 1641, 1969, 1910, 578,
+	579, 1246, 1520, 103, 100, 105, 841, 105, 2179, 2177,
+	2144, 1801, 2141, 2106, 2105, 652, 653, 2090, 2087, 709,
+	1800, 2007, 924, 707, 704, 697, 701, 705, 706, 702,
+	1845, 612, 603, 2089, 2020, 1514, 1409, 1410, 1411, 2222,
+	11, 1815, 9, 2208, 2207, 605, 1856, 1852, 1686, 1821,
+	1846, 98, 1685, 97, 1488, 940, 265, 901, 265, 1861,
+	1730, 2221, 2091, 1869, 1978, 899, 1707, 107, 1757, 95,
+	1, 1119, 874, 1395, 836, 1864, 1606, 1532, 422, 1797,
+	1792, 703, 1354, 648, 250, 265, 2048, 1976, 115, 1884,
+	1585, 1589, 100, 1363, 1361, 265, 1892, 2113, 1766, 1193,
+	977, 975, 1543, 974, 1687, 1186, 471, 630, 963, 1401,
+	265, 265, 1888, 1851, 1336, 941, 822, 1905, 1876, 1879,
+	405, 719, 1649, 1891, 1143, 1448, 782, 473, 952, 619,
+	265, 1889, 1574, 100, 637, 2023, 1859, 265, 1867, 2082,
+	1832, 1088, 1866, 99, 1581, 265, 2142, 2015, 1908, 2084,
+	1894, 1941, 1900, 1318, 1335, 1335, 1335, 1335, 1335, 1335,
+	1901, 2180, 1913, 1930, 1911, 1931, 1915, 2147, 1907, 1335,
+	1335, 2073, 2088, 262, 1936, 2019, 1923, 1895, 1498, 1897,
+	1608, 853, 1242, 115, 656, 920, 657, 115, 494, 1150,
+	1944, 1885, 1886, 1887, 510, 509, 508, 774, 1939, 774,
+	1949, 1713, 1721, 492, 1954, 1403, 486, 1334, 1730, 1327,
+	1557, 1554, 1555, 1406, 1407, 1877, 1333, 1961, 1088, 1855,
+	641, 115, 1945, 1927, 1973, 1234, 1975, 531, 1302, 1842,
+	2012, 1240, 657, 79, 41, 604, 265, 618, 1962, 1274,
+	800, 798, 809, 810, 802, 803, 804, 805, 806, 807,
+	808, 801, 799, 1970, 1971, 811, 1974, 1271, 1660, 812,
+	582, 78, 34, 33, 1666, 32, 31, 1979, 774, 1980,
+	1996, 30, 2004, 29, 28, 27, 1998, 26, 2001, 25,
+	24, 2005, 2003, 23, 115, 22, 21, 4, 35, 20,
+	265, 265, 19, 18, 1446, 435, 265, 430, 265, 418,
+	1415, 2198, 47, 115, 51, 48, 2026, 1864, 50, 2046,
+	46, 2036, 17, 16, 2035, 2031, 2047, 660, 661, 2029,
+	1726, 2045, 2044, 841, 15, 2037, 14, 1457, 13, 12,
+	115, 1461, 8, 1666, 7, 38, 925, 82, 2021, 1987,
+	1616, 1614, 410, 409, 1045, 2067, 687, 1038, 2053, 1983,
+	1902, 2121, 2077, 2076, 2058, 265, 1782, 408, 413, 1335,
+	406, 1041, 1408, 1050, 1039, 398, 1088, 2095, 1088, 265,
+	2093, 2, 750, 265, 265, 0, 2100, 2097, 0, 0,
+	0, 0, 1864, 1477, 2104, 0, 0, 2112, 2107, 2108,
+	262, 262, 262, 262, 262, 2110, 262, 0, 2111, 2109,
+	0, 0, 0, 262, 0, 0, 2135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2136,
+	0, 0, 0, 0, 0, 265, 2140, 265, 2151, 1730,
+	265, 0, 0, 0, 0, 2150, 0, 0, 0, 2157,
+	0, 0, 2156, 0, 0, 0, 0, 0, 0, 2098,
+	1884, 0, 2163, 0, 0, 0, 0, 0, 1335, 265,
+	0, 0, 0, 0, 856, 2135, 0, 0, 1545, 0,
+	0, 2168, 0, 0, 2166, 0, 0, 0, 0, 265,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2185,
+	0, 0, 1335, 0, 0, 0, 0, 0, 0, 0,
+	2188, 1567, 0, 0, 909, 911, 0, 0, 0, 0,
+	913, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2197, 1730, 2135, 0, 265, 2014, 2205, 0, 2202,
+	2204, 0, 2206, 1365, 0, 1366, 1367, 1368, 1369, 0,
+	944, 0, 2215, 0, 0, 0, 0, 0, 0, 0,
+	1088, 1378, 1379, 1380, 262, 2223, 2224, 0, 0, 0,
+	0, 964, 0, 0, 0, 1608, 1088, 2013, 0, 800,
+	798, 809, 810, 802, 803, 804, 805, 806, 807, 808,
+	801, 799, 0, 0, 811, 0, 0, 0, 812, 1627,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	774, 0, 1830, 920, 0, 0, 0, 0, 0, 1644,
+	1645, 0, 0, 0, 0, 0, 0, 0, 0, 1058,
+	0, 0, 841, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1074, 0, 1075, 1076, 0,
+	0, 0, 0, 0, 0, 1081, 0, 0, 1082, 0,
+	0, 0, 0, 262, 0, 0, 0, 262, 800, 798,
+	809, 810, 802, 803, 804, 805, 806, 807, 808, 801,
+	799, 0, 0, 811, 0, 1696, 0, 812, 0, 0,
+	0, 0, 0, 0, 0, 919, 0, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 1477, 0, 0,
+	0, 0, 0, 262, 262, 0, 0, 0, 0, 0,
+	0, 0, 0, 1725, 1727, 0, 0, 1336, 1336, 1336,
+	1336, 1336, 1336, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1567, 1336, 0, 1754, 0, 0, 0, 0,
+	0, 0, 1465, 1466, 1208, 1467, 0, 0, 0, 1468,
+	920, 0, 1469, 1470, 0, 0, 0, 0, 0, 1213,
+	0, 262, 0, 0, 793, 0, 797, 0, 0, 0,
+	1208, 1231, 813, 814, 815, 816, 817, 818, 819, 1208,
+	795, 796, 792, 794, 800, 798, 809, 810, 802, 803,
+	804, 805, 806, 807, 808, 801, 799, 1258, 0, 811,
+	0, 0, 0, 812, 0, 800, 798, 809, 810, 802,
+	803, 804, 805, 806, 807, 808, 801, 799, 0, 0,
+	811, 0, 0, 0, 812, 909, 0, 1814, 1489, 0,
+	800, 798, 809, 810, 802, 803, 804, 805, 806, 807,
+	808, 801, 799, 523, 0, 811, 0, 0, 0, 812,
+	0, 0, 0, 1839, 1840, 1841, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 944, 0, 0,
+	262, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	0, 0, 0, 0, 0, 0, 262, 113, 1865, 0,
+	100, 100, 0, 0, 0, 262, 0, 0, 451, 0,
+	0, 0, 0, 0, 1656, 0, 0, 483, 0, 0,
+	0, 451, 0, 0, 0, 0, 0, 451, 0, 0,
+	0, 0, 1336, 451, 994, 800, 798, 809, 810, 802,
+	803, 804, 805, 806, 807, 808, 801, 799, 0, 0,
+	811, 0, 0, 0, 812, 656, 0, 0, 0, 0,
+	617, 0, 0, 1392, 0, 113, 262, 0, 262, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 995, 996,
+	997, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1464, 0, 0, 1413, 0, 0, 0, 0,
+	0, 656, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1421, 800, 798, 809, 810, 802, 803, 804,
+	805, 806, 807, 808, 801, 799, 0, 0, 811, 262,
+	0, 1336, 812, 0, 0, 0, 0, 262, 0, 0,
+	0, 982, 800, 798, 809, 810, 802, 803, 804, 805,
+	806, 807, 808, 801, 799, 0, 0, 811, 1967, 0,
+	1441, 812, 0, 0, 0, 1336, 798, 809, 810, 802,
+	803, 804, 805, 806, 807, 808, 801, 799, 0, 0,
+	811, 0, 0, 0, 812, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 636, 0, 451, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2024, 0, 0, 0,
+	1865, 0, 0, 2032, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2038, 0, 2042, 2043, 0, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 0, 1015, 1016, 1017, 1018,
+	1019, 998, 999, 980, 981, 0, 0, 983, 1208, 984,
+	985, 986, 987, 988, 989, 990, 991, 992, 993, 1000,
+	1001, 1002, 1003, 1004, 1005, 1006, 1007, 0, 0, 0,
+	0, 0, 1512, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2096, 0, 1865, 0, 100, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 0, 0, 0, 0,
+	0, 0, 0, 1818, 1819, 0, 1820, 0, 0, 1822,
+	0, 1824, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 451, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 451, 0, 0, 995, 996, 997, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	0, 0, 451, 0, 0, 0, 616, 921, 0, 0,
+	0, 0, 0, 451, 2167, 0, 934, 0, 0, 0,
+	0, 451, 0, 0, 451, 451, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	0, 0, 0, 0, 0, 0, 1610, 1187, 0, 0,
+	0, 0, 0, 0, 0, 0, 660, 0, 0, 0,
+	1020, 0, 0, 0, 0, 1028, 0, 1626, 0, 0,
+	0, 0, 0, 485, 0, 0, 262, 0, 0, 262,
+	1814, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1648, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 613, 0, 0, 0, 262, 0, 0, 0,
+	0, 0, 262, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 451, 451, 0, 0,
+	0, 451, 0, 0, 910, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1008, 1009, 1010, 1011, 1012, 1013,
+	1014, 0, 1015, 1016, 1017, 1018, 1019, 998, 999, 1188,
+	1197, 451, 0, 1198, 1190, 1199, 1200, 1201, 1202, 1203,
+	1204, 1205, 1206, 1189, 0, 0, 1714, 0, 0, 113,
+	0, 262, 0, 0, 636, 1208, 1191, 1192, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 451, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 262, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 451, 451, 0, 0,
+	0, 0, 0, 0, 1222, 0, 1224, 0, 0, 0,
+	0, 0, 0, 1046, 451, 451, 451, 0, 0, 0,
+	1787, 0, 0, 0, 1247, 0, 0, 660, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 451, 0, 0, 0, 0, 660, 660,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 451, 1283, 1812, 451, 0, 0,
+	451, 451, 451, 451, 0, 0, 1111, 451, 451, 451,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 451, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1208, 0, 0, 1868,
+	0, 1648, 0, 0, 0, 1353, 0, 0, 0, 0,
+	0, 0, 0, 0, 1209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1648, 0,
+	617, 1111, 0, 0, 0, 0, 617, 617, 262, 0,
+	1209, 0, 0, 0, 0, 617, 0, 0, 0, 1209,
+	0, 0, 0, 262, 262, 0, 0, 0, 0, 0,
+	1391, 617, 617, 617, 617, 617, 1256, 0, 0, 451,
+	0, 0, 0, 1919, 0, 0, 0, 0, 0, 0,
+	1922, 0, 0, 0, 0, 0, 0, 0, 1925, 0,
+	1256, 0, 0, 0, 0, 910, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 790, 0, 0, 451, 0, 0,
+	0, 0, 0, 0, 451, 0, 0, 0, 0, 0,
+	1111, 0, 451, 451, 1208, 0, 0, 0, 636, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 485, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 854, 0, 0, 0, 1986,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1455, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	451, 0, 0, 2033, 2034, 0, 0, 0, 0, 660,
+	0, 1648, 0, 1471, 0, 0, 0, 0, 0, 937,
+	1474, 0, 0, 0, 0, 0, 0, 0, 0, 1479,
+	0, 1480, 1481, 1482, 0, 0, 0, 0, 0, 1490,
+	0, 0, 1338, 0, 1494, 1496, 0, 0, 0, 0,
+	0, 1502, 0, 0, 1504, 1505, 1506, 1507, 1508, 0,
+	0, 0, 0, 451, 1024, 0, 451, 0, 660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 451, 660, 0, 0, 0, 660, 660, 0, 0,
+	1529, 451, 0, 0, 451, 0, 0, 450, 0, 0,
+	0, 0, 0, 0, 0, 1540, 0, 0, 0, 0,
+	559, 0, 0, 0, 0, 0, 576, 0, 0, 0,
+	0, 0, 586, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1208, 0, 0, 2155, 0,
+	660, 0, 0, 2159, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 660, 0, 0, 0, 0, 0, 0, 617,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2182, 1147, 1148, 1149, 0, 0, 1209, 0,
+	0, 0, 0, 0, 617, 0, 0, 0, 0, 1611,
+	451, 0, 0, 0, 0, 0, 0, 0, 0, 1256,
+	0, 0, 0, 0, 0, 0, 0, 0, 1208, 0,
+	0, 0, 0, 0, 0, 1212, 0, 0, 2182, 0,
+	451, 0, 0, 1256, 0, 0, 0, 0, 485, 0,
+	0, 1228, 1229, 0, 0, 0, 1233, 1238, 0, 0,
+	0, 0, 0, 1654, 0, 617, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1663, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 451, 0, 643, 0, 0, 0, 0, 0,
+	451, 0, 1256, 0, 451, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 937, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1321, 1322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1348, 0,
+	0, 0, 1746, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1652, 1653, 0, 0, 0, 0, 1785,
+	0, 0, 0, 0, 0, 0, 1788, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1111, 0, 0, 0,
+	617, 617, 0, 0, 0, 0, 674, 0, 0, 0,
+	0, 0, 1805, 1807, 0, 0, 0, 0, 0, 0,
+	693, 0, 0, 1811, 0, 0, 0, 0, 0, 0,
+	0, 1816, 0, 1817, 0, 0, 0, 0, 0, 0,
+	0, 739, 0, 0, 1826, 1827, 1829, 1831, 1833, 1834,
+	1835, 0, 751, 1838, 0, 0, 0, 0, 0, 0,
+	757, 0, 0, 757, 761, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1209, 451, 451, 451, 451,
+	451, 451, 0, 0, 0, 1857, 0, 0, 0, 1745,
+	0, 451, 451, 0, 0, 451, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 451, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 451, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1917, 0, 0, 0, 0, 0, 1920,
+	1921, 0, 0, 0, 0, 896, 896, 0, 0, 0,
+	900, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1929, 0, 0, 0, 0, 0,
+	0, 0, 1932, 1933, 0, 0, 0, 0, 1937, 0,
+	932, 1501, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1956, 1957, 1958, 0, 0, 617, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1209, 969, 0, 0,
+	0, 0, 0, 0, 1972, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 693, 1035, 0, 0, 0,
+	0, 451, 0, 0, 937, 0, 0, 0, 0, 0,
+	0, 0, 0, 1047, 1048, 1049, 2008, 2009, 0, 0,
+	2010, 2011, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1079, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1100, 0, 0, 1103, 0, 0, 1106,
+	1107, 1108, 1109, 0, 0, 0, 757, 757, 757, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 451, 0, 2078, 0,
+	451, 0, 0, 0, 0, 0, 0, 1146, 0, 0,
+	0, 0, 0, 0, 1209, 0, 0, 0, 0, 0,
+	0, 0, 0, 2102, 2103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 451, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 485, 0, 0, 0, 1657, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2130, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1682, 0, 0, 1684, 2154,
+	0, 0, 0, 0, 2158, 0, 0, 1693, 0, 0,
+	2160, 0, 617, 2161, 2162, 0, 0, 2028, 757, 0,
+	0, 1699, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1256, 0, 0, 0,
+	0, 0, 2174, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1719, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2186, 451, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1315, 0, 0, 0,
+	0, 0, 0, 1323, 0, 1763, 0, 0, 0, 0,
+	0, 1329, 0, 0, 2200, 2201, 0, 0, 0, 0,
+	0, 40, 84, 42, 44, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 0,
+	0, 0, 45, 71, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 63, 0, 1209, 0, 0, 1809, 83,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
+	43, 0, 94, 0, 0, 0, 0, 0, 0, 1400,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1836, 1837, 0, 0, 0, 0, 0, 0,
+	0, 1844, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 49, 86, 53, 52,
+	55, 0, 1434, 0, 0, 1435, 0, 0, 1209, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1438, 62, 92, 93, 0, 57, 56, 58, 54, 0,
+	761, 0, 0, 761, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1904, 0, 0, 0, 0, 36, 37, 0, 64, 65,
+	70, 66, 67, 68, 69, 0, 0, 72, 0, 73,
+	87, 88, 89, 90, 0, 0, 0, 59, 60, 61,
+	75, 76, 77, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 485, 0, 0, 0, 0, 0, 1963, 0,
+	0, 1964, 0, 0, 0, 1966, 0, 0, 0, 1503,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 896,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 74, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1550, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 757, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 485, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2123, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2149, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2165,
+	377, 0, 323, 380, 296, 313, 388, 314, 315, 350,
+	275, 332, 189, 311, 0, 300, 308, 270, 297, 143,
+	328, 294, 364, 336, 167, 386, 169, 344, 0, 210,
+	180, 389, 353, 391, 289, 287, 288, 135, 0, 0,
+	369, 370, 367, 368, 301, 327, 371, 330, 360, 321,
+	352, 282, 343, 381, 312, 348, 382, 0, 0, 362,
+	269, 318, 358, 0, 0, 325, 136, 220, 221, 1089,
+	390, 199, 0, 264, 229, 195, 153, 0, 1090, 0,
+	0, 0, 0, 0, 1755, 131, 0, 347, 376, 310,
+	231, 349, 268, 346, 0, 273, 277, 387, 374, 305,
+	306, 0, 0, 0, 0, 0, 0, 0, 326, 331,
+	356, 319, 340, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 1781, 341, 0, 0, 0, 0, 279, 274,
+	0, 324, 0, 0, 1789, 0, 281, 0, 303, 357,
+	0, 267, 150, 116, 361, 372, 320, 237, 375, 317,
+	378, 197, 0, 0, 213, 155, 154, 166, 0, 0,
+	0, 365, 298, 309, 307, 203, 191, 129, 228, 339,
+	192, 202, 171, 219, 198, 227, 156, 146, 140, 272,
+	304, 147, 215, 144, 351, 322, 359, 299, 366, 355,
+	342, 238, 239, 216, 236, 119, 214, 226, 132, 206,
+	245, 141, 160, 152, 329, 174, 345, 379, 337, 276,
+	278, 217, 205, 354, 295, 316, 266, 130, 125, 335,
+	196, 148, 138, 161, 0, 0, 0, 134, 186, 0,
+	0, 0, 0, 0, 0, 0, 121, 223, 212, 178,
+	162, 163, 120, 0, 201, 142, 151, 139, 187, 137,
+	246, 126, 235, 123, 127, 234, 185, 218, 224, 179,
+	176, 122, 222, 177, 175, 165, 145, 157, 193, 173,
+	194, 158, 182, 181, 183, 0, 271, 0, 211, 232,
+	247, 293, 373, 240, 241, 242, 243, 0, 0, 0,
+	184, 128, 159, 208, 164, 172, 200, 244, 190, 204,
+	133, 230, 209, 285, 292, 283, 286, 284, 333, 334,
+	383, 384, 385, 280, 0, 118, 290, 291, 0, 0,
+	0, 0, 124, 170, 225, 0, 363, 207, 188, 338,
+	117, 0, 168, 149, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1943, 0, 0, 0, 0,
+	0, 0, 377, 0, 323, 380, 296, 313, 388, 314,
+	315, 350, 275, 332, 189, 311, 0, 300, 308, 270,
+	297, 143, 328, 294, 364, 336, 167, 386, 169, 344,
+	0, 210, 180, 389, 353, 391, 289, 287, 288, 135,
+	0, 0, 369, 370, 367, 368, 301, 327, 371, 330,
+	360, 321, 352, 282, 343, 381, 312, 348, 382, 0,
+	0, 362, 269, 318, 358, 0, 0, 325, 136, 220,
+	221, 0, 390, 199, 0, 264, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 347,
+	376, 310, 231, 349, 268, 346, 0, 273, 277, 387,
+	374, 305, 306, 0, 0, 0, 0, 0, 0, 0,
+	326, 331, 356, 319, 340, 0, 0, 0, 0, 0,
+	0, 1720, 0, 302, 0, 341, 0, 0, 0, 0,
+	279, 274, 0, 324, 0, 0, 0, 0, 281, 0,
+	303, 357, 2070, 267, 150, 116, 361, 372, 320, 237,
+	375, 317, 378, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 365, 298, 309, 307, 203, 191, 129,
+	228, 339, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 272, 304, 147, 215, 144, 351, 322, 359, 299,
+	366, 355, 342, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 329, 174, 345, 379,
+	337, 276, 278, 217, 205, 354, 295, 316, 266, 130,
+	125, 335, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 271, 0,
+	211, 232, 247, 293, 373, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 285, 292, 283, 286, 284,
+	333, 334, 383, 384, 385, 280, 0, 118, 290, 291,
+	0, 0, 0, 0, 124, 170, 225, 0, 363, 207,
+	188, 338, 117, 0, 168, 149, 233, 377, 0, 323,
+	380, 296, 313, 388, 314, 315, 350, 275, 332, 189,
+	311, 0, 300, 308, 270, 297, 143, 328, 294, 364,
+	336, 167, 386, 169, 344, 0, 210, 180, 389, 353,
+	391, 289, 287, 288, 135, 0, 0, 369, 370, 367,
+	368, 301, 327, 371, 330, 360, 321, 352, 282, 343,
+	381, 312, 348, 382, 0, 0, 362, 269, 318, 358,
+	0, 0, 325, 136, 220, 221, 0, 390, 199, 94,
+	264, 229, 195, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 347, 376, 310, 231, 349, 268,
+	346, 0, 273, 277, 387, 374, 305, 306, 0, 0,
+	0, 0, 0, 0, 0, 326, 331, 356, 319, 340,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	341, 0, 0, 0, 0, 279, 274, 0, 324, 0,
+	0, 0, 0, 281, 0, 303, 357, 0, 267, 150,
+	116, 361, 372, 320, 237, 375, 317, 378, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 365, 298,
+	309, 307, 203, 191, 129, 228, 339, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 272, 304, 147, 215,
+	144, 351, 322, 359, 299, 366, 355, 342, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 329, 174, 345, 379, 337, 276, 278, 217, 205,
+	354, 295, 316, 266, 130, 125, 335, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 127, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 271, 0, 211, 232, 247, 293, 373,
+	240, 241, 242, 243, 0, 0, 0, 184, 128, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	285, 292, 283, 286, 284, 333, 334, 383, 384, 385,
+	280, 0, 118, 290, 291, 0, 0, 0, 0, 124,
+	170, 225, 0, 363, 207, 188, 338, 117, 0, 168,
+	149, 233, 377, 0, 323, 380, 296, 313, 388, 314,
+	315, 350, 275, 332, 189, 311, 0, 300, 308, 270,
+	297, 143, 328, 294, 364, 336, 167, 386, 169, 344,
+	0, 210, 180, 389, 353, 391, 289, 287, 288, 135,
+	0, 0, 369, 370, 367, 368, 301, 327, 371, 330,
+	360, 321, 352, 282, 343, 381, 312, 348, 382, 0,
+	0, 362, 269, 318, 358, 0, 0, 325, 136, 220,
+	221, 0, 390, 199, 0, 555, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 347,
+	376, 310, 231, 349, 268, 346, 0, 273, 277, 387,
+	374, 305, 306, 0, 0, 0, 0, 0, 0, 0,
+	326, 331, 356, 319, 340, 0, 0, 0, 0, 0,
+	0, 1325, 0, 302, 0, 341, 0, 0, 0, 0,
+	279, 274, 0, 324, 0, 0, 0, 0, 281, 0,
+	303, 357, 0, 267, 150, 116, 361, 372, 320, 237,
+	375, 317, 378, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 365, 298, 309, 307, 203, 191, 129,
+	228, 339, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 272, 304, 147, 215, 144, 351, 322, 359, 299,
+	366, 355, 342, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 329, 174, 345, 379,
+	337, 276, 278, 217, 205, 354, 295, 316, 1221, 130,
+	125, 335, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 271, 0,
+	211, 232, 247, 293, 373, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 285, 292, 283, 286, 284,
+	333, 334, 383, 384, 385, 280, 0, 118, 290, 291,
+	0, 0, 0, 0, 124, 170, 225, 0, 363, 207,
+	188, 338, 117, 0, 168, 149, 233, 377, 0, 323,
+	380, 296, 313, 388, 314, 315, 350, 275, 332, 189,
+	311, 0, 300, 308, 270, 297, 143, 328, 294, 364,
+	336, 167, 386, 169, 344, 0, 210, 180, 389, 353,
+	391, 289, 287, 288, 135, 0, 0, 369, 370, 367,
+	368, 301, 327, 371, 330, 360, 321, 352, 282, 343,
+	381, 312, 348, 382, 0, 0, 362, 269, 318, 358,
+	0, 0, 325, 136, 220, 221, 0, 390, 199, 0,
+	264, 229, 195, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 347, 376, 310, 231, 349, 268,
+	346, 0, 273, 277, 387, 374, 305, 306, 0, 0,
+	0, 0, 0, 0, 0, 326, 331, 356, 319, 340,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	341, 0, 0, 0, 0, 279, 274, 0, 324, 0,
+	0, 0, 0, 281, 0, 303, 357, 0, 267, 150,
+	116, 361, 372, 320, 237, 375, 317, 378, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 365, 298,
+	309, 307, 203, 191, 129, 228, 339, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 272, 304, 147, 215,
+	144, 351, 322, 359, 299, 366, 355, 342, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 329, 174, 345, 379, 337, 276, 278, 217, 205,
+	354, 295, 316, 266, 130, 125, 335, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 127, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 271, 0, 211, 232, 247, 293, 373,
+	240, 241, 242, 243, 0, 0, 0, 184, 128, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	285, 292, 283, 286, 284, 333, 334, 383, 384, 385,
+	280, 0, 118, 290, 291, 0, 0, 0, 0, 124,
+	170, 225, 0, 363, 207, 188, 338, 117, 0, 168,
+	149, 233, 377, 0, 323, 380, 296, 313, 388, 314,
+	315, 350, 275, 332, 189, 311, 0, 300, 308, 270,
+	297, 143, 328, 294, 364, 336, 167, 386, 169, 344,
+	0, 210, 180, 389, 353, 391, 289, 287, 288, 135,
+	0, 0, 369, 370, 367, 368, 301, 327, 371, 330,
+	360, 321, 352, 282, 343, 381, 312, 348, 382, 0,
+	0, 362, 269, 318, 358, 0, 0, 325, 136, 220,
+	221, 0, 390, 199, 0, 555, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 347,
+	376, 310, 231, 349, 268, 346, 0, 273, 277, 387,
+	374, 305, 306, 0, 0, 0, 0, 0, 0, 0,
+	326, 331, 356, 319, 340, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 341, 0, 0, 0, 0,
+	279, 274, 0, 324, 0, 0, 0, 0, 281, 0,
+	303, 357, 0, 267, 150, 116, 361, 372, 320, 237,
+	375, 317, 378, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 365, 298, 309, 307, 203, 191, 129,
+	228, 339, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 272, 304, 147, 215, 144, 351, 322, 359, 299,
+	366, 355, 342, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 329, 174, 345, 379,
+	337, 276, 278, 217, 205, 354, 295, 316, 1221, 130,
+	125, 335, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 271, 0,
+	211, 232, 247, 293, 373, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 285, 292, 283, 286, 284,
+	333, 334, 383, 384, 385, 280, 0, 118, 290, 291,
+	0, 0, 0, 0, 124, 170, 225, 0, 363, 207,
+	188, 338, 117, 0, 168, 149, 233, 377, 0, 323,
+	380, 296, 313, 388, 314, 315, 350, 275, 332, 189,
+	311, 0, 300, 308, 270, 297, 143, 328, 294, 364,
+	336, 167, 386, 169, 344, 0, 210, 180, 389, 353,
+	391, 289, 287, 288, 135, 0, 0, 369, 370, 367,
+	368, 301, 327, 371, 330, 360, 321, 352, 282, 343,
+	381, 312, 348, 382, 0, 0, 362, 269, 318, 358,
+	0, 0, 325, 136, 220, 221, 0, 390, 199, 0,
+	555, 229, 195, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 347, 376, 310, 231, 349, 268,
+	346, 0, 273, 277, 387, 374, 305, 306, 0, 0,
+	0, 0, 0, 0, 0, 326, 331, 356, 319, 340,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	341, 0, 0, 0, 0, 279, 274, 0, 324, 0,
+	0, 0, 0, 281, 0, 303, 357, 0, 267, 150,
+	116, 361, 372, 320, 237, 375, 317, 378, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 365, 298,
+	309, 307, 203, 191, 129, 228, 339, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 272, 304, 147, 215,
+	144, 351, 322, 359, 299, 366, 355, 342, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 329, 174, 345, 379, 337, 276, 278, 217, 205,
+	354, 295, 316, 266, 130, 125, 335, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 639, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 271, 0, 211, 232, 247, 293, 373,
+	240, 241, 242, 243, 0, 0, 0, 640, 638, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	285, 292, 283, 286, 284, 333, 334, 383, 384, 385,
+	280, 0, 118, 290, 291, 0, 0, 0, 0, 124,
+	170, 225, 0, 363, 207, 188, 338, 117, 0, 168,
+	149, 233, 377, 0, 323, 380, 296, 313, 388, 314,
+	315, 350, 275, 332, 189, 311, 0, 300, 308, 270,
+	297, 143, 328, 294, 364, 336, 167, 386, 169, 344,
+	0, 210, 180, 389, 353, 391, 289, 287, 288, 135,
+	0, 0, 369, 370, 367, 368, 301, 327, 371, 330,
+	360, 321, 352, 282, 343, 381, 312, 348, 382, 0,
+	0, 362, 269, 318, 358, 0, 0, 325, 136, 220,
+	221, 0, 390, 199, 0, 114, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 347,
+	376, 310, 231, 349, 268, 346, 0, 273, 277, 387,
+	374, 305, 306, 0, 0, 0, 0, 0, 0, 0,
+	326, 331, 356, 319, 340, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 341, 0, 0, 0, 0,
+	279, 274, 0, 324, 0, 0, 0, 0, 281, 0,
+	303, 357, 0, 267, 150, 116, 361, 372, 320, 237,
+	375, 317, 378, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 365, 298, 309, 307, 203, 191, 129,
+	228, 339, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 272, 304, 147, 215, 144, 351, 322, 359, 299,
+	366, 355, 342, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 329, 174, 345, 379,
+	337, 276, 278, 217, 205, 354, 295, 316, 1113, 130,
+	125, 335, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 271, 0,
+	211, 232, 247, 293, 373, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 285, 292, 283, 286, 284,
+	333, 334, 383, 384, 385, 280, 0, 118, 290, 291,
+	0, 0, 0, 0, 124, 170, 225, 0, 363, 207,
+	188, 338, 117, 0, 168, 149, 233, 377, 0, 323,
+	380, 296, 313, 388, 314, 315, 350, 275, 332, 189,
+	311, 0, 300, 308, 270, 297, 143, 328, 294, 364,
+	336, 167, 386, 169, 344, 0, 210, 180, 389, 353,
+	391, 289, 287, 288, 135, 0, 0, 369, 370, 367,
+	368, 301, 327, 371, 330, 360, 321, 352, 282, 343,
+	381, 312, 348, 382, 0, 0, 362, 269, 318, 358,
+	0, 0, 325, 136, 220, 221, 0, 390, 199, 0,
+	555, 229, 195, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 347, 376, 310, 231, 349, 268,
+	346, 0, 273, 277, 387, 374, 305, 306, 0, 0,
+	0, 0, 0, 0, 0, 326, 331, 356, 319, 340,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	341, 0, 0, 0, 0, 279, 274, 0, 324, 0,
+	0, 0, 0, 281, 0, 303, 357, 0, 267, 150,
+	116, 361, 372, 320, 237, 375, 317, 378, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 365, 298,
+	309, 307, 203, 191, 129, 228, 339, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 272, 304, 147, 215,
+	144, 351, 322, 359, 299, 366, 355, 342, 238, 239,
+	216, 236, 119, 214, 956, 132, 206, 245, 141, 160,
+	152, 329, 174, 345, 379, 337, 276, 278, 217, 205,
+	354, 295, 316, 266, 130, 125, 335, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 639, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 271, 0, 211, 232, 247, 293, 373,
+	240, 241, 242, 243, 0, 0, 0, 640, 638, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	285, 292, 283, 286, 284, 333, 334, 383, 384, 385,
+	280, 0, 118, 290, 291, 0, 0, 0, 0, 124,
+	170, 225, 0, 363, 207, 188, 338, 117, 0, 168,
+	149, 233, 377, 0, 323, 380, 296, 313, 388, 314,
+	315, 350, 275, 332, 189, 311, 0, 300, 308, 270,
+	297, 143, 328, 294, 364, 336, 167, 386, 169, 344,
+	0, 210, 180, 389, 353, 391, 289, 287, 288, 135,
+	0, 0, 369, 370, 367, 368, 301, 327, 371, 330,
+	360, 321, 352, 282, 343, 381, 312, 348, 382, 0,
+	0, 362, 269, 318, 358, 0, 0, 325, 136, 220,
+	221, 0, 390, 199, 0, 555, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 347,
+	376, 310, 231, 349, 268, 346, 0, 273, 277, 387,
+	374, 305, 306, 0, 0, 0, 0, 0, 0, 0,
+	326, 331, 356, 319, 340, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 341, 0, 0, 0, 0,
+	279, 274, 0, 324, 0, 0, 0, 0, 281, 0,
+	303, 357, 0, 267, 150, 116, 361, 372, 320, 237,
+	375, 317, 378, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 365, 298, 309, 307, 203, 191, 129,
+	228, 339, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 272, 304, 147, 215, 144, 351, 322, 359, 299,
+	366, 355, 342, 238, 239, 216, 236, 119, 214, 631,
+	132, 206, 245, 141, 160, 152, 329, 174, 345, 379,
+	337, 276, 278, 217, 205, 354, 295, 316, 266, 130,
+	125, 335, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 639, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 271, 0,
+	211, 232, 247, 293, 373, 240, 241, 242, 243, 0,
+	0, 0, 640, 638, 634, 633, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 285, 292, 283, 286, 284,
+	333, 334, 383, 384, 385, 280, 0, 118, 290, 291,
+	0, 0, 0, 0, 124, 170, 225, 0, 363, 207,
+	188, 338, 117, 0, 168, 149, 233, 377, 0, 323,
+	380, 296, 313, 388, 314, 315, 350, 275, 332, 189,
+	311, 0, 300, 308, 270, 297, 143, 328, 294, 364,
+	336, 167, 386, 169, 344, 0, 210, 180, 389, 353,
+	391, 289, 287, 288, 135, 0, 0, 369, 370, 367,
+	368, 301, 327, 371, 330, 360, 321, 352, 282, 343,
+	381, 312, 348, 382, 0, 0, 0, 0, 0, 0,
+	0, 0, 325, 136, 220, 221, 1089, 390, 199, 0,
+	264, 229, 195, 153, 0, 1090, 0, 0, 0, 0,
+	0, 0, 131, 0, 347, 376, 310, 231, 349, 268,
+	346, 0, 273, 277, 387, 374, 305, 306, 1582, 0,
+	0, 0, 0, 0, 0, 326, 331, 356, 319, 340,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	341, 0, 0, 0, 0, 279, 274, 0, 324, 0,
+	0, 0, 0, 281, 0, 303, 357, 0, 267, 150,
+	116, 361, 372, 320, 237, 375, 317, 378, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 365, 298,
+	309, 307, 203, 191, 129, 228, 339, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 272, 304, 147, 215,
+	144, 351, 322, 359, 299, 366, 355, 342, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 329, 174, 345, 379, 337, 276, 278, 217, 205,
+	354, 295, 316, 266, 130, 125, 335, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 127, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 271, 0, 211, 232, 247, 293, 373,
+	240, 241, 242, 243, 0, 0, 0, 184, 128, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	285, 292, 283, 286, 284, 333, 334, 383, 384, 385,
+	280, 0, 118, 290, 291, 0, 0, 0, 0, 124,
+	170, 225, 0, 363, 207, 188, 338, 117, 0, 168,
+	149, 233, 377, 0, 323, 380, 296, 313, 388, 314,
+	315, 350, 275, 332, 189, 311, 0, 300, 308, 270,
+	297, 143, 328, 294, 364, 336, 167, 386, 169, 344,
+	0, 210, 180, 389, 353, 391, 289, 287, 288, 135,
+	0, 0, 369, 370, 367, 368, 301, 327, 371, 330,
+	360, 321, 352, 282, 343, 381, 312, 348, 382, 0,
+	0, 0, 0, 0, 0, 0, 0, 325, 136, 220,
+	221, 1089, 390, 199, 0, 264, 229, 195, 153, 0,
+	1090, 0, 0, 0, 0, 0, 0, 131, 0, 347,
+	376, 310, 231, 349, 268, 346, 0, 273, 277, 387,
+	374, 305, 306, 0, 0, 0, 0, 0, 0, 0,
+	326, 331, 356, 319, 340, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 341, 0, 0, 0, 0,
+	279, 274, 0, 324, 0, 0, 0, 0, 281, 0,
+	303, 357, 0, 267, 150, 116, 361, 372, 320, 237,
+	375, 317, 378, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 365, 298, 309, 307, 203, 191, 129,
+	228, 339, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 272, 304, 147, 215, 144, 351, 322, 359, 299,
+	366, 355, 342, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 329, 174, 345, 379,
+	337, 276, 278, 217, 205, 354, 295, 316, 266, 130,
+	125, 335, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 271, 0,
+	211, 232, 247, 293, 373, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 285, 292, 283, 286, 284,
+	333, 334, 383, 384, 385, 280, 0, 118, 290, 291,
+	0, 0, 0, 0, 124, 170, 225, 0, 363, 207,
+	188, 338, 117, 189, 168, 149, 233, 490, 0, 0,
+	143, 0, 489, 0, 0, 167, 539, 169, 540, 0,
+	210, 180, 0, 0, 391, 0, 0, 0, 135, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 527, 528,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 920,
+	83, 0, 0, 554, 0, 0, 0, 496, 497, 498,
+	511, 43, 199, 94, 555, 229, 195, 153, 512, 514,
+	515, 516, 517, 518, 0, 0, 131, 513, 519, 520,
+	521, 231, 0, 0, 487, 505, 0, 538, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 502, 503,
+	0, 0, 0, 0, 553, 0, 0, 504, 0, 0,
+	500, 501, 506, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 116, 552, 0, 0, 237, 0,
+	550, 0, 197, 0, 0, 213, 155, 154, 166, 0,
+	0, 0, 0, 0, 0, 0, 203, 191, 129, 228,
+	0, 192, 202, 171, 219, 198, 227, 156, 146, 140,
+	0, 0, 147, 215, 144, 0, 0, 0, 0, 0,
+	0, 0, 238, 239, 216, 236, 119, 214, 226, 132,
+	206, 245, 141, 160, 152, 0, 174, 0, 0, 0,
+	0, 0, 217, 205, 0, 0, 0, 266, 130, 125,
+	0, 196, 148, 138, 161, 0, 0, 0, 134, 186,
+	0, 0, 0, 0, 0, 0, 0, 121, 223, 212,
+	178, 162, 163, 120, 0, 201, 142, 151, 139, 187,
+	137, 246, 126, 235, 123, 127, 234, 185, 218, 224,
+	179, 176, 122, 222, 177, 175, 165, 145, 157, 193,
+	173, 194, 158, 182, 181, 183, 0, 0, 0, 211,
+	232, 247, 0, 0, 240, 241, 242, 243, 0, 0,
+	0, 184, 128, 159, 208, 164, 172, 200, 244, 190,
+	204, 133, 230, 209, 541, 551, 547, 549, 548, 545,
+	546, 544, 543, 542, 529, 530, 118, 557, 558, 532,
+	533, 534, 535, 124, 170, 225, 537, 0, 207, 188,
+	536, 117, 0, 168, 149, 233, 189, 526, 0, 499,
+	490, 0, 0, 143, 0, 489, 0, 0, 167, 539,
+	169, 540, 0, 210, 180, 0, 0, 391, 0, 0,
+	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 527, 528, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 0, 0, 554, 0, 0, 0,
+	496, 497, 498, 511, 43, 199, 94, 555, 229, 195,
+	153, 512, 514, 515, 516, 517, 518, 0, 0, 131,
+	513, 519, 520, 521, 231, 0, 0, 487, 505, 0,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 502, 503, 0, 0, 0, 0, 553, 0, 0,
+	504, 0, 0, 500, 501, 506, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 150, 116, 552, 0,
+	0, 237, 0, 550, 0, 197, 0, 0, 213, 155,
+	154, 166, 0, 0, 0, 0, 0, 0, 0, 203,
+	191, 129, 228, 0, 192, 202, 171, 219, 198, 227,
+	156, 146, 140, 0, 0, 147, 215, 144, 0, 0,
+	0, 0, 0, 0, 0, 238, 239, 216, 236, 119,
+	214, 226, 132, 206, 245, 141, 160, 152, 0, 174,
+	0, 0, 0, 0, 0, 217, 205, 0, 0, 0,
+	266, 130, 125, 0, 196, 148, 138, 161, 0, 0,
+	0, 134, 186, 0, 0, 0, 0, 0, 0, 0,
+	121, 223, 212, 178, 162, 163, 120, 0, 201, 142,
+	151, 139, 187, 137, 246, 126, 235, 123, 127, 234,
+	185, 218, 224, 179, 176, 122, 222, 177, 175, 165,
+	145, 157, 193, 173, 194, 158, 182, 181, 183, 0,
+	0, 0, 211, 232, 247, 0, 0, 240, 241, 242,
+	243, 0, 0, 0, 184, 128, 159, 208, 164, 172,
+	200, 244, 190, 204, 133, 230, 209, 541, 551, 547,
+	549, 548, 545, 546, 544, 543, 542, 529, 530, 118,
+	557, 558, 532, 533, 534, 535, 124, 170, 225, 537,
+	0, 207, 188, 536, 117, 0, 168, 149, 233, 0,
+	526, 189, 499, 0, 1215, 490, 0, 0, 143, 0,
+	489, 0, 0, 167, 539, 169, 540, 0, 210, 180,
+	0, 0, 391, 0, 0, 0, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 527, 528, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 554, 0, 0, 0, 496, 497, 498, 511, 0,
+	199, 94, 555, 229, 195, 153, 512, 514, 515, 516,
+	517, 518, 0, 0, 131, 513, 519, 520, 521, 231,
+	0, 0, 487, 505, 0, 538, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 502, 503, 615, 0,
+	0, 0, 553, 0, 0, 504, 0, 0, 500, 501,
+	506, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 116, 552, 0, 0, 237, 0, 550, 0,
+	197, 0, 0, 213, 155, 154, 166, 0, 0, 0,
+	0, 0, 0, 0, 203, 191, 129, 228, 0, 192,
+	202, 171, 219, 198, 227, 156, 146, 140, 0, 0,
+	147, 215, 144, 0, 0, 0, 0, 0, 0, 0,
+	238, 239, 216, 236, 119, 214, 226, 132, 206, 245,
+	141, 160, 152, 0, 174, 0, 0, 0, 0, 0,
+	217, 205, 0, 0, 0, 266, 130, 125, 0, 196,
+	148, 138, 161, 0, 0, 0, 134, 186, 0, 0,
+	0, 0, 0, 0, 0, 121, 223, 212, 178, 162,
+	163, 120, 0, 201, 142, 151, 139, 187, 137, 246,
+	126, 235, 123, 127, 234, 185, 218, 224, 179, 176,
+	122, 222, 177, 175, 165, 145, 157, 193, 173, 194,
+	158, 182, 181, 183, 0, 0, 0, 211, 232, 247,
+	0, 0, 240, 241, 242, 243, 0, 0, 0, 184,
+	128, 159, 208, 164, 172, 200, 244, 190, 204, 133,
+	230, 209, 541, 551, 547, 549, 548, 545, 546, 544,
+	543, 542, 529, 530, 118, 557, 558, 532, 533, 534,
+	535, 124, 170, 225, 537, 0, 207, 188, 536, 117,
+	0, 168, 149, 233, 189, 526, 0, 499, 490, 0,
+	0, 143, 0, 489, 0, 0, 167, 539, 169, 540,
+	0, 210, 180, 0, 0, 391, 0, 0, 0, 135,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 527,
+	528, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 554, 0, 0, 0, 496, 497,
+	498, 511, 0, 199, 94, 555, 229, 195, 153, 512,
+	514, 515, 516, 517, 518, 0, 0, 131, 513, 519,
+	520, 521, 231, 0, 0, 487, 505, 0, 538, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 502,
+	503, 615, 0, 0, 0, 553, 0, 0, 504, 0,
+	0, 500, 501, 506, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 116, 552, 0, 0, 237,
+	0, 550, 0, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 0, 0, 0, 0, 203, 191, 129,
+	228, 0, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 0, 0, 147, 215, 144, 0, 0, 0, 0,
+	0, 0, 0, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 0, 174, 0, 0,
+	0, 0, 0, 217, 205, 0, 0, 0, 266, 130,
+	125, 0, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 0, 0,
+	211, 232, 247, 0, 0, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 541, 551, 547, 549, 548,
+	545, 546, 544, 543, 542, 529, 530, 118, 557, 558,
+	532, 533, 534, 535, 124, 170, 225, 537, 0, 207,
+	188, 536, 117, 0, 168, 149, 233, 189, 526, 0,
+	499, 490, 0, 0, 143, 0, 489, 0, 0, 167,
+	539, 169, 540, 0, 210, 180, 0, 0, 391, 0,
+	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 527, 528, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 920, 0, 0, 0, 554, 0, 0,
+	0, 496, 497, 498, 511, 0, 199, 94, 555, 229,
+	195, 153, 512, 514, 515, 516, 517, 518, 0, 0,
+	131, 513, 519, 520, 521, 231, 0, 0, 487, 505,
+	0, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 502, 503, 0, 0, 0, 0, 553, 0,
+	0, 504, 0, 0, 500, 501, 506, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 116, 552,
+	0, 0, 237, 0, 550, 0, 197, 0, 0, 213,
+	155, 154, 166, 0, 0, 0, 0, 0, 0, 0,
+	203, 191, 129, 228, 0, 192, 202, 171, 219, 198,
+	227, 156, 146, 140, 0, 0, 147, 215, 144, 0,
+	0, 0, 0, 0, 0, 0, 238, 239, 216, 236,
+	119, 214, 226, 132, 206, 245, 141, 160, 152, 0,
+	174, 0, 0, 0, 0, 0, 217, 205, 0, 0,
+	0, 266, 130, 125, 0, 196, 148, 138, 161, 0,
+	0, 0, 134, 186, 0, 0, 0, 0, 0, 0,
+	0, 121, 223, 212, 178, 162, 163, 120, 0, 201,
+	142, 151, 139, 187, 137, 246, 126, 235, 123, 127,
+	234, 185, 218, 224, 179, 176, 122, 222, 177, 175,
+	165, 145, 157, 193, 173, 194, 158, 182, 181, 183,
+	0, 0, 0, 211, 232, 247, 0, 0, 240, 241,
+	242, 243, 0, 0, 0, 184, 128, 159, 208, 164,
+	172, 200, 244, 190, 204, 133, 230, 209, 541, 551,
+	547, 549, 548, 545, 546, 544, 543, 542, 529, 530,
+	118, 557, 558, 532, 533, 534, 535, 124, 170, 225,
+	537, 0, 207, 188, 536, 117, 0, 168, 149, 233,
+	189, 526, 0, 499, 490, 0, 0, 143, 0, 489,
+	0, 0, 167, 539, 169, 1542, 0, 210, 180, 0,
+	0, 391, 0, 0, 0, 135, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 527, 528, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	554, 0, 0, 0, 496, 497, 498, 511, 0, 199,
+	94, 555, 229, 195, 153, 512, 514, 515, 516, 517,
+	518, 0, 0, 131, 513, 519, 520, 521, 231, 0,
+	0, 487, 505, 0, 538, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 502, 503, 615, 0, 0,
+	0, 553, 0, 0, 504, 0, 0, 500, 501, 506,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 116, 552, 0, 0, 237, 0, 550, 0, 197,
+	0, 0, 213, 155, 154, 166, 0, 0, 0, 0,
+	0, 0, 0, 203, 191, 129, 228, 0, 192, 202,
+	171, 219, 198, 227, 156, 146, 140, 0, 0, 147,
+	215, 144, 0, 0, 0, 0, 0, 0, 0, 238,
+	239, 216, 236, 119, 214, 226, 132, 206, 245, 141,
+	160, 152, 0, 174, 0, 0, 0, 0, 0, 217,
+	205, 0, 0, 0, 266, 130, 125, 0, 196, 148,
+	138, 161, 0, 0, 0, 134, 186, 0, 0, 0,
+	0, 0, 0, 0, 121, 223, 212, 178, 162, 163,
+	120, 0, 201, 142, 151, 139, 187, 137, 246, 126,
+	235, 123, 127, 234, 185, 218, 224, 179, 176, 122,
+	222, 177, 175, 165, 145, 157, 193, 173, 194, 158,
+	182, 181, 183, 0, 0, 0, 211, 232, 247, 0,
+	0, 240, 241, 242, 243, 0, 0, 0, 184, 128,
+	159, 208, 164, 172, 200, 244, 190, 204, 133, 230,
+	209, 541, 551, 547, 549, 548, 545, 546, 544, 543,
+	542, 529, 530, 118, 557, 558, 532, 533, 534, 535,
+	124, 170, 225, 537, 0, 207, 188, 536, 117, 0,
+	168, 149, 233, 189, 526, 0, 499, 490, 0, 0,
+	143, 0, 489, 0, 0, 167, 539, 169, 540, 0,
+	210, 180, 0, 0, 391, 0, 0, 0, 135, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 527, 528,
+	0, 0, 0, 0, 0, 0, 1347, 0, 0, 0,
+	0, 0, 0, 554, 0, 0, 0, 496, 497, 498,
+	511, 0, 199, 94, 555, 229, 195, 153, 512, 514,
+	515, 516, 517, 518, 0, 0, 131, 513, 519, 520,
+	521, 231, 0, 0, 487, 505, 0, 538, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 502, 503,
+	0, 0, 0, 0, 553, 0, 0, 504, 0, 0,
+	500, 501, 506, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 116, 552, 0, 0, 237, 0,
+	550, 0, 197, 0, 0, 213, 155, 154, 166, 0,
+	0, 0, 0, 0, 0, 0, 203, 191, 129, 228,
+	0, 192, 202, 171, 219, 198, 227, 156, 146, 140,
+	0, 0, 147, 215, 144, 0, 0, 0, 0, 0,
+	0, 0, 238, 239, 216, 236, 119, 214, 226, 132,
+	206, 245, 141, 160, 152, 0, 174, 0, 0, 0,
+	0, 0, 217, 205, 0, 0, 0, 266, 130, 125,
+	0, 196, 148, 138, 161, 0, 0, 0, 134, 186,
+	0, 0, 0, 0, 0, 0, 0, 121, 223, 212,
+	178, 162, 163, 120, 0, 201, 142, 151, 139, 187,
+	137, 246, 126, 235, 123, 127, 234, 185, 218, 224,
+	179, 176, 122, 222, 177, 175, 165, 145, 157, 193,
+	173, 194, 158, 182, 181, 183, 0, 0, 0, 211,
+	232, 247, 0, 0, 240, 241, 242, 243, 0, 0,
+	0, 184, 128, 159, 208, 164, 172, 200, 244, 190,
+	204, 133, 230, 209, 541, 551, 547, 549, 548, 545,
+	546, 544, 543, 542, 529, 530, 118, 557, 558, 532,
+	533, 534, 535, 124, 170, 225, 537, 0, 207, 188,
+	536, 117, 0, 168, 149, 233, 189, 526, 0, 499,
+	490, 0, 0, 143, 0, 489, 0, 0, 167, 539,
+	169, 540, 0, 210, 180, 0, 0, 391, 0, 0,
+	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 527, 528, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 554, 0, 0, 0,
+	496, 497, 498, 511, 0, 199, 94, 555, 229, 195,
+	153, 512, 514, 515, 516, 517, 518, 0, 0, 131,
+	513, 519, 520, 521, 231, 0, 0, 487, 505, 0,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 502, 503, 0, 0, 0, 0, 553, 0, 0,
+	504, 0, 0, 500, 501, 506, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 150, 116, 552, 0,
+	0, 237, 0, 550, 0, 197, 0, 0, 213, 155,
+	154, 166, 0, 0, 0, 0, 0, 0, 0, 203,
+	191, 129, 228, 0, 192, 202, 171, 219, 198, 227,
+	156, 146, 140, 0, 0, 147, 215, 144, 0, 0,
+	0, 0, 0, 0, 0, 238, 239, 216, 236, 119,
+	214, 226, 132, 206, 245, 141, 160, 152, 0, 174,
+	0, 0, 0, 0, 0, 217, 205, 0, 0, 0,
+	266, 130, 125, 0, 196, 148, 138, 161, 0, 0,
+	0, 134, 186, 0, 0, 0, 0, 0, 0, 0,
+	121, 223, 212, 178, 162, 163, 120, 0, 201, 142,
+	151, 139, 187, 137, 246, 126, 235, 123, 127, 234,
+	185, 218, 224, 179, 176, 122, 222, 177, 175, 165,
+	145, 157, 193, 173, 194, 158, 182, 181, 183, 0,
+	0, 0, 211, 232, 247, 0, 0, 240, 241, 242,
+	243, 0, 0, 0, 184, 128, 159, 208, 164, 172,
+	200, 244, 190, 204, 133, 230, 209, 541, 551, 547,
+	549, 548, 545, 546, 544, 543, 542, 529, 530, 118,
+	557, 558, 532, 533, 534, 535, 124, 170, 225, 537,
+	0, 207, 188, 536, 117, 0, 168, 149, 233, 189,
+	526, 0, 499, 490, 0, 0, 143, 0, 489, 0,
+	0, 167, 539, 169, 540, 0, 210, 180, 0, 0,
+	391, 0, 0, 0, 135, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 527, 528, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 554,
+	0, 0, 0, 496, 497, 498, 511, 0, 199, 94,
+	555, 229, 195, 153, 512, 514, 515, 516, 517, 518,
+	0, 0, 131, 513, 519, 520, 521, 231, 0, 0,
+	487, 505, 0, 538, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 502, 503, 0, 0, 0, 0,
+	553, 0, 0, 504, 0, 0, 500, 501, 506, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	116, 552, 0, 0, 237, 0, 550, 0, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 0, 0,
+	0, 0, 203, 191, 129, 228, 0, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 0, 0, 147, 215,
+	144, 0, 0, 0, 0, 0, 0, 0, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 0, 174, 0, 0, 0, 0, 0, 217, 205,
+	0, 0, 0, 266, 130, 125, 0, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 127, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 0, 0, 211, 232, 247, 0, 0,
+	240, 241, 242, 243, 0, 0, 0, 184, 128, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	541, 551, 547, 549, 548, 545, 546, 544, 543, 542,
+	529, 530, 118, 557, 558, 532, 533, 534, 535, 1235,
+	1236, 1237, 537, 0, 207, 188, 536, 117, 0, 168,
+	149, 233, 189, 526, 0, 499, 0, 0, 0, 143,
+	0, 564, 0, 0, 167, 539, 169, 540, 0, 210,
+	180, 0, 0, 391, 0, 0, 0, 135, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 527, 528, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 554, 0, 0, 0, 496, 497, 498, 511,
+	0, 199, 94, 555, 229, 195, 153, 512, 514, 515,
+	516, 517, 518, 0, 0, 131, 513, 519, 520, 521,
+	231, 0, 0, 0, 505, 0, 538, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 502, 503, 0,
+	0, 0, 0, 553, 0, 0, 504, 0, 0, 500,
+	501, 506, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 116, 552, 0, 0, 237, 0, 550,
+	0, 197, 0, 0, 213, 155, 154, 166, 0, 0,
+	0, 0, 0, 0, 0, 203, 191, 129, 228, 2189,
+	192, 202, 171, 219, 198, 227, 156, 146, 140, 0,
+	0, 147, 215, 144, 0, 0, 0, 0, 0, 0,
+	0, 238, 239, 216, 236, 119, 214, 226, 132, 206,
+	245, 141, 160, 152, 0, 174, 0, 0, 0, 0,
+	0, 217, 205, 0, 0, 0, 266, 130, 125, 0,
+	196, 148, 138, 161, 0, 0, 0, 134, 186, 0,
+	0, 0, 0, 0, 0, 0, 121, 223, 212, 178,
+	162, 163, 120, 0, 201, 142, 151, 139, 187, 137,
+	246, 126, 235, 123, 127, 234, 185, 218, 224, 179,
+	176, 122, 222, 177, 175, 165, 145, 157, 193, 173,
+	194, 158, 182, 181, 183, 0, 0, 0, 211, 232,
+	247, 0, 0, 240, 241, 242, 243, 0, 0, 0,
+	184, 128, 159, 208, 164, 172, 200, 244, 190, 204,
+	133, 230, 209, 541, 551, 547, 549, 548, 545, 546,
+	544, 543, 542, 529, 530, 118, 557, 558, 532, 533,
+	534, 535, 124, 170, 225, 537, 0, 207, 188, 536,
+	117, 0, 168, 149, 233, 189, 526, 0, 499, 0,
+	0, 0, 143, 0, 564, 0, 0, 167, 539, 169,
+	540, 0, 210, 180, 0, 0, 391, 0, 0, 0,
+	2134, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	527, 528, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 554, 0, 0, 0, 496,
+	497, 498, 511, 0, 199, 94, 555, 2133, 195, 153,
+	512, 514, 515, 516, 517, 518, 0, 0, 131, 513,
+	519, 520, 521, 231, 0, 0, 0, 505, 2132, 538,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	502, 503, 0, 0, 0, 0, 553, 0, 0, 504,
+	0, 0, 500, 501, 506, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 116, 552, 0, 0,
+	237, 0, 550, 0, 197, 0, 0, 213, 155, 154,
+	166, 0, 0, 0, 0, 0, 0, 0, 203, 191,
+	129, 228, 0, 192, 202, 171, 219, 198, 227, 156,
+	146, 140, 0, 0, 147, 215, 144, 0, 0, 0,
+	0, 0, 0, 0, 238, 239, 216, 236, 119, 214,
+	226, 132, 206, 245, 141, 160, 152, 0, 174, 0,
+	0, 0, 0, 0, 217, 205, 0, 0, 0, 266,
+	130, 125, 0, 196, 148, 138, 161, 0, 0, 0,
+	134, 186, 0, 0, 0, 0, 0, 0, 0, 121,
+	223, 212, 178, 162, 163, 120, 0, 201, 142, 151,
+	139, 187, 137, 246, 126, 235, 123, 127, 234, 185,
+	218, 224, 179, 176, 122, 222, 177, 175, 165, 145,
+	157, 193, 173, 194, 158, 182, 181, 183, 0, 0,
+	0, 211, 232, 247, 0, 0, 240, 241, 242, 243,
+	0, 0, 0, 184, 128, 159, 208, 164, 172, 200,
+	244, 190, 204, 133, 230, 209, 541, 551, 547, 549,
+	548, 545, 546, 544, 543, 542, 529, 530, 118, 557,
+	558, 532, 533, 534, 535, 124, 170, 225, 537, 0,
+	207, 188, 536, 117, 0, 168, 149, 233, 189, 526,
+	0, 499, 0, 0, 0, 143, 0, 564, 0, 0,
+	167, 539, 169, 540, 0, 210, 180, 0, 0, 391,
+	0, 0, 0, 2134, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 527, 528, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 554, 0,
+	0, 0, 496, 497, 498, 511, 0, 199, 94, 555,
+	2133, 195, 153, 512, 514, 515, 516, 517, 518, 0,
+	0, 131, 513, 519, 520, 521, 231, 0, 0, 0,
+	505, 0, 538, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 502, 503, 0, 0, 0, 0, 553,
+	0, 0, 504, 0, 0, 500, 501, 506, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 116,
+	552, 0, 0, 237, 0, 550, 0, 197, 0, 0,
+	213, 155, 154, 166, 0, 0, 0, 0, 0, 0,
+	0, 203, 191, 129, 228, 0, 192, 202, 171, 219,
+	198, 227, 156, 146, 140, 0, 0, 147, 215, 144,
+	0, 0, 0, 0, 0, 0, 0, 238, 239, 216,
+	236, 119, 214, 226, 132, 206, 245, 141, 160, 152,
+	0, 174, 0, 0, 0, 0, 0, 217, 205, 0,
+	0, 0, 266, 130, 125, 0, 196, 148, 138, 161,
+	0, 0, 0, 134, 186, 0, 0, 0, 0, 0,
+	0, 0, 121, 223, 212, 178, 162, 163, 120, 0,
+	201, 142, 151, 139, 187, 137, 246, 126, 235, 123,
+	127, 234, 185, 218, 224, 179, 176, 122, 222, 177,
+	175, 165, 145, 157, 193, 173, 194, 158, 182, 181,
+	183, 0, 0, 0, 211, 232, 247, 0, 0, 240,
+	241, 242, 243, 0, 0, 0, 184, 128, 159, 208,
+	164, 172, 200, 244, 190, 204, 133, 230, 209, 541,
+	551, 547, 549, 548, 545, 546, 544, 543, 542, 529,
+	530, 118, 557, 558, 532, 533, 534, 535, 124, 170,
+	225, 537, 0, 207, 188, 536, 117, 0, 168, 149,
+	233, 189, 526, 0, 499, 0, 0, 0, 143, 0,
+	564, 0, 0, 167, 539, 169, 540, 0, 210, 180,
+	0, 0, 391, 0, 0, 0, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 527, 528, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 554, 0, 0, 0, 496, 497, 498, 511, 0,
+	199, 94, 555, 229, 195, 153, 512, 514, 515, 516,
+	517, 518, 0, 0, 131, 513, 519, 520, 521, 231,
+	0, 0, 0, 505, 0, 538, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 502, 503, 0, 0,
+	0, 0, 553, 0, 0, 504, 0, 0, 500, 501,
+	506, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 116, 552, 0, 0, 237, 0, 550, 0,
+	197, 0, 0, 213, 155, 154, 166, 0, 0, 0,
+	0, 0, 0, 0, 203, 191, 129, 228, 0, 192,
+	202, 171, 219, 198, 227, 156, 146, 140, 0, 0,
+	147, 215, 144, 0, 0, 0, 0, 0, 0, 0,
+	238, 239, 216, 236, 119, 214, 226, 132, 206, 245,
+	141, 160, 152, 0, 174, 0, 0, 0, 0, 0,
+	217, 205, 0, 0, 0, 266, 130, 125, 0, 196,
+	148, 138, 161, 0, 0, 0, 134, 186, 0, 0,
+	0, 0, 0, 0, 0, 121, 223, 212, 178, 162,
+	163, 120, 0, 201, 142, 151, 139, 187, 137, 246,
+	126, 235, 123, 127, 234, 185, 218, 224, 179, 176,
+	122, 222, 177, 175, 165, 145, 157, 193, 173, 194,
+	158, 182, 181, 183, 0, 0, 0, 211, 232, 247,
+	0, 0, 240, 241, 242, 243, 0, 0, 0, 184,
+	128, 159, 208, 164, 172, 200, 244, 190, 204, 133,
+	230, 209, 541, 551, 547, 549, 548, 545, 546, 544,
+	543, 542, 529, 530, 118, 557, 558, 532, 533, 534,
+	535, 124, 170, 225, 537, 0, 207, 188, 536, 117,
+	0, 168, 149, 233, 189, 526, 0, 499, 0, 0,
+	0, 143, 0, 564, 0, 0, 167, 539, 169, 540,
+	0, 210, 180, 0, 0, 391, 0, 0, 0, 135,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 527,
+	528, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 554, 0, 0, 0, 496, 497,
+	498, 511, 0, 562, 94, 563, 229, 195, 153, 512,
+	514, 515, 516, 517, 518, 0, 0, 131, 513, 519,
+	520, 521, 231, 0, 0, 0, 505, 0, 538, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 131, 0, 0, 145, 98, 97, 106, 0,
-	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
-	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
-	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
-	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
-	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
-	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
-	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
-	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
-	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
-	158, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 125, 0, 77,
-	0, 108, 169, 133, 95, 160, 93, 0, 0, 0,
-	0, 107, 0, 109, 0, 0, 142, 118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 502,
+	503, 0, 0, 0, 0, 553, 0, 0, 504, 0,
+	0, 500, 501, 506, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 116, 552, 0, 0, 237,
+	0, 550, 0, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 0, 0, 0, 0, 203, 191, 129,
+	228, 0, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 0, 0, 147, 215, 144, 0, 0, 0, 0,
+	0, 0, 0, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 0, 174, 0, 0,
+	0, 0, 0, 217, 205, 0, 0, 0, 266, 130,
+	125, 0, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 0, 0,
+	211, 232, 247, 0, 0, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 541, 551, 547, 549, 548,
+	545, 546, 544, 543, 542, 529, 530, 118, 557, 558,
+	532, 533, 534, 535, 124, 170, 225, 537, 0, 207,
+	188, 536, 117, 0, 168, 149, 233, 189, 526, 0,
+	499, 0, 0, 0, 143, 0, 564, 0, 0, 167,
+	539, 169, 540, 0, 210, 180, 0, 0, 391, 0,
+	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 527, 528, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 554, 0, 0,
+	0, 496, 497, 498, 511, 0, 199, 0, 555, 229,
+	195, 153, 512, 514, 515, 516, 517, 518, 0, 0,
+	131, 513, 519, 520, 521, 231, 0, 0, 0, 505,
+	0, 538, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 75, 0, 0, 693, 0,
-	0, 694, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 502, 503, 0, 0, 0, 0, 553, 0,
+	0, 504, 0, 0, 500, 501, 506, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 116, 552,
+	0, 0, 237, 0, 550, 0, 197, 0, 0, 213,
+	155, 154, 166, 0, 0, 0, 0, 0, 0, 0,
+	203, 191, 129, 228, 0, 192, 202, 171, 219, 198,
+	227, 156, 146, 140, 0, 0, 147, 215, 144, 0,
+	0, 0, 0, 0, 0, 0, 238, 239, 216, 236,
+	119, 214, 226, 132, 206, 245, 141, 160, 152, 0,
+	174, 0, 0, 0, 0, 0, 217, 205, 0, 0,
+	0, 266, 130, 125, 0, 196, 148, 138, 161, 0,
+	0, 0, 134, 186, 0, 0, 0, 0, 0, 0,
+	0, 121, 223, 212, 178, 162, 163, 120, 0, 201,
+	142, 151, 139, 187, 137, 246, 126, 235, 123, 127,
+	234, 185, 218, 224, 179, 176, 122, 222, 177, 175,
+	165, 145, 157, 193, 173, 194, 158, 182, 181, 183,
+	0, 0, 0, 211, 232, 247, 0, 0, 240, 241,
+	242, 243, 0, 0, 0, 184, 128, 159, 208, 164,
+	172, 200, 244, 190, 204, 133, 230, 209, 541, 551,
+	547, 549, 548, 545, 546, 544, 543, 542, 529, 530,
+	118, 557, 558, 532, 533, 534, 535, 124, 170, 225,
+	537, 0, 207, 188, 536, 117, 0, 168, 149, 233,
+	189, 526, 0, 499, 0, 0, 0, 143, 0, 0,
+	0, 0, 167, 0, 169, 0, 0, 210, 180, 0,
+	0, 391, 0, 0, 0, 135, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 136, 220, 221, 0, 0, 199,
+	0, 264, 229, 195, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 0, 0, 0, 231, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 800, 798, 809, 810, 802, 803, 804, 805, 806,
+	807, 808, 801, 799, 0, 0, 811, 0, 0, 0,
+	812, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 116, 0, 0, 0, 237, 0, 0, 0, 197,
+	0, 0, 213, 155, 154, 166, 0, 0, 0, 0,
+	0, 0, 0, 203, 191, 129, 228, 0, 192, 202,
+	171, 219, 198, 227, 156, 146, 140, 0, 0, 147,
+	215, 144, 0, 0, 0, 0, 0, 0, 0, 238,
+	239, 216, 236, 119, 214, 226, 132, 206, 245, 141,
+	160, 152, 0, 174, 0, 0, 0, 0, 0, 217,
+	205, 0, 0, 0, 266, 130, 125, 0, 196, 148,
+	138, 161, 0, 0, 0, 134, 186, 0, 0, 0,
+	0, 0, 0, 0, 121, 223, 212, 178, 162, 163,
+	120, 0, 201, 142, 151, 139, 187, 137, 246, 126,
+	235, 123, 127, 234, 185, 218, 224, 179, 176, 122,
+	222, 177, 175, 165, 145, 157, 193, 173, 194, 158,
+	182, 181, 183, 0, 0, 0, 211, 232, 247, 0,
+	0, 240, 241, 242, 243, 0, 0, 0, 184, 128,
+	159, 208, 164, 172, 200, 244, 190, 204, 133, 230,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	124, 170, 225, 0, 0, 207, 188, 0, 117, 0,
+	168, 149, 233, 591, 593, 594, 0, 0, 0, 0,
+	0, 0, 0, 189, 598, 0, 0, 0, 0, 0,
+	143, 0, 0, 0, 0, 167, 0, 169, 0, 0,
+	210, 180, 0, 0, 391, 0, 0, 0, 135, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 131, 0, 0, 145, 98,
-	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
-	0, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
-	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
-	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	125, 0, 77, 0, 108, 169, 133, 95, 160, 93,
-	0, 593, 0, 0, 107, 0, 109, 0, 0, 142,
-	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 75, 0,
-	592, 0, 0, 0, 0, 0, 0, 86, 0, 0,
+	590, 0, 0, 592, 0, 0, 0, 136, 220, 221,
+	0, 588, 199, 599, 589, 229, 195, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 0, 0,
+	0, 231, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 596, 0, 150, 116, 0, 595, 0, 237, 0,
+	0, 0, 197, 0, 0, 213, 155, 154, 166, 0,
+	0, 0, 0, 0, 0, 0, 203, 191, 129, 228,
+	0, 192, 202, 171, 219, 198, 227, 156, 146, 140,
+	0, 0, 147, 215, 144, 0, 0, 0, 0, 0,
+	0, 0, 238, 239, 216, 236, 119, 214, 226, 132,
+	206, 245, 141, 160, 152, 0, 174, 0, 0, 0,
+	0, 0, 217, 205, 0, 0, 0, 0, 130, 125,
+	0, 196, 148, 138, 161, 0, 0, 0, 134, 186,
+	0, 0, 0, 0, 0, 0, 0, 121, 223, 212,
+	178, 162, 163, 120, 0, 201, 142, 151, 139, 187,
+	137, 246, 126, 235, 123, 127, 234, 185, 218, 224,
+	179, 176, 122, 222, 177, 175, 165, 145, 157, 193,
+	173, 194, 158, 182, 181, 183, 0, 0, 0, 211,
+	232, 247, 0, 0, 240, 241, 242, 243, 597, 0,
+	0, 184, 128, 159, 208, 164, 172, 200, 244, 190,
+	204, 133, 230, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 124, 170, 225, 0, 0, 207, 188,
+	189, 117, 0, 168, 149, 233, 0, 143, 0, 0,
+	0, 0, 167, 0, 169, 0, 0, 210, 180, 0,
+	0, 391, 0, 0, 0, 135, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 0, 0, 131, 0,
-	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
-	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
-	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
-	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
-	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
-	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
-	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
-	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
-	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
-	111, 134, 170, 126, 138, 88, 158, 141, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 77, 0, 108, 169, 133,
-	95, 160, 125, 0, 0, 0, 573, 0, 0, 0,
-	0, 93, 0, 0, 0, 0, 107, 0, 109, 0,
-	0, 142, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 136, 220, 221, 511, 0, 199,
+	0, 555, 229, 195, 153, 512, 514, 515, 516, 517,
+	518, 0, 0, 131, 513, 519, 0, 0, 231, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 0, 575, 0, 0, 0, 0, 0, 0, 86,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 116, 0, 0, 0, 237, 0, 0, 0, 197,
+	0, 0, 213, 155, 154, 166, 0, 0, 0, 0,
+	0, 0, 0, 203, 191, 129, 228, 0, 192, 202,
+	171, 219, 198, 227, 156, 146, 140, 0, 0, 147,
+	215, 144, 0, 0, 0, 0, 0, 0, 0, 238,
+	239, 216, 236, 119, 214, 226, 132, 206, 245, 141,
+	160, 152, 0, 174, 0, 0, 0, 0, 0, 217,
+	205, 0, 0, 0, 266, 130, 125, 0, 196, 148,
+	138, 161, 0, 0, 0, 134, 186, 0, 0, 0,
+	0, 0, 0, 0, 121, 223, 212, 178, 162, 163,
+	120, 0, 201, 142, 151, 139, 187, 137, 246, 126,
+	235, 123, 127, 234, 185, 218, 224, 179, 176, 122,
+	222, 177, 175, 165, 145, 157, 193, 173, 194, 158,
+	182, 181, 183, 0, 0, 0, 211, 232, 247, 0,
+	0, 240, 241, 242, 243, 0, 0, 0, 184, 128,
+	159, 208, 164, 172, 200, 244, 190, 204, 133, 230,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	124, 170, 225, 0, 0, 207, 188, 189, 117, 0,
+	168, 149, 233, 0, 143, 0, 0, 0, 0, 167,
+	0, 169, 1260, 0, 210, 180, 0, 0, 391, 0,
+	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 0, 0, 0, 0,
-	131, 0, 0, 145, 98, 97, 106, 0, 0, 0,
-	89, 0, 137, 127, 157, 0, 571, 136, 110, 149,
-	132, 156, 188, 164, 147, 163, 78, 146, 155, 87,
-	139, 80, 153, 144, 116, 102, 103, 79, 0, 135,
-	92, 96, 91, 124, 150, 151, 90, 171, 83, 162,
-	82, 84, 161, 123, 148, 154, 117, 114, 81, 152,
-	115, 113, 105, 94, 99, 129, 112, 130, 100, 120,
-	119, 121, 0, 0, 0, 143, 159, 172, 0, 0,
-	165, 166, 167, 168, 0, 0, 0, 122, 85, 101,
-	140, 104, 111, 134, 170, 126, 138, 88, 158, 141,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 125, 0, 77, 0, 108,
-	169, 133, 95, 160, 93, 0, 0, 0, 0, 107,
-	0, 109, 0, 0, 142, 118, 0, 0, 0, 0,
+	0, 136, 220, 221, 0, 0, 199, 0, 264, 229,
+	195, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 0, 0, 0, 0, 231, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 813, 814, 815, 816,
+	817, 818, 819, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	50, 0, 0, 185, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 116, 0,
+	0, 0, 237, 0, 0, 0, 197, 0, 0, 213,
+	155, 154, 166, 0, 0, 0, 0, 0, 0, 0,
+	203, 191, 129, 228, 0, 192, 202, 171, 219, 198,
+	227, 156, 146, 140, 0, 0, 147, 215, 144, 0,
+	0, 0, 0, 0, 0, 0, 238, 239, 216, 236,
+	119, 214, 226, 132, 206, 245, 141, 160, 152, 0,
+	174, 0, 0, 0, 0, 0, 217, 205, 0, 0,
+	0, 266, 130, 125, 0, 196, 148, 138, 161, 0,
+	0, 0, 134, 186, 0, 0, 0, 0, 0, 0,
+	0, 121, 223, 212, 178, 162, 163, 120, 0, 201,
+	142, 151, 139, 187, 137, 246, 126, 235, 123, 127,
+	234, 185, 218, 224, 179, 176, 122, 222, 177, 175,
+	165, 145, 157, 193, 173, 194, 158, 182, 181, 183,
+	0, 0, 0, 211, 232, 247, 0, 0, 240, 241,
+	242, 243, 0, 0, 0, 184, 128, 159, 208, 164,
+	172, 200, 244, 190, 204, 133, 230, 209, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 124, 170, 225,
+	0, 0, 207, 188, 189, 117, 0, 168, 149, 233,
+	0, 143, 0, 0, 0, 0, 167, 0, 169, 0,
+	0, 210, 180, 0, 0, 391, 0, 0, 0, 135,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 0, 0, 131, 0, 0, 145, 98, 97, 106,
-	0, 0, 0, 89, 0, 137, 127, 157, 0, 128,
-	136, 110, 149, 132, 156, 188, 164, 147, 163, 78,
-	146, 155, 87, 139, 80, 153, 144, 116, 102, 103,
-	79, 0, 135, 92, 96, 91, 124, 150, 151, 90,
-	171, 83, 162, 82, 84, 161, 123, 148, 154, 117,
-	114, 81, 152, 115, 113, 105, 94, 99, 129, 112,
-	130, 100, 120, 119, 121, 0, 0, 0, 143, 159,
-	172, 0, 0, 165, 166, 167, 168, 0, 0, 0,
-	122, 85, 101, 140, 104, 111, 134, 170, 126, 138,
-	88, 158, 141, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
-	77, 0, 108, 169, 133, 95, 160, 93, 0, 0,
-	0, 0, 107, 0, 109, 0, 0, 142, 118, 0,
+	0, 83, 0, 0, 0, 0, 0, 0, 136, 220,
+	221, 0, 43, 199, 94, 114, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 0,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 185, 0, 575, 0,
-	0, 0, 0, 0, 0, 86, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 116, 0, 0, 0, 237,
+	0, 0, 0, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 0, 0, 0, 0, 203, 191, 129,
+	228, 0, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 0, 0, 147, 215, 144, 0, 0, 0, 0,
+	0, 0, 0, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 0, 174, 0, 0,
+	0, 0, 0, 217, 205, 0, 0, 0, 0, 130,
+	125, 0, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 0, 0,
+	211, 232, 247, 0, 0, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 0, 124, 170, 225, 0, 0, 207,
+	188, 189, 117, 0, 168, 149, 233, 0, 143, 0,
+	1337, 0, 0, 167, 0, 169, 0, 0, 210, 180,
+	0, 0, 391, 0, 0, 0, 135, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 0, 0, 0, 0, 131, 0, 0, 145,
-	98, 97, 106, 0, 0, 0, 89, 0, 137, 127,
-	157, 0, 128, 136, 110, 149, 132, 156, 188, 164,
-	147, 163, 78, 146, 155, 87, 139, 80, 153, 144,
-	116, 102, 103, 79, 0, 135, 92, 96, 91, 124,
-	150, 151, 90, 171, 83, 162, 82, 84, 161, 123,
-	148, 154, 117, 114, 81, 152, 115, 113, 105, 94,
-	99, 129, 112, 130, 100, 120, 119, 121, 0, 0,
-	0, 143, 159, 172, 0, 0, 165, 166, 167, 168,
-	0, 0, 0, 122, 85, 101, 140, 104, 111, 134,
-	170, 126, 138, 88, 158, 141, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 136, 220, 221, 0, 0,
+	199, 94, 114, 229, 195, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 0, 0, 0, 231,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 125, 0, 77, 0, 108, 169, 133, 95, 160,
-	93, 0, 0, 0, 0, 107, 0, 109, 0, 0,
-	142, 118, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 75,
-	0, 478, 0, 0, 0, 0, 0, 0, 86, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 116, 0, 0, 0, 237, 0, 0, 0,
+	197, 0, 0, 213, 155, 154, 166, 0, 0, 0,
+	0, 0, 0, 0, 203, 191, 129, 228, 0, 192,
+	202, 171, 219, 198, 227, 156, 146, 140, 0, 0,
+	147, 215, 144, 0, 0, 0, 0, 0, 0, 0,
+	238, 239, 216, 236, 119, 214, 226, 132, 206, 245,
+	141, 160, 152, 0, 174, 0, 0, 0, 0, 0,
+	217, 205, 0, 0, 0, 0, 130, 125, 0, 196,
+	148, 138, 161, 0, 0, 0, 134, 186, 0, 0,
+	0, 0, 0, 0, 0, 121, 223, 212, 178, 162,
+	163, 120, 0, 201, 142, 151, 139, 187, 137, 246,
+	126, 235, 123, 127, 234, 185, 218, 224, 179, 176,
+	122, 222, 177, 175, 165, 145, 157, 193, 173, 194,
+	158, 182, 181, 183, 0, 0, 0, 211, 232, 247,
+	0, 0, 240, 241, 242, 243, 0, 0, 0, 184,
+	128, 159, 208, 164, 172, 200, 244, 190, 204, 133,
+	230, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 124, 170, 225, 0, 0, 207, 188, 189, 117,
+	0, 168, 149, 233, 0, 143, 0, 1337, 0, 0,
+	167, 0, 169, 0, 0, 210, 180, 0, 0, 391,
+	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 0, 0, 131,
-	0, 0, 145, 98, 97, 106, 0, 0, 0, 89,
-	0, 137, 127, 157, 0, 128, 136, 110, 149, 132,
-	156, 188, 164, 147, 163, 78, 146, 155, 87, 139,
-	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
-	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
-	84, 161, 123, 148, 154, 117, 114, 81, 152, 115,
-	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
-	121, 0, 0, 0, 143, 159, 172, 0, 0, 165,
-	166, 167, 168, 0, 0, 0, 122, 85, 101, 140,
-	104, 111, 134, 170, 126, 138, 88, 158, 141, 0,
+	0, 0, 0, 0, 0, 0, 943, 0, 0, 0,
+	0, 0, 136, 220, 221, 945, 0, 199, 0, 264,
+	229, 195, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 0, 0, 0, 231, 788, 787, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 125, 77, 0, 108, 169,
-	133, 95, 160, 550, 93, 0, 0, 0, 0, 107,
-	0, 109, 0, 0, 142, 118, 0, 0, 0, 0,
+	0, 0, 0, 0, 789, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 185, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 116,
+	0, 0, 0, 237, 0, 0, 0, 197, 0, 0,
+	213, 155, 154, 166, 0, 0, 0, 0, 0, 0,
+	0, 203, 191, 129, 228, 0, 192, 202, 171, 219,
+	198, 227, 156, 146, 140, 0, 0, 147, 215, 144,
+	0, 0, 0, 0, 0, 0, 0, 238, 239, 216,
+	236, 119, 214, 226, 132, 206, 245, 141, 160, 152,
+	0, 174, 0, 0, 0, 0, 0, 217, 205, 0,
+	0, 0, 266, 130, 125, 0, 196, 148, 138, 161,
+	0, 0, 0, 134, 186, 0, 0, 0, 0, 0,
+	0, 0, 121, 223, 212, 178, 162, 163, 120, 0,
+	201, 142, 151, 139, 187, 137, 246, 126, 235, 123,
+	127, 234, 185, 218, 224, 179, 176, 122, 222, 177,
+	175, 165, 145, 157, 193, 173, 194, 158, 182, 181,
+	183, 0, 0, 0, 211, 232, 247, 0, 0, 240,
+	241, 242, 243, 0, 0, 0, 184, 128, 159, 208,
+	164, 172, 200, 244, 190, 204, 133, 230, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 124, 170,
+	225, 0, 0, 207, 188, 189, 117, 0, 168, 149,
+	233, 0, 143, 0, 0, 0, 0, 167, 0, 169,
+	0, 0, 210, 180, 0, 0, 391, 0, 0, 0,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 136,
+	220, 221, 0, 0, 199, 0, 264, 229, 195, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	0, 0, 0, 231, 404, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 0, 0, 131, 0, 0, 145, 98, 97, 106,
-	0, 0, 0, 89, 0, 137, 127, 157, 0, 128,
-	136, 110, 149, 132, 156, 188, 164, 147, 163, 78,
-	146, 155, 87, 139, 80, 153, 144, 116, 102, 103,
-	79, 0, 135, 92, 96, 91, 124, 150, 151, 90,
-	171, 83, 162, 82, 84, 161, 123, 148, 154, 117,
-	114, 81, 152, 115, 113, 105, 94, 99, 129, 112,
-	130, 100, 120, 119, 121, 0, 0, 0, 143, 159,
-	172, 0, 0, 165, 166, 167, 168, 0, 0, 0,
-	122, 85, 101, 140, 104, 111, 134, 170, 126, 138,
-	88, 158, 141, 0, 0, 0, 0, 0, 0, 0,
-	0, 301, 0, 0, 0, 0, 0, 0, 125, 0,
-	77, 0, 108, 169, 133, 95, 160, 93, 0, 0,
-	0, 0, 107, 0, 109, 0, 0, 142, 118, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 185, 0, 0, 0,
-	0, 0, 0, 0, 0, 86, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 116, 396, 402, 0,
+	403, 0, 0, 411, 197, 0, 0, 213, 155, 154,
+	166, 0, 0, 0, 0, 0, 0, 0, 203, 191,
+	129, 228, 0, 192, 202, 171, 219, 415, 417, 416,
+	414, 407, 0, 0, 147, 215, 144, 0, 0, 0,
+	0, 0, 0, 0, 394, 239, 216, 236, 119, 214,
+	226, 132, 206, 245, 141, 160, 152, 0, 174, 0,
+	0, 0, 0, 0, 217, 205, 0, 0, 0, 266,
+	130, 125, 0, 196, 148, 138, 161, 0, 0, 0,
+	134, 186, 0, 0, 0, 0, 0, 0, 0, 121,
+	223, 212, 178, 162, 163, 120, 0, 201, 142, 151,
+	139, 187, 137, 246, 126, 235, 123, 127, 234, 185,
+	218, 224, 179, 176, 122, 222, 177, 175, 165, 145,
+	157, 193, 173, 194, 158, 182, 181, 183, 0, 0,
+	0, 211, 232, 247, 0, 0, 240, 241, 242, 243,
+	0, 0, 0, 184, 128, 159, 208, 164, 172, 200,
+	244, 190, 204, 133, 230, 209, 0, 395, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 124, 170, 225, 0, 0,
+	207, 188, 189, 117, 0, 168, 149, 233, 0, 143,
+	0, 0, 0, 0, 167, 0, 169, 0, 0, 210,
+	180, 0, 0, 391, 0, 0, 0, 135, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 136, 220, 221, 905,
+	0, 199, 0, 908, 229, 195, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 0, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 0, 0, 0, 0, 131, 0, 0, 145,
-	98, 97, 106, 0, 0, 0, 89, 0, 137, 127,
-	157, 0, 128, 136, 110, 149, 132, 156, 188, 164,
-	147, 163, 78, 146, 155, 87, 139, 80, 153, 144,
-	116, 102, 103, 79, 0, 135, 92, 96, 91, 124,
-	150, 151, 90, 171, 83, 162, 82, 84, 161, 123,
-	148, 154, 117, 114, 81, 152, 115, 113, 105, 94,
-	99, 129, 112, 130, 100, 120, 119, 121, 0, 0,
-	0, 143, 159, 172, 0, 0, 165, 166, 167, 168,
-	0, 0, 0, 122, 85, 101, 140, 104, 111, 134,
-	170, 126, 138, 88, 158, 141, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 125, 0, 77, 0, 108, 169, 133, 95, 160,
-	93, 0, 0, 0, 0, 107, 0, 109, 0, 0,
-	142, 118, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 116, 0, 0, 0, 237, 0, 0,
+	0, 197, 0, 0, 213, 155, 154, 166, 0, 0,
+	0, 0, 0, 0, 0, 203, 191, 129, 228, 0,
+	192, 202, 171, 219, 198, 227, 156, 146, 140, 0,
+	0, 147, 215, 144, 0, 0, 0, 0, 0, 0,
+	0, 238, 239, 216, 236, 119, 214, 226, 132, 206,
+	245, 141, 160, 152, 0, 174, 0, 0, 0, 0,
+	0, 217, 205, 0, 0, 0, 266, 130, 125, 0,
+	196, 148, 138, 161, 0, 0, 0, 134, 186, 0,
+	0, 0, 0, 0, 0, 0, 121, 223, 212, 178,
+	162, 163, 120, 0, 201, 142, 151, 139, 187, 137,
+	246, 126, 235, 123, 127, 234, 185, 218, 224, 179,
+	176, 122, 222, 177, 175, 165, 145, 157, 193, 173,
+	194, 158, 182, 181, 183, 0, 0, 0, 211, 232,
+	247, 0, 0, 240, 241, 242, 243, 0, 0, 0,
+	184, 128, 159, 208, 164, 172, 200, 244, 190, 204,
+	133, 230, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 0, 124, 170, 225, 0, 0, 207, 188, 0,
+	117, 189, 168, 149, 233, 0, 526, 0, 143, 0,
+	0, 0, 0, 167, 0, 169, 0, 0, 210, 180,
+	0, 0, 391, 0, 0, 0, 135, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 0,
+	0, 0, 0, 0, 0, 136, 220, 221, 0, 0,
+	199, 94, 264, 229, 195, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 0, 0, 0, 231,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 182, 0, 187, 0, 0, 0, 0, 131,
-	0, 0, 145, 98, 97, 106, 0, 0, 0, 89,
-	0, 137, 127, 157, 0, 128, 136, 110, 149, 132,
-	156, 188, 164, 147, 163, 78, 146, 155, 87, 139,
-	80, 153, 144, 116, 102, 103, 79, 0, 135, 92,
-	96, 91, 124, 150, 151, 90, 171, 83, 162, 82,
-	84, 161, 123, 148, 154, 117, 114, 81, 152, 115,
-	113, 105, 94, 99, 129, 112, 130, 100, 120, 119,
-	121, 0, 0, 0, 143, 159, 172, 0, 0, 165,
-	166, 167, 168, 0, 0, 0, 122, 85, 101, 140,
-	104, 111, 134, 170, 126, 138, 88, 158, 141, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 125, 0, 77, 0, 108, 169,
-	133, 95, 160, 93, 0, 0, 0, 0, 107, 0,
-	109, 0, 0, 142, 118, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 75, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 116, 0, 0, 0, 237, 0, 0, 0,
+	197, 0, 0, 213, 155, 154, 166, 0, 0, 0,
+	0, 0, 0, 0, 203, 191, 129, 228, 0, 192,
+	202, 171, 219, 198, 227, 156, 146, 140, 0, 0,
+	147, 215, 144, 0, 0, 0, 0, 0, 0, 0,
+	238, 239, 216, 236, 119, 214, 226, 132, 206, 245,
+	141, 160, 152, 0, 174, 0, 0, 0, 0, 0,
+	217, 205, 0, 0, 0, 266, 130, 125, 0, 196,
+	148, 138, 161, 0, 0, 0, 134, 186, 0, 0,
+	0, 0, 0, 0, 0, 121, 223, 212, 178, 162,
+	163, 120, 0, 201, 142, 151, 139, 187, 137, 246,
+	126, 235, 123, 127, 234, 185, 218, 224, 179, 176,
+	122, 222, 177, 175, 165, 145, 157, 193, 173, 194,
+	158, 182, 181, 183, 0, 0, 0, 211, 232, 247,
+	0, 0, 240, 241, 242, 243, 0, 0, 0, 184,
+	128, 159, 208, 164, 172, 200, 244, 190, 204, 133,
+	230, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 124, 170, 225, 0, 0, 207, 188, 189, 117,
+	0, 168, 149, 233, 0, 143, 0, 0, 0, 0,
+	167, 0, 169, 0, 0, 210, 180, 0, 0, 391,
+	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 136, 220, 221, 0, 0, 199, 0, 264,
+	229, 195, 153, 0, 1715, 0, 0, 0, 1716, 0,
+	0, 131, 0, 0, 0, 0, 231, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 131, 0, 0, 145, 98, 97, 106, 0,
-	0, 0, 89, 0, 137, 127, 157, 0, 128, 136,
-	110, 149, 132, 156, 188, 164, 147, 163, 78, 146,
-	155, 87, 139, 80, 153, 144, 116, 102, 103, 79,
-	0, 135, 92, 96, 91, 124, 150, 151, 90, 171,
-	83, 162, 82, 84, 161, 123, 148, 154, 117, 114,
-	81, 152, 115, 113, 105, 94, 99, 129, 112, 130,
-	100, 120, 119, 121, 0, 0, 0, 143, 159, 172,
-	0, 0, 165, 166, 167, 168, 0, 0, 0, 122,
-	85, 101, 140, 104, 111, 134, 170, 126, 138, 88,
-	158, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 125, 0, 77,
-	0, 108, 169, 133, 95, 160, 93, 0, 0, 0,
-	0, 107, 0, 109, 0, 0, 142, 118, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 116,
+	0, 0, 0, 237, 0, 0, 0, 197, 0, 0,
+	213, 155, 154, 166, 0, 0, 0, 0, 0, 0,
+	0, 203, 191, 129, 228, 0, 192, 202, 171, 219,
+	198, 227, 156, 146, 140, 0, 0, 147, 215, 144,
+	0, 0, 0, 0, 0, 0, 0, 238, 239, 216,
+	236, 119, 214, 226, 132, 206, 245, 141, 160, 152,
+	0, 174, 0, 0, 0, 0, 0, 217, 205, 0,
+	0, 0, 266, 130, 125, 0, 196, 148, 138, 161,
+	0, 0, 0, 134, 186, 0, 0, 0, 0, 0,
+	0, 0, 121, 223, 212, 178, 162, 163, 120, 0,
+	201, 142, 151, 139, 187, 137, 246, 126, 235, 123,
+	127, 234, 185, 218, 224, 179, 176, 122, 222, 177,
+	175, 165, 145, 157, 193, 173, 194, 158, 182, 181,
+	183, 0, 0, 0, 211, 232, 247, 0, 0, 240,
+	241, 242, 243, 0, 0, 0, 184, 128, 159, 208,
+	164, 172, 200, 244, 190, 204, 133, 230, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 124, 170,
+	225, 0, 0, 207, 188, 189, 117, 0, 168, 149,
+	233, 0, 143, 0, 0, 0, 0, 167, 0, 169,
+	0, 0, 210, 180, 0, 0, 391, 0, 0, 0,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1279, 0, 0, 0, 0, 0, 136,
+	220, 221, 1257, 0, 199, 0, 114, 229, 195, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	0, 0, 0, 231, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 131, 0, 0, 145, 98,
-	97, 106, 0, 0, 0, 89, 0, 137, 127, 157,
-	0, 128, 136, 110, 149, 132, 156, 188, 164, 147,
-	163, 78, 146, 155, 87, 139, 80, 153, 144, 116,
-	102, 103, 79, 0, 135, 92, 96, 91, 124, 150,
-	151, 90, 171, 83, 162, 82, 84, 161, 123, 148,
-	154, 117, 114, 81, 152, 115, 113, 105, 94, 99,
-	129, 112, 130, 100, 120, 119, 121, 0, 0, 0,
-	143, 159, 172, 0, 0, 165, 166, 167, 168, 0,
-	0, 0, 122, 85, 101, 140, 104, 111, 134, 170,
-	126, 138, 88, 158, 141, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	125, 0, 77, 0, 108, 169, 133, 95, 160, 93,
-	0, 0, 0, 0, 107, 0, 109, 0, 0, 142,
-	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 185, 0,
-	0, 0, 0, 0, 0, 0, 0, 86, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 116, 0, 0, 0,
+	237, 0, 0, 0, 197, 0, 0, 213, 155, 154,
+	166, 0, 0, 0, 0, 0, 0, 0, 203, 191,
+	129, 228, 0, 192, 202, 171, 219, 198, 227, 156,
+	146, 140, 0, 0, 147, 215, 144, 0, 0, 0,
+	0, 0, 0, 0, 238, 239, 216, 236, 119, 214,
+	226, 132, 206, 245, 141, 160, 152, 0, 174, 0,
+	0, 1282, 0, 0, 217, 205, 0, 0, 0, 0,
+	130, 125, 0, 196, 148, 138, 161, 0, 0, 0,
+	134, 186, 0, 0, 0, 0, 0, 0, 0, 121,
+	223, 212, 178, 162, 163, 120, 0, 201, 142, 151,
+	139, 187, 137, 246, 126, 235, 123, 127, 234, 185,
+	218, 224, 179, 176, 122, 222, 177, 175, 165, 145,
+	157, 193, 173, 194, 158, 182, 181, 183, 0, 0,
+	0, 211, 232, 247, 0, 0, 240, 241, 242, 243,
+	0, 0, 0, 184, 128, 159, 208, 164, 172, 1280,
+	1281, 190, 204, 133, 230, 209, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 124, 170, 225, 0, 0,
+	207, 188, 189, 117, 0, 168, 149, 233, 0, 143,
+	0, 966, 0, 0, 167, 0, 169, 0, 0, 210,
+	180, 0, 0, 391, 0, 0, 0, 135, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 136, 220, 221, 965,
+	0, 199, 0, 264, 229, 195, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 0, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 0, 0, 131, 0,
-	0, 145, 98, 97, 106, 0, 0, 0, 89, 0,
-	137, 127, 157, 0, 128, 136, 110, 149, 132, 156,
-	188, 164, 147, 163, 78, 146, 155, 87, 139, 80,
-	153, 144, 116, 102, 103, 79, 0, 135, 92, 96,
-	91, 124, 150, 151, 90, 171, 83, 162, 82, 84,
-	161, 123, 148, 154, 117, 114, 81, 152, 115, 113,
-	105, 94, 99, 129, 112, 130, 100, 120, 119, 121,
-	0, 0, 0, 143, 159, 172, 0, 0, 165, 166,
-	167, 168, 0, 0, 0, 122, 85, 101, 140, 104,
-	111, 134, 170, 126, 138, 88, 158, 141, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 77, 0, 108, 169, 133,
-	95, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 116, 0, 0, 0, 237, 0, 0,
+	0, 197, 0, 0, 213, 155, 154, 166, 0, 0,
+	0, 0, 0, 0, 0, 203, 191, 129, 228, 0,
+	192, 202, 171, 219, 198, 227, 156, 146, 140, 0,
+	0, 147, 215, 144, 0, 0, 0, 0, 0, 0,
+	0, 238, 239, 216, 236, 119, 214, 226, 132, 206,
+	245, 141, 160, 152, 0, 174, 0, 0, 0, 0,
+	0, 217, 205, 0, 0, 0, 266, 130, 125, 0,
+	196, 148, 138, 161, 0, 0, 0, 134, 186, 0,
+	0, 0, 0, 0, 0, 0, 121, 223, 212, 178,
+	162, 163, 120, 0, 201, 142, 151, 139, 187, 137,
+	246, 126, 235, 123, 127, 234, 185, 218, 224, 179,
+	176, 122, 222, 177, 175, 165, 145, 157, 193, 173,
+	194, 158, 182, 181, 183, 0, 0, 0, 211, 232,
+	247, 0, 0, 240, 241, 242, 243, 0, 0, 0,
+	184, 128, 159, 208, 164, 172, 200, 244, 190, 204,
+	133, 230, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 0, 124, 170, 225, 0, 0, 207, 188, 189,
+	117, 0, 168, 149, 233, 0, 143, 0, 0, 0,
+	0, 167, 0, 169, 0, 0, 210, 180, 0, 0,
+	391, 0, 0, 0, 135, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 920, 0, 0, 0, 0,
+	0, 0, 0, 136, 220, 221, 0, 0, 199, 0,
+	264, 229, 195, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 0, 0, 0, 231, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	116, 0, 0, 0, 237, 0, 0, 0, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 0, 0,
+	0, 0, 203, 191, 129, 228, 0, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 0, 0, 147, 215,
+	144, 0, 0, 0, 0, 0, 0, 0, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 0, 174, 0, 0, 0, 0, 0, 217, 205,
+	0, 0, 0, 266, 130, 125, 0, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 127, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 0, 0, 211, 232, 247, 0, 0,
+	240, 241, 242, 243, 0, 0, 0, 184, 128, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 124,
+	170, 225, 0, 0, 207, 188, 189, 117, 0, 168,
+	149, 233, 0, 143, 0, 0, 0, 0, 167, 0,
+	169, 0, 0, 210, 180, 0, 0, 391, 0, 0,
+	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1255, 0, 0, 0, 0, 0,
+	136, 220, 221, 1257, 0, 199, 0, 114, 229, 195,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 0, 0, 0, 231, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 150, 116, 0, 0,
+	0, 237, 0, 0, 0, 197, 0, 0, 213, 155,
+	154, 166, 0, 0, 0, 0, 0, 0, 0, 203,
+	191, 129, 228, 0, 192, 202, 171, 219, 198, 227,
+	156, 146, 140, 0, 0, 147, 215, 144, 0, 0,
+	0, 0, 0, 0, 0, 238, 239, 216, 236, 119,
+	214, 226, 132, 206, 245, 141, 160, 152, 0, 174,
+	0, 0, 0, 0, 0, 217, 205, 0, 0, 0,
+	0, 130, 125, 0, 196, 148, 138, 161, 0, 0,
+	0, 134, 186, 0, 0, 0, 0, 0, 0, 0,
+	121, 223, 212, 178, 162, 163, 120, 0, 201, 142,
+	151, 139, 187, 137, 246, 126, 235, 123, 127, 234,
+	185, 218, 224, 179, 176, 122, 222, 177, 175, 165,
+	145, 157, 193, 173, 194, 158, 182, 181, 183, 0,
+	0, 0, 211, 232, 247, 0, 0, 240, 241, 242,
+	243, 0, 0, 0, 184, 128, 159, 208, 164, 172,
+	200, 244, 190, 204, 133, 230, 209, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 0, 124, 170, 225, 0,
+	0, 207, 188, 189, 117, 0, 168, 149, 233, 0,
+	143, 0, 0, 0, 0, 167, 0, 169, 0, 0,
+	210, 180, 0, 0, 391, 0, 0, 0, 135, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 136, 220, 221,
+	0, 0, 199, 94, 264, 229, 195, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 0, 0,
+	0, 231, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 116, 0, 0, 0, 237, 0,
+	0, 0, 197, 0, 0, 213, 155, 154, 166, 0,
+	0, 0, 0, 0, 0, 0, 203, 191, 129, 228,
+	0, 192, 202, 171, 219, 198, 227, 156, 146, 140,
+	0, 0, 147, 215, 144, 0, 0, 0, 0, 0,
+	0, 0, 238, 239, 216, 236, 119, 214, 226, 132,
+	206, 245, 141, 160, 152, 0, 174, 0, 0, 0,
+	0, 0, 217, 205, 0, 0, 0, 266, 130, 125,
+	0, 196, 148, 138, 161, 0, 0, 0, 134, 186,
+	0, 0, 0, 0, 0, 0, 0, 121, 223, 212,
+	178, 162, 163, 120, 0, 201, 142, 151, 139, 187,
+	137, 246, 126, 235, 123, 127, 234, 185, 218, 224,
+	179, 176, 122, 222, 177, 175, 165, 145, 157, 193,
+	173, 194, 158, 182, 181, 183, 0, 0, 0, 211,
+	232, 247, 0, 0, 240, 241, 242, 243, 0, 0,
+	0, 184, 128, 159, 208, 164, 172, 200, 244, 190,
+	204, 133, 230, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 124, 170, 225, 0, 0, 207, 188,
+	189, 117, 1647, 168, 149, 233, 0, 143, 0, 0,
+	0, 0, 167, 0, 169, 0, 0, 210, 180, 0,
+	0, 391, 0, 0, 0, 135, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 136, 220, 221, 0, 0, 199,
+	0, 264, 229, 195, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 0, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 116, 0, 0, 0, 237, 0, 0, 0, 197,
+	0, 0, 213, 155, 154, 166, 0, 0, 0, 0,
+	0, 0, 0, 203, 191, 129, 228, 0, 192, 202,
+	171, 219, 198, 227, 156, 146, 140, 0, 0, 147,
+	215, 144, 0, 0, 0, 0, 0, 0, 0, 238,
+	239, 216, 236, 119, 214, 226, 132, 206, 245, 141,
+	160, 152, 0, 174, 0, 0, 0, 0, 0, 217,
+	205, 0, 0, 0, 266, 130, 125, 0, 196, 148,
+	138, 161, 0, 0, 0, 134, 186, 0, 0, 0,
+	0, 0, 0, 0, 121, 223, 212, 178, 162, 163,
+	120, 0, 201, 142, 151, 139, 187, 137, 246, 126,
+	235, 123, 127, 234, 185, 218, 224, 179, 176, 122,
+	222, 177, 175, 165, 145, 157, 193, 173, 194, 158,
+	182, 181, 183, 0, 0, 0, 211, 232, 247, 0,
+	0, 240, 241, 242, 243, 0, 0, 0, 184, 128,
+	159, 208, 164, 172, 200, 244, 190, 204, 133, 230,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	124, 170, 225, 0, 0, 207, 188, 189, 117, 0,
+	168, 149, 233, 0, 143, 0, 0, 0, 0, 167,
+	0, 169, 0, 0, 210, 180, 0, 0, 391, 0,
+	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1255, 0, 0, 0, 0,
+	0, 136, 220, 221, 1257, 0, 199, 0, 114, 229,
+	195, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 0, 0, 0, 0, 231, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 116, 0,
+	0, 0, 237, 0, 0, 0, 197, 0, 0, 213,
+	155, 154, 166, 0, 0, 0, 0, 0, 0, 0,
+	203, 191, 129, 228, 0, 1570, 202, 171, 219, 198,
+	227, 156, 146, 140, 0, 0, 147, 215, 144, 0,
+	0, 0, 0, 0, 0, 0, 238, 239, 216, 236,
+	119, 214, 226, 132, 206, 245, 141, 160, 152, 0,
+	174, 0, 0, 0, 0, 0, 217, 205, 0, 0,
+	0, 0, 130, 125, 0, 196, 148, 138, 161, 0,
+	0, 0, 134, 186, 0, 0, 0, 0, 0, 0,
+	0, 121, 223, 212, 178, 162, 163, 120, 0, 201,
+	142, 151, 139, 187, 137, 246, 126, 235, 123, 127,
+	234, 185, 218, 224, 179, 176, 122, 222, 177, 175,
+	165, 145, 157, 193, 173, 194, 158, 182, 181, 183,
+	0, 0, 0, 211, 232, 247, 0, 0, 240, 241,
+	242, 243, 0, 0, 0, 184, 128, 159, 208, 164,
+	172, 200, 244, 190, 204, 133, 230, 209, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 124, 170, 225,
+	0, 0, 207, 188, 189, 117, 0, 168, 149, 233,
+	0, 143, 0, 0, 0, 0, 167, 0, 169, 0,
+	0, 210, 180, 0, 0, 391, 0, 0, 0, 135,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 136, 220,
+	221, 945, 0, 199, 0, 264, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 0,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 116, 0, 0, 0, 237,
+	0, 0, 0, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 0, 0, 0, 0, 203, 191, 129,
+	228, 0, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 0, 0, 147, 215, 144, 0, 0, 0, 0,
+	0, 0, 0, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 0, 174, 0, 0,
+	0, 0, 0, 217, 205, 0, 0, 0, 266, 130,
+	125, 0, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 0, 0,
+	211, 232, 247, 0, 0, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 0, 124, 170, 225, 0, 0, 207,
+	188, 189, 117, 0, 168, 149, 233, 0, 143, 0,
+	0, 0, 0, 167, 0, 169, 1260, 0, 210, 180,
+	0, 0, 391, 0, 0, 0, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 136, 220, 221, 0, 0,
+	199, 0, 264, 229, 195, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 0, 0, 0, 231,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 116, 0, 0, 0, 237, 0, 0, 0,
+	197, 0, 0, 213, 155, 154, 166, 0, 0, 0,
+	0, 0, 0, 0, 203, 191, 129, 228, 0, 192,
+	202, 171, 219, 198, 227, 156, 146, 140, 0, 0,
+	147, 215, 144, 0, 0, 0, 0, 0, 0, 0,
+	238, 239, 216, 236, 119, 214, 226, 132, 206, 245,
+	141, 160, 152, 0, 174, 0, 0, 0, 0, 0,
+	217, 205, 0, 0, 0, 266, 130, 125, 0, 196,
+	148, 138, 161, 0, 0, 0, 134, 186, 0, 0,
+	0, 0, 0, 0, 0, 121, 223, 212, 178, 162,
+	163, 120, 0, 201, 142, 151, 139, 187, 137, 246,
+	126, 235, 123, 127, 234, 185, 218, 224, 179, 176,
+	122, 222, 177, 175, 165, 145, 157, 193, 173, 194,
+	158, 182, 181, 183, 0, 0, 0, 211, 232, 247,
+	0, 0, 240, 241, 242, 243, 0, 0, 0, 184,
+	128, 159, 208, 164, 172, 200, 244, 190, 204, 133,
+	230, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 124, 170, 225, 0, 0, 207, 188, 189, 117,
+	0, 168, 149, 233, 0, 143, 0, 0, 0, 0,
+	167, 0, 169, 0, 0, 210, 180, 0, 0, 391,
+	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 136, 220, 221, 914, 0, 199, 0, 264,
+	229, 195, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 0, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 116,
+	0, 0, 0, 237, 0, 0, 0, 197, 0, 0,
+	213, 155, 154, 166, 0, 0, 0, 0, 0, 0,
+	0, 203, 191, 129, 228, 0, 192, 202, 171, 219,
+	198, 227, 156, 146, 140, 0, 0, 147, 215, 144,
+	0, 0, 0, 0, 0, 0, 0, 238, 239, 216,
+	236, 119, 214, 226, 132, 206, 245, 141, 160, 152,
+	0, 174, 0, 0, 0, 0, 0, 217, 205, 0,
+	0, 0, 266, 130, 125, 0, 196, 148, 138, 161,
+	0, 0, 0, 134, 186, 0, 0, 0, 0, 0,
+	0, 0, 121, 223, 212, 178, 162, 163, 120, 0,
+	201, 142, 151, 139, 187, 137, 246, 126, 235, 123,
+	127, 234, 185, 218, 224, 179, 176, 122, 222, 177,
+	175, 165, 145, 157, 193, 173, 194, 158, 182, 181,
+	183, 0, 0, 0, 211, 232, 247, 0, 0, 240,
+	241, 242, 243, 0, 0, 0, 184, 128, 159, 208,
+	164, 172, 200, 244, 190, 204, 133, 230, 209, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 124, 170,
+	225, 0, 0, 207, 188, 189, 117, 0, 168, 149,
+	233, 0, 143, 0, 0, 0, 0, 167, 0, 169,
+	0, 0, 210, 180, 0, 0, 391, 0, 0, 0,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 136,
+	220, 221, 0, 0, 199, 0, 264, 229, 195, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	0, 0, 0, 231, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 116, 0, 0, 0,
+	237, 0, 0, 0, 197, 0, 0, 213, 155, 154,
+	166, 0, 0, 0, 0, 0, 0, 0, 203, 191,
+	129, 228, 0, 192, 202, 171, 219, 198, 227, 156,
+	146, 140, 0, 0, 147, 215, 144, 0, 0, 0,
+	0, 0, 0, 0, 238, 239, 216, 236, 119, 214,
+	226, 132, 206, 245, 141, 160, 152, 0, 174, 0,
+	0, 0, 0, 0, 217, 205, 0, 0, 0, 266,
+	130, 125, 0, 196, 148, 138, 161, 0, 0, 0,
+	134, 186, 0, 0, 0, 0, 0, 0, 0, 121,
+	223, 212, 178, 162, 163, 120, 0, 201, 142, 151,
+	139, 187, 137, 246, 126, 235, 123, 127, 234, 185,
+	218, 224, 179, 176, 122, 222, 177, 175, 165, 145,
+	157, 193, 173, 194, 158, 182, 181, 183, 0, 0,
+	0, 211, 232, 247, 0, 0, 240, 241, 242, 243,
+	0, 0, 0, 184, 128, 159, 208, 164, 172, 200,
+	244, 190, 204, 133, 230, 209, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 124, 170, 225, 0, 0,
+	207, 188, 189, 117, 0, 168, 149, 233, 0, 143,
+	0, 0, 0, 0, 167, 0, 169, 0, 0, 210,
+	180, 0, 0, 391, 0, 0, 0, 135, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 136, 220, 221, 0,
+	0, 199, 0, 555, 229, 195, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 0, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 116, 0, 0, 0, 237, 0, 0,
+	0, 197, 0, 0, 213, 155, 154, 166, 0, 0,
+	0, 0, 0, 0, 0, 203, 191, 129, 228, 0,
+	192, 202, 171, 219, 198, 227, 156, 146, 140, 0,
+	0, 147, 215, 144, 0, 0, 0, 0, 0, 0,
+	0, 238, 239, 216, 236, 119, 214, 226, 132, 206,
+	245, 141, 160, 152, 0, 174, 0, 0, 0, 0,
+	0, 217, 205, 0, 0, 0, 266, 130, 125, 0,
+	196, 148, 138, 161, 0, 0, 0, 134, 186, 0,
+	0, 0, 0, 0, 0, 0, 121, 223, 212, 178,
+	162, 163, 120, 0, 201, 142, 151, 139, 187, 137,
+	246, 126, 235, 123, 127, 234, 185, 218, 224, 179,
+	176, 122, 222, 177, 175, 165, 145, 157, 193, 173,
+	194, 158, 182, 181, 183, 0, 0, 0, 211, 232,
+	247, 0, 0, 240, 241, 242, 243, 0, 0, 0,
+	184, 128, 159, 208, 164, 172, 200, 244, 190, 204,
+	133, 230, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 0, 124, 170, 225, 0, 0, 207, 188, 189,
+	117, 0, 168, 149, 233, 0, 143, 0, 0, 0,
+	0, 167, 0, 169, 0, 0, 210, 180, 0, 0,
+	391, 0, 0, 0, 135, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 136, 220, 221, 0, 0, 199, 0,
+	264, 229, 195, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 0, 0, 0, 231, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	116, 0, 0, 0, 237, 0, 0, 0, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 0, 0,
+	0, 0, 203, 191, 129, 228, 0, 1926, 202, 171,
+	219, 198, 227, 156, 146, 140, 0, 0, 147, 215,
+	144, 0, 0, 0, 0, 0, 0, 0, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 0, 174, 0, 0, 0, 0, 0, 217, 205,
+	0, 0, 0, 266, 130, 125, 0, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 127, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 0, 0, 211, 232, 247, 0, 0,
+	240, 241, 242, 243, 0, 0, 0, 184, 128, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 124,
+	170, 225, 0, 0, 207, 188, 1571, 117, 0, 168,
+	149, 233, 0, 189, 0, 0, 0, 0, 0, 0,
+	143, 0, 0, 0, 0, 167, 0, 169, 0, 0,
+	210, 180, 0, 0, 391, 0, 0, 0, 135, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 136, 220, 221,
+	0, 0, 199, 0, 114, 229, 195, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 0, 0,
+	0, 231, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 116, 0, 0, 0, 237, 0,
+	0, 0, 197, 0, 0, 213, 155, 154, 166, 0,
+	0, 0, 0, 0, 0, 0, 203, 191, 129, 228,
+	0, 192, 202, 171, 219, 198, 227, 156, 146, 140,
+	0, 0, 147, 215, 144, 0, 0, 0, 0, 0,
+	0, 0, 238, 239, 216, 236, 119, 214, 226, 132,
+	206, 245, 141, 160, 152, 0, 174, 0, 0, 0,
+	0, 0, 217, 205, 0, 0, 0, 0, 130, 125,
+	0, 196, 148, 138, 161, 0, 0, 0, 134, 186,
+	0, 0, 0, 0, 0, 0, 0, 121, 223, 212,
+	178, 162, 163, 120, 0, 201, 142, 151, 139, 187,
+	137, 246, 126, 235, 123, 127, 234, 185, 218, 224,
+	179, 176, 122, 222, 177, 175, 165, 145, 157, 193,
+	173, 194, 158, 182, 181, 183, 0, 0, 0, 211,
+	232, 247, 0, 0, 240, 241, 242, 243, 0, 0,
+	0, 184, 128, 159, 208, 164, 172, 200, 244, 190,
+	204, 133, 230, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 124, 170, 225, 0, 0, 207, 188,
+	189, 117, 0, 168, 149, 233, 0, 143, 0, 0,
+	0, 0, 167, 0, 169, 0, 0, 210, 180, 0,
+	0, 391, 0, 0, 0, 135, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 136, 220, 221, 1257, 0, 199,
+	0, 114, 229, 195, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 0, 0, 0, 231, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 116, 0, 0, 0, 237, 0, 0, 0, 197,
+	0, 0, 213, 155, 154, 166, 0, 0, 0, 0,
+	0, 0, 0, 203, 191, 129, 228, 0, 192, 202,
+	171, 219, 198, 227, 156, 146, 140, 0, 0, 147,
+	215, 144, 0, 0, 0, 0, 0, 0, 0, 238,
+	239, 216, 236, 119, 214, 226, 132, 206, 245, 141,
+	160, 152, 0, 174, 0, 0, 0, 0, 0, 217,
+	205, 0, 0, 0, 0, 130, 125, 0, 196, 148,
+	138, 161, 0, 0, 0, 134, 186, 0, 0, 0,
+	0, 0, 0, 0, 121, 223, 212, 178, 162, 163,
+	120, 0, 201, 142, 151, 139, 187, 137, 246, 126,
+	235, 123, 127, 234, 185, 218, 224, 179, 176, 122,
+	222, 177, 175, 165, 145, 157, 193, 173, 194, 158,
+	182, 181, 183, 0, 0, 0, 211, 232, 247, 0,
+	0, 240, 241, 242, 243, 0, 0, 0, 184, 128,
+	159, 208, 164, 172, 200, 244, 190, 204, 133, 230,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	124, 170, 225, 0, 0, 207, 188, 189, 117, 0,
+	168, 149, 233, 0, 143, 0, 0, 0, 0, 167,
+	0, 169, 0, 0, 210, 180, 0, 0, 391, 0,
+	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1328, 136, 220, 221, 0, 0, 199, 0, 114, 229,
+	195, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 0, 0, 0, 0, 231, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 116, 0,
+	0, 0, 237, 0, 0, 0, 197, 0, 0, 213,
+	155, 154, 166, 0, 0, 0, 0, 0, 0, 0,
+	203, 191, 129, 228, 0, 192, 202, 171, 219, 198,
+	227, 156, 146, 140, 0, 0, 147, 215, 144, 0,
+	0, 0, 0, 0, 0, 0, 238, 239, 216, 236,
+	119, 214, 226, 132, 206, 245, 141, 160, 152, 0,
+	174, 0, 0, 0, 0, 0, 217, 205, 0, 0,
+	0, 0, 130, 125, 0, 196, 148, 138, 161, 0,
+	0, 0, 134, 186, 0, 0, 0, 0, 0, 0,
+	0, 121, 223, 212, 178, 162, 163, 120, 0, 201,
+	142, 151, 139, 187, 137, 246, 126, 235, 123, 127,
+	234, 185, 218, 224, 179, 176, 122, 222, 177, 175,
+	165, 145, 157, 193, 173, 194, 158, 182, 181, 183,
+	0, 0, 0, 211, 232, 247, 0, 0, 240, 241,
+	242, 243, 0, 0, 0, 184, 128, 159, 208, 164,
+	172, 200, 244, 190, 204, 133, 230, 209, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 124, 170, 225,
+	0, 0, 207, 188, 189, 117, 0, 168, 149, 233,
+	0, 143, 0, 0, 0, 0, 167, 0, 169, 0,
+	0, 210, 180, 0, 0, 391, 0, 0, 0, 135,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 136, 220,
+	221, 0, 1263, 199, 0, 114, 229, 195, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 0,
+	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 116, 0, 0, 0, 237,
+	0, 0, 0, 197, 0, 0, 213, 155, 154, 166,
+	0, 0, 0, 0, 0, 0, 0, 203, 191, 129,
+	228, 0, 192, 202, 171, 219, 198, 227, 156, 146,
+	140, 0, 0, 147, 215, 144, 0, 0, 0, 0,
+	0, 0, 0, 238, 239, 216, 236, 119, 214, 226,
+	132, 206, 245, 141, 160, 152, 0, 174, 0, 0,
+	0, 0, 0, 217, 205, 0, 0, 0, 0, 130,
+	125, 0, 196, 148, 138, 161, 0, 0, 0, 134,
+	186, 0, 0, 0, 0, 0, 0, 0, 121, 223,
+	212, 178, 162, 163, 120, 0, 201, 142, 151, 139,
+	187, 137, 246, 126, 235, 123, 127, 234, 185, 218,
+	224, 179, 176, 122, 222, 177, 175, 165, 145, 157,
+	193, 173, 194, 158, 182, 181, 183, 0, 0, 0,
+	211, 232, 247, 0, 0, 240, 241, 242, 243, 0,
+	0, 0, 184, 128, 159, 208, 164, 172, 200, 244,
+	190, 204, 133, 230, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 0, 124, 170, 225, 0, 0, 207,
+	188, 189, 117, 0, 168, 149, 233, 0, 143, 0,
+	0, 0, 0, 167, 0, 169, 0, 0, 210, 180,
+	0, 0, 391, 0, 0, 0, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 136, 220, 221, 0, 0,
+	199, 0, 583, 229, 195, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 0, 0, 0, 231,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 584, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 116, 0, 0, 0, 237, 0, 0, 0,
+	197, 0, 0, 213, 155, 154, 166, 0, 0, 0,
+	0, 0, 0, 0, 203, 191, 129, 228, 0, 192,
+	202, 171, 219, 198, 227, 156, 146, 140, 0, 0,
+	147, 215, 144, 0, 0, 0, 0, 0, 0, 0,
+	238, 239, 216, 236, 119, 214, 226, 132, 206, 245,
+	141, 160, 152, 0, 174, 0, 0, 0, 0, 0,
+	217, 205, 0, 0, 0, 0, 130, 125, 0, 196,
+	148, 138, 161, 0, 0, 0, 134, 186, 0, 0,
+	0, 0, 0, 0, 0, 121, 223, 212, 178, 162,
+	163, 120, 0, 201, 142, 151, 139, 187, 137, 246,
+	126, 235, 123, 127, 234, 185, 218, 224, 179, 176,
+	122, 222, 177, 175, 165, 145, 157, 193, 173, 194,
+	158, 182, 181, 183, 0, 0, 0, 211, 232, 247,
+	0, 0, 240, 241, 242, 243, 0, 0, 0, 184,
+	128, 159, 208, 164, 172, 200, 244, 190, 204, 133,
+	230, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 124, 170, 225, 0, 0, 207, 188, 189, 117,
+	0, 168, 149, 233, 0, 143, 0, 0, 0, 0,
+	167, 0, 169, 0, 0, 210, 180, 0, 0, 391,
+	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 136, 220, 221, 0, 0, 199, 0, 264,
+	229, 195, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 0, 0, 0, 231, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 116,
+	0, 0, 0, 237, 0, 0, 0, 197, 0, 0,
+	213, 155, 154, 166, 0, 0, 0, 0, 0, 0,
+	0, 203, 191, 129, 228, 0, 192, 202, 171, 219,
+	198, 227, 156, 146, 140, 0, 0, 147, 215, 144,
+	0, 0, 0, 0, 0, 0, 0, 238, 239, 216,
+	236, 119, 214, 226, 132, 206, 245, 141, 160, 152,
+	0, 174, 0, 0, 0, 0, 0, 217, 205, 0,
+	0, 0, 266, 130, 125, 0, 196, 148, 138, 580,
+	0, 0, 0, 134, 186, 0, 0, 0, 0, 0,
+	0, 0, 121, 223, 212, 178, 162, 163, 120, 0,
+	201, 142, 151, 139, 187, 137, 246, 126, 235, 123,
+	127, 234, 185, 218, 224, 179, 176, 122, 222, 177,
+	175, 165, 145, 157, 193, 173, 194, 158, 182, 181,
+	183, 0, 0, 0, 211, 232, 247, 0, 0, 240,
+	241, 242, 243, 0, 0, 0, 184, 128, 159, 208,
+	164, 172, 200, 244, 190, 204, 133, 230, 209, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 124, 170,
+	225, 0, 0, 207, 188, 189, 117, 0, 168, 149,
+	233, 0, 143, 0, 0, 0, 0, 167, 0, 169,
+	0, 0, 210, 180, 0, 0, 391, 0, 0, 0,
+	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 136,
+	220, 221, 0, 0, 199, 0, 114, 229, 195, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	0, 0, 0, 231, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 116, 0, 449, 0,
+	237, 0, 0, 0, 197, 0, 0, 213, 155, 154,
+	166, 0, 0, 0, 0, 0, 0, 0, 203, 191,
+	129, 228, 0, 192, 202, 171, 219, 198, 227, 156,
+	146, 140, 0, 0, 147, 215, 144, 0, 0, 0,
+	0, 0, 0, 0, 238, 239, 216, 236, 119, 214,
+	226, 132, 206, 245, 141, 160, 152, 0, 174, 0,
+	0, 0, 0, 0, 217, 205, 0, 0, 0, 0,
+	130, 125, 0, 196, 148, 138, 161, 0, 0, 0,
+	134, 186, 0, 0, 0, 0, 0, 0, 0, 121,
+	223, 212, 178, 162, 163, 120, 0, 201, 142, 151,
+	139, 187, 137, 246, 126, 235, 123, 127, 234, 185,
+	218, 224, 179, 176, 122, 222, 177, 175, 165, 145,
+	157, 193, 173, 194, 158, 182, 181, 183, 0, 0,
+	0, 211, 232, 247, 0, 0, 240, 241, 242, 243,
+	0, 0, 0, 184, 128, 159, 208, 164, 172, 200,
+	244, 190, 204, 133, 230, 209, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 124, 170, 225, 0, 0,
+	207, 188, 189, 117, 0, 168, 149, 233, 0, 143,
+	0, 0, 0, 0, 167, 0, 169, 0, 0, 210,
+	180, 0, 0, 391, 0, 0, 0, 135, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 136, 220, 221, 0,
+	0, 199, 0, 114, 229, 195, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 0, 0, 0,
+	231, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 116, 0, 0, 0, 237, 0, 0,
+	0, 197, 0, 0, 213, 155, 154, 166, 0, 0,
+	0, 0, 0, 0, 0, 203, 191, 129, 228, 0,
+	192, 202, 171, 219, 198, 227, 156, 146, 140, 0,
+	0, 147, 215, 144, 0, 0, 0, 0, 0, 0,
+	0, 238, 239, 216, 236, 119, 214, 226, 132, 206,
+	245, 141, 160, 152, 0, 174, 0, 0, 0, 0,
+	0, 217, 205, 0, 0, 0, 0, 130, 125, 0,
+	196, 148, 138, 161, 0, 0, 0, 134, 186, 0,
+	0, 0, 0, 0, 0, 0, 121, 223, 212, 178,
+	162, 163, 120, 0, 201, 142, 151, 139, 187, 137,
+	246, 126, 235, 123, 127, 234, 185, 218, 224, 179,
+	176, 122, 222, 177, 175, 165, 145, 157, 193, 173,
+	194, 158, 182, 181, 183, 0, 0, 0, 211, 232,
+	247, 0, 0, 240, 241, 242, 243, 0, 0, 0,
+	184, 128, 159, 208, 164, 172, 200, 244, 190, 204,
+	133, 230, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 0, 124, 170, 225, 0, 0, 207, 188, 189,
+	117, 0, 168, 149, 233, 0, 143, 0, 0, 0,
+	0, 167, 0, 169, 0, 0, 210, 180, 0, 0,
+	111, 0, 0, 0, 135, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 136, 220, 221, 0, 0, 199, 0,
+	114, 229, 195, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 0, 0, 0, 231, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	116, 0, 0, 0, 237, 0, 0, 0, 197, 0,
+	0, 213, 155, 154, 166, 0, 0, 0, 0, 0,
+	0, 0, 203, 191, 129, 228, 0, 192, 202, 171,
+	219, 198, 227, 156, 146, 140, 0, 0, 147, 215,
+	144, 0, 0, 0, 0, 0, 0, 0, 238, 239,
+	216, 236, 119, 214, 226, 132, 206, 245, 141, 160,
+	152, 0, 174, 0, 0, 0, 0, 0, 217, 205,
+	0, 0, 0, 0, 130, 125, 0, 196, 148, 138,
+	161, 0, 0, 0, 134, 186, 0, 0, 0, 0,
+	0, 0, 0, 121, 223, 212, 178, 162, 163, 120,
+	0, 201, 142, 151, 139, 187, 137, 246, 126, 235,
+	123, 127, 234, 185, 218, 224, 179, 176, 122, 222,
+	177, 175, 165, 145, 157, 193, 173, 194, 158, 182,
+	181, 183, 0, 0, 0, 211, 232, 247, 0, 0,
+	240, 241, 242, 243, 0, 0, 0, 184, 128, 159,
+	208, 164, 172, 200, 244, 190, 204, 133, 230, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 124,
+	170, 225, 0, 0, 207, 188, 0, 117, 0, 168,
+	149, 233,
 }
-var yyPact = [...]int{
 
-	1811, -1000, -179, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 785, 805, -1000, -1000, -1000, -1000, -1000, -1000, 641,
-	7288, 43, 68, -2, 9983, 64, 297, 10652, -1000, -7,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 589, -1000, -1000,
-	-1000, -1000, -1000, 777, 783, 643, 765, 690, -1000, 5465,
-	40, 8867, 9760, 4769, -1000, 493, 57, 10652, -149, 10206,
-	35, 35, 35, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 62, 10652, -1000, 10652, 33, 483, 33,
-	33, 33, 10652, -1000, 117, -1000, -1000, -1000, -1000, 10652,
-	472, 719, 39, 2809, 2809, 2809, 2809, -1, 2809, -115,
-	656, -1000, -1000, -1000, -1000, 2809, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 356, 729, 6164, 6164,
-	785, -1000, 589, -1000, -1000, -1000, 721, -1000, -1000, 257,
-	795, -1000, 7065, 113, -1000, 6164, 1614, 516, -1000, -1000,
-	516, -1000, -1000, 78, -1000, -1000, 6610, 6610, 6610, 6610,
-	6610, 6610, 6610, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 516, -1000, 5932,
-	516, 516, 516, 516, 516, 516, 516, 516, 6164, 516,
-	516, 516, 516, 516, 516, 516, 516, 516, 516, 516,
-	516, 516, 9537, 530, 710, -1000, -1000, -1000, 762, 7966,
-	8644, 10652, 513, -1000, 587, 4524, -82, -1000, -1000, -1000,
-	172, 8412, -1000, -1000, -1000, 715, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 488,
-	-1000, 1867, 439, 2809, 51, 621, 434, 190, 424, 10652,
-	10652, 2809, 46, 10652, 756, 655, 10652, 416, 414, -1000,
-	4279, -1000, 2809, 2809, 2809, 2809, 2809, 2809, 2809, 2809,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2809, 2809, -1000, -81,
-	-1000, 10652, -1000, -1000, -1000, -1000, 800, 138, 285, 92,
-	591, -1000, 323, 777, 356, 690, 8189, 660, -1000, -1000,
-	10652, -1000, 6164, 6164, 360, -1000, 9313, -1000, -1000, 3299,
-	153, 6610, 296, 286, 6610, 6610, 6610, 6610, 6610, 6610,
-	6610, 6610, 6610, 6610, 6610, 6610, 6610, 6610, 6610, 287,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 410, -1000,
-	589, 426, 426, 122, 122, 122, 122, 122, 122, 6833,
-	5001, 356, 482, 325, 5932, 5465, 5465, 6164, 6164, 10429,
-	10429, 5465, 767, 185, 325, 10429, -1000, 356, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 5465, 5465, 5465, 5465, 13,
-	10652, -1000, 10429, 8867, 8867, 8867, 8867, 8867, 8867, -1000,
-	687, 675, -1000, 669, 667, 676, 10652, -1000, 478, 7966,
-	120, 516, -1000, 9090, -1000, -1000, 13, 512, 8867, 10652,
-	-1000, -1000, 4034, 587, -82, 564, -1000, -126, -92, 5697,
-	104, -1000, -1000, -1000, -1000, 2564, 128, 255, -70, -1000,
-	-1000, -1000, 610, -1000, 610, 610, 610, 610, -34, -34,
-	-34, -34, -1000, -1000, -1000, -1000, -1000, 640, 626, -1000,
-	610, 610, 610, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 623,
-	623, 623, 611, 611, 637, -1000, 10652, -165, 393, 2809,
-	755, 2809, -1000, 65, -1000, 10652, -1000, -1000, 10652, 2809,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 223, -1000, -1000, -1000, -1000,
-	701, 6164, 6164, 3789, 6164, -1000, -1000, -1000, 729, -1000,
-	767, 782, -1000, 711, 707, 5465, -1000, -1000, 153, 168,
-	-1000, -1000, 396, -1000, -1000, -1000, -1000, 91, 516, -1000,
-	1837, -1000, -1000, -1000, -1000, 296, 6610, 6610, 6610, 829,
-	1837, 2069, 357, 119, 122, 233, 233, 131, 131, 131,
-	131, 131, 210, 210, -1000, -1000, -1000, 356, -1000, -1000,
-	-1000, 356, 5465, 586, -1000, -1000, 6164, -1000, 356, 476,
-	476, 337, 355, 651, -1000, 84, 636, 476, 5465, 220,
-	-1000, 6164, 356, -1000, 476, 356, 476, 476, 515, 516,
-	-1000, 634, -1000, 171, 710, 629, 629, 652, 475, -1000,
-	-1000, -1000, -1000, 671, -1000, 670, -1000, -1000, -1000, -1000,
-	-1000, 56, 54, 53, 10206, -1000, 793, 8867, 605, -1000,
-	-1000, 564, -82, -132, -1000, -1000, -1000, 325, -1000, 385,
-	538, 2319, -1000, -1000, -1000, -1000, -1000, -1000, 622, 743,
-	143, 147, 367, -1000, -1000, 730, -1000, 202, -89, -1000,
-	-1000, 308, -34, -34, -1000, -1000, 104, 713, 104, 104,
-	104, 343, 343, -1000, -1000, -1000, -1000, 306, -1000, -1000,
-	-1000, 299, -1000, 647, 10206, 2809, -1000, 3544, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1277, 950, 182, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 12, -1000,
-	2809, -1000, 235, 10652, 10652, 698, 325, 325, 83, -1000,
-	-1000, 10652, -1000, -1000, -1000, -1000, 628, -1000, -1000, -1000,
-	3054, 5465, -1000, 829, 1837, 2034, -1000, 6610, 6610, -1000,
-	-1000, 476, 5465, 325, -1000, -1000, -1000, 218, 287, 218,
-	6610, 6610, 3789, 6610, 6610, -159, 620, 179, -1000, 6164,
-	249, -1000, -1000, -1000, -1000, -1000, 646, 10429, 516, -1000,
-	7743, 10206, 785, 10429, 6164, 6164, -1000, -1000, 6164, 619,
-	-1000, -1000, 6164, -1000, -1000, -1000, 516, 516, 516, 427,
-	-1000, 785, 605, -1000, -1000, -1000, -130, -101, -1000, -1000,
-	2564, -1000, 2564, 10206, -1000, 365, 362, -1000, -1000, 644,
-	67, -1000, -1000, -1000, 497, 104, 104, -1000, 189, -1000,
-	-1000, -1000, 460, -1000, 454, 532, 445, 10652, -1000, -1000,
-	529, -1000, 170, -1000, -1000, 10206, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10206, 10652,
-	-1000, -1000, -1000, -1000, -1000, 10206, -1000, -1000, 340, 6164,
-	-1000, -1000, -1000, 3544, -1000, 793, 8867, -1000, -1000, 356,
-	-1000, 6610, 1837, 1837, -1000, -1000, 356, 610, 610, -1000,
-	610, 611, -1000, 610, -16, 610, -17, 356, 356, 1776,
-	1976, -1000, 1510, 1892, 516, -156, -1000, 325, 6164, -1000,
-	745, 499, 519, -1000, -1000, 5233, 356, 443, 82, 427,
-	777, -1000, 325, 325, 325, 10206, 325, 10206, 10206, 10206,
-	7520, 10206, 777, -1000, -1000, -1000, -1000, 2319, -1000, 423,
-	-1000, 610, -1000, -1000, -79, 799, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -34, 329, -34,
-	269, -1000, 266, 2809, 3544, 2564, -1000, 590, -1000, -1000,
-	-1000, -1000, 750, -1000, 325, 791, 524, -1000, 1837, -1000,
-	-1000, 88, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 6610, 6610, -1000, 6610, 6610, 6610, 356, 312, 325,
-	739, -1000, 516, -1000, -1000, 618, 10206, 10206, -1000, -1000,
-	421, -1000, 390, 390, 390, 120, -1000, -1000, 100, 10206,
-	-1000, 134, -1000, -138, 104, -1000, 104, 489, 358, -1000,
-	-1000, -1000, 10206, 516, 788, 780, -1000, -1000, 1808, 1808,
-	1808, 1808, 10, -1000, -1000, 798, -1000, 516, -1000, 589,
-	81, -1000, 10206, -1000, -1000, -1000, -1000, -1000, 100, -1000,
-	359, 164, 311, -1000, 232, 736, -1000, 733, -1000, -1000,
-	-1000, -1000, -1000, 376, 11, -1000, 6164, 6164, -1000, -1000,
-	-1000, -1000, 356, 50, -170, 10429, 519, 356, 10206, -1000,
-	-1000, -1000, 240, -1000, -1000, -1000, 288, -1000, -1000, 621,
-	350, -1000, 10206, 325, 517, -1000, 695, -162, -175, 509,
-	-1000, -1000, -1000, -1000, -165, -1000, 11, 706, -1000, 693,
-	-1000, -1000, -1000, 7, -167, 3, -171, 516, -176, 6387,
-	-1000, 1808, 356, -1000, -1000,
+var yyPact = [...]int16{
+	4545, -32768, -183, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	928, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 254, 1659, -32768,
+	-32768, -32768, -32768, 24132, -32768, -32768, 904, 5782, 856, 16198,
+	244, 856, 316, 67, 23498, 95, 95, 95, 87, 87,
+	284, 283, 1020, 23815, -32768, -32768, 11379, 23815, 95, 13317,
+	410, 90, 88, 23815, 84, 20638, 23181, 59, 22864, 14296,
+	855, 1688, 1710, -32768, -32768, -32768, 367, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	855, -32768, -32768, 1686, -32768, 11379, 10087, -32768, 82, 91,
+	1381, 23815, -32768, 856, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 91, 8127,
+	1136, 23815, 605, -32768, 855, 1142, 423, -32768, -32768, -32768,
+	18736, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 1147, 20638, 20638, 247, 247, -32768, 232, -32768,
+	-32768, -32768, 247, 23815, 1115, 256, 146, 524, 146, 146,
+	138, -32768, -32768, 1012, 247, 247, 247, 23815, 1382, 520,
+	710, 486, -32768, -32768, 217, 537, 432, 387, 275, -32768,
+	487, -32768, -32768, -32768, -32768, 100, -32768, 1145, 23815, 248,
+	1007, 248, 248, 248, 248, 248, 248, 248, 20638, 23815,
+	-32768, 425, 87, -32768, -32768, 87, 87, 23815, -32768, -32768,
+	23815, 23815, 1109, 1005, 1592, 144, 5782, 5782, 5782, 5782,
+	5782, 132, 5782, -62, 1426, -32768, -32768, -32768, -32768, 5782,
+	-32768, -32768, -32768, -32768, 1266, 743, -32768, 11379, 2336, 856,
+	856, -32768, -32768, 377, -32768, -32768, 1102, 1101, 1099, 1004,
+	12994, 12994, 12994, 12994, 12994, 12994, 12994, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 856, 416, -32768, 9439, -32768, 856, 856, 856,
+	856, 856, 856, 856, 856, 856, 856, 856, 11379, 856,
+	20638, 856, 856, 856, 856, 856, 856, 856, 856, 856,
+	856, 856, 856, 856, 856, -32768, -32768, -32768, -32768, 124,
+	250, 2584, 12994, 13640, 856, 704, 704, 704, 704, 126,
+	704, 704, 23815, 23815, -32768, -32768, 856, 23815, 1717, 1392,
+	16515, 20638, -32768, -32768, -32768, -32768, 20321, -32768, 1003, 414,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	1165, 1165, 1661, 1677, 1016, 1645, -32768, 23815, 286, -32768,
+	1165, -32768, 11379, 1026, 1715, -32768, 15881, 412, 632, 1132,
+	-32768, -32768, -32768, 1132, -32768, 23815, 1381, 1144, 76, 1373,
+	7792, -94, -32768, -32768, -32768, 521, 411, 17785, -32768, 1591,
+	-32768, 1142, -32768, -32768, 23815, -32768, 855, -32768, 1270, -32768,
+	2567, -32768, -32768, -32768, 1264, -32768, 1014, 11379, 855, 1156,
+	-32768, 1387, 1000, 551, 999, -32768, -32768, -32768, -32768, 247,
+	247, 247, 23815, 23815, -32768, 251, 978, -32768, -32768, -32768,
+	976, 118, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 23815,
+	23815, 23815, 23815, 279, 165, 20638, 347, 423, 757, -32768,
+	-32768, 975, 1628, 1049, 1626, 465, 465, 450, 974, -32768,
+	-32768, 20638, -32768, 20638, 20638, 1619, 1617, -32768, -32768, 23815,
+	423, 20638, -32768, -32768, 20638, 423, 423, 347, 423, 5075,
+	447, 423, -23, 5075, -32768, 1586, -32768, -32768, 855, 271,
+	23815, 1637, 1425, 23815, 970, 969, 23815, 23815, 23815, 23815,
+	-32768, -32768, 7457, 23815, 23815, 23815, 303, -32768, 303, 456,
+	-32768, 216, 70, 5782, 5782, 5782, 5782, 5782, 5782, 5782,
+	5782, 5782, 5782, -32768, -32768, -32768, -32768, -32768, -32768, 5782,
+	5782, -32768, -59, -32768, 23815, -32768, 11379, 11379, 11379, 867,
+	463, 12994, 641, 572, -162, 12994, 12994, 12994, 12994, 12994,
+	12994, 12994, 12994, 12994, 12994, 12994, 12994, 12994, 12994, 12994,
+	12994, 745, 2863, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	20955, -32768, 940, 1166, 1166, -32768, -32768, -32768, 11379, 403,
+	403, 403, 403, 403, 403, 13963, 9764, 6787, 1165, 855,
+	1260, 9439, 10087, 10087, 11379, 11379, 20955, 20638, 12994, 11702,
+	11379, 10087, 1642, 540, 743, 20955, 1653, -32768, 1165, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 10087, 10087, 10087,
+	10087, 10087, 18419, 20004, 1380, 22547, -32768, 968, -32768, 958,
+	2584, 2584, 815, 1376, -32768, -32768, -32768, 815, 953, -32768,
+	-32768, 950, 948, -32768, 1375, -32768, 17468, 1375, -32768, 10410,
+	856, 16515, -32768, 826, 1392, -32768, -32768, -32768, -32768, 856,
+	401, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 1072, -32768, 11379, 1688, -32768, 855, -32768, -32768,
+	-32768, 609, -32768, 23815, -32768, 1384, -32768, 827, 11379, 11379,
+	23815, -32768, -32768, 19687, -32768, -32768, 6117, -32768, 22230, 15564,
+	-32768, 856, 1132, -32768, 7122, 1373, -94, 1364, -32768, -73,
+	-77, 11056, 6452, 321, -32768, -32768, -32768, -32768, 855, 1165,
+	-32768, 8797, 1431, 946, -43, -32768, -32768, -32768, 1014, -32768,
+	1014, 1014, 1014, 1014, -27, -27, -27, -27, -32768, -32768,
+	-32768, -32768, -32768, 1048, 1044, -32768, 1014, 1014, 1014, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, 1042, 1042, 1042, 1015, 1015,
+	-32768, 18736, -32768, 694, 894, -32768, -32768, -32768, -32768, 20638,
+	1061, 945, 5782, 1635, 5782, -32768, 23815, 1388, -32768, -32768,
+	856, 769, -32768, -32768, -32768, -32768, -32768, 1424, 856, 856,
+	1699, -32768, -32768, -32768, -32768, 1538, 832, 435, 1024, -32768,
+	-32768, 20638, 347, -32768, -32768, -32768, 942, 18736, -32768, 935,
+	934, 931, -32768, -32768, -32768, -32768, -32768, -32768, 20638, -32768,
+	262, 255, 1071, 347, 423, -32768, 347, -32768, -32768, -32768,
+	-32768, 1603, 1602, 378, 1569, 5075, -32768, -32768, -32768, 23815,
+	-32768, -32768, 23815, 5782, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, 1368, 1368, 303, 23815, -32768, 221,
+	-32768, -32768, -32768, -32768, 930, -32768, 20638, 23815, 452, 856,
+	23815, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, 532, -32768, -32768, -32768, 743, 463, 484,
+	-32768, -32768, 981, -32768, -32768, -32768, 2584, -32768, 9116, -32768,
+	-32768, -32768, 641, 12994, 12994, 12994, 856, 987, 2584, 2555,
+	1161, 403, 2607, 514, 1067, 1067, 393, 393, 393, 393,
+	393, 1396, 1396, -32768, -32768, -32768, -32768, 1014, 1014, -32768,
+	1014, -32768, -32768, -32768, 1014, -32768, -32768, 1014, 1014, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 1165, -32768, 394,
+	-32768, -32768, 93, -32768, 1165, 10087, 1268, -32768, 856, 374,
+	-32768, -32768, -32768, 1165, -32768, 1165, 1253, 1253, 662, 788,
+	1289, 1714, 2382, 783, 14613, -32768, -32768, -32768, 842, 1253,
+	10087, -32768, 557, -32768, 11379, 1165, 23815, -32768, 1253, 1165,
+	1165, 1253, 1253, -32768, -32768, 21913, -32768, -32768, 14930, 1694,
+	-32768, 259, 1084, -81, -32768, -32768, -32768, -32768, -32768, 704,
+	-32768, -32768, 1654, -32768, -32768, 908, 23815, -32768, -19, 21913,
+	105, -32768, -105, -32768, 1260, -188, -32768, 1352, -32768, -32768,
+	-32768, 6452, -32768, -32768, -32768, 1549, 185, 1367, 1661, 1165,
+	-32768, 10733, -32768, 663, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 11379, -32768, -32768,
+	-32768, 743, 743, -32768, -32768, -32768, 359, 214, 23815, -32768,
+	1370, 1420, -32768, -32768, -32768, 1142, 15247, 906, 19370, -32768,
+	21596, -32768, 1364, -94, -106, -32768, -32768, -32768, 743, 519,
+	-32768, 889, -32768, -32768, 1363, 8462, -32768, -32768, -32768, 757,
+	-32768, 619, 693, -51, -32768, -32768, -27, -27, -32768, -32768,
+	321, 1568, 499, 321, 321, 321, 1095, 1095, -32768, -32768,
+	-32768, -32768, 691, -32768, -32768, -32768, 680, -32768, -32768, -32768,
+	1215, -32768, -32768, -32768, 6452, -32768, -32768, -32768, -32768, -32768,
+	-32768, 1418, 20638, 1165, -32768, 878, 227, 227, 1409, -32768,
+	-32768, -32768, 20638, -32768, -32768, 1017, -32768, 1257, -32768, -32768,
+	-32768, -32768, 423, 20638, 856, -32768, 347, -32768, 194, -32768,
+	1600, 1595, 5075, 321, -32768, 5782, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, 1117, 856, 856, 19053, 1359, 556, 23815,
+	23815, -32768, -32768, -32768, -32768, -32768, -32768, 9116, 987, 2584,
+	2477, 11379, -32768, 12994, 12994, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 6787, -32768, 1497, 1253, 10087, 10087, 6452, -32768,
+	-32768, -32768, -32768, 380, 745, 380, 12994, 12994, 11379, 12994,
+	-32768, 11379, 1712, 1708, -32768, 110, -167, 1334, 541, -32768,
+	11379, 954, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	856, 1694, -32768, 1661, 11379, -32768, -90, 868, 1556, 1357,
+	847, -32768, -32768, -32768, 105, -32768, -19, -32768, -32768, -32768,
+	-32768, 826, 856, -32768, 1728, 436, 1090, 1089, 1352, 1549,
+	-32768, 609, 17151, 1116, 51, 11379, -32768, 5447, 800, 856,
+	-32768, 20955, 15564, 15564, 15564, 15564, 15564, 15564, -32768, 1441,
+	1438, -32768, 1490, 1458, 1485, 23815, 1233, 15247, 15564, 1122,
+	856, 23815, 1212, -32768, -32768, -92, -91, -32768, 11379, -32768,
+	5075, -32768, 5075, -32768, -32768, 1594, -32768, 574, -32768, -32768,
+	-32768, 321, 321, -32768, 497, -32768, -32768, -32768, -32768, -32768,
+	1208, -32768, 1206, 1342, 1204, -32768, 1339, -32768, 503, 23815,
+	-32768, -32768, 278, 1165, 1290, -32768, 20638, -32768, -32768, -32768,
+	1165, 23815, 1198, 20638, 336, -32768, -32768, 210, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 194, -32768, 321,
+	-32768, -32768, -32768, 822, 20638, 20638, 1170, 1165, -32768, -32768,
+	1088, 11379, -32768, -32768, -32768, -32768, 12994, 894, 2584, 2584,
+	-32768, -32768, 18736, 1497, -32768, 1165, -32768, 1165, 1014, 1014,
+	-32768, 1014, 1015, -32768, 1014, 7, 1014, 5, 1165, 123,
+	2210, 1792, 894, 2357, 894, 11379, 11379, 1165, 856, 856,
+	856, -156, -32768, 743, 11379, 1694, 11379, 1661, -32768, 743,
+	1543, -32768, -32768, 653, -32768, -32768, -32768, -32768, 1516, -27,
+	-32768, -32768, 10087, 1707, 1653, 1535, 1532, 1706, -32768, 894,
+	-32768, 1408, 20955, 856, -32768, 16834, 855, 20638, 1214, -32768,
+	488, 1420, 1348, 1348, 1405, 1293, -32768, -32768, -32768, -32768,
+	1437, -32768, 1430, -32768, -32768, -32768, -32768, 128, -32768, 427,
+	-32768, 465, 465, 465, 20638, 214, 1274, 15564, -32768, -32768,
+	-32768, -32768, -32768, 743, 8462, -32768, 1404, 194, -32768, -32768,
+	-32768, -32768, -32768, -27, 1086, -27, 677, -32768, 669, 6452,
+	5075, -32768, 1403, 11379, 12994, -32768, 227, 2567, 821, 1650,
+	1387, 1192, 336, -32768, 820, 487, 1083, 1176, -32768, 20638,
+	-32768, -32768, -32768, 1156, 1156, -32768, 20638, 452, -32768, 743,
+	2584, -32768, -32768, -32768, 21272, -32768, -32768, -32768, -32768, 151,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 1165, -32768,
+	12994, -32768, 12994, -32768, -32768, -32768, 894, 894, -32768, 668,
+	654, 12994, 1165, 1081, 743, 1661, -32768, -32768, -32768, -32768,
+	1511, 817, 23815, -32768, -32768, 1694, 15564, 50, 43, 43,
+	252, 1143, 1125, -32768, -32768, 10410, 1165, 1174, 354, 1688,
+	20955, 11379, -32768, -32768, 11379, 929, -32768, -32768, 11379, -32768,
+	-32768, -32768, -32768, 856, -32768, 1649, 1649, 1649, 1170, 1694,
+	15564, 1235, -39, 1726, -32768, 321, -32768, 321, 1209, 1199,
+	-32768, -32768, 816, 794, 743, 13963, 72, -32768, -32768, 2567,
+	163, 1061, 235, -32768, -32768, 649, -32768, -32768, 210, 1531,
+	-32768, -32768, -32768, 1117, 1688, 204, 1676, -32768, -32768, -32768,
+	2357, 2357, -32768, -32768, 1165, 1165, 2121, -32768, -32768, -32768,
+	-32768, 17, 27, -32768, 1692, 1276, -32768, -32768, 10087, -32768,
+	1615, 887, 1402, 23815, -32768, 856, -32768, -32768, 957, 20638,
+	20638, 1661, -32768, 743, 743, 20638, 743, 20638, 856, 1562,
+	856, 856, 18419, 1688, 1235, 43, 451, -32768, -125, -32768,
+	-32768, -32768, -32768, 617, 1400, 611, 158, -32768, 1078, 147,
+	-32768, 150, 143, 142, 140, 791, -32768, 792, -32768, 23815,
+	-32768, -32768, 201, -32768, 1472, 1688, 1676, 11379, -32768, -32768,
+	-32768, -32768, 1165, 109, 1053, -32768, 14, 22, 1673, 1690,
+	1672, 1268, 1724, 104, 20638, 241, 43, 1631, 856, -32768,
+	856, -32768, 855, 341, -32768, 43, 1172, 1170, 18102, -32768,
+	1669, 1668, 20638, 20638, 1122, 1661, 43, -32768, 593, 1611,
+	-32768, 1605, -32768, 94, 1077, 780, -32768, 719, 156, 11379,
+	-32768, -32768, -32768, -32768, 714, 711, 304, 72, -32768, -32768,
+	905, 189, 1165, 12348, -32768, -32768, 1472, 1266, -32768, 1507,
+	-172, -174, 27, 1667, 19, 1665, 24, 1075, 1491, 11379,
+	11379, 20955, 267, 1156, 20638, -32768, 20638, 1125, 1165, 20638,
+	-32768, -32768, -32768, -32768, 1156, -32768, -32768, 1156, 1156, -32768,
+	1122, 43, -32768, -32768, 1074, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 11379, 743, -32768, -32768, -32768, -32768, 20638, 856,
+	-32768, -32768, 12671, 715, 1453, 1377, 1165, -32768, 1506, -32768,
+	-32768, 1073, -32768, 1664, 1066, 1663, -32768, -32768, 20638, 743,
+	1068, 1251, -32768, 1558, 1694, -32768, 1156, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, 743, 1151, 12025, 565, -32768,
+	-32768, -32768, -32768, -32768, -32768, 1060, -32768, 1064, -32768, 1063,
+	1167, -32768, 1141, -160, 20955, -32768, -32768, 1399, 2357, 1165,
+	12671, 1021, -32768, -32768, 20638, 856, -32768, 1214, -32768, 1705,
+	-32768, -32768, -32768, -175, -32768, -32768, -32768, 404, 404, -32768,
+	1395, -32768, -32768, 639, 709, 1394, 1723, -32768, -32768, -32768,
+	1700, 404, 404, -32768, -32768,
 }
-var yyPgo = [...]int{
 
-	0, 1038, 26, 534, 1036, 1032, 1031, 1030, 1029, 1027,
-	1026, 1021, 1020, 1019, 1018, 1017, 1016, 1015, 1014, 1013,
-	1012, 1011, 1010, 1009, 113, 1008, 1003, 1000, 75, 998,
-	63, 997, 996, 33, 830, 38, 31, 561, 995, 20,
-	58, 54, 994, 41, 45, 993, 66, 990, 57, 986,
-	985, 983, 1361, 981, 980, 12, 36, 977, 976, 975,
-	974, 64, 378, 973, 970, 969, 964, 963, 961, 49,
-	7, 6, 11, 13, 960, 99, 44, 955, 43, 949,
-	947, 946, 945, 25, 942, 47, 935, 32, 46, 933,
-	22, 55, 29, 19, 5, 65, 52, 932, 28, 53,
-	42, 917, 916, 341, 915, 914, 913, 912, 903, 902,
-	136, 279, 900, 899, 898, 896, 30, 167, 471, 768,
-	61, 895, 894, 892, 1200, 60, 59, 15, 891, 23,
-	1033, 37, 890, 889, 34, 888, 886, 884, 883, 880,
-	879, 878, 78, 854, 853, 852, 10, 18, 851, 850,
-	50, 17, 849, 848, 847, 39, 51, 846, 40, 842,
-	841, 839, 838, 21, 16, 837, 9, 836, 8, 834,
-	829, 2, 825, 14, 824, 3, 821, 4, 35, 816,
-	813, 0, 24, 812, 811, 97,
+var yyPgo = [...]int16{
+	0, 2041, 151, 2035, 2034, 108, 2033, 2032, 2031, 149,
+	2030, 2028, 148, 2027, 2026, 2024, 2021, 2020, 2019, 2018,
+	146, 2017, 2016, 2014, 147, 2013, 144, 2012, 75, 2011,
+	51, 2010, 2009, 18, 86, 138, 2007, 2006, 841, 129,
+	2005, 26, 31, 583, 131, 2004, 2002, 1712, 1710, 1999,
+	1998, 1996, 1994, 1983, 1982, 1980, 1978, 1975, 1974, 1972,
+	136, 90, 92, 1971, 3, 111, 1970, 1969, 1967, 1965,
+	1963, 1962, 1959, 1958, 100, 102, 38, 28, 1957, 1956,
+	1955, 1953, 1950, 1949, 1947, 1945, 1944, 1943, 1941, 1936,
+	1935, 1933, 1932, 1931, 1930, 145, 122, 95, 62, 124,
+	320, 121, 128, 1927, 61, 1909, 139, 98, 115, 1907,
+	1905, 1904, 1903, 1901, 1900, 1899, 89, 1898, 1897, 1895,
+	1890, 245, 71, 117, 59, 82, 10, 70, 2956, 1889,
+	35, 78, 94, 1886, 53, 54, 1885, 66, 1882, 1881,
+	60, 58, 1880, 3592, 1879, 1877, 24, 20, 9, 36,
+	4, 1876, 1873, 135, 1872, 96, 2, 1871, 1866, 1865,
+	127, 1864, 1859, 101, 14, 16, 21, 33, 1858, 271,
+	7, 1852, 99, 1851, 1848, 1845, 1842, 17, 120, 47,
+	1, 12, 8, 1841, 25, 1837, 1831, 5, 88, 1823,
+	22, 1531, 69, 57, 65, 1821, 1819, 1817, 13, 1816,
+	1809, 1808, 15, 588, 45, 27, 23, 11, 1806, 1805,
+	6, 130, 113, 1804, 37, 114, 87, 1802, 118, 1799,
+	250, 1798, 1797, 1796, 1795, 1794, 1792, 176, 188, 1791,
+	107, 1790, 91, 0, 105, 1593, 455, 119, 1785, 1779,
+	1778, 2513, 125, 142, 19, 84, 123, 230, 67, 1777,
+	1776, 63, 1775, 1774, 30, 141, 140, 1773, 132, 1771,
+	1770, 1769, 1224, 1768, 52, 1767, 1766, 1764, 73, 41,
+	1763, 1761, 116, 46, 1760, 1757, 1756, 83, 126, 85,
+	109, 40, 1754, 1753, 1752, 56, 49, 1751, 110, 104,
+	39, 1750, 1749, 29, 1748, 42, 1746, 34, 1743, 43,
+	134, 93, 1742, 1158, 103, 1741, 106, 1740, 1739, 834,
+	2355, 1738, 143, 137, 1737, 430,
 }
-var yyR1 = [...]int{
 
-	0, 179, 180, 180, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 6, 3, 4, 4, 5,
-	5, 7, 7, 27, 27, 8, 9, 9, 9, 183,
-	183, 46, 46, 91, 91, 10, 10, 10, 10, 96,
-	96, 100, 100, 100, 101, 101, 101, 101, 132, 132,
-	11, 11, 11, 11, 11, 11, 11, 177, 177, 176,
-	175, 175, 174, 174, 173, 16, 160, 161, 161, 161,
-	156, 135, 135, 135, 135, 138, 138, 136, 136, 136,
-	136, 136, 136, 136, 137, 137, 137, 137, 137, 139,
-	139, 139, 139, 139, 140, 140, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 140, 140, 140, 141,
-	141, 141, 141, 141, 141, 141, 141, 155, 155, 142,
-	142, 150, 150, 151, 151, 151, 148, 148, 149, 149,
-	152, 152, 152, 143, 143, 143, 143, 143, 143, 143,
-	145, 145, 153, 153, 146, 146, 146, 147, 147, 154,
-	154, 154, 154, 154, 144, 144, 157, 157, 169, 169,
-	168, 168, 168, 159, 159, 165, 165, 165, 165, 165,
-	158, 158, 167, 167, 166, 162, 162, 162, 163, 163,
-	163, 164, 164, 164, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 178, 178, 178, 178, 178, 178, 178,
-	178, 178, 178, 178, 172, 170, 170, 171, 171, 13,
-	14, 14, 14, 14, 14, 15, 15, 17, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 108, 108, 105, 105, 106, 106, 107, 107, 107,
-	109, 109, 109, 133, 133, 133, 19, 19, 21, 21,
-	22, 23, 20, 20, 20, 20, 20, 184, 24, 25,
-	25, 26, 26, 26, 30, 30, 30, 28, 28, 29,
-	29, 35, 35, 34, 34, 36, 36, 36, 36, 121,
-	121, 121, 120, 120, 38, 38, 39, 39, 40, 40,
-	41, 41, 41, 54, 54, 90, 90, 92, 92, 42,
-	42, 42, 42, 42, 43, 43, 44, 44, 45, 45,
-	128, 128, 127, 127, 127, 126, 126, 47, 47, 51,
-	49, 48, 48, 48, 48, 50, 50, 53, 53, 52,
-	52, 55, 55, 55, 55, 56, 56, 37, 37, 37,
-	37, 37, 37, 37, 104, 104, 58, 58, 57, 57,
-	57, 57, 57, 57, 57, 57, 57, 57, 68, 68,
-	68, 68, 68, 68, 59, 59, 59, 59, 59, 59,
-	59, 33, 33, 69, 69, 69, 75, 70, 70, 62,
-	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-	66, 66, 66, 64, 64, 64, 64, 64, 64, 64,
-	64, 64, 64, 64, 64, 64, 64, 64, 65, 65,
-	65, 65, 65, 65, 65, 65, 185, 185, 67, 67,
-	67, 67, 31, 31, 31, 31, 31, 131, 131, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 79, 79, 32, 32, 77, 77, 78, 80,
-	80, 76, 76, 76, 61, 61, 61, 61, 61, 61,
-	61, 61, 63, 63, 63, 81, 81, 82, 82, 83,
-	83, 84, 84, 85, 86, 86, 86, 87, 87, 87,
-	87, 88, 88, 88, 60, 60, 60, 60, 60, 60,
-	89, 89, 89, 89, 93, 93, 71, 71, 73, 73,
-	72, 74, 94, 94, 98, 95, 95, 99, 99, 99,
-	97, 97, 97, 123, 123, 123, 102, 102, 110, 110,
-	111, 111, 103, 103, 112, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 113, 113, 113, 114, 114, 115,
-	115, 115, 122, 122, 118, 118, 119, 119, 124, 124,
-	125, 125, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 181, 182, 129, 130, 130, 130,
+var yyR1 = [...]int16{
+	0, 307, 308, 308, 1, 1, 1, 38, 38, 38,
+	40, 40, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 34, 34, 34, 41, 41, 42, 42,
+	43, 43, 44, 45, 35, 36, 36, 37, 37, 46,
+	46, 46, 111, 111, 47, 48, 48, 48, 311, 311,
+	137, 137, 204, 204, 49, 49, 49, 49, 212, 212,
+	216, 216, 216, 217, 217, 217, 217, 249, 249, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 3, 4, 4, 4, 8, 8, 5, 5, 9,
+	9, 10, 10, 11, 11, 6, 6, 6, 7, 7,
+	7, 12, 12, 13, 14, 14, 15, 15, 16, 16,
+	17, 17, 17, 18, 18, 18, 19, 19, 24, 24,
+	25, 26, 26, 27, 28, 28, 29, 29, 30, 31,
+	31, 31, 31, 33, 33, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 22, 23, 20, 21, 299, 299,
+	298, 297, 297, 296, 296, 295, 55, 57, 57, 59,
+	58, 39, 39, 203, 203, 282, 283, 283, 283, 283,
+	283, 283, 278, 234, 234, 234, 254, 254, 254, 254,
+	257, 257, 255, 255, 255, 255, 255, 255, 255, 256,
+	256, 256, 256, 256, 258, 258, 258, 258, 258, 259,
+	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
+	259, 259, 259, 259, 260, 260, 260, 260, 260, 260,
+	260, 260, 267, 267, 277, 277, 262, 262, 272, 272,
+	273, 273, 273, 270, 270, 271, 271, 274, 274, 274,
+	263, 263, 264, 264, 264, 264, 264, 264, 264, 266,
+	266, 275, 275, 268, 268, 268, 268, 268, 269, 269,
+	276, 276, 276, 276, 276, 265, 265, 279, 279, 65,
+	65, 62, 66, 66, 63, 63, 63, 63, 63, 64,
+	64, 64, 64, 64, 291, 291, 290, 290, 290, 281,
+	281, 287, 287, 287, 287, 287, 287, 287, 287, 287,
+	287, 287, 280, 280, 289, 289, 288, 288, 284, 284,
+	284, 285, 285, 285, 286, 286, 286, 56, 51, 51,
+	51, 51, 51, 51, 51, 67, 67, 60, 60, 60,
+	60, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	60, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	60, 60, 60, 68, 68, 68, 68, 68, 68, 68,
+	69, 69, 61, 61, 61, 294, 292, 292, 293, 293,
+	52, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	54, 54, 70, 70, 70, 70, 70, 70, 70, 74,
+	74, 75, 75, 76, 76, 76, 77, 77, 312, 312,
+	304, 304, 305, 305, 306, 306, 306, 306, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 225, 225, 222, 222, 223, 223,
+	224, 224, 224, 226, 226, 226, 250, 250, 250, 72,
+	72, 78, 78, 79, 80, 81, 82, 82, 82, 82,
+	300, 300, 83, 83, 83, 83, 83, 83, 303, 303,
+	303, 302, 302, 301, 301, 301, 301, 89, 123, 123,
+	123, 90, 90, 96, 96, 97, 97, 98, 91, 91,
+	84, 84, 84, 92, 92, 93, 93, 93, 93, 94,
+	94, 94, 95, 85, 85, 85, 85, 85, 85, 85,
+	85, 85, 100, 100, 100, 100, 101, 101, 101, 102,
+	102, 102, 103, 103, 103, 105, 105, 86, 86, 106,
+	106, 107, 107, 107, 104, 104, 104, 104, 87, 87,
+	88, 88, 99, 99, 99, 73, 73, 73, 73, 73,
+	73, 73, 112, 112, 112, 313, 313, 313, 313, 313,
+	313, 313, 313, 313, 313, 314, 108, 109, 109, 110,
+	110, 110, 116, 116, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 113, 113, 192, 192, 192,
+	192, 192, 125, 125, 124, 124, 127, 127, 127, 127,
+	238, 238, 238, 237, 237, 129, 129, 130, 130, 131,
+	131, 132, 132, 132, 132, 145, 145, 145, 202, 202,
+	205, 205, 133, 133, 133, 133, 133, 134, 134, 135,
+	135, 136, 136, 245, 245, 244, 244, 244, 243, 243,
+	140, 140, 139, 138, 141, 141, 141, 141, 142, 142,
+	144, 144, 143, 143, 146, 146, 147, 147, 148, 148,
+	148, 148, 149, 149, 149, 149, 150, 150, 128, 128,
+	128, 128, 128, 128, 128, 152, 152, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	162, 162, 162, 162, 162, 162, 162, 162, 153, 153,
+	153, 153, 153, 153, 153, 122, 122, 163, 163, 163,
+	169, 169, 164, 164, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 160, 160, 160, 160, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 118, 118,
+	119, 119, 119, 253, 253, 315, 315, 161, 161, 161,
+	161, 161, 114, 114, 114, 114, 114, 248, 248, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 252, 252, 252, 252, 252, 252, 252, 261,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 173, 173, 115, 115, 171, 171, 172, 174,
+	174, 170, 170, 170, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 157, 157, 157, 175, 175, 175, 176,
+	176, 179, 179, 179, 180, 180, 181, 181, 181, 183,
+	183, 182, 182, 182, 182, 182, 184, 184, 185, 185,
+	186, 186, 187, 177, 177, 178, 178, 188, 189, 189,
+	189, 190, 190, 191, 191, 191, 194, 194, 194, 194,
+	195, 195, 198, 198, 196, 196, 196, 199, 199, 197,
+	197, 200, 200, 193, 193, 193, 154, 154, 154, 154,
+	154, 154, 201, 201, 201, 201, 207, 207, 207, 206,
+	206, 208, 208, 209, 209, 209, 126, 126, 165, 165,
+	167, 167, 166, 168, 210, 210, 214, 211, 211, 215,
+	215, 215, 215, 213, 213, 213, 240, 240, 240, 227,
+	227, 228, 228, 120, 120, 121, 121, 218, 218, 219,
+	219, 219, 219, 220, 220, 221, 221, 229, 229, 229,
+	230, 230, 231, 231, 231, 239, 239, 235, 235, 235,
+	236, 236, 241, 241, 242, 242, 242, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 309, 310, 246, 247, 247, 247,
 }
-var yyR2 = [...]int{
 
-	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+var yyR2 = [...]int8{
+	0, 2, 0, 1, 1, 1, 1, 1, 5, 2,
+	3, 3, 1, 1, 1, 1, 2, 1, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 6, 7, 5, 10, 1, 3, 1,
-	3, 7, 8, 1, 1, 8, 8, 7, 6, 1,
-	1, 1, 3, 0, 4, 3, 4, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 8, 4, 6, 5, 5, 5, 0, 2, 1,
-	0, 2, 1, 3, 3, 4, 4, 1, 3, 3,
-	8, 3, 1, 1, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
-	2, 2, 2, 1, 4, 4, 2, 2, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
-	3, 0, 5, 0, 3, 5, 0, 1, 0, 1,
-	0, 1, 2, 0, 2, 2, 2, 2, 2, 2,
-	0, 3, 0, 1, 0, 3, 3, 0, 2, 0,
-	2, 1, 2, 1, 0, 2, 5, 4, 1, 2,
-	2, 3, 2, 0, 1, 2, 3, 3, 2, 2,
-	1, 1, 1, 3, 2, 0, 1, 3, 1, 2,
-	3, 1, 1, 1, 6, 7, 7, 12, 7, 7,
-	7, 4, 5, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 5,
-	4, 6, 5, 4, 4, 3, 2, 3, 4, 4,
-	4, 4, 4, 4, 4, 4, 3, 3, 3, 3,
-	4, 3, 6, 4, 2, 4, 2, 2, 2, 2,
-	3, 1, 1, 0, 1, 0, 1, 0, 2, 2,
-	0, 2, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 2, 2, 2, 2, 2, 0, 2, 0,
-	2, 1, 2, 2, 0, 1, 1, 0, 1, 0,
-	1, 0, 1, 1, 3, 1, 2, 3, 5, 0,
-	1, 2, 1, 1, 0, 2, 1, 3, 1, 1,
-	1, 3, 3, 3, 7, 1, 3, 1, 3, 4,
-	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
-	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
-	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
-	3, 0, 5, 5, 5, 0, 2, 1, 3, 3,
-	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
-	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
-	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 6, 4, 4, 6, 6, 6, 6, 8,
-	8, 6, 8, 8, 9, 7, 5, 4, 2, 2,
-	2, 2, 2, 2, 2, 2, 0, 2, 4, 4,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 5, 6, 8, 1, 2, 2, 3,
+	1, 3, 4, 5, 11, 1, 3, 1, 3, 9,
+	9, 11, 1, 1, 11, 12, 11, 10, 1, 1,
+	1, 3, 0, 4, 3, 4, 5, 4, 1, 3,
+	3, 2, 2, 2, 2, 2, 1, 1, 1, 2,
+	3, 5, 2, 3, 4, 5, 2, 5, 1, 4,
+	5, 5, 5, 2, 3, 2, 3, 2, 3, 2,
+	3, 3, 1, 3, 1, 1, 2, 1, 1, 2,
+	2, 1, 3, 9, 9, 1, 1, 1, 1, 1,
+	1, 2, 2, 10, 2, 5, 0, 2, 0, 2,
+	0, 3, 4, 0, 1, 3, 0, 2, 2, 2,
+	7, 2, 2, 9, 0, 1, 1, 3, 3, 0,
+	1, 1, 1, 0, 2, 2, 2, 1, 2, 2,
+	3, 3, 3, 3, 2, 0, 2, 0, 0, 2,
+	1, 0, 2, 1, 3, 3, 4, 3, 5, 7,
+	3, 1, 1, 0, 3, 4, 1, 3, 3, 4,
+	3, 4, 9, 1, 1, 1, 3, 1, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 2, 2, 2, 1, 2, 2, 2, 1, 4,
+	4, 2, 2, 3, 3, 3, 3, 1, 1, 1,
+	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 2, 1, 3, 0, 3, 0, 5,
+	0, 3, 5, 0, 1, 0, 1, 0, 1, 2,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	3, 0, 1, 0, 3, 3, 2, 2, 0, 2,
+	0, 2, 1, 2, 1, 0, 2, 5, 4, 1,
+	2, 12, 0, 1, 0, 3, 3, 6, 6, 1,
+	1, 2, 2, 2, 1, 2, 2, 3, 2, 0,
+	1, 2, 3, 2, 3, 2, 3, 2, 2, 1,
+	2, 1, 1, 1, 1, 3, 2, 3, 0, 1,
+	3, 1, 2, 3, 1, 1, 1, 4, 2, 9,
+	4, 4, 1, 4, 2, 1, 3, 3, 4, 3,
+	2, 3, 2, 2, 3, 3, 3, 4, 4, 5,
+	3, 4, 5, 6, 4, 5, 5, 5, 6, 5,
+	1, 3, 3, 1, 1, 1, 1, 3, 2, 2,
+	0, 1, 0, 1, 2, 7, 1, 3, 8, 8,
+	5, 4, 6, 5, 4, 4, 4, 4, 4, 4,
+	3, 2, 4, 4, 5, 4, 4, 5, 5, 1,
+	3, 4, 7, 0, 4, 4, 0, 2, 1, 1,
+	0, 1, 1, 2, 1, 1, 1, 2, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 3, 3,
+	3, 3, 3, 4, 3, 6, 4, 2, 4, 2,
+	2, 2, 2, 3, 1, 1, 0, 1, 0, 1,
+	0, 2, 2, 0, 2, 2, 0, 1, 1, 2,
+	1, 1, 2, 1, 1, 2, 4, 8, 7, 6,
+	1, 1, 3, 3, 4, 6, 7, 6, 0, 1,
+	1, 1, 3, 1, 1, 2, 2, 4, 1, 1,
+	1, 3, 4, 0, 2, 1, 3, 1, 3, 3,
+	2, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	1, 1, 0, 4, 4, 4, 3, 3, 4, 3,
+	2, 4, 1, 3, 5, 5, 1, 1, 1, 0,
+	1, 1, 0, 1, 3, 0, 2, 3, 3, 1,
+	3, 2, 3, 4, 1, 2, 1, 2, 2, 2,
+	3, 5, 0, 2, 3, 2, 3, 3, 3, 4,
+	4, 4, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 2, 0, 2, 1,
+	2, 2, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 0, 2, 3,
+	4, 5, 0, 1, 1, 3, 1, 2, 3, 5,
+	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
+	1, 1, 3, 3, 4, 3, 7, 7, 1, 3,
+	1, 3, 4, 4, 4, 4, 3, 2, 4, 0,
+	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
+	1, 2, 2, 1, 2, 3, 2, 3, 2, 2,
+	2, 1, 1, 3, 0, 1, 1, 2, 5, 6,
+	6, 6, 0, 2, 3, 3, 0, 2, 1, 3,
+	3, 2, 3, 1, 1, 1, 1, 3, 3, 4,
+	6, 4, 5, 4, 5, 3, 4, 5, 6, 2,
+	1, 2, 1, 2, 1, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
+	3, 4, 1, 3, 1, 1, 1, 1, 1, 2,
+	2, 2, 4, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
+	1, 5, 6, 6, 1, 4, 4, 6, 6, 7,
+	6, 6, 8, 6, 8, 6, 6, 4, 6, 7,
+	7, 4, 6, 9, 7, 5, 4, 4, 4, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 4, 4, 0, 2, 4, 4, 4,
 	4, 4, 0, 3, 4, 7, 3, 1, 1, 2,
 	3, 3, 1, 2, 2, 1, 2, 1, 2, 2,
-	1, 2, 0, 1, 0, 2, 1, 2, 4, 0,
+	1, 2, 2, 2, 1, 2, 1, 1, 1, 2,
+	1, 1, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 0, 2, 1, 2, 4, 0,
 	2, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 2, 0, 3, 0, 2, 0,
-	3, 1, 3, 2, 0, 1, 1, 0, 2, 4,
-	4, 0, 2, 4, 2, 1, 3, 5, 4, 6,
-	1, 3, 3, 5, 0, 5, 1, 3, 1, 2,
-	3, 1, 1, 3, 3, 1, 3, 3, 3, 3,
-	1, 2, 1, 1, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 1, 0,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 0, 3, 5, 0,
+	2, 0, 2, 2, 5, 6, 0, 2, 5, 1,
+	1, 2, 2, 2, 2, 2, 0, 3, 0, 2,
+	1, 3, 3, 0, 3, 1, 3, 2, 0, 1,
+	1, 0, 1, 2, 4, 4, 0, 6, 3, 2,
+	0, 4, 0, 3, 0, 3, 4, 0, 3, 0,
+	3, 0, 3, 0, 2, 4, 3, 1, 3, 6,
+	4, 6, 1, 3, 3, 5, 0, 2, 5, 0,
+	5, 5, 8, 0, 4, 3, 0, 2, 1, 3,
+	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
+	3, 5, 3, 1, 2, 1, 1, 1, 1, 0,
+	2, 0, 3, 0, 1, 1, 1, 0, 1, 0,
+	1, 1, 1, 0, 1, 0, 1, 0, 1, 1,
+	1, 1, 0, 1, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2059,304 +4093,491 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -179, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -17, -18, -19, -21, -22, -23,
-	-20, -3, -4, 6, 7, -27, 9, 10, 30, -16,
-	112, 113, 115, 114, 140, 116, 133, 49, 152, 153,
-	155, 156, 25, 134, 135, 138, 139, -181, 8, 235,
-	53, -180, 250, -83, 15, -26, 5, -24, -184, -24,
-	-24, -24, -24, -24, -160, 53, -115, 121, 70, 148,
-	227, 118, 119, 125, -118, 56, -117, 243, 152, 163,
-	157, 184, 176, 174, 177, 214, 65, 155, 223, 136,
-	172, 168, 166, 27, 189, 248, 167, 131, 130, 190,
-	194, 215, 161, 162, 217, 188, 132, 32, 245, 34,
-	144, 218, 192, 187, 183, 186, 160, 182, 38, 196,
-	195, 197, 213, 179, 169, 18, 221, 139, 142, 191,
-	193, 126, 146, 247, 219, 165, 143, 138, 222, 156,
-	216, 225, 37, 201, 159, 129, 153, 150, 180, 145,
-	170, 171, 185, 158, 181, 154, 147, 140, 224, 202,
-	249, 178, 175, 151, 149, 206, 207, 208, 209, 246,
-	220, 173, 203, -103, 121, 123, 119, 119, 120, 121,
-	227, 118, 119, -52, -124, 56, -117, 121, 148, 119,
-	106, 177, 112, 204, 120, 32, 146, -133, 119, -105,
-	149, 206, 207, 208, 209, 56, 216, 215, 210, -124,
-	154, -129, -129, -129, -129, -129, -2, -87, 17, 16,
-	-5, -3, -181, 6, 20, 21, -30, 39, 40, -25,
-	-36, 97, -37, -124, -57, 72, -62, 29, 56, -117,
-	23, -61, -58, -76, -74, -75, 106, 107, 95, 96,
-	103, 73, 108, -66, -64, -65, -67, 58, 57, 66,
-	59, 60, 61, 62, 67, 68, 69, -118, -72, -181,
-	43, 44, 236, 237, 238, 239, 242, 240, 75, 33,
-	226, 234, 233, 232, 230, 231, 228, 229, 124, 227,
-	101, 235, -103, -39, -40, -41, -42, -54, -75, -181,
-	-52, 11, -46, -52, -95, -132, 154, -99, 216, 215,
-	-119, -97, -118, -116, 214, 177, 213, 117, 71, 22,
-	24, 199, 74, 106, 16, 75, 105, 236, 112, 47,
-	228, 229, 226, 238, 239, 227, 204, 29, 10, 25,
-	134, 21, 99, 114, 78, 79, 137, 23, 135, 69,
-	19, 50, 11, 13, 14, 124, 123, 90, 120, 45,
-	8, 108, 26, 87, 41, 28, 43, 88, 17, 230,
-	231, 31, 242, 141, 101, 48, 35, 72, 67, 51,
-	70, 15, 46, 89, 115, 235, 44, 118, 6, 241,
-	30, 133, 42, 119, 205, 77, 122, 68, 5, 125,
-	9, 49, 52, 232, 233, 234, 33, 76, 12, -161,
-	-156, 56, 120, -52, 235, -118, -111, 124, -111, -111,
-	119, -52, -52, -110, 124, 56, -110, -110, -110, -52,
-	109, -52, 56, 30, 227, 56, 146, 119, 147, 121,
-	-130, -181, -119, -130, -130, -130, 150, 151, -130, -106,
-	211, 51, -130, -182, 55, -88, 19, 31, -37, -124,
-	-84, -85, -37, -83, -2, -24, 35, -28, 21, 64,
-	11, -121, 71, 70, 87, -120, 22, -118, 58, 109,
-	-37, -59, 90, 72, 88, 89, 74, 92, 91, 102,
-	95, 96, 97, 98, 99, 100, 101, 93, 94, 105,
-	80, 81, 82, 83, 84, 85, 86, -104, -181, -75,
-	-181, 110, 111, -62, -62, -62, -62, -62, -62, -62,
-	-181, -2, -70, -37, -181, -181, -181, -181, -181, -181,
-	-181, -181, -181, -79, -37, -181, -185, -181, -185, -185,
-	-185, -185, -185, -185, -185, -181, -181, -181, -181, -53,
-	26, -52, 30, 54, -47, -51, -49, -48, -50, 41,
-	45, 47, 42, 43, 44, 48, -128, 22, -39, -181,
-	-127, 142, -126, 22, -124, 58, -52, -46, -183, 54,
-	11, 52, 54, -95, 154, -96, -100, 217, 219, 80,
-	-123, -118, 58, 29, 30, 55, 54, -135, -138, -140,
-	-139, -141, -136, -137, 174, 175, 106, 178, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 189, 30, 136,
-	170, 171, 172, 173, 190, 191, 192, 193, 194, 195,
-	196, 197, 157, 158, 159, 160, 161, 162, 163, 165,
-	166, 167, 168, 169, 56, -130, 121, -177, 52, 56,
-	72, 56, -52, -52, -130, 122, -52, 23, 51, -52,
-	56, 56, -125, -124, -116, -130, -130, -130, -130, -130,
-	-130, -130, -130, -130, -130, -108, 205, 212, -52, 9,
-	90, 54, 18, 109, 54, -86, 24, 25, -87, -182,
-	-30, -63, -118, 59, 62, -29, 42, -52, -37, -37,
-	-68, 67, 72, 68, 69, -120, 97, -125, -119, -116,
-	-62, -69, -72, -75, 63, 90, 88, 89, 74, -62,
-	-62, -62, -62, -62, -62, -62, -62, -62, -62, -62,
-	-62, -62, -62, -62, -131, 56, 58, 56, -61, -61,
-	-118, -35, 21, -34, -36, -182, 54, -182, -2, -34,
-	-34, -37, -37, -76, -118, -124, -76, -34, -28, -77,
-	-78, 76, -76, -182, -34, -35, -34, -34, -91, 142,
-	-52, -94, -98, -76, -40, -41, -41, -41, -40, -41,
-	41, 41, 41, 46, 41, 46, 41, -48, -124, -182,
-	-55, 49, 123, 50, -181, -126, -91, 52, -39, -52,
-	-99, -96, 54, 218, 220, 221, 51, -37, -147, 105,
-	-162, -163, -164, -119, 58, 59, -156, -157, -165, 126,
-	129, 125, -158, 120, 28, -152, 67, 72, -148, 202,
-	-142, 53, -142, -142, -142, -142, -146, 177, -146, -146,
-	-146, 53, 53, -142, -142, -142, -150, 53, -150, -150,
-	-151, 53, -151, -122, 52, -52, -175, 246, -176, 56,
-	-130, 23, -130, -112, 117, 114, 115, -172, 113, 199,
-	177, 65, 29, 15, 236, 142, 249, 56, 143, -52,
-	-52, -130, -107, 11, 90, 37, -37, -37, -125, -85,
-	-88, -102, 19, 11, 33, 33, -34, 67, 68, 69,
-	109, -181, -69, -62, -62, -62, -33, 137, 71, -182,
-	-182, -34, 54, -37, -182, -182, -182, 54, 52, 22,
-	54, 11, 109, 54, 11, -182, -34, -80, -78, 78,
-	-37, -182, -182, -182, -182, -182, -60, 30, 33, -2,
-	-181, -181, -56, 54, 12, 80, -44, -43, 51, 52,
-	-44, -45, 51, -43, 41, 41, 120, 120, 120, -92,
-	-118, -56, -39, -56, -100, -101, 222, 219, 225, 56,
-	54, -164, 80, 53, 28, -158, -158, 56, 56, -143,
-	29, 67, -149, 203, 59, -146, -146, -147, 30, -147,
-	-147, -147, -155, 58, -155, 59, 59, 51, -118, -130,
-	-174, -173, -119, -129, -178, 148, 127, 128, 131, 130,
-	56, 120, 28, 126, 129, 142, 125, -178, 148, -113,
-	-114, 122, 22, 120, 28, 142, -130, -109, 88, 12,
-	-124, -124, 38, 109, -52, -38, 11, 97, -119, -35,
-	-33, 71, -62, -62, -182, -36, -134, 106, 174, 136,
-	172, 168, 188, 179, 201, 170, 202, -131, -134, -62,
-	-62, -119, -62, -62, 243, -83, 79, -37, 77, -93,
-	51, -94, -71, -73, -72, -181, -2, -89, -118, -92,
-	-83, -98, -37, -37, -37, 53, -37, -181, -181, -181,
-	-182, 54, -83, -56, 219, 223, 224, -163, -164, -167,
-	-166, -118, 56, 56, -145, 51, 58, 59, 60, 67,
-	226, 66, 55, -147, -147, 56, 106, 55, 54, 55,
-	54, 55, 54, -52, 54, 80, -129, -118, -129, -118,
-	-52, -129, -118, 58, -37, -56, -39, -182, -62, -182,
-	-142, -142, -142, -151, -142, 162, -142, 162, -182, -182,
-	-182, 54, 19, -182, 54, 19, -181, -32, 241, -37,
-	27, -93, 54, -182, -182, -182, 54, 109, -182, -87,
-	-90, -118, -90, -90, -90, -127, -118, -87, 55, 54,
-	-142, -153, 199, 9, -146, 58, -146, 59, 59, -130,
-	-173, -164, 53, 26, -81, 13, -146, 56, -62, -62,
-	-62, -62, -62, -182, 58, 28, -73, 33, -2, -181,
-	-118, -118, 54, 55, -182, -182, -182, -55, -169, -168,
-	52, 132, 65, -166, -154, 126, 28, 125, 226, -147,
-	-147, 55, 55, -90, -181, -82, 14, 16, -182, -182,
-	-182, -182, -31, 90, 246, 9, -71, -2, 109, -118,
-	-168, 56, -159, 80, 58, -144, 65, 28, 28, 55,
-	-170, -171, 142, -37, -70, -182, 244, 48, 247, -94,
-	-182, -118, 59, 58, -177, -182, 54, -118, 38, 245,
-	248, -175, -171, 33, 38, 144, 246, 145, 247, -181,
-	248, -62, 141, -182, -182,
+var yyChk = [...]int16{
+	-32768, -307, -1, -2, -78, -38, -41, -45, -46, -47,
+	-42, -48, -49, -50, -51, -52, -53, -54, -70, -71,
+	-72, -79, -80, -81, -82, -83, -84, -85, -86, -87,
+	-88, -89, -90, -91, -92, -73, 200, 201, -40, -34,
+	6, -111, 8, 75, 9, 27, -55, -59, -57, 141,
+	-56, -58, 144, 143, 173, 145, 171, 170, 172, 222,
+	223, 224, 166, 58, 203, 204, 206, 207, 208, 209,
+	205, 28, 212, 214, 343, 225, 226, 227, -93, -112,
+	-309, -35, -36, 64, 7, 67, 142, 215, 216, 217,
+	218, 23, 167, 168, 77, -308, 342, -47, -48, -34,
+	-309, 202, 78, 14, -191, 16, -108, -314, -108, -108,
+	-43, 38, -44, -241, 78, -233, 148, 335, 320, 200,
+	247, 241, 266, 258, 327, 223, 256, 259, 296, 172,
+	222, 90, 203, 305, 232, 42, 71, 254, 227, 252,
+	183, 206, 250, 24, 188, 271, 182, 186, 226, 338,
+	147, 251, 208, 81, 161, 160, 181, 272, 276, 297,
+	207, 228, 245, 246, 299, 270, 162, 29, 337, 31,
+	328, 177, 300, 274, 210, 269, 265, 268, 244, 264,
+	35, 278, 277, 279, 295, 261, 233, 253, 333, 17,
+	303, 171, 175, 273, 275, 80, 225, 156, 179, 76,
+	301, 249, 176, 170, 304, 217, 204, 332, 298, 307,
+	34, 283, 243, 159, 201, 187, 198, 216, 262, 178,
+	72, 73, 267, 242, 263, 329, 202, 180, 173, 79,
+	306, 95, 284, 339, 260, 257, 199, 152, 196, 197,
+	288, 289, 290, 291, 302, 205, 255, 285, -108, -108,
+	-282, 114, -309, -34, 65, -121, 77, 66, 67, -247,
+	-309, -236, -235, -232, 78, -233, 221, 146, 97, 65,
+	22, 281, 184, 100, 134, 15, 214, 101, 215, 133,
+	318, 141, 56, 310, 312, 308, 311, 40, 41, 39,
+	321, 322, 309, 286, 26, 219, 9, 23, 167, 192,
+	20, 49, 126, 143, 185, 104, 105, 169, 21, 168,
+	94, 18, 59, 10, 12, 13, 220, 154, 66, 116,
+	151, 54, 190, 7, 136, 70, 113, 50, 25, 209,
+	52, 114, 16, 313, 314, 224, 28, 213, 334, 174,
+	117, 128, 195, 57, 32, 211, 98, 92, 60, 96,
+	14, 189, 55, 37, 218, 194, 115, 144, 67, 191,
+	53, 149, 64, 331, 27, 166, 193, 47, 48, 45,
+	46, 51, 150, 287, 103, 153, 93, 5, 155, 212,
+	8, 58, 61, 315, 316, 317, 30, 102, 11, 36,
+	75, 38, -203, -309, 196, 309, 149, -9, -3, -12,
+	-24, -26, 150, 152, 96, -231, -10, 183, -13, -25,
+	-27, 155, -235, -11, 182, 179, 181, 180, -67, 146,
+	143, 144, -294, -60, 176, 215, 78, 142, 318, 59,
+	-68, 28, 281, 90, 162, -69, 26, -203, 150, 150,
+	151, 152, 309, 149, 180, 182, 179, 181, 225, 150,
+	-143, -241, -303, 210, 211, -303, -303, -312, 150, 287,
+	-312, 151, 151, 134, 259, 141, 286, 181, 151, 29,
+	179, -250, 150, -222, 197, 288, 289, 290, 291, 78,
+	298, 297, 292, -241, -164, -128, -151, 98, -156, 26,
+	21, -155, -152, -170, -168, -169, 71, 72, 73, 343,
+	134, 135, 122, 123, 131, 99, 136, -160, -158, -159,
+	-161, 74, 82, 91, 83, 84, 85, 86, 87, 92,
+	93, 94, -235, -241, -166, -309, 341, 52, 53, 318,
+	319, -118, 323, 324, 325, 326, 334, 330, 101, 30,
+	32, 308, 317, 316, 315, 313, 314, 310, 312, 311,
+	154, 309, 149, 128, 67, 78, -233, 321, 322, -143,
+	-303, -156, 76, 78, 26, 201, 200, 105, 225, 78,
+	203, 204, 287, 150, 287, 150, -143, 214, -235, -235,
+	228, 225, -94, 78, 134, -233, -143, -313, 75, 78,
+	64, 7, 67, 8, 9, 150, 145, 292, 18, 77,
+	-34, -38, -177, 14, -110, 5, -108, 152, -218, 66,
+	-34, -95, 15, -128, -127, 124, -128, -241, -109, -219,
+	213, 219, 220, -220, 213, 62, -43, -203, -220, -211,
+	-249, 202, -215, 298, 297, -236, -241, -213, 296, 259,
+	295, -120, -121, -143, 114, -34, -245, 65, -283, -278,
+	-234, 78, 147, 148, -289, -288, -235, -309, 65, -202,
+	-235, -235, -228, 154, -228, -9, -12, -24, -26, 182,
+	179, 181, 180, -228, -143, 67, 151, -2, -20, 200,
+	201, 106, -2, -20, -2, -20, -20, -22, 191, 78,
+	-228, -228, -228, -143, 62, 196, -278, 157, -65, -62,
+	-279, 158, 161, -287, 156, 159, 160, 155, -280, 151,
+	25, 196, 78, 157, -230, 156, 161, 151, 25, -229,
+	157, -230, 153, 65, 175, -234, 157, -278, 157, 106,
+	-234, 157, 153, -281, 106, 259, 296, 133, 65, -143,
+	-227, 154, 78, -227, -227, -227, -227, -227, -227, -227,
+	-235, -143, 138, -312, -312, -312, -137, -143, -137, -74,
+	-75, -143, 70, 78, 27, 309, 182, 181, 78, 179,
+	150, 180, 152, -247, -309, -247, -247, -247, -247, 198,
+	199, -247, -223, 293, 60, -247, 62, 97, 96, 113,
+	-128, -153, 116, 98, 117, 114, 115, 100, 119, 130,
+	118, 129, 122, 123, 124, 125, 126, 127, 128, 120,
+	121, 133, 137, 106, 107, 108, 109, 110, 111, 112,
+	-309, -169, -309, 139, 140, 74, 74, 74, 78, -156,
+	-156, -156, -156, -156, -156, -156, -309, 138, -34, -42,
+	-164, -309, -309, -309, -309, -309, -309, -309, -309, -309,
+	-309, -309, -309, -173, -128, -309, -235, -315, -309, -315,
+	-315, -315, -315, -315, -315, -315, -315, -309, -309, -309,
+	-309, -309, 78, 301, -302, -312, -301, 78, 198, 134,
+	-156, -156, -100, -101, 74, 82, 86, -100, -100, -100,
+	-100, 318, -100, -100, -106, -107, -143, -106, -99, -309,
+	-143, 10, -96, 61, -123, 74, -98, -160, 78, -235,
+	-241, -235, -95, -235, 74, -246, 78, 106, -313, -310,
+	63, -310, -190, -191, 15, -37, -35, -309, 64, 19,
+	20, -116, -143, 150, -310, -178, -188, -128, 62, 17,
+	10, -238, -237, 65, -235, 74, 138, 89, -218, -218,
+	-44, 65, -221, 221, 62, -211, 202, -212, -216, 299,
+	301, 106, 138, -240, -235, 74, 26, 27, -245, -143,
+	-34, 63, 62, -254, -257, -259, -258, -260, -255, -256,
+	256, 257, 134, 260, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 27, 71, 72, 73, 254, 255,
+	272, 273, 274, 275, 276, 277, 278, 279, 241, 242,
+	243, 244, 245, 246, 247, 249, 250, 251, 252, 253,
+	-310, 62, -262, 77, -128, -39, -34, -38, -310, 62,
+	-299, 61, 78, 98, 78, -143, 152, 78, -21, -4,
+	311, -8, -5, 78, 74, -23, -241, -143, -143, -143,
+	-6, 184, 148, 78, -60, 146, 143, 144, -235, -61,
+	147, 148, -278, -279, -62, 78, 25, 77, 25, -280,
+	-280, -280, 78, 78, -235, -235, -235, 25, 25, -143,
+	-234, -235, -235, -278, -234, -61, -278, -286, -236, 74,
+	83, 27, 143, -234, 259, 296, -286, 27, -39, 153,
+	-143, 21, 60, -143, 78, 78, -143, -143, -143, -143,
+	-242, -241, -232, 221, -137, -137, -137, 62, -304, -305,
+	-306, 78, 292, 221, 18, -304, 116, 62, -204, 175,
+	223, -247, -247, -247, -247, -247, -247, -247, -247, -247,
+	-247, -247, -247, -225, 287, 294, -143, -128, -128, -128,
+	-162, 92, 98, 93, 94, 95, -156, -163, -309, -166,
+	-169, 88, 116, 114, 115, 100, 333, -156, -156, -156,
+	-156, -156, -156, -156, -156, -156, -156, -156, -156, -156,
+	-156, -156, -156, -248, 78, 74, -252, 134, 256, 270,
+	261, 283, 284, -261, -255, -256, -258, 257, 260, 262,
+	263, 264, 265, 266, 267, 268, 269, -170, -235, -241,
+	-155, -155, -128, -235, -125, 20, -124, -127, -236, -242,
+	-232, 221, -310, -34, -310, -34, -124, -124, -128, -128,
+	-170, -235, -156, -128, -119, 327, 328, 329, -128, -124,
+	-113, 20, -171, -172, 102, -170, 18, -310, -124, -125,
+	-125, -124, -124, -244, -243, 65, -241, 74, -235, -300,
+	32, 62, -137, 75, 78, 78, -102, 50, 78, 62,
+	-102, -103, 78, 78, -105, 78, 62, -104, -243, 65,
+	301, 302, 213, -310, -164, -99, -123, -97, -98, 78,
+	-96, 138, -246, -246, -246, -194, 70, -178, -177, -34,
+	-108, -192, -117, 229, 19, 20, 49, 220, 51, 46,
+	47, 48, 44, 43, 45, -143, -190, 62, -189, 22,
+	23, -128, -128, -143, -237, 124, -242, -144, 70, -143,
+	-130, -131, -132, -133, -145, -169, -309, 343, -143, -169,
+	-218, -215, -212, 62, 300, 302, 303, 60, -128, -236,
+	-269, 133, -34, -310, -284, -285, -286, -278, -279, -65,
+	-62, -267, 78, -270, 284, -262, -262, -262, -262, -262,
+	-268, 259, 296, -268, -268, -268, 77, 77, -262, -262,
+	-262, -272, 77, -272, -272, -273, 77, -273, -246, -288,
+	83, -310, -235, -297, 75, -298, 78, -247, 21, -247,
+	-143, -239, 61, -309, -5, 60, -309, -309, -7, 7,
+	8, 9, 77, -235, -61, -66, 78, -289, 78, 78,
+	78, -235, 153, 153, 70, -61, -278, -61, 26, 26,
+	27, 143, 27, -286, -143, -143, -247, -304, -143, -306,
+	78, -235, -75, -76, 151, 25, -309, -74, -224, 10,
+	116, 92, 93, 94, 95, -310, -163, -309, -156, -156,
+	-156, -309, -122, 169, 97, -262, -262, -262, -262, -262,
+	-262, -310, 138, 344, -310, -124, 62, -309, 138, -310,
+	-310, -310, -310, 62, 61, 65, 62, 10, 10, 116,
+	-310, 10, -155, -170, -310, 65, -310, -124, -174, -172,
+	104, -128, -310, -143, -310, -310, -310, -310, -310, -243,
+	-153, -300, -235, -150, 11, -301, 75, 18, 301, -101,
+	18, 78, -107, -104, 301, 302, -243, 210, 302, -310,
+	344, 62, -236, -193, 18, 28, 234, 78, -97, -190,
+	-310, -116, 32, -124, 83, -309, -188, 138, -204, 175,
+	-143, 27, 62, -140, -139, -138, -141, -142, 50, 54,
+	56, 51, 52, 53, 57, -245, -130, -309, 78, -244,
+	175, 10, -137, -216, -217, 304, 301, 307, 106, 78,
+	62, -286, 106, -279, -62, -274, 92, 98, 83, -271,
+	285, -268, -268, -269, 27, 78, 134, -269, -269, -269,
+	-277, 74, -277, 83, 83, 63, -296, -295, -236, 60,
+	-235, -310, 78, -28, -29, -30, -31, 116, 189, 190,
+	-28, 60, -202, 77, 63, -234, -235, -309, -61, -264,
+	74, 83, 84, 85, 92, 308, 91, 26, 26, -286,
+	-269, -247, -77, 66, -309, -309, -205, 19, -235, -226,
+	114, 11, -241, -241, -310, -122, 97, -128, -156, -156,
+	-236, -179, 37, -310, -127, -125, -236, -251, 134, 256,
+	71, 254, 252, 270, 261, 283, 72, 284, -248, -251,
+	-156, -156, -128, -156, -128, 10, 10, -253, 256, 134,
+	335, -177, 105, -128, 103, -166, -309, -150, -190, -128,
+	301, 78, 28, 62, 78, -104, -98, 8, 116, 74,
+	74, -193, -192, -157, -235, 83, 87, -194, 230, -128,
+	124, -154, 27, 30, -41, -309, -42, -309, -210, -214,
+	-170, -131, -132, -132, -132, -131, -132, 50, 50, 50,
+	55, 50, 55, -140, -141, -241, -310, -131, -146, -147,
+	-148, 58, 66, 59, -309, -143, -137, -311, 10, 61,
+	301, 305, 306, -128, -285, -286, -263, 26, 92, -269,
+	-269, 78, 134, 63, 62, 63, 62, 63, 62, 62,
+	106, -143, -14, 78, 186, -310, 62, -235, -310, -143,
+	63, -202, -291, -290, 61, 162, 90, -292, -293, 175,
+	-264, -269, 78, -202, -202, -310, 62, -310, 74, -128,
+	-156, -310, -235, -180, -309, -179, -310, -310, -262, -262,
+	-262, -273, -262, 246, -262, 246, -310, -310, 320, -310,
+	62, -310, 18, -310, -310, -310, -128, -128, -310, -309,
+	-309, -309, -115, 331, -128, -150, -190, 28, 83, 86,
+	34, -268, 10, 30, 30, -129, 10, -310, -206, -208,
+	60, -210, -165, -167, -166, -309, -34, -201, -235, -150,
+	62, 106, -135, -134, 60, 61, -135, -136, 60, -134,
+	50, 50, 344, 175, -148, -280, -280, -280, -205, -204,
+	61, -130, -266, 60, -264, -268, 74, -268, 83, 83,
+	-295, -286, -17, 60, -128, -156, -33, -30, -254, 78,
+	18, -299, 63, -290, 78, -281, 74, -310, 62, -235,
+	-310, -310, -235, -76, -184, -235, 175, -268, 78, -310,
+	-156, -156, -310, -310, 83, 83, -156, -310, 74, -190,
+	35, -195, 78, -143, -150, -130, 230, -126, 231, -126,
+	24, 232, -207, 65, -207, 62, -310, -310, -310, 62,
+	138, -177, -214, -128, -128, 77, -128, -309, -149, 18,
+	-149, -149, -310, -150, -130, -150, -275, 281, 8, -269,
+	-269, 63, 63, -18, 78, 78, -235, -32, 90, 337,
+	192, 98, 78, 194, 195, 193, -254, 185, -297, 163,
+	83, -293, 30, -77, -177, -184, 175, 15, -310, -310,
+	-310, -310, -114, 116, 75, -197, 239, -198, 235, -175,
+	12, -124, 25, -209, -309, 60, -206, 60, -241, -167,
+	30, -41, -309, -235, -235, -190, -202, -205, -309, 50,
+	14, 12, -309, -309, -244, -177, -150, -126, -276, 156,
+	25, 155, 308, -19, 90, 60, 78, 98, -15, 187,
+	74, 193, 192, 193, 193, 193, 78, -33, 41, 78,
+	-143, 177, -181, -183, 39, 40, -177, -164, -310, 336,
+	57, 76, -200, 240, -196, 236, 237, 15, -176, 13,
+	15, 8, 206, -202, 158, -126, -309, -165, -34, 138,
+	-126, 63, -310, -310, -202, 15, 15, -202, -202, -146,
+	-147, -190, -126, -265, 90, 25, 25, 206, 74, 78,
+	78, -16, 188, -128, 78, 78, 183, 78, 77, 178,
+	-310, -182, 100, 79, 42, -156, -181, 35, 337, 338,
+	-198, 15, -199, 238, 15, 236, 74, -185, 36, -128,
+	-164, -210, 233, 8, -310, -235, -202, -207, -310, -235,
+	-310, -310, -310, -126, 74, -128, -202, -309, -182, 80,
+	81, 41, 80, 81, -310, 35, 74, 15, 74, 15,
+	-186, -187, -235, 75, 27, -150, -310, 63, -156, 174,
+	97, 75, 74, 74, 62, 65, 332, -210, -63, 60,
+	-310, -310, -182, 76, -187, -180, -150, 9, 8, 338,
+	-64, 165, 164, 27, 78, -64, 60, 92, 26, 78,
+	60, 8, 9, -64, -64,
 }
-var yyDef = [...]int{
 
-	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
-	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 509, 0, 277, 277, 277, 277, 277, 277, 0,
-	579, 562, 0, 0, 0, 0, -2, 267, 268, 0,
-	270, 271, 784, 784, 784, 784, 784, 0, 33, 34,
-	782, 1, 3, 517, 0, 0, 281, 284, 279, 0,
-	562, 0, 0, 0, 60, 0, 0, 771, 0, 772,
-	560, 560, 560, 580, 581, 584, 585, 684, 685, 686,
-	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
-	697, 698, 699, 700, 701, 702, 703, 704, 705, 706,
-	707, 708, 709, 710, 711, 712, 713, 714, 715, 716,
-	717, 718, 719, 720, 721, 722, 723, 724, 725, 726,
-	727, 728, 729, 730, 731, 732, 733, 734, 735, 736,
-	737, 738, 739, 740, 741, 742, 743, 744, 745, 746,
-	747, 748, 749, 750, 751, 752, 753, 754, 755, 756,
-	757, 758, 759, 760, 761, 762, 763, 764, 765, 766,
-	767, 768, 769, 770, 773, 774, 775, 776, 777, 778,
-	779, 780, 781, 0, 0, 563, 0, 558, 0, 558,
-	558, 558, 0, 226, 349, 588, 589, 771, 772, 0,
-	0, 0, 0, 785, 785, 785, 785, 0, 785, 255,
-	244, 246, 247, 248, 249, 785, 264, 265, 254, 266,
-	269, 272, 273, 274, 275, 276, 27, 521, 0, 0,
-	509, 29, 0, 277, 282, 283, 287, 285, 286, 278,
-	0, 295, 299, 0, 357, 0, 362, 364, -2, -2,
-	0, 399, 400, 401, 402, 403, 0, 0, 0, 0,
-	0, 0, 0, 426, 427, 428, 429, 494, 495, 496,
-	497, 498, 499, 500, 501, 366, 367, 491, 541, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 482, 0,
-	456, 456, 456, 456, 456, 456, 456, 456, 0, 0,
-	0, 0, 0, 0, 306, 308, 309, 310, 330, 0,
-	332, 0, 0, 41, 45, 0, 762, 545, -2, -2,
-	0, 0, 586, 587, -2, 691, -2, 592, 593, 594,
-	595, 596, 597, 598, 599, 600, 601, 602, 603, 604,
-	605, 606, 607, 608, 609, 610, 611, 612, 613, 614,
-	615, 616, 617, 618, 619, 620, 621, 622, 623, 624,
-	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
-	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
-	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
-	655, 656, 657, 658, 659, 660, 661, 662, 663, 664,
-	665, 666, 667, 668, 669, 670, 671, 672, 673, 674,
-	675, 676, 677, 678, 679, 680, 681, 682, 683, 0,
-	77, 0, 0, 785, 0, 67, 0, 0, 0, 0,
-	0, 785, 0, 0, 0, 0, 0, 0, 0, 225,
-	0, 227, 785, 785, 785, 785, 785, 785, 785, 785,
-	236, 786, 787, 237, 238, 239, 785, 785, 241, 0,
-	256, 0, 250, 28, 783, 22, 0, 0, 518, 0,
-	510, 511, 514, 517, 27, 284, 0, 289, 288, 280,
-	0, 296, 0, 0, 0, 300, 0, 302, 303, 0,
-	360, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	384, 385, 386, 387, 388, 389, 390, 363, 0, 377,
-	0, 0, 0, 419, 420, 421, 422, 423, 424, 0,
-	291, 27, 0, 397, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 0, 483, 0, 448, 0, 449, 450,
-	451, 452, 453, 454, 455, 0, 291, 0, 0, 43,
-	0, 348, 0, 0, 0, 0, 0, 0, 0, 337,
-	0, 0, 340, 0, 0, 0, 0, 331, 0, 0,
-	351, 735, 333, 0, 335, 336, -2, 0, 0, 0,
-	39, 40, 0, 46, 762, 48, 49, 0, 0, 0,
-	157, 553, 554, 555, 551, 185, 0, 140, 136, 82,
-	83, 84, 129, 86, 129, 129, 129, 129, 154, 154,
-	154, 154, 112, 113, 114, 115, 116, 0, 0, 99,
-	129, 129, 129, 103, 119, 120, 121, 122, 123, 124,
-	125, 126, 87, 88, 89, 90, 91, 92, 93, 131,
-	131, 131, 133, 133, 582, 62, 0, 70, 0, 785,
-	0, 785, 75, 0, 201, 0, 220, 559, 0, 785,
-	223, 224, 350, 590, 591, 228, 229, 230, 231, 232,
-	233, 234, 235, 240, 243, 257, 251, 252, 245, 522,
-	0, 0, 0, 0, 0, 513, 515, 516, 521, 30,
-	287, 0, 502, 0, 0, 0, 290, 25, 358, 359,
-	361, 378, 0, 380, 382, 301, 297, 0, 492, -2,
-	368, 369, 393, 394, 395, 0, 0, 0, 0, 391,
-	373, 0, 404, 405, 406, 407, 408, 409, 410, 411,
-	412, 413, 414, 415, 418, 467, 468, 0, 416, 417,
-	425, 0, 0, 292, 293, 396, 0, 540, 27, 0,
-	0, 0, 0, 0, 491, 0, 0, 0, 0, 489,
-	486, 0, 0, 457, 0, 0, 0, 0, 0, 0,
-	347, 355, 542, 0, 307, 326, 326, 328, 0, 323,
-	338, 339, 341, 0, 343, 0, 345, 346, 311, 312,
-	313, 0, 0, 0, 0, 334, 355, 0, 355, 42,
-	546, 47, 0, 0, 52, 53, 547, 548, 549, 0,
-	76, 186, 188, 191, 192, 193, 78, 79, 0, 0,
-	0, 0, 0, 180, 181, 143, 141, 0, 138, 137,
-	85, 0, 154, 154, 106, 107, 157, 0, 157, 157,
-	157, 0, 0, 100, 101, 102, 94, 0, 95, 96,
-	97, 0, 98, 0, 0, 785, 64, 0, 68, 69,
-	65, 561, 66, 784, 0, 0, 574, 202, 564, 565,
-	566, 567, 568, 569, 570, 571, 572, 573, 0, 219,
-	785, 222, 260, 0, 0, 0, 519, 520, 0, 512,
-	23, 0, 556, 557, 503, 504, 304, 379, 381, 383,
-	0, 291, 370, 391, 374, 0, 371, 0, 0, 365,
-	430, 0, 0, 398, -2, 433, 434, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 509, 0, 487, 0,
-	0, 447, 458, 459, 460, 461, 534, 0, 0, -2,
-	0, 0, 509, 0, 0, 0, 319, 327, 0, 0,
-	320, 321, 0, 322, 342, 344, 0, 0, 0, 0,
-	317, 509, 355, 38, 50, 51, 0, 0, 57, 158,
-	0, 189, 0, 0, 175, 0, 0, 178, 179, 150,
-	0, 142, 81, 139, 0, 157, 157, 108, 0, 109,
-	110, 111, 0, 127, 0, 0, 0, 0, 583, 63,
-	71, 72, 0, 194, 784, 0, 203, 204, 205, 206,
-	207, 208, 209, 210, 211, 212, 213, 784, 0, 0,
-	784, 575, 576, 577, 578, 0, 221, 242, 0, 0,
-	258, 259, 523, 0, 24, 355, 0, 298, 493, 0,
-	372, 0, 392, 375, 431, 294, 0, 129, 129, 472,
-	129, 133, 475, 129, 477, 129, 480, 0, 0, 0,
-	0, 492, 0, 0, 0, 484, 446, 490, 0, 31,
-	0, 534, 524, 536, 538, 0, 27, 0, 530, 0,
-	517, 543, 356, 544, 324, 0, 329, 0, 0, 0,
-	332, 0, 517, 37, 54, 55, 56, 187, 190, 0,
-	182, 129, 176, 177, 152, 0, 144, 145, 146, 147,
-	148, 149, 130, 104, 105, 155, 156, 154, 0, 154,
-	0, 134, 0, 785, 0, 0, 195, 0, 196, 198,
-	199, 200, 0, 261, 262, 505, 305, 432, 376, 435,
-	469, 154, 473, 474, 476, 478, 479, 481, 437, 436,
-	438, 0, 0, 441, 0, 0, 0, 0, 0, 488,
-	0, 32, 0, 539, -2, 0, 0, 0, 44, 35,
-	0, 315, 0, 0, 0, 351, 318, 36, 167, 0,
-	184, 159, 153, 0, 157, 128, 157, 0, 0, 61,
-	73, 74, 0, 0, 507, 0, 470, 471, 0, 0,
-	0, 0, 462, 445, 485, 0, 537, 0, -2, 0,
-	532, 531, 0, 325, 352, 353, 354, 314, 166, 168,
-	0, 173, 0, 183, 164, 0, 161, 163, 151, 117,
-	118, 132, 135, 0, 0, 26, 0, 0, 439, 440,
-	442, 443, 0, 0, 0, 0, 527, 27, 0, 316,
-	169, 170, 0, 174, 172, 80, 0, 160, 162, 67,
-	0, 215, 0, 508, 506, 444, 0, 0, 0, 535,
-	-2, 533, 171, 165, 70, 214, 0, 0, 463, 0,
-	466, 197, 216, 0, 464, 0, 0, 0, 0, 0,
-	465, 0, 0, 217, 218,
+var yyDef = [...]int16{
+	0, -2, 2, 4, 5, 6, 12, 13, 14, 15,
+	0, 17, 19, 20, 21, 22, 23, 24, 25, 26,
+	27, 28, 29, 30, 31, 32, 33, 34, 35, 36,
+	37, 38, 39, 40, 41, 42, 481, 0, 7, -2,
+	595, 595, 595, 0, 595, 595, 0, 1297, -2, 1022,
+	390, -2, 0, 0, 0, 498, 498, 498, 0, 0,
+	0, 0, -2, 480, 483, 484, 0, 0, 498, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 923, 0, 595, 62, 63, 1007, 525, 526, 527,
+	528, 582, 583, 584, 1294, 1, 3, 16, 18, -2,
+	0, 482, 532, 0, 9, 0, 0, 597, 1009, 1013,
+	48, 1247, 50, 193, 1032, 1033, 1161, 1162, 1163, 1164,
+	1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174,
+	1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184,
+	1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194,
+	1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204,
+	1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214,
+	1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224,
+	1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234,
+	1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244,
+	1245, 1246, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
+	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
+	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
+	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1013, 0,
+	-2, 0, 0, -2, 0, 663, 1294, 1005, 1006, 96,
+	1298, 1299, 1030, 1031, 1027, 1028, 1029, 1037, 1038, 1039,
+	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
+	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
+	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
+	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
+	1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089,
+	1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109,
+	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119,
+	1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129,
+	1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139,
+	1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149,
+	1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
+	1160, 1247, 0, 0, 1284, 1001, 1001, 103, 0, 105,
+	107, 109, 1001, 1283, 0, 0, 0, 1185, 0, 0,
+	0, 1023, 1024, 121, -2, -2, -2, 1273, 348, 0,
+	0, 1017, 354, 355, 0, 0, 0, 0, 0, 380,
+	319, 383, 384, 385, 386, 0, 391, 0, 0, 999,
+	0, 999, 999, 999, 999, 999, 999, 999, 0, 0,
+	411, 682, 0, 499, 500, 0, 0, 0, 428, 429,
+	0, 0, 0, 0, 0, 0, 1297, 1297, 1297, 1297,
+	1297, 0, 1297, 468, 457, 459, 460, 461, 462, 1297,
+	477, 478, 467, 479, 485, 742, 698, 0, 703, 704,
+	0, 744, 745, 746, 747, 748, 1181, 1266, 1267, 0,
+	0, 0, 0, 0, 0, 0, 0, 777, 778, 779,
+	780, 884, 885, 886, 887, 888, 889, 890, 891, 892,
+	705, 706, 881, 0, 983, 0, 784, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 872, 0,
+	0, 825, 825, 825, 825, 825, 825, 825, 825, 825,
+	0, 0, 0, 0, 0, -2, -2, 818, 819, 0,
+	0, 520, 1244, -2, 0, 0, 0, 0, 0, 540,
+	0, 0, 0, 0, 568, 569, 572, 0, 0, 513,
+	1206, 0, 532, 529, 530, 531, 575, 1296, 0, 1032,
+	585, 586, 587, 588, 589, 590, 591, 592, 593, 594,
+	55, 0, 931, 0, 0, 599, 602, 0, 0, 1008,
+	55, 524, 0, 933, 0, 626, 630, 0, 596, 1007,
+	1010, 1011, 1012, 1007, 1014, 0, 49, 0, 1015, 74,
+	0, 1272, 987, -2, -2, 0, 0, 0, -2, 1172,
+	-2, 663, 1004, 90, 0, -2, 0, 664, 0, 196,
+	0, 203, 204, 205, 0, 334, 256, 0, 0, 0,
+	648, 178, 0, 0, 0, 104, 106, 108, 110, 1001,
+	1001, 1001, 0, 0, 187, 0, 0, 119, 120, 177,
+	0, 0, 131, 132, 148, 149, 151, 152, 175, 0,
+	0, 0, 0, 0, 390, 0, 392, 0, 0, 360,
+	362, 299, 0, 0, 0, 0, 0, 329, 331, 332,
+	333, 0, 363, 0, 0, 0, 0, 1020, 1021, 0,
+	0, 0, 1018, 1019, 0, 0, 0, 392, 0, 0,
+	0, 0, 0, 0, 320, 0, 388, 389, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	519, 410, 0, 0, 0, 0, 430, 70, 430, 0,
+	419, 72, 0, 1297, 1297, 1297, 1297, 1297, 1297, 1297,
+	1297, 1297, 1297, 448, 1298, 449, 450, 451, 452, 1297,
+	1297, 454, 0, 469, 0, 463, 0, 0, 0, 0,
+	701, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 728, 729, 730, 731, 732, 733, 734,
+	0, 719, 0, 0, 0, 749, 750, 751, 0, 770,
+	771, 772, 773, 774, 775, 0, 622, 0, 55, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 615, 0, 873, 0, 0, 809, 0, 810,
+	811, 812, 813, 814, 815, 816, 817, 0, 622, 622,
+	0, 0, 665, 0, 492, 493, 501, 503, 504, 0,
+	521, 522, 549, 542, 546, 547, 548, 549, 552, 536,
+	537, 0, 555, 539, 557, 559, 0, 558, 570, 0,
+	572, 0, 511, 0, 513, 508, 509, 510, -2, 0,
+	0, 518, 523, 576, 577, 578, 1296, 1296, 1296, -2,
+	1295, 11, 936, 932, 0, 923, 57, 0, 595, 600,
+	601, 617, 190, 0, 56, 931, 925, 928, 0, 0,
+	0, 627, 631, 0, 633, 634, 0, 598, 0, 0,
+	51, 0, 1007, 1016, 0, 75, 1272, 77, 78, 0,
+	0, 0, 0, 288, 996, 997, 998, 994, 0, 0,
+	-2, 338, 0, 252, 263, 207, 208, 209, 256, 211,
+	256, 256, 256, 256, 283, 283, 283, 283, 237, 238,
+	239, 240, 241, 0, 0, 224, 256, 256, 256, 228,
+	244, 245, 246, 247, 248, 249, 250, 251, 212, 213,
+	214, 215, 216, 217, 218, 258, 258, 258, 260, 260,
+	1296, 0, 336, 0, 0, 99, -2, 192, 194, 0,
+	181, 0, 1297, 0, 1297, 186, 0, 1025, 176, 111,
+	112, 114, 115, 117, 118, 174, 122, 0, 0, 0,
+	0, 125, 126, 127, 356, 0, 0, 0, 0, 357,
+	393, 0, 392, 359, 361, 300, 302, 0, 321, 323,
+	325, 327, 328, 330, 350, 364, 365, 366, 0, 351,
+	0, 0, 0, 392, 0, 370, 392, 381, 344, 345,
+	346, 0, 0, 0, 0, 0, 382, 387, 353, 0,
+	401, 1000, 0, 1297, 404, 405, 406, 407, 408, 409,
+	683, 1034, 1035, 1036, 412, 413, 430, 0, 415, 431,
+	432, 434, 435, 436, 0, 416, 0, 0, 423, 0,
+	0, 438, 439, 440, 441, 442, 443, 444, 445, 446,
+	447, 453, 456, 470, 464, 465, 458, 743, 699, 700,
+	702, 720, 0, 722, 724, 726, 707, 708, 0, 737,
+	738, 739, 0, 0, 0, 0, 0, 735, 715, 0,
+	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
+	763, 764, 765, 768, 837, 838, 769, 256, 256, 854,
+	256, 856, 857, 858, 256, 860, 861, 256, 256, 864,
+	865, 866, 867, 868, 869, 870, 871, 0, 881, 0,
+	766, 767, 0, 776, 0, 0, 623, 624, 882, 0,
+	-2, -2, 740, 55, 982, 55, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, -2, -2, -2, 0, 0,
+	0, 616, 879, 876, 0, 0, 0, 826, 0, 0,
+	0, 0, 0, 486, 666, 0, 668, 669, 490, 696,
+	491, 0, 494, 0, 506, 505, 533, 550, 551, 0,
+	534, 535, 553, 541, 538, 0, 0, 561, 0, 0,
+	-2, -2, 0, 573, 0, 0, 507, 514, 515, 517,
+	512, 0, 579, 580, 581, 953, 0, 924, 931, 55,
+	602, 0, 603, 0, 604, 605, 606, 607, 608, 609,
+	610, 611, 612, 613, 614, 347, 8, 0, 927, 929,
+	930, 934, 935, 53, 632, 628, 0, 72, 0, 681,
+	0, 637, 639, 640, 641, 663, 0, 0, 665, 52,
+	0, 988, 76, 0, 0, 81, 82, 989, 990, 0,
+	992, 0, -2, 91, 195, 339, 341, 197, 198, 0,
+	200, 267, 0, 265, 264, 210, 283, 283, 231, 232,
+	288, 0, 0, 288, 288, 288, 0, 0, 225, 226,
+	227, 219, 0, 220, 221, 222, 0, 223, 97, 335,
+	0, 337, 649, 100, 0, 179, 180, 101, 1002, 102,
+	188, 0, 0, 0, 116, 0, -2, -2, 0, 128,
+	129, 130, 0, 394, 358, 0, 303, 0, 322, 324,
+	326, 367, 0, 0, 0, 368, 392, 371, 0, 374,
+	0, 0, 0, 288, 400, 1297, 403, 414, 71, 433,
+	437, 417, 420, 426, 0, 0, 0, 418, 473, 0,
+	0, 721, 723, 725, 727, 711, 709, 0, 735, 716,
+	0, 0, 713, 0, 0, 852, 853, 855, 859, 862,
+	863, 808, 0, 752, 901, 0, 0, 622, 0, 741,
+	-2, 785, 786, 0, 0, 0, 0, 0, 0, 0,
+	797, 0, 0, 0, 801, 0, 0, 923, 0, 877,
+	0, 0, 806, 807, 827, 828, 829, 830, 831, 667,
+	0, 696, 490, 931, 0, 502, 0, 0, 0, 543,
+	0, 556, 560, 562, 564, 566, 0, 565, 567, 574,
+	571, 0, 0, 43, 0, 0, 0, 517, 939, 953,
+	58, 617, 0, 936, 618, 0, 926, 0, 0, 0,
+	680, 0, 0, 0, 0, 0, 0, 0, 670, 0,
+	0, 673, 0, 0, 0, 0, 0, 0, 0, 684,
+	1237, 0, 0, 79, 80, 0, 0, 86, 0, 289,
+	0, 342, 0, 199, 201, 270, 268, 0, 253, 206,
+	266, 288, 288, 233, 0, 286, 287, 234, 235, 236,
+	0, 254, 0, 0, 0, 257, 182, 183, 0, 0,
+	1026, 113, 0, 0, 155, 156, 0, 160, 161, 162,
+	0, 0, 0, 0, 298, 376, 377, 0, 369, 372,
+	272, 273, 274, 275, 276, 277, 278, 0, 375, 288,
+	379, 402, 421, 0, 0, 0, 0, 0, 650, 455,
+	0, 0, 471, 472, 712, 714, 0, 0, 736, 717,
+	882, 781, 0, 901, 625, 0, 883, 0, 256, 256,
+	842, 256, 260, 845, 256, 847, 256, 850, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 874, 805, 880, 0, 696, 0, 931, 489, 697,
+	0, 497, 495, 0, 554, 563, 516, 954, 0, 283,
+	938, 44, 0, 0, 893, 0, 0, 635, 619, 0,
+	629, 969, 0, 0, 957, 0, 0, 0, 696, 984,
+	0, 638, 659, 659, 661, 0, 656, 671, 672, 674,
+	0, 676, 0, 678, 679, 642, 643, 0, 645, 685,
+	686, 0, 0, 0, 0, -2, 0, 0, 68, 69,
+	83, 84, 85, 991, 340, 343, 279, 0, 269, 229,
+	230, 284, 285, 283, 0, 283, 0, 261, 0, 0,
+	0, 189, 140, 0, 0, 163, 159, 0, 0, 0,
+	178, 0, 297, 314, 0, 319, 0, 0, 396, 0,
+	373, 378, 427, 0, 0, 73, 0, 423, 474, 475,
+	718, 710, 902, 903, 916, 782, 783, 787, 839, 283,
+	843, 844, 846, 848, 849, 851, 790, 788, 0, 791,
+	0, 793, 0, 795, 796, 798, 0, 0, 802, 0,
+	0, 0, 0, 0, 878, 931, 488, 496, 544, 545,
+	0, 940, 0, 894, 895, 696, 0, 620, 976, 976,
+	0, 966, 966, 978, 980, 0, 55, 0, 962, 923,
+	0, 0, 652, 660, 0, 0, 653, 654, 0, 655,
+	675, 677, 644, 0, 687, 692, 692, 692, 0, 696,
+	0, 696, 281, 0, 271, 288, 255, 288, 0, 0,
+	184, 185, 143, 0, 134, 0, 150, 157, 158, 0,
+	0, 181, 0, 315, 316, 0, 318, 395, 0, 0,
+	424, 425, 651, 426, 923, 916, 1237, 840, 841, 789,
+	0, 0, 799, 800, 0, 0, 832, 804, 875, 487,
+	955, 949, 942, 45, 896, 636, 621, 59, 0, 60,
+	0, 973, 969, 0, 956, 0, 981, -2, 0, 0,
+	0, 931, 985, 986, 657, 0, 662, 0, 0, 0,
+	0, 0, 665, 923, 696, 976, 290, 282, 0, 242,
+	243, 259, 262, 146, 144, 0, 136, 164, 0, 0,
+	167, 0, 0, 0, 0, 0, 163, 0, 349, 0,
+	317, 397, 0, 422, 906, 923, 0, 0, 792, 794,
+	823, 824, 0, 0, 0, 937, 951, 944, 0, 899,
+	0, 977, 0, 0, 0, 0, 976, 0, 967, 979,
+	0, 960, 0, 964, 963, 976, 0, 0, 0, 693,
+	0, 0, 0, 0, 684, 931, 976, 67, 295, 0,
+	292, 294, 280, 0, 0, 0, 141, 0, 138, 0,
+	165, 166, 168, 169, 0, 0, 0, 153, 123, 124,
+	0, 0, 0, 0, 909, 910, 906, 917, 803, 0,
+	0, 0, 942, 0, 947, 0, 0, 0, 918, 0,
+	0, 0, 0, 0, 0, 61, 0, 966, 55, 0,
+	64, 658, 647, 688, 0, 694, 695, 0, 0, 646,
+	685, 976, 66, 202, 0, 291, 293, 133, 147, 145,
+	142, 135, 0, 137, 170, 171, 172, 173, 0, 0,
+	904, 907, 0, 1275, 1180, 0, 0, 833, 0, 836,
+	950, 0, 941, 0, 0, 0, 943, 54, 0, 900,
+	897, 970, 971, 0, 696, 975, 0, 959, -2, 965,
+	689, 690, 691, 65, 296, 139, 0, 0, 0, 911,
+	912, 913, 914, 915, 905, 834, 952, 0, 945, 0,
+	919, 920, 0, 0, 0, 974, 968, 304, 0, 0,
+	0, 0, 948, 946, 0, 0, 898, 696, 301, 0,
+	398, 399, 908, 0, 921, 922, 972, 0, 0, 835,
+	305, 309, 310, 0, 0, 306, 0, 311, 312, 313,
+	0, 0, 0, 307, 308,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 73, 3, 3, 3, 100, 92, 3,
-	53, 55, 97, 95, 54, 96, 109, 98, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 250,
-	81, 80, 82, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 99, 3, 3, 3, 127, 119, 3,
+	77, 63, 124, 122, 62, 123, 138, 125, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 342,
+	107, 106, 108, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 102, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 129, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 91, 3, 103,
+	3, 3, 3, 343, 118, 344, 131,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 56, 57, 58, 59, 60, 61, 62, 63, 64,
-	65, 66, 67, 68, 69, 70, 71, 72, 74, 75,
-	76, 77, 78, 79, 83, 84, 85, 86, 87, 88,
-	89, 90, 93, 94, 99, 101, 104, 105, 106, 107,
-	108, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	64, 65, 66, 67, 68, 69, 70, 71, 72, 73,
+	74, 75, 76, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 100, 101, 102, 103, 104, 105,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 120,
+	121, 126, 128, 130, 132, 133, 134, 135, 136, 137,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
@@ -2368,10 +4589,26 @@ var yyTok2 = [...]int{
 	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
 	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
 	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
-	249,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
 }
-var yyTok3 = [...]int{
-	0,
+
+var yyTok3 = [...]uint16{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
+	57630, 305, 57631, 306, 57632, 307, 57633, 308, 57634, 309,
+	57635, 310, 57636, 311, 57637, 312, 57638, 313, 57639, 314,
+	57640, 315, 57641, 316, 57642, 317, 57643, 318, 57644, 319,
+	57645, 320, 57646, 321, 57647, 322, 57648, 323, 57649, 324,
+	57650, 325, 57651, 326, 57652, 327, 57653, 328, 57654, 329,
+	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
+	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
+	57665, 340, 57666, 341, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -2413,7 +4650,7 @@ func yyNewParser() yyParser {
 	return &yyParserImpl{}
 }
 
-const yyFlag = -1000
+const yyFlag = -32768
 
 func yyTokname(c int) string {
 	if c >= 1 && c-1 < len(yyToknames) {
@@ -2452,9 +4689,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -2464,13 +4701,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -2501,30 +4738,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -2579,7 +4816,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -2590,8 +4827,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -2604,7 +4841,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -2613,18 +4850,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -2646,10 +4883,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -2685,7 +4922,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -2696,16 +4933,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -2713,299 +4950,477 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:302
+//line sql.y:534
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:307
+//line sql.y:539
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:308
+//line sql.y:540
 		{
 		}
-	case 4:
+	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:312
+//line sql.y:546
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 22:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:335
+	case 7:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:556
+		{
+			yyVAL.selStmt = yyDollar[1].parenSelect
+		}
+	case 8:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:560
+		{
+			yyDollar[1].parenSelect.OrderBy = yyDollar[4].orderBy
+			yyDollar[1].parenSelect.Limit = yyDollar[5].limit
+			yyVAL.selStmt = yyDollar[1].parenSelect
+		}
+	case 9:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:566
+		{
+			yyDollar[1].parenSelect.Limit = yyDollar[2].limit
+			yyVAL.selStmt = yyDollar[1].parenSelect
+		}
+	case 10:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:573
+		{
+			yyVAL.parenSelect = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 11:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:577
+		{
+			yyVAL.parenSelect = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 12:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:585
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 16:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:592
+		{
+			yyDollar[2].statement.(*Update).With = yyDollar[1].with
+			yyVAL.statement = yyDollar[2].statement
+		}
+	case 18:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:598
+		{
+			yyDollar[2].statement.(*Delete).With = yyDollar[1].with
+			yyVAL.statement = yyDollar[2].statement
+		}
+	case 43:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:629
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
-			sel.Limit = yyDollar[3].limit
-			sel.Lock = yyDollar[4].str
+			if yyDollar[3].limit != nil {
+				if sel.Limit != nil {
+					yylex.Error("cannot use both top and limit")
+					return 1
+				}
+				sel.Limit = yyDollar[3].limit
+			}
+			if yyDollar[4].selectInto != nil {
+				if sel.Into != nil {
+					yylex.Error("cannot use into twice")
+					return 1
+				}
+				sel.Into = yyDollar[4].selectInto
+			}
+			sel.Lock = yyDollar[5].str
 			yyVAL.selStmt = sel
 		}
-	case 23:
+	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:343
+//line sql.y:650
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 24:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:347
+	case 45:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:654
+		{
+			if yyDollar[4].limit != nil {
+				yylex.Error("unexpected top before next")
+				return 1
+			}
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Options: yyDollar[3].selectOptions, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[6].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[8].tableName}}}
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:666
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 47:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:670
+		{
+			yyVAL.selStmt = withSelect(yyDollar[1].with, yyDollar[2].selStmt)
+		}
+	case 48:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:676
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 49:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:680
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:686
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:690
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 52:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:696
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
 		}
-	case 25:
+	case 53:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:353
+//line sql.y:702
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 26:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:360
+	case 54:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:709
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Options: yyDollar[3].selectOptions, Limit: yyDollar[4].limit, SelectExprs: yyDollar[5].selectExprs, Into: yyDollar[6].selectInto, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].groupBy.exprs), WithRollup: yyDollar[9].groupBy.rollup, Having: NewWhere(HavingStr, yyDollar[10].expr), Windows: yyDollar[11].namedWindows}
 		}
-	case 27:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:366
+//line sql.y:715
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 28:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:370
+//line sql.y:719
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 29:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:376
+//line sql.y:725
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:380
+//line sql.y:729
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:387
+	case 59:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:736
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
-			ins := yyDollar[6].ins
+			ins := yyDollar[7].ins
 			ins.Action = yyDollar[1].str
 			ins.Comments = yyDollar[2].bytes2
-			ins.Ignore = yyDollar[3].str
-			ins.Table = yyDollar[4].tableName
-			ins.Partitions = yyDollar[5].partitions
-			ins.OnDup = OnDup(yyDollar[7].updateExprs)
+			ins.Priority = yyDollar[3].str
+			ins.Ignore = yyDollar[4].str
+			ins.Table = yyDollar[5].tableName
+			ins.Partitions = yyDollar[6].partitions
+			ins.OnDup = OnDup(yyDollar[8].updateExprs)
+			ins.Returning = yyDollar[9].selectExprs
 			yyVAL.statement = ins
 		}
-	case 32:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:399
+	case 60:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:750
 		{
-			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
-			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
-			for _, updateList := range yyDollar[7].updateExprs {
-				cols = append(cols, updateList.Name.Name)
-				vals = append(vals, updateList.Expr)
-			}
-			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
+			ins := yyDollar[7].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Priority = yyDollar[3].str
+			ins.Ignore = yyDollar[4].str
+			ins.Table = yyDollar[5].tableName
+			ins.Partitions = yyDollar[6].partitions
+			ins.OnConflict = yyDollar[8].onConflict
+			ins.Returning = yyDollar[9].selectExprs
+			yyVAL.statement = ins
+		}
+	case 61:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:763
+		{
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Priority: yyDollar[3].str, Ignore: yyDollar[4].str, Table: yyDollar[5].tableName, Partitions: yyDollar[6].partitions, SetExprs: yyDollar[8].updateExprs, RowAlias: yyDollar[9].rowAlias, OnDup: OnDup(yyDollar[10].updateExprs), Returning: yyDollar[11].selectExprs}
 		}
-	case 33:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:411
+//line sql.y:769
 		{
 			yyVAL.str = InsertStr
 		}
-	case 34:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:415
+//line sql.y:773
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 35:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:421
+	case 64:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:779
 		{
-			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Priority: yyDollar[3].str, Ignore: yyDollar[4].str, TableExprs: yyDollar[5].tableExprs, Exprs: yyDollar[7].updateExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), OrderBy: yyDollar[9].orderBy, Limit: yyDollar[10].limit, Returning: yyDollar[11].selectExprs}
 		}
-	case 36:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:427
+	case 65:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:785
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Priority: yyDollar[3].str, Quick: yyDollar[4].str, Ignore: yyDollar[5].str, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, Partitions: yyDollar[8].partitions, Where: NewWhere(WhereStr, yyDollar[9].expr), OrderBy: yyDollar[10].orderBy, Limit: yyDollar[11].limit, Returning: yyDollar[12].selectExprs}
 		}
-	case 37:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:431
+	case 66:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:789
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Priority: yyDollar[3].str, Quick: yyDollar[4].str, Ignore: yyDollar[5].str, Targets: yyDollar[7].tableNames, TableExprs: yyDollar[9].tableExprs, Where: NewWhere(WhereStr, yyDollar[10].expr), Returning: yyDollar[11].selectExprs}
 		}
-	case 38:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:435
+	case 67:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:793
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Priority: yyDollar[3].str, Quick: yyDollar[4].str, Ignore: yyDollar[5].str, Targets: yyDollar[6].tableNames, TableExprs: yyDollar[8].tableExprs, Where: NewWhere(WhereStr, yyDollar[9].expr), Returning: yyDollar[10].selectExprs}
 		}
-	case 39:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:440
+//line sql.y:798
 		{
 		}
-	case 40:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:441
+//line sql.y:799
 		{
 		}
-	case 41:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:445
+//line sql.y:803
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 42:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:449
+//line sql.y:807
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 43:
+	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:454
+//line sql.y:812
 		{
 			yyVAL.partitions = nil
 		}
-	case 44:
+	case 73:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:458
+//line sql.y:816
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 45:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:464
+//line sql.y:822
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 46:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:468
+//line sql.y:826
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 47:
+	case 76:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:472
+//line sql.y:830
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 48:
+	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:476
+//line sql.y:834
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 49:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:482
+//line sql.y:840
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 50:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:486
+//line sql.y:844
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 51:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:492
+//line sql.y:850
 		{
 			yyVAL.setExpr = yyDollar[3].setExpr
 		}
-	case 52:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:496
+//line sql.y:854
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
 		}
-	case 53:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:500
+//line sql.y:858
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
 		}
-	case 54:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:506
+//line sql.y:864
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
 		}
-	case 55:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:510
+//line sql.y:868
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
 		}
-	case 56:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:514
+//line sql.y:872
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
 		}
-	case 57:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:518
+//line sql.y:876
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
 		}
-	case 58:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:524
+//line sql.y:882
 		{
 			yyVAL.str = SessionStr
 		}
-	case 59:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:528
+//line sql.y:886
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 60:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:534
+//line sql.y:892
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 61:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:539
+	case 90:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:897
 		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
+			yyDollar[1].ddl.OptLike = &OptLike{LikeTable: yyDollar[3].tableName}
+			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 62:
+	case 91:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:902
+		{
+			yyDollar[1].ddl.OptLike = &OptLike{LikeTable: yyDollar[4].tableName}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 92:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:907
+		{
+			yyDollar[1].ddl.AsSelect = yyDollar[2].selStmt
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 93:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:912
+		{
+			yyDollar[1].ddl.AsSelect = yyDollar[3].selStmt
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:544
+//line sql.y:917
 		{
-			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
+			yyDollar[1].ddl.AsSelectDuplicate = yyDollar[2].str
+			yyDollar[1].ddl.AsSelect = yyDollar[4].selStmt
+			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 63:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:548
+	case 95:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:923
 		{
-			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			yyDollar[1].ddl.AsSelectDuplicate = yyDollar[3].str
+			yyDollar[1].ddl.AsSelect = yyDollar[5].selStmt
+			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 64:
+	case 96:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:930
+		{
+			yyDollar[1].ddl.AlterSpecs = nil
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 97:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:935
+		{
+			yyDollar[1].ddl.AlterSpecs[0].Index.Columns = yyDollar[3].indexColumns
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:940
+		{
+			yyDollar[1].ddl.ViewSpec = nil
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 99:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:945
+		{
+			yyDollar[1].ddl.ViewSpec.Columns = yyDollar[2].columns
+			yyDollar[1].ddl.ViewSpec.Select = yyDollar[4].selStmt
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 100:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:552
+//line sql.y:951
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[3].colIdent,
@@ -3013,2108 +5428,4240 @@ yydefault:
 				Params: yyDollar[5].vindexParams,
 			}}
 		}
-	case 65:
+	case 101:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:560
+//line sql.y:959
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 66:
+	case 102:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:564
+//line sql.y:963
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 67:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:569
-		{
-			yyVAL.colIdent = NewColIdent("")
-		}
-	case 68:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:573
+//line sql.y:967
 		{
-			yyVAL.colIdent = yyDollar[2].colIdent
+			yyVAL.statement = yyDollar[2].createTrigger
 		}
-	case 69:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:579
+	case 104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:971
 		{
-			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+			yyDollar[3].createTrigger.Definer = yyDollar[2].str
+			yyVAL.statement = yyDollar[3].createTrigger
 		}
-	case 70:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:584
+	case 105:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:976
 		{
-			var v []VindexParam
-			yyVAL.vindexParams = v
+			yyVAL.statement = yyDollar[2].createEvent
 		}
-	case 71:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:589
+	case 106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:980
 		{
-			yyVAL.vindexParams = yyDollar[2].vindexParams
+			yyDollar[3].createEvent.Definer = yyDollar[2].str
+			yyVAL.statement = yyDollar[3].createEvent
 		}
-	case 72:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:595
+	case 107:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:985
 		{
-			yyVAL.vindexParams = make([]VindexParam, 0, 4)
-			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
+			yyVAL.statement = yyDollar[2].createProcedure
 		}
-	case 73:
+	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:600
+//line sql.y:989
 		{
-			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
+			yyDollar[3].createProcedure.Definer = yyDollar[2].str
+			yyVAL.statement = yyDollar[3].createProcedure
 		}
-	case 74:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:606
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:994
 		{
-			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
+			yyVAL.statement = yyDollar[2].createFunction
 		}
-	case 75:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:612
+	case 110:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:998
 		{
-			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
-			setDDL(yylex, yyVAL.ddl)
+			yyDollar[3].createFunction.Definer = yyDollar[2].str
+			yyVAL.statement = yyDollar[3].createFunction
 		}
-	case 76:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:619
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1005
 		{
-			yyVAL.TableSpec = yyDollar[2].TableSpec
-			yyVAL.TableSpec.Options = yyDollar[4].str
+			yyVAL.str = yyDollar[3].str
 		}
-	case 77:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:626
+//line sql.y:1011
 		{
-			yyVAL.TableSpec = &TableSpec{}
-			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+			yyVAL.str = "current_user"
 		}
-	case 78:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:631
+//line sql.y:1015
 		{
-			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			yyVAL.str = "current_user"
 		}
-	case 79:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:635
+	case 114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1019
 		{
-			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
-		}
-	case 80:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:641
-		{
-			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
-			yyDollar[2].columnType.Default = yyDollar[4].optVal
-			yyDollar[2].columnType.OnUpdate = yyDollar[5].optVal
-			yyDollar[2].columnType.Autoincrement = yyDollar[6].boolVal
-			yyDollar[2].columnType.KeyOpt = yyDollar[7].colKeyOpt
-			yyDollar[2].columnType.Comment = yyDollar[8].optVal
-			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+			yyVAL.str = formatAccountName(yyDollar[1].strs)
 		}
-	case 81:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:652
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1027
 		{
-			yyVAL.columnType = yyDollar[1].columnType
-			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
-			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 85:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:663
+//line sql.y:1031
 		{
-			yyVAL.columnType = yyDollar[1].columnType
-			yyVAL.columnType.Length = yyDollar[2].optVal
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 86:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:668
+//line sql.y:1037
 		{
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 87:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:674
+//line sql.y:1041
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 88:
+	case 119:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1047
+		{
+			yyDollar[1].createTrigger.Body = yyDollar[2].statement
+			yyVAL.createTrigger = yyDollar[1].createTrigger
+		}
+	case 120:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1052
+		{
+			yyDollar[1].createTrigger.RawBody = yyDollar[2].str
+			yyVAL.createTrigger = yyDollar[1].createTrigger
+		}
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:678
+//line sql.y:1059
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			setInTrigger(yylex)
+			yyVAL.createTrigger = yyDollar[1].createTrigger
 		}
-	case 89:
+	case 122:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1064
+		{
+			order := strings.ToLower(string(yyDollar[2].bytes))
+			if order != FollowsStr && order != PrecedesStr {
+				yylex.Error("expecting follows or precedes")
+				return 1
+			}
+			yyDollar[1].createTrigger.Order = order
+			yyDollar[1].createTrigger.OtherTrigger = yyDollar[3].tableIdent
+			setInTrigger(yylex)
+			yyVAL.createTrigger = yyDollar[1].createTrigger
+		}
+	case 123:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1078
+		{
+			yyVAL.createTrigger = &CreateTrigger{Name: yyDollar[2].tableName, Timing: yyDollar[3].str, Event: yyDollar[4].str, Table: yyDollar[6].tableName}
+		}
+	case 124:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1082
+		{
+			yylex.Error("expecting for each row")
+			return 1
+		}
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:682
+//line sql.y:1089
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = BeforeStr
 		}
-	case 90:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:686
+//line sql.y:1093
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = AfterStr
 		}
-	case 91:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:690
+//line sql.y:1097
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yylex.Error("expecting before or after")
+			return 1
 		}
-	case 92:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:694
+//line sql.y:1104
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = InsertStr
 		}
-	case 93:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:698
+//line sql.y:1108
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = UpdateStr
 		}
-	case 94:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:704
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1112
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.str = DeleteStr
 		}
-	case 95:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:710
+//line sql.y:1118
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyDollar[1].createEvent.Body = yyDollar[2].statement
+			yyVAL.createEvent = yyDollar[1].createEvent
 		}
-	case 96:
+	case 132:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:716
+//line sql.y:1123
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyDollar[1].createEvent.RawBody = yyDollar[2].str
+			yyVAL.createEvent = yyDollar[1].createEvent
 		}
-	case 97:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:722
+	case 133:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1130
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			if !strings.EqualFold(string(yyDollar[5].bytes), "schedule") {
+				yylex.Error("expecting on schedule")
+				return 1
+			}
+			yyVAL.createEvent = &CreateEvent{
+				IfNotExists:  yyDollar[2].byt != 0,
+				Name:         yyDollar[3].tableName,
+				Schedule:     yyDollar[6].str,
+				OnCompletion: yyDollar[7].str,
+				Status:       yyDollar[8].str,
+				Comment:      yyDollar[9].optVal,
+			}
 		}
-	case 98:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:728
+//line sql.y:1147
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			if !strings.EqualFold(string(yyDollar[1].bytes), "at") {
+				yylex.Error("expecting at or every")
+				return 1
+			}
+			yyVAL.str = "at " + String(yyDollar[2].expr)
 		}
-	case 99:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+	case 135:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1155
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = "every " + String(yyDollar[2].expr) + " " + yyDollar[3].colIdent.Lowered() + yyDollar[4].str + yyDollar[5].str
 		}
-	case 100:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:740
+	case 136:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1160
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.str = ""
 		}
-	case 101:
+	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:744
+//line sql.y:1164
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.str = " starts " + String(yyDollar[2].expr)
 		}
-	case 102:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:748
+	case 138:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1169
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.str = ""
 		}
-	case 103:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:752
+	case 139:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1173
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = " ends " + String(yyDollar[2].expr)
 		}
-	case 104:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:758
+	case 140:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1178
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyVAL.str = ""
 		}
-	case 105:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:762
+	case 141:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1182
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			if !strings.EqualFold(string(yyDollar[2].bytes), "completion") || !strings.EqualFold(string(yyDollar[3].bytes), PreserveStr) {
+				yylex.Error("expecting on completion preserve")
+				return 1
+			}
+			yyVAL.str = PreserveStr
 		}
-	case 106:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:766
+	case 142:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1190
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			if !strings.EqualFold(string(yyDollar[2].bytes), "completion") || !strings.EqualFold(string(yyDollar[4].bytes), PreserveStr) {
+				yylex.Error("expecting on completion not preserve")
+				return 1
+			}
+			yyVAL.str = NotPreserveStr
 		}
-	case 107:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:770
+	case 143:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1199
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.str = ""
 		}
-	case 108:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:774
+	case 144:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1203
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			switch strings.ToLower(string(yyDollar[1].bytes)) {
+			case EnableStr:
+				yyVAL.str = EnableStr
+			case DisableStr:
+				yyVAL.str = DisableStr
+			default:
+				yylex.Error("expecting enable or disable")
+				return 1
+			}
 		}
-	case 109:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:778
+//line sql.y:1215
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			if !strings.EqualFold(string(yyDollar[1].bytes), DisableStr) || !strings.EqualFold(string(yyDollar[3].bytes), "slave") {
+				yylex.Error("expecting disable on slave")
+				return 1
+			}
+			yyVAL.str = DisableOnSlaveStr
 		}
-	case 110:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:782
+	case 146:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1224
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.optVal = nil
 		}
-	case 111:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:786
+	case 147:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1228
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 112:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:790
+	case 148:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1234
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyDollar[1].createProcedure.Body = yyDollar[2].statement
+			yyVAL.createProcedure = yyDollar[1].createProcedure
 		}
-	case 113:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:794
+	case 149:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1239
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyDollar[1].createProcedure.RawBody = yyDollar[2].str
+			yyVAL.createProcedure = yyDollar[1].createProcedure
 		}
-	case 114:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+	case 150:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1246
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.createProcedure = &CreateProcedure{IfNotExists: yyDollar[2].byt != 0, Name: yyDollar[3].tableName, Params: yyDollar[5].procParams, Characteristics: yyDollar[7].strs}
 		}
-	case 115:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:802
+	case 151:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1254
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyDollar[1].createFunction.RawBody = yyDollar[2].str
+			yyVAL.createFunction = yyDollar[1].createFunction
 		}
-	case 116:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:806
+	case 152:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1259
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyDollar[1].createFunction.RawBody = yyDollar[2].str
+			yyVAL.createFunction = yyDollar[1].createFunction
 		}
-	case 117:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:810
+	case 153:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1266
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+			if !strings.EqualFold(string(yyDollar[7].bytes), "returns") {
+				yylex.Error("expecting returns")
+				return 1
+			}
+			yyVAL.createFunction = &CreateFunction{IfNotExists: yyDollar[2].byt != 0, Name: yyDollar[3].tableName, Params: yyDollar[5].procParams, Returns: yyDollar[8].columnType, Characteristics: yyDollar[9].strs}
 		}
-	case 118:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:815
+	case 154:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1275
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+			yyVAL.procParams = nil
 		}
-	case 119:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:821
+//line sql.y:1279
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.procParams = yyDollar[1].procParams
 		}
-	case 120:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:825
+//line sql.y:1285
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.procParams = ProcParams{yyDollar[1].procParam}
 		}
-	case 121:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:829
+	case 157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1289
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.procParams = append(yyDollar[1].procParams, yyDollar[3].procParam)
 		}
-	case 122:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:833
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1295
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.procParam = &ProcParam{Mode: yyDollar[1].str, Name: yyDollar[2].colIdent, Type: yyDollar[3].columnType}
 		}
-	case 123:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:837
+	case 159:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1300
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = ""
 		}
-	case 124:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:841
+//line sql.y:1304
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = InStr
 		}
-	case 125:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:845
+//line sql.y:1308
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = OutStr
 		}
-	case 126:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:849
+//line sql.y:1312
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = InOutStr
 		}
-	case 127:
+	case 163:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1317
+		{
+			yyVAL.strs = nil
+		}
+	case 164:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1321
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
+		}
+	case 165:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1327
+		{
+			yyVAL.str = "comment " + String(NewStrVal(yyDollar[2].bytes))
+		}
+	case 166:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1331
+		{
+			yyVAL.str = "language sql"
+		}
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:855
+//line sql.y:1335
 		{
-			yyVAL.strs = make([]string, 0, 4)
-			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
+			yyVAL.str = "deterministic"
 		}
-	case 128:
+	case 168:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1339
+		{
+			yyVAL.str = "not deterministic"
+		}
+	case 169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1343
+		{
+			switch strings.ToLower(string(yyDollar[1].bytes)) {
+			case "contains", "no":
+				yyVAL.str = strings.ToLower(string(yyDollar[1].bytes)) + " sql"
+			default:
+				yylex.Error("expecting contains sql or no sql")
+				return 1
+			}
+		}
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:860
+//line sql.y:1353
 		{
-			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
+			if !strings.EqualFold(string(yyDollar[3].bytes), "data") {
+				yylex.Error("expecting reads sql data")
+				return 1
+			}
+			yyVAL.str = "reads sql data"
 		}
-	case 129:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:865
+	case 171:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1361
 		{
-			yyVAL.optVal = nil
+			if !strings.EqualFold(string(yyDollar[3].bytes), "data") {
+				yylex.Error("expecting modifies sql data")
+				return 1
+			}
+			yyVAL.str = "modifies sql data"
 		}
-	case 130:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:869
+//line sql.y:1369
 		{
-			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+			if !strings.EqualFold(string(yyDollar[2].bytes), "security") {
+				yylex.Error("expecting sql security")
+				return 1
+			}
+			yyVAL.str = "sql security definer"
 		}
-	case 131:
+	case 173:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1377
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), "security") || !strings.EqualFold(string(yyDollar[3].bytes), "invoker") {
+				yylex.Error("expecting sql security definer or invoker")
+				return 1
+			}
+			yyVAL.str = "sql security invoker"
+		}
+	case 174:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1389
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[2].str
+		}
+	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:874
+//line sql.y:1394
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{}
+			body, ok := yylex.(*Tokenizer).scanStatementBody()
+			if !ok {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.str = strings.TrimLeft(body, " \n\r\t")
 		}
-	case 132:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:878
+	case 176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1408
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{
-				Length: NewIntVal(yyDollar[2].bytes),
-				Scale:  NewIntVal(yyDollar[4].bytes),
+			yyVAL.str = string(yyDollar[1].bytes) + yyDollar[2].str
+		}
+	case 177:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1413
+		{
+			body, ok := yylex.(*Tokenizer).scanBlockBody()
+			if !ok {
+				yylex.Error("unterminated begin block")
+				return 1
 			}
+			yyVAL.str = body
 		}
-	case 133:
+	case 178:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:886
+//line sql.y:1423
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{}
+			yyVAL.colIdent = NewColIdent("")
 		}
-	case 134:
+	case 179:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1427
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 180:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1433
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 181:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1438
+		{
+			var v []VindexParam
+			yyVAL.vindexParams = v
+		}
+	case 182:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1443
+		{
+			yyVAL.vindexParams = yyDollar[2].vindexParams
+		}
+	case 183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1449
+		{
+			yyVAL.vindexParams = make([]VindexParam, 0, 4)
+			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
+		}
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:890
+//line sql.y:1454
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{
-				Length: NewIntVal(yyDollar[2].bytes),
-			}
+			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 135:
+	case 185:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1460
+		{
+			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
+		}
+	case 186:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1466
+		{
+			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName, IfNotExists: yyDollar[3].byt != 0}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 187:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1476
+		{
+			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName(), ViewSpec: &ViewSpec{}}
+			setDDL(yylex, &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()})
+		}
+	case 188:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:896
+//line sql.y:1481
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{
-				Length: NewIntVal(yyDollar[2].bytes),
-				Scale:  NewIntVal(yyDollar[4].bytes),
-			}
+			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName(), ViewSpec: &ViewSpec{OrReplace: true}}
+			setDDL(yylex, &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()})
 		}
-	case 136:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:904
+	case 189:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1491
 		{
-			yyVAL.boolVal = BoolVal(false)
+			info := newCreateIndexInfo(yyDollar[2].str, NewColIdent(string(yyDollar[4].bytes)))
+			yyVAL.ddl = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName, AlterSpecs: []*AlterSpec{{Action: AddIndexStr, Index: &IndexDefinition{Info: info}}}}
+			setDDL(yylex, &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName})
 		}
-	case 137:
+	case 190:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1499
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:908
+//line sql.y:1506
 		{
-			yyVAL.boolVal = BoolVal(true)
+			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 138:
+	case 192:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1510
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 193:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:913
+//line sql.y:1515
 		{
-			yyVAL.boolVal = BoolVal(false)
+			yyVAL.columns = nil
 		}
-	case 139:
+	case 194:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1519
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 195:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1525
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str
+		}
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:917
+//line sql.y:1532
 		{
-			yyVAL.boolVal = BoolVal(true)
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 140:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:923
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1537
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1541
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 199:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1545
+		{
+			yyDollar[4].indexDefinition.Info.setConstraintName(yyDollar[3].colIdent)
+			yyVAL.TableSpec.AddIndex(yyDollar[4].indexDefinition)
+		}
+	case 200:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1550
+		{
+			yyVAL.TableSpec.AddForeignKey(yyDollar[3].foreignKeyDefinition)
+		}
+	case 201:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1554
+		{
+			yyDollar[4].foreignKeyDefinition.Name = yyDollar[3].colIdent
+			yyVAL.TableSpec.AddForeignKey(yyDollar[4].foreignKeyDefinition)
+		}
+	case 202:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1561
+		{
+			yyDollar[2].columnType.SRID = yyDollar[3].optVal
+			yyDollar[2].columnType.NotNull = yyDollar[4].boolVal
+			yyDollar[2].columnType.Default = yyDollar[5].optVal
+			yyDollar[2].columnType.OnUpdate = yyDollar[6].optVal
+			yyDollar[2].columnType.Autoincrement = yyDollar[7].boolVal
+			yyDollar[2].columnType.KeyOpt = yyDollar[8].colKeyOpt
+			yyDollar[2].columnType.Comment = yyDollar[9].optVal
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 206:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1581
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 210:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1592
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].optVal
+		}
+	case 211:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1597
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1603
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1607
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 214:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1611
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 215:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1615
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 216:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1619
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 217:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1623
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 218:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1627
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 219:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1633
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 220:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1639
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 221:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1645
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1651
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1657
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 224:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1665
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 225:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1669
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 226:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1673
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1677
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 228:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1681
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 229:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1687
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 230:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1691
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 231:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1695
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 232:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1699
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1703
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 234:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1707
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1711
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1715
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 237:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1719
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 238:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1723
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 239:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1727
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 240:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1731
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 241:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1735
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 242:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1739
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 243:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1744
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 244:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1750
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 245:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1754
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 246:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1758
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 247:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1762
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 248:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1766
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1770
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 250:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1774
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1778
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 252:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1783
+		{
+			yyVAL.optVal = nil
+		}
+	case 253:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1787
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), "srid") {
+				yylex.Error("expecting srid")
+				return 1
+			}
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 254:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1797
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
+		}
+	case 255:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1802
+		{
+			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
+		}
+	case 256:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1807
+		{
+			yyVAL.optVal = nil
+		}
+	case 257:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1811
+		{
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 258:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1816
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 259:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1820
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 260:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1828
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 261:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1832
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 262:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1838
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 263:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1846
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 264:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1850
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 265:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1855
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 266:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1859
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 267:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1865
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 268:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1869
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 269:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1873
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 270:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1878
+		{
+			yyVAL.optVal = nil
+		}
+	case 271:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1882
+		{
+			yyVAL.optVal = yyDollar[2].optVal
+		}
+	case 272:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1888
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[1].bytes)
+		}
+	case 273:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1892
+		{
+			yyVAL.optVal = NewIntVal(yyDollar[1].bytes)
+		}
+	case 274:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1896
+		{
+			yyVAL.optVal = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 275:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1900
+		{
+			yyVAL.optVal = NewDecimalVal(yyDollar[1].bytes)
+		}
+	case 276:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1904
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
+		}
+	case 277:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1908
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
+		}
+	case 278:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1912
+		{
+			yyVAL.optVal = NewBitVal(yyDollar[1].bytes)
+		}
+	case 279:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1917
+		{
+			yyVAL.optVal = nil
+		}
+	case 280:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1921
+		{
+			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
+		}
+	case 281:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1926
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1930
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 283:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1935
+		{
+			yyVAL.str = ""
+		}
+	case 284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1939
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 285:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1943
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 286:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1947
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 287:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1951
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 288:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1956
+		{
+			yyVAL.str = ""
+		}
+	case 289:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1960
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 290:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1965
+		{
+			yyVAL.colKeyOpt = colKeyNone
+		}
+	case 291:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1969
+		{
+			yyVAL.colKeyOpt = colKeyPrimary
+		}
+	case 292:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1973
+		{
+			yyVAL.colKeyOpt = colKey
+		}
+	case 293:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1977
+		{
+			yyVAL.colKeyOpt = colKeyUniqueKey
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1981
+		{
+			yyVAL.colKeyOpt = colKeyUnique
+		}
+	case 295:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1986
+		{
+			yyVAL.optVal = nil
+		}
+	case 296:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1990
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 297:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1996
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+		}
+	case 298:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2000
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+		}
+	case 299:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2008
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 300:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2012
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[2].bytes))
+		}
+	case 301:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2018
+		{
+			yyVAL.foreignKeyDefinition = yyDollar[12].foreignKeyDefinition
+			yyVAL.foreignKeyDefinition.IndexName = yyDollar[3].colIdent
+			yyVAL.foreignKeyDefinition.Source = yyDollar[5].columns
+			yyVAL.foreignKeyDefinition.ReferencedTable = yyDollar[8].tableName
+			yyVAL.foreignKeyDefinition.ReferencedColumns = yyDollar[10].columns
+		}
+	case 302:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2027
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2031
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 304:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2038
+		{
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{}
+		}
+	case 305:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2042
+		{
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{OnDelete: yyDollar[3].str}
+		}
+	case 306:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2046
+		{
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{OnUpdate: yyDollar[3].str}
+		}
+	case 307:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2050
+		{
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{OnDelete: yyDollar[3].str, OnUpdate: yyDollar[6].str}
+		}
+	case 308:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2054
+		{
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{OnDelete: yyDollar[6].str, OnUpdate: yyDollar[3].str}
+		}
+	case 309:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2060
+		{
+			yyVAL.str = "restrict"
+		}
+	case 310:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2064
+		{
+			yyVAL.str = "cascade"
+		}
+	case 311:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2068
+		{
+			yyVAL.str = "set null"
+		}
+	case 312:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2072
+		{
+			yyVAL.str = "set default"
+		}
+	case 313:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2076
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), "no") || !strings.EqualFold(string(yyDollar[2].bytes), "action") {
+				yylex.Error("expecting no action")
+				return 1
+			}
+			yyVAL.str = "no action"
+		}
+	case 314:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2086
+		{
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+		}
+	case 315:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2090
+		{
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+		}
+	case 316:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2096
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 317:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2100
+		{
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 318:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2105
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 319:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2111
+		{
+			yyVAL.str = ""
+		}
+	case 320:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2115
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 321:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2121
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 322:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2125
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
+		}
+	case 323:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2129
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Spatial: true, Unique: false}
+		}
+	case 324:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2133
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Fulltext: true, Unique: false}
+		}
+	case 325:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2137
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Fulltext: true, Unique: false}
+		}
+	case 326:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2141
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
+		}
+	case 327:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2145
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Unique: true}
+		}
+	case 328:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2149
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
+		}
+	case 329:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2153
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Unique: true}
+		}
+	case 330:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2157
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2161
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Unique: false}
+		}
+	case 332:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2167
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 333:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2171
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 334:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2177
+		{
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+		}
+	case 335:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2181
+		{
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+		}
+	case 336:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2187
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
+		}
+	case 337:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2191
+		{
+			yyVAL.indexColumn = &IndexColumn{Expr: yyDollar[2].expr}
+		}
+	case 338:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2197
+		{
+			yyVAL.str = ""
+		}
+	case 339:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2201
+		{
+			yyVAL.str = " " + string(yyDollar[1].str)
+		}
+	case 340:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2205
+		{
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+		}
+	case 341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2213
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 342:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2217
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 343:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2221
+		{
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+		}
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2227
+		{
+			yyVAL.str = yyDollar[1].colIdent.String()
+		}
+	case 345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2231
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 346:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2235
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 347:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2241
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 348:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2248
+		{
+			yyDollar[1].ddl.AlterSpecs = yyDollar[2].alterSpecs
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 349:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2253
+		{
+			yyDollar[1].ddl.Action = AddColVindexStr
+			yyDollar[1].ddl.NewName = TableName{}
+			yyDollar[1].ddl.VindexSpec = &VindexSpec{
+				Name:   yyDollar[4].colIdent,
+				Type:   yyDollar[8].colIdent,
+				Params: yyDollar[9].vindexParams,
+			}
+			yyDollar[1].ddl.VindexCols = yyDollar[6].columns
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 350:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2265
+		{
+			yyDollar[1].ddl.Action = DropColVindexStr
+			yyDollar[1].ddl.NewName = TableName{}
+			yyDollar[1].ddl.VindexSpec = &VindexSpec{
+				Name: yyDollar[4].colIdent,
+			}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 351:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2274
+		{
+			// Change this to a rename statement
+			yyDollar[1].ddl.Action = RenameStr
+			yyDollar[1].ddl.NewName = yyDollar[4].tableName
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 352:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2281
+		{
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 353:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2285
+		{
+			yyDollar[1].ddl.ViewSpec = &ViewSpec{Columns: yyDollar[2].columns, Select: yyDollar[4].selStmt}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 354:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2290
+		{
+			yyDollar[1].ddl.NewName = TableName{}
+			yyDollar[1].ddl.PartitionSpec = yyDollar[2].partSpec
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2298
+		{
+			yyVAL.alterSpecs = []*AlterSpec{yyDollar[1].alterSpec}
+		}
+	case 356:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2302
+		{
+			yyVAL.alterSpecs = append(yyDollar[1].alterSpecs, yyDollar[3].alterSpec)
+		}
+	case 357:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2310
+		{
+			yyVAL.alterSpec = yyDollar[3].alterSpec
+			yyVAL.alterSpec.Action = AddColumnStr
+			yyVAL.alterSpec.Column = yyDollar[2].columnDefinition
+		}
+	case 358:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2316
+		{
+			yyVAL.alterSpec = yyDollar[4].alterSpec
+			yyVAL.alterSpec.Action = AddColumnStr
+			yyVAL.alterSpec.Column = yyDollar[3].columnDefinition
+		}
+	case 359:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2322
+		{
+			yyDollar[3].indexDefinition.Info.setConstraintName(yyDollar[2].colIdent)
+			yyVAL.alterSpec = &AlterSpec{Action: AddIndexStr, Index: yyDollar[3].indexDefinition}
+		}
+	case 360:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2327
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: AddForeignKeyStr, ForeignKey: yyDollar[2].foreignKeyDefinition}
+		}
+	case 361:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2331
+		{
+			yyDollar[3].foreignKeyDefinition.Name = yyDollar[2].colIdent
+			yyVAL.alterSpec = &AlterSpec{Action: AddForeignKeyStr, ForeignKey: yyDollar[3].foreignKeyDefinition}
+		}
+	case 362:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2336
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: AddIndexStr, Index: yyDollar[2].indexDefinition}
+		}
+	case 363:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2340
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropColumnStr, Name: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 364:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2344
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropColumnStr, Name: yyDollar[3].colIdent}
+		}
+	case 365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2348
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropIndexStr, Name: yyDollar[3].colIdent}
+		}
+	case 366:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2352
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropPrimaryKeyStr}
+		}
+	case 367:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2356
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropForeignKeyStr, Name: yyDollar[4].colIdent}
+		}
+	case 368:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2360
+		{
+			yyVAL.alterSpec = yyDollar[4].alterSpec
+			yyVAL.alterSpec.Action = ChangeColumnStr
+			yyVAL.alterSpec.Name = NewColIdent(string(yyDollar[2].bytes))
+			yyVAL.alterSpec.Column = yyDollar[3].columnDefinition
+		}
+	case 369:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2367
+		{
+			yyVAL.alterSpec = yyDollar[5].alterSpec
+			yyVAL.alterSpec.Action = ChangeColumnStr
+			yyVAL.alterSpec.Name = NewColIdent(string(yyDollar[3].bytes))
+			yyVAL.alterSpec.Column = yyDollar[4].columnDefinition
+		}
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2375
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), "modify") {
+				yylex.Error("expecting modify")
+				return 1
+			}
+			yyVAL.alterSpec = yyDollar[3].alterSpec
+			yyVAL.alterSpec.Action = ModifyColumnStr
+			yyVAL.alterSpec.Column = yyDollar[2].columnDefinition
+		}
+	case 371:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2385
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), "modify") {
+				yylex.Error("expecting modify")
+				return 1
+			}
+			yyVAL.alterSpec = yyDollar[4].alterSpec
+			yyVAL.alterSpec.Action = ModifyColumnStr
+			yyVAL.alterSpec.Column = yyDollar[3].columnDefinition
+		}
+	case 372:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2395
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: SetDefaultStr, Name: NewColIdent(string(yyDollar[2].bytes)), Default: yyDollar[5].optVal}
+		}
+	case 373:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2399
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: SetDefaultStr, Name: NewColIdent(string(yyDollar[3].bytes)), Default: yyDollar[6].optVal}
+		}
+	case 374:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2403
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropDefaultStr, Name: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 375:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2407
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropDefaultStr, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 376:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2411
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: RenameColumnStr, Name: NewColIdent(string(yyDollar[3].bytes)), NewName: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 377:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2415
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: RenameIndexStr, Name: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
+		}
+	case 378:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2419
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: ConvertCharsetStr, Value: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 379:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2423
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: ConvertCharsetStr, Value: yyDollar[4].str, Collate: yyDollar[5].str}
+		}
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2427
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: ForceRebuildStr}
+		}
+	case 381:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2432
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: TableOptionStr, Option: strings.ToLower(string(yyDollar[1].bytes)), Value: yyDollar[3].str}
+		}
+	case 382:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2436
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: TableOptionStr, Option: yyDollar[1].str, Value: yyDollar[3].str}
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2442
+		{
+			yyVAL.str = "lock"
+		}
+	case 384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2446
+		{
+			yyVAL.str = "auto_increment"
+		}
+	case 385:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2450
+		{
+			yyVAL.str = "comment"
+		}
+	case 386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2454
+		{
+			yyVAL.str = "key_block_size"
+		}
+	case 387:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2458
+		{
+			yyVAL.str = yyDollar[1].str + "character set"
+		}
+	case 388:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2462
+		{
+			yyVAL.str = yyDollar[1].str + "charset"
+		}
+	case 389:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2466
+		{
+			yyVAL.str = yyDollar[1].str + "collate"
+		}
+	case 390:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2471
+		{
+			yyVAL.str = ""
+		}
+	case 391:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2475
+		{
+			yyVAL.str = "default "
+		}
+	case 392:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2482
+		{
+			yyVAL.alterSpec = &AlterSpec{}
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2486
+		{
+			yyVAL.alterSpec = &AlterSpec{First: true}
+		}
+	case 394:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2490
+		{
+			yyVAL.alterSpec = &AlterSpec{After: yyDollar[2].colIdent}
+		}
+	case 395:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2496
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 396:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2502
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 397:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2506
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 398:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2512
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+		}
+	case 399:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2516
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 400:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2522
+		{
+			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
+		}
+	case 401:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2528
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
+		}
+	case 402:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2536
+		{
+			// Change this to an alter statement
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName, AlterSpecs: []*AlterSpec{{Action: DropIndexStr, Name: NewColIdent(string(yyDollar[3].bytes))}}}
+		}
+	case 403:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2541
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
+		}
+	case 404:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2549
+		{
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 405:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2553
+		{
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 406:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2557
+		{
+			yyVAL.statement = &DropTrigger{IfExists: yyDollar[3].byt != 0, Name: yyDollar[4].tableName}
+		}
+	case 407:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2561
+		{
+			yyVAL.statement = &DropEvent{IfExists: yyDollar[3].byt != 0, Name: yyDollar[4].tableName}
+		}
+	case 408:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2565
+		{
+			yyVAL.statement = &DropRoutine{Type: ProcedureStr, IfExists: yyDollar[3].byt != 0, Name: yyDollar[4].tableName}
+		}
+	case 409:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2569
+		{
+			yyVAL.statement = &DropRoutine{Type: FunctionStr, IfExists: yyDollar[3].byt != 0, Name: yyDollar[4].tableName}
+		}
+	case 410:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2575
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 411:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2579
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 412:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2584
+		{
+			yyVAL.statement = &TableMaintenance{Action: AnalyzeStr, IsLocal: bool(yyDollar[2].boolVal), Tables: yyDollar[4].tableNames}
+		}
+	case 413:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2588
+		{
+			yyVAL.statement = &TableMaintenance{Action: OptimizeStr, IsLocal: bool(yyDollar[2].boolVal), Tables: yyDollar[4].tableNames}
+		}
+	case 414:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2592
+		{
+			if option := invalidMaintenanceOption(RepairStr, yyDollar[5].strs); option != "" {
+				yylex.Error("unexpected option " + option + " for repair")
+				return 1
+			}
+			yyVAL.statement = &TableMaintenance{Action: RepairStr, IsLocal: bool(yyDollar[2].boolVal), Tables: yyDollar[4].tableNames, Options: yyDollar[5].strs}
+		}
+	case 415:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2600
+		{
+			if option := invalidMaintenanceOption(CheckStr, yyDollar[4].strs); option != "" {
+				yylex.Error("unexpected option " + option + " for check")
+				return 1
+			}
+			yyVAL.statement = &TableMaintenance{Action: CheckStr, Tables: yyDollar[3].tableNames, Options: yyDollar[4].strs}
+		}
+	case 416:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2608
+		{
+			if option := invalidMaintenanceOption(ChecksumStr, yyDollar[4].strs); option != "" {
+				yylex.Error("unexpected option " + option + " for checksum")
+				return 1
+			}
+			yyVAL.statement = &TableMaintenance{Action: ChecksumStr, Tables: yyDollar[3].tableNames, Options: yyDollar[4].strs}
+		}
+	case 417:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2616
+		{
+			for _, table := range yyDollar[3].indexCacheTables {
+				if table.IgnoreLeaves {
+					yylex.Error("unexpected ignore leaves for cache index")
+					return 1
+				}
+			}
+			yyVAL.statement = &IndexCache{Action: CacheIndexStr, Tables: yyDollar[3].indexCacheTables, KeyCache: yyDollar[5].colIdent}
+		}
+	case 418:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2626
+		{
+			yyVAL.statement = &IndexCache{Action: LoadIndexStr, Tables: yyDollar[5].indexCacheTables}
+		}
+	case 419:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2632
+		{
+			yyVAL.indexCacheTables = IndexCacheTables{yyDollar[1].indexCacheTable}
+		}
+	case 420:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2636
+		{
+			yyVAL.indexCacheTables = append(yyDollar[1].indexCacheTables, yyDollar[3].indexCacheTable)
+		}
+	case 421:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2642
+		{
+			yyVAL.indexCacheTable = &IndexCacheTable{Table: yyDollar[1].tableName, Partitions: yyDollar[2].partitions, Indexes: yyDollar[3].colIdents, IgnoreLeaves: bool(yyDollar[4].boolVal)}
+		}
+	case 422:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2646
+		{
+			yyVAL.indexCacheTable = &IndexCacheTable{Table: yyDollar[1].tableName, AllPartitions: true, Indexes: yyDollar[6].colIdents, IgnoreLeaves: bool(yyDollar[7].boolVal)}
+		}
+	case 423:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2651
+		{
+			yyVAL.colIdents = nil
+		}
+	case 424:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2655
+		{
+			yyVAL.colIdents = yyDollar[3].columns
+		}
+	case 425:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2659
+		{
+			yyVAL.colIdents = yyDollar[3].columns
+		}
+	case 426:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2664
+		{
+			yyVAL.boolVal = false
+		}
+	case 427:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2668
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "leaves" {
+				yylex.Error("expecting leaves after ignore")
+				return 1
+			}
+			yyVAL.boolVal = true
+		}
+	case 428:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2677
+		{
+		}
+	case 429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2678
+		{
+		}
+	case 430:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2681
+		{
+			yyVAL.strs = nil
+		}
+	case 431:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2685
+		{
+			yyVAL.strs = yyDollar[1].strs
+		}
+	case 432:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2691
+		{
+			yyVAL.strs = []string{yyDollar[1].str}
+		}
+	case 433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2695
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
+		}
+	case 434:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2701
+		{
+			yyVAL.str = strings.ToLower(string(yyDollar[1].bytes))
+		}
+	case 435:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2705
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 436:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2709
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2713
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "upgrade" {
+				yylex.Error("expecting upgrade after for")
+				return 1
+			}
+			yyVAL.str = "for upgrade"
+		}
+	case 438:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2723
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 439:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2727
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 440:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2731
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 441:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2735
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 442:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2739
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 443:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2744
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 444:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2748
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 445:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2752
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 446:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2756
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 447:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2760
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 448:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2764
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 449:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2768
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 450:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2772
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 451:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2776
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 452:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2780
 		{
-			yyVAL.boolVal = BoolVal(false)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 141:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:927
+	case 453:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2784
 		{
-			yyVAL.boolVal = BoolVal(false)
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 142:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:931
+	case 454:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2788
 		{
-			yyVAL.boolVal = BoolVal(true)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 143:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:936
+	case 455:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2792
 		{
-			yyVAL.optVal = nil
+			// this is ugly, but I couldn't find a better way for now
+			if yyDollar[4].str == "processlist" {
+				yyVAL.statement = &Show{Type: yyDollar[4].str}
+			} else {
+				showTablesOpt := &ShowTablesOpt{Extended: yyDollar[2].str, Full: yyDollar[3].str, DbName: yyDollar[5].str, Filter: yyDollar[6].showFilter}
+				yyVAL.statement = &Show{Type: yyDollar[4].str, ShowTablesOpt: showTablesOpt}
+			}
 		}
-	case 144:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:940
+	case 456:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2802
 		{
-			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 145:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:944
+//line sql.y:2806
 		{
-			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 146:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:948
+	case 458:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2810
 		{
-			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
 		}
-	case 147:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:952
+//line sql.y:2814
 		{
-			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 148:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:956
+//line sql.y:2818
 		{
-			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 149:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:960
+//line sql.y:2822
 		{
-			yyVAL.optVal = NewBitVal(yyDollar[2].bytes)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 150:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:965
+	case 462:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2826
 		{
-			yyVAL.optVal = nil
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 151:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:969
+//line sql.y:2836
 		{
-			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 152:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:974
+	case 464:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2842
 		{
-			yyVAL.boolVal = BoolVal(false)
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 153:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:978
+//line sql.y:2846
 		{
-			yyVAL.boolVal = BoolVal(true)
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 154:
+	case 466:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:983
+//line sql.y:2852
 		{
 			yyVAL.str = ""
 		}
-	case 155:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:987
-		{
-			yyVAL.str = string(yyDollar[3].bytes)
-		}
-	case 156:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:991
+	case 467:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2856
 		{
-			yyVAL.str = string(yyDollar[3].bytes)
+			yyVAL.str = "extended "
 		}
-	case 157:
+	case 468:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:996
+//line sql.y:2862
 		{
 			yyVAL.str = ""
 		}
-	case 158:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1000
+	case 469:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2866
 		{
-			yyVAL.str = string(yyDollar[2].bytes)
+			yyVAL.str = "full "
 		}
-	case 159:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1005
+//line sql.y:2872
 		{
-			yyVAL.colKeyOpt = colKeyNone
+			yyVAL.str = ""
 		}
-	case 160:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1009
-		{
-			yyVAL.colKeyOpt = colKeyPrimary
-		}
-	case 161:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1013
+//line sql.y:2876
 		{
-			yyVAL.colKeyOpt = colKey
+			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 162:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1017
+//line sql.y:2880
 		{
-			yyVAL.colKeyOpt = colKeyUniqueKey
+			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 163:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1021
+	case 473:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2886
 		{
-			yyVAL.colKeyOpt = colKeyUnique
+			yyVAL.showFilter = nil
 		}
-	case 164:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1026
+	case 474:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2890
 		{
-			yyVAL.optVal = nil
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 165:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1030
+//line sql.y:2894
 		{
-			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 166:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1036
+	case 476:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2900
 		{
-			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+			yyVAL.str = ""
 		}
-	case 167:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1040
+	case 477:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2904
 		{
-			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+			yyVAL.str = SessionStr
 		}
-	case 168:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1046
+//line sql.y:2908
 		{
-			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+			yyVAL.str = GlobalStr
 		}
-	case 169:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1050
+//line sql.y:2914
 		{
-			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 170:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1056
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2918
 		{
-			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 171:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1060
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2924
 		{
-			// should not be string
-			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+			yyVAL.statement = &Begin{}
 		}
-	case 172:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1065
+//line sql.y:2928
 		{
-			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+			yyVAL.statement = &Begin{}
 		}
-	case 173:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1071
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2934
 		{
-			yyVAL.str = ""
+			yyVAL.statement = &Commit{}
 		}
-	case 174:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1075
+//line sql.y:2940
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.statement = &Rollback{}
 		}
-	case 175:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1081
+//line sql.y:2946
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+			yyVAL.statement = &Do{Exprs: yyDollar[2].exprs}
 		}
-	case 176:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1085
-		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
+	case 486:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2952
+		{
+			switch strings.ToLower(string(yyDollar[3].bytes)) {
+			case HandlerOpenStr:
+				yyVAL.statement = &Handler{Action: HandlerOpenStr, Table: yyDollar[2].tableName, As: yyDollar[4].tableIdent}
+			case HandlerCloseStr:
+				if !yyDollar[4].tableIdent.IsEmpty() {
+					yylex.Error("syntax error")
+					return 1
+				}
+				yyVAL.statement = &Handler{Action: HandlerCloseStr, Table: yyDollar[2].tableName}
+			default:
+				yylex.Error("expecting open or close after handler table")
+				return 1
+			}
 		}
-	case 177:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1089
+	case 487:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2968
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
+			yyVAL.statement = &Handler{Action: HandlerReadStr, Table: yyDollar[2].tableName, Index: yyDollar[4].colIdent, Operator: yyDollar[5].str, Values: yyDollar[6].valTuple, Where: NewWhere(WhereStr, yyDollar[7].expr), Limit: yyDollar[8].limit}
 		}
-	case 178:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1093
+	case 488:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2972
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
+			yyVAL.statement = &Handler{Action: HandlerReadStr, Table: yyDollar[2].tableName, Index: yyDollar[4].colIdent, Operator: yyDollar[5].str, Where: NewWhere(WhereStr, yyDollar[6].expr), Limit: yyDollar[7].limit}
 		}
-	case 179:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1097
+	case 489:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2976
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
+			yyVAL.statement = &Handler{Action: HandlerReadStr, Table: yyDollar[2].tableName, Operator: yyDollar[4].str, Where: NewWhere(WhereStr, yyDollar[5].expr), Limit: yyDollar[6].limit}
 		}
-	case 180:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1103
+//line sql.y:2982
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			switch yyDollar[1].colIdent.Lowered() {
+			case HandlerFirstStr, HandlerPrevStr, HandlerLastStr:
+				yyVAL.str = yyDollar[1].colIdent.Lowered()
+			default:
+				yylex.Error("expecting first, next, prev or last")
+				return 1
+			}
 		}
-	case 181:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1107
+//line sql.y:2992
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = HandlerNextStr
 		}
-	case 182:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1113
+	case 492:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2998
 		{
-			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+			yyVAL.statement = &Flush{IsLocal: bool(yyDollar[2].boolVal), FlushOptions: yyDollar[3].strs}
 		}
-	case 183:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1117
+//line sql.y:3002
 		{
-			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+			yyVAL.statement = &Flush{IsLocal: bool(yyDollar[2].boolVal)}
 		}
-	case 184:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1123
+	case 494:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3006
 		{
-			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
+			yyVAL.statement = &Flush{IsLocal: bool(yyDollar[2].boolVal), TableNames: yyDollar[4].tableNames}
 		}
-	case 185:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1128
+	case 495:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3010
 		{
-			yyVAL.str = ""
+			yyVAL.statement = &Flush{IsLocal: bool(yyDollar[2].boolVal), WithLock: true}
 		}
-	case 186:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1132
+	case 496:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3014
 		{
-			yyVAL.str = " " + string(yyDollar[1].str)
+			yyVAL.statement = &Flush{IsLocal: bool(yyDollar[2].boolVal), TableNames: yyDollar[4].tableNames, WithLock: true}
 		}
-	case 187:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1136
+	case 497:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3018
+		{
+			if strings.ToLower(string(yyDollar[6].bytes)) != "export" {
+				yylex.Error("expecting export after for")
+				return 1
+			}
+			yyVAL.statement = &Flush{IsLocal: bool(yyDollar[2].boolVal), TableNames: yyDollar[4].tableNames, ForExport: true}
+		}
+	case 498:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3027
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 499:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3031
 		{
-			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+			yyVAL.boolVal = BoolVal(true)
 		}
-	case 188:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1144
+//line sql.y:3035
 		{
-			yyVAL.str = yyDollar[1].str
+			yyVAL.boolVal = BoolVal(true)
 		}
-	case 189:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1148
+	case 501:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3041
 		{
-			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 190:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1152
-		{
-			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
-		}
-	case 191:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1158
+//line sql.y:3045
 		{
-			yyVAL.str = yyDollar[1].colIdent.String()
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 192:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1162
+//line sql.y:3051
 		{
-			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+			yyVAL.str = strings.ToLower(string(yyDollar[1].bytes))
 		}
-	case 193:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1166
+//line sql.y:3055
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 194:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1172
+	case 505:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3059
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.str = string(yyDollar[1].bytes) + " " + strings.ToLower(string(yyDollar[2].bytes))
 		}
-	case 195:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1176
+	case 506:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3063
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.str = strings.ToLower(string(yyDollar[1].bytes)) + " " + strings.ToLower(string(yyDollar[2].bytes))
 		}
-	case 196:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1180
+	case 507:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3069
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.statement = &Prepare{Name: yyDollar[2].colIdent, Stmt: yyDollar[4].expr}
 		}
-	case 197:
-		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1184
-		{
-			yyVAL.statement = &DDL{
-				Action: AddColVindexStr,
-				Table:  yyDollar[4].tableName,
-				VindexSpec: &VindexSpec{
-					Name:   yyDollar[7].colIdent,
-					Type:   yyDollar[11].colIdent,
-					Params: yyDollar[12].vindexParams,
-				},
-				VindexCols: yyDollar[9].columns,
-			}
+	case 508:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3077
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 198:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1197
-		{
-			yyVAL.statement = &DDL{
-				Action: DropColVindexStr,
-				Table:  yyDollar[4].tableName,
-				VindexSpec: &VindexSpec{
-					Name: yyDollar[7].colIdent,
-				},
-			}
+	case 509:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3081
+		{
+			yyVAL.expr = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 199:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1207
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3085
 		{
-			// Change this to a rename statement
-			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
+			yyVAL.expr = yyDollar[1].expr
 		}
-	case 200:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1212
+	case 511:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3091
 		{
-			// Rename an index can just be an alter
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.statement = &Execute{Name: yyDollar[2].colIdent, Using: yyDollar[3].colIdents}
 		}
-	case 201:
+	case 512:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1217
+//line sql.y:3095
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
+			yyVAL.statement = &Execute{Immediate: yyDollar[3].expr, Using: yyDollar[4].colIdents}
 		}
-	case 202:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1221
+	case 513:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3100
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
+			yyVAL.colIdents = nil
 		}
-	case 214:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1240
+	case 514:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3104
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+			yyVAL.colIdents = yyDollar[2].colIdents
 		}
-	case 215:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1246
+//line sql.y:3110
 		{
-			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
 		}
-	case 216:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1250
+//line sql.y:3114
 		{
-			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+			yyVAL.colIdents = append(yyDollar[1].colIdents, yyDollar[3].colIdent)
 		}
-	case 217:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1256
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3120
 		{
-			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+			if len(yyDollar[1].bytes) < 2 || yyDollar[1].bytes[0] != '@' || yyDollar[1].bytes[1] == '@' {
+				yylex.Error("expecting a user variable")
+				return 1
+			}
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 218:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1260
+	case 518:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3130
 		{
-			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+			yyVAL.statement = &Deallocate{Name: yyDollar[3].colIdent}
 		}
-	case 219:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1266
+	case 519:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3134
 		{
-			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
+			yyVAL.statement = &Deallocate{Name: yyDollar[3].colIdent}
 		}
-	case 220:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1272
+	case 520:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3140
 		{
-			var exists bool
-			if yyDollar[3].byt != 0 {
-				exists = true
-			}
-			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
+			yyVAL.statement = &Kill{ProcesslistID: yyDollar[2].expr}
 		}
-	case 221:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1280
+	case 521:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3144
 		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
+			yyVAL.statement = &Kill{Type: KillQueryStr, ProcesslistID: yyDollar[3].expr}
 		}
-	case 222:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1285
+	case 522:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3148
 		{
-			var exists bool
-			if yyDollar[3].byt != 0 {
-				exists = true
+			if strings.ToLower(string(yyDollar[2].bytes)) != KillConnectionStr {
+				yylex.Error("expecting connection or query after kill")
+				return 1
 			}
-			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
+			yyVAL.statement = &Kill{Type: KillConnectionStr, ProcesslistID: yyDollar[3].expr}
 		}
-	case 223:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1293
-		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
-		}
-	case 224:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1297
+	case 523:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3158
 		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.statement = newReplication(string(yyDollar[1].bytes), string(yyDollar[1].bytes)+" "+string(yyDollar[2].bytes)+" "+yyDollar[3].str)
 		}
-	case 225:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1303
+//line sql.y:3162
 		{
-			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+			yyVAL.statement = newReplication(string(yyDollar[1].bytes), string(yyDollar[1].bytes)+" "+string(yyDollar[2].bytes)+" "+yyDollar[3].str)
 		}
-	case 226:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1307
+	case 532:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3180
 		{
-			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+			yyVAL.str = scanRest(yylex)
 		}
-	case 227:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1312
+	case 533:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3186
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
+			yyVAL.statement = &XATransaction{Action: XAStartStr, Xid: yyDollar[3].xid, Option: yyDollar[4].str}
 		}
-	case 228:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1318
+//line sql.y:3190
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.statement = &XATransaction{Action: XAStartStr, Xid: yyDollar[3].xid, Option: yyDollar[4].str}
 		}
-	case 229:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1322
+//line sql.y:3194
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.statement = &XATransaction{Action: XAEndStr, Xid: yyDollar[3].xid, Option: yyDollar[4].str}
 		}
-	case 230:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1326
+	case 536:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3198
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.statement = &XATransaction{Action: XAPrepareStr, Xid: yyDollar[3].xid}
 		}
-	case 231:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1331
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3202
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yylex.Error("expecting start, end, prepare, commit, rollback or recover after xa")
+			return 1
 		}
-	case 232:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1335
+//line sql.y:3207
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.statement = &XATransaction{Action: XACommitStr, Xid: yyDollar[3].xid, OnePhase: bool(yyDollar[4].boolVal)}
 		}
-	case 233:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1339
+	case 539:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3211
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.statement = &XATransaction{Action: XARollbackStr, Xid: yyDollar[3].xid}
 		}
-	case 234:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1343
+	case 540:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3215
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			if strings.ToLower(string(yyDollar[2].bytes)) != XARecoverStr {
+				yylex.Error("expecting start, end, prepare, commit, rollback or recover after xa")
+				return 1
+			}
+			yyVAL.statement = &XATransaction{Action: XARecoverStr}
 		}
-	case 235:
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1347
+//line sql.y:3223
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			if strings.ToLower(string(yyDollar[2].bytes)) != XARecoverStr || strings.ToLower(string(yyDollar[4].bytes)) != "xid" {
+				yylex.Error("expecting recover convert xid after xa")
+				return 1
+			}
+			yyVAL.statement = &XATransaction{Action: XARecoverStr, Option: XAConvertXidStr}
 		}
-	case 236:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1351
+	case 542:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3233
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.xid = &Xid{Gtrid: yyDollar[1].optVal}
 		}
-	case 237:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1355
+//line sql.y:3237
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.xid = &Xid{Gtrid: yyDollar[1].optVal, Bqual: yyDollar[3].optVal}
 		}
-	case 238:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1359
+	case 544:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3241
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.xid = &Xid{Gtrid: yyDollar[1].optVal, Bqual: yyDollar[3].optVal, FormatID: NewIntVal(yyDollar[5].bytes)}
 		}
-	case 239:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1363
+	case 545:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3245
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.xid = &Xid{Gtrid: yyDollar[1].optVal, Bqual: yyDollar[3].optVal, FormatID: NewHexNum(yyDollar[5].bytes)}
 		}
-	case 240:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1367
+	case 546:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3251
 		{
-			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+			yyVAL.optVal = NewStrVal(yyDollar[1].bytes)
 		}
-	case 241:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1371
+	case 547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3255
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.optVal = NewHexVal(yyDollar[1].bytes)
 		}
-	case 242:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1375
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3259
 		{
-			// this is ugly, but I couldn't find a better way for now
-			if yyDollar[4].str == "processlist" {
-				yyVAL.statement = &Show{Type: yyDollar[4].str}
-			} else {
-				showTablesOpt := &ShowTablesOpt{Extended: yyDollar[2].str, Full: yyDollar[3].str, DbName: yyDollar[5].str, Filter: yyDollar[6].showFilter}
-				yyVAL.statement = &Show{Type: yyDollar[4].str, ShowTablesOpt: showTablesOpt}
-			}
+			yyVAL.optVal = NewHexNum(yyDollar[1].bytes)
 		}
-	case 243:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1385
+	case 549:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3264
 		{
-			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+			yyVAL.str = ""
 		}
-	case 244:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1389
+	case 550:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3268
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.str = XAJoinStr
 		}
-	case 245:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1393
+	case 551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3272
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
+			if strings.ToLower(string(yyDollar[1].bytes)) != XAResumeStr {
+				yylex.Error("expecting join or resume")
+				return 1
+			}
+			yyVAL.str = XAResumeStr
 		}
-	case 246:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1397
+	case 552:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3281
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.str = ""
 		}
-	case 247:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1401
+	case 553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3285
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			if strings.ToLower(string(yyDollar[1].bytes)) != XASuspendStr {
+				yylex.Error("expecting suspend")
+				return 1
+			}
+			yyVAL.str = XASuspendStr
+		}
+	case 554:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3293
+		{
+			if strings.ToLower(string(yyDollar[1].bytes)) != XASuspendStr || strings.ToLower(string(yyDollar[3].bytes)) != "migrate" {
+				yylex.Error("expecting suspend for migrate")
+				return 1
+			}
+			yyVAL.str = XASuspendForMigrateStr
 		}
-	case 248:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1405
+	case 555:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3302
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.boolVal = BoolVal(false)
 		}
-	case 249:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1409
+//line sql.y:3306
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			if strings.ToLower(string(yyDollar[1].bytes)) != "one" || strings.ToLower(string(yyDollar[2].bytes)) != "phase" {
+				yylex.Error("expecting one phase")
+				return 1
+			}
+			yyVAL.boolVal = BoolVal(true)
 		}
-	case 250:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1419
+//line sql.y:3316
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableLocks}
 		}
-	case 251:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1425
+	case 558:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3320
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableLocks}
 		}
-	case 252:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1429
+//line sql.y:3326
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.tableLocks = TableLocks{yyDollar[1].tableLock}
 		}
-	case 253:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1435
+	case 560:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3330
 		{
-			yyVAL.str = ""
+			yyVAL.tableLocks = append(yyDollar[1].tableLocks, yyDollar[3].tableLock)
 		}
-	case 254:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1439
+	case 561:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3336
 		{
-			yyVAL.str = "extended "
+			yyVAL.tableLock = &TableLock{Table: yyDollar[1].tableName, Lock: yyDollar[2].str}
 		}
-	case 255:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1445
+	case 562:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3340
 		{
-			yyVAL.str = ""
+			yyVAL.tableLock = &TableLock{Table: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Lock: yyDollar[3].str}
 		}
-	case 256:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1449
+	case 563:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3344
 		{
-			yyVAL.str = "full "
+			yyVAL.tableLock = &TableLock{Table: yyDollar[1].tableName, As: yyDollar[3].tableIdent, Lock: yyDollar[4].str}
 		}
-	case 257:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1455
+	case 564:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3350
 		{
-			yyVAL.str = ""
+			yyVAL.str = LockReadStr
 		}
-	case 258:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1459
+//line sql.y:3354
 		{
-			yyVAL.str = yyDollar[2].tableIdent.v
+			yyVAL.str = LockReadLocalStr
 		}
-	case 259:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1463
+	case 566:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3358
 		{
-			yyVAL.str = yyDollar[2].tableIdent.v
+			yyVAL.str = LockWriteStr
 		}
-	case 260:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1469
+	case 567:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3362
 		{
-			yyVAL.showFilter = nil
+			yyVAL.str = LockLowPriorityWriteStr
 		}
-	case 261:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1473
+//line sql.y:3368
 		{
-			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+			yyVAL.statement = &UnlockTables{}
 		}
-	case 262:
+	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1477
+//line sql.y:3372
 		{
-			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+			yyVAL.statement = &UnlockTables{}
 		}
-	case 263:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1483
+	case 570:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3378
 		{
-			yyVAL.str = ""
+			yyVAL.statement = &Call{Name: yyDollar[2].tableName, Params: yyDollar[3].exprs}
 		}
-	case 264:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1487
+	case 571:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3382
 		{
-			yyVAL.str = SessionStr
+			yyVAL.statement = &Call{Name: yyDollar[3].tableName, Params: yyDollar[4].exprs, ODBC: true}
 		}
-	case 265:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1491
+	case 572:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3387
 		{
-			yyVAL.str = GlobalStr
+			yyVAL.exprs = nil
 		}
-	case 266:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1497
+//line sql.y:3391
 		{
-			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
+			yyVAL.exprs = nil
 		}
-	case 267:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1501
+	case 574:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3395
 		{
-			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
+			yyVAL.exprs = yyDollar[2].exprs
 		}
-	case 268:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1507
+	case 575:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3401
 		{
-			yyVAL.statement = &Begin{}
+			yyVAL.statement = &DescribeTable{Verb: yyDollar[1].str, Table: yyDollar[2].tableName}
 		}
-	case 269:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1511
+	case 576:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3405
 		{
-			yyVAL.statement = &Begin{}
+			yyVAL.statement = &DescribeTable{Verb: yyDollar[1].str, Table: yyDollar[2].tableName, Column: yyDollar[3].colIdent}
 		}
-	case 270:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1517
+	case 577:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3409
 		{
-			yyVAL.statement = &Commit{}
+			yyVAL.statement = &DescribeTable{Verb: yyDollar[1].str, Table: yyDollar[2].tableName, Wild: string(yyDollar[3].bytes)}
 		}
-	case 271:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1523
+	case 578:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3413
 		{
-			yyVAL.statement = &Rollback{}
+			yyVAL.statement = &OtherRead{}
 		}
-	case 272:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1529
+	case 579:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3417
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 273:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1533
+	case 580:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3422
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 274:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1537
+	case 581:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3426
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 275:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1541
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3432
 		{
-			yyVAL.statement = &OtherAdmin{}
+			yyVAL.str = DescStr
 		}
-	case 276:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1545
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3436
 		{
-			yyVAL.statement = &OtherAdmin{}
+			yyVAL.str = DescribeStr
 		}
-	case 277:
+	case 584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3440
+		{
+			yyVAL.str = ExplainStr
+		}
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1550
+//line sql.y:3459
 		{
 			setAllowComments(yylex, true)
 		}
-	case 278:
+	case 596:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1554
+//line sql.y:3463
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 279:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1560
+//line sql.y:3469
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 280:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1564
+//line sql.y:3473
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 281:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1570
+//line sql.y:3479
 		{
 			yyVAL.str = UnionStr
 		}
-	case 282:
+	case 600:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1574
+//line sql.y:3483
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 283:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1578
+//line sql.y:3487
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 284:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1583
+//line sql.y:3492
 		{
-			yyVAL.str = ""
+			yyVAL.selectOptions = nil
 		}
-	case 285:
+	case 603:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3496
+		{
+			for _, opt := range yyDollar[1].selectOptions {
+				if opt.same() == yyDollar[2].selectOption.same() || opt.conflicts(yyDollar[2].selectOption) {
+					yylex.Error("incorrect usage of " + opt.String() + " and " + yyDollar[2].selectOption.String())
+					return 1
+				}
+			}
+			yyVAL.selectOptions = append(yyDollar[1].selectOptions, yyDollar[2].selectOption)
+		}
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1587
+//line sql.y:3508
 		{
-			yyVAL.str = SQLNoCacheStr
+			yyVAL.selectOption = SelectAll
 		}
-	case 286:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1591
+//line sql.y:3512
 		{
-			yyVAL.str = SQLCacheStr
+			yyVAL.selectOption = SelectDistinct
 		}
-	case 287:
+	case 606:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3516
+		{
+			yyVAL.selectOption = SelectDistinctRow
+		}
+	case 607:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3520
+		{
+			yyVAL.selectOption = SelectHighPriority
+		}
+	case 608:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3524
+		{
+			yyVAL.selectOption = SelectStraightJoin
+		}
+	case 609:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3528
+		{
+			yyVAL.selectOption = SelectSmallResult
+		}
+	case 610:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3532
+		{
+			yyVAL.selectOption = SelectBigResult
+		}
+	case 611:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3536
+		{
+			yyVAL.selectOption = SelectBufferResult
+		}
+	case 612:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3540
+		{
+			yyVAL.selectOption = SelectCache
+		}
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3544
+		{
+			yyVAL.selectOption = SelectNoCache
+		}
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3548
+		{
+			yyVAL.selectOption = SelectCalcFoundRows
+		}
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1596
+//line sql.y:3553
 		{
 			yyVAL.str = ""
 		}
-	case 288:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1600
+//line sql.y:3557
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 289:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1605
+//line sql.y:3562
 		{
-			yyVAL.str = ""
+			yyVAL.limit = nil
 		}
-	case 290:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1609
+	case 618:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3566
 		{
-			yyVAL.str = StraightJoinHint
+			yyVAL.limit = &Limit{Rowcount: NewIntVal(yyDollar[2].bytes)}
 		}
-	case 291:
+	case 619:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3570
+		{
+			yyVAL.limit = &Limit{Rowcount: NewIntVal(yyDollar[2].bytes), Percent: true}
+		}
+	case 620:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3574
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[3].expr}
+		}
+	case 621:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3578
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[3].expr, Percent: true}
+		}
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1614
+//line sql.y:3583
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 292:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1618
+//line sql.y:3587
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 293:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1624
+//line sql.y:3593
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 294:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1628
+//line sql.y:3597
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 295:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1634
+//line sql.y:3603
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 296:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1638
+//line sql.y:3607
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 297:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1642
+//line sql.y:3611
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 298:
+	case 629:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1646
+//line sql.y:3615
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 299:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1651
+//line sql.y:3620
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 300:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+//line sql.y:3624
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 301:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1659
+//line sql.y:3628
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 303:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1666
+//line sql.y:3635
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 304:
+	case 635:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1671
+//line sql.y:3640
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 305:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1675
+//line sql.y:3644
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 306:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1681
+//line sql.y:3650
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 307:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1685
+//line sql.y:3654
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 310:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1695
+//line sql.y:3664
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 311:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1699
+//line sql.y:3668
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 312:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1703
+//line sql.y:3672
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 313:
+	case 644:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3676
+		{
+			// ODBC outer join escape.
+			if strings.ToLower(string(yyDollar[2].bytes)) != "oj" {
+				yylex.Error("expecting oj")
+				return 1
+			}
+			yyVAL.tableExpr = &ParenTableExpr{Exprs: TableExprs{yyDollar[3].tableExpr}, ODBC: true}
+		}
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1709
+//line sql.y:3687
 		{
-			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintList}
 		}
-	case 314:
+	case 646:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1713
+//line sql.y:3691
 		{
-			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
+			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintList}
 		}
-	case 315:
+	case 647:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3695
+		{
+			// The partitions are also accepted after the index hints.
+			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[6].partitions, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintList}
+		}
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1719
+//line sql.y:3702
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 316:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1723
+//line sql.y:3706
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 317:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1729
+//line sql.y:3712
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 318:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1733
+//line sql.y:3716
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 319:
+	case 652:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1746
+//line sql.y:3729
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			yyDollar[2].joinTableExpr.LeftExpr, yyDollar[2].joinTableExpr.RightExpr, yyDollar[2].joinTableExpr.Condition = yyDollar[1].tableExpr, yyDollar[3].tableExpr, yyDollar[4].joinCondition
+			yyVAL.tableExpr = yyDollar[2].joinTableExpr
 		}
-	case 320:
+	case 653:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1750
+//line sql.y:3734
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 321:
+	case 654:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1754
+//line sql.y:3738
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 322:
+	case 655:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1758
+//line sql.y:3742
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			yyDollar[2].joinTableExpr.LeftExpr, yyDollar[2].joinTableExpr.RightExpr, yyDollar[2].joinTableExpr.Condition = yyDollar[1].tableExpr, yyDollar[3].tableExpr, yyDollar[4].joinCondition
+			yyVAL.tableExpr = yyDollar[2].joinTableExpr
 		}
-	case 323:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1762
+//line sql.y:3747
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+			yyDollar[2].joinTableExpr.LeftExpr, yyDollar[2].joinTableExpr.RightExpr = yyDollar[1].tableExpr, yyDollar[3].tableExpr
+			yyVAL.tableExpr = yyDollar[2].joinTableExpr
 		}
-	case 324:
+	case 657:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1768
+//line sql.y:3754
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 325:
+	case 658:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1770
+//line sql.y:3756
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 326:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1774
+//line sql.y:3760
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 327:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1776
+//line sql.y:3762
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 328:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1780
+//line sql.y:3766
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 329:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1782
+//line sql.y:3768
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 330:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1785
+//line sql.y:3771
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 331:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1787
+//line sql.y:3773
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 332:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1790
+//line sql.y:3776
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 333:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1794
+//line sql.y:3780
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 334:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1798
+//line sql.y:3784
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 336:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1805
+//line sql.y:3791
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 337:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1811
+//line sql.y:3797
 		{
-			yyVAL.str = JoinStr
+			yyVAL.joinTableExpr = &JoinTableExpr{Join: JoinStr}
 		}
-	case 338:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1815
+//line sql.y:3801
 		{
-			yyVAL.str = JoinStr
+			yyVAL.joinTableExpr = &JoinTableExpr{Join: JoinStr, Inner: true}
 		}
-	case 339:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1821
+//line sql.y:3807
 		{
 			yyVAL.str = CrossJoinStr
 		}
-	case 340:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1827
+//line sql.y:3813
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 341:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1833
+//line sql.y:3819
 		{
-			yyVAL.str = LeftJoinStr
+			yyVAL.joinTableExpr = &JoinTableExpr{Join: LeftJoinStr}
 		}
-	case 342:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1837
+//line sql.y:3823
 		{
-			yyVAL.str = LeftJoinStr
+			yyVAL.joinTableExpr = &JoinTableExpr{Join: LeftJoinStr, Outer: true}
 		}
-	case 343:
+	case 676:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1841
+//line sql.y:3827
 		{
-			yyVAL.str = RightJoinStr
+			yyVAL.joinTableExpr = &JoinTableExpr{Join: RightJoinStr}
 		}
-	case 344:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1845
+//line sql.y:3831
 		{
-			yyVAL.str = RightJoinStr
+			yyVAL.joinTableExpr = &JoinTableExpr{Join: RightJoinStr, Outer: true}
 		}
-	case 345:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1851
+//line sql.y:3837
 		{
-			yyVAL.str = NaturalJoinStr
+			yyDollar[2].joinTableExpr.Join = NaturalJoinStr
+			yyVAL.joinTableExpr = yyDollar[2].joinTableExpr
 		}
-	case 346:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1855
+//line sql.y:3842
 		{
-			if yyDollar[2].str == LeftJoinStr {
-				yyVAL.str = NaturalLeftJoinStr
+			if yyDollar[2].joinTableExpr.Join == LeftJoinStr {
+				yyDollar[2].joinTableExpr.Join = NaturalLeftJoinStr
 			} else {
-				yyVAL.str = NaturalRightJoinStr
+				yyDollar[2].joinTableExpr.Join = NaturalRightJoinStr
 			}
+			yyVAL.joinTableExpr = yyDollar[2].joinTableExpr
 		}
-	case 347:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1865
+//line sql.y:3853
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 348:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1869
+//line sql.y:3857
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 349:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1875
+//line sql.y:3863
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 350:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1879
+//line sql.y:3867
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 351:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1884
+//line sql.y:3872
 		{
-			yyVAL.indexHints = nil
+			yyVAL.indexHintList = nil
 		}
-	case 352:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1888
+	case 685:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3876
 		{
-			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
+			yyVAL.indexHintList = yyDollar[1].indexHintList
 		}
-	case 353:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1892
+	case 686:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3882
 		{
-			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
+			yyVAL.indexHintList = IndexHintList{yyDollar[1].indexHints}
 		}
-	case 354:
+	case 687:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3886
+		{
+			yyVAL.indexHintList = append(yyDollar[1].indexHintList, yyDollar[2].indexHints)
+		}
+	case 688:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1896
+//line sql.y:3892
 		{
-			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Keyword: strings.ToLower(yyDollar[2].str), For: yyDollar[3].str}
 		}
-	case 355:
+	case 689:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3896
+		{
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Keyword: strings.ToLower(yyDollar[2].str), For: yyDollar[3].str, Indexes: yyDollar[5].columns}
+		}
+	case 690:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3900
+		{
+			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Keyword: strings.ToLower(yyDollar[2].str), For: yyDollar[3].str, Indexes: yyDollar[5].columns}
+		}
+	case 691:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3904
+		{
+			yyVAL.indexHints = &IndexHints{Type: ForceStr, Keyword: strings.ToLower(yyDollar[2].str), For: yyDollar[3].str, Indexes: yyDollar[5].columns}
+		}
+	case 692:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3909
+		{
+			yyVAL.str = ""
+		}
+	case 693:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3913
+		{
+			yyVAL.str = ForJoinStr
+		}
+	case 694:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3917
+		{
+			yyVAL.str = ForOrderByStr
+		}
+	case 695:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3921
+		{
+			yyVAL.str = ForGroupByStr
+		}
+	case 696:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1901
+//line sql.y:3926
 		{
 			yyVAL.expr = nil
 		}
-	case 356:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1905
+//line sql.y:3930
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 357:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1911
+//line sql.y:3936
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 358:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1915
+//line sql.y:3940
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 359:
+	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1919
+//line sql.y:3944
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 360:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1923
+//line sql.y:3948
 		{
-			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+			yyVAL.expr = newNotExpr(yyDollar[2].expr)
 		}
-	case 361:
+	case 702:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1927
+//line sql.y:3952
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 362:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1931
+//line sql.y:3956
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 363:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1935
-		{
-			yyVAL.expr = &Default{ColName: yyDollar[2].str}
-		}
-	case 364:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1941
-		{
-			yyVAL.str = ""
-		}
-	case 365:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1945
+	case 704:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3960
 		{
-			yyVAL.str = string(yyDollar[2].bytes)
+			yyVAL.expr = &Default{}
 		}
-	case 366:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1951
+//line sql.y:3966
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 367:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1955
+//line sql.y:3970
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 368:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1961
+//line sql.y:3976
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 369:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1965
+//line sql.y:3980
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 370:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1969
+//line sql.y:3984
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 371:
+	case 710:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3988
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: MemberOfStr, Right: yyDollar[5].expr}
+		}
+	case 711:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1973
+//line sql.y:3992
+		{
+			if !yylex.(*Tokenizer).EmptyInLists {
+				yylex.(*Tokenizer).errorEmptyInList()
+				return 1
+			}
+			yyVAL.expr = &EmptyInExpr{Left: yyDollar[1].expr, Operator: InStr}
+		}
+	case 712:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4000
+		{
+			if !yylex.(*Tokenizer).EmptyInLists {
+				yylex.(*Tokenizer).errorEmptyInList()
+				return 1
+			}
+			yyVAL.expr = &EmptyInExpr{Left: yyDollar[1].expr, Operator: NotInStr}
+		}
+	case 713:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4008
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 372:
+	case 714:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1977
+//line sql.y:4012
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 373:
+	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1981
+//line sql.y:4016
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 374:
+	case 716:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1985
+//line sql.y:4020
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 375:
+	case 717:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1989
+//line sql.y:4024
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 376:
+	case 718:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1993
+//line sql.y:4028
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 377:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1997
+//line sql.y:4032
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 378:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2003
+//line sql.y:4038
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 379:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2007
+//line sql.y:4042
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 380:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2011
+//line sql.y:4046
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 381:
+	case 723:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2015
+//line sql.y:4050
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 382:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2019
+//line sql.y:4054
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 383:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2023
+//line sql.y:4058
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 384:
+	case 726:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4062
+		{
+			yyVAL.str = IsUnknownStr
+		}
+	case 727:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4066
+		{
+			yyVAL.str = IsNotUnknownStr
+		}
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2029
+//line sql.y:4072
 		{
 			yyVAL.str = EqualStr
 		}
-	case 385:
+	case 729:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2033
+//line sql.y:4076
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 386:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2037
+//line sql.y:4080
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 387:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2041
+//line sql.y:4084
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 388:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2045
+//line sql.y:4088
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 389:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2049
+//line sql.y:4092
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 390:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2053
+//line sql.y:4096
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 391:
+	case 735:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2058
+//line sql.y:4101
 		{
 			yyVAL.expr = nil
 		}
-	case 392:
+	case 736:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2062
+//line sql.y:4105
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 393:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2068
+//line sql.y:4111
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 394:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2072
+//line sql.y:4115
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 395:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2076
+//line sql.y:4119
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 396:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2082
+//line sql.y:4125
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 397:
+	case 741:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4129
+		{
+			yyVAL.subquery = &Subquery{withSelect(yyDollar[2].with, yyDollar[3].selStmt)}
+		}
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2088
+//line sql.y:4135
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 398:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2092
+//line sql.y:4139
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 399:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2098
+//line sql.y:4145
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 400:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2102
+//line sql.y:4149
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 401:
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2106
+//line sql.y:4153
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 402:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2110
+//line sql.y:4157
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 403:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2114
+//line sql.y:4161
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 404:
+	case 749:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4165
+		{
+			if !isDateLiteral(yyDollar[2].bytes) {
+				yylex.Error("incorrect date literal")
+				return 1
+			}
+			yyVAL.expr = NewDateVal(yyDollar[2].bytes)
+		}
+	case 750:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4173
+		{
+			if !isTimeLiteral(yyDollar[2].bytes) {
+				yylex.Error("incorrect time literal")
+				return 1
+			}
+			yyVAL.expr = NewTimeVal(yyDollar[2].bytes)
+		}
+	case 751:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4181
+		{
+			if !isTimestampLiteral(yyDollar[2].bytes) {
+				yylex.Error("incorrect timestamp literal")
+				return 1
+			}
+			yyVAL.expr = NewTimestampVal(yyDollar[2].bytes)
+		}
+	case 752:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4189
+		{
+			// ODBC escape sequences, which are kept as flags of the
+			// date and time literals and of the function calls. Other
+			// expressions of {fn ...} lose the escape.
+			val, isStr := yyDollar[3].expr.(*SQLVal)
+			isStr = isStr && val.Type == StrVal
+			switch strings.ToLower(string(yyDollar[2].bytes)) {
+			case "d":
+				if !isStr || !isDateLiteral(val.Val) {
+					yylex.Error("incorrect date literal")
+					return 1
+				}
+				lit := NewDateVal(val.Val)
+				lit.ODBC = true
+				yyVAL.expr = lit
+			case "t":
+				if !isStr || !isTimeLiteral(val.Val) {
+					yylex.Error("incorrect time literal")
+					return 1
+				}
+				lit := NewTimeVal(val.Val)
+				lit.ODBC = true
+				yyVAL.expr = lit
+			case "ts":
+				if !isStr || !isTimestampLiteral(val.Val) {
+					yylex.Error("incorrect timestamp literal")
+					return 1
+				}
+				lit := NewTimestampVal(val.Val)
+				lit.ODBC = true
+				yyVAL.expr = lit
+			case "fn":
+				if fn, ok := yyDollar[3].expr.(*FuncExpr); ok {
+					fn.ODBC = true
+				}
+				yyVAL.expr = yyDollar[3].expr
+			default:
+				yylex.Error("expecting d, t, ts or fn in odbc escape")
+				return 1
+			}
+		}
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2118
+//line sql.y:4231
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 405:
+	case 754:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4235
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ConcatStr, Right: yyDollar[3].expr}
+		}
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2122
+//line sql.y:4239
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 406:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2126
+//line sql.y:4243
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 407:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2130
+//line sql.y:4247
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 408:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2134
+//line sql.y:4251
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 409:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2138
+//line sql.y:4255
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 410:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2142
+//line sql.y:4259
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 411:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2146
+//line sql.y:4263
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 412:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2150
+//line sql.y:4267
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 413:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2154
+//line sql.y:4271
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 414:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2158
+//line sql.y:4275
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 415:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2162
+//line sql.y:4279
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 416:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2166
+//line sql.y:4283
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 417:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:4287
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 418:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2174
+//line sql.y:4291
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 419:
+	case 769:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4295
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
+		}
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2178
+//line sql.y:4299
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 420:
+	case 771:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2182
+//line sql.y:4303
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 421:
+	case 772:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2186
+//line sql.y:4307
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -5122,9 +9669,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 422:
+	case 773:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2194
+//line sql.y:4315
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -5138,21 +9685,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 423:
+	case 774:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2208
+//line sql.y:4329
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 424:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2212
+//line sql.y:4333
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 425:
+	case 776:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2216
+//line sql.y:4337
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -5160,431 +9707,675 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 430:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2234
+	case 781:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4355
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
 		}
-	case 431:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2238
+	case 782:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4359
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].over}
 		}
-	case 432:
+	case 783:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2242
+//line sql.y:4363
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 433:
+	case 784:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4367
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 785:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2252
+//line sql.y:4377
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 434:
+	case 786:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2256
+//line sql.y:4381
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 435:
+	case 787:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2260
+//line sql.y:4385
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 436:
+	case 788:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2264
+//line sql.y:4389
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 437:
+	case 789:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4393
+		{
+			yyDollar[5].convertType.Array = true
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 790:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2268
+//line sql.y:4398
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 438:
+	case 791:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4402
+		{
+			yyVAL.expr = &SubstrExpr{FuncName: yyDollar[1].str, Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
+		}
+	case 792:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4406
+		{
+			yyVAL.expr = &SubstrExpr{FuncName: yyDollar[1].str, Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 793:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4410
+		{
+			yyVAL.expr = &SubstrExpr{FuncName: yyDollar[1].str, Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil, FromFor: true}
+		}
+	case 794:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4414
+		{
+			yyVAL.expr = &SubstrExpr{FuncName: yyDollar[1].str, Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr, FromFor: true}
+		}
+	case 795:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4418
+		{
+			yyVAL.expr = &ExtractExpr{Unit: yyDollar[3].colIdent.Lowered(), Expr: yyDollar[5].expr}
+		}
+	case 796:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2272
+//line sql.y:4422
+		{
+			yyVAL.expr = &PositionExpr{Substr: yyDollar[3].expr, Str: yyDollar[5].expr}
+		}
+	case 797:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4426
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
+			yyVAL.expr = &TrimExpr{Str: yyDollar[3].expr}
 		}
-	case 439:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2276
+	case 798:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4430
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			// BOTH, LEADING and TRAILING are non-reserved, so a lone
+			// trim type is parsed as a column name.
+			if col, ok := yyDollar[3].expr.(*ColName); ok && col.Qualifier.IsEmpty() && isTrimType(col.Name.Lowered()) {
+				yyVAL.expr = &TrimExpr{Type: col.Name.Lowered(), Str: yyDollar[5].expr}
+			} else {
+				yyVAL.expr = &TrimExpr{RemStr: yyDollar[3].expr, Str: yyDollar[5].expr}
+			}
 		}
-	case 440:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2280
+	case 799:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4440
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &TrimExpr{Type: yyDollar[3].str, RemStr: yyDollar[4].expr, Str: yyDollar[6].expr}
 		}
-	case 441:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2284
+	case 800:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4444
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
+			yyVAL.expr = &TrimExpr{Type: yyDollar[3].str, RemStr: yyDollar[4].colName, Str: yyDollar[6].expr}
 		}
-	case 442:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2288
+	case 801:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4448
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &WeightStringExpr{Expr: yyDollar[3].expr}
 		}
-	case 443:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2292
+	case 802:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4452
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &WeightStringExpr{Expr: yyDollar[3].expr, As: yyDollar[5].convertType}
 		}
-	case 444:
+	case 803:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2296
+//line sql.y:4456
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 445:
+	case 804:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2300
+//line sql.y:4460
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 446:
+	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2304
+//line sql.y:4464
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 447:
+	case 806:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2308
+//line sql.y:4468
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 448:
+	case 807:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4472
+		{
+			if yyDollar[2].colIdent.Lowered() != "value" {
+				yylex.Error("expecting value after next")
+				return 1
+			}
+			yyVAL.expr = &NextValueExpr{Sequence: yyDollar[4].tableName}
+		}
+	case 808:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4480
+		{
+			yyVAL.expr = &Default{Name: yyDollar[3].colName}
+		}
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2318
+//line sql.y:4490
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 449:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2322
+//line sql.y:4494
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 450:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2326
+//line sql.y:4498
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 451:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2330
+//line sql.y:4502
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 452:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2335
+//line sql.y:4507
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 453:
+	case 814:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2340
+//line sql.y:4512
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 454:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2345
+//line sql.y:4517
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 455:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2350
+//line sql.y:4522
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 458:
+	case 817:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4526
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_user")}
+		}
+	case 818:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4532
+		{
+			yyVAL.str = SubstrStr
+		}
+	case 819:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4536
+		{
+			yyVAL.str = SubstringStr
+		}
+	case 820:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4542
+		{
+			yyVAL.str = TrimBothStr
+		}
+	case 821:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4546
+		{
+			yyVAL.str = TrimLeadingStr
+		}
+	case 822:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4550
+		{
+			yyVAL.str = TrimTrailingStr
+		}
+	case 823:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4556
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 824:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4560
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 827:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2364
+//line sql.y:4574
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 459:
+	case 828:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2368
+//line sql.y:4578
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 460:
+	case 829:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4582
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 830:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2372
+//line sql.y:4586
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 461:
+	case 831:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2376
+//line sql.y:4590
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 462:
+	case 832:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2382
+//line sql.y:4596
 		{
 			yyVAL.str = ""
 		}
-	case 463:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2386
+//line sql.y:4600
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 464:
+	case 834:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2390
+//line sql.y:4604
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 465:
+	case 835:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2394
+//line sql.y:4608
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 466:
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2398
+//line sql.y:4612
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 467:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2404
+//line sql.y:4618
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 468:
+	case 838:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2408
+//line sql.y:4622
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 469:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2414
+//line sql.y:4628
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 470:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2418
+//line sql.y:4632
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 471:
+	case 841:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2422
+//line sql.y:4636
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 472:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2426
+//line sql.y:4640
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 473:
+	case 843:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2430
+//line sql.y:4644
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 474:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2434
+//line sql.y:4648
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 475:
+	case 845:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2440
+//line sql.y:4654
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 476:
+	case 846:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2444
+//line sql.y:4658
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 477:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2448
+//line sql.y:4662
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 478:
+	case 848:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2452
+//line sql.y:4666
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 479:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2456
+//line sql.y:4670
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 480:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2460
+//line sql.y:4674
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 481:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2464
+//line sql.y:4678
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 482:
+	case 852:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4688
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 853:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4692
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 854:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4696
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 855:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4700
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 856:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4704
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4708
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 858:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4712
+		{
+			yyVAL.convertType = castType(yyDollar[1].columnType)
+			if yyVAL.convertType == nil {
+				yylex.Error("cannot cast to " + yyDollar[1].columnType.Type)
+				return 1
+			}
+		}
+	case 859:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4722
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].optVal
+		}
+	case 862:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4729
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 863:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4733
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 864:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4737
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 865:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4741
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 866:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4745
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 867:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4749
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 868:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4753
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 869:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4757
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 870:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4761
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 871:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4765
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 872:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2469
+//line sql.y:4770
 		{
 			yyVAL.expr = nil
 		}
-	case 483:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2473
+//line sql.y:4774
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 484:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2478
+//line sql.y:4779
 		{
 			yyVAL.str = string("")
 		}
-	case 485:
+	case 875:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2482
+//line sql.y:4783
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 486:
+	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2488
+//line sql.y:4789
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 487:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2492
+//line sql.y:4793
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 488:
+	case 878:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2498
+//line sql.y:4799
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 489:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2503
+//line sql.y:4804
 		{
 			yyVAL.expr = nil
 		}
-	case 490:
+	case 880:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2507
+//line sql.y:4808
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 491:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2513
+//line sql.y:4814
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 492:
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2517
+//line sql.y:4818
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 493:
+	case 883:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2521
+//line sql.y:4822
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 494:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2527
+//line sql.y:4828
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 495:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2531
+//line sql.y:4832
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 496:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2535
+//line sql.y:4836
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 497:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2539
+//line sql.y:4840
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 498:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2543
+//line sql.y:4844
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 499:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2547
+//line sql.y:4848
+		{
+			yyVAL.expr = NewDecimalVal(yyDollar[1].bytes)
+		}
+	case 890:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4852
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 500:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2551
+//line sql.y:4856
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 501:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2555
+//line sql.y:4860
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 502:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2561
+//line sql.y:4866
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -5593,239 +10384,565 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 503:
+	case 894:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4875
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 895:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4879
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 896:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4884
+		{
+			yyVAL.groupBy = groupByClause{}
+		}
+	case 897:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4888
+		{
+			yyVAL.groupBy = groupByClause{exprs: yyDollar[3].exprs}
+		}
+	case 898:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4892
+		{
+			yyVAL.groupBy = groupByClause{exprs: yyDollar[3].exprs, rollup: true}
+		}
+	case 899:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4897
+		{
+			yyVAL.expr = nil
+		}
+	case 900:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4901
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 901:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4906
+		{
+			yyVAL.over = nil
+		}
+	case 902:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4910
+		{
+			yyVAL.over = &Over{WindowName: yyDollar[2].colIdent}
+		}
+	case 903:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4914
+		{
+			yyVAL.over = &Over{Spec: yyDollar[2].windowSpec}
+		}
+	case 904:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4920
+		{
+			yyVAL.windowSpec = &WindowSpec{PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].windowFrame}
+		}
+	case 905:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4924
+		{
+			yyVAL.windowSpec = &WindowSpec{Name: yyDollar[2].colIdent, PartitionBy: yyDollar[3].exprs, OrderBy: yyDollar[4].orderBy, Frame: yyDollar[5].windowFrame}
+		}
+	case 906:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4929
+		{
+			yyVAL.windowFrame = nil
+		}
+	case 907:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4933
+		{
+			yyVAL.windowFrame = &WindowFrame{Unit: yyDollar[1].str, Start: yyDollar[2].frameBound}
+		}
+	case 908:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4937
+		{
+			yyVAL.windowFrame = &WindowFrame{Unit: yyDollar[1].str, Start: yyDollar[3].frameBound, End: yyDollar[5].frameBound}
+		}
+	case 909:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4943
+		{
+			yyVAL.str = RowsStr
+		}
+	case 910:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4947
+		{
+			yyVAL.str = RangeStr
+		}
+	case 911:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4953
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedPrecedingStr}
+		}
+	case 912:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4957
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedFollowingStr}
+		}
+	case 913:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4961
+		{
+			yyVAL.frameBound = &FrameBound{Type: CurrentRowStr}
+		}
+	case 914:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2570
+//line sql.y:4965
 		{
-			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+			yyVAL.frameBound = &FrameBound{Type: PrecedingStr, Expr: yyDollar[1].expr}
 		}
-	case 504:
+	case 915:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2574
+//line sql.y:4969
 		{
-			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+			yyVAL.frameBound = &FrameBound{Type: FollowingStr, Expr: yyDollar[1].expr}
 		}
-	case 505:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2579
+//line sql.y:4974
 		{
 			yyVAL.exprs = nil
 		}
-	case 506:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2583
+//line sql.y:4978
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 507:
+	case 918:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2588
+//line sql.y:4983
 		{
-			yyVAL.expr = nil
+			yyVAL.namedWindows = nil
 		}
-	case 508:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2592
+//line sql.y:4987
 		{
-			yyVAL.expr = yyDollar[2].expr
+			yyVAL.namedWindows = yyDollar[2].namedWindows
 		}
-	case 509:
+	case 920:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4993
+		{
+			yyVAL.namedWindows = NamedWindows{yyDollar[1].namedWindow}
+		}
+	case 921:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4997
+		{
+			yyVAL.namedWindows = append(yyDollar[1].namedWindows, yyDollar[3].namedWindow)
+		}
+	case 922:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5003
+		{
+			yyVAL.namedWindow = &NamedWindow{Name: yyDollar[1].colIdent, Spec: yyDollar[3].windowSpec}
+		}
+	case 923:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2597
+//line sql.y:5008
 		{
 			yyVAL.orderBy = nil
 		}
-	case 510:
+	case 924:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2601
+//line sql.y:5012
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 511:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2607
+//line sql.y:5018
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 512:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2611
+//line sql.y:5022
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 513:
+	case 927:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2617
+//line sql.y:5028
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 514:
+	case 928:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2622
+//line sql.y:5033
 		{
 			yyVAL.str = AscScr
 		}
-	case 515:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2626
+//line sql.y:5037
 		{
 			yyVAL.str = AscScr
 		}
-	case 516:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2630
+//line sql.y:5041
 		{
 			yyVAL.str = DescScr
 		}
-	case 517:
+	case 931:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2635
+//line sql.y:5046
 		{
 			yyVAL.limit = nil
 		}
-	case 518:
+	case 932:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5050
+		{
+			yyVAL.limit = yyDollar[1].limit
+		}
+	case 933:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2639
+//line sql.y:5056
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 519:
+	case 934:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2643
+//line sql.y:5060
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 520:
+	case 935:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2647
+//line sql.y:5064
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 521:
+	case 936:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5070
+		{
+			yyVAL.selectInto = nil
+		}
+	case 937:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5074
+		{
+			into := yyDollar[5].selectInto
+			into.Type = IntoOutfileStr
+			into.FileName = string(yyDollar[3].bytes)
+			into.Charset = yyDollar[4].str
+			into.LinesStartingBy = yyDollar[6].selectInto.LinesStartingBy
+			into.LinesTerminatedBy = yyDollar[6].selectInto.LinesTerminatedBy
+			yyVAL.selectInto = into
+		}
+	case 938:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5084
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), IntoDumpfileStr) {
+				yylex.Error("expecting dumpfile")
+				return 1
+			}
+			yyVAL.selectInto = &SelectInto{Type: IntoDumpfileStr, FileName: string(yyDollar[3].bytes)}
+		}
+	case 939:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5092
+		{
+			yyVAL.selectInto = &SelectInto{Type: IntoVarsStr, Vars: yyDollar[2].colIdents}
+		}
+	case 940:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5097
+		{
+			yyVAL.selectInto = &SelectInto{}
+		}
+	case 941:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5101
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), "fields") && !strings.EqualFold(string(yyDollar[1].bytes), "columns") {
+				yylex.Error("expecting fields or columns")
+				return 1
+			}
+			yyVAL.selectInto = yyDollar[3].selectInto
+			yyVAL.selectInto.FieldsTerminatedBy = yyDollar[2].optVal
+			yyVAL.selectInto.FieldsEscapedBy = yyDollar[4].optVal
+		}
+	case 942:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5112
+		{
+			yyVAL.optVal = nil
+		}
+	case 943:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5116
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 944:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5121
+		{
+			yyVAL.selectInto = &SelectInto{}
+		}
+	case 945:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5125
+		{
+			yyVAL.selectInto = &SelectInto{FieldsEnclosedBy: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 946:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5129
+		{
+			yyVAL.selectInto = &SelectInto{FieldsEnclosedBy: NewStrVal(yyDollar[4].bytes), Optionally: true}
+		}
+	case 947:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5134
+		{
+			yyVAL.optVal = nil
+		}
+	case 948:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5138
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 949:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5143
+		{
+			yyVAL.selectInto = &SelectInto{}
+		}
+	case 950:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5147
+		{
+			yyVAL.selectInto = &SelectInto{LinesStartingBy: yyDollar[2].optVal, LinesTerminatedBy: yyDollar[3].optVal}
+		}
+	case 951:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5152
+		{
+			yyVAL.optVal = nil
+		}
+	case 952:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5156
+		{
+			yyVAL.optVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 953:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2652
+//line sql.y:5161
 		{
 			yyVAL.str = ""
 		}
-	case 522:
+	case 954:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2656
+//line sql.y:5165
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 523:
+	case 955:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2660
+//line sql.y:5169
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 524:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2673
+	case 956:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5182
 		{
-			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
+			yyVAL.ins = &Insert{Rows: yyDollar[2].values, RowAlias: yyDollar[3].rowAlias}
 		}
-	case 525:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2677
+//line sql.y:5186
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 526:
+	case 958:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2681
+//line sql.y:5190
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 527:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2686
+	case 959:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5195
 		{
-			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values, RowAlias: yyDollar[6].rowAlias}
 		}
-	case 528:
+	case 960:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2690
+//line sql.y:5199
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 529:
+	case 961:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2694
+//line sql.y:5203
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 530:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2701
+//line sql.y:5210
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 531:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2705
+//line sql.y:5214
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 532:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2709
+//line sql.y:5218
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 533:
+	case 965:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2713
+//line sql.y:5222
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 534:
+	case 966:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2718
+//line sql.y:5227
+		{
+			yyVAL.rowAlias = nil
+		}
+	case 967:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5231
+		{
+			yyVAL.rowAlias = &RowAlias{Name: yyDollar[2].tableIdent}
+		}
+	case 968:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5235
+		{
+			yyVAL.rowAlias = &RowAlias{Name: yyDollar[2].tableIdent, Columns: yyDollar[4].columns}
+		}
+	case 969:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5240
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 535:
+	case 970:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2722
+//line sql.y:5244
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 536:
+	case 971:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5250
+		{
+			yyVAL.onConflict = yyDollar[3].onConflict
+			yyVAL.onConflict.Action = DoNothingStr
+		}
+	case 972:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5255
+		{
+			yyVAL.onConflict = yyDollar[3].onConflict
+			yyVAL.onConflict.Action = DoUpdateStr
+			yyVAL.onConflict.Exprs = yyDollar[7].updateExprs
+			yyVAL.onConflict.UpdateWhere = NewWhere(WhereStr, yyDollar[8].expr)
+		}
+	case 973:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5263
+		{
+			yyVAL.onConflict = &OnConflict{}
+		}
+	case 974:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5267
+		{
+			yyVAL.onConflict = &OnConflict{Columns: yyDollar[2].columns, Where: NewWhere(WhereStr, yyDollar[4].expr)}
+		}
+	case 975:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5271
+		{
+			yyVAL.onConflict = &OnConflict{Constraint: yyDollar[3].colIdent}
+		}
+	case 976:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5276
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 977:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5280
+		{
+			yyVAL.selectExprs = yyDollar[2].selectExprs
+		}
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2728
+//line sql.y:5286
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 537:
+	case 979:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2732
+//line sql.y:5290
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 538:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2738
+//line sql.y:5296
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 539:
+	case 981:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2742
+//line sql.y:5300
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 540:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2748
+//line sql.y:5306
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 541:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2754
+//line sql.y:5312
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -5833,306 +10950,347 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 542:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2764
+//line sql.y:5322
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 543:
+	case 985:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2768
+//line sql.y:5326
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 544:
+	case 986:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2774
+//line sql.y:5332
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 545:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2780
+//line sql.y:5338
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 546:
+	case 988:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2784
+//line sql.y:5342
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 547:
+	case 989:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2790
+//line sql.y:5348
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 548:
+	case 990:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2794
+//line sql.y:5352
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 549:
+	case 991:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5356
+		{
+			// Columns of the NEW row are set this way in triggers.
+			if !inTrigger(yylex) {
+				yylex.Error("cannot set a qualified column outside of a trigger")
+				return 1
+			}
+			switch strings.ToLower(yyDollar[1].tableIdent.String()) {
+			case "new", "old":
+			default:
+				yylex.Error("expecting new or old")
+				return 1
+			}
+			yyVAL.setExpr = &SetExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Expr: yyDollar[5].expr}
+		}
+	case 992:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2798
+//line sql.y:5371
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 551:
+	case 994:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2805
+//line sql.y:5378
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 553:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2812
+//line sql.y:5385
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 554:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2816
+//line sql.y:5389
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 555:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2820
+//line sql.y:5393
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 558:
+	case 999:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2829
+//line sql.y:5398
 		{
 			yyVAL.byt = 0
 		}
-	case 559:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2831
+//line sql.y:5400
 		{
 			yyVAL.byt = 1
 		}
-	case 560:
+	case 1001:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2834
+//line sql.y:5403
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.byt = 0
 		}
-	case 561:
+	case 1002:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2836
+//line sql.y:5405
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.byt = 1
 		}
-	case 562:
+	case 1003:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2839
+//line sql.y:5408
 		{
 			yyVAL.str = ""
 		}
-	case 563:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2841
+//line sql.y:5410
 		{
-			yyVAL.str = IgnoreStr
+			yyVAL.str = yyDollar[1].str
 		}
-	case 564:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2845
+//line sql.y:5414
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = DuplicateIgnoreStr
 		}
-	case 565:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2847
+//line sql.y:5416
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = DuplicateReplaceStr
 		}
-	case 566:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2849
+	case 1007:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5419
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = ""
 		}
-	case 567:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2851
+//line sql.y:5421
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = IgnoreStr
 		}
-	case 568:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2853
+	case 1009:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5424
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = ""
 		}
-	case 569:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2855
+//line sql.y:5426
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = LowPriorityStr
 		}
-	case 570:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2857
+//line sql.y:5428
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = DelayedStr
 		}
-	case 571:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2859
+//line sql.y:5430
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = HighPriorityStr
 		}
-	case 572:
+	case 1013:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5433
+		{
+			yyVAL.str = ""
+		}
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2861
+//line sql.y:5435
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = LowPriorityStr
 		}
-	case 573:
+	case 1015:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5438
+		{
+			yyVAL.str = ""
+		}
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2863
+//line sql.y:5440
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = QuickStr
 		}
-	case 574:
+	case 1017:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2866
+//line sql.y:5442
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 575:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2868
+//line sql.y:5444
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 576:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2870
+//line sql.y:5446
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 577:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2874
+//line sql.y:5450
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 578:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2876
+//line sql.y:5452
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 579:
+	case 1022:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2879
+//line sql.y:5455
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = ""
 		}
-	case 580:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2881
+//line sql.y:5457
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 581:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2883
+//line sql.y:5459
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 582:
+	case 1025:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2886
+//line sql.y:5462
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 583:
+	case 1026:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2888
+//line sql.y:5464
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 584:
+	case 1027:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5468
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1028:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2892
+//line sql.y:5472
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 585:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2896
+//line sql.y:5478
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 587:
+	case 1031:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2903
+//line sql.y:5485
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 588:
+	case 1032:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2909
+//line sql.y:5491
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 589:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2913
+//line sql.y:5495
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 591:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2920
+//line sql.y:5502
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 782:
+	case 1036:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5506
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3136
+//line sql.y:5789
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 783:
+	case 1295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3145
+//line sql.y:5798
 		{
 			decNesting(yylex)
 		}
-	case 784:
+	case 1296:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3150
+//line sql.y:5803
 		{
 			forceEOF(yylex)
 		}
-	case 785:
+	case 1297:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3155
+//line sql.y:5808
 		{
 			forceEOF(yylex)
 		}
-	case 786:
+	case 1298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3159
+//line sql.y:5812
 		{
 			forceEOF(yylex)
 		}
-	case 787:
+	case 1299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3163
+//line sql.y:5816
 		{
 			forceEOF(yylex)
 		}
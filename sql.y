@@ -17,10 +17,28 @@ limitations under the License.
 %{
 package sqlparser
 
+import "strings"
+
 func setParseTree(yylex interface{}, stmt Statement) {
   yylex.(*Tokenizer).ParseTree = stmt
 }
 
+// newNotExpr returns NOT expr. The parentheses of NOT (EXISTS ...)
+// are dropped, so that it's the same as NOT EXISTS, see ExistsExpr.
+func newNotExpr(expr Expr) Expr {
+  if exists, ok := unparen(expr).(*ExistsExpr); ok {
+    expr = exists
+  }
+  return &NotExpr{Expr: expr}
+}
+
+// groupByClause is the GROUP BY clause of a select, and whether it
+// has a WITH ROLLUP.
+type groupByClause struct {
+  exprs  Exprs
+  rollup bool
+}
+
 func setAllowComments(yylex interface{}, allow bool) {
   yylex.(*Tokenizer).AllowComments = allow
 }
@@ -29,9 +47,55 @@ func setDDL(yylex interface{}, ddl *DDL) {
   yylex.(*Tokenizer).partialDDL = ddl
 }
 
+// withSelect sets the WITH clause of sel, a select or a union, to
+// with, and returns sel.
+func withSelect(with *With, sel SelectStatement) SelectStatement {
+  switch sel := sel.(type) {
+  case *Select:
+    sel.With = with
+  case *Union:
+    sel.With = with
+  }
+  return sel
+}
+
+// setInTrigger records that the parser is in the body of a trigger,
+// where the columns of the NEW row can be set.
+// castType returns the type of the CONVERT that a postgres style ::
+// cast to the column type t is, or nil if CONVERT has no equivalent
+// type: the integers are SIGNED, TIMESTAMP is DATETIME, and the
+// strings are CHAR or BINARY, which keep the length of t.
+func castType(t ColumnType) *ConvertType {
+	ct := &ConvertType{Type: t.Type, Length: t.Length, Scale: t.Scale}
+	switch strings.ToLower(t.Type) {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint":
+		return &ConvertType{Type: "signed"}
+	case "numeric":
+		ct.Type = "decimal"
+	case "timestamp":
+		ct.Type = "datetime"
+	case "varchar", "text", "tinytext", "mediumtext", "longtext":
+		ct.Type = "char"
+	case "varbinary", "blob", "tinyblob", "mediumblob", "longblob":
+		ct.Type = "binary"
+	case "real", "double", "float", "decimal", "date", "time", "datetime", "year":
+	default:
+		return nil
+	}
+	return ct
+}
+
+func setInTrigger(yylex interface{}) {
+  yylex.(*Tokenizer).inTrigger = true
+}
+
+func inTrigger(yylex interface{}) bool {
+  return yylex.(*Tokenizer).inTrigger
+}
+
 func incNesting(yylex interface{}) bool {
   yylex.(*Tokenizer).nesting++
-  if yylex.(*Tokenizer).nesting == 200 {
+  if yylex.(*Tokenizer).nesting == maxNesting {
     return true
   }
   return false
@@ -48,12 +112,19 @@ func forceEOF(yylex interface{}) {
   yylex.(*Tokenizer).ForceEOF = true
 }
 
+// scanRest returns the text of the rest of the statement as written,
+// and makes the lexer return EOF after it.
+func scanRest(yylex interface{}) string {
+  return yylex.(*Tokenizer).scanRest()
+}
+
 %}
 
 %union {
   empty         struct{}
   statement     Statement
   selStmt       SelectStatement
+  parenSelect   *ParenSelect
   ddl           *DDL
   ins           *Insert
   byt           byte
@@ -68,10 +139,12 @@ func forceEOF(yylex interface{}) {
   colName       *ColName
   tableExprs    TableExprs
   tableExpr     TableExpr
+  joinTableExpr *JoinTableExpr
   joinCondition JoinCondition
   tableName     TableName
   tableNames    TableNames
   indexHints    *IndexHints
+  indexHintList IndexHintList
   expr          Expr
   exprs         Exprs
   boolVal       BoolVal
@@ -89,6 +162,7 @@ func forceEOF(yylex interface{}) {
   updateExpr    *UpdateExpr
   setExpr       *SetExpr
   colIdent      ColIdent
+  colIdents     []ColIdent
   tableIdent    TableIdent
   convertType   *ConvertType
   aliasedTableName *AliasedTableExpr
@@ -99,7 +173,10 @@ func forceEOF(yylex interface{}) {
   LengthScaleOption LengthScaleOption
   columnDefinition *ColumnDefinition
   indexDefinition *IndexDefinition
+  foreignKeyDefinition *ForeignKeyDefinition
   indexInfo     *IndexInfo
+  alterSpec     *AlterSpec
+  alterSpecs    []*AlterSpec
   indexOption   *IndexOption
   indexOptions  []*IndexOption
   indexColumn   *IndexColumn
@@ -110,20 +187,75 @@ func forceEOF(yylex interface{}) {
   vindexParam   VindexParam
   vindexParams  []VindexParam
   showFilter    *ShowFilter
+  xid           *Xid
+  tableLocks    TableLocks
+  tableLock     *TableLock
+  indexCacheTables IndexCacheTables
+  indexCacheTable  *IndexCacheTable
+  createTrigger *CreateTrigger
+  createEvent   *CreateEvent
+  createProcedure *CreateProcedure
+  createFunction *CreateFunction
+  procParams    ProcParams
+  procParam     *ProcParam
+  rowAlias      *RowAlias
+  onConflict    *OnConflict
+  selectInto    *SelectInto
+  selectOption  SelectOption
+  selectOptions SelectOptions
+  over          *Over
+  windowSpec    *WindowSpec
+  windowFrame   *WindowFrame
+  frameBound    *FrameBound
+  namedWindows  NamedWindows
+  namedWindow   *NamedWindow
+  groupBy       groupByClause
+  with          *With
+  ctes          []*CommonTableExpr
+  cte           *CommonTableExpr
 }
 
 %token LEX_ERROR
 %left <bytes> UNION
-%token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
-%token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK KEYS
+%token <bytes> STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
+%token <bytes> ALL DISTINCT EXISTS ASC DESC DUPLICATE KEY DEFAULT SET LOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
-%token <bytes> SQL_NO_CACHE SQL_CACHE
+%token <bytes> WINDOW OVER RECURSIVE
+%token <bytes> ROWS RANGE ROW CURRENT
+%token <bytes> SQL_NO_CACHE SQL_CACHE SQL_CALC_FOUND_ROWS SQL_SMALL_RESULT SQL_BIG_RESULT SQL_BUFFER_RESULT DISTINCTROW
 %left <bytes> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <bytes> ON USING
-%token <empty> '(' ',' ')'
-%token <bytes> ID HEX STRING INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL
-%token <bytes> NULL TRUE FALSE
+%token <empty> ',' ')'
+
+// Table options accept any keyword. A select that follows the options
+// in CREATE TABLE ... SELECT takes precedence over another option.
+%nonassoc <bytes> SELECT AS IGNORE REPLACE
+%nonassoc <bytes> TABLE_OPTIONS_END
+// The INTO clause of a select can come before its FROM clause, or at
+// its end: without a FROM clause, the first position is taken.
+%nonassoc <bytes> INTO_END
+%nonassoc <bytes> INTO
+// DATE, TIME and TIMESTAMP followed by a string are literals, not
+// columns with an alias.
+%nonassoc <bytes> DATE TIME TIMESTAMP
+%nonassoc <bytes> STRING
+// EXPLAIN WITH followed by a name explains a query with a common table
+// expression, not the columns of a table named with.
+%nonassoc <bytes> WITH
+// KILL QUERY followed by an expression, even one that starts with a
+// parenthesis or a sign, kills a query: query is not a column there.
+// After KILL, a name followed by a parenthesis calls a function, like
+// connection_id(), rather than being the CONNECTION of KILL CONNECTION.
+%nonassoc <bytes> QUERY
+%nonassoc <empty> '('
+%nonassoc <bytes> ID
+// UNBOUNDED followed by PRECEDING or FOLLOWING is a bound of the frame
+// of a window, not a column.
+%nonassoc <bytes> UNBOUNDED
+%nonassoc <bytes> PRECEDING FOLLOWING
+%token <bytes> HEX INTEGRAL FLOAT DECIMAL_LITERAL HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL
+%token <bytes> NULL TRUE FALSE UNKNOWN
 
 // Precedence dictated by mysql. But the vitess grammar is simplified.
 // Some of these operators don't conflict in our situation. Nevertheless,
@@ -133,17 +265,19 @@ func forceEOF(yylex interface{}) {
 %left <bytes> AND
 %right <bytes> NOT '!'
 %left <bytes> BETWEEN CASE WHEN THEN ELSE END
-%left <bytes> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN
+%left <bytes> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN MEMBER
 %left <bytes> '|'
 %left <bytes> '&'
 %left <bytes> SHIFT_LEFT SHIFT_RIGHT
 %left <bytes> '+' '-'
 %left <bytes> '*' '/' DIV '%' MOD
 %left <bytes> '^'
+%left <bytes> PIPE_CONCAT
 %right <bytes> '~' UNARY
 %left <bytes> COLLATE
 %right <bytes> BINARY UNDERSCORE_BINARY
 %right <bytes> INTERVAL
+%left <bytes> TYPECAST
 %nonassoc <bytes> '.'
 
 // There is no need to define precedence for the JSON
@@ -152,20 +286,40 @@ func forceEOF(yylex interface{}) {
 %token <empty> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 
 // DDL Tokens
-%token <bytes> CREATE ALTER DROP RENAME ANALYZE ADD
-%token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
-%token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> CREATE ALTER DROP RENAME ANALYZE ADD FIRST AFTER
+%token <bytes> SCHEMA TABLE INDEX VIEW TO IF UNIQUE PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
+%token <bytes> REFERENCES CASCADE RESTRICT
+%token <bytes> SHOW DESCRIBE EXPLAIN ESCAPE REPAIR OPTIMIZE CHECK TRUNCATE
+%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER FUNCTION
+%token <bytes> EVENT DEFINER BEFORE EACH EVERY STARTS ENDS
+%token <bytes> OUT INOUT RETURN DETERMINISTIC SQL READS MODIFIES
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK XA
+
+// Misc statement Tokens
+%token <bytes> DO HANDLER FLUSH KILL LOCAL NO_WRITE_TO_BINLOG UNLOCK LOW_PRIORITY CALL
+%token <bytes> CHANGE STOP RESET PURGE
+%token <bytes> DELAYED HIGH_PRIORITY QUICK
+%token <bytes> CHECKSUM CACHE LOAD
+%token <bytes> PREPARE EXECUTE DEALLOCATE IMMEDIATE
+
+// SQL Server Tokens
+%token <bytes> TOP PERCENT
+
+// Postgres and MariaDB Tokens
+%token <bytes> RETURNING
+%token <bytes> CONFLICT NOTHING
+
+// SELECT ... INTO Tokens
+%token <bytes> OUTFILE TERMINATED ENCLOSED OPTIONALLY ESCAPED LINES STARTING
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
 %token <bytes> REAL DOUBLE FLOAT_TYPE DECIMAL NUMERIC
-%token <bytes> TIME TIMESTAMP DATETIME YEAR
+%token <bytes> DATETIME YEAR
 %token <bytes> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <bytes> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
 %token <bytes> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM
@@ -181,42 +335,102 @@ func forceEOF(yylex interface{}) {
 %token <bytes> NAMES CHARSET GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
 
 // Functions
-%token <bytes> CURRENT_TIMESTAMP DATABASE CURRENT_DATE
+%token <bytes> CURRENT_TIMESTAMP DATABASE CURRENT_DATE CURRENT_USER
 %token <bytes> CURRENT_TIME LOCALTIME LOCALTIMESTAMP
 %token <bytes> UTC_DATE UTC_TIME UTC_TIMESTAMP
-%token <bytes> REPLACE
-%token <bytes> CONVERT CAST
+%token <bytes> CONVERT CAST ARRAY
 %token <bytes> SUBSTR SUBSTRING
+%token <bytes> EXTRACT POSITION TRIM WEIGHT_STRING
+%token <bytes> BOTH LEADING TRAILING
 %token <bytes> GROUP_CONCAT SEPARATOR
+%token <bytes> ROLLUP
+%token <bytes> OF
 
 // Match
-%token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
+%token <bytes> MATCH AGAINST BOOLEAN LANGUAGE EXPANSION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED
 
-%type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+// DELIMITER is returned by the tokenizer for a DELIMITER command.
+// It never reaches the parser.
+%token <bytes> DELIMITER
+
+// EXTENSION_FUNC is a call to a function registered with
+// RegisterFunctionExtension, scanned as a single token.
+%token <expr> EXTENSION_FUNC
+
+%type <statement> command routine_statement
+%type <str> definer definer_user account_name_part trigger_timing trigger_event
+%type <strs> account_name_parts
+%type <createTrigger> trigger_definition trigger_head trigger_spec
+%type <createEvent> event_definition event_head
+%type <str> event_schedule event_starts_opt event_ends_opt event_completion_opt event_status_opt
+%type <optVal> event_comment_opt
+%type <str> compound_block compound_capture return_statement statement_capture
+%type <createProcedure> procedure_definition procedure_head
+%type <createFunction> function_definition function_head
+%type <procParams> proc_param_list_opt proc_param_list
+%type <procParam> proc_param
+%type <str> proc_param_mode_opt routine_characteristic
+%type <strs> routine_characteristics_opt
+%type <selStmt> select_statement base_select union_lhs union_rhs paren_select_statement view_select
+%type <parenSelect> paren_select
+%type <selStmt> with_select_statement
+%type <with> with_clause
+%type <ctes> cte_list
+%type <cte> cte
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement
-%type <ddl> create_table_prefix
-%type <statement> analyze_statement show_statement use_statement other_statement
+%type <ddl> create_table_prefix alter_table_prefix create_view_prefix alter_view_prefix create_index_prefix
+%type <alterSpec> alter_spec column_position_opt
+%type <foreignKeyDefinition> foreign_key_definition fk_reference_opts
+%type <str> fk_reference_option
+%type <colIdent> constraint_symbol fk_index_name_opt
+%type <alterSpecs> alter_spec_list
+%type <str> table_option_keyword default_opt
+%type <statement> maintenance_statement show_statement use_statement other_statement
+%type <indexCacheTables> index_cache_table_list
+%type <indexCacheTable> index_cache_table
+%type <colIdents> index_cache_indexes_opt
+%type <boolVal> ignore_leaves_opt
 %type <statement> begin_statement commit_statement rollback_statement
+%type <statement> do_statement handler_statement flush_statement kill_statement
+%type <statement> xa_statement lock_statement unlock_statement call_statement
+%type <statement> prepare_statement execute_statement deallocate_statement
+%type <statement> replication_statement
+%type <bytes> replication_verb replication_word
+%type <str> replication_options
+%type <colIdents> execute_using_opt user_var_list
+%type <colIdent> user_var
+%type <exprs> call_params_opt
+%type <xid> xid
+%type <optVal> xid_value
+%type <str> xa_start_option_opt xa_end_option_opt lock_type
+%type <boolVal> xa_one_phase_opt
+%type <tableLocks> table_lock_list
+%type <tableLock> table_lock
 %type <bytes2> comment_opt comment_list
-%type <str> union_op insert_or_replace
-%type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
-%type <expr> like_escape_opt
-%type <selectExprs> select_expression_list select_expression_list_opt
+%type <str> union_op insert_or_replace explain_verb
+%type <str> distinct_opt match_option separator_opt
+%type <selectOptions> select_options_opt
+%type <selectOption> select_option
+%type <str> substr_keyword trim_type create_select_duplicate_opt create_select_duplicate
+%type <expr> like_escape_opt prepare_source
+%type <selectExprs> select_expression_list select_expression_list_opt returning_opt
 %type <selectExpr> select_expression
 %type <expr> expression
 %type <tableExprs> from_opt table_references
 %type <tableExpr> table_reference table_factor join_table
 %type <joinCondition> join_condition join_condition_opt on_expression_opt
 %type <tableNames> table_name_list
-%type <str> inner_join outer_join straight_join natural_join cross_join
+%type <str> straight_join cross_join
+%type <joinTableExpr> inner_join outer_join natural_join
 %type <tableName> table_name into_table_name
 %type <aliasedTableName> aliased_table_name
-%type <indexHints> index_hint_list
+%type <indexHintList> index_hint_list index_hints
+%type <indexHints> index_hint
+%type <str> index_hint_for_opt
 %type <expr> where_expression_opt
 %type <expr> condition
 %type <boolVal> boolean_value
@@ -235,28 +449,41 @@ func forceEOF(yylex interface{}) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <groupBy> group_by_opt
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
+%type <over> over_opt
+%type <windowSpec> window_spec
+%type <windowFrame> frame_opt
+%type <frameBound> frame_bound
+%type <str> frame_unit
+%type <exprs> partition_by_opt
+%type <namedWindows> window_opt named_window_list
+%type <namedWindow> named_window
 %type <order> order
 %type <str> asc_desc_opt
-%type <limit> limit_opt
+%type <limit> limit_opt limit_clause top_opt
 %type <str> lock_opt
-%type <columns> ins_column_list column_list
+%type <selectInto> into_opt fields_opt enclosed_by_opt lines_opt
+%type <optVal> terminated_by_opt escaped_by_opt starting_by_opt
+%type <columns> ins_column_list column_list view_column_list_opt
 %type <partitions> opt_partition_clause partition_list
 %type <updateExprs> on_dup_opt
+%type <rowAlias> row_alias_opt
+%type <onConflict> on_conflict conflict_target_opt
 %type <updateExprs> update_list
 %type <setExprs> set_list transaction_chars
 %type <bytes> charset_or_character_set
 %type <updateExpr> update_expression
 %type <setExpr> set_expression transaction_char isolation_level
-%type <bytes> for_from
-%type <str> ignore_opt default_opt
+%type <str> ignore_opt
+%type <str> insert_priority_opt low_priority_opt quick_opt
 %type <str> extended_opt full_opt from_database_opt tables_or_processlist
 %type <showFilter> like_or_where_opt
-%type <byt> exists_opt
-%type <empty> not_exists_opt non_add_drop_or_rename_operation to_opt index_opt constraint_opt
-%type <bytes> reserved_keyword non_reserved_keyword
+%type <byt> exists_opt not_exists_opt
+%type <empty> to_opt index_opt
+%type <str> constraint_opt
+%type <bytes> reserved_keyword non_reserved_keyword column_name_id
 %type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt using_opt
 %type <expr> charset_value
 %type <tableIdent> table_id reserved_table_id table_alias as_opt_id
@@ -264,10 +491,11 @@ func forceEOF(yylex interface{}) {
 %type <empty> force_eof ddl_force_eof
 %type <str> charset
 %type <str> set_session_or_global show_session_or_global
-%type <convertType> convert_type
+%type <convertType> convert_type typecast_type weight_string_type
 %type <columnType> column_type
 %type <columnType> int_type decimal_type numeric_type time_type char_type spatial_type
-%type <optVal> length_opt column_default_opt column_comment_opt on_update_opt
+%type <columnType> typecast_column_type
+%type <optVal> length_opt column_default_opt column_default column_comment_opt on_update_opt srid_opt
 %type <str> charset_opt collate_opt
 %type <boolVal> unsigned_opt zero_fill_opt
 %type <LengthScaleOption> float_length_opt decimal_length_opt
@@ -291,7 +519,11 @@ func forceEOF(yylex interface{}) {
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <colIdent> vindex_type vindex_type_opt
-%type <bytes> alter_object_type
+%type <str> handler_read_direction flush_option
+%type <strs> flush_option_list
+%type <boolVal> flush_local_opt
+%type <strs> maintenance_option_list_opt maintenance_option_list
+%type <str> maintenance_option
 
 %start any_command
 
@@ -308,44 +540,161 @@ semicolon_opt:
 | ';' {}
 
 command:
-  select_statement
+  routine_statement
+| begin_statement
+| paren_select_statement
+  {
+    $$ = $1
+  }
+
+// paren_select_statement is a parenthesized SELECT or UNION at the
+// top level, or as the definition of a view, which can be followed by
+// an ORDER BY and a LIMIT. Nested parentheses are kept, as ParenSelect
+// of a ParenSelect.
+paren_select_statement:
+  paren_select
+  {
+    $$ = $1
+  }
+| paren_select ORDER BY order_list limit_opt
+  {
+    $1.OrderBy = $4
+    $1.Limit = $5
+    $$ = $1
+  }
+| paren_select limit_clause
+  {
+    $1.Limit = $2
+    $$ = $1
+  }
+
+paren_select:
+  openb select_statement closeb
+  {
+    $$ = &ParenSelect{Select: $2}
+  }
+| openb paren_select_statement closeb
+  {
+    $$ = &ParenSelect{Select: $2}
+  }
+
+// routine_statement is any statement that can be the body of a
+// trigger or an event. BEGIN starts a compound statement there.
+routine_statement:
+  with_select_statement
   {
     $$ = $1
   }
 | stream_statement
 | insert_statement
 | update_statement
+| with_clause update_statement
+  {
+    $2.(*Update).With = $1
+    $$ = $2
+  }
 | delete_statement
+| with_clause delete_statement
+  {
+    $2.(*Delete).With = $1
+    $$ = $2
+  }
 | set_statement
 | create_statement
 | alter_statement
 | rename_statement
 | drop_statement
 | truncate_statement
-| analyze_statement
+| maintenance_statement
 | show_statement
 | use_statement
-| begin_statement
 | commit_statement
 | rollback_statement
+| do_statement
+| handler_statement
+| flush_statement
+| kill_statement
+| xa_statement
+| lock_statement
+| unlock_statement
+| call_statement
+| prepare_statement
+| execute_statement
+| deallocate_statement
+| replication_statement
 | other_statement
 
 select_statement:
-  base_select order_by_opt limit_opt lock_opt
+  base_select order_by_opt limit_opt into_opt lock_opt
   {
     sel := $1.(*Select)
     sel.OrderBy = $2
-    sel.Limit = $3
-    sel.Lock = $4
+    if $3 != nil {
+      if sel.Limit != nil {
+        yylex.Error("cannot use both top and limit")
+        return 1
+      }
+      sel.Limit = $3
+    }
+    if $4 != nil {
+      if sel.Into != nil {
+        yylex.Error("cannot use into twice")
+        return 1
+      }
+      sel.Into = $4
+    }
+    sel.Lock = $5
     $$ = sel
   }
 | union_lhs union_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = &Union{Type: $2, Left: $1, Right: $3, OrderBy: $4, Limit: $5, Lock: $6}
   }
-| SELECT comment_opt cache_opt NEXT num_val for_from table_name
+| SELECT comment_opt select_options_opt top_opt NEXT num_val FROM table_name
+  {
+    if $4 != nil {
+      yylex.Error("unexpected top before next")
+      return 1
+    }
+    $$ = &Select{Comments: Comments($2), Options: $3, SelectExprs: SelectExprs{Nextval{Expr: $6}}, From: TableExprs{&AliasedTableExpr{Expr: $8}}}
+  }
+
+// with_select_statement is a select statement that can start with
+// a WITH clause.
+with_select_statement:
+  select_statement
+  {
+    $$ = $1
+  }
+| with_clause select_statement
+  {
+    $$ = withSelect($1, $2)
+  }
+
+with_clause:
+  WITH cte_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE cte_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+cte_list:
+  cte
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| cte_list ',' cte
+  {
+    $$ = append($1, $3)
+  }
+
+cte:
+  table_id view_column_list_opt AS subquery
   {
-    $$ = &Select{Comments: Comments($2), Cache: $3, SelectExprs: SelectExprs{Nextval{Expr: $5}}, From: TableExprs{&AliasedTableExpr{Expr: $7}}}
+    $$ = &CommonTableExpr{Name: $1, Columns: $2, Subquery: $4}
   }
 
 stream_statement:
@@ -356,9 +705,9 @@ stream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt select_options_opt top_opt select_expression_list into_opt from_opt where_expression_opt group_by_opt having_opt window_opt
   {
-    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10)}
+    $$ = &Select{Comments: Comments($2), Options: $3, Limit: $4, SelectExprs: $5, Into: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9.exprs), WithRollup: $9.rollup, Having: NewWhere(HavingStr, $10), Windows: $11}
   }
 
 union_lhs:
@@ -383,27 +732,36 @@ union_rhs:
 
 
 insert_statement:
-  insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause insert_data on_dup_opt
+  insert_or_replace comment_opt insert_priority_opt ignore_opt into_table_name opt_partition_clause insert_data on_dup_opt returning_opt
   {
     // insert_data returns a *Insert pre-filled with Columns & Values
-    ins := $6
+    ins := $7
     ins.Action = $1
     ins.Comments = $2
-    ins.Ignore = $3
-    ins.Table = $4
-    ins.Partitions = $5
-    ins.OnDup = OnDup($7)
+    ins.Priority = $3
+    ins.Ignore = $4
+    ins.Table = $5
+    ins.Partitions = $6
+    ins.OnDup = OnDup($8)
+    ins.Returning = $9
     $$ = ins
   }
-| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list on_dup_opt
+| insert_or_replace comment_opt insert_priority_opt ignore_opt into_table_name opt_partition_clause insert_data on_conflict returning_opt
   {
-    cols := make(Columns, 0, len($7))
-    vals := make(ValTuple, 0, len($8))
-    for _, updateList := range $7 {
-      cols = append(cols, updateList.Name.Name)
-      vals = append(vals, updateList.Expr)
-    }
-    $$ = &Insert{Action: $1, Comments: Comments($2), Ignore: $3, Table: $4, Partitions: $5, Columns: cols, Rows: Values{vals}, OnDup: OnDup($8)}
+    ins := $7
+    ins.Action = $1
+    ins.Comments = $2
+    ins.Priority = $3
+    ins.Ignore = $4
+    ins.Table = $5
+    ins.Partitions = $6
+    ins.OnConflict = $8
+    ins.Returning = $9
+    $$ = ins
+  }
+| insert_or_replace comment_opt insert_priority_opt ignore_opt into_table_name opt_partition_clause SET update_list row_alias_opt on_dup_opt returning_opt
+  {
+    $$ = &Insert{Action: $1, Comments: Comments($2), Priority: $3, Ignore: $4, Table: $5, Partitions: $6, SetExprs: $8, RowAlias: $9, OnDup: OnDup($10), Returning: $11}
   }
 
 insert_or_replace:
@@ -417,23 +775,23 @@ insert_or_replace:
   }
 
 update_statement:
-  UPDATE comment_opt table_references SET update_list where_expression_opt order_by_opt limit_opt
+  UPDATE comment_opt low_priority_opt ignore_opt table_references SET update_list where_expression_opt order_by_opt limit_opt returning_opt
   {
-    $$ = &Update{Comments: Comments($2), TableExprs: $3, Exprs: $5, Where: NewWhere(WhereStr, $6), OrderBy: $7, Limit: $8}
+    $$ = &Update{Comments: Comments($2), Priority: $3, Ignore: $4, TableExprs: $5, Exprs: $7, Where: NewWhere(WhereStr, $8), OrderBy: $9, Limit: $10, Returning: $11}
   }
 
 delete_statement:
-  DELETE comment_opt FROM table_name opt_partition_clause where_expression_opt order_by_opt limit_opt
+  DELETE comment_opt low_priority_opt quick_opt ignore_opt FROM table_name opt_partition_clause where_expression_opt order_by_opt limit_opt returning_opt
   {
-    $$ = &Delete{Comments: Comments($2), TableExprs:  TableExprs{&AliasedTableExpr{Expr:$4}}, Partitions: $5, Where: NewWhere(WhereStr, $6), OrderBy: $7, Limit: $8}
+    $$ = &Delete{Comments: Comments($2), Priority: $3, Quick: $4, Ignore: $5, TableExprs:  TableExprs{&AliasedTableExpr{Expr:$7}}, Partitions: $8, Where: NewWhere(WhereStr, $9), OrderBy: $10, Limit: $11, Returning: $12}
   }
-| DELETE comment_opt FROM table_name_list USING table_references where_expression_opt
+| DELETE comment_opt low_priority_opt quick_opt ignore_opt FROM table_name_list USING table_references where_expression_opt returning_opt
   {
-    $$ = &Delete{Comments: Comments($2), Targets: $4, TableExprs: $6, Where: NewWhere(WhereStr, $7)}
+    $$ = &Delete{Comments: Comments($2), Priority: $3, Quick: $4, Ignore: $5, Targets: $7, TableExprs: $9, Where: NewWhere(WhereStr, $10), Returning: $11}
   }
-| DELETE comment_opt table_name_list from_or_using table_references where_expression_opt
+| DELETE comment_opt low_priority_opt quick_opt ignore_opt table_name_list from_or_using table_references where_expression_opt returning_opt
   {
-    $$ = &Delete{Comments: Comments($2), Targets: $3, TableExprs: $5, Where: NewWhere(WhereStr, $6)}
+    $$ = &Delete{Comments: Comments($2), Priority: $3, Quick: $4, Ignore: $5, Targets: $6, TableExprs: $8, Where: NewWhere(WhereStr, $9), Returning: $10}
   }
 
 from_or_using:
@@ -535,18 +893,59 @@ create_statement:
     $1.TableSpec = $2
     $$ = $1
   }
-| CREATE constraint_opt INDEX ID using_opt ON table_name ddl_force_eof
+| create_table_prefix LIKE table_name
   {
-    // Change this to an alter statement
-    $$ = &DDL{Action: AlterStr, Table: $7, NewName:$7}
+    $1.OptLike = &OptLike{LikeTable: $3}
+    $$ = $1
+  }
+| create_table_prefix openb LIKE table_name closeb
+  {
+    $1.OptLike = &OptLike{LikeTable: $4}
+    $$ = $1
+  }
+| create_table_prefix select_statement
+  {
+    $1.AsSelect = $2
+    $$ = $1
+  }
+| create_table_prefix AS select_statement
+  {
+    $1.AsSelect = $3
+    $$ = $1
   }
-| CREATE VIEW table_name ddl_force_eof
+| create_table_prefix create_select_duplicate as_opt select_statement
   {
-    $$ = &DDL{Action: CreateStr, NewName: $3.ToViewName()}
+    $1.AsSelectDuplicate = $2
+    $1.AsSelect = $4
+    $$ = $1
+  }
+| create_table_prefix table_spec create_select_duplicate_opt as_opt select_statement
+  {
+    $1.TableSpec = $2
+    $1.AsSelectDuplicate = $3
+    $1.AsSelect = $5
+    $$ = $1
+  }
+| create_index_prefix ddl_force_eof
+  {
+    $1.AlterSpecs = nil
+    $$ = $1
   }
-| CREATE OR REPLACE VIEW table_name ddl_force_eof
+| create_index_prefix openb index_column_list closeb force_eof
   {
-    $$ = &DDL{Action: CreateStr, NewName: $5.ToViewName()}
+    $1.AlterSpecs[0].Index.Columns = $3
+    $$ = $1
+  }
+| create_view_prefix
+  {
+    $1.ViewSpec = nil
+    $$ = $1
+  }
+| create_view_prefix view_column_list_opt AS view_select
+  {
+    $1.ViewSpec.Columns = $2
+    $1.ViewSpec.Select = $4
+    $$ = $1
   }
 | CREATE VINDEX sql_id vindex_type_opt vindex_params_opt
   {
@@ -564,988 +963,2498 @@ create_statement:
   {
     $$ = &DBDDL{Action: CreateStr, DBName: string($4)}
   }
-
-vindex_type_opt:
+| CREATE trigger_definition
   {
-    $$ = NewColIdent("")
+    $$ = $2
   }
-| USING vindex_type
+| CREATE definer trigger_definition
   {
-    $$ = $2
+    $3.Definer = $2
+    $$ = $3
   }
-
-vindex_type:
-  ID
+| CREATE event_definition
   {
-    $$ = NewColIdent(string($1))
+    $$ = $2
   }
-
-vindex_params_opt:
+| CREATE definer event_definition
   {
-    var v []VindexParam
-    $$ = v
+    $3.Definer = $2
+    $$ = $3
   }
-| WITH vindex_param_list
+| CREATE procedure_definition
   {
     $$ = $2
   }
-
-vindex_param_list:
-  vindex_param
+| CREATE definer procedure_definition
   {
-    $$ = make([]VindexParam, 0, 4)
-    $$ = append($$, $1)
+    $3.Definer = $2
+    $$ = $3
   }
-| vindex_param_list ',' vindex_param
+| CREATE function_definition
   {
-    $$ = append($$, $3)
+    $$ = $2
   }
-
-vindex_param:
-  reserved_sql_id '=' table_opt_value
+| CREATE definer function_definition
   {
-    $$ = VindexParam{Key: $1, Val: $3}
+    $3.Definer = $2
+    $$ = $3
   }
 
-create_table_prefix:
-  CREATE TABLE not_exists_opt table_name
+definer:
+  DEFINER '=' definer_user
   {
-    $$ = &DDL{Action: CreateStr, NewName: $4}
-    setDDL(yylex, $$)
+    $$ = $3
   }
 
-table_spec:
-  '(' table_column_list ')' table_option_list
+definer_user:
+  CURRENT_USER
   {
-    $$ = $2
-    $$.Options = $4
+    $$ = "current_user"
   }
-
-table_column_list:
-  column_definition
+| CURRENT_USER openb closeb
   {
-    $$ = &TableSpec{}
-    $$.AddColumn($1)
+    $$ = "current_user"
   }
-| table_column_list ',' column_definition
+| account_name_parts
   {
-    $$.AddColumn($3)
+    $$ = formatAccountName($1)
   }
-| table_column_list ',' index_definition
+
+// An account name like `root`@`localhost` is scanned as several
+// tokens: the @ starts an identifier of its own.
+account_name_parts:
+  account_name_part
   {
-    $$.AddIndex($3)
+    $$ = []string{$1}
+  }
+| account_name_parts account_name_part
+  {
+    $$ = append($1, $2)
   }
 
-column_definition:
-  ID column_type null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
-  {
-    $2.NotNull = $3
-    $2.Default = $4
-    $2.OnUpdate = $5
-    $2.Autoincrement = $6
-    $2.KeyOpt = $7
-    $2.Comment = $8
-    $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
+account_name_part:
+  ID
+  {
+    $$ = string($1)
   }
-column_type:
-  numeric_type unsigned_opt zero_fill_opt
+| STRING
   {
-    $$ = $1
-    $$.Unsigned = $2
-    $$.Zerofill = $3
+    $$ = string($1)
   }
-| char_type
-| time_type
-| spatial_type
 
-numeric_type:
-  int_type length_opt
+trigger_definition:
+  trigger_head routine_statement
   {
+    $1.Body = $2
     $$ = $1
-    $$.Length = $2
   }
-| decimal_type
+| trigger_head compound_block
   {
+    $1.RawBody = $2
     $$ = $1
   }
 
-int_type:
-  BIT
+trigger_head:
+  trigger_spec
   {
-    $$ = ColumnType{Type: string($1)}
+    setInTrigger(yylex)
+    $$ = $1
   }
-| TINYINT
+| trigger_spec ID table_id
   {
-    $$ = ColumnType{Type: string($1)}
+    order := strings.ToLower(string($2))
+    if order != FollowsStr && order != PrecedesStr {
+      yylex.Error("expecting follows or precedes")
+      return 1
+    }
+    $1.Order = order
+    $1.OtherTrigger = $3
+    setInTrigger(yylex)
+    $$ = $1
   }
-| SMALLINT
+
+trigger_spec:
+  TRIGGER table_name trigger_timing trigger_event ON table_name FOR EACH ROW
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = &CreateTrigger{Name: $2, Timing: $3, Event: $4, Table: $6}
   }
-| MEDIUMINT
+| TRIGGER table_name trigger_timing trigger_event ON table_name FOR EACH ID
   {
-    $$ = ColumnType{Type: string($1)}
+    yylex.Error("expecting for each row")
+    return 1
   }
-| INT
+
+trigger_timing:
+  BEFORE
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = BeforeStr
   }
-| INTEGER
+| AFTER
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = AfterStr
   }
-| BIGINT
+| ID
   {
-    $$ = ColumnType{Type: string($1)}
+    yylex.Error("expecting before or after")
+    return 1
   }
 
-decimal_type:
-REAL float_length_opt
+trigger_event:
+  INSERT
   {
-    $$ = ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = InsertStr
   }
-| DOUBLE float_length_opt
+| UPDATE
   {
-    $$ = ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = UpdateStr
   }
-| FLOAT_TYPE float_length_opt
+| DELETE
   {
-    $$ = ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = DeleteStr
   }
-| DECIMAL decimal_length_opt
+
+event_definition:
+  event_head routine_statement
   {
-    $$ = ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $1.Body = $2
+    $$ = $1
   }
-| NUMERIC decimal_length_opt
+| event_head compound_block
   {
-    $$ = ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $1.RawBody = $2
+    $$ = $1
   }
 
-time_type:
-  DATE
+event_head:
+  EVENT not_exists_opt table_name ON ID event_schedule event_completion_opt event_status_opt event_comment_opt DO
   {
-    $$ = ColumnType{Type: string($1)}
+    if !strings.EqualFold(string($5), "schedule") {
+      yylex.Error("expecting on schedule")
+      return 1
+    }
+    $$ = &CreateEvent{
+      IfNotExists: $2 != 0,
+      Name: $3,
+      Schedule: $6,
+      OnCompletion: $7,
+      Status: $8,
+      Comment: $9,
+    }
   }
-| TIME length_opt
+
+event_schedule:
+  ID expression
   {
-    $$ = ColumnType{Type: string($1), Length: $2}
+    if !strings.EqualFold(string($1), "at") {
+      yylex.Error("expecting at or every")
+      return 1
+    }
+    $$ = "at " + String($2)
   }
-| TIMESTAMP length_opt
+| EVERY value_expression sql_id event_starts_opt event_ends_opt
   {
-    $$ = ColumnType{Type: string($1), Length: $2}
+    $$ = "every " + String($2) + " " + $3.Lowered() + $4 + $5
   }
-| DATETIME length_opt
+
+event_starts_opt:
   {
-    $$ = ColumnType{Type: string($1), Length: $2}
+    $$ = ""
   }
-| YEAR
+| STARTS expression
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = " starts " + String($2)
   }
 
-char_type:
-  CHAR length_opt charset_opt collate_opt
+event_ends_opt:
   {
-    $$ = ColumnType{Type: string($1), Length: $2, Charset: $3, Collate: $4}
+    $$ = ""
   }
-| VARCHAR length_opt charset_opt collate_opt
+| ENDS expression
   {
-    $$ = ColumnType{Type: string($1), Length: $2, Charset: $3, Collate: $4}
+    $$ = " ends " + String($2)
   }
-| BINARY length_opt
+
+event_completion_opt:
   {
-    $$ = ColumnType{Type: string($1), Length: $2}
+    $$ = ""
   }
-| VARBINARY length_opt
+| ON ID ID
   {
-    $$ = ColumnType{Type: string($1), Length: $2}
+    if !strings.EqualFold(string($2), "completion") || !strings.EqualFold(string($3), PreserveStr) {
+      yylex.Error("expecting on completion preserve")
+      return 1
+    }
+    $$ = PreserveStr
   }
-| TEXT charset_opt collate_opt
+| ON ID NOT ID
   {
-    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+    if !strings.EqualFold(string($2), "completion") || !strings.EqualFold(string($4), PreserveStr) {
+      yylex.Error("expecting on completion not preserve")
+      return 1
+    }
+    $$ = NotPreserveStr
   }
-| TINYTEXT charset_opt collate_opt
+
+event_status_opt:
   {
-    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+    $$ = ""
   }
-| MEDIUMTEXT charset_opt collate_opt
+| ID
   {
-    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+    switch strings.ToLower(string($1)) {
+    case EnableStr:
+      $$ = EnableStr
+    case DisableStr:
+      $$ = DisableStr
+    default:
+      yylex.Error("expecting enable or disable")
+      return 1
+    }
   }
-| LONGTEXT charset_opt collate_opt
+| ID ON ID
   {
-    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+    if !strings.EqualFold(string($1), DisableStr) || !strings.EqualFold(string($3), "slave") {
+      yylex.Error("expecting disable on slave")
+      return 1
+    }
+    $$ = DisableOnSlaveStr
   }
-| BLOB
+
+event_comment_opt:
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = nil
   }
-| TINYBLOB
+| COMMENT_KEYWORD STRING
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = NewStrVal($2)
   }
-| MEDIUMBLOB
+
+procedure_definition:
+  procedure_head routine_statement
   {
-    $$ = ColumnType{Type: string($1)}
+    $1.Body = $2
+    $$ = $1
   }
-| LONGBLOB
+| procedure_head compound_block
   {
-    $$ = ColumnType{Type: string($1)}
+    $1.RawBody = $2
+    $$ = $1
   }
-| JSON
+
+procedure_head:
+  PROCEDURE not_exists_opt table_name openb proc_param_list_opt closeb routine_characteristics_opt
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = &CreateProcedure{IfNotExists: $2 != 0, Name: $3, Params: $5, Characteristics: $7}
   }
-| ENUM '(' enum_values ')' charset_opt collate_opt
+
+// A function can't return a result set, so its body is always
+// a RETURN statement or a block.
+function_definition:
+  function_head compound_block
   {
-    $$ = ColumnType{Type: string($1), EnumValues: $3, Charset: $5, Collate: $6}
+    $1.RawBody = $2
+    $$ = $1
   }
-// need set_values / SetValues ?
-| SET '(' enum_values ')' charset_opt collate_opt
+| function_head return_statement
   {
-    $$ = ColumnType{Type: string($1), EnumValues: $3, Charset: $5, Collate: $6}
+    $1.RawBody = $2
+    $$ = $1
   }
 
-spatial_type:
-  GEOMETRY
+function_head:
+  FUNCTION not_exists_opt table_name openb proc_param_list_opt closeb ID column_type routine_characteristics_opt
   {
-    $$ = ColumnType{Type: string($1)}
+    if !strings.EqualFold(string($7), "returns") {
+      yylex.Error("expecting returns")
+      return 1
+    }
+    $$ = &CreateFunction{IfNotExists: $2 != 0, Name: $3, Params: $5, Returns: $8, Characteristics: $9}
   }
-| POINT
+
+proc_param_list_opt:
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = nil
   }
-| LINESTRING
+| proc_param_list
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = $1
   }
-| POLYGON
+
+proc_param_list:
+  proc_param
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = ProcParams{$1}
   }
-| GEOMETRYCOLLECTION
+| proc_param_list ',' proc_param
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = append($1, $3)
   }
-| MULTIPOINT
+
+proc_param:
+  proc_param_mode_opt sql_id column_type
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = &ProcParam{Mode: $1, Name: $2, Type: $3}
   }
-| MULTILINESTRING
+
+proc_param_mode_opt:
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = ""
   }
-| MULTIPOLYGON
+| IN
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = InStr
   }
-
-enum_values:
-  STRING
+| OUT
   {
-    $$ = make([]string, 0, 4)
-    $$ = append($$, "'" + string($1) + "'")
+    $$ = OutStr
   }
-| enum_values ',' STRING
+| INOUT
   {
-    $$ = append($1, "'" + string($3) + "'")
+    $$ = InOutStr
   }
 
-length_opt:
+routine_characteristics_opt:
   {
     $$ = nil
   }
-| '(' INTEGRAL ')'
+| routine_characteristics_opt routine_characteristic
   {
-    $$ = NewIntVal($2)
+    $$ = append($1, $2)
   }
 
-float_length_opt:
+routine_characteristic:
+  COMMENT_KEYWORD STRING
   {
-    $$ = LengthScaleOption{}
+    $$ = "comment " + String(NewStrVal($2))
   }
-| '(' INTEGRAL ',' INTEGRAL ')'
+| LANGUAGE SQL
   {
-    $$ = LengthScaleOption{
-        Length: NewIntVal($2),
-        Scale: NewIntVal($4),
-    }
+    $$ = "language sql"
   }
-
-decimal_length_opt:
+| DETERMINISTIC
   {
-    $$ = LengthScaleOption{}
+    $$ = "deterministic"
   }
-| '(' INTEGRAL ')'
+| NOT DETERMINISTIC
   {
-    $$ = LengthScaleOption{
-        Length: NewIntVal($2),
-    }
+    $$ = "not deterministic"
   }
-| '(' INTEGRAL ',' INTEGRAL ')'
+| ID SQL
   {
-    $$ = LengthScaleOption{
-        Length: NewIntVal($2),
-        Scale: NewIntVal($4),
+    switch strings.ToLower(string($1)) {
+    case "contains", "no":
+      $$ = strings.ToLower(string($1)) + " sql"
+    default:
+      yylex.Error("expecting contains sql or no sql")
+      return 1
     }
   }
-
-unsigned_opt:
+| READS SQL ID
   {
-    $$ = BoolVal(false)
+    if !strings.EqualFold(string($3), "data") {
+      yylex.Error("expecting reads sql data")
+      return 1
+    }
+    $$ = "reads sql data"
   }
-| UNSIGNED
+| MODIFIES SQL ID
   {
-    $$ = BoolVal(true)
+    if !strings.EqualFold(string($3), "data") {
+      yylex.Error("expecting modifies sql data")
+      return 1
+    }
+    $$ = "modifies sql data"
   }
-
-zero_fill_opt:
+| SQL ID DEFINER
   {
-    $$ = BoolVal(false)
+    if !strings.EqualFold(string($2), "security") {
+      yylex.Error("expecting sql security")
+      return 1
+    }
+    $$ = "sql security definer"
   }
-| ZEROFILL
+| SQL ID ID
   {
-    $$ = BoolVal(true)
+    if !strings.EqualFold(string($2), "security") || !strings.EqualFold(string($3), "invoker") {
+      yylex.Error("expecting sql security definer or invoker")
+      return 1
+    }
+    $$ = "sql security invoker"
   }
 
-// Null opt returns false to mean NULL (i.e. the default) and true for NOT NULL
-null_opt:
-  {
-    $$ = BoolVal(false)
-  }
-| NULL
+// return_statement is the RETURN statement of a function, which
+// is captured as text like compound_block.
+return_statement:
+  RETURN statement_capture
   {
-    $$ = BoolVal(false)
+    $$ = string($1) + " " + $2
   }
-| NOT NULL
+
+statement_capture:
   {
-    $$ = BoolVal(true)
+    body, ok := yylex.(*Tokenizer).scanStatementBody()
+    if !ok {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = strings.TrimLeft(body, " \n\r\t")
   }
 
-column_default_opt:
+// compound_block is a BEGIN ... END block, which is captured as
+// text. The empty rule is reduced before the token after BEGIN
+// is read, so the tokenizer is still right after it.
+compound_block:
+  BEGIN compound_capture
   {
-    $$ = nil
+    $$ = string($1) + $2
   }
-| DEFAULT STRING
+
+compound_capture:
   {
-    $$ = NewStrVal($2)
+    body, ok := yylex.(*Tokenizer).scanBlockBody()
+    if !ok {
+      yylex.Error("unterminated begin block")
+      return 1
+    }
+    $$ = body
   }
-| DEFAULT INTEGRAL
+
+vindex_type_opt:
   {
-    $$ = NewIntVal($2)
+    $$ = NewColIdent("")
   }
-| DEFAULT FLOAT
+| USING vindex_type
   {
-    $$ = NewFloatVal($2)
+    $$ = $2
   }
-| DEFAULT NULL
+
+vindex_type:
+  ID
   {
-    $$ = NewValArg($2)
+    $$ = NewColIdent(string($1))
   }
-| DEFAULT CURRENT_TIMESTAMP
+
+vindex_params_opt:
   {
-    $$ = NewValArg($2)
+    var v []VindexParam
+    $$ = v
   }
-| DEFAULT BIT_LITERAL
+| WITH vindex_param_list
   {
-    $$ = NewBitVal($2)
+    $$ = $2
   }
 
-on_update_opt:
+vindex_param_list:
+  vindex_param
   {
-    $$ = nil
+    $$ = make([]VindexParam, 0, 4)
+    $$ = append($$, $1)
   }
-| ON UPDATE CURRENT_TIMESTAMP
-{
-  $$ = NewValArg($3)
-}
-
-auto_increment_opt:
+| vindex_param_list ',' vindex_param
   {
-    $$ = BoolVal(false)
+    $$ = append($$, $3)
   }
-| AUTO_INCREMENT
+
+vindex_param:
+  reserved_sql_id '=' table_opt_value
   {
-    $$ = BoolVal(true)
+    $$ = VindexParam{Key: $1, Val: $3}
   }
 
-charset_opt:
+create_table_prefix:
+  CREATE TABLE not_exists_opt table_name
   {
-    $$ = ""
+    $$ = &DDL{Action: CreateStr, NewName: $4, IfNotExists: $3 != 0}
+    setDDL(yylex, $$)
   }
-| CHARACTER SET ID
+
+// The view prefixes set the partial DDL, without the definition, for
+// the views the parser doesn't fully parse, like the ones with an
+// ALGORITHM or a WITH CHECK OPTION.
+create_view_prefix:
+  CREATE VIEW table_name
   {
-    $$ = string($3)
+    $$ = &DDL{Action: CreateStr, NewName: $3.ToViewName(), ViewSpec: &ViewSpec{}}
+    setDDL(yylex, &DDL{Action: CreateStr, NewName: $3.ToViewName()})
   }
-| CHARACTER SET BINARY
+| CREATE OR REPLACE VIEW table_name
   {
-    $$ = string($3)
+    $$ = &DDL{Action: CreateStr, NewName: $5.ToViewName(), ViewSpec: &ViewSpec{OrReplace: true}}
+    setDDL(yylex, &DDL{Action: CreateStr, NewName: $5.ToViewName()})
   }
 
-collate_opt:
+// CREATE INDEX is changed to the ALTER TABLE that adds the index. The
+// prefix sets the partial DDL, without the index, for the statements
+// whose key parts the parser doesn't parse.
+create_index_prefix:
+  CREATE constraint_opt INDEX ID using_opt ON table_name
   {
-    $$ = ""
+    info := newCreateIndexInfo($2, NewColIdent(string($4)))
+    $$ = &DDL{Action: AlterStr, Table: $7, NewName: $7, AlterSpecs: []*AlterSpec{{Action: AddIndexStr, Index: &IndexDefinition{Info: info}}}}
+    setDDL(yylex, &DDL{Action: AlterStr, Table: $7, NewName: $7})
   }
-| COLLATE ID
+
+alter_view_prefix:
+  ALTER VIEW table_name
   {
-    $$ = string($2)
+    $$ = &DDL{Action: AlterStr, Table: $3.ToViewName(), NewName: $3.ToViewName()}
+    setDDL(yylex, $$)
   }
 
-column_key_opt:
+view_select:
+  select_statement
   {
-    $$ = colKeyNone
+    $$ = $1
   }
-| PRIMARY KEY
+| paren_select_statement
   {
-    $$ = colKeyPrimary
+    $$ = $1
   }
-| KEY
+
+view_column_list_opt:
   {
-    $$ = colKey
+    $$ = nil
   }
-| UNIQUE KEY
+| openb column_list closeb
   {
-    $$ = colKeyUniqueKey
+    $$ = $2
   }
-| UNIQUE
+
+table_spec:
+  '(' table_column_list ')' table_option_list
   {
-    $$ = colKeyUnique
+    $$ = $2
+    $$.Options = $4
   }
 
-column_comment_opt:
+table_column_list:
+  column_definition
   {
-    $$ = nil
+    $$ = &TableSpec{}
+    $$.AddColumn($1)
   }
-| COMMENT_KEYWORD STRING
+| table_column_list ',' column_definition
   {
-    $$ = NewStrVal($2)
+    $$.AddColumn($3)
   }
-
-index_definition:
-  index_info '(' index_column_list ')' index_option_list
+| table_column_list ',' index_definition
   {
-    $$ = &IndexDefinition{Info: $1, Columns: $3, Options: $5}
+    $$.AddIndex($3)
   }
-| index_info '(' index_column_list ')'
+| table_column_list ',' constraint_symbol index_definition
   {
-    $$ = &IndexDefinition{Info: $1, Columns: $3}
+    $4.Info.setConstraintName($3)
+    $$.AddIndex($4)
   }
-
-index_option_list:
-  index_option
+| table_column_list ',' foreign_key_definition
   {
-    $$ = []*IndexOption{$1}
+    $$.AddForeignKey($3)
   }
-| index_option_list index_option
+| table_column_list ',' constraint_symbol foreign_key_definition
   {
-    $$ = append($$, $2)
+    $4.Name = $3
+    $$.AddForeignKey($4)
   }
 
-index_option:
-  USING ID
-  {
-    $$ = &IndexOption{Name: string($1), Using: string($2)}
+column_definition:
+  column_name_id column_type srid_opt null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
+  {
+    $2.SRID = $3
+    $2.NotNull = $4
+    $2.Default = $5
+    $2.OnUpdate = $6
+    $2.Autoincrement = $7
+    $2.KeyOpt = $8
+    $2.Comment = $9
+    $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
   }
-| KEY_BLOCK_SIZE equal_opt INTEGRAL
-  {
-    // should not be string
-    $$ = &IndexOption{Name: string($1), Value: NewIntVal($3)}
+// column_name_id is the name of a column in a definition. FIRST and
+// AFTER are keywords for the position of a column in ALTER TABLE, but
+// they remain valid column names.
+column_name_id:
+  ID
+| FIRST
+| AFTER
+
+column_type:
+  numeric_type unsigned_opt zero_fill_opt
+  {
+    $$ = $1
+    $$.Unsigned = $2
+    $$.Zerofill = $3
+  }
+| char_type
+| time_type
+| spatial_type
+
+numeric_type:
+  int_type length_opt
+  {
+    $$ = $1
+    $$.Length = $2
+  }
+| decimal_type
+  {
+    $$ = $1
+  }
+
+int_type:
+  BIT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| TINYINT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| SMALLINT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| MEDIUMINT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| INT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| INTEGER
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| BIGINT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+
+decimal_type:
+REAL float_length_opt
+  {
+    $$ = ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
+  }
+| DOUBLE float_length_opt
+  {
+    $$ = ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
+  }
+| FLOAT_TYPE float_length_opt
+  {
+    $$ = ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
+  }
+| DECIMAL decimal_length_opt
+  {
+    $$ = ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
+  }
+| NUMERIC decimal_length_opt
+  {
+    $$ = ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
+  }
+
+time_type:
+  DATE
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| TIME length_opt
+  {
+    $$ = ColumnType{Type: string($1), Length: $2}
+  }
+| TIMESTAMP length_opt
+  {
+    $$ = ColumnType{Type: string($1), Length: $2}
+  }
+| DATETIME length_opt
+  {
+    $$ = ColumnType{Type: string($1), Length: $2}
+  }
+| YEAR
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+
+char_type:
+  CHAR length_opt charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), Length: $2, Charset: $3, Collate: $4}
+  }
+| VARCHAR length_opt charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), Length: $2, Charset: $3, Collate: $4}
+  }
+| BINARY length_opt
+  {
+    $$ = ColumnType{Type: string($1), Length: $2}
+  }
+| VARBINARY length_opt
+  {
+    $$ = ColumnType{Type: string($1), Length: $2}
+  }
+| TEXT charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+  }
+| TINYTEXT charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+  }
+| MEDIUMTEXT charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+  }
+| LONGTEXT charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), Charset: $2, Collate: $3}
+  }
+| BLOB
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| TINYBLOB
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| MEDIUMBLOB
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| LONGBLOB
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| JSON
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| ENUM '(' enum_values ')' charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), EnumValues: $3, Charset: $5, Collate: $6}
+  }
+// need set_values / SetValues ?
+| SET '(' enum_values ')' charset_opt collate_opt
+  {
+    $$ = ColumnType{Type: string($1), EnumValues: $3, Charset: $5, Collate: $6}
+  }
+
+spatial_type:
+  GEOMETRY
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| POINT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| LINESTRING
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| POLYGON
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| GEOMETRYCOLLECTION
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| MULTIPOINT
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| MULTILINESTRING
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| MULTIPOLYGON
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+
+srid_opt:
+  {
+    $$ = nil
+  }
+| ID INTEGRAL
+  {
+    if !strings.EqualFold(string($1), "srid") {
+      yylex.Error("expecting srid")
+      return 1
+    }
+    $$ = NewIntVal($2)
+  }
+
+enum_values:
+  STRING
+  {
+    $$ = make([]string, 0, 4)
+    $$ = append($$, "'" + string($1) + "'")
+  }
+| enum_values ',' STRING
+  {
+    $$ = append($1, "'" + string($3) + "'")
+  }
+
+length_opt:
+  {
+    $$ = nil
+  }
+| '(' INTEGRAL ')'
+  {
+    $$ = NewIntVal($2)
+  }
+
+float_length_opt:
+  {
+    $$ = LengthScaleOption{}
+  }
+| '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = LengthScaleOption{
+        Length: NewIntVal($2),
+        Scale: NewIntVal($4),
+    }
+  }
+
+decimal_length_opt:
+  {
+    $$ = LengthScaleOption{}
+  }
+| '(' INTEGRAL ')'
+  {
+    $$ = LengthScaleOption{
+        Length: NewIntVal($2),
+    }
+  }
+| '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = LengthScaleOption{
+        Length: NewIntVal($2),
+        Scale: NewIntVal($4),
+    }
+  }
+
+unsigned_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| UNSIGNED
+  {
+    $$ = BoolVal(true)
+  }
+
+zero_fill_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| ZEROFILL
+  {
+    $$ = BoolVal(true)
+  }
+
+// Null opt returns false to mean NULL (i.e. the default) and true for NOT NULL
+null_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| NULL
+  {
+    $$ = BoolVal(false)
+  }
+| NOT NULL
+  {
+    $$ = BoolVal(true)
+  }
+
+column_default_opt:
+  {
+    $$ = nil
+  }
+| DEFAULT column_default
+  {
+    $$ = $2
+  }
+
+column_default:
+  STRING
+  {
+    $$ = NewStrVal($1)
+  }
+| INTEGRAL
+  {
+    $$ = NewIntVal($1)
+  }
+| FLOAT
+  {
+    $$ = NewFloatVal($1)
+  }
+| DECIMAL_LITERAL
+  {
+    $$ = NewDecimalVal($1)
+  }
+| NULL
+  {
+    $$ = NewValArg($1)
+  }
+| CURRENT_TIMESTAMP
+  {
+    $$ = NewValArg($1)
+  }
+| BIT_LITERAL
+  {
+    $$ = NewBitVal($1)
+  }
+
+on_update_opt:
+  {
+    $$ = nil
+  }
+| ON UPDATE CURRENT_TIMESTAMP
+{
+  $$ = NewValArg($3)
+}
+
+auto_increment_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| AUTO_INCREMENT
+  {
+    $$ = BoolVal(true)
+  }
+
+charset_opt:
+  {
+    $$ = ""
+  }
+| CHARACTER SET ID
+  {
+    $$ = string($3)
+  }
+| CHARACTER SET BINARY
+  {
+    $$ = string($3)
+  }
+| CHARSET ID
+  {
+    $$ = string($2)
+  }
+| CHARSET BINARY
+  {
+    $$ = string($2)
+  }
+
+collate_opt:
+  {
+    $$ = ""
+  }
+| COLLATE ID
+  {
+    $$ = string($2)
+  }
+
+column_key_opt:
+  {
+    $$ = colKeyNone
+  }
+| PRIMARY KEY
+  {
+    $$ = colKeyPrimary
+  }
+| KEY
+  {
+    $$ = colKey
+  }
+| UNIQUE KEY
+  {
+    $$ = colKeyUniqueKey
+  }
+| UNIQUE
+  {
+    $$ = colKeyUnique
+  }
+
+column_comment_opt:
+  {
+    $$ = nil
+  }
+| COMMENT_KEYWORD STRING
+  {
+    $$ = NewStrVal($2)
+  }
+
+index_definition:
+  index_info '(' index_column_list ')' index_option_list
+  {
+    $$ = &IndexDefinition{Info: $1, Columns: $3, Options: $5}
+  }
+| index_info '(' index_column_list ')'
+  {
+    $$ = &IndexDefinition{Info: $1, Columns: $3}
+  }
+
+// constraint_symbol is the CONSTRAINT [symbol] that can name an index
+// or a foreign key.
+constraint_symbol:
+  CONSTRAINT
+  {
+    $$ = ColIdent{}
+  }
+| CONSTRAINT ID
+  {
+    $$ = NewColIdent(string($2))
+  }
+
+foreign_key_definition:
+  FOREIGN KEY fk_index_name_opt '(' column_list ')' REFERENCES table_name '(' column_list ')' fk_reference_opts
+  {
+    $$ = $12
+    $$.IndexName = $3
+    $$.Source = $5
+    $$.ReferencedTable = $8
+    $$.ReferencedColumns = $10
+  }
+
+fk_index_name_opt:
+  {
+    $$ = ColIdent{}
+  }
+| ID
+  {
+    $$ = NewColIdent(string($1))
+  }
+
+// fk_reference_opts are the ON DELETE and ON UPDATE actions of a
+// ForeignKeyDefinition, in any order.
+fk_reference_opts:
+  {
+    $$ = &ForeignKeyDefinition{}
+  }
+| ON DELETE fk_reference_option
+  {
+    $$ = &ForeignKeyDefinition{OnDelete: $3}
+  }
+| ON UPDATE fk_reference_option
+  {
+    $$ = &ForeignKeyDefinition{OnUpdate: $3}
+  }
+| ON DELETE fk_reference_option ON UPDATE fk_reference_option
+  {
+    $$ = &ForeignKeyDefinition{OnDelete: $3, OnUpdate: $6}
+  }
+| ON UPDATE fk_reference_option ON DELETE fk_reference_option
+  {
+    $$ = &ForeignKeyDefinition{OnDelete: $6, OnUpdate: $3}
+  }
+
+fk_reference_option:
+  RESTRICT
+  {
+    $$ = "restrict"
+  }
+| CASCADE
+  {
+    $$ = "cascade"
+  }
+| SET NULL
+  {
+    $$ = "set null"
+  }
+| SET DEFAULT
+  {
+    $$ = "set default"
+  }
+| ID ID
+  {
+    if !strings.EqualFold(string($1), "no") || !strings.EqualFold(string($2), "action") {
+      yylex.Error("expecting no action")
+      return 1
+    }
+    $$ = "no action"
+  }
+
+index_option_list:
+  index_option
+  {
+    $$ = []*IndexOption{$1}
+  }
+| index_option_list index_option
+  {
+    $$ = append($$, $2)
+  }
+
+index_option:
+  USING ID
+  {
+    $$ = &IndexOption{Name: string($1), Using: string($2)}
+  }
+| KEY_BLOCK_SIZE equal_opt INTEGRAL
+  {
+    // should not be string
+    $$ = &IndexOption{Name: string($1), Value: NewIntVal($3)}
+  }
+| COMMENT_KEYWORD STRING
+  {
+    $$ = &IndexOption{Name: string($1), Value: NewStrVal($2)}
+  }
+
+equal_opt:
+  /* empty */
+  {
+    $$ = ""
+  }
+| '='
+  {
+    $$ = string($1)
+  }
+
+index_info:
+  PRIMARY KEY
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+  }
+| SPATIAL index_or_key ID
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Spatial: true, Unique: false}
+  }
+| SPATIAL index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Spatial: true, Unique: false}
+  }
+| FULLTEXT index_or_key ID
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Fulltext: true, Unique: false}
+  }
+| FULLTEXT index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Fulltext: true, Unique: false}
+  }
+| UNIQUE index_or_key ID
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Unique: true}
+  }
+| UNIQUE index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Unique: true}
+  }
+| UNIQUE ID
+  {
+    $$ = &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Unique: true}
+  }
+| UNIQUE
+  {
+    $$ = &IndexInfo{Type: string($1), Unique: true}
+  }
+| index_or_key ID
+  {
+    $$ = &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Unique: false}
+  }
+| index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1), Unique: false}
+  }
+
+index_or_key:
+    INDEX
+  {
+    $$ = string($1)
+  }
+  | KEY
+  {
+    $$ = string($1)
+  }
+
+index_column_list:
+  index_column
+  {
+    $$ = []*IndexColumn{$1}
+  }
+| index_column_list ',' index_column
+  {
+    $$ = append($$, $3)
+  }
+
+index_column:
+  sql_id length_opt
+  {
+      $$ = &IndexColumn{Column: $1, Length: $2}
+  }
+| openb expression closeb
+  {
+      $$ = &IndexColumn{Expr: $2}
+  }
+
+table_option_list:
+  %prec TABLE_OPTIONS_END
+  {
+    $$ = ""
+  }
+| table_option %prec TABLE_OPTIONS_END
+  {
+    $$ = " " + string($1)
+  }
+| table_option_list ',' table_option %prec TABLE_OPTIONS_END
+  {
+    $$ = string($1) + ", " + string($3)
+  }
+
+// rather than explicitly parsing the various keywords for table options,
+// just accept any number of keywords, IDs, strings, numbers, and '='
+table_option:
+  table_opt_value
+  {
+    $$ = $1
+  }
+| table_option table_opt_value
+  {
+    $$ = $1 + " " + $2
+  }
+| table_option '=' table_opt_value
+  {
+    $$ = $1 + "=" + $3
+  }
+
+table_opt_value:
+  reserved_sql_id
+  {
+    $$ = $1.String()
+  }
+| STRING
+  {
+    $$ = "'" + string($1) + "'"
+  }
+| INTEGRAL
+  {
+    $$ = string($1)
+  }
+
+alter_table_prefix:
+  ALTER ignore_opt TABLE table_name
+  {
+    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    setDDL(yylex, $$)
+  }
+
+alter_statement:
+  alter_table_prefix alter_spec_list
+  {
+    $1.AlterSpecs = $2
+    $$ = $1
+  }
+| alter_table_prefix ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
+  {
+    $1.Action = AddColVindexStr
+    $1.NewName = TableName{}
+    $1.VindexSpec = &VindexSpec{
+      Name: $4,
+      Type: $8,
+      Params: $9,
+    }
+    $1.VindexCols = $6
+    $$ = $1
+  }
+| alter_table_prefix DROP VINDEX sql_id
+  {
+    $1.Action = DropColVindexStr
+    $1.NewName = TableName{}
+    $1.VindexSpec = &VindexSpec{
+      Name: $4,
+    }
+    $$ = $1
+  }
+| alter_table_prefix RENAME to_opt table_name
+  {
+    // Change this to a rename statement
+    $1.Action = RenameStr
+    $1.NewName = $4
+    $$ = $1
+  }
+| alter_view_prefix
+  {
+    $$ = $1
+  }
+| alter_view_prefix view_column_list_opt AS view_select
+  {
+    $1.ViewSpec = &ViewSpec{Columns: $2, Select: $4}
+    $$ = $1
+  }
+| alter_table_prefix partition_operation
+  {
+    $1.NewName = TableName{}
+    $1.PartitionSpec = $2
+    $$ = $1
+  }
+
+alter_spec_list:
+  alter_spec
+  {
+    $$ = []*AlterSpec{$1}
+  }
+| alter_spec_list ',' alter_spec
+  {
+    $$ = append($1, $3)
+  }
+
+// The alterations that are not parsed, like ORDER BY or DISCARD
+// TABLESPACE, make the ALTER TABLE a partially parsed DDL.
+alter_spec:
+  ADD column_definition column_position_opt
+  {
+    $$ = $3
+    $$.Action = AddColumnStr
+    $$.Column = $2
+  }
+| ADD COLUMN column_definition column_position_opt
+  {
+    $$ = $4
+    $$.Action = AddColumnStr
+    $$.Column = $3
+  }
+| ADD constraint_symbol index_definition
+  {
+    $3.Info.setConstraintName($2)
+    $$ = &AlterSpec{Action: AddIndexStr, Index: $3}
+  }
+| ADD foreign_key_definition
+  {
+    $$ = &AlterSpec{Action: AddForeignKeyStr, ForeignKey: $2}
+  }
+| ADD constraint_symbol foreign_key_definition
+  {
+    $3.Name = $2
+    $$ = &AlterSpec{Action: AddForeignKeyStr, ForeignKey: $3}
+  }
+| ADD index_definition
+  {
+    $$ = &AlterSpec{Action: AddIndexStr, Index: $2}
+  }
+| DROP ID
+  {
+    $$ = &AlterSpec{Action: DropColumnStr, Name: NewColIdent(string($2))}
+  }
+| DROP COLUMN sql_id
+  {
+    $$ = &AlterSpec{Action: DropColumnStr, Name: $3}
+  }
+| DROP index_opt sql_id
+  {
+    $$ = &AlterSpec{Action: DropIndexStr, Name: $3}
+  }
+| DROP PRIMARY KEY
+  {
+    $$ = &AlterSpec{Action: DropPrimaryKeyStr}
+  }
+| DROP FOREIGN KEY sql_id
+  {
+    $$ = &AlterSpec{Action: DropForeignKeyStr, Name: $4}
+  }
+| CHANGE column_name_id column_definition column_position_opt
+  {
+    $$ = $4
+    $$.Action = ChangeColumnStr
+    $$.Name = NewColIdent(string($2))
+    $$.Column = $3
+  }
+| CHANGE COLUMN column_name_id column_definition column_position_opt
+  {
+    $$ = $5
+    $$.Action = ChangeColumnStr
+    $$.Name = NewColIdent(string($3))
+    $$.Column = $4
+  }
+// MODIFY [COLUMN]
+| ID column_definition column_position_opt
+  {
+    if !strings.EqualFold(string($1), "modify") {
+      yylex.Error("expecting modify")
+      return 1
+    }
+    $$ = $3
+    $$.Action = ModifyColumnStr
+    $$.Column = $2
+  }
+| ID COLUMN column_definition column_position_opt
+  {
+    if !strings.EqualFold(string($1), "modify") {
+      yylex.Error("expecting modify")
+      return 1
+    }
+    $$ = $4
+    $$.Action = ModifyColumnStr
+    $$.Column = $3
+  }
+| ALTER column_name_id SET DEFAULT column_default
+  {
+    $$ = &AlterSpec{Action: SetDefaultStr, Name: NewColIdent(string($2)), Default: $5}
+  }
+| ALTER COLUMN column_name_id SET DEFAULT column_default
+  {
+    $$ = &AlterSpec{Action: SetDefaultStr, Name: NewColIdent(string($3)), Default: $6}
+  }
+| ALTER column_name_id DROP DEFAULT
+  {
+    $$ = &AlterSpec{Action: DropDefaultStr, Name: NewColIdent(string($2))}
+  }
+| ALTER COLUMN column_name_id DROP DEFAULT
+  {
+    $$ = &AlterSpec{Action: DropDefaultStr, Name: NewColIdent(string($3))}
+  }
+| RENAME COLUMN column_name_id TO column_name_id
+  {
+    $$ = &AlterSpec{Action: RenameColumnStr, Name: NewColIdent(string($3)), NewName: NewColIdent(string($5))}
+  }
+| RENAME index_opt sql_id TO sql_id
+  {
+    $$ = &AlterSpec{Action: RenameIndexStr, Name: $3, NewName: $5}
+  }
+| CONVERT TO CHARACTER SET table_opt_value collate_opt
+  {
+    $$ = &AlterSpec{Action: ConvertCharsetStr, Value: $5, Collate: $6}
+  }
+| CONVERT TO CHARSET table_opt_value collate_opt
+  {
+    $$ = &AlterSpec{Action: ConvertCharsetStr, Value: $4, Collate: $5}
+  }
+| FORCE
+  {
+    $$ = &AlterSpec{Action: ForceRebuildStr}
+  }
+// ENGINE = InnoDB, ALGORITHM = INPLACE, ...
+| ID '=' table_opt_value
+  {
+    $$ = &AlterSpec{Action: TableOptionStr, Option: strings.ToLower(string($1)), Value: $3}
+  }
+| table_option_keyword equal_opt table_opt_value
+  {
+    $$ = &AlterSpec{Action: TableOptionStr, Option: $1, Value: $3}
+  }
+
+table_option_keyword:
+  LOCK
+  {
+    $$ = "lock"
+  }
+| AUTO_INCREMENT
+  {
+    $$ = "auto_increment"
+  }
+| COMMENT_KEYWORD
+  {
+    $$ = "comment"
+  }
+| KEY_BLOCK_SIZE
+  {
+    $$ = "key_block_size"
+  }
+| default_opt CHARACTER SET
+  {
+    $$ = $1 + "character set"
+  }
+| default_opt CHARSET
+  {
+    $$ = $1 + "charset"
+  }
+| default_opt COLLATE
+  {
+    $$ = $1 + "collate"
+  }
+
+default_opt:
+  {
+    $$ = ""
+  }
+| DEFAULT
+  {
+    $$ = "default "
+  }
+
+// column_position_opt is the position of a column, FIRST or
+// AFTER column, in an AlterSpec that has no Action yet.
+column_position_opt:
+  {
+    $$ = &AlterSpec{}
+  }
+| FIRST
+  {
+    $$ = &AlterSpec{First: true}
+  }
+| AFTER sql_id
+  {
+    $$ = &AlterSpec{After: $2}
+  }
+
+partition_operation:
+  REORGANIZE PARTITION sql_id INTO openb partition_definitions closeb
+  {
+    $$ = &PartitionSpec{Action: ReorganizeStr, Name: $3, Definitions: $6}
+  }
+
+partition_definitions:
+  partition_definition
+  {
+    $$ = []*PartitionDefinition{$1}
+  }
+| partition_definitions ',' partition_definition
+  {
+    $$ = append($1, $3)
+  }
+
+partition_definition:
+  PARTITION sql_id VALUES LESS THAN openb value_expression closeb
+  {
+    $$ = &PartitionDefinition{Name: $2, Limit: $7}
+  }
+| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
+  }
+
+rename_statement:
+  RENAME TABLE table_name TO table_name
+  {
+    $$ = &DDL{Action: RenameStr, Table: $3, NewName: $5}
+  }
+
+drop_statement:
+  DROP TABLE exists_opt table_name
+  {
+    var exists bool
+    if $3 != 0 {
+      exists = true
+    }
+    $$ = &DDL{Action: DropStr, Table: $4, IfExists: exists}
+  }
+| DROP INDEX ID ON table_name ddl_force_eof
+  {
+    // Change this to an alter statement
+    $$ = &DDL{Action: AlterStr, Table: $5, NewName: $5, AlterSpecs: []*AlterSpec{{Action: DropIndexStr, Name: NewColIdent(string($3))}}}
+  }
+| DROP VIEW exists_opt table_name ddl_force_eof
+  {
+    var exists bool
+        if $3 != 0 {
+          exists = true
+        }
+    $$ = &DDL{Action: DropStr, Table: $4.ToViewName(), IfExists: exists}
+  }
+| DROP DATABASE exists_opt ID
+  {
+    $$ = &DBDDL{Action: DropStr, DBName: string($4)}
+  }
+| DROP SCHEMA exists_opt ID
+  {
+    $$ = &DBDDL{Action: DropStr, DBName: string($4)}
+  }
+| DROP TRIGGER exists_opt table_name
+  {
+    $$ = &DropTrigger{IfExists: $3 != 0, Name: $4}
+  }
+| DROP EVENT exists_opt table_name
+  {
+    $$ = &DropEvent{IfExists: $3 != 0, Name: $4}
+  }
+| DROP PROCEDURE exists_opt table_name
+  {
+    $$ = &DropRoutine{Type: ProcedureStr, IfExists: $3 != 0, Name: $4}
+  }
+| DROP FUNCTION exists_opt table_name
+  {
+    $$ = &DropRoutine{Type: FunctionStr, IfExists: $3 != 0, Name: $4}
+  }
+
+truncate_statement:
+  TRUNCATE TABLE table_name
+  {
+    $$ = &DDL{Action: TruncateStr, Table: $3}
+  }
+| TRUNCATE table_name
+  {
+    $$ = &DDL{Action: TruncateStr, Table: $2}
+  }
+maintenance_statement:
+  ANALYZE flush_local_opt table_or_tables table_name_list
+  {
+    $$ = &TableMaintenance{Action: AnalyzeStr, IsLocal: bool($2), Tables: $4}
+  }
+| OPTIMIZE flush_local_opt table_or_tables table_name_list
+  {
+    $$ = &TableMaintenance{Action: OptimizeStr, IsLocal: bool($2), Tables: $4}
+  }
+| REPAIR flush_local_opt table_or_tables table_name_list maintenance_option_list_opt
+  {
+    if option := invalidMaintenanceOption(RepairStr, $5); option != "" {
+      yylex.Error("unexpected option " + option + " for repair")
+      return 1
+    }
+    $$ = &TableMaintenance{Action: RepairStr, IsLocal: bool($2), Tables: $4, Options: $5}
+  }
+| CHECK table_or_tables table_name_list maintenance_option_list_opt
+  {
+    if option := invalidMaintenanceOption(CheckStr, $4); option != "" {
+      yylex.Error("unexpected option " + option + " for check")
+      return 1
+    }
+    $$ = &TableMaintenance{Action: CheckStr, Tables: $3, Options: $4}
+  }
+| CHECKSUM table_or_tables table_name_list maintenance_option_list_opt
+  {
+    if option := invalidMaintenanceOption(ChecksumStr, $4); option != "" {
+      yylex.Error("unexpected option " + option + " for checksum")
+      return 1
+    }
+    $$ = &TableMaintenance{Action: ChecksumStr, Tables: $3, Options: $4}
+  }
+| CACHE INDEX index_cache_table_list IN sql_id
+  {
+    for _, table := range $3 {
+      if table.IgnoreLeaves {
+        yylex.Error("unexpected ignore leaves for cache index")
+        return 1
+      }
+    }
+    $$ = &IndexCache{Action: CacheIndexStr, Tables: $3, KeyCache: $5}
+  }
+| LOAD INDEX INTO CACHE index_cache_table_list
+  {
+    $$ = &IndexCache{Action: LoadIndexStr, Tables: $5}
+  }
+
+index_cache_table_list:
+  index_cache_table
+  {
+    $$ = IndexCacheTables{$1}
+  }
+| index_cache_table_list ',' index_cache_table
+  {
+    $$ = append($1, $3)
+  }
+
+index_cache_table:
+  table_name opt_partition_clause index_cache_indexes_opt ignore_leaves_opt
+  {
+    $$ = &IndexCacheTable{Table: $1, Partitions: $2, Indexes: $3, IgnoreLeaves: bool($4)}
+  }
+| table_name PARTITION openb ALL closeb index_cache_indexes_opt ignore_leaves_opt
+  {
+    $$ = &IndexCacheTable{Table: $1, AllPartitions: true, Indexes: $6, IgnoreLeaves: bool($7)}
+  }
+
+index_cache_indexes_opt:
+  {
+    $$ = nil
+  }
+| INDEX openb column_list closeb
+  {
+    $$ = $3
+  }
+| KEY openb column_list closeb
+  {
+    $$ = $3
+  }
+
+ignore_leaves_opt:
+  {
+    $$ = false
+  }
+| IGNORE ID
+  {
+    if strings.ToLower(string($2)) != "leaves" {
+      yylex.Error("expecting leaves after ignore")
+      return 1
+    }
+    $$ = true
+  }
+
+table_or_tables:
+  TABLE {}
+| TABLES {}
+
+maintenance_option_list_opt:
+  {
+    $$ = nil
+  }
+| maintenance_option_list
+  {
+    $$ = $1
+  }
+
+maintenance_option_list:
+  maintenance_option
+  {
+    $$ = []string{$1}
+  }
+| maintenance_option_list maintenance_option
+  {
+    $$ = append($1, $2)
+  }
+
+maintenance_option:
+  ID
+  {
+    $$ = strings.ToLower(string($1))
+  }
+| EXTENDED
+  {
+    $$ = string($1)
+  }
+| QUICK
+  {
+    $$ = string($1)
+  }
+| FOR ID
+  {
+    if strings.ToLower(string($2)) != "upgrade" {
+      yylex.Error("expecting upgrade after for")
+      return 1
+    }
+    $$ = "for upgrade"
+  }
+
+show_statement:
+  SHOW BINARY ID ddl_force_eof /* SHOW BINARY LOGS */
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CHARACTER SET ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CREATE DATABASE ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CREATE EVENT ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CREATE FUNCTION ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
   }
-| COMMENT_KEYWORD STRING
+/* Rule to handle SHOW CREATE USER, etc. */
+| SHOW CREATE ID ddl_force_eof
   {
-    $$ = &IndexOption{Name: string($1), Value: NewStrVal($2)}
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CREATE PROCEDURE ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CREATE TABLE ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CREATE TRIGGER ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW CREATE VIEW ddl_force_eof
+  {
+    $$ = &Show{Type: string($2) + " " + string($3)}
+  }
+| SHOW DATABASES ddl_force_eof
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW FUNCTION ddl_force_eof
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW INDEX ddl_force_eof
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW KEYS ddl_force_eof
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW PROCEDURE ddl_force_eof
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW show_session_or_global STATUS ddl_force_eof
+  {
+    $$ = &Show{Scope: $2, Type: string($3)}
+  }
+| SHOW TABLE ddl_force_eof
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW extended_opt full_opt tables_or_processlist from_database_opt like_or_where_opt
+  {
+    // this is ugly, but I couldn't find a better way for now
+    if $4 == "processlist" {
+      $$ = &Show{Type: $4}
+    } else {
+      showTablesOpt := &ShowTablesOpt{Extended: $2, Full:$3, DbName:$5, Filter:$6}
+      $$ = &Show{Type: $4, ShowTablesOpt: showTablesOpt}
+    }
+  }
+| SHOW show_session_or_global VARIABLES ddl_force_eof
+  {
+    $$ = &Show{Scope: $2, Type: string($3)}
+  }
+| SHOW VINDEXES
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW VINDEXES ON table_name
+  {
+    $$ = &Show{Type: string($2), OnTable: $4}
+  }
+| SHOW VITESS_KEYSPACES
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW VITESS_SHARDS
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW VITESS_TABLETS
+  {
+    $$ = &Show{Type: string($2)}
+  }
+| SHOW VSCHEMA_TABLES
+  {
+    $$ = &Show{Type: string($2)}
+  }
+/*
+ * Catch-all for show statements without vitess keywords:
+ *
+ *  SHOW BINARY LOGS
+ *  SHOW INVALID
+ */
+| SHOW ID ddl_force_eof
+  {
+    $$ = &Show{Type: string($2)}
   }
 
-equal_opt:
+tables_or_processlist:
+  TABLES
+  {
+    $$ = string($1)
+  }
+| PROCESSLIST
+  {
+    $$ = string($1)
+  }
+
+extended_opt:
   /* empty */
   {
     $$ = ""
   }
-| '='
+| EXTENDED
   {
-    $$ = string($1)
+    $$ = "extended "
   }
 
-index_info:
-  PRIMARY KEY
+full_opt:
+  /* empty */
   {
-    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+    $$ = ""
   }
-| SPATIAL index_or_key ID
+| FULL
   {
-    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Spatial: true, Unique: false}
+    $$ = "full "
   }
-| UNIQUE index_or_key ID
+
+from_database_opt:
+  /* empty */
   {
-    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Unique: true}
+    $$ = ""
   }
-| UNIQUE ID
+| FROM table_id
   {
-    $$ = &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Unique: true}
+    $$ = $2.v
   }
-| index_or_key ID
+| IN table_id
   {
-    $$ = &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Unique: false}
+    $$ = $2.v
   }
 
-index_or_key:
-    INDEX
+like_or_where_opt:
+  /* empty */
   {
-    $$ = string($1)
+    $$ = nil
   }
-  | KEY
+| LIKE STRING
   {
-    $$ = string($1)
+    $$ = &ShowFilter{Like:string($2)}
+  }
+| WHERE expression
+  {
+    $$ = &ShowFilter{Filter:$2}
   }
 
-index_column_list:
-  index_column
+show_session_or_global:
+  /* empty */
   {
-    $$ = []*IndexColumn{$1}
+    $$ = ""
   }
-| index_column_list ',' index_column
+| SESSION
   {
-    $$ = append($$, $3)
+    $$ = SessionStr
+  }
+| GLOBAL
+  {
+    $$ = GlobalStr
   }
 
-index_column:
-  sql_id length_opt
+use_statement:
+  USE table_id
   {
-      $$ = &IndexColumn{Column: $1, Length: $2}
+    $$ = &Use{DBName: $2}
+  }
+| USE
+  {
+    $$ = &Use{DBName:TableIdent{v:""}}
   }
 
-table_option_list:
+begin_statement:
+  BEGIN
   {
-    $$ = ""
+    $$ = &Begin{}
   }
-| table_option
+| START TRANSACTION
   {
-    $$ = " " + string($1)
+    $$ = &Begin{}
   }
-| table_option_list ',' table_option
+
+commit_statement:
+  COMMIT
   {
-    $$ = string($1) + ", " + string($3)
+    $$ = &Commit{}
   }
 
-// rather than explicitly parsing the various keywords for table options,
-// just accept any number of keywords, IDs, strings, numbers, and '='
-table_option:
-  table_opt_value
+rollback_statement:
+  ROLLBACK
   {
-    $$ = $1
+    $$ = &Rollback{}
   }
-| table_option table_opt_value
+
+do_statement:
+  DO expression_list
   {
-    $$ = $1 + " " + $2
+    $$ = &Do{Exprs: $2}
   }
-| table_option '=' table_opt_value
+
+handler_statement:
+  HANDLER table_name ID as_opt_id
   {
-    $$ = $1 + "=" + $3
+    switch strings.ToLower(string($3)) {
+    case HandlerOpenStr:
+      $$ = &Handler{Action: HandlerOpenStr, Table: $2, As: $4}
+    case HandlerCloseStr:
+      if !$4.IsEmpty() {
+        yylex.Error("syntax error")
+        return 1
+      }
+      $$ = &Handler{Action: HandlerCloseStr, Table: $2}
+    default:
+      yylex.Error("expecting open or close after handler table")
+      return 1
+    }
+  }
+| HANDLER table_name READ sql_id compare row_tuple where_expression_opt limit_opt
+  {
+    $$ = &Handler{Action: HandlerReadStr, Table: $2, Index: $4, Operator: $5, Values: $6, Where: NewWhere(WhereStr, $7), Limit: $8}
+  }
+| HANDLER table_name READ sql_id handler_read_direction where_expression_opt limit_opt
+  {
+    $$ = &Handler{Action: HandlerReadStr, Table: $2, Index: $4, Operator: $5, Where: NewWhere(WhereStr, $6), Limit: $7}
+  }
+| HANDLER table_name READ handler_read_direction where_expression_opt limit_opt
+  {
+    $$ = &Handler{Action: HandlerReadStr, Table: $2, Operator: $4, Where: NewWhere(WhereStr, $5), Limit: $6}
   }
 
-table_opt_value:
-  reserved_sql_id
+handler_read_direction:
+  sql_id
   {
-    $$ = $1.String()
+    switch $1.Lowered() {
+    case HandlerFirstStr, HandlerPrevStr, HandlerLastStr:
+      $$ = $1.Lowered()
+    default:
+      yylex.Error("expecting first, next, prev or last")
+      return 1
+    }
   }
-| STRING
+| NEXT
   {
-    $$ = "'" + string($1) + "'"
+    $$ = HandlerNextStr
   }
-| INTEGRAL
+
+flush_statement:
+  FLUSH flush_local_opt flush_option_list
   {
-    $$ = string($1)
+    $$ = &Flush{IsLocal: bool($2), FlushOptions: $3}
+  }
+| FLUSH flush_local_opt table_or_tables
+  {
+    $$ = &Flush{IsLocal: bool($2)}
+  }
+| FLUSH flush_local_opt table_or_tables table_name_list
+  {
+    $$ = &Flush{IsLocal: bool($2), TableNames: $4}
+  }
+| FLUSH flush_local_opt table_or_tables WITH READ LOCK
+  {
+    $$ = &Flush{IsLocal: bool($2), WithLock: true}
+  }
+| FLUSH flush_local_opt table_or_tables table_name_list WITH READ LOCK
+  {
+    $$ = &Flush{IsLocal: bool($2), TableNames: $4, WithLock: true}
+  }
+| FLUSH flush_local_opt table_or_tables table_name_list FOR ID
+  {
+    if strings.ToLower(string($6)) != "export" {
+      yylex.Error("expecting export after for")
+      return 1
+    }
+    $$ = &Flush{IsLocal: bool($2), TableNames: $4, ForExport: true}
   }
 
-alter_statement:
-  ALTER ignore_opt TABLE table_name non_add_drop_or_rename_operation force_eof
+flush_local_opt:
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    $$ = BoolVal(false)
   }
-| ALTER ignore_opt TABLE table_name ADD alter_object_type force_eof
+| LOCAL
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    $$ = BoolVal(true)
+  }
+| NO_WRITE_TO_BINLOG
+  {
+    $$ = BoolVal(true)
+  }
+
+flush_option_list:
+  flush_option
+  {
+    $$ = []string{$1}
+  }
+| flush_option_list ',' flush_option
+  {
+    $$ = append($1, $3)
+  }
+
+flush_option:
+  ID
+  {
+    $$ = strings.ToLower(string($1))
+  }
+| STATUS
+  {
+    $$ = string($1)
   }
-| ALTER ignore_opt TABLE table_name DROP alter_object_type force_eof
+| BINARY ID
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
-  }
-| ALTER ignore_opt TABLE table_name ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
-  {
-    $$ = &DDL{
-        Action: AddColVindexStr,
-        Table: $4,
-        VindexSpec: &VindexSpec{
-            Name: $7,
-            Type: $11,
-            Params: $12,
-        },
-        VindexCols: $9,
-      }
+    $$ = string($1) + " " + strings.ToLower(string($2))
   }
-| ALTER ignore_opt TABLE table_name DROP VINDEX sql_id
+| ID ID
   {
-    $$ = &DDL{
-        Action: DropColVindexStr,
-        Table: $4,
-        VindexSpec: &VindexSpec{
-            Name: $7,
-        },
-      }
+    $$ = strings.ToLower(string($1)) + " " + strings.ToLower(string($2))
   }
-| ALTER ignore_opt TABLE table_name RENAME to_opt table_name
+
+prepare_statement:
+  PREPARE sql_id FROM prepare_source
   {
-    // Change this to a rename statement
-    $$ = &DDL{Action: RenameStr, Table: $4, NewName: $7}
+    $$ = &Prepare{Name: $2, Stmt: $4}
   }
-| ALTER ignore_opt TABLE table_name RENAME index_opt force_eof
+
+// prepare_source is the text of a statement to prepare. The function
+// calls, like CONCAT(...), are the expressions of MariaDB.
+prepare_source:
+  STRING
   {
-    // Rename an index can just be an alter
-    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    $$ = NewStrVal($1)
   }
-| ALTER VIEW table_name ddl_force_eof
+| user_var
   {
-    $$ = &DDL{Action: AlterStr, Table: $3.ToViewName(), NewName: $3.ToViewName()}
+    $$ = &ColName{Name: $1}
   }
-| ALTER ignore_opt TABLE table_name partition_operation
+| function_call_generic
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, PartitionSpec: $5}
+    $$ = $1
   }
 
-alter_object_type:
-  COLUMN
-| CONSTRAINT
-| FOREIGN
-| FULLTEXT
-| ID
-| INDEX
-| KEY
-| PRIMARY
-| SPATIAL
-| PARTITION
-| UNIQUE
-
-partition_operation:
-  REORGANIZE PARTITION sql_id INTO openb partition_definitions closeb
+execute_statement:
+  EXECUTE sql_id execute_using_opt
   {
-    $$ = &PartitionSpec{Action: ReorganizeStr, Name: $3, Definitions: $6}
+    $$ = &Execute{Name: $2, Using: $3}
+  }
+| EXECUTE IMMEDIATE prepare_source execute_using_opt
+  {
+    $$ = &Execute{Immediate: $3, Using: $4}
   }
 
-partition_definitions:
-  partition_definition
+execute_using_opt:
   {
-    $$ = []*PartitionDefinition{$1}
+    $$ = nil
   }
-| partition_definitions ',' partition_definition
+| USING user_var_list
   {
-    $$ = append($1, $3)
+    $$ = $2
   }
 
-partition_definition:
-  PARTITION sql_id VALUES LESS THAN openb value_expression closeb
+user_var_list:
+  user_var
   {
-    $$ = &PartitionDefinition{Name: $2, Limit: $7}
+    $$ = []ColIdent{$1}
   }
-| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb
+| user_var_list ',' user_var
   {
-    $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
+    $$ = append($1, $3)
   }
 
-rename_statement:
-  RENAME TABLE table_name TO table_name
+user_var:
+  ID
   {
-    $$ = &DDL{Action: RenameStr, Table: $3, NewName: $5}
+    if len($1) < 2 || $1[0] != '@' || $1[1] == '@' {
+      yylex.Error("expecting a user variable")
+      return 1
+    }
+    $$ = NewColIdent(string($1))
   }
 
-drop_statement:
-  DROP TABLE exists_opt table_name
+deallocate_statement:
+  DEALLOCATE PREPARE sql_id
   {
-    var exists bool
-    if $3 != 0 {
-      exists = true
-    }
-    $$ = &DDL{Action: DropStr, Table: $4, IfExists: exists}
+    $$ = &Deallocate{Name: $3}
   }
-| DROP INDEX ID ON table_name ddl_force_eof
+| DROP PREPARE sql_id
   {
-    // Change this to an alter statement
-    $$ = &DDL{Action: AlterStr, Table: $5, NewName: $5}
+    $$ = &Deallocate{Name: $3}
   }
-| DROP VIEW exists_opt table_name ddl_force_eof
+
+kill_statement:
+  KILL value_expression
   {
-    var exists bool
-        if $3 != 0 {
-          exists = true
-        }
-    $$ = &DDL{Action: DropStr, Table: $4.ToViewName(), IfExists: exists}
+    $$ = &Kill{ProcesslistID: $2}
   }
-| DROP DATABASE exists_opt ID
+| KILL QUERY value_expression
   {
-    $$ = &DBDDL{Action: DropStr, DBName: string($4)}
+    $$ = &Kill{Type: KillQueryStr, ProcesslistID: $3}
   }
-| DROP SCHEMA exists_opt ID
+| KILL ID value_expression
   {
-    $$ = &DBDDL{Action: DropStr, DBName: string($4)}
+    if strings.ToLower(string($2)) != KillConnectionStr {
+      yylex.Error("expecting connection or query after kill")
+      return 1
+    }
+    $$ = &Kill{Type: KillConnectionStr, ProcesslistID: $3}
   }
 
-truncate_statement:
-  TRUNCATE TABLE table_name
-  {
-    $$ = &DDL{Action: TruncateStr, Table: $3}
-  }
-| TRUNCATE table_name
+replication_statement:
+  replication_verb replication_word replication_options
   {
-    $$ = &DDL{Action: TruncateStr, Table: $2}
+    $$ = newReplication(string($1), string($1) + " " + string($2) + " " + $3)
   }
-analyze_statement:
-  ANALYZE TABLE table_name
+| START ID replication_options
   {
-    $$ = &DDL{Action: AlterStr, Table: $3, NewName: $3}
+    $$ = newReplication(string($1), string($1) + " " + string($2) + " " + $3)
   }
 
-show_statement:
-  SHOW BINARY ID ddl_force_eof /* SHOW BINARY LOGS */
+replication_verb:
+  CHANGE
+| STOP
+| RESET
+| PURGE
+
+replication_word:
+  ID
+| BINARY
+| non_reserved_keyword
+
+// replication_options is the rest of a replication statement, which
+// isn't parsed.
+replication_options:
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    $$ = scanRest(yylex)
   }
-| SHOW CHARACTER SET ddl_force_eof
+
+xa_statement:
+  XA START xid xa_start_option_opt
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    $$ = &XATransaction{Action: XAStartStr, Xid: $3, Option: $4}
   }
-| SHOW CREATE DATABASE ddl_force_eof
+| XA BEGIN xid xa_start_option_opt
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    $$ = &XATransaction{Action: XAStartStr, Xid: $3, Option: $4}
   }
-/* Rule to handle SHOW CREATE EVENT, SHOW CREATE FUNCTION, etc. */
-| SHOW CREATE ID ddl_force_eof
+| XA END xid xa_end_option_opt
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    $$ = &XATransaction{Action: XAEndStr, Xid: $3, Option: $4}
   }
-| SHOW CREATE PROCEDURE ddl_force_eof
+| XA PREPARE xid
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    $$ = &XATransaction{Action: XAPrepareStr, Xid: $3}
   }
-| SHOW CREATE TABLE ddl_force_eof
+| XA ID xid
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    yylex.Error("expecting start, end, prepare, commit, rollback or recover after xa")
+    return 1
   }
-| SHOW CREATE TRIGGER ddl_force_eof
+| XA COMMIT xid xa_one_phase_opt
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    $$ = &XATransaction{Action: XACommitStr, Xid: $3, OnePhase: bool($4)}
   }
-| SHOW CREATE VIEW ddl_force_eof
+| XA ROLLBACK xid
   {
-    $$ = &Show{Type: string($2) + " " + string($3)}
+    $$ = &XATransaction{Action: XARollbackStr, Xid: $3}
   }
-| SHOW DATABASES ddl_force_eof
+| XA ID
   {
-    $$ = &Show{Type: string($2)}
+    if strings.ToLower(string($2)) != XARecoverStr {
+      yylex.Error("expecting start, end, prepare, commit, rollback or recover after xa")
+      return 1
+    }
+    $$ = &XATransaction{Action: XARecoverStr}
   }
-| SHOW INDEX ddl_force_eof
+| XA ID CONVERT ID
   {
-    $$ = &Show{Type: string($2)}
+    if strings.ToLower(string($2)) != XARecoverStr || strings.ToLower(string($4)) != "xid" {
+      yylex.Error("expecting recover convert xid after xa")
+      return 1
+    }
+    $$ = &XATransaction{Action: XARecoverStr, Option: XAConvertXidStr}
   }
-| SHOW KEYS ddl_force_eof
+
+xid:
+  xid_value
   {
-    $$ = &Show{Type: string($2)}
+    $$ = &Xid{Gtrid: $1}
   }
-| SHOW PROCEDURE ddl_force_eof
+| xid_value ',' xid_value
   {
-    $$ = &Show{Type: string($2)}
+    $$ = &Xid{Gtrid: $1, Bqual: $3}
   }
-| SHOW show_session_or_global STATUS ddl_force_eof
+| xid_value ',' xid_value ',' INTEGRAL
   {
-    $$ = &Show{Scope: $2, Type: string($3)}
+    $$ = &Xid{Gtrid: $1, Bqual: $3, FormatID: NewIntVal($5)}
   }
-| SHOW TABLE ddl_force_eof
+| xid_value ',' xid_value ',' HEXNUM
   {
-    $$ = &Show{Type: string($2)}
+    $$ = &Xid{Gtrid: $1, Bqual: $3, FormatID: NewHexNum($5)}
   }
-| SHOW extended_opt full_opt tables_or_processlist from_database_opt like_or_where_opt
+
+xid_value:
+  STRING
   {
-    // this is ugly, but I couldn't find a better way for now
-    if $4 == "processlist" {
-      $$ = &Show{Type: $4}
-    } else {
-      showTablesOpt := &ShowTablesOpt{Extended: $2, Full:$3, DbName:$5, Filter:$6}
-      $$ = &Show{Type: $4, ShowTablesOpt: showTablesOpt}
-    }
+    $$ = NewStrVal($1)
   }
-| SHOW show_session_or_global VARIABLES ddl_force_eof
+| HEX
   {
-    $$ = &Show{Scope: $2, Type: string($3)}
+    $$ = NewHexVal($1)
   }
-| SHOW VINDEXES
+| HEXNUM
   {
-    $$ = &Show{Type: string($2)}
+    $$ = NewHexNum($1)
   }
-| SHOW VINDEXES ON table_name
+
+xa_start_option_opt:
   {
-    $$ = &Show{Type: string($2), OnTable: $4}
+    $$ = ""
   }
-| SHOW VITESS_KEYSPACES
+| JOIN
   {
-    $$ = &Show{Type: string($2)}
+    $$ = XAJoinStr
   }
-| SHOW VITESS_SHARDS
+| ID
   {
-    $$ = &Show{Type: string($2)}
+    if strings.ToLower(string($1)) != XAResumeStr {
+      yylex.Error("expecting join or resume")
+      return 1
+    }
+    $$ = XAResumeStr
   }
-| SHOW VITESS_TABLETS
+
+xa_end_option_opt:
   {
-    $$ = &Show{Type: string($2)}
+    $$ = ""
   }
-| SHOW VSCHEMA_TABLES
+| ID
   {
-    $$ = &Show{Type: string($2)}
+    if strings.ToLower(string($1)) != XASuspendStr {
+      yylex.Error("expecting suspend")
+      return 1
+    }
+    $$ = XASuspendStr
   }
-/*
- * Catch-all for show statements without vitess keywords:
- *
- *  SHOW BINARY LOGS
- *  SHOW INVALID
- */
-| SHOW ID ddl_force_eof
+| ID FOR ID
   {
-    $$ = &Show{Type: string($2)}
+    if strings.ToLower(string($1)) != XASuspendStr || strings.ToLower(string($3)) != "migrate" {
+      yylex.Error("expecting suspend for migrate")
+      return 1
+    }
+    $$ = XASuspendForMigrateStr
   }
 
-tables_or_processlist:
-  TABLES
+xa_one_phase_opt:
   {
-    $$ = string($1)
+    $$ = BoolVal(false)
   }
-| PROCESSLIST
+| ID ID
   {
-    $$ = string($1)
+    if strings.ToLower(string($1)) != "one" || strings.ToLower(string($2)) != "phase" {
+      yylex.Error("expecting one phase")
+      return 1
+    }
+    $$ = BoolVal(true)
   }
 
-extended_opt:
-  /* empty */
+lock_statement:
+  LOCK TABLES table_lock_list
   {
-    $$ = ""
+    $$ = &LockTables{Tables: $3}
   }
-| EXTENDED
+| LOCK TABLE table_lock_list
   {
-    $$ = "extended "
+    $$ = &LockTables{Tables: $3}
   }
 
-full_opt:
-  /* empty */
+table_lock_list:
+  table_lock
   {
-    $$ = ""
+    $$ = TableLocks{$1}
   }
-| FULL
+| table_lock_list ',' table_lock
   {
-    $$ = "full "
+    $$ = append($1, $3)
   }
 
-from_database_opt:
-  /* empty */
+table_lock:
+  table_name lock_type
   {
-    $$ = ""
+    $$ = &TableLock{Table: $1, Lock: $2}
   }
-| FROM table_id
+| table_name table_alias lock_type
   {
-    $$ = $2.v
+    $$ = &TableLock{Table: $1, As: $2, Lock: $3}
   }
-| IN table_id
+| table_name AS table_alias lock_type
   {
-    $$ = $2.v
+    $$ = &TableLock{Table: $1, As: $3, Lock: $4}
   }
 
-like_or_where_opt:
-  /* empty */
+lock_type:
+  READ
   {
-    $$ = nil
+    $$ = LockReadStr
   }
-| LIKE STRING
+| READ LOCAL
   {
-    $$ = &ShowFilter{Like:string($2)}
+    $$ = LockReadLocalStr
   }
-| WHERE expression
+| WRITE
   {
-    $$ = &ShowFilter{Filter:$2}
+    $$ = LockWriteStr
   }
-
-show_session_or_global:
-  /* empty */
+| LOW_PRIORITY WRITE
   {
-    $$ = ""
+    $$ = LockLowPriorityWriteStr
   }
-| SESSION
+
+unlock_statement:
+  UNLOCK TABLES
   {
-    $$ = SessionStr
+    $$ = &UnlockTables{}
   }
-| GLOBAL
+| UNLOCK TABLE
   {
-    $$ = GlobalStr
+    $$ = &UnlockTables{}
   }
 
-use_statement:
-  USE table_id
+call_statement:
+  CALL table_name call_params_opt
   {
-    $$ = &Use{DBName: $2}
+    $$ = &Call{Name: $2, Params: $3}
   }
-| USE
+| '{' CALL table_name call_params_opt '}'
   {
-    $$ = &Use{DBName:TableIdent{v:""}}
+    $$ = &Call{Name: $3, Params: $4, ODBC: true}
   }
 
-begin_statement:
-  BEGIN
+call_params_opt:
   {
-    $$ = &Begin{}
+    $$ = nil
   }
-| START TRANSACTION
+| openb closeb
   {
-    $$ = &Begin{}
+    $$ = nil
   }
-
-commit_statement:
-  COMMIT
+| openb expression_list closeb
   {
-    $$ = &Commit{}
+    $$ = $2
   }
 
-rollback_statement:
-  ROLLBACK
+other_statement:
+  explain_verb table_name
   {
-    $$ = &Rollback{}
+    $$ = &DescribeTable{Verb: $1, Table: $2}
   }
-
-other_statement:
-  DESC force_eof
+| explain_verb table_name sql_id
+  {
+    $$ = &DescribeTable{Verb: $1, Table: $2, Column: $3}
+  }
+| explain_verb table_name STRING
+  {
+    $$ = &DescribeTable{Verb: $1, Table: $2, Wild: string($3)}
+  }
+| explain_verb explain_start force_eof
   {
     $$ = &OtherRead{}
   }
-| DESCRIBE force_eof
+| explain_verb WITH ID force_eof
   {
     $$ = &OtherRead{}
   }
-| EXPLAIN force_eof
+// EXPLAIN FORMAT = JSON ..., EXPLAIN PARTITIONS SELECT ...
+| explain_verb ID '=' force_eof
   {
     $$ = &OtherRead{}
   }
-| REPAIR force_eof
+| explain_verb ID explain_start force_eof
   {
-    $$ = &OtherAdmin{}
+    $$ = &OtherRead{}
+  }
+
+explain_verb:
+  DESC
+  {
+    $$ = DescStr
   }
-| OPTIMIZE force_eof
+| DESCRIBE
+  {
+    $$ = DescribeStr
+  }
+| EXPLAIN
   {
-    $$ = &OtherAdmin{}
+    $$ = ExplainStr
   }
 
+// explain_start are the tokens that an EXPLAIN of a statement, rather
+// than of a table, starts with.
+explain_start:
+  SELECT
+| INSERT
+| REPLACE
+| UPDATE
+| DELETE
+| TABLE
+| ANALYZE
+| EXTENDED
+| FOR
+| '('
+
 comment_opt:
   {
     setAllowComments(yylex, true)
@@ -1579,18 +3488,66 @@ union_op:
     $$ = UnionDistinctStr
   }
 
-cache_opt:
-{
-  $$ = ""
-}
-| SQL_NO_CACHE
-{
-  $$ = SQLNoCacheStr
-}
+select_options_opt:
+  {
+    $$ = nil
+  }
+| select_options_opt select_option
+  {
+    for _, opt := range $1 {
+      if opt.same() == $2.same() || opt.conflicts($2) {
+        yylex.Error("incorrect usage of " + opt.String() + " and " + $2.String())
+        return 1
+      }
+    }
+    $$ = append($1, $2)
+  }
+
+select_option:
+  ALL
+  {
+    $$ = SelectAll
+  }
+| DISTINCT
+  {
+    $$ = SelectDistinct
+  }
+| DISTINCTROW
+  {
+    $$ = SelectDistinctRow
+  }
+| HIGH_PRIORITY
+  {
+    $$ = SelectHighPriority
+  }
+| STRAIGHT_JOIN
+  {
+    $$ = SelectStraightJoin
+  }
+| SQL_SMALL_RESULT
+  {
+    $$ = SelectSmallResult
+  }
+| SQL_BIG_RESULT
+  {
+    $$ = SelectBigResult
+  }
+| SQL_BUFFER_RESULT
+  {
+    $$ = SelectBufferResult
+  }
 | SQL_CACHE
-{
-  $$ = SQLCacheStr
-}
+  {
+    $$ = SelectCache
+  }
+| SQL_NO_CACHE
+  {
+    $$ = SelectNoCache
+  }
+| SQL_CALC_FOUND_ROWS
+  {
+    $$ = SelectCalcFoundRows
+  }
 
 distinct_opt:
   {
@@ -1601,13 +3558,25 @@ distinct_opt:
     $$ = DistinctStr
   }
 
-straight_join_opt:
+top_opt:
   {
-    $$ = ""
+    $$ = nil
   }
-| STRAIGHT_JOIN
+| TOP INTEGRAL
+  {
+    $$ = &Limit{Rowcount: NewIntVal($2)}
+  }
+| TOP INTEGRAL PERCENT
+  {
+    $$ = &Limit{Rowcount: NewIntVal($2), Percent: true}
+  }
+| TOP openb expression closeb
   {
-    $$ = StraightJoinHint
+    $$ = &Limit{Rowcount: $3}
+  }
+| TOP openb expression closeb PERCENT
+  {
+    $$ = &Limit{Rowcount: $3, Percent: true}
   }
 
 select_expression_list_opt:
@@ -1703,6 +3672,15 @@ table_factor:
   {
     $$ = &ParenTableExpr{Exprs: $2}
   }
+| '{' ID table_reference '}'
+  {
+    // ODBC outer join escape.
+    if strings.ToLower(string($2)) != "oj" {
+      yylex.Error("expecting oj")
+      return 1
+    }
+    $$ = &ParenTableExpr{Exprs: TableExprs{$3}, ODBC: true}
+  }
 
 aliased_table_name:
 table_name as_opt_id index_hint_list
@@ -1713,6 +3691,11 @@ table_name as_opt_id index_hint_list
   {
     $$ = &AliasedTableExpr{Expr:$1, Partitions: $4, As: $6, Hints: $7}
   }
+| table_name as_opt_id index_hints PARTITION openb partition_list closeb
+  {
+    // The partitions are also accepted after the index hints.
+    $$ = &AliasedTableExpr{Expr:$1, Partitions: $6, As: $2, Hints: $3}
+  }
 
 column_list:
   sql_id
@@ -1744,7 +3727,8 @@ partition_list:
 join_table:
   table_reference inner_join table_factor join_condition_opt
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $2.LeftExpr, $2.RightExpr, $2.Condition = $1, $3, $4
+    $$ = $2
   }
 | table_reference cross_join table_factor join_condition_opt
     {
@@ -1756,11 +3740,13 @@ join_table:
   }
 | table_reference outer_join table_reference join_condition
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $2.LeftExpr, $2.RightExpr, $2.Condition = $1, $3, $4
+    $$ = $2
   }
 | table_reference natural_join table_factor
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3}
+    $2.LeftExpr, $2.RightExpr = $1, $3
+    $$ = $2
   }
 
 join_condition:
@@ -1809,11 +3795,11 @@ table_alias:
 inner_join:
   JOIN
   {
-    $$ = JoinStr
+    $$ = &JoinTableExpr{Join: JoinStr}
   }
 | INNER JOIN
   {
-    $$ = JoinStr
+    $$ = &JoinTableExpr{Join: JoinStr, Inner: true}
   }
 
 cross_join:
@@ -1831,33 +3817,35 @@ straight_join:
 outer_join:
   LEFT JOIN
   {
-    $$ = LeftJoinStr
+    $$ = &JoinTableExpr{Join: LeftJoinStr}
   }
 | LEFT OUTER JOIN
   {
-    $$ = LeftJoinStr
+    $$ = &JoinTableExpr{Join: LeftJoinStr, Outer: true}
   }
 | RIGHT JOIN
   {
-    $$ = RightJoinStr
+    $$ = &JoinTableExpr{Join: RightJoinStr}
   }
 | RIGHT OUTER JOIN
   {
-    $$ = RightJoinStr
+    $$ = &JoinTableExpr{Join: RightJoinStr, Outer: true}
   }
 
 natural_join:
- NATURAL JOIN
+  NATURAL inner_join
   {
-    $$ = NaturalJoinStr
+    $2.Join = NaturalJoinStr
+    $$ = $2
   }
 | NATURAL outer_join
   {
-    if $2 == LeftJoinStr {
-      $$ = NaturalLeftJoinStr
+    if $2.Join == LeftJoinStr {
+      $2.Join = NaturalLeftJoinStr
     } else {
-      $$ = NaturalRightJoinStr
+      $2.Join = NaturalRightJoinStr
     }
+    $$ = $2
   }
 
 into_table_name:
@@ -1884,17 +3872,54 @@ index_hint_list:
   {
     $$ = nil
   }
-| USE INDEX openb column_list closeb
+| index_hints
+  {
+    $$ = $1
+  }
+
+index_hints:
+  index_hint
+  {
+    $$ = IndexHintList{$1}
+  }
+| index_hints index_hint
+  {
+    $$ = append($1, $2)
+  }
+
+index_hint:
+  USE index_or_key index_hint_for_opt openb closeb
+  {
+    $$ = &IndexHints{Type: UseStr, Keyword: strings.ToLower($2), For: $3}
+  }
+| USE index_or_key index_hint_for_opt openb column_list closeb
+  {
+    $$ = &IndexHints{Type: UseStr, Keyword: strings.ToLower($2), For: $3, Indexes: $5}
+  }
+| IGNORE index_or_key index_hint_for_opt openb column_list closeb
+  {
+    $$ = &IndexHints{Type: IgnoreStr, Keyword: strings.ToLower($2), For: $3, Indexes: $5}
+  }
+| FORCE index_or_key index_hint_for_opt openb column_list closeb
+  {
+    $$ = &IndexHints{Type: ForceStr, Keyword: strings.ToLower($2), For: $3, Indexes: $5}
+  }
+
+index_hint_for_opt:
+  {
+    $$ = ""
+  }
+| FOR JOIN
   {
-    $$ = &IndexHints{Type: UseStr, Indexes: $4}
+    $$ = ForJoinStr
   }
-| IGNORE INDEX openb column_list closeb
+| FOR ORDER BY
   {
-    $$ = &IndexHints{Type: IgnoreStr, Indexes: $4}
+    $$ = ForOrderByStr
   }
-| FORCE INDEX openb column_list closeb
+| FOR GROUP BY
   {
-    $$ = &IndexHints{Type: ForceStr, Indexes: $4}
+    $$ = ForGroupByStr
   }
 
 where_expression_opt:
@@ -1921,7 +3946,7 @@ expression:
   }
 | NOT expression
   {
-    $$ = &NotExpr{Expr: $2}
+    $$ = newNotExpr($2)
   }
 | expression IS is_suffix
   {
@@ -1931,19 +3956,9 @@ expression:
   {
     $$ = $1
   }
-| DEFAULT default_opt
-  {
-    $$ = &Default{ColName: $2}
-  }
-
-default_opt:
-  /* empty */
-  {
-    $$ = ""
-  }
-| openb ID closeb
+| DEFAULT
   {
-    $$ = string($2)
+    $$ = &Default{}
   }
 
 boolean_value:
@@ -1969,6 +3984,26 @@ condition:
   {
     $$ = &ComparisonExpr{Left: $1, Operator: NotInStr, Right: $4}
   }
+| value_expression MEMBER OF openb expression closeb
+  {
+    $$ = &ComparisonExpr{Left: $1, Operator: MemberOfStr, Right: $5}
+  }
+| value_expression IN openb closeb
+  {
+    if !yylex.(*Tokenizer).EmptyInLists {
+      yylex.(*Tokenizer).errorEmptyInList()
+      return 1
+    }
+    $$ = &EmptyInExpr{Left: $1, Operator: InStr}
+  }
+| value_expression NOT IN openb closeb
+  {
+    if !yylex.(*Tokenizer).EmptyInLists {
+      yylex.(*Tokenizer).errorEmptyInList()
+      return 1
+    }
+    $$ = &EmptyInExpr{Left: $1, Operator: NotInStr}
+  }
 | value_expression LIKE value_expression like_escape_opt
   {
     $$ = &ComparisonExpr{Left: $1, Operator: LikeStr, Right: $3, Escape: $4}
@@ -2023,6 +4058,14 @@ is_suffix:
   {
     $$ = IsNotFalseStr
   }
+| UNKNOWN
+  {
+    $$ = IsUnknownStr
+  }
+| NOT UNKNOWN
+  {
+    $$ = IsNotUnknownStr
+  }
 
 compare:
   '='
@@ -2082,6 +4125,10 @@ subquery:
   {
     $$ = &Subquery{$2}
   }
+| openb with_clause select_statement closeb
+  {
+    $$ = &Subquery{withSelect($2, $3)}
+  }
 
 expression_list:
   expression
@@ -2114,10 +4161,80 @@ value_expression:
   {
     $$ = $1
   }
+| DATE STRING
+  {
+    if !isDateLiteral($2) {
+      yylex.Error("incorrect date literal")
+      return 1
+    }
+    $$ = NewDateVal($2)
+  }
+| TIME STRING
+  {
+    if !isTimeLiteral($2) {
+      yylex.Error("incorrect time literal")
+      return 1
+    }
+    $$ = NewTimeVal($2)
+  }
+| TIMESTAMP STRING
+  {
+    if !isTimestampLiteral($2) {
+      yylex.Error("incorrect timestamp literal")
+      return 1
+    }
+    $$ = NewTimestampVal($2)
+  }
+| '{' ID expression '}'
+  {
+    // ODBC escape sequences, which are kept as flags of the
+    // date and time literals and of the function calls. Other
+    // expressions of {fn ...} lose the escape.
+    val, isStr := $3.(*SQLVal)
+    isStr = isStr && val.Type == StrVal
+    switch strings.ToLower(string($2)) {
+    case "d":
+      if !isStr || !isDateLiteral(val.Val) {
+        yylex.Error("incorrect date literal")
+        return 1
+      }
+      lit := NewDateVal(val.Val)
+      lit.ODBC = true
+      $$ = lit
+    case "t":
+      if !isStr || !isTimeLiteral(val.Val) {
+        yylex.Error("incorrect time literal")
+        return 1
+      }
+      lit := NewTimeVal(val.Val)
+      lit.ODBC = true
+      $$ = lit
+    case "ts":
+      if !isStr || !isTimestampLiteral(val.Val) {
+        yylex.Error("incorrect timestamp literal")
+        return 1
+      }
+      lit := NewTimestampVal(val.Val)
+      lit.ODBC = true
+      $$ = lit
+    case "fn":
+      if fn, ok := $3.(*FuncExpr); ok {
+        fn.ODBC = true
+      }
+      $$ = $3
+    default:
+      yylex.Error("expecting d, t, ts or fn in odbc escape")
+      return 1
+    }
+  }
 | value_expression '&' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitAndStr, Right: $3}
   }
+| value_expression PIPE_CONCAT value_expression
+  {
+    $$ = &BinaryExpr{Left: $1, Operator: ConcatStr, Right: $3}
+  }
 | value_expression '|' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitOrStr, Right: $3}
@@ -2174,6 +4291,10 @@ value_expression:
   {
     $$ = &CollateExpr{Expr: $1, Charset: $3}
   }
+| value_expression TYPECAST typecast_type
+  {
+    $$ = &ConvertExpr{Expr: $1, Type: $3}
+  }
 | BINARY value_expression %prec UNARY
   {
     $$ = &UnaryExpr{Operator: BinaryStr, Expr: $2}
@@ -2230,18 +4351,22 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
   }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
+| EXTENSION_FUNC
+  {
+    $$ = $1
+  }
 
 /*
   Function calls using reserved keywords, with dedicated grammar rules
@@ -2264,33 +4389,68 @@ function_call_keyword:
   {
     $$ = &ConvertExpr{Expr: $3, Type: $5}
   }
+| CAST openb expression AS convert_type ARRAY closeb
+  {
+    $5.Array = true
+    $$ = &ConvertExpr{Expr: $3, Type: $5}
+  }
 | CONVERT openb expression USING charset closeb
   {
     $$ = &ConvertUsingExpr{Expr: $3, Type: $5}
   }
-| SUBSTR openb column_name ',' value_expression closeb
+| substr_keyword openb column_name ',' value_expression closeb
+  {
+    $$ = &SubstrExpr{FuncName: $1, Name: $3, From: $5, To: nil}
+  }
+| substr_keyword openb column_name ',' value_expression ',' value_expression closeb
+  {
+    $$ = &SubstrExpr{FuncName: $1, Name: $3, From: $5, To: $7}
+  }
+| substr_keyword openb column_name FROM value_expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: nil}
+    $$ = &SubstrExpr{FuncName: $1, Name: $3, From: $5, To: nil, FromFor: true}
   }
-| SUBSTR openb column_name ',' value_expression ',' value_expression closeb
+| substr_keyword openb column_name FROM value_expression FOR value_expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    $$ = &SubstrExpr{FuncName: $1, Name: $3, From: $5, To: $7, FromFor: true}
   }
-| SUBSTR openb column_name FROM value_expression FOR value_expression closeb
+| EXTRACT openb sql_id FROM expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    $$ = &ExtractExpr{Unit: $3.Lowered(), Expr: $5}
   }
-| SUBSTRING openb column_name ',' value_expression closeb
+| POSITION openb value_expression IN value_expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: nil}
+    $$ = &PositionExpr{Substr: $3, Str: $5}
   }
-| SUBSTRING openb column_name ',' value_expression ',' value_expression closeb
+| TRIM openb expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    $$ = &TrimExpr{Str: $3}
   }
-| SUBSTRING openb column_name FROM value_expression FOR value_expression closeb
+| TRIM openb expression FROM expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    // BOTH, LEADING and TRAILING are non-reserved, so a lone
+    // trim type is parsed as a column name.
+    if col, ok := $3.(*ColName); ok && col.Qualifier.IsEmpty() && isTrimType(col.Name.Lowered()) {
+      $$ = &TrimExpr{Type: col.Name.Lowered(), Str: $5}
+    } else {
+      $$ = &TrimExpr{RemStr: $3, Str: $5}
+    }
+  }
+| TRIM openb trim_type value FROM expression closeb
+  {
+    $$ = &TrimExpr{Type: $3, RemStr: $4, Str: $6}
+  }
+| TRIM openb trim_type column_name FROM expression closeb
+  {
+    $$ = &TrimExpr{Type: $3, RemStr: $4, Str: $6}
+  }
+| WEIGHT_STRING openb expression closeb
+  {
+    $$ = &WeightStringExpr{Expr: $3}
+  }
+| WEIGHT_STRING openb expression AS weight_string_type closeb
+  {
+    $$ = &WeightStringExpr{Expr: $3, As: $5}
   }
 | MATCH openb select_expression_list closeb AGAINST openb value_expression match_option closeb
   {
@@ -2308,6 +4468,18 @@ function_call_keyword:
   {
     $$ = &ValuesFuncExpr{Name: $3}
   }
+| NEXT sql_id FOR table_name
+  {
+    if $2.Lowered() != "value" {
+      yylex.Error("expecting value after next")
+      return 1
+    }
+    $$ = &NextValueExpr{Sequence: $4}
+  }
+| DEFAULT openb column_name closeb
+  {
+    $$ = &Default{Name: $3}
+  }
 
 /*
   Function calls using non reserved keywords but with special syntax forms.
@@ -2350,6 +4522,44 @@ function_call_nonkeyword:
   {
     $$ = &FuncExpr{Name:NewColIdent("current_time")}
   }
+| CURRENT_USER func_datetime_precision_opt
+  {
+    $$ = &FuncExpr{Name:NewColIdent("current_user")}
+  }
+
+substr_keyword:
+  SUBSTR
+  {
+    $$ = SubstrStr
+  }
+| SUBSTRING
+  {
+    $$ = SubstringStr
+  }
+
+trim_type:
+  BOTH
+  {
+    $$ = TrimBothStr
+  }
+| LEADING
+  {
+    $$ = TrimLeadingStr
+  }
+| TRAILING
+  {
+    $$ = TrimTrailingStr
+  }
+
+weight_string_type:
+  CHAR openb INTEGRAL closeb
+  {
+    $$ = &ConvertType{Type: string($1), Length: NewIntVal($3)}
+  }
+| BINARY openb INTEGRAL closeb
+  {
+    $$ = &ConvertType{Type: string($1), Length: NewIntVal($3)}
+  }
 
 func_datetime_precision_opt:
   /* empty */
@@ -2368,6 +4578,10 @@ function_call_conflict:
   {
     $$ = &FuncExpr{Name: NewColIdent("database"), Exprs: $3}
   }
+| SCHEMA openb select_expression_list_opt closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("schema"), Exprs: $3}
+  }
 | MOD openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("mod"), Exprs: $3}
@@ -2414,55 +4628,142 @@ convert_type:
   {
     $$ = &ConvertType{Type: string($1), Length: $2}
   }
-| CHAR length_opt charset_opt
+| CHAR length_opt charset_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2, Charset: $3, Operator: CharacterSetStr}
+  }
+| CHAR length_opt ID
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2, Charset: string($3)}
+  }
+| DATE
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| DATETIME length_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2}
+  }
+| DECIMAL decimal_length_opt
+  {
+    $$ = &ConvertType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
+  }
+| JSON
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| NCHAR length_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2}
+  }
+| SIGNED
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| SIGNED INTEGER
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| TIME length_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2}
+  }
+| UNSIGNED
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| UNSIGNED INTEGER
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+
+// typecast_type is the type of the postgres style :: operator: the
+// types of convert_type, and the column types that have an equivalent
+// one, see castType. Trailing modifiers that could be mistaken for an
+// alias, like a charset, are not accepted.
+typecast_type:
+  BINARY length_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2}
+  }
+| CHAR length_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2}
+  }
+| JSON
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| NCHAR length_opt
+  {
+    $$ = &ConvertType{Type: string($1), Length: $2}
+  }
+| SIGNED
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| UNSIGNED
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
+| typecast_column_type
   {
-    $$ = &ConvertType{Type: string($1), Length: $2, Charset: $3, Operator: CharacterSetStr}
+    $$ = castType($1)
+    if $$ == nil {
+      yylex.Error("cannot cast to " + $1.Type)
+      return 1
+    }
   }
-| CHAR length_opt ID
+
+typecast_column_type:
+  int_type length_opt
   {
-    $$ = &ConvertType{Type: string($1), Length: $2, Charset: string($3)}
+    $$ = $1
+    $$.Length = $2
   }
-| DATE
+| decimal_type
+| time_type
+| VARCHAR length_opt
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ColumnType{Type: string($1), Length: $2}
   }
-| DATETIME length_opt
+| VARBINARY length_opt
   {
-    $$ = &ConvertType{Type: string($1), Length: $2}
+    $$ = ColumnType{Type: string($1), Length: $2}
   }
-| DECIMAL decimal_length_opt
+| TEXT
   {
-    $$ = &ConvertType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = ColumnType{Type: string($1)}
   }
-| JSON
+| TINYTEXT
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ColumnType{Type: string($1)}
   }
-| NCHAR length_opt
+| MEDIUMTEXT
   {
-    $$ = &ConvertType{Type: string($1), Length: $2}
+    $$ = ColumnType{Type: string($1)}
   }
-| SIGNED
+| LONGTEXT
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ColumnType{Type: string($1)}
   }
-| SIGNED INTEGER
+| BLOB
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ColumnType{Type: string($1)}
   }
-| TIME length_opt
+| TINYBLOB
   {
-    $$ = &ConvertType{Type: string($1), Length: $2}
+    $$ = ColumnType{Type: string($1)}
   }
-| UNSIGNED
+| MEDIUMBLOB
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ColumnType{Type: string($1)}
   }
-| UNSIGNED INTEGER
+| LONGBLOB
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ColumnType{Type: string($1)}
   }
 
 expression_opt:
@@ -2543,6 +4844,10 @@ value:
   {
     $$ = NewFloatVal($1)
   }
+| DECIMAL_LITERAL
+  {
+    $$ = NewDecimalVal($1)
+  }
 | HEXNUM
   {
     $$ = NewHexNum($1)
@@ -2577,11 +4882,15 @@ num_val:
 
 group_by_opt:
   {
-    $$ = nil
+    $$ = groupByClause{}
   }
 | GROUP BY expression_list
   {
-    $$ = $3
+    $$ = groupByClause{exprs: $3}
+  }
+| GROUP BY expression_list WITH ROLLUP
+  {
+    $$ = groupByClause{exprs: $3, rollup: true}
   }
 
 having_opt:
@@ -2593,6 +4902,108 @@ having_opt:
     $$ = $2
   }
 
+over_opt:
+  {
+    $$ = nil
+  }
+| OVER sql_id
+  {
+    $$ = &Over{WindowName: $2}
+  }
+| OVER window_spec
+  {
+    $$ = &Over{Spec: $2}
+  }
+
+window_spec:
+  openb partition_by_opt order_by_opt frame_opt closeb
+  {
+    $$ = &WindowSpec{PartitionBy: $2, OrderBy: $3, Frame: $4}
+  }
+| openb sql_id partition_by_opt order_by_opt frame_opt closeb
+  {
+    $$ = &WindowSpec{Name: $2, PartitionBy: $3, OrderBy: $4, Frame: $5}
+  }
+
+frame_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_bound
+  {
+    $$ = &WindowFrame{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_bound AND frame_bound
+  {
+    $$ = &WindowFrame{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_bound:
+  UNBOUNDED PRECEDING
+  {
+    $$ = &FrameBound{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FrameBound{Type: UnboundedFollowingStr}
+  }
+| CURRENT ROW
+  {
+    $$ = &FrameBound{Type: CurrentRowStr}
+  }
+| value_expression PRECEDING
+  {
+    $$ = &FrameBound{Type: PrecedingStr, Expr: $1}
+  }
+| value_expression FOLLOWING
+  {
+    $$ = &FrameBound{Type: FollowingStr, Expr: $1}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+window_opt:
+  {
+    $$ = nil
+  }
+| WINDOW named_window_list
+  {
+    $$ = $2
+  }
+
+named_window_list:
+  named_window
+  {
+    $$ = NamedWindows{$1}
+  }
+| named_window_list ',' named_window
+  {
+    $$ = append($1, $3)
+  }
+
+named_window:
+  sql_id AS window_spec
+  {
+    $$ = &NamedWindow{Name: $1, Spec: $3}
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -2635,7 +5046,13 @@ limit_opt:
   {
     $$ = nil
   }
-| LIMIT expression
+| limit_clause
+  {
+    $$ = $1
+  }
+
+limit_clause:
+  LIMIT expression
   {
     $$ = &Limit{Rowcount: $2}
   }
@@ -2648,6 +5065,98 @@ limit_opt:
     $$ = &Limit{Offset: $4, Rowcount: $2}
   }
 
+into_opt:
+  %prec INTO_END
+  {
+    $$ = nil
+  }
+| INTO OUTFILE STRING charset_opt fields_opt lines_opt
+  {
+    into := $5
+    into.Type = IntoOutfileStr
+    into.FileName = string($3)
+    into.Charset = $4
+    into.LinesStartingBy = $6.LinesStartingBy
+    into.LinesTerminatedBy = $6.LinesTerminatedBy
+    $$ = into
+  }
+| INTO ID STRING
+  {
+    if !strings.EqualFold(string($2), IntoDumpfileStr) {
+      yylex.Error("expecting dumpfile")
+      return 1
+    }
+    $$ = &SelectInto{Type: IntoDumpfileStr, FileName: string($3)}
+  }
+| INTO user_var_list
+  {
+    $$ = &SelectInto{Type: IntoVarsStr, Vars: $2}
+  }
+
+fields_opt:
+  {
+    $$ = &SelectInto{}
+  }
+| ID terminated_by_opt enclosed_by_opt escaped_by_opt
+  {
+    if !strings.EqualFold(string($1), "fields") && !strings.EqualFold(string($1), "columns") {
+      yylex.Error("expecting fields or columns")
+      return 1
+    }
+    $$ = $3
+    $$.FieldsTerminatedBy = $2
+    $$.FieldsEscapedBy = $4
+  }
+
+terminated_by_opt:
+  {
+    $$ = nil
+  }
+| TERMINATED BY STRING
+  {
+    $$ = NewStrVal($3)
+  }
+
+enclosed_by_opt:
+  {
+    $$ = &SelectInto{}
+  }
+| ENCLOSED BY STRING
+  {
+    $$ = &SelectInto{FieldsEnclosedBy: NewStrVal($3)}
+  }
+| OPTIONALLY ENCLOSED BY STRING
+  {
+    $$ = &SelectInto{FieldsEnclosedBy: NewStrVal($4), Optionally: true}
+  }
+
+escaped_by_opt:
+  {
+    $$ = nil
+  }
+| ESCAPED BY STRING
+  {
+    $$ = NewStrVal($3)
+  }
+
+lines_opt:
+  {
+    $$ = &SelectInto{}
+  }
+| LINES starting_by_opt terminated_by_opt
+  {
+    $$ = &SelectInto{LinesStartingBy: $2, LinesTerminatedBy: $3}
+  }
+
+starting_by_opt:
+  {
+    $$ = nil
+  }
+| STARTING BY STRING
+  {
+    $$ = NewStrVal($3)
+  }
+
 lock_opt:
   {
     $$ = ""
@@ -2669,11 +5178,11 @@ lock_opt:
 // Because the rules are together, the parser can keep shifting
 // the tokens until it disambiguates a as sql_id and select as keyword.
 insert_data:
-  VALUES tuple_list
+  VALUES tuple_list row_alias_opt
   {
-    $$ = &Insert{Rows: $2}
+    $$ = &Insert{Rows: $2, RowAlias: $3}
   }
-| select_statement
+| with_select_statement
   {
     $$ = &Insert{Rows: $1}
   }
@@ -2682,11 +5191,11 @@ insert_data:
     // Drop the redundant parenthesis.
     $$ = &Insert{Rows: $2}
   }
-| openb ins_column_list closeb VALUES tuple_list
+| openb ins_column_list closeb VALUES tuple_list row_alias_opt
   {
-    $$ = &Insert{Columns: $2, Rows: $5}
+    $$ = &Insert{Columns: $2, Rows: $5, RowAlias: $6}
   }
-| openb ins_column_list closeb select_statement
+| openb ins_column_list closeb with_select_statement
   {
     $$ = &Insert{Columns: $2, Rows: $4}
   }
@@ -2714,6 +5223,19 @@ ins_column_list:
     $$ = append($$, $5)
   }
 
+row_alias_opt:
+  {
+    $$ = nil
+  }
+| AS table_id
+  {
+    $$ = &RowAlias{Name: $2}
+  }
+| AS table_id openb column_list closeb
+  {
+    $$ = &RowAlias{Name: $2, Columns: $4}
+  }
+
 on_dup_opt:
   {
     $$ = nil
@@ -2723,6 +5245,42 @@ on_dup_opt:
     $$ = $5
   }
 
+on_conflict:
+  ON CONFLICT conflict_target_opt DO NOTHING
+  {
+    $$ = $3
+    $$.Action = DoNothingStr
+  }
+| ON CONFLICT conflict_target_opt DO UPDATE SET update_list where_expression_opt
+  {
+    $$ = $3
+    $$.Action = DoUpdateStr
+    $$.Exprs = $7
+    $$.UpdateWhere = NewWhere(WhereStr, $8)
+  }
+
+conflict_target_opt:
+  {
+    $$ = &OnConflict{}
+  }
+| openb column_list closeb where_expression_opt
+  {
+    $$ = &OnConflict{Columns: $2, Where: NewWhere(WhereStr, $4)}
+  }
+| ON CONSTRAINT sql_id
+  {
+    $$ = &OnConflict{Constraint: $3}
+  }
+
+returning_opt:
+  {
+    $$ = nil
+  }
+| RETURNING select_expression_list
+  {
+    $$ = $2
+  }
+
 tuple_list:
   tuple_or_empty
   {
@@ -2794,6 +5352,21 @@ set_expression:
   {
     $$ = &SetExpr{Name: $1, Expr: $3}
   }
+| table_id '.' reserved_sql_id '=' expression
+  {
+    // Columns of the NEW row are set this way in triggers.
+    if !inTrigger(yylex) {
+      yylex.Error("cannot set a qualified column outside of a trigger")
+      return 1
+    }
+    switch strings.ToLower($1.String()) {
+    case "new", "old":
+    default:
+      yylex.Error("expecting new or old")
+      return 1
+    }
+    $$ = &SetExpr{Qualifier: $1, Name: $3, Expr: $5}
+  }
 | charset_or_character_set charset_value collate_opt
   {
     $$ = &SetExpr{Name: NewColIdent(string($1)), Expr: $2}
@@ -2821,47 +5394,50 @@ charset_value:
     $$ = &Default{}
   }
 
-for_from:
-  FOR
-| FROM
-
 exists_opt:
   { $$ = 0 }
 | IF EXISTS
   { $$ = 1 }
 
 not_exists_opt:
-  { $$ = struct{}{} }
+  { $$ = 0 }
 | IF NOT EXISTS
-  { $$ = struct{}{} }
+  { $$ = 1 }
+
+create_select_duplicate_opt:
+  { $$ = "" }
+| create_select_duplicate
+  { $$ = $1 }
+
+create_select_duplicate:
+  IGNORE
+  { $$ = DuplicateIgnoreStr }
+| REPLACE
+  { $$ = DuplicateReplaceStr }
 
 ignore_opt:
   { $$ = "" }
 | IGNORE
   { $$ = IgnoreStr }
 
-non_add_drop_or_rename_operation:
-  ALTER
-  { $$ = struct{}{} }
-| AUTO_INCREMENT
-  { $$ = struct{}{} }
-| CHARACTER
-  { $$ = struct{}{} }
-| COMMENT_KEYWORD
-  { $$ = struct{}{} }
-| DEFAULT
-  { $$ = struct{}{} }
-| ORDER
-  { $$ = struct{}{} }
-| CONVERT
-  { $$ = struct{}{} }
-| PARTITION
-  { $$ = struct{}{} }
-| UNUSED
-  { $$ = struct{}{} }
-| ID
-  { $$ = struct{}{} }
+insert_priority_opt:
+  { $$ = "" }
+| LOW_PRIORITY
+  { $$ = LowPriorityStr }
+| DELAYED
+  { $$ = DelayedStr }
+| HIGH_PRIORITY
+  { $$ = HighPriorityStr }
+
+low_priority_opt:
+  { $$ = "" }
+| LOW_PRIORITY
+  { $$ = LowPriorityStr }
 
+quick_opt:
+  { $$ = "" }
+| QUICK
+  { $$ = QuickStr }
 to_opt:
   { $$ = struct{}{} }
 | TO
@@ -2876,11 +5452,11 @@ index_opt:
   { $$ = struct{}{} }
 
 constraint_opt:
-  { $$ = struct{}{} }
+  { $$ = "" }
 | UNIQUE
-  { $$ = struct{}{} }
+  { $$ = string($1) }
 | sql_id
-  { $$ = struct{}{} }
+  { $$ = $1.String() }
 
 using_opt:
   { $$ = ColIdent{} }
@@ -2896,6 +5472,12 @@ sql_id:
   {
     $$ = NewColIdent(string($1))
   }
+// QUICK is not a table_id: it would be ambiguous
+// with the table names of DELETE.
+| QUICK
+  {
+    $$ = NewColIdent(string($1))
+  }
 
 reserved_sql_id:
   sql_id
@@ -2920,6 +5502,10 @@ reserved_table_id:
   {
     $$ = NewTableIdent(string($1))
   }
+| QUICK
+  {
+    $$ = NewTableIdent(string($1))
+  }
 
 /*
   These are not all necessarily reserved in MySQL, but some are.
@@ -2936,10 +5522,13 @@ reserved_keyword:
 | AS
 | ASC
 | AUTO_INCREMENT
+| BEFORE
 | BETWEEN
 | BINARY
 | BY
+| CALL
 | CASE
+| CHANGE
 | COLLATE
 | CONVERT
 | CREATE
@@ -2947,17 +5536,25 @@ reserved_keyword:
 | CURRENT_DATE
 | CURRENT_TIME
 | CURRENT_TIMESTAMP
+| CURRENT_USER
+| RANGE
+| ROW
+| ROWS
 | SUBSTR
 | SUBSTRING
 | DATABASE
 | DATABASES
 | DEFAULT
+| DELAYED
 | DELETE
 | DESC
 | DESCRIBE
+| DETERMINISTIC
 | DISTINCT
+| DISTINCTROW
 | DIV
 | DROP
+| EACH
 | ELSE
 | END
 | ESCAPE
@@ -2969,43 +5566,61 @@ reserved_keyword:
 | FROM
 | GROUP
 | HAVING
+| HIGH_PRIORITY
 | IF
 | IGNORE
 | IN
 | INDEX
 | INNER
+| INOUT
 | INSERT
 | INTERVAL
 | INTO
 | IS
 | JOIN
 | KEY
+| KILL
 | LEFT
 | LIKE
 | LIMIT
 | LOCALTIME
 | LOCALTIMESTAMP
+| LOAD
 | LOCK
+| LOW_PRIORITY
 | MATCH
 | MAXVALUE
+| MEMBER
 | MOD
+| MODIFIES
 | NATURAL
 | NEXT // next should be doable as non-reserved, but is not due to the special `select next num_val` query that vitess supports
+| NO_WRITE_TO_BINLOG
 | NOT
 | NULL
 | ON
 | OR
 | ORDER
+| OUT
 | OUTER
+| OVER
+| PURGE
+| READS
 | REGEXP
 | RENAME
 | REPLACE
+| RETURN
 | RIGHT
 | SCHEMA
 | SELECT
 | SEPARATOR
 | SET
 | SHOW
+| SQL
+| SQL_BIG_RESULT
+| SQL_BUFFER_RESULT
+| SQL_CALC_FOUND_ROWS
+| SQL_SMALL_RESULT
 | STRAIGHT_JOIN
 | TABLE
 | TABLES
@@ -3014,6 +5629,7 @@ reserved_keyword:
 | TRUE
 | UNION
 | UNIQUE
+| UNLOCK
 | UPDATE
 | USE
 | USING
@@ -3023,6 +5639,8 @@ reserved_keyword:
 | VALUES
 | WHEN
 | WHERE
+| WINDOW
+| WITH
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -3032,31 +5650,52 @@ reserved_keyword:
   Sorted alphabetically
 */
 non_reserved_keyword:
-  AGAINST
+  AFTER
+| AGAINST
+| ARRAY
 | BEGIN
 | BIGINT
 | BIT
 | BLOB
 | BOOL
+| BOTH
+| CACHE
 | CHAR
 | CHARACTER
 | CHARSET
+| CHECK
+| CHECKSUM
 | COMMENT_KEYWORD
 | COMMIT
 | COMMITTED
+| CONFLICT
+| CURRENT
 | DATE
 | DATETIME
+| DEALLOCATE
 | DECIMAL
+| DEFINER
+| DO
 | DOUBLE
 | DUPLICATE
+| ENDS
 | ENUM
+| EVENT
+| EVERY
+| EXECUTE
 | EXPANSION
+| FIRST
 | FLOAT_TYPE
+| FLUSH
+| FOLLOWING
 | FOREIGN
 | FULLTEXT
+| FUNCTION
 | GEOMETRY
 | GEOMETRYCOLLECTION
 | GLOBAL
+| HANDLER
+| IMMEDIATE
 | INT
 | INTEGER
 | ISOLATION
@@ -3065,9 +5704,11 @@ non_reserved_keyword:
 | KEYS
 | LANGUAGE
 | LAST_INSERT_ID
+| LEADING
 | LESS
 | LEVEL
 | LINESTRING
+| LOCAL
 | LONGBLOB
 | LONGTEXT
 | MEDIUMBLOB
@@ -3079,22 +5720,29 @@ non_reserved_keyword:
 | MULTIPOLYGON
 | NAMES
 | NCHAR
+| NOTHING
 | NUMERIC
+| OF
 | OFFSET
 | ONLY
 | OPTIMIZE
 | PARTITION
 | POINT
 | POLYGON
+| PRECEDING
+| PREPARE
 | PRIMARY
 | PROCEDURE
 | QUERY
 | READ
 | REAL
+| RECURSIVE
 | REORGANIZE
 | REPAIR
 | REPEATABLE
+| RESET
 | ROLLBACK
+| ROLLUP
 | SESSION
 | SERIALIZABLE
 | SHARE
@@ -3102,7 +5750,9 @@ non_reserved_keyword:
 | SMALLINT
 | SPATIAL
 | START
+| STARTS
 | STATUS
+| STOP
 | TEXT
 | THAN
 | TIME
@@ -3110,10 +5760,13 @@ non_reserved_keyword:
 | TINYBLOB
 | TINYINT
 | TINYTEXT
+| TRAILING
 | TRANSACTION
 | TRIGGER
 | TRUNCATE
+| UNBOUNDED
 | UNCOMMITTED
+| UNKNOWN
 | UNSIGNED
 | UNUSED
 | VARBINARY
@@ -3126,8 +5779,8 @@ non_reserved_keyword:
 | VITESS_SHARDS
 | VITESS_TABLETS
 | VSCHEMA_TABLES
-| WITH
 | WRITE
+| XA
 | YEAR
 | ZEROFILL
 
@@ -0,0 +1,57 @@
+package sqlparser
+
+import "hash/fnv"
+
+// StructureHashVersion is the version of the algorithm used by
+// StructureHash. It changes whenever the hash of a statement can
+// change, so hashes should only be compared if they were computed
+// with the same version.
+const StructureHashVersion = 1
+
+// StructureHash returns a hash of the structure of stmt: the kinds of
+// its nodes, the identifiers, case-folded, the operators and the
+// number of bind vars. Literal values are not hashed, so statements
+// that only differ in their values have the same hash, and so do a
+// statement and its normalized form.
+//
+// Version 1 of the algorithm is the 64-bit FNV-1a hash of the
+// statement formatted with every value as ?, every IN list that
+// Normalize turns into a list bind var as ::?, and every identifier
+// lowercased and quoted. It doesn't depend on the process, so the
+// hashes can be persisted.
+func StructureHash(stmt Statement) uint64 {
+	buf := NewTrackedBuffer(formatStructure)
+	buf.Myprintf("%v", stmt)
+	h := fnv.New64a()
+	_, _ = h.Write(buf.Bytes())
+	return h.Sum64()
+}
+
+// formatStructure is the NodeFormatter of StructureHash.
+func formatStructure(buf *TrackedBuffer, node SQLNode) {
+	switch node := node.(type) {
+	case *SQLVal, BoolVal:
+		buf.WriteByte('?')
+	case ListArg:
+		buf.WriteString("::?")
+	case ColIdent:
+		buf.Myprintf("`%s`", node.Lowered())
+	case TableIdent:
+		buf.Myprintf("`%s`", node.Lowered())
+	case *ComparisonExpr:
+		if isListValue(node) {
+			buf.Myprintf("%v %s ::?", node.Left, node.Operator)
+			return
+		}
+		node.Format(buf)
+	default:
+		node.Format(buf)
+	}
+}
+
+// isListValue returns true if Normalize would turn the right side
+// of node into a list bind var.
+func isListValue(node *ComparisonExpr) bool {
+	var nz normalizer
+	return nz.listBindvar(node) != nil
+}
@@ -0,0 +1,100 @@
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+)
+
+func TestStructureHash(t *testing.T) {
+	testcases := []struct {
+		in1, in2 string
+		same     bool
+	}{{
+		in1:  "select a from t where b = 1 and c in (1, 2, 3)",
+		in2:  "SELECT A FROM T WHERE b = 'x' AND c IN (4, 5)",
+		same: true,
+	}, {
+		in1:  "select a from t where b = 1",
+		in2:  "select a from t where b = :x",
+		same: true,
+	}, {
+		in1:  "insert into t(a, b) values (1, 'x')",
+		in2:  "insert into t(a, b) values (2, 'y')",
+		same: true,
+	}, {
+		in1: "insert into t(a, b) values (1, 'x')",
+		in2: "insert into t(a, b) values (1, 'x'), (2, 'y')",
+	}, {
+		in1: "select a from t where b = 1",
+		in2: "select a from t where b > 1",
+	}, {
+		in1: "select a from t where b = 1",
+		in2: "select a from u where b = 1",
+	}, {
+		in1: "select a from t where b in (1, 2)",
+		in2: "select a from t where b in (1, c)",
+	}, {
+		in1:  "select a from t where (b, c) in ((1, 2))",
+		in2:  "select a from t where (b, c) in ((3, 'x'), (4, 'y'))",
+		same: true,
+	}, {
+		in1: "select a from t where b = 1",
+		in2: "select a from t where b = c",
+	}}
+	for _, tcase := range testcases {
+		tree1, err := Parse(tcase.in1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tree2, err := Parse(tcase.in2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := StructureHash(tree1) == StructureHash(tree2); got != tcase.same {
+			t.Errorf("StructureHash(%s) == StructureHash(%s): %v, want %v", tcase.in1, tcase.in2, got, tcase.same)
+		}
+	}
+}
+
+func TestStructureHashNormalized(t *testing.T) {
+	for _, sql := range []string{
+		"select a, b + 1 from t where c = 'x' and d in (1, 2.5, 'y') and e not in (X'01', 2) limit 10",
+		"select * from t where a > date '2024-01-01' and b = true",
+		"insert into t(a, b) values (1, 'x'), (2, 'y') on duplicate key update b = 'z'",
+		"update t set a = 1 where b in (1, 2)",
+		"select * from t where (a, b) in ((1, 'x'), (2, 'y')) and (c, d) >= (3, 4)",
+		"delete from t where a = 'x'",
+	} {
+		tree, err := Parse(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := StructureHash(tree)
+		Normalize(tree, make(map[string]*querypb.BindVariable), "bv")
+		if got := StructureHash(tree); got != want {
+			t.Errorf("StructureHash(Normalize(%s)): %d, want %d", sql, got, want)
+		}
+	}
+}
+
+func TestStructureHashStable(t *testing.T) {
+	// The hash of version 1 must not change across releases.
+	tree, err := Parse("select a from t where b = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := StructureHash(tree), uint64(17835645892769106811); got != want {
+		t.Errorf("StructureHash: %d, want %d", got, want)
+	}
+}
+
+func BenchmarkStructureHash(b *testing.B) {
+	tree, err := Parse("select a, b, count(*) from t join u on t.id = u.t_id where t.c = 1 and u.d in (1, 2, 3) group by a, b order by a desc limit 10")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		StructureHash(tree)
+	}
+}
@@ -0,0 +1,168 @@
+package sqlparser
+
+// WalkTableExprs calls visit on every table expression of node, in the
+// order Walk visits them, including the ones of subqueries anywhere in
+// the tree. If visit returns false, the table expression's own subtree,
+// like the sides of a join or a derived table, is skipped. If it returns
+// an error, walking is interrupted, and the error is returned.
+//
+// Unlike Walk, it only descends into expressions as far as needed to
+// find their subqueries, so it's much cheaper on big queries.
+func WalkTableExprs(node SQLNode, visit func(TableExpr) (bool, error)) error {
+	var walk Visit
+	walk = func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case TableExpr:
+			return visit(node)
+		case Expr:
+			return false, walkSubqueries(walk, node)
+		}
+		return !isSelectFree(node), nil
+	}
+	return Walk(walk, node)
+}
+
+// WalkSelects calls visit on every select statement of node, in the
+// order Walk visits them: the statement itself, the sides of unions,
+// and subqueries, including derived tables. If visit returns false,
+// the subqueries of the statement are skipped. If it returns an error,
+// walking is interrupted, and the error is returned.
+//
+// Like WalkTableExprs, it doesn't descend into the nodes that can't
+// contain a select statement.
+func WalkSelects(node SQLNode, visit func(SelectStatement) (bool, error)) error {
+	var walk Visit
+	walk = func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case SelectStatement:
+			return visit(node)
+		case Expr:
+			return false, walkSubqueries(walk, node)
+		}
+		return !isSelectFree(node), nil
+	}
+	return Walk(walk, node)
+}
+
+// walkSubqueries walks the subqueries of expr with visit, in the order
+// Walk would. The common operators are handled without calling visit or
+// walkSubtree on them: that's where most of the nodes of big queries
+// are, and most of the cost of walking them is the calls.
+func walkSubqueries(visit Visit, expr Expr) error {
+	switch expr := expr.(type) {
+	case nil:
+		return nil
+	case *AndExpr:
+		if expr == nil {
+			return nil
+		}
+		if err := walkSubqueries(visit, expr.Left); err != nil {
+			return err
+		}
+		return walkSubqueries(visit, expr.Right)
+	case *OrExpr:
+		if expr == nil {
+			return nil
+		}
+		if err := walkSubqueries(visit, expr.Left); err != nil {
+			return err
+		}
+		return walkSubqueries(visit, expr.Right)
+	case *NotExpr:
+		if expr == nil {
+			return nil
+		}
+		return walkSubqueries(visit, expr.Expr)
+	case *ParenExpr:
+		if expr == nil {
+			return nil
+		}
+		return walkSubqueries(visit, expr.Expr)
+	case *ComparisonExpr:
+		if expr == nil {
+			return nil
+		}
+		for _, e := range []Expr{expr.Left, expr.Right, expr.Escape} {
+			if err := walkSubqueries(visit, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *RangeCond:
+		if expr == nil {
+			return nil
+		}
+		for _, e := range []Expr{expr.Left, expr.From, expr.To} {
+			if err := walkSubqueries(visit, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *IsExpr:
+		if expr == nil {
+			return nil
+		}
+		return walkSubqueries(visit, expr.Expr)
+	case *BinaryExpr:
+		if expr == nil {
+			return nil
+		}
+		if err := walkSubqueries(visit, expr.Left); err != nil {
+			return err
+		}
+		return walkSubqueries(visit, expr.Right)
+	case *UnaryExpr:
+		if expr == nil {
+			return nil
+		}
+		return walkSubqueries(visit, expr.Expr)
+	case ValTuple:
+		for _, e := range expr {
+			if err := walkSubqueries(visit, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *FuncExpr:
+		if expr == nil {
+			return nil
+		}
+		for _, sel := range expr.Exprs {
+			var err error
+			switch sel := sel.(type) {
+			case *AliasedExpr:
+				if sel != nil {
+					err = walkSubqueries(visit, sel.Expr)
+				}
+			case *StarExpr:
+			default:
+				err = Walk(visit, sel)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if expr.Over != nil {
+			return Walk(visit, expr.Over)
+		}
+		return nil
+	}
+	if isSelectFree(expr) {
+		return nil
+	}
+	return expr.walkSubtree(visit)
+}
+
+// isSelectFree returns true if node is of a type whose subtree can
+// never contain a select statement, and so no table expression either.
+// Expressions in general can, through subqueries: only the leaves and
+// the nodes made of identifiers qualify.
+func isSelectFree(node SQLNode) bool {
+	switch node.(type) {
+	case *ColName, *SQLVal, *NullVal, BoolVal, ListArg, *Default, *StarExpr,
+		ColIdent, TableIdent, TableName, TableNames, Columns, Comments,
+		*ConvertType, *ColumnType, IndexHintList, *IndexHints, *RowAlias, *SelectInto, *Xid, VindexParam:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/corpus"
+)
+
+func TestTargetedWalksMatchWalk(t *testing.T) {
+	for _, query := range corpus.Queries() {
+		tree, err := Parse(query.Input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", query.Input, err)
+			continue
+		}
+		var wantTables, gotTables []TableExpr
+		var wantSelects, gotSelects []SelectStatement
+		_ = Walk(func(node SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case TableExpr:
+				wantTables = append(wantTables, node)
+			case SelectStatement:
+				wantSelects = append(wantSelects, node)
+			}
+			return true, nil
+		}, tree)
+		_ = WalkTableExprs(tree, func(expr TableExpr) (bool, error) {
+			gotTables = append(gotTables, expr)
+			return true, nil
+		})
+		_ = WalkSelects(tree, func(sel SelectStatement) (bool, error) {
+			gotSelects = append(gotSelects, sel)
+			return true, nil
+		})
+		if !reflect.DeepEqual(gotTables, wantTables) {
+			t.Errorf("WalkTableExprs(%q): %d table expressions, want %d", query.Input, len(gotTables), len(wantTables))
+		}
+		if !reflect.DeepEqual(gotSelects, wantSelects) {
+			t.Errorf("WalkSelects(%q): %d select statements, want %d", query.Input, len(gotSelects), len(wantSelects))
+		}
+	}
+}
+
+func TestWalkTableExprs(t *testing.T) {
+	tree, err := Parse("select * from a join (select 1 from b) as d on a.id in (select id from c) where exists (select 1 from e join f)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	err = WalkTableExprs(tree, func(expr TableExpr) (bool, error) {
+		if join, ok := expr.(*JoinTableExpr); ok {
+			names = append(names, "join")
+			// The sides of the join from e are skipped.
+			return String(join.LeftExpr) != "e", nil
+		}
+		names = append(names, String(expr))
+		return true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"join", "a", "(select 1 from b) as d", "b", "c", "join"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("WalkTableExprs: %v, want %v", names, want)
+	}
+
+	// The subqueries of an OVER clause are walked too.
+	tree, err = Parse("select sum(a) over (partition by (select b from u limit 1)) from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names = nil
+	err = WalkTableExprs(tree, func(expr TableExpr) (bool, error) {
+		names = append(names, String(expr))
+		return true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"u", "t"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("WalkTableExprs: %v, want %v", names, want)
+	}
+
+	errStop := errors.New("stop")
+	count := 0
+	err = WalkTableExprs(tree, func(expr TableExpr) (bool, error) {
+		count++
+		return true, errStop
+	})
+	if err != errStop || count != 1 {
+		t.Errorf("WalkTableExprs: %v after %d calls, want %v after 1", err, count, errStop)
+	}
+}
+
+func TestWalkSelects(t *testing.T) {
+	tree, err := Parse("select (select max(a) from t) from u where b in (select b from v union (select b from w where c = (select 1))) and exists (select 1 from x)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sels []string
+	err = WalkSelects(tree, func(sel SelectStatement) (bool, error) {
+		sels = append(sels, String(sel))
+		// The subqueries of the union are skipped.
+		_, union := sel.(*Union)
+		return !union, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"select (select max(a) from t) from u where b in (select b from v union (select b from w where c = (select 1 from dual))) and exists (select 1 from x)",
+		"select max(a) from t",
+		"select b from v union (select b from w where c = (select 1 from dual))",
+		"select 1 from x",
+	}
+	if !reflect.DeepEqual(sels, want) {
+		t.Errorf("WalkSelects:\n%s\nwant\n%s", strings.Join(sels, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// deepQuery returns a query with a big where clause, nested subqueries
+// and derived tables.
+func deepQuery() string {
+	var conds []string
+	for i := 0; i < 50; i++ {
+		conds = append(conds, fmt.Sprintf("t.c%d = %d and lower(t.s%d) like 'x%d%%'", i, i, i, i))
+	}
+	where := strings.Join(conds, " and ")
+	query := "select a, b from t where " + where
+	for i := 0; i < 5; i++ {
+		query = fmt.Sprintf("select d%d.a, b from (%s) as d%d join t on t.id = d%d.a where t.id in (select id from u where %s)", i, query, i, i, where)
+	}
+	return query
+}
+
+func BenchmarkWalkTableExprs(b *testing.B) {
+	tree, err := Parse(deepQuery())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("Walk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = Walk(func(node SQLNode) (bool, error) {
+				_, _ = node.(TableExpr)
+				return true, nil
+			}, tree)
+		}
+	})
+	b.Run("WalkTableExprs", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = WalkTableExprs(tree, func(expr TableExpr) (bool, error) {
+				return true, nil
+			})
+		}
+	})
+}
+
+func BenchmarkWalkSelects(b *testing.B) {
+	tree, err := Parse(deepQuery())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("Walk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = Walk(func(node SQLNode) (bool, error) {
+				_, _ = node.(SelectStatement)
+				return true, nil
+			}, tree)
+		}
+	})
+	b.Run("WalkSelects", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = WalkSelects(tree, func(sel SelectStatement) (bool, error) {
+				return true, nil
+			})
+		}
+	})
+}
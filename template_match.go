@@ -0,0 +1,175 @@
+package sqlparser
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+)
+
+// TemplateMatcher matches queries against a list of templates,
+// see MatchTemplate. It computes the StructureHash of every template
+// once, so it should be reused to match many queries.
+type TemplateMatcher struct {
+	templates []Statement
+	hashes    []uint64
+}
+
+// NewTemplateMatcher returns a TemplateMatcher for templates.
+func NewTemplateMatcher(templates []Statement) *TemplateMatcher {
+	m := &TemplateMatcher{
+		templates: templates,
+		hashes:    make([]uint64, len(templates)),
+	}
+	for i, tmpl := range templates {
+		m.hashes[i] = StructureHash(tmpl)
+	}
+	return m
+}
+
+// Match returns the index of the first template that matches query,
+// and the values its bind variables capture. See MatchTemplate.
+func (m *TemplateMatcher) Match(query Statement) (index int, bindings map[string]*querypb.BindVariable, ok bool) {
+	hash := StructureHash(query)
+	for i, tmpl := range m.templates {
+		// Statements that match have the same structure,
+		// which is much cheaper to compare first.
+		if m.hashes[i] != hash {
+			continue
+		}
+		tm := &templateMatch{bindings: make(map[string]*querypb.BindVariable)}
+		if tm.match(reflect.ValueOf(tmpl), reflect.ValueOf(query)) {
+			return i, tm.bindings, true
+		}
+	}
+	return -1, nil, false
+}
+
+// MatchTemplate returns the index of the first of templates that
+// matches query, and the values its bind variables capture.
+//
+// A template matches a query if they are the same statement, except
+// that each bind variable of the template stands for a value of the
+// query: the values that Normalize would turn into bind variables.
+// A bind variable that occurs several times must capture the same
+// value each time. A list bind variable, like the one of
+// a in ::list, captures the whole list of a query IN clause, and
+// so does the one of a row constructor, like (a, b) in ::list.
+// Like for Normalize, such an IN clause of the query doesn't match
+// a list of scalar bind variables, like a in (:x, :y).
+//
+// Column names are compared case-insensitively, and the other
+// identifiers and the literals of the template must be the
+// same in the query.
+//
+// Use a TemplateMatcher to match many queries against the
+// same templates.
+func MatchTemplate(query Statement, templates []Statement) (index int, bindings map[string]*querypb.BindVariable, ok bool) {
+	return NewTemplateMatcher(templates).Match(query)
+}
+
+// templateMatch compares a template to a query, and
+// collects the values captured by the template bind vars.
+type templateMatch struct {
+	nz       normalizer
+	bindings map[string]*querypb.BindVariable
+}
+
+var (
+	colIdentType   = reflect.TypeOf(ColIdent{})
+	tableIdentType = reflect.TypeOf(TableIdent{})
+	bytesType      = reflect.TypeOf([]byte(nil))
+)
+
+func (tm *templateMatch) match(tmpl, query reflect.Value) bool {
+	if tmpl.Kind() == reflect.Interface {
+		if tmpl.IsNil() || query.IsNil() {
+			return tmpl.IsNil() && query.IsNil()
+		}
+		tmpl, query = tmpl.Elem(), query.Elem()
+	}
+	if tmpl.Type() != query.Type() {
+		return false
+	}
+	switch tmpl.Type() {
+	case colIdentType:
+		return tmpl.Interface().(ColIdent).Equal(query.Interface().(ColIdent))
+	case tableIdentType:
+		return tmpl.Interface().(TableIdent).String() == query.Interface().(TableIdent).String()
+	case bytesType:
+		return bytes.Equal(tmpl.Bytes(), query.Bytes())
+	}
+
+	switch tmpl.Kind() {
+	case reflect.Ptr:
+		if tmpl.IsNil() || query.IsNil() {
+			return tmpl.IsNil() && query.IsNil()
+		}
+		switch node := tmpl.Interface().(type) {
+		case *SQLVal:
+			if node.Type == ValArg {
+				return tm.capture(string(node.Val[1:]), tm.nz.sqlToBindvar(query.Interface().(*SQLVal)))
+			}
+		case *ComparisonExpr:
+			if list, ok := node.Right.(ListArg); ok {
+				return tm.matchList(node, string(list[2:]), query.Interface().(*ComparisonExpr))
+			}
+		}
+		return tm.match(tmpl.Elem(), query.Elem())
+	case reflect.Struct:
+		for i := 0; i < tmpl.NumField(); i++ {
+			// The unexported fields, like the source of
+			// statements, are not part of the structure.
+			if tmpl.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if !tm.match(tmpl.Field(i), query.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if tmpl.Len() != query.Len() {
+			return false
+		}
+		for i := 0; i < tmpl.Len(); i++ {
+			if !tm.match(tmpl.Index(i), query.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.String:
+		return tmpl.String() == query.String()
+	case reflect.Bool:
+		return tmpl.Bool() == query.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return tmpl.Int() == query.Int()
+	}
+	return reflect.DeepEqual(tmpl.Interface(), query.Interface())
+}
+
+// matchList matches a comparison of the template with a list bind
+// var to the comparison of the query, whose list is captured.
+func (tm *templateMatch) matchList(tmpl *ComparisonExpr, name string, query *ComparisonExpr) bool {
+	if tmpl.Operator != query.Operator {
+		return false
+	}
+	if !tm.match(reflect.ValueOf(&tmpl.Left).Elem(), reflect.ValueOf(&query.Left).Elem()) ||
+		!tm.match(reflect.ValueOf(&tmpl.Escape).Elem(), reflect.ValueOf(&query.Escape).Elem()) {
+		return false
+	}
+	return tm.capture(name, tm.nz.listBindvar(query))
+}
+
+// capture records that the bind var name stands for bval. It returns
+// false if there's no value, or if name already stands for another.
+func (tm *templateMatch) capture(name string, bval *querypb.BindVariable) bool {
+	if bval == nil {
+		return false
+	}
+	if prev, ok := tm.bindings[name]; ok {
+		return reflect.DeepEqual(prev, bval)
+	}
+	tm.bindings[name] = bval
+	return true
+}
@@ -0,0 +1,193 @@
+package sqlparser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xwb1989/sqlparser/dependency/querypb"
+	"github.com/xwb1989/sqlparser/dependency/sqltypes"
+)
+
+func TestMatchTemplate(t *testing.T) {
+	var templates []Statement
+	for _, sql := range []string{
+		"select * from t where id = :id",
+		"select a, b from t where a = :x and b = :x",
+		"select * from t where id in ::ids and c = 'active'",
+		"select * from t where (a, b) in ::rows",
+		"update t set a = :a where id = :id limit 1",
+		"insert into t(a, b) values (:a, :b)",
+		"select * from t where a in (:x, 2)",
+	} {
+		stmt, err := Parse(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		templates = append(templates, stmt)
+	}
+
+	testcases := []struct {
+		in       string
+		index    int
+		bindings map[string]*querypb.BindVariable
+	}{{
+		in:    "select * from t where id = 5",
+		index: 0,
+		bindings: map[string]*querypb.BindVariable{
+			"id": sqltypes.Int64BindVariable(5),
+		},
+	}, {
+		in:    "SELECT * FROM t WHERE ID = 'x'",
+		index: 0,
+		bindings: map[string]*querypb.BindVariable{
+			"id": sqltypes.BytesBindVariable([]byte("x")),
+		},
+	}, {
+		in:    "select * from T where id = 5",
+		index: -1,
+	}, {
+		in:    "select * from t where id = b",
+		index: -1,
+	}, {
+		in:    "select * from t where id = 5 and 1 = 1",
+		index: -1,
+	}, {
+		in:    "select a, b from t where a = 1.5 and b = 1.5",
+		index: 1,
+		bindings: map[string]*querypb.BindVariable{
+			"x": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5"))),
+		},
+	}, {
+		in:    "select a, b from t where a = 1 and b = 2",
+		index: -1,
+	}, {
+		in:    "select * from t where id in (1, 2, 3) and c = 'active'",
+		index: 2,
+		bindings: map[string]*querypb.BindVariable{
+			"ids": sqltypes.TestBindVariable([]interface{}{1, 2, 3}),
+		},
+	}, {
+		in:    "select * from t where id in (1, 2, 3) and c = 'deleted'",
+		index: -1,
+	}, {
+		in:    "select * from t where id in (1, x) and c = 'active'",
+		index: -1,
+	}, {
+		in:    "select * from t where (a, b) in ((1, 'x'), (2, 'y'))",
+		index: 3,
+		bindings: map[string]*querypb.BindVariable{
+			"rows": sqltypes.TestBindVariable([]interface{}{
+				[]interface{}{1, []byte("x")},
+				[]interface{}{2, []byte("y")},
+			}),
+		},
+	}, {
+		in:    "update t set a = null where id = 1 limit 1",
+		index: -1,
+	}, {
+		in:    "update /* trace */ t set a = 'z' where id = 1 limit 1",
+		index: -1,
+	}, {
+		in:    "update t set a = 'z' where id = 1 limit 1",
+		index: 4,
+		bindings: map[string]*querypb.BindVariable{
+			"a":  sqltypes.BytesBindVariable([]byte("z")),
+			"id": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		in:    "insert into t(a, b) values (1, 2)",
+		index: 5,
+		bindings: map[string]*querypb.BindVariable{
+			"a": sqltypes.Int64BindVariable(1),
+			"b": sqltypes.Int64BindVariable(2),
+		},
+	}, {
+		in:    "insert into t(a, b) values (1, 2), (3, 4)",
+		index: -1,
+	}, {
+		in:    "select * from t where a in (1, 2)",
+		index: -1,
+	}, {
+		in:    "select * from t where a in (1, 2, b)",
+		index: -1,
+	}, {
+		in:    "select * from t where a in (b, 2)",
+		index: -1,
+	}}
+	matcher := NewTemplateMatcher(templates)
+	for _, tcase := range testcases {
+		query, err := Parse(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		index, bindings, ok := matcher.Match(query)
+		if index != tcase.index || ok != (tcase.index >= 0) {
+			t.Errorf("Match(%s): %d, %v, want %d", tcase.in, index, ok, tcase.index)
+			continue
+		}
+		if !reflect.DeepEqual(bindings, tcase.bindings) {
+			t.Errorf("Match(%s) bindings: %v, want %v", tcase.in, bindings, tcase.bindings)
+		}
+		if index2, bindings2, ok2 := MatchTemplate(query, templates); index2 != index || ok2 != ok || !reflect.DeepEqual(bindings2, bindings) {
+			t.Errorf("MatchTemplate(%s): %d, %v, want the result of Match", tcase.in, index2, ok2)
+		}
+	}
+}
+
+func TestMatchTemplateNormalized(t *testing.T) {
+	// A template is a query that Normalize turned into
+	// bind vars: it matches the original query, and the
+	// bindings are the bind vars of Normalize.
+	for _, sql := range []string{
+		"select a, b from t where c = 'x' and d in (1, 2.5, 'y') and e = 1 limit 10",
+		"insert into t(a, b) values (1, 'x') on duplicate key update b = 'z'",
+		"delete from t where (a, b) in ((1, 2)) and c like 'x%'",
+	} {
+		tmpl, err := Parse(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bindVars := make(map[string]*querypb.BindVariable)
+		Normalize(tmpl, bindVars, "bv")
+		query, err := Parse(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		index, bindings, ok := MatchTemplate(query, []Statement{tmpl})
+		if !ok || index != 0 {
+			t.Errorf("MatchTemplate(%s, %s): %d, %v, want a match", sql, String(tmpl), index, ok)
+			continue
+		}
+		if !reflect.DeepEqual(bindings, bindVars) {
+			t.Errorf("MatchTemplate(%s) bindings: %v, want %v", sql, bindings, bindVars)
+		}
+	}
+}
+
+func BenchmarkMatchTemplate(b *testing.B) {
+	var templates []Statement
+	for _, sql := range []string{
+		"select * from t where id = :id",
+		"select a, b from t where a = :x and b = :x",
+		"update t set a = :a where id = :id limit 1",
+		"select * from t where id in ::ids and c = 'active' order by a desc limit 10",
+	} {
+		stmt, err := Parse(sql)
+		if err != nil {
+			b.Fatal(err)
+		}
+		templates = append(templates, stmt)
+	}
+	query, err := Parse("select * from t where id in (1, 2, 3, 4, 5) and c = 'active' order by a desc limit 10")
+	if err != nil {
+		b.Fatal(err)
+	}
+	matcher := NewTemplateMatcher(templates)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := matcher.Match(query); !ok {
+			b.Fatal("no match")
+		}
+	}
+}
@@ -18,9 +18,9 @@ package sqlparser
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/xwb1989/sqlparser/dependency/bytes2"
 	"github.com/xwb1989/sqlparser/dependency/sqltypes"
@@ -29,13 +29,113 @@ import (
 const (
 	defaultBufSize = 4096
 	eofChar        = 0x100
+	// tokenSize is the least number of AST bytes a token takes,
+	// not counting its text. It's what a value of a long INSERT
+	// takes, which has the smallest AST for its number of tokens.
+	tokenSize = 24
+	// maxNesting is the deepest nesting of parentheses the parser accepts.
+	maxNesting = 200
 )
 
+// Dialect selects the SQL dialect understood by the Tokenizer.
+type Dialect int
+
+// Dialect values
+const (
+	// MySQLDialect is the default dialect.
+	MySQLDialect Dialect = iota
+	// PostgresDialect additionally accepts :: as a cast operator,
+	// dollar-quoted strings, RETURNING clauses and the ON CONFLICT
+	// clause of INSERT, and || always concatenates strings. List
+	// bind variables (::name) are not available in this dialect.
+	PostgresDialect
+	// SQLServerDialect additionally accepts [bracketed] identifiers and
+	// the TOP clause.
+	SQLServerDialect
+	// MariaDBDialect additionally accepts the RETURNING clauses of
+	// INSERT, UPDATE and DELETE.
+	MariaDBDialect
+)
+
+// hasReturning returns true if RETURNING is a keyword of the dialect.
+func (d Dialect) hasReturning() bool {
+	return d == PostgresDialect || d == MariaDBDialect
+}
+
+// hasOnConflict returns true if the dialect accepts the
+// ON CONFLICT clause of INSERT.
+func (d Dialect) hasOnConflict() bool {
+	return d == PostgresDialect
+}
+
+// pipesAsConcat returns true if || concatenates strings in the
+// dialect, whatever the PipesAsConcat option.
+func (d Dialect) pipesAsConcat() bool {
+	return d == PostgresDialect
+}
+
+// SQLMode is a set of the SQL modes of MySQL that change how the
+// statements are tokenized, see ParseOptions and FormatOptions.
+type SQLMode int
+
+// SQLMode values
+const (
+	// SQLModeANSIQuotes is the ANSI_QUOTES mode: a "double quoted"
+	// text is an identifier, like a `backquoted` one, rather than
+	// a string.
+	SQLModeANSIQuotes SQLMode = 1 << iota
+	// SQLModeNoBackslashEscapes is the NO_BACKSLASH_ESCAPES mode:
+	// a backslash in a string is an ordinary character rather than
+	// the start of an escape sequence. A quote is escaped by doubling
+	// it.
+	SQLModeNoBackslashEscapes
+	// SQLModePipesAsConcat is the PIPES_AS_CONCAT mode: || is the
+	// string concatenation operator rather than a logical OR. It's
+	// the same as the PipesAsConcat options.
+	SQLModePipesAsConcat
+)
+
+// ParseSQLMode returns the SQLMode of a value of the sql_mode
+// variable of MySQL, a comma separated list like
+// ANSI_QUOTES,STRICT_TRANS_TABLES. The ANSI combination mode includes
+// ANSI_QUOTES and PIPES_AS_CONCAT. The modes that don't change the
+// tokenizing, and the unknown ones, are ignored.
+func ParseSQLMode(modes string) SQLMode {
+	var mode SQLMode
+	for _, name := range strings.Split(modes, ",") {
+		switch strings.ToUpper(strings.TrimSpace(name)) {
+		case "ANSI":
+			mode |= SQLModeANSIQuotes | SQLModePipesAsConcat
+		case "ANSI_QUOTES":
+			mode |= SQLModeANSIQuotes
+		case "PIPES_AS_CONCAT":
+			mode |= SQLModePipesAsConcat
+		case "NO_BACKSLASH_ESCAPES":
+			mode |= SQLModeNoBackslashEscapes
+		}
+	}
+	return mode
+}
+
+// backslashEscapes returns true if a backslash starts an escape
+// sequence in a text quoted with quote.
+func (m SQLMode) backslashEscapes(quote uint16) bool {
+	switch {
+	case quote == '`', quote == ']', m&SQLModeNoBackslashEscapes != 0:
+		return false
+	case quote == '"':
+		return m&SQLModeANSIQuotes == 0
+	}
+	return true
+}
+
 // Tokenizer is the struct used to generate SQL
 // tokens for the parser.
 type Tokenizer struct {
 	InStream       io.Reader
 	AllowComments  bool
+	Dialect        Dialect
+	TrackSource    bool
 	ForceEOF       bool
 	lastChar       uint16
 	Position       int
@@ -45,9 +145,70 @@ type Tokenizer struct {
 	ParseTree      Statement
 	partialDDL     *DDL
 	nesting        int
+	inTrigger      bool
 	multi          bool
 	specialComment *Tokenizer
 
+	// PipesAsConcat makes || the string concatenation operator, like
+	// the PIPES_AS_CONCAT SQL mode of MySQL, instead of a logical OR.
+	PipesAsConcat bool
+
+	// EmptyInLists accepts IN () and NOT IN (), see ParseOptions.
+	EmptyInLists bool
+
+	// NestedComments makes the /* */ comments nest, see ParseOptions.
+	NestedComments bool
+
+	// SQLMode is the SQL mode of the statements, see ParseOptions.
+	SQLMode SQLMode
+
+	// extension is the call to a function extension last scanned,
+	// and extensionErr the error of its extension, if any.
+	extension    *ExtensionExpr
+	extensionErr error
+
+	// MaxSize, if set, aborts the parsing of a statement with
+	// ErrTooComplex once its tokens imply that its AST would
+	// take more than MaxSize bytes, see EstimateSize.
+	MaxSize int
+
+	// lastTyp and prevTyp are the types of the last two tokens
+	// returned by Lex, and sawStatement is set once it returned
+	// one that's not a ';'. They classify the parse errors.
+	lastTyp, prevTyp int
+	sawStatement     bool
+	// size is the estimated size of the AST of the statement,
+	// and tooLarge is set once it exceeds MaxSize.
+	size     int
+	tooLarge bool
+
+	// delimiter is the statement delimiter set by a DELIMITER
+	// command, or empty if it's the default ';'.
+	delimiter string
+	// inStatement is set once a statement has started, DELIMITER
+	// commands are only recognized outside of statements.
+	inStatement bool
+
+	// nameBeforeDot is set when the last token is a name immediately
+	// followed by a dot, and afterDot when it's that dot: the name it
+	// qualifies can then start with digits, like in t.1a or t.5e, and
+	// is not a number.
+	nameBeforeDot, afterDot bool
+
+	// line is the number of line breaks consumed, and tokenPos and
+	// tokenLine are the position and the line of the first char of
+	// the token being scanned.
+	line                int
+	tokenPos, tokenLine int
+	// unterminated is set to what a LEX_ERROR was about, like
+	// "comment", when the input ended inside of it.
+	unterminated string
+
+	// source holds the input consumed since sourceStart
+	// if TrackSource is set.
+	source      []byte
+	sourceStart int
+
 	buf     []byte
 	bufPos  int
 	bufSize int
@@ -85,16 +246,18 @@ func NewTokenizer(r io.Reader) *Tokenizer {
 var keywords = map[string]int{
 	"accessible":          UNUSED,
 	"add":                 ADD,
+	"after":               AFTER,
 	"against":             AGAINST,
 	"all":                 ALL,
 	"alter":               ALTER,
 	"analyze":             ANALYZE,
 	"and":                 AND,
+	"array":               ARRAY,
 	"as":                  AS,
 	"asc":                 ASC,
 	"asensitive":          UNUSED,
 	"auto_increment":      AUTO_INCREMENT,
-	"before":              UNUSED,
+	"before":              BEFORE,
 	"begin":               BEGIN,
 	"between":             BETWEEN,
 	"bigint":              BIGINT,
@@ -104,17 +267,19 @@ var keywords = map[string]int{
 	"blob":                BLOB,
 	"bool":                BOOL,
 	"boolean":             BOOLEAN,
-	"both":                UNUSED,
+	"both":                BOTH,
 	"by":                  BY,
-	"call":                UNUSED,
-	"cascade":             UNUSED,
+	"cache":               CACHE,
+	"call":                CALL,
+	"cascade":             CASCADE,
 	"case":                CASE,
 	"cast":                CAST,
-	"change":              UNUSED,
+	"change":              CHANGE,
 	"char":                CHAR,
 	"character":           CHARACTER,
 	"charset":             CHARSET,
-	"check":               UNUSED,
+	"check":               CHECK,
+	"checksum":            CHECKSUM,
 	"collate":             COLLATE,
 	"column":              COLUMN,
 	"comment":             COMMENT_KEYWORD,
@@ -128,10 +293,11 @@ var keywords = map[string]int{
 	"substring":           SUBSTRING,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
-	"current_user":        UNUSED,
+	"current_user":        CURRENT_USER,
 	"cursor":              UNUSED,
 	"database":            DATABASE,
 	"databases":           DATABASES,
@@ -139,31 +305,38 @@ var keywords = map[string]int{
 	"day_microsecond":     UNUSED,
 	"day_minute":          UNUSED,
 	"day_second":          UNUSED,
+	"deallocate":          DEALLOCATE,
 	"date":                DATE,
 	"datetime":            DATETIME,
 	"dec":                 UNUSED,
 	"decimal":             DECIMAL,
 	"declare":             UNUSED,
 	"default":             DEFAULT,
-	"delayed":             UNUSED,
+	"definer":             DEFINER,
+	"delayed":             DELAYED,
 	"delete":              DELETE,
 	"desc":                DESC,
 	"describe":            DESCRIBE,
-	"deterministic":       UNUSED,
+	"deterministic":       DETERMINISTIC,
 	"distinct":            DISTINCT,
-	"distinctrow":         UNUSED,
+	"distinctrow":         DISTINCTROW,
 	"div":                 DIV,
+	"do":                  DO,
 	"double":              DOUBLE,
 	"drop":                DROP,
 	"duplicate":           DUPLICATE,
-	"each":                UNUSED,
+	"each":                EACH,
 	"else":                ELSE,
 	"elseif":              UNUSED,
-	"enclosed":            UNUSED,
+	"enclosed":            ENCLOSED,
 	"end":                 END,
+	"ends":                ENDS,
 	"enum":                ENUM,
 	"escape":              ESCAPE,
-	"escaped":             UNUSED,
+	"escaped":             ESCAPED,
+	"event":               EVENT,
+	"every":               EVERY,
+	"execute":             EXECUTE,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
@@ -171,15 +344,19 @@ var keywords = map[string]int{
 	"extended":            EXTENDED,
 	"false":               FALSE,
 	"fetch":               UNUSED,
+	"first":               FIRST,
 	"float":               FLOAT_TYPE,
+	"flush":               FLUSH,
 	"float4":              UNUSED,
 	"float8":              UNUSED,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
 	"from":                FROM,
 	"full":                FULL,
 	"fulltext":            FULLTEXT,
+	"function":            FUNCTION,
 	"generated":           UNUSED,
 	"geometry":            GEOMETRY,
 	"geometrycollection":  GEOMETRYCOLLECTION,
@@ -188,17 +365,19 @@ var keywords = map[string]int{
 	"grant":               UNUSED,
 	"group":               GROUP,
 	"group_concat":        GROUP_CONCAT,
+	"handler":             HANDLER,
 	"having":              HAVING,
-	"high_priority":       UNUSED,
+	"high_priority":       HIGH_PRIORITY,
 	"hour_microsecond":    UNUSED,
 	"hour_minute":         UNUSED,
 	"hour_second":         UNUSED,
 	"if":                  IF,
 	"ignore":              IGNORE,
+	"immediate":           IMMEDIATE,
 	"in":                  IN,
 	"index":               INDEX,
 	"infile":              UNUSED,
-	"inout":               UNUSED,
+	"inout":               INOUT,
 	"inner":               INNER,
 	"insensitive":         UNUSED,
 	"insert":              INSERT,
@@ -220,10 +399,10 @@ var keywords = map[string]int{
 	"key":                 KEY,
 	"keys":                KEYS,
 	"key_block_size":      KEY_BLOCK_SIZE,
-	"kill":                UNUSED,
+	"kill":                KILL,
 	"language":            LANGUAGE,
 	"last_insert_id":      LAST_INSERT_ID,
-	"leading":             UNUSED,
+	"leading":             LEADING,
 	"leave":               UNUSED,
 	"left":                LEFT,
 	"less":                LESS,
@@ -231,9 +410,10 @@ var keywords = map[string]int{
 	"like":                LIKE,
 	"limit":               LIMIT,
 	"linear":              UNUSED,
-	"lines":               UNUSED,
+	"lines":               LINES,
 	"linestring":          LINESTRING,
-	"load":                UNUSED,
+	"load":                LOAD,
+	"local":               LOCAL,
 	"localtime":           LOCALTIME,
 	"localtimestamp":      LOCALTIMESTAMP,
 	"lock":                LOCK,
@@ -241,19 +421,20 @@ var keywords = map[string]int{
 	"longblob":            LONGBLOB,
 	"longtext":            LONGTEXT,
 	"loop":                UNUSED,
-	"low_priority":        UNUSED,
+	"low_priority":        LOW_PRIORITY,
 	"master_bind":         UNUSED,
 	"match":               MATCH,
 	"maxvalue":            MAXVALUE,
 	"mediumblob":          MEDIUMBLOB,
 	"mediumint":           MEDIUMINT,
 	"mediumtext":          MEDIUMTEXT,
+	"member":              MEMBER,
 	"middleint":           UNUSED,
 	"minute_microsecond":  UNUSED,
 	"minute_second":       UNUSED,
 	"mod":                 MOD,
 	"mode":                MODE,
-	"modifies":            UNUSED,
+	"modifies":            MODIFIES,
 	"multilinestring":     MULTILINESTRING,
 	"multipoint":          MULTIPOINT,
 	"multipolygon":        MULTIPOLYGON,
@@ -262,35 +443,42 @@ var keywords = map[string]int{
 	"nchar":               NCHAR,
 	"next":                NEXT,
 	"not":                 NOT,
-	"no_write_to_binlog":  UNUSED,
+	"no_write_to_binlog":  NO_WRITE_TO_BINLOG,
 	"null":                NULL,
 	"numeric":             NUMERIC,
+	"of":                  OF,
 	"offset":              OFFSET,
 	"on":                  ON,
 	"only":                ONLY,
 	"optimize":            OPTIMIZE,
 	"optimizer_costs":     UNUSED,
 	"option":              UNUSED,
-	"optionally":          UNUSED,
+	"optionally":          OPTIONALLY,
 	"or":                  OR,
 	"order":               ORDER,
-	"out":                 UNUSED,
+	"out":                 OUT,
 	"outer":               OUTER,
-	"outfile":             UNUSED,
+	"outfile":             OUTFILE,
+	"over":                OVER,
 	"partition":           PARTITION,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
+	"prepare":             PREPARE,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
+	"purge":               PURGE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"quick":               QUICK,
+	"range":               RANGE,
 	"read":                READ,
-	"reads":               UNUSED,
+	"reads":               READS,
 	"read_write":          UNUSED,
 	"real":                REAL,
-	"references":          UNUSED,
+	"recursive":           RECURSIVE,
+	"references":          REFERENCES,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
 	"rename":              RENAME,
@@ -300,13 +488,17 @@ var keywords = map[string]int{
 	"repeatable":          REPEATABLE,
 	"replace":             REPLACE,
 	"require":             UNUSED,
+	"reset":               RESET,
 	"resignal":            UNUSED,
-	"restrict":            UNUSED,
-	"return":              UNUSED,
+	"restrict":            RESTRICT,
+	"return":              RETURN,
 	"revoke":              UNUSED,
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"rollup":              ROLLUP,
+	"row":                 ROW,
+	"rows":                ROWS,
 	"schema":              SCHEMA,
 	"schemas":             UNUSED,
 	"second_microsecond":  UNUSED,
@@ -323,25 +515,28 @@ var keywords = map[string]int{
 	"smallint":            SMALLINT,
 	"spatial":             SPATIAL,
 	"specific":            UNUSED,
-	"sql":                 UNUSED,
+	"sql":                 SQL,
 	"sqlexception":        UNUSED,
 	"sqlstate":            UNUSED,
 	"sqlwarning":          UNUSED,
-	"sql_big_result":      UNUSED,
+	"sql_big_result":      SQL_BIG_RESULT,
+	"sql_buffer_result":   SQL_BUFFER_RESULT,
 	"sql_cache":           SQL_CACHE,
-	"sql_calc_found_rows": UNUSED,
+	"sql_calc_found_rows": SQL_CALC_FOUND_ROWS,
 	"sql_no_cache":        SQL_NO_CACHE,
-	"sql_small_result":    UNUSED,
+	"sql_small_result":    SQL_SMALL_RESULT,
 	"ssl":                 UNUSED,
 	"start":               START,
-	"starting":            UNUSED,
+	"starts":              STARTS,
+	"starting":            STARTING,
 	"status":              STATUS,
+	"stop":                STOP,
 	"stored":              UNUSED,
 	"straight_join":       STRAIGHT_JOIN,
 	"stream":              STREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
-	"terminated":          UNUSED,
+	"terminated":          TERMINATED,
 	"text":                TEXT,
 	"than":                THAN,
 	"then":                THEN,
@@ -351,16 +546,18 @@ var keywords = map[string]int{
 	"tinyint":             TINYINT,
 	"tinytext":            TINYTEXT,
 	"to":                  TO,
-	"trailing":            UNUSED,
+	"trailing":            TRAILING,
 	"transaction":         TRANSACTION,
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"uncommitted":         UNCOMMITTED,
 	"undo":                UNUSED,
 	"union":               UNION,
 	"unique":              UNIQUE,
-	"unlock":              UNUSED,
+	"unknown":             UNKNOWN,
+	"unlock":              UNLOCK,
 	"unsigned":            UNSIGNED,
 	"update":              UPDATE,
 	"usage":               UNUSED,
@@ -386,14 +583,46 @@ var keywords = map[string]int{
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"write":               WRITE,
+	"xa":                  XA,
 	"xor":                 UNUSED,
 	"year":                YEAR,
 	"year_month":          UNUSED,
 	"zerofill":            ZEROFILL,
 }
 
+// sqlServerKeywords are keywords that are only recognized in the
+// SQLServerDialect. They remain plain identifiers otherwise.
+var sqlServerKeywords = map[string]int{
+	"percent": PERCENT,
+	"top":     TOP,
+}
+
+// returningKeywords are keywords that are only recognized in the
+// dialects that accept RETURNING clauses.
+var returningKeywords = map[string]int{
+	"returning": RETURNING,
+}
+
+// onConflictKeywords are keywords that are only recognized in the
+// dialects that accept ON CONFLICT clauses.
+var onConflictKeywords = map[string]int{
+	"conflict": CONFLICT,
+	"nothing":  NOTHING,
+}
+
+// functionKeywords are names of functions with special argument
+// syntax. Like MySQL, they are only recognized as keywords when
+// immediately followed by '(', and are plain identifiers otherwise.
+var functionKeywords = map[string]int{
+	"extract":       EXTRACT,
+	"position":      POSITION,
+	"trim":          TRIM,
+	"weight_string": WEIGHT_STRING,
+}
+
 // keywordStrings contains the reverse mapping of token to keyword strings
 var keywordStrings = map[int]string{}
 
@@ -404,6 +633,15 @@ func init() {
 		}
 		keywordStrings[id] = str
 	}
+	for str, id := range sqlServerKeywords {
+		keywordStrings[id] = str
+	}
+	for str, id := range returningKeywords {
+		keywordStrings[id] = str
+	}
+	for str, id := range functionKeywords {
+		keywordStrings[id] = str
+	}
 }
 
 // KeywordString returns the string corresponding to the given keyword
@@ -419,41 +657,98 @@ func KeywordString(id int) string {
 // This function is used by go yacc.
 func (tkn *Tokenizer) Lex(lval *yySymType) int {
 	typ, val := tkn.Scan()
-	for typ == COMMENT {
-		if tkn.AllowComments {
-			break
-		}
+	for typ == DELIMITER || typ == COMMENT && !tkn.AllowComments {
 		typ, val = tkn.Scan()
 	}
 	lval.bytes = val
+	if typ == EXTENSION_FUNC {
+		lval.expr = tkn.extension
+	}
 	tkn.lastToken = val
+	tkn.prevTyp, tkn.lastTyp = tkn.lastTyp, typ
+	if typ != ';' && typ != 0 {
+		tkn.sawStatement = true
+	}
+	if tkn.MaxSize > 0 {
+		tkn.size += tokenSize + len(val)
+		if tkn.size > tkn.MaxSize {
+			tkn.tooLarge = true
+			return LEX_ERROR
+		}
+	}
 	return typ
 }
 
 // Error is called by go yacc if there's a parsing error.
+// It sets LastError to a *ParseError.
 func (tkn *Tokenizer) Error(err string) {
-	buf := &bytes2.Buffer{}
-	if tkn.lastToken != nil {
-		fmt.Fprintf(buf, "%s at position %v near '%s'", err, tkn.Position, tkn.lastToken)
-	} else {
-		fmt.Fprintf(buf, "%s at position %v", err, tkn.Position)
+	parseErr := &ParseError{
+		Message:  err,
+		Position: tkn.Position,
+		Near:     string(tkn.lastToken),
+		err:      ErrSyntax,
 	}
-	tkn.LastError = errors.New(buf.String())
+	switch {
+	case tkn.extensionErr != nil:
+		parseErr.Message = tkn.extensionErr.Error()
+	case tkn.unterminated != "":
+		// Point at where it starts, the end of the input
+		// tells nothing.
+		parseErr.Message = fmt.Sprintf("unterminated %s starting at line %d", tkn.unterminated, tkn.tokenLine)
+		parseErr.Position = tkn.tokenPos
+		parseErr.Near = ""
+	case tkn.tooLarge:
+		parseErr.Message = fmt.Sprintf("statement exceeds the size limit of %d bytes", tkn.MaxSize)
+		parseErr.err = ErrTooComplex
+	case tkn.nesting >= maxNesting:
+		parseErr.err = ErrTooComplex
+	case !tkn.sawStatement:
+		parseErr.err = ErrEmpty
+	case tkn.prevTyp == ';' && tkn.lastTyp != ';' && !tkn.multi:
+		// The statement before the ';' was complete.
+		parseErr.err = ErrMultipleStatements
+	}
+	tkn.LastError = parseErr
 
 	// Try and re-sync to the next statement
-	if tkn.lastChar != ';' {
-		tkn.skipStatement()
+	tkn.skipStatement()
+}
+
+// errorEmptyInList reports an IN with an empty list, which is
+// an error unless EmptyInLists is set.
+func (tkn *Tokenizer) errorEmptyInList() {
+	tkn.Error("empty in list")
+	if parseErr, ok := tkn.LastError.(*ParseError); ok && parseErr.err == ErrSyntax {
+		parseErr.err = ErrEmptyInList
 	}
 }
 
 // Scan scans the tokenizer for the next token and returns
 // the token type and an optional value.
+//
+// A DELIMITER command, like the one of the mysql client, is
+// returned as a DELIMITER token with the new delimiter as value.
+// After it the delimiter ends statements like ';' does: it's
+// returned as a ';' token with the delimiter as value.
 func (tkn *Tokenizer) Scan() (int, []byte) {
+	typ, val := tkn.scan()
+	switch typ {
+	case COMMENT, DELIMITER:
+	case 0, ';':
+		tkn.inStatement = false
+	default:
+		tkn.inStatement = true
+	}
+	return typ, val
+}
+
+func (tkn *Tokenizer) scan() (int, []byte) {
 	if tkn.specialComment != nil {
 		// Enter specialComment scan mode.
 		// for scanning such kind of comment: /*! MySQL-specific code */
 		specialComment := tkn.specialComment
 		tok, val := specialComment.Scan()
+		tkn.extension, tkn.extensionErr = specialComment.extension, specialComment.extensionErr
 		if tok != 0 {
 			// return the specialComment scan result as the result
 			return tok, val
@@ -464,6 +759,8 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 	if tkn.lastChar == 0 {
 		tkn.next()
 	}
+	nameBeforeDot, afterDot := tkn.nameBeforeDot, tkn.afterDot
+	tkn.nameBeforeDot, tkn.afterDot = false, false
 
 	if tkn.ForceEOF {
 		tkn.skipStatement()
@@ -471,6 +768,14 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 	}
 
 	tkn.skipBlank()
+	tkn.tokenPos, tkn.tokenLine = tkn.Position, tkn.line+1
+	if tkn.delimiter != "" && tkn.hasPrefix(tkn.delimiter) {
+		if tkn.multi {
+			return 0, nil
+		}
+		tkn.skipDelimiter()
+		return ';', []byte(tkn.delimiter)
+	}
 	switch ch := tkn.lastChar; {
 	case isLetter(ch):
 		tkn.next()
@@ -490,19 +795,31 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 		if ch == '@' && tkn.lastChar == '@' {
 			isDbSystemVariable = true
 		}
-		return tkn.scanIdentifier(byte(ch), isDbSystemVariable)
+		typ, val := tkn.scanIdentifier(byte(ch), isDbSystemVariable)
+		if typ == ID && !tkn.inStatement && (tkn.lastChar == ' ' || tkn.lastChar == '\t') && bytes.EqualFold(val, []byte("delimiter")) {
+			return tkn.scanDelimiterCommand()
+		}
+		tkn.nameBeforeDot = tkn.lastChar == '.'
+		return typ, val
+	case isDigit(ch) && afterDot:
+		buffer := &bytes2.Buffer{}
+		for isLetter(tkn.lastChar) || isDigit(tkn.lastChar) {
+			tkn.consumeNext(buffer)
+		}
+		tkn.nameBeforeDot = tkn.lastChar == '.'
+		return ID, buffer.Bytes()
 	case isDigit(ch):
 		return tkn.scanNumber(false)
 	case ch == ':':
 		return tkn.scanBindVar()
-	case ch == ';' && tkn.multi:
+	case ch == ';' && tkn.multi && tkn.delimiter == "":
 		return 0, nil
 	default:
 		tkn.next()
 		switch ch {
 		case eofChar:
 			return 0, nil
-		case '=', ',', ';', '(', ')', '+', '*', '%', '^', '~':
+		case '=', ',', ';', '(', ')', '+', '*', '%', '^', '~', '{', '}':
 			return int(ch), nil
 		case '&':
 			if tkn.lastChar == '&' {
@@ -513,6 +830,9 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 		case '|':
 			if tkn.lastChar == '|' {
 				tkn.next()
+				if tkn.PipesAsConcat || tkn.SQLMode&SQLModePipesAsConcat != 0 || tkn.Dialect.pipesAsConcat() {
+					return PIPE_CONCAT, nil
+				}
 				return OR, nil
 			}
 			return int(ch), nil
@@ -522,9 +842,10 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 			fmt.Fprintf(buf, ":v%d", tkn.posVarIndex)
 			return VALUE_ARG, buf.Bytes()
 		case '.':
-			if isDigit(tkn.lastChar) {
+			if isDigit(tkn.lastChar) && !nameBeforeDot {
 				return tkn.scanNumber(true)
 			}
+			tkn.afterDot = nameBeforeDot
 			return int(ch), nil
 		case '/':
 			switch tkn.lastChar {
@@ -562,7 +883,7 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 			switch tkn.lastChar {
 			case '>':
 				tkn.next()
-				return NE, nil
+				return NE, []byte("<>")
 			case '<':
 				tkn.next()
 				return SHIFT_LEFT, nil
@@ -592,13 +913,32 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 		case '!':
 			if tkn.lastChar == '=' {
 				tkn.next()
-				return NE, nil
+				return NE, []byte("!=")
 			}
 			return int(ch), nil
-		case '\'', '"':
+		case '"':
+			if tkn.SQLMode&SQLModeANSIQuotes != 0 {
+				typ, val := tkn.scanLiteralIdentifier('"')
+				tkn.nameBeforeDot = tkn.lastChar == '.'
+				return typ, val
+			}
+			return tkn.scanString(ch, STRING)
+		case '\'':
 			return tkn.scanString(ch, STRING)
 		case '`':
-			return tkn.scanLiteralIdentifier()
+			typ, val := tkn.scanLiteralIdentifier('`')
+			tkn.nameBeforeDot = tkn.lastChar == '.'
+			return typ, val
+		case '[':
+			if tkn.Dialect == SQLServerDialect {
+				return tkn.scanLiteralIdentifier(']')
+			}
+			return LEX_ERROR, []byte{byte(ch)}
+		case '$':
+			if tkn.Dialect == PostgresDialect {
+				return tkn.scanDollarQuotedString()
+			}
+			return LEX_ERROR, []byte{byte(ch)}
 		default:
 			return LEX_ERROR, []byte{byte(ch)}
 		}
@@ -607,13 +947,121 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 
 // skipStatement scans until the EOF, or end of statement is encountered.
 func (tkn *Tokenizer) skipStatement() {
-	ch := tkn.lastChar
-	for ch != ';' && ch != eofChar {
+	for !tkn.atDelimiter() && tkn.lastChar != eofChar {
 		tkn.next()
-		ch = tkn.lastChar
 	}
 }
 
+// scanRest scans until the end of the statement like skipStatement,
+// and returns the text it scanned without the surrounding spaces. The
+// delimiters in quoted strings and identifiers don't end it. The next
+// Scan returns the end of the statement.
+func (tkn *Tokenizer) scanRest() string {
+	var text []byte
+	for !tkn.atDelimiter() && tkn.lastChar != eofChar {
+		quote := tkn.lastChar
+		text = append(text, byte(quote))
+		tkn.next()
+		if quote != '\'' && quote != '"' && quote != '`' {
+			continue
+		}
+		for tkn.lastChar != eofChar {
+			ch := tkn.lastChar
+			text = append(text, byte(ch))
+			tkn.next()
+			if ch == quote {
+				break
+			}
+			if ch == '\\' && tkn.SQLMode.backslashEscapes(quote) && tkn.lastChar != eofChar {
+				text = append(text, byte(tkn.lastChar))
+				tkn.next()
+			}
+		}
+	}
+	tkn.ForceEOF = true
+	return strings.TrimSpace(string(text))
+}
+
+// atDelimiter reports whether the input at the current char
+// starts with the statement delimiter.
+func (tkn *Tokenizer) atDelimiter() bool {
+	if tkn.delimiter == "" {
+		return tkn.lastChar == ';'
+	}
+	return tkn.hasPrefix(tkn.delimiter)
+}
+
+// isDelimiter reports whether the token typ with the value val,
+// as returned by Scan, is the statement delimiter. A ';' isn't
+// once a DELIMITER command changed it.
+func (tkn *Tokenizer) isDelimiter(typ int, val []byte) bool {
+	return typ == ';' && (tkn.delimiter == "" || len(val) != 0)
+}
+
+// skipDelimiter consumes the statement delimiter if the input
+// at the current char starts with it.
+func (tkn *Tokenizer) skipDelimiter() {
+	if !tkn.atDelimiter() {
+		return
+	}
+	n := 1
+	if tkn.delimiter != "" {
+		n = len(tkn.delimiter)
+	}
+	for i := 0; i < n; i++ {
+		tkn.next()
+	}
+}
+
+// hasPrefix reports whether the input at the current char starts
+// with s. It may read ahead from InStream, but doesn't consume
+// anything.
+func (tkn *Tokenizer) hasPrefix(s string) bool {
+	if len(s) == 0 || tkn.lastChar != uint16(s[0]) {
+		return false
+	}
+	rest := s[1:]
+	if tkn.bufSize-tkn.bufPos < len(rest) && tkn.InStream != nil {
+		// Move the unread input to the start of the buffer
+		// and read enough to compare the rest of s.
+		tkn.bufSize = copy(tkn.buf, tkn.buf[tkn.bufPos:tkn.bufSize])
+		tkn.bufPos = 0
+		for tkn.bufSize < len(rest) {
+			n, err := tkn.InStream.Read(tkn.buf[tkn.bufSize:])
+			tkn.bufSize += n
+			if err != nil {
+				if err != io.EOF {
+					tkn.LastError = err
+				}
+				break
+			}
+		}
+	}
+	return bytes.HasPrefix(tkn.buf[tkn.bufPos:tkn.bufSize], []byte(rest))
+}
+
+// scanDelimiterCommand scans the argument of a DELIMITER command,
+// which runs until the end of the line, and makes it the statement
+// delimiter.
+func (tkn *Tokenizer) scanDelimiterCommand() (int, []byte) {
+	for tkn.lastChar == ' ' || tkn.lastChar == '\t' {
+		tkn.next()
+	}
+	buffer := &bytes2.Buffer{}
+	for tkn.lastChar != eofChar && tkn.lastChar != '\n' && tkn.lastChar != '\r' {
+		tkn.consumeNext(buffer)
+	}
+	delimiter := bytes.TrimRight(buffer.Bytes(), " \t")
+	if len(delimiter) == 0 {
+		return LEX_ERROR, buffer.Bytes()
+	}
+	tkn.delimiter = string(delimiter)
+	if tkn.delimiter == ";" {
+		tkn.delimiter = ""
+	}
+	return DELIMITER, delimiter
+}
+
 func (tkn *Tokenizer) skipBlank() {
 	ch := tkn.lastChar
 	for ch == ' ' || ch == '\n' || ch == '\r' || ch == '\t' {
@@ -634,6 +1082,29 @@ func (tkn *Tokenizer) scanIdentifier(firstByte byte, isDbSystemVariable bool) (i
 	if keywordID, found := keywords[loweredStr]; found {
 		return keywordID, lowered
 	}
+	if tkn.lastChar == '(' {
+		if keywordID, found := functionKeywords[loweredStr]; found {
+			return keywordID, lowered
+		}
+		if parse := functionExtension(loweredStr); parse != nil {
+			return tkn.scanExtensionCall(buffer.Bytes(), parse)
+		}
+	}
+	if tkn.Dialect == SQLServerDialect {
+		if keywordID, found := sqlServerKeywords[loweredStr]; found {
+			return keywordID, lowered
+		}
+	}
+	if tkn.Dialect.hasReturning() {
+		if keywordID, found := returningKeywords[loweredStr]; found {
+			return keywordID, lowered
+		}
+	}
+	if tkn.Dialect.hasOnConflict() {
+		if keywordID, found := onConflictKeywords[loweredStr]; found {
+			return keywordID, lowered
+		}
+	}
 	// dual must always be case-insensitive
 	if loweredStr == "dual" {
 		return ID, lowered
@@ -664,25 +1135,28 @@ func (tkn *Tokenizer) scanBitLiteral() (int, []byte) {
 	return BIT_LITERAL, buffer.Bytes()
 }
 
-func (tkn *Tokenizer) scanLiteralIdentifier() (int, []byte) {
+// scanLiteralIdentifier scans a quoted identifier that is terminated
+// by delim. A doubled delim stands for a single one.
+func (tkn *Tokenizer) scanLiteralIdentifier(delim uint16) (int, []byte) {
 	buffer := &bytes2.Buffer{}
-	backTickSeen := false
+	delimSeen := false
 	for {
-		if backTickSeen {
-			if tkn.lastChar != '`' {
+		if delimSeen {
+			if tkn.lastChar != delim {
 				break
 			}
-			backTickSeen = false
-			buffer.WriteByte('`')
+			delimSeen = false
+			buffer.WriteByte(byte(delim))
 			tkn.next()
 			continue
 		}
-		// The previous char was not a backtick.
+		// The previous char was not a delimiter.
 		switch tkn.lastChar {
-		case '`':
-			backTickSeen = true
+		case delim:
+			delimSeen = true
 		case eofChar:
 			// Premature EOF.
+			tkn.unterminated = "quoted identifier"
 			return LEX_ERROR, buffer.Bytes()
 		default:
 			buffer.WriteByte(byte(tkn.lastChar))
@@ -701,6 +1175,10 @@ func (tkn *Tokenizer) scanBindVar() (int, []byte) {
 	token := VALUE_ARG
 	tkn.next()
 	if tkn.lastChar == ':' {
+		if tkn.Dialect == PostgresDialect {
+			tkn.next()
+			return TYPECAST, nil
+		}
 		token = LIST_ARG
 		buffer.WriteByte(byte(tkn.lastChar))
 		tkn.next()
@@ -725,7 +1203,7 @@ func (tkn *Tokenizer) scanNumber(seenDecimalPoint bool) (int, []byte) {
 	token := INTEGRAL
 	buffer := &bytes2.Buffer{}
 	if seenDecimalPoint {
-		token = FLOAT
+		token = DECIMAL_LITERAL
 		buffer.WriteByte('.')
 		tkn.scanMantissa(10, buffer)
 		goto exponent
@@ -745,18 +1223,24 @@ func (tkn *Tokenizer) scanNumber(seenDecimalPoint bool) (int, []byte) {
 	tkn.scanMantissa(10, buffer)
 
 	if tkn.lastChar == '.' {
-		token = FLOAT
+		token = DECIMAL_LITERAL
 		tkn.consumeNext(buffer)
 		tkn.scanMantissa(10, buffer)
 	}
 
 exponent:
+	// A number with a fraction part is a decimal,
+	// unless it has an exponent.
 	if tkn.lastChar == 'e' || tkn.lastChar == 'E' {
 		token = FLOAT
 		tkn.consumeNext(buffer)
 		if tkn.lastChar == '+' || tkn.lastChar == '-' {
 			tkn.consumeNext(buffer)
 		}
+		// An exponent needs digits.
+		if !isDigit(tkn.lastChar) {
+			return LEX_ERROR, buffer.Bytes()
+		}
 		tkn.scanMantissa(10, buffer)
 	}
 
@@ -769,29 +1253,64 @@ exit:
 	return token, buffer.Bytes()
 }
 
+// scanDollarQuotedString scans a postgres style $tag$...$tag$ string.
+// The opening '$' has already been consumed. The body is returned as is,
+// without any escape processing.
+func (tkn *Tokenizer) scanDollarQuotedString() (int, []byte) {
+	delim := &bytes2.Buffer{}
+	delim.WriteByte('$')
+	for tkn.lastChar != '$' {
+		if !isLetter(tkn.lastChar) && !(delim.Len() > 1 && isDigit(tkn.lastChar)) {
+			return LEX_ERROR, delim.Bytes()
+		}
+		delim.WriteByte(byte(tkn.lastChar))
+		tkn.next()
+	}
+	delim.WriteByte('$')
+	tkn.next()
+
+	buffer := &bytes2.Buffer{}
+	for {
+		if tkn.lastChar == eofChar {
+			tkn.unterminated = "string"
+			return LEX_ERROR, buffer.Bytes()
+		}
+		buffer.WriteByte(byte(tkn.lastChar))
+		tkn.next()
+		if bytes.HasSuffix(buffer.Bytes(), delim.Bytes()) {
+			return STRING, buffer.Bytes()[:buffer.Len()-delim.Len()]
+		}
+	}
+}
+
 func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
 	var buffer bytes2.Buffer
+	escapes := tkn.SQLMode.backslashEscapes(delim)
 	for {
 		ch := tkn.lastChar
 		if ch == eofChar {
-			// Unterminated string.
+			tkn.unterminated = "string"
 			return LEX_ERROR, buffer.Bytes()
 		}
 
-		if ch != delim && ch != '\\' {
+		if ch != delim && (ch != '\\' || !escapes) {
 			buffer.WriteByte(byte(ch))
 
 			// Scan ahead to the next interesting character.
 			start := tkn.bufPos
 			for ; tkn.bufPos < tkn.bufSize; tkn.bufPos++ {
 				ch = uint16(tkn.buf[tkn.bufPos])
-				if ch == delim || ch == '\\' {
+				if ch == delim || ch == '\\' && escapes {
 					break
 				}
 			}
 
 			buffer.Write(tkn.buf[start:tkn.bufPos])
 			tkn.Position += (tkn.bufPos - start)
+			tkn.line += bytes.Count(tkn.buf[start:tkn.bufPos], []byte{'\n'})
+			if tkn.TrackSource {
+				tkn.source = append(tkn.source, tkn.buf[start:tkn.bufPos]...)
+			}
 
 			if tkn.bufPos >= tkn.bufSize {
 				// Reached the end of the buffer without finding a delim or
@@ -800,17 +1319,26 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
 				continue
 			}
 
+			if tkn.TrackSource {
+				tkn.source = append(tkn.source, tkn.buf[tkn.bufPos])
+			}
 			tkn.bufPos++
 			tkn.Position++
 		}
 		tkn.next() // Read one past the delim or escape character.
 
-		if ch == '\\' {
+		if ch == '\\' && escapes {
 			if tkn.lastChar == eofChar {
 				// String terminates mid escape character.
+				tkn.unterminated = "string"
 				return LEX_ERROR, buffer.Bytes()
 			}
-			if decodedChar := sqltypes.SQLDecodeMap[byte(tkn.lastChar)]; decodedChar == sqltypes.DontEscape {
+			if tkn.lastChar == '%' || tkn.lastChar == '_' {
+				// MySQL keeps the backslash for \% and \_ so that
+				// they still act as escaped wildcards in LIKE patterns.
+				buffer.WriteByte('\\')
+				ch = tkn.lastChar
+			} else if decodedChar := sqltypes.SQLDecodeMap[byte(tkn.lastChar)]; decodedChar == sqltypes.DontEscape {
 				ch = tkn.lastChar
 			} else {
 				ch = uint16(decodedChar)
@@ -841,19 +1369,34 @@ func (tkn *Tokenizer) scanCommentType1(prefix string) (int, []byte) {
 	return COMMENT, buffer.Bytes()
 }
 
+// scanCommentType2 scans a /* */ comment. With NestedComments,
+// the comment ends at the */ that matches its /*, rather than at
+// the first one.
 func (tkn *Tokenizer) scanCommentType2() (int, []byte) {
 	buffer := &bytes2.Buffer{}
 	buffer.WriteString("/*")
+	depth := 1
 	for {
 		if tkn.lastChar == '*' {
 			tkn.consumeNext(buffer)
 			if tkn.lastChar == '/' {
 				tkn.consumeNext(buffer)
-				break
+				if depth--; !tkn.NestedComments || depth == 0 {
+					break
+				}
+			}
+			continue
+		}
+		if tkn.lastChar == '/' && tkn.NestedComments {
+			tkn.consumeNext(buffer)
+			if tkn.lastChar == '*' {
+				tkn.consumeNext(buffer)
+				depth++
 			}
 			continue
 		}
 		if tkn.lastChar == eofChar {
+			tkn.unterminated = "comment"
 			return LEX_ERROR, buffer.Bytes()
 		}
 		tkn.consumeNext(buffer)
@@ -875,15 +1418,174 @@ func (tkn *Tokenizer) scanMySQLSpecificComment() (int, []byte) {
 			continue
 		}
 		if tkn.lastChar == eofChar {
+			tkn.unterminated = "comment"
 			return LEX_ERROR, buffer.Bytes()
 		}
 		tkn.consumeNext(buffer)
 	}
 	_, sql := ExtractMysqlComment(buffer.String())
 	tkn.specialComment = NewStringTokenizer(sql)
+	tkn.specialComment.Dialect = tkn.Dialect
+	tkn.specialComment.PipesAsConcat = tkn.PipesAsConcat
+	tkn.specialComment.EmptyInLists = tkn.EmptyInLists
+	tkn.specialComment.NestedComments = tkn.NestedComments
+	tkn.specialComment.SQLMode = tkn.SQLMode
 	return tkn.Scan()
 }
 
+// scanBlockBody consumes the raw text of a BEGIN ... END compound
+// statement whose BEGIN keyword was just returned by Scan. Statements
+// inside the block are not tokenized, only strings, comments and the
+// keywords that open and close nested blocks are tracked. It returns
+// the text up to and including the matching END, and false if the
+// input ended before the block was closed.
+func (tkn *Tokenizer) scanBlockBody() (string, bool) {
+	if tkn.specialComment != nil {
+		return tkn.specialComment.scanBlockBody()
+	}
+	buffer := &bytes2.Buffer{}
+	depth := 1
+	// stmtStart is set where a new statement inside the block may
+	// begin, so that IF, WHILE and friends are not mistaken for the
+	// functions of the same name.
+	stmtStart := true
+	afterEnd := false
+	for {
+		ch := tkn.lastChar
+		switch {
+		case ch == eofChar, tkn.delimiter != "" && tkn.hasPrefix(tkn.delimiter):
+			return buffer.String(), false
+		case isLetter(ch):
+			start := buffer.Len()
+			for isLetter(tkn.lastChar) || isDigit(tkn.lastChar) {
+				tkn.consumeNext(buffer)
+			}
+			word := string(bytes.ToLower(buffer.Bytes()[start:]))
+			opens := false
+			switch word {
+			case "begin", "case":
+				opens = true
+			case "if", "loop", "repeat", "while":
+				opens = stmtStart
+			case "end":
+				depth--
+				if depth == 0 {
+					return buffer.String(), true
+				}
+				afterEnd = true
+				stmtStart = false
+				continue
+			}
+			if opens && !afterEnd {
+				depth++
+			}
+			afterEnd = false
+			switch word {
+			case "begin", "then", "else", "do", "loop", "repeat":
+				stmtStart = true
+			default:
+				stmtStart = false
+			}
+		case ch == ' ' || ch == '\n' || ch == '\r' || ch == '\t':
+			tkn.consumeNext(buffer)
+		default:
+			matched, ok := tkn.scanRawQuoteOrComment(buffer)
+			if !ok {
+				return buffer.String(), false
+			}
+			if matched {
+				continue
+			}
+			// A label ends in ':' and precedes a statement.
+			stmtStart = ch == ';' || ch == ':'
+			afterEnd = false
+			tkn.consumeNext(buffer)
+		}
+	}
+}
+
+// scanStatementBody consumes the raw text up to the end of the
+// current statement, which is not tokenized. It returns false if
+// the input ended in a string or a comment.
+func (tkn *Tokenizer) scanStatementBody() (string, bool) {
+	if tkn.specialComment != nil {
+		return tkn.specialComment.scanStatementBody()
+	}
+	buffer := &bytes2.Buffer{}
+	for tkn.lastChar != eofChar && !tkn.atDelimiter() {
+		matched, ok := tkn.scanRawQuoteOrComment(buffer)
+		if !ok {
+			return buffer.String(), false
+		}
+		if !matched {
+			tkn.consumeNext(buffer)
+		}
+	}
+	return strings.TrimRight(buffer.String(), " \n\r\t"), true
+}
+
+// scanRawQuoteOrComment copies a quoted string or identifier, or a
+// comment, into buffer if one starts at the current char. It returns
+// whether one did, and false as second value if the input ended
+// before it was closed.
+func (tkn *Tokenizer) scanRawQuoteOrComment(buffer *bytes2.Buffer) (bool, bool) {
+	switch ch := tkn.lastChar; ch {
+	case '\'', '"', '`':
+		tkn.consumeNext(buffer)
+		for tkn.lastChar != ch {
+			if tkn.lastChar == eofChar {
+				return true, false
+			}
+			if tkn.lastChar == '\\' && tkn.SQLMode.backslashEscapes(ch) {
+				tkn.consumeNext(buffer)
+				if tkn.lastChar == eofChar {
+					return true, false
+				}
+			}
+			tkn.consumeNext(buffer)
+		}
+		tkn.consumeNext(buffer)
+		return true, true
+	case '#':
+		tkn.scanRawLineComment(buffer)
+		return true, true
+	case '-':
+		if !tkn.hasPrefix("--") {
+			return false, true
+		}
+		tkn.scanRawLineComment(buffer)
+		return true, true
+	case '/':
+		if !tkn.hasPrefix("/*") {
+			return false, true
+		}
+		tkn.consumeNext(buffer)
+		tkn.consumeNext(buffer)
+		for {
+			if tkn.lastChar == eofChar {
+				return true, false
+			}
+			if tkn.lastChar == '*' {
+				tkn.consumeNext(buffer)
+				if tkn.lastChar == '/' {
+					tkn.consumeNext(buffer)
+					return true, true
+				}
+				continue
+			}
+			tkn.consumeNext(buffer)
+		}
+	}
+	return false, true
+}
+
+// scanRawLineComment copies a single line comment into buffer.
+func (tkn *Tokenizer) scanRawLineComment(buffer *bytes2.Buffer) {
+	for tkn.lastChar != eofChar && tkn.lastChar != '\n' {
+		tkn.consumeNext(buffer)
+	}
+}
+
 func (tkn *Tokenizer) consumeNext(buffer *bytes2.Buffer) {
 	if tkn.lastChar == eofChar {
 		// This should never happen.
@@ -912,16 +1614,57 @@ func (tkn *Tokenizer) next() {
 		tkn.Position++
 		tkn.lastChar = uint16(tkn.buf[tkn.bufPos])
 		tkn.bufPos++
+		if tkn.lastChar == '\n' {
+			tkn.line++
+		}
+		if tkn.TrackSource {
+			tkn.source = append(tkn.source, byte(tkn.lastChar))
+		}
 	}
 }
 
+// recordSource attaches the input consumed since the last reset
+// to the parse tree if TrackSource is set.
+func (tkn *Tokenizer) recordSource() {
+	tracker, ok := tkn.ParseTree.(sourceTracker)
+	if !tkn.TrackSource || !ok {
+		return
+	}
+	// The current char is not part of the statement.
+	end := tkn.Position - 1
+	text := bytes.TrimRight(tkn.source[:end-tkn.sourceStart], " \n\r\t")
+	if !tkn.multi {
+		text = bytes.TrimRight(bytes.TrimSuffix(text, []byte{';'}), " \n\r\t")
+	}
+	trimmed := bytes.TrimLeft(text, " \n\r\t")
+	start := tkn.sourceStart + len(text) - len(trimmed)
+	text = trimmed
+	tracker.setSource(&sourceText{
+		span: Span{Start: start, End: start + len(text)},
+		text: string(text),
+	})
+}
+
 // reset clears any internal state.
 func (tkn *Tokenizer) reset() {
 	tkn.ParseTree = nil
 	tkn.partialDDL = nil
+	if tkn.TrackSource && len(tkn.source) > 0 {
+		// Keep the current char, it starts the next statement.
+		tkn.sourceStart = tkn.Position - 1
+		tkn.source = append(tkn.source[:0], tkn.source[len(tkn.source)-1])
+	}
 	tkn.specialComment = nil
+	tkn.extensionErr = nil
+	tkn.unterminated = ""
+	tkn.inStatement = false
 	tkn.posVarIndex = 0
 	tkn.nesting = 0
+	tkn.inTrigger = false
+	tkn.lastTyp, tkn.prevTyp = 0, 0
+	tkn.sawStatement = false
+	tkn.size = 0
+	tkn.tooLarge = false
 	tkn.ForceEOF = false
 }
 
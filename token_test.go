@@ -137,6 +137,56 @@ func TestString(t *testing.T) {
 	}
 }
 
+func TestDollarQuotedString(t *testing.T) {
+	testcases := []struct {
+		in   string
+		id   int
+		want string
+	}{{
+		in:   "$$$$",
+		id:   STRING,
+		want: "",
+	}, {
+		in:   "$$hello$$",
+		id:   STRING,
+		want: "hello",
+	}, {
+		in:   "$$it's \\n$$",
+		id:   STRING,
+		want: "it's \\n",
+	}, {
+		in:   "$fn$costs $5\nor $$ more\n$fn$",
+		id:   STRING,
+		want: "costs $5\nor $$ more\n",
+	}, {
+		in:   "$a1$x$a$a1$",
+		id:   STRING,
+		want: "x$a",
+	}, {
+		in:   "$$hello",
+		id:   LEX_ERROR,
+		want: "hello",
+	}, {
+		in:   "$1$x$1$",
+		id:   LEX_ERROR,
+		want: "$",
+	}}
+
+	for _, tcase := range testcases {
+		tkn := NewStringTokenizer(tcase.in)
+		tkn.Dialect = PostgresDialect
+		id, got := tkn.Scan()
+		if tcase.id != id || string(got) != tcase.want {
+			t.Errorf("Scan(%q) = (%s, %q), want (%s, %q)", tcase.in, tokenName(id), got, tokenName(tcase.id), tcase.want)
+		}
+	}
+
+	// Dollar quoting is not available in the default dialect.
+	if id, _ := NewStringTokenizer("$$hello$$").Scan(); id != LEX_ERROR {
+		t.Errorf("Scan($$hello$$) = %s, want LEX_ERROR", tokenName(id))
+	}
+}
+
 func TestSplitStatement(t *testing.T) {
 	testcases := []struct {
 		in  string
@@ -19,6 +19,7 @@ package sqlparser
 import (
 	"bytes"
 	"fmt"
+	"io"
 )
 
 // NodeFormatter defines the signature of a custom node formatter
@@ -32,16 +33,111 @@ type NodeFormatter func(buf *TrackedBuffer, node SQLNode)
 // use to format a node. By default(nil), it's FormatNode.
 // But you can supply a different formatting function if you
 // want to generate a query that's different from the default.
+// Dialect selects the identifier quoting and syntax the query
+// is generated in. By default, it's MySQL. Style is the style the
+// query is formatted in, see SetDefaultStyle. SingleLine,
+// PipesAsConcat and SQLMode are set by StringWithOptions, see
+// FormatOptions.
 type TrackedBuffer struct {
 	*bytes.Buffer
+	Dialect       Dialect
+	Style         FormatStyle
+	SingleLine    bool
+	PipesAsConcat bool
+	SQLMode       SQLMode
 	bindLocations []bindLocation
+	// countArg is true while the buffer formats a bind variable in
+	// place of the row count or the offset of a LIMIT.
+	countArg bool
+	// verbatim are the spans of the buffer whose text keeps its case,
+	// see writeVerbatim.
+	verbatim      [][2]int
 	nodeFormatter NodeFormatter
+	// w, if set, is where WriteTo streams the formatted query: the
+	// buffer is flushed to it between nodes. flushed is the number
+	// of bytes written to w.
+	w       io.Writer
+	flushed int64
+}
+
+// flushSize is the size at which WriteTo flushes the buffer.
+const flushSize = 32 * 1024
+
+// writeError wraps the error of the writer of WriteTo, to stop the
+// formatting with a panic.
+type writeError struct {
+	err error
+}
+
+// WriteTo writes the formatted SQL of stmt to w, as String returns it,
+// and returns the number of bytes written. The SQL is written while it's
+// formatted, in chunks, so that large statements, like INSERTs of many
+// rows, don't have to fit in memory. Formatting stops at the first error
+// of w, which WriteTo returns.
+func WriteTo(w io.Writer, stmt Statement) (int64, error) {
+	return writeNode(w, stmt, MySQLDialect)
+}
+
+// writeNode writes the formatted node to w in dialect. String and
+// StringWithDialect use it with a bytes.Buffer, which is written to
+// directly.
+func writeNode(w io.Writer, node SQLNode, dialect Dialect) (n int64, err error) {
+	if node == nil {
+		written, err := io.WriteString(w, "<nil>")
+		return int64(written), err
+	}
+	if b, ok := w.(*bytes.Buffer); ok {
+		start := b.Len()
+		b.Grow(formattedSize(node))
+		buf := &TrackedBuffer{Buffer: b, Dialect: dialect, Style: defaultStyle}
+		buf.formatNode(node)
+		buf.applyKeywordCase(start)
+		return int64(b.Len() - start), nil
+	}
+	buf := NewTrackedBuffer(nil)
+	buf.Dialect = dialect
+	buf.w = w
+	defer func() {
+		if r := recover(); r != nil {
+			werr, ok := r.(writeError)
+			if !ok {
+				panic(r)
+			}
+			n, err = buf.flushed, werr.err
+		}
+	}()
+	buf.formatNode(node)
+	buf.flush()
+	return buf.flushed, nil
+}
+
+// maybeFlush flushes the buffer to the writer of WriteTo, if any,
+// once it holds flushSize bytes.
+func (buf *TrackedBuffer) maybeFlush() {
+	if buf.w != nil && buf.Len() >= flushSize {
+		buf.flush()
+	}
+}
+
+func (buf *TrackedBuffer) flush() {
+	buf.applyKeywordCase(0)
+	buf.verbatim = nil
+	n, err := buf.w.Write(buf.Bytes())
+	buf.flushed += int64(n)
+	if err == nil && n < buf.Len() {
+		err = io.ErrShortWrite
+	}
+	if err != nil {
+		panic(writeError{err: err})
+	}
+	buf.Buffer.Reset()
 }
 
 // NewTrackedBuffer creates a new TrackedBuffer.
 func NewTrackedBuffer(nodeFormatter NodeFormatter) *TrackedBuffer {
 	return &TrackedBuffer{
 		Buffer:        new(bytes.Buffer),
+		Style:         defaultStyle,
 		nodeFormatter: nodeFormatter,
 	}
 }
@@ -95,6 +191,7 @@ func (buf *TrackedBuffer) Myprintf(format string, values ...interface{}) {
 			}
 		case 'v':
 			node := values[fieldnum].(SQLNode)
+			buf.maybeFlush()
 			if buf.nodeFormatter == nil {
 				node.Format(buf)
 			} else {
@@ -110,20 +207,68 @@ func (buf *TrackedBuffer) Myprintf(format string, values ...interface{}) {
 	}
 }
 
+// formatNode formats node like Myprintf("%v", node) does. The Format
+// methods of the most common nodes use it instead of Myprintf, whose
+// parsing of the format and boxing of the arguments would dominate the
+// formatting of large statements. Nodes that are not pointers are
+// boxed by the conversion to SQLNode: these methods call their Format
+// method directly if there is no nodeFormatter.
+func (buf *TrackedBuffer) formatNode(node SQLNode) {
+	buf.maybeFlush()
+	if buf.nodeFormatter == nil {
+		node.Format(buf)
+	} else {
+		buf.nodeFormatter(buf, node)
+	}
+	if holder, ok := node.(extensionHolder); ok {
+		for _, clause := range holder.getExtensions() {
+			buf.WriteString(" ")
+			buf.WriteString(clause.Text)
+		}
+	}
+}
+
+// Reset empties the buffer and forgets the bind variable locations,
+// so that the buffer can format another node without allocating new
+// storage. ParsedQuery results built before are not affected.
+func (buf *TrackedBuffer) Reset() {
+	buf.Buffer.Reset()
+	buf.bindLocations = nil
+	buf.verbatim = nil
+}
+
 // WriteArg writes a value argument into the buffer along with
 // tracking information for future substitutions. arg must contain
 // the ":" or "::" prefix.
 func (buf *TrackedBuffer) WriteArg(arg string) {
 	buf.bindLocations = append(buf.bindLocations, bindLocation{
-		offset: buf.Len(),
+		offset: int(buf.flushed) + buf.Len(),
 		length: len(arg),
+		count:  buf.countArg,
 	})
 	buf.WriteString(arg)
 }
 
+// formatCount formats the row count or the offset of a LIMIT. A bind
+// variable in its place must be a non-negative integer when the query
+// is generated. A count that isn't atomic, like the 1 + 1 of the SQL
+// Server TOP (1 + 1), is parenthesized.
+func (buf *TrackedBuffer) formatCount(expr Expr) {
+	if val, ok := expr.(*SQLVal); ok && val.Type == ValArg {
+		buf.countArg = true
+		defer func() { buf.countArg = false }()
+	}
+	if !isAtomicExpr(expr) {
+		buf.Myprintf("(%v)", expr)
+		return
+	}
+	buf.Myprintf("%v", expr)
+}
+
 // ParsedQuery returns a ParsedQuery that contains bind
 // locations for easy substitution.
 func (buf *TrackedBuffer) ParsedQuery() *ParsedQuery {
+	buf.applyKeywordCase(0)
 	return &ParsedQuery{Query: buf.String(), bindLocations: buf.bindLocations}
 }
 